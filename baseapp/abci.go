@@ -305,19 +305,69 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) (res abci.ResponseDeliv
 
 // PrepareProposal fullfills the celestia-core version of the ABCI interface. It
 // allows for arbitrary processing steps before transaction data is included in
-// the block.
-func (app *BaseApp) PrepareProposal(req abci.RequestPrepareProposal) abci.ResponsePrepareProposal {
-	return abci.ResponsePrepareProposal{
-		BlockData: req.BlockData,
+// the block. The txs are processed by the PrepareProposalHandler in a state
+// branched from the latest committed state, which is reset on every call since
+// PrepareProposal can be called again in a subsequent round.
+func (app *BaseApp) PrepareProposal(req abci.RequestPrepareProposal) (resp abci.ResponsePrepareProposal) {
+	if app.prepareProposal == nil {
+		return abci.ResponsePrepareProposal{BlockData: req.BlockData}
 	}
+
+	header := tmproto.Header{
+		ChainID: req.ChainId,
+		Height:  req.Height,
+		Time:    req.Time,
+	}
+	app.setProposalState(runTxPrepareProposal, header)
+
+	ctx := app.prepareProposalState.ctx
+	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+
+	defer func() {
+		if r := recover(); r != nil {
+			app.logger.Error(
+				"panic recovered in PrepareProposal",
+				"height", req.Height,
+				"time", req.Time,
+				"panic", r,
+			)
+
+			// propose the txs as is rather than halting the node
+			resp = abci.ResponsePrepareProposal{BlockData: req.BlockData}
+		}
+	}()
+
+	return app.prepareProposal(ctx, req)
 }
 
 // ProcessProposal fulfills the celestia-core version of the ABCI++ interface.
 // It allows for arbitrary processing to occur after receiving a proposal block.
-func (app *BaseApp) ProcessProposal(req abci.RequestProcessProposal) abci.ResponseProcessProposal {
-	return abci.ResponseProcessProposal{
-		Result: abci.ResponseProcessProposal_ACCEPT,
+// The proposal is validated by the ProcessProposalHandler in a state branched
+// from the latest committed state, which is reset on every call.
+func (app *BaseApp) ProcessProposal(req abci.RequestProcessProposal) (resp abci.ResponseProcessProposal) {
+	if app.processProposal == nil {
+		return abci.ResponseProcessProposal{Result: abci.ResponseProcessProposal_ACCEPT}
 	}
+
+	app.setProposalState(runTxProcessProposal, req.Header)
+
+	ctx := app.processProposalState.ctx
+	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+
+	defer func() {
+		if r := recover(); r != nil {
+			app.logger.Error(
+				"panic recovered in ProcessProposal",
+				"height", req.Header.Height,
+				"time", req.Header.Time,
+				"panic", r,
+			)
+
+			resp = abci.ResponseProcessProposal{Result: abci.ResponseProcessProposal_REJECT}
+		}
+	}()
+
+	return app.processProposal(ctx, req)
 }
 
 // Commit implements the ABCI interface. It will commit all state that exists in
@@ -18,14 +18,17 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 )
 
 const (
-	runTxModeCheck    runTxMode = iota // Check a transaction
-	runTxModeReCheck                   // Recheck a (pending) transaction after a commit
-	runTxModeSimulate                  // Simulate a transaction
-	runTxModeDeliver                   // Deliver a transaction
+	runTxModeCheck       runTxMode = iota // Check a transaction
+	runTxModeReCheck                      // Recheck a (pending) transaction after a commit
+	runTxModeSimulate                     // Simulate a transaction
+	runTxModeDeliver                      // Deliver a transaction
+	runTxPrepareProposal                  // Prepare a block proposal
+	runTxProcessProposal                  // Process a block proposal
 )
 
 var _ abci.Application = (*BaseApp)(nil)
@@ -63,6 +66,9 @@ type BaseApp struct { // nolint: maligned
 	name              string // application name from abci.Info
 	interfaceRegistry codectypes.InterfaceRegistry
 	txDecoder         sdk.TxDecoder // unmarshal []byte into sdk.Tx
+	txEncoder         sdk.TxEncoder // marshal sdk.Tx into []byte
+
+	mempool mempool.Mempool // application side mempool
 
 	anteHandler sdk.AnteHandler // ante handler for fee and auth
 	postHandler sdk.AnteHandler // post handler, optional, e.g. for tips
@@ -82,6 +88,12 @@ type BaseApp struct { // nolint: maligned
 	// set on InitChain and BeginBlock and set to nil on Commit.
 	deliverState *state // for DeliverTx
 
+	// prepareProposalState and processProposalState are used for PrepareProposal
+	// and ProcessProposal respectively. They are branched from the latest
+	// committed state, are never committed and are reset on every call.
+	prepareProposalState *state
+	processProposalState *state
+
 	// paramStore is used to query for ABCI consensus parameters from an
 	// application parameter store.
 	paramStore ParamStore
@@ -169,6 +181,9 @@ type abciData struct {
 	beginBlocker sdk.BeginBlocker // logic to run before any txs
 	endBlocker   sdk.EndBlocker   // logic to run after all txs, and to determine valset changes
 
+	prepareProposal sdk.PrepareProposalHandler // logic to select the txs of a block proposal
+	processProposal sdk.ProcessProposalHandler // logic to validate a block proposal
+
 	// absent validators from begin block
 	voteInfos []abci.VoteInfo
 }
@@ -216,6 +231,10 @@ func NewBaseApp(
 
 	app.runTxRecoveryMiddleware = newDefaultRecoveryMiddleware()
 
+	if app.mempool == nil {
+		app.mempool = mempool.NoOpMempool{}
+	}
+
 	return app
 }
 
@@ -252,6 +271,11 @@ func (app *BaseApp) Version() string {
 	return app.version
 }
 
+// Mempool returns the Mempool of the app.
+func (app *BaseApp) Mempool() mempool.Mempool {
+	return app.mempool
+}
+
 // Logger returns the logger of the BaseApp.
 func (app *BaseApp) Logger() log.Logger {
 	return app.logger
@@ -407,6 +431,15 @@ func (app *BaseApp) Init() error {
 		panic("cannot call initFromMainStore: baseapp already sealed")
 	}
 
+	// install the default proposal handlers unless custom ones are provided
+	proposalHandler := NewDefaultProposalHandler(app.mempool, app)
+	if app.prepareProposal == nil {
+		app.prepareProposal = proposalHandler.PrepareProposalHandler()
+	}
+	if app.processProposal == nil {
+		app.processProposal = proposalHandler.ProcessProposalHandler()
+	}
+
 	// needed for the export command which inits from store but never calls initchain
 	app.setCheckState(tmproto.Header{})
 	app.Seal()
@@ -494,6 +527,33 @@ func (app *BaseApp) setDeliverState(header tmproto.Header) {
 	}
 }
 
+// setProposalState sets the state used by PrepareProposal or ProcessProposal
+// with a branched multi-store (i.e. a CacheMultiStore) and a new Context with
+// the same multi-store branch, and provided header. The state is branched from
+// the genesis state at the initial height, before it is committed.
+func (app *BaseApp) setProposalState(mode runTxMode, header tmproto.Header) {
+	var ms sdk.CacheMultiStore
+	if app.deliverState != nil {
+		ms = app.deliverState.CacheMultiStore()
+	} else {
+		ms = app.cms.CacheMultiStore()
+	}
+
+	st := &state{
+		ms:  ms,
+		ctx: sdk.NewContext(ms, header, false, app.logger).WithBlockGasMeter(storetypes.NewInfiniteGasMeter()),
+	}
+
+	switch mode {
+	case runTxPrepareProposal:
+		app.prepareProposalState = st
+	case runTxProcessProposal:
+		app.processProposalState = st
+	default:
+		panic(fmt.Sprintf("invalid proposal mode: %d", mode))
+	}
+}
+
 // GetConsensusParams returns the current consensus parameters from the BaseApp's
 // ParamStore. If the BaseApp has no ParamStore defined, nil is returned.
 func (app *BaseApp) GetConsensusParams(ctx sdk.Context) *abci.ConsensusParams {
@@ -630,11 +690,16 @@ func validateBasicTxMsgs(msgs []sdk.Msg) error {
 // Returns the applications's deliverState if app is in runTxModeDeliver,
 // otherwise it returns the application's checkstate.
 func (app *BaseApp) getState(mode runTxMode) *state {
-	if mode == runTxModeDeliver {
+	switch mode {
+	case runTxModeDeliver:
 		return app.deliverState
+	case runTxPrepareProposal:
+		return app.prepareProposalState
+	case runTxProcessProposal:
+		return app.processProposalState
+	default:
+		return app.checkState
 	}
-
-	return app.checkState
 }
 
 // NewProposalContext returns a context with a branched version of the state
@@ -780,6 +845,13 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		gasWanted = ctx.GasMeter().Limit()
 
 		if err != nil {
+			if mode == runTxModeReCheck {
+				// the tx is no longer valid, so remove it from the mempool
+				if rmErr := app.mempool.Remove(tx); rmErr != nil && !errors.Is(rmErr, mempool.ErrTxNotFound) {
+					return gInfo, nil, nil, 0, rmErr
+				}
+			}
+
 			return gInfo, nil, nil, 0, err
 		}
 
@@ -788,6 +860,19 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		anteEvents = events.ToABCIEvents()
 	}
 
+	switch mode {
+	case runTxModeCheck:
+		if err := app.mempool.Insert(ctx, tx); err != nil {
+			return gInfo, nil, anteEvents, priority, err
+		}
+
+	case runTxModeDeliver:
+		if err := app.mempool.Remove(tx); err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+			return gInfo, nil, anteEvents, priority,
+				fmt.Errorf("failed to remove tx from mempool: %w", err)
+		}
+	}
+
 	// Create a new Context based off of the existing Context with a MultiStore branch
 	// in case message processing fails. At this point, the MultiStore
 	// is a branch of a branch.
@@ -843,8 +928,9 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 
 	// NOTE: GasWanted is determined by the AnteHandler and GasUsed by the GasMeter.
 	for i, msg := range msgs {
-		// skip actual execution for (Re)CheckTx mode
-		if mode == runTxModeCheck || mode == runTxModeReCheck {
+		// skip actual execution for (Re)CheckTx and proposal modes
+		if mode == runTxModeCheck || mode == runTxModeReCheck ||
+			mode == runTxPrepareProposal || mode == runTxProcessProposal {
 			break
 		}
 
@@ -2,6 +2,7 @@ package baseapp
 
 import (
 	"bytes"
+	"context"
 	"encoding/binary"
 	"fmt"
 	"math/rand"
@@ -30,6 +31,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 )
 
@@ -492,6 +494,18 @@ func TestBaseAppOptionSeal(t *testing.T) {
 	require.Panics(t, func() {
 		app.SetAnteHandler(nil)
 	})
+	require.Panics(t, func() {
+		app.SetMempool(nil)
+	})
+	require.Panics(t, func() {
+		app.SetTxEncoder(nil)
+	})
+	require.Panics(t, func() {
+		app.SetPrepareProposal(nil)
+	})
+	require.Panics(t, func() {
+		app.SetProcessProposal(nil)
+	})
 	require.Panics(t, func() {
 		app.SetAddrPeerFilter(nil)
 	})
@@ -928,6 +942,306 @@ func TestCheckTx(t *testing.T) {
 	require.Nil(t, storedBytes)
 }
 
+func TestPrepareProposal(t *testing.T) {
+	counterKey := []byte("counter-key")
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, counterKey)) }
+	app := setupBaseApp(t, anteOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	marshal := func(tx *txTest) []byte {
+		txBytes, err := codec.Marshal(tx)
+		require.NoError(t, err)
+		return txBytes
+	}
+
+	failingTx := newTxCounter(1, 0)
+	failingTx.setFailOnAnte(true)
+
+	tx0, tx1, tx2 := marshal(newTxCounter(0, 0)), marshal(newTxCounter(1, 0)), marshal(newTxCounter(2, 0))
+	txs := [][]byte{
+		tx0,
+		marshal(failingTx),
+		[]byte("garbage"),
+		tx1,
+		tx2,
+	}
+
+	req := abci.RequestPrepareProposal{
+		BlockData: &tmproto.Data{Txs: txs, SquareSize: 4, Hash: []byte("data-hash")},
+		ChainId:   "test-chain",
+		Height:    1,
+	}
+	res := app.PrepareProposal(req)
+	require.Equal(t, [][]byte{tx0, tx1, tx2}, res.BlockData.Txs)
+	require.Equal(t, uint64(4), res.BlockData.SquareSize)
+	require.Equal(t, []byte("data-hash"), res.BlockData.Hash)
+
+	// the state is reset on every call and the check state is untouched
+	res = app.PrepareProposal(req)
+	require.Equal(t, [][]byte{tx0, tx1, tx2}, res.BlockData.Txs)
+	require.Equal(t, int64(0), getIntFromStore(app.checkState.ctx.KVStore(capKey1), counterKey))
+
+	// txs exceeding the maximum block size are dropped
+	req.BlockDataSize = int64(len(tx0) + len(tx1))
+	res = app.PrepareProposal(req)
+	require.Equal(t, [][]byte{tx0, tx1}, res.BlockData.Txs)
+}
+
+// gasTxTest is a tx defining a gas limit
+type gasTxTest struct {
+	txTest
+	gas uint64
+}
+
+func (tx gasTxTest) GetGas() uint64             { return tx.gas }
+func (tx gasTxTest) GetFee() sdk.Coins          { return nil }
+func (tx gasTxTest) FeePayer() sdk.AccAddress   { return nil }
+func (tx gasTxTest) FeeGranter() sdk.AccAddress { return nil }
+
+// gasTxVerifier decodes txs as gasTxTest with a gas limit equal to the tx size
+type gasTxVerifier struct{}
+
+func (gasTxVerifier) TxDecode(txBz []byte) (sdk.Tx, error) {
+	return gasTxTest{gas: uint64(len(txBz))}, nil
+}
+
+func (v gasTxVerifier) PrepareProposalVerifyTx(txBz []byte) (sdk.Tx, error) {
+	return v.TxDecode(txBz)
+}
+
+func (v gasTxVerifier) ProcessProposalVerifyTx(txBz []byte) (sdk.Tx, error) {
+	return v.TxDecode(txBz)
+}
+
+func (gasTxVerifier) TxEncode(tx sdk.Tx) ([]byte, error) {
+	return make([]byte, tx.(gasTxTest).gas), nil
+}
+
+func TestDefaultProposalHandlerMaxGas(t *testing.T) {
+	handler := NewDefaultProposalHandler(mempool.NoOpMempool{}, gasTxVerifier{})
+	ctx := sdk.Context{}.WithConsensusParams(&abci.ConsensusParams{
+		Block: &abci.BlockParams{MaxGas: 10},
+	})
+
+	txs := [][]byte{[]byte("12345"), []byte("123456"), []byte("1234")}
+
+	// the second tx would exceed the maximum block gas
+	res := handler.PrepareProposalHandler()(ctx, abci.RequestPrepareProposal{BlockData: &tmproto.Data{Txs: txs}})
+	require.Equal(t, [][]byte{txs[0], txs[2]}, res.BlockData.Txs)
+
+	processRes := handler.ProcessProposalHandler()(ctx, abci.RequestProcessProposal{BlockData: &tmproto.Data{Txs: txs}})
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, processRes.Result)
+
+	processRes = handler.ProcessProposalHandler()(ctx, abci.RequestProcessProposal{BlockData: res.BlockData})
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, processRes.Result)
+}
+
+func TestDefaultProposalHandlerNoOpMempoolPointer(t *testing.T) {
+	ctx := sdk.Context{}.WithConsensusParams(&abci.ConsensusParams{})
+	txs := [][]byte{[]byte("12345"), []byte("123456")}
+
+	// the txs proposed by Tendermint are kept with a NoOpMempool passed by
+	// value or by pointer
+	for _, mp := range []mempool.Mempool{nil, mempool.NoOpMempool{}, &mempool.NoOpMempool{}} {
+		handler := NewDefaultProposalHandler(mp, gasTxVerifier{})
+		res := handler.PrepareProposalHandler()(ctx, abci.RequestPrepareProposal{BlockData: &tmproto.Data{Txs: txs}})
+		require.Equal(t, txs, res.BlockData.Txs)
+	}
+}
+
+// testMempool is a mempool keeping the txs in insertion order
+type testMempool struct {
+	txs []sdk.Tx
+}
+
+var _ mempool.Mempool = (*testMempool)(nil)
+
+func (mp *testMempool) Insert(_ context.Context, tx sdk.Tx) error {
+	mp.txs = append(mp.txs, tx)
+	return nil
+}
+
+func (mp *testMempool) Select(_ context.Context, _ [][]byte) mempool.Iterator {
+	if len(mp.txs) == 0 {
+		return nil
+	}
+
+	return &testMempoolIterator{txs: append([]sdk.Tx{}, mp.txs...)}
+}
+
+func (mp *testMempool) CountTx() int { return len(mp.txs) }
+
+func (mp *testMempool) Remove(tx sdk.Tx) error {
+	for i, mpTx := range mp.txs {
+		if mpTx.(txTest).Counter == tx.(txTest).Counter {
+			mp.txs = append(mp.txs[:i], mp.txs[i+1:]...)
+			return nil
+		}
+	}
+
+	return mempool.ErrTxNotFound
+}
+
+type testMempoolIterator struct {
+	txs []sdk.Tx
+}
+
+func (i *testMempoolIterator) Next() mempool.Iterator {
+	i.txs = i.txs[1:]
+	if len(i.txs) == 0 {
+		return nil
+	}
+
+	return i
+}
+
+func (i *testMempoolIterator) Tx() sdk.Tx { return i.txs[0] }
+
+func TestMempool(t *testing.T) {
+	counterKey := []byte("counter-key")
+	mp := &testMempool{}
+	opts := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, counterKey))
+		bapp.SetTxEncoder(aminoTxEncoder())
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, []byte("deliver-key"))))
+	}
+	app := setupBaseApp(t, SetMempool(mp), opts)
+	app.InitChain(abci.RequestInitChain{})
+	require.Equal(t, mp, app.Mempool())
+
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	marshal := func(tx *txTest) []byte {
+		txBytes, err := codec.Marshal(tx)
+		require.NoError(t, err)
+		return txBytes
+	}
+
+	// the txs passing CheckTx are inserted in the mempool
+	txs := [][]byte{marshal(newTxCounter(0, 0)), marshal(newTxCounter(1, 1))}
+	for _, txBytes := range txs {
+		require.True(t, app.CheckTx(abci.RequestCheckTx{Tx: txBytes}).IsOK())
+	}
+
+	failingTx := newTxCounter(2, 0)
+	failingTx.setFailOnAnte(true)
+	require.False(t, app.CheckTx(abci.RequestCheckTx{Tx: marshal(failingTx)}).IsOK())
+	require.Equal(t, 2, mp.CountTx())
+
+	// the proposal is built from the mempool, whose invalid txs are removed
+	require.NoError(t, mp.Insert(sdk.Context{}, *failingTx))
+	res := app.PrepareProposal(abci.RequestPrepareProposal{
+		BlockData: &tmproto.Data{Txs: [][]byte{[]byte("ignored")}},
+		Height:    1,
+	})
+	require.Equal(t, txs, res.BlockData.Txs)
+	require.Equal(t, 2, mp.CountTx())
+
+	// the delivered txs are removed from the mempool
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	for _, txBytes := range txs {
+		require.True(t, app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes}).IsOK())
+	}
+	require.Zero(t, mp.CountTx())
+}
+
+func TestDefaultProposalHandlerSkipsLargeTxs(t *testing.T) {
+	mp := &testMempool{}
+	for _, size := range []uint64{100, 500, 80, 20} {
+		require.NoError(t, mp.Insert(sdk.Context{}, gasTxTest{gas: size}))
+	}
+	handler := NewDefaultProposalHandler(mp, gasTxVerifier{})
+
+	// the tx of 500 bytes is skipped, and the selection ends once the
+	// remaining 20 bytes are below the minimum tx size
+	res := handler.PrepareProposalHandler()(sdk.Context{}, abci.RequestPrepareProposal{
+		BlockData:     &tmproto.Data{},
+		BlockDataSize: 200,
+	})
+	require.Equal(t, [][]byte{make([]byte, 100), make([]byte, 80)}, res.BlockData.Txs)
+	require.Equal(t, 4, mp.CountTx())
+}
+
+func TestProcessProposal(t *testing.T) {
+	counterKey := []byte("counter-key")
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, counterKey)) }
+	app := setupBaseApp(t, anteOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	marshal := func(tx *txTest) []byte {
+		txBytes, err := codec.Marshal(tx)
+		require.NoError(t, err)
+		return txBytes
+	}
+
+	failingTx := newTxCounter(1, 0)
+	failingTx.setFailOnAnte(true)
+
+	testCases := map[string]struct {
+		txs    [][]byte
+		result abci.ResponseProcessProposal_Result
+	}{
+		"empty": {
+			nil,
+			abci.ResponseProcessProposal_ACCEPT,
+		},
+		"valid txs": {
+			[][]byte{marshal(newTxCounter(0, 0)), marshal(newTxCounter(1, 0))},
+			abci.ResponseProcessProposal_ACCEPT,
+		},
+		"undecodable tx": {
+			[][]byte{marshal(newTxCounter(0, 0)), []byte("garbage")},
+			abci.ResponseProcessProposal_REJECT,
+		},
+		"ante handler failure": {
+			[][]byte{marshal(newTxCounter(0, 0)), marshal(failingTx)},
+			abci.ResponseProcessProposal_REJECT,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			res := app.ProcessProposal(abci.RequestProcessProposal{
+				Header:    tmproto.Header{Height: 1},
+				BlockData: &tmproto.Data{Txs: tc.txs},
+			})
+			require.Equal(t, tc.result, res.Result)
+		})
+	}
+}
+
+func TestCustomProposalHandlers(t *testing.T) {
+	extraTx := []byte("extra")
+	proposalOpt := func(bapp *BaseApp) {
+		bapp.SetPrepareProposal(func(ctx sdk.Context, req abci.RequestPrepareProposal) abci.ResponsePrepareProposal {
+			return abci.ResponsePrepareProposal{
+				BlockData: &tmproto.Data{Txs: append(req.BlockData.Txs, extraTx)},
+			}
+		})
+		bapp.SetProcessProposal(func(ctx sdk.Context, req abci.RequestProcessProposal) abci.ResponseProcessProposal {
+			require.Equal(t, int64(2), ctx.BlockHeight())
+			panic("process proposal failure")
+		})
+	}
+	app := setupBaseApp(t, proposalOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	res := app.PrepareProposal(abci.RequestPrepareProposal{BlockData: &tmproto.Data{}, Height: 1})
+	require.Equal(t, [][]byte{extraTx}, res.BlockData.Txs)
+
+	// panics are recovered and the proposal is rejected
+	processRes := app.ProcessProposal(abci.RequestProcessProposal{Header: tmproto.Header{Height: 2}})
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, processRes.Result)
+}
+
 // Test that successive DeliverTx can see each others' effects
 // on the store, both within and across blocks.
 func TestDeliverTx(t *testing.T) {
@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 )
 
 // File for storing in-package BaseApp optional functions,
@@ -62,6 +63,11 @@ func SetIndexEvents(ie []string) func(*BaseApp) {
 	return func(app *BaseApp) { app.setIndexEvents(ie) }
 }
 
+// SetMempool sets the mempool on BaseApp.
+func SetMempool(mempool mempool.Mempool) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetMempool(mempool) }
+}
+
 // SetIAVLCacheSize provides a BaseApp option function that sets the size of IAVL cache.
 func SetIAVLCacheSize(size int) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetIAVLCacheSize(size) }
@@ -191,6 +197,46 @@ func (app *BaseApp) SetPostHandler(ph sdk.AnteHandler) {
 	app.postHandler = ph
 }
 
+// SetMempool sets the mempool used by CheckTx and the default proposal
+// handlers. The mempool must be set before the app is loaded.
+func (app *BaseApp) SetMempool(mempool mempool.Mempool) {
+	if app.sealed {
+		panic("SetMempool() on sealed BaseApp")
+	}
+
+	app.mempool = mempool
+}
+
+// SetTxEncoder sets the TxEncoder used by the default PrepareProposal handler
+// to encode the txs selected from the mempool.
+func (app *BaseApp) SetTxEncoder(txEncoder sdk.TxEncoder) {
+	if app.sealed {
+		panic("SetTxEncoder() on sealed BaseApp")
+	}
+
+	app.txEncoder = txEncoder
+}
+
+// SetPrepareProposal sets the handler selecting the txs of the block proposals
+// built by the node. It overrides the default handler.
+func (app *BaseApp) SetPrepareProposal(handler sdk.PrepareProposalHandler) {
+	if app.sealed {
+		panic("SetPrepareProposal() on sealed BaseApp")
+	}
+
+	app.prepareProposal = handler
+}
+
+// SetProcessProposal sets the handler validating the block proposals received
+// by the node. It overrides the default handler.
+func (app *BaseApp) SetProcessProposal(handler sdk.ProcessProposalHandler) {
+	if app.sealed {
+		panic("SetProcessProposal() on sealed BaseApp")
+	}
+
+	app.processProposal = handler
+}
+
 func (app *BaseApp) SetAddrPeerFilter(pf sdk.PeerFilter) {
 	if app.sealed {
 		panic("SetAddrPeerFilter() on sealed BaseApp")
@@ -0,0 +1,297 @@
+package baseapp
+
+import (
+	"errors"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// ProposalTxVerifier defines the interface that is implemented by BaseApp,
+// that any custom proposal handler can use to verify the transactions of a
+// block proposal.
+type ProposalTxVerifier interface {
+	PrepareProposalVerifyTx(txBz []byte) (sdk.Tx, error)
+	ProcessProposalVerifyTx(txBz []byte) (sdk.Tx, error)
+	TxDecode(txBz []byte) (sdk.Tx, error)
+	TxEncode(tx sdk.Tx) ([]byte, error)
+}
+
+// DefaultProposalHandler defines the default PrepareProposal and ProcessProposal
+// handlers. The txs are verified one after the other in the same branched
+// state, so that each tx is validated against the state changes performed by
+// the ante handler for the previous ones.
+type DefaultProposalHandler struct {
+	mempool    mempool.Mempool
+	txVerifier ProposalTxVerifier
+}
+
+// NewDefaultProposalHandler returns a new DefaultProposalHandler.
+func NewDefaultProposalHandler(mp mempool.Mempool, txVerifier ProposalTxVerifier) DefaultProposalHandler {
+	return DefaultProposalHandler{
+		mempool:    mp,
+		txVerifier: txVerifier,
+	}
+}
+
+// PrepareProposalHandler returns the default PrepareProposal handler. With a
+// NoOpMempool, it keeps the txs proposed by Tendermint in order. Otherwise, it
+// selects the txs from the app-side mempool in the mempool order, and removes
+// the invalid ones from the mempool. In both cases, the txs that cannot be
+// decoded, that fail the ante handler, or that would make the block exceed the
+// maximum block size or gas set in the consensus params are dropped.
+func (h DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req abci.RequestPrepareProposal) abci.ResponsePrepareProposal {
+		selector := newTxSelector(maxBlockBytes(ctx, req.BlockDataSize), maxBlockGas(ctx))
+		blockData := req.GetBlockData()
+
+		if isNoOpMempool(h.mempool) {
+			for _, txBz := range blockData.GetTxs() {
+				tx, err := h.txVerifier.TxDecode(txBz)
+				if err != nil {
+					continue
+				}
+
+				// the txs that don't fit in the block or that are invalid are dropped
+				_ = selector.selectTx(h.txVerifier, tx, txBz)
+			}
+		} else {
+			skippedSigners := make(map[string]bool)
+			for iterator := h.mempool.Select(ctx, blockData.GetTxs()); iterator != nil; iterator = iterator.Next() {
+				tx := iterator.Tx()
+				if hasSigner(tx, skippedSigners) {
+					// the tx would fail verification because of the nonce gap
+					// left by a skipped tx of the same signer
+					continue
+				}
+
+				txBz, err := h.txVerifier.TxEncode(tx)
+				if err != nil {
+					h.removeTx(ctx, tx)
+					continue
+				}
+
+				err = selector.selectTx(h.txVerifier, tx, txBz)
+				if errors.Is(err, errBlockFull) {
+					// the tx is skipped and stays in the mempool, a smaller
+					// tx may still fit in the block
+					for _, signer := range txSigners(tx) {
+						skippedSigners[signer] = true
+					}
+				} else if err != nil {
+					h.removeTx(ctx, tx)
+				}
+
+				if selector.isFull() {
+					break
+				}
+			}
+		}
+
+		// the other fields of the block data, like the square size and the
+		// data hash, are kept as set in the request
+		resBlockData := &tmproto.Data{}
+		if blockData != nil {
+			*resBlockData = *blockData
+		}
+		resBlockData.Txs = selector.txs
+
+		return abci.ResponsePrepareProposal{BlockData: resBlockData}
+	}
+}
+
+// isNoOpMempool returns true if the mempool is nil or a NoOpMempool, passed by
+// value or by pointer, which never returns txs to build a proposal from.
+func isNoOpMempool(mp mempool.Mempool) bool {
+	switch mp.(type) {
+	case nil, mempool.NoOpMempool, *mempool.NoOpMempool:
+		return true
+	default:
+		return false
+	}
+}
+
+// removeTx removes an invalid tx from the mempool.
+func (h DefaultProposalHandler) removeTx(ctx sdk.Context, tx sdk.Tx) {
+	if err := h.mempool.Remove(tx); err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+		ctx.Logger().Error("failed to remove invalid tx from mempool", "err", err)
+	}
+}
+
+// errBlockFull is returned by txSelector when a tx doesn't fit in the block.
+var errBlockFull = errors.New("block is full")
+
+// minTxSize is the size of the smallest valid tx. Once the remaining space of
+// the block is below it, no other tx can be added to the proposal.
+const minTxSize = 64
+
+// hasSigner returns true if one of the signers of the tx is in signers.
+func hasSigner(tx sdk.Tx, signers map[string]bool) bool {
+	for _, signer := range txSigners(tx) {
+		if signers[signer] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// txSigners returns the signers of the msgs of the tx.
+func txSigners(tx sdk.Tx) []string {
+	var signers []string
+	for _, msg := range tx.GetMsgs() {
+		for _, signer := range msg.GetSigners() {
+			signers = append(signers, signer.String())
+		}
+	}
+
+	return signers
+}
+
+// txSelector accumulates the txs of a block proposal within the maximum block
+// size and gas.
+type txSelector struct {
+	maxBytes  int64
+	maxGas    uint64
+	totalSize int64
+	totalGas  uint64
+	txs       [][]byte
+}
+
+func newTxSelector(maxBytes int64, maxGas uint64) *txSelector {
+	return &txSelector{maxBytes: maxBytes, maxGas: maxGas}
+}
+
+// isFull returns true if the remaining space of the block is below the size
+// of the smallest tx, or if there is no gas left.
+func (s *txSelector) isFull() bool {
+	if s.maxBytes > 0 && s.maxBytes-s.totalSize < minTxSize {
+		return true
+	}
+
+	return s.maxGas > 0 && s.totalGas >= s.maxGas
+}
+
+// selectTx adds the tx to the proposal if it fits in the block and passes the
+// verification. It returns errBlockFull if the tx doesn't fit in the block.
+func (s *txSelector) selectTx(txVerifier ProposalTxVerifier, tx sdk.Tx, txBz []byte) error {
+	txSize := int64(len(txBz))
+	if s.maxBytes > 0 && s.totalSize+txSize > s.maxBytes {
+		return errBlockFull
+	}
+
+	txGas := txGasLimit(tx)
+	if s.maxGas > 0 && s.totalGas+txGas > s.maxGas {
+		return errBlockFull
+	}
+
+	if _, err := txVerifier.PrepareProposalVerifyTx(txBz); err != nil {
+		return err
+	}
+
+	s.txs = append(s.txs, txBz)
+	s.totalSize += txSize
+	s.totalGas += txGas
+
+	return nil
+}
+
+// ProcessProposalHandler returns the default ProcessProposal handler. It rejects
+// the proposals containing a tx that cannot be decoded or that fails the ante
+// handler, and the ones exceeding the maximum block gas set in the consensus
+// params.
+func (h DefaultProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req abci.RequestProcessProposal) abci.ResponseProcessProposal {
+		maxGas := maxBlockGas(ctx)
+
+		var totalGas uint64
+		for _, txBz := range req.GetBlockData().GetTxs() {
+			tx, err := h.txVerifier.ProcessProposalVerifyTx(txBz)
+			if err != nil {
+				return abci.ResponseProcessProposal{Result: abci.ResponseProcessProposal_REJECT}
+			}
+
+			totalGas += txGasLimit(tx)
+			if maxGas > 0 && totalGas > maxGas {
+				return abci.ResponseProcessProposal{Result: abci.ResponseProcessProposal_REJECT}
+			}
+		}
+
+		return abci.ResponseProcessProposal{Result: abci.ResponseProcessProposal_ACCEPT}
+	}
+}
+
+// PrepareProposalVerifyTx performs transaction verification when a proposer is
+// creating a block proposal. The tx is decoded and run through the ante handler
+// in the PrepareProposal state, without executing its messages.
+func (app *BaseApp) PrepareProposalVerifyTx(txBz []byte) (sdk.Tx, error) {
+	return app.proposalVerifyTx(runTxPrepareProposal, txBz)
+}
+
+// ProcessProposalVerifyTx performs transaction verification when receiving a
+// block proposal. The tx is decoded and run through the ante handler in the
+// ProcessProposal state, without executing its messages.
+func (app *BaseApp) ProcessProposalVerifyTx(txBz []byte) (sdk.Tx, error) {
+	return app.proposalVerifyTx(runTxProcessProposal, txBz)
+}
+
+// TxDecode decodes the given tx bytes with the app's tx decoder.
+func (app *BaseApp) TxDecode(txBz []byte) (sdk.Tx, error) {
+	return app.txDecoder(txBz)
+}
+
+// TxEncode encodes the given tx with the app's tx encoder.
+func (app *BaseApp) TxEncode(tx sdk.Tx) ([]byte, error) {
+	if app.txEncoder == nil {
+		return nil, errors.New("no tx encoder set")
+	}
+
+	return app.txEncoder(tx)
+}
+
+func (app *BaseApp) proposalVerifyTx(mode runTxMode, txBz []byte) (sdk.Tx, error) {
+	tx, err := app.txDecoder(txBz)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, _, _, _, err := app.runTx(mode, txBz); err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// maxBlockBytes returns the maximum size of the txs of a block, or 0 if there
+// is no limit.
+func maxBlockBytes(ctx sdk.Context, blockDataSize int64) int64 {
+	maxBytes := blockDataSize
+	if cp := ctx.ConsensusParams(); cp != nil && cp.Block != nil && cp.Block.MaxBytes > 0 {
+		if maxBytes <= 0 || cp.Block.MaxBytes < maxBytes {
+			maxBytes = cp.Block.MaxBytes
+		}
+	}
+
+	return maxBytes
+}
+
+// maxBlockGas returns the maximum gas of a block, or 0 if there is no limit.
+func maxBlockGas(ctx sdk.Context) uint64 {
+	if cp := ctx.ConsensusParams(); cp != nil && cp.Block != nil && cp.Block.MaxGas > 0 {
+		return uint64(cp.Block.MaxGas)
+	}
+
+	return 0
+}
+
+// txGasLimit returns the gas limit of the tx, or 0 if it doesn't define one.
+func txGasLimit(tx sdk.Tx) uint64 {
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		return feeTx.GetGas()
+	}
+
+	return 0
+}
@@ -19,3 +19,10 @@ type EndBlocker func(ctx Context, req abci.RequestEndBlock) abci.ResponseEndBloc
 
 // PeerFilter responds to p2p filtering queries from Tendermint
 type PeerFilter func(info string) abci.ResponseQuery
+
+// PrepareProposalHandler processes the transactions proposed by Tendermint before
+// they are included in a block proposal
+type PrepareProposalHandler func(ctx Context, req abci.RequestPrepareProposal) abci.ResponsePrepareProposal
+
+// ProcessProposalHandler validates a block proposal received from another validator
+type ProcessProposalHandler func(ctx Context, req abci.RequestProcessProposal) abci.ResponseProcessProposal
@@ -0,0 +1,112 @@
+package mempool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/tidwall/btree"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// Mempool defines the app-side mempool, used by BaseApp to keep track of the
+// txs that passed CheckTx and to select the txs of the block proposals.
+type Mempool interface {
+	// Insert attempts to insert a Tx into the app-side mempool returning
+	// an error upon failure.
+	Insert(context.Context, sdk.Tx) error
+
+	// Select returns an Iterator over the app-side mempool. If txs are specified,
+	// then they shall be incorporated into the Iterator. The mempool must not
+	// be modified while iterating, except for removing the current tx.
+	Select(context.Context, [][]byte) Iterator
+
+	// CountTx returns the number of transactions currently in the mempool.
+	CountTx() int
+
+	// Remove attempts to remove a transaction from the mempool, returning an error
+	// upon failure.
+	Remove(sdk.Tx) error
+}
+
+// Iterator defines an app-side mempool iterator interface that is as minimal as
+// possible. The order of iteration is determined by the app-side mempool
+// implementation.
+type Iterator interface {
+	// Next returns the next transaction from the mempool. If there are no more
+	// transactions, it returns nil.
+	Next() Iterator
+
+	// Tx returns the transaction at the current position of the iterator.
+	Tx() sdk.Tx
+}
+
+var (
+	// ErrTxNotFound is returned when removing a tx that is not in the mempool
+	ErrTxNotFound = errors.New("tx not found in mempool")
+
+	// ErrMempoolTxMaxCapacity is returned when inserting a tx in a full mempool
+	ErrMempoolTxMaxCapacity = errors.New("pool reached max tx capacity")
+)
+
+// nonceIndexDegree is the approximate number of items and children per B-tree
+// node of the per sender indexes.
+const nonceIndexDegree = 32
+
+// txMeta stores the data used to order a transaction in the mempool.
+type txMeta struct {
+	tx       sdk.Tx
+	sender   string
+	nonce    uint64
+	priority int64
+	// order is the insertion order of the tx, used to break priority ties
+	order uint64
+}
+
+// nonceIndex holds the txs of a single sender ordered by nonce.
+type nonceIndex = btree.BTreeG[*txMeta]
+
+func newNonceIndex() *nonceIndex {
+	return btree.NewBTreeGOptions(func(a, b *txMeta) bool {
+		return a.nonce < b.nonce
+	}, btree.Options{
+		Degree: nonceIndexDegree,
+		// Contract: the mempools must not be called concurrently
+		NoLocks: true,
+	})
+}
+
+// nextTx returns the tx of the index with the lowest nonce greater than or
+// equal to the given nonce, or nil if there is none.
+func nextTx(index *nonceIndex, nonce uint64) *txMeta {
+	var next *txMeta
+	index.Ascend(&txMeta{nonce: nonce}, func(tx *txMeta) bool {
+		next = tx
+		return false
+	})
+
+	return next
+}
+
+// getSenderNonce returns the sender of the tx, i.e. its first signer, and the
+// sequence of the first signature.
+func getSenderNonce(tx sdk.Tx) (string, uint64, error) {
+	sigTx, ok := tx.(signing.SigVerifiableTx)
+	if !ok {
+		return "", 0, fmt.Errorf("tx of type %T does not implement SigVerifiableTx", tx)
+	}
+
+	signers := sigTx.GetSigners()
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return "", 0, err
+	}
+
+	if len(signers) == 0 || len(sigs) == 0 {
+		return "", 0, errors.New("tx must have at least one signer")
+	}
+
+	return signers[0].String(), sigs[0].Sequence, nil
+}
@@ -0,0 +1,71 @@
+package mempool_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// testTx is a tx signed by a single sender
+type testTx struct {
+	id       int
+	sender   sdk.AccAddress
+	nonce    uint64
+	priority int64
+}
+
+var _ authsigning.SigVerifiableTx = testTx{}
+
+func (tx testTx) GetMsgs() []sdk.Msg                        { return nil }
+func (tx testTx) ValidateBasic() error                      { return nil }
+func (tx testTx) GetSigners() []sdk.AccAddress              { return []sdk.AccAddress{tx.sender} }
+func (tx testTx) GetPubKeys() ([]cryptotypes.PubKey, error) { return nil, nil }
+func (tx testTx) GetSignaturesV2() ([]signing.SignatureV2, error) {
+	return []signing.SignatureV2{{Sequence: tx.nonce}}, nil
+}
+
+// unsignedTx is a tx without signer
+type unsignedTx struct{}
+
+func (unsignedTx) GetMsgs() []sdk.Msg   { return nil }
+func (unsignedTx) ValidateBasic() error { return nil }
+
+func newSender(i byte) sdk.AccAddress {
+	return sdk.AccAddress([]byte{i, i, i, i, i, i, i, i, i, i, i, i, i, i, i, i, i, i, i, i})
+}
+
+func insertTxs(t *testing.T, mp mempool.Mempool, txs []testTx) {
+	t.Helper()
+
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+	}
+}
+
+func fetchTxIDs(mp mempool.Mempool) []int {
+	var ids []int
+	for iterator := mp.Select(sdk.Context{}, nil); iterator != nil; iterator = iterator.Next() {
+		ids = append(ids, iterator.Tx().(testTx).id)
+	}
+
+	return ids
+}
+
+func TestNoOpMempool(t *testing.T) {
+	mp := mempool.NoOpMempool{}
+	tx := testTx{sender: newSender(1)}
+
+	require.NoError(t, mp.Insert(sdk.Context{}, tx))
+	require.Zero(t, mp.CountTx())
+	require.Nil(t, mp.Select(sdk.Context{}, nil))
+	require.NoError(t, mp.Remove(tx))
+}
@@ -0,0 +1,197 @@
+package mempool
+
+import (
+	"container/heap"
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ Mempool = (*PriorityNonceMempool)(nil)
+
+// PriorityNonceMempool is a mempool implementation that stores txs in a
+// partially ordered set by 2 dimensions: priority, and sender-nonce (sequence
+// number). Internally it uses one B-tree per sender indexing its txs by nonce.
+// When a tx is selected, the txs with the highest priority are returned first
+// among the txs with the lowest nonce of each sender, so that the txs of a
+// sender are always returned in nonce order. Priority ties are broken by
+// insertion order.
+//
+// The priority of a tx is the priority set in the sdk.Context by the ante
+// handler during CheckTx. A tx replaces the tx of its sender with the same
+// nonce.
+//
+// Note: the mempool is not safe for concurrent use.
+type PriorityNonceMempool struct {
+	senderIndices map[string]*nonceIndex
+	count         int
+	order         uint64
+	maxTx         int
+}
+
+// PriorityNonceOption is a function that configures a PriorityNonceMempool.
+type PriorityNonceOption func(*PriorityNonceMempool)
+
+// PriorityNonceMaxTxOpt sets the maximum number of txs of the mempool. A value
+// of 0 means no limit, and a negative value disables the mempool, so that no
+// tx is ever inserted.
+func PriorityNonceMaxTxOpt(maxTx int) PriorityNonceOption {
+	return func(mp *PriorityNonceMempool) {
+		mp.maxTx = maxTx
+	}
+}
+
+// NewPriorityMempool returns a new PriorityNonceMempool.
+func NewPriorityMempool(opts ...PriorityNonceOption) *PriorityNonceMempool {
+	mp := &PriorityNonceMempool{
+		senderIndices: make(map[string]*nonceIndex),
+	}
+
+	for _, opt := range opts {
+		opt(mp)
+	}
+
+	return mp
+}
+
+// Insert attempts to insert a tx into the mempool, returning an error upon
+// failure. The sdk.Context priority is used as the tx priority.
+func (mp *PriorityNonceMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	if mp.maxTx < 0 {
+		return nil
+	}
+
+	sender, nonce, err := getSenderNonce(tx)
+	if err != nil {
+		return err
+	}
+
+	index, ok := mp.senderIndices[sender]
+	if !ok {
+		index = newNonceIndex()
+	}
+
+	key := &txMeta{nonce: nonce}
+	if _, replaced := index.Get(key); !replaced {
+		if mp.maxTx > 0 && mp.count >= mp.maxTx {
+			return ErrMempoolTxMaxCapacity
+		}
+		mp.count++
+	}
+
+	mp.order++
+	index.Set(&txMeta{
+		tx:       tx,
+		sender:   sender,
+		nonce:    nonce,
+		priority: sdk.UnwrapSDKContext(ctx).Priority(),
+		order:    mp.order,
+	})
+	mp.senderIndices[sender] = index
+
+	return nil
+}
+
+// Select returns an iterator over the txs of the mempool, ordered by priority
+// while keeping the nonce order of each sender. It returns nil if the mempool
+// is empty. The txs given as argument are ignored.
+func (mp *PriorityNonceMempool) Select(_ context.Context, _ [][]byte) Iterator {
+	iterator := &priorityNonceIterator{mp: mp}
+	for _, index := range mp.senderIndices {
+		if head, ok := index.Min(); ok {
+			iterator.heads = append(iterator.heads, head)
+		}
+	}
+	heap.Init(&iterator.heads)
+
+	return iterator.Next()
+}
+
+// CountTx returns the number of txs in the mempool.
+func (mp *PriorityNonceMempool) CountTx() int {
+	return mp.count
+}
+
+// Remove removes a tx from the mempool. It returns ErrTxNotFound if the tx is
+// not in the mempool.
+func (mp *PriorityNonceMempool) Remove(tx sdk.Tx) error {
+	sender, nonce, err := getSenderNonce(tx)
+	if err != nil {
+		return err
+	}
+
+	index, ok := mp.senderIndices[sender]
+	if !ok {
+		return ErrTxNotFound
+	}
+
+	if _, found := index.Delete(&txMeta{nonce: nonce}); !found {
+		return ErrTxNotFound
+	}
+
+	if index.Len() == 0 {
+		delete(mp.senderIndices, sender)
+	}
+	mp.count--
+
+	return nil
+}
+
+// priorityNonceIterator iterates over the txs of a PriorityNonceMempool. The
+// heads heap holds the next tx of each sender.
+type priorityNonceIterator struct {
+	mp    *PriorityNonceMempool
+	heads txHeap
+	cur   *txMeta
+}
+
+var _ Iterator = (*priorityNonceIterator)(nil)
+
+func (i *priorityNonceIterator) Next() Iterator {
+	if i.cur != nil {
+		if index, ok := i.mp.senderIndices[i.cur.sender]; ok {
+			if next := nextTx(index, i.cur.nonce+1); next != nil {
+				heap.Push(&i.heads, next)
+			}
+		}
+	}
+
+	if i.heads.Len() == 0 {
+		return nil
+	}
+
+	i.cur = heap.Pop(&i.heads).(*txMeta)
+	return i
+}
+
+func (i *priorityNonceIterator) Tx() sdk.Tx {
+	return i.cur.tx
+}
+
+// txHeap is a max-heap of txs ordered by priority, and then by insertion order.
+type txHeap []*txMeta
+
+var _ heap.Interface = (*txHeap)(nil)
+
+func (h txHeap) Len() int { return len(h) }
+
+func (h txHeap) Less(i, j int) bool {
+	if h[i].priority != h[j].priority {
+		return h[i].priority > h[j].priority
+	}
+
+	return h[i].order < h[j].order
+}
+
+func (h txHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *txHeap) Push(x interface{}) { *h = append(*h, x.(*txMeta)) }
+
+func (h *txHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return item
+}
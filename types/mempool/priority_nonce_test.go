@@ -0,0 +1,118 @@
+package mempool_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+func TestPriorityNonceMempoolOrdering(t *testing.T) {
+	sa, sb, sc := newSender(1), newSender(2), newSender(3)
+
+	testCases := map[string]struct {
+		txs   []testTx
+		order []int
+	}{
+		"priority order": {
+			txs: []testTx{
+				{id: 0, sender: sa, nonce: 0, priority: 5},
+				{id: 1, sender: sb, nonce: 0, priority: 10},
+				{id: 2, sender: sc, nonce: 0, priority: 7},
+			},
+			order: []int{1, 2, 0},
+		},
+		"nonce order within a sender": {
+			txs: []testTx{
+				{id: 0, sender: sa, nonce: 1, priority: 20},
+				{id: 1, sender: sa, nonce: 0, priority: 1},
+				{id: 2, sender: sb, nonce: 0, priority: 10},
+			},
+			order: []int{2, 1, 0},
+		},
+		"high priority txs unlocked by low priority ones": {
+			txs: []testTx{
+				{id: 0, sender: sa, nonce: 0, priority: 5},
+				{id: 1, sender: sa, nonce: 1, priority: 30},
+				{id: 2, sender: sb, nonce: 0, priority: 10},
+				{id: 3, sender: sb, nonce: 1, priority: 1},
+			},
+			order: []int{2, 0, 1, 3},
+		},
+		"ties broken by insertion order": {
+			txs: []testTx{
+				{id: 0, sender: sc, nonce: 0, priority: 5},
+				{id: 1, sender: sa, nonce: 0, priority: 5},
+				{id: 2, sender: sb, nonce: 0, priority: 5},
+			},
+			order: []int{0, 1, 2},
+		},
+		"nonce replacement": {
+			txs: []testTx{
+				{id: 0, sender: sa, nonce: 0, priority: 5},
+				{id: 1, sender: sb, nonce: 0, priority: 10},
+				{id: 2, sender: sa, nonce: 0, priority: 20},
+			},
+			order: []int{2, 1},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			mp := mempool.NewPriorityMempool()
+			insertTxs(t, mp, tc.txs)
+
+			require.Equal(t, len(tc.order), mp.CountTx())
+			require.Equal(t, tc.order, fetchTxIDs(mp))
+		})
+	}
+}
+
+func TestPriorityNonceMempoolRemove(t *testing.T) {
+	sa, sb := newSender(1), newSender(2)
+	txs := []testTx{
+		{id: 0, sender: sa, nonce: 0, priority: 5},
+		{id: 1, sender: sa, nonce: 1, priority: 5},
+		{id: 2, sender: sb, nonce: 0, priority: 10},
+	}
+
+	mp := mempool.NewPriorityMempool()
+	insertTxs(t, mp, txs)
+
+	require.NoError(t, mp.Remove(txs[2]))
+	require.ErrorIs(t, mp.Remove(txs[2]), mempool.ErrTxNotFound)
+	require.Equal(t, 2, mp.CountTx())
+	require.Equal(t, []int{0, 1}, fetchTxIDs(mp))
+
+	// removing the current tx while iterating is supported
+	var ids []int
+	for iterator := mp.Select(sdk.Context{}, nil); iterator != nil; iterator = iterator.Next() {
+		ids = append(ids, iterator.Tx().(testTx).id)
+		require.NoError(t, mp.Remove(iterator.Tx()))
+	}
+	require.Equal(t, []int{0, 1}, ids)
+	require.Zero(t, mp.CountTx())
+	require.Nil(t, mp.Select(sdk.Context{}, nil))
+}
+
+func TestPriorityNonceMempoolMaxTx(t *testing.T) {
+	sa := newSender(1)
+
+	mp := mempool.NewPriorityMempool(mempool.PriorityNonceMaxTxOpt(1))
+	insertTxs(t, mp, []testTx{{id: 0, sender: sa, nonce: 0}})
+	require.ErrorIs(t, mp.Insert(sdk.Context{}, testTx{id: 1, sender: sa, nonce: 1}), mempool.ErrMempoolTxMaxCapacity)
+
+	// replacing a tx is allowed in a full mempool
+	insertTxs(t, mp, []testTx{{id: 2, sender: sa, nonce: 0}})
+	require.Equal(t, []int{2}, fetchTxIDs(mp))
+
+	// a negative maximum disables the mempool
+	mp = mempool.NewPriorityMempool(mempool.PriorityNonceMaxTxOpt(-1))
+	insertTxs(t, mp, []testTx{{id: 0, sender: sa, nonce: 0}})
+	require.Zero(t, mp.CountTx())
+
+	require.Error(t, mempool.NewPriorityMempool().Insert(sdk.Context{}, unsignedTx{}))
+}
@@ -0,0 +1,186 @@
+package mempool
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ Mempool = (*SenderNonceMempool)(nil)
+
+// SenderNonceMempool is a mempool that prioritizes txs within a sender by
+// nonce, the lowest first, but selects a random sender on each iteration. The
+// mempool is iterated by:
+//
+// 1) Maintaining a separate list of nonce ordered txs per sender
+// 2) For each select iteration, randomly choose a sender and pick the next nonce ordered tx from their list
+// 3) Repeat 1,2 until the mempool is exhausted
+//
+// The random sender order is seeded, so that the selection is deterministic
+// for a given seed and mempool content. A tx replaces the tx of its sender with
+// the same nonce.
+//
+// Note: the mempool is not safe for concurrent use.
+type SenderNonceMempool struct {
+	senders map[string]*nonceIndex
+	rnd     *rand.Rand
+	count   int
+	maxTx   int
+}
+
+// SenderNonceOption is a function that configures a SenderNonceMempool.
+type SenderNonceOption func(*SenderNonceMempool)
+
+// SenderNonceSeedOpt sets the seed of the random sender selection.
+func SenderNonceSeedOpt(seed int64) SenderNonceOption {
+	return func(mp *SenderNonceMempool) {
+		mp.rnd = rand.New(rand.NewSource(seed))
+	}
+}
+
+// SenderNonceMaxTxOpt sets the maximum number of txs of the mempool. A value of
+// 0 means no limit, and a negative value disables the mempool, so that no tx
+// is ever inserted.
+func SenderNonceMaxTxOpt(maxTx int) SenderNonceOption {
+	return func(mp *SenderNonceMempool) {
+		mp.maxTx = maxTx
+	}
+}
+
+// NewSenderNonceMempool returns a new SenderNonceMempool. Unless a seed is set
+// with SenderNonceSeedOpt, the random sender selection is seeded with the
+// current time.
+func NewSenderNonceMempool(opts ...SenderNonceOption) *SenderNonceMempool {
+	mp := &SenderNonceMempool{
+		senders: make(map[string]*nonceIndex),
+		rnd:     rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+
+	for _, opt := range opts {
+		opt(mp)
+	}
+
+	return mp
+}
+
+// Insert adds a tx to the mempool. It returns an error if the tx doesn't have
+// a signature or if the mempool is full.
+func (mp *SenderNonceMempool) Insert(_ context.Context, tx sdk.Tx) error {
+	if mp.maxTx < 0 {
+		return nil
+	}
+
+	sender, nonce, err := getSenderNonce(tx)
+	if err != nil {
+		return err
+	}
+
+	index, ok := mp.senders[sender]
+	if !ok {
+		index = newNonceIndex()
+	}
+
+	if _, replaced := index.Get(&txMeta{nonce: nonce}); !replaced {
+		if mp.maxTx > 0 && mp.count >= mp.maxTx {
+			return ErrMempoolTxMaxCapacity
+		}
+		mp.count++
+	}
+
+	index.Set(&txMeta{tx: tx, sender: sender, nonce: nonce})
+	mp.senders[sender] = index
+
+	return nil
+}
+
+// Select returns an iterator over the txs of the mempool, picking a random
+// sender on each iteration. It returns nil if the mempool is empty. The txs
+// given as argument are ignored.
+func (mp *SenderNonceMempool) Select(_ context.Context, _ [][]byte) Iterator {
+	senders := make([]string, 0, len(mp.senders))
+	for sender := range mp.senders {
+		senders = append(senders, sender)
+	}
+	// sort the senders so that the selection only depends on the seed
+	sort.Strings(senders)
+
+	iterator := &senderNonceIterator{
+		mp:      mp,
+		senders: senders,
+		cursors: make(map[string]uint64),
+	}
+
+	return iterator.Next()
+}
+
+// CountTx returns the number of txs in the mempool.
+func (mp *SenderNonceMempool) CountTx() int {
+	return mp.count
+}
+
+// Remove removes a tx from the mempool. It returns ErrTxNotFound if the tx is
+// not in the mempool.
+func (mp *SenderNonceMempool) Remove(tx sdk.Tx) error {
+	sender, nonce, err := getSenderNonce(tx)
+	if err != nil {
+		return err
+	}
+
+	index, ok := mp.senders[sender]
+	if !ok {
+		return ErrTxNotFound
+	}
+
+	if _, found := index.Delete(&txMeta{nonce: nonce}); !found {
+		return ErrTxNotFound
+	}
+
+	if index.Len() == 0 {
+		delete(mp.senders, sender)
+	}
+	mp.count--
+
+	return nil
+}
+
+// senderNonceIterator iterates over the txs of a SenderNonceMempool. The
+// cursors hold the nonce of the next tx to return for each sender.
+type senderNonceIterator struct {
+	mp      *SenderNonceMempool
+	senders []string
+	cursors map[string]uint64
+	cur     *txMeta
+}
+
+var _ Iterator = (*senderNonceIterator)(nil)
+
+func (i *senderNonceIterator) Next() Iterator {
+	for len(i.senders) > 0 {
+		idx := i.mp.rnd.Intn(len(i.senders))
+		sender := i.senders[idx]
+
+		var next *txMeta
+		if index, ok := i.mp.senders[sender]; ok {
+			next = nextTx(index, i.cursors[sender])
+		}
+
+		if next == nil {
+			// the txs of the sender are exhausted
+			i.senders = append(i.senders[:idx], i.senders[idx+1:]...)
+			continue
+		}
+
+		i.cursors[sender] = next.nonce + 1
+		i.cur = next
+		return i
+	}
+
+	return nil
+}
+
+func (i *senderNonceIterator) Tx() sdk.Tx {
+	return i.cur.tx
+}
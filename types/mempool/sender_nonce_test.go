@@ -0,0 +1,75 @@
+package mempool_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+func TestSenderNonceMempoolOrdering(t *testing.T) {
+	senders := []sdk.AccAddress{newSender(1), newSender(2), newSender(3)}
+
+	var txs []testTx
+	for i := 0; i < 30; i++ {
+		txs = append(txs, testTx{id: i, sender: senders[i%3], nonce: uint64(10 - i/3)})
+	}
+
+	mp := mempool.NewSenderNonceMempool(mempool.SenderNonceSeedOpt(42))
+	insertTxs(t, mp, txs)
+	require.Equal(t, 30, mp.CountTx())
+
+	ids := fetchTxIDs(mp)
+	require.Len(t, ids, 30)
+
+	// the txs of each sender are returned in nonce order
+	lastNonce := make(map[string]uint64)
+	for _, id := range ids {
+		tx := txs[id]
+		if last, ok := lastNonce[tx.sender.String()]; ok {
+			require.Greater(t, tx.nonce, last)
+		}
+		lastNonce[tx.sender.String()] = tx.nonce
+	}
+
+	// the selection is deterministic for a given seed
+	other := mempool.NewSenderNonceMempool(mempool.SenderNonceSeedOpt(42))
+	insertTxs(t, other, txs)
+	require.Equal(t, ids, fetchTxIDs(other))
+}
+
+func TestSenderNonceMempoolRemove(t *testing.T) {
+	sa, sb := newSender(1), newSender(2)
+	txs := []testTx{
+		{id: 0, sender: sa, nonce: 0},
+		{id: 1, sender: sa, nonce: 1},
+		{id: 2, sender: sb, nonce: 0},
+	}
+
+	mp := mempool.NewSenderNonceMempool(mempool.SenderNonceSeedOpt(1))
+	insertTxs(t, mp, txs)
+
+	require.NoError(t, mp.Remove(txs[2]))
+	require.ErrorIs(t, mp.Remove(txs[2]), mempool.ErrTxNotFound)
+	require.Equal(t, []int{0, 1}, fetchTxIDs(mp))
+
+	for iterator := mp.Select(sdk.Context{}, nil); iterator != nil; iterator = iterator.Next() {
+		require.NoError(t, mp.Remove(iterator.Tx()))
+	}
+	require.Zero(t, mp.CountTx())
+	require.Nil(t, mp.Select(sdk.Context{}, nil))
+}
+
+func TestSenderNonceMempoolMaxTx(t *testing.T) {
+	sa := newSender(1)
+
+	mp := mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(1))
+	insertTxs(t, mp, []testTx{{id: 0, sender: sa, nonce: 0}})
+	require.ErrorIs(t, mp.Insert(sdk.Context{}, testTx{id: 1, sender: sa, nonce: 1}), mempool.ErrMempoolTxMaxCapacity)
+
+	mp = mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(-1))
+	insertTxs(t, mp, []testTx{{id: 0, sender: sa, nonce: 0}})
+	require.Zero(t, mp.CountTx())
+}
@@ -54,12 +54,16 @@ type AccountKeeperI interface {
 type AccountKeeper struct {
 	key           storetypes.StoreKey
 	cdc           codec.BinaryCodec
-	paramSubspace paramtypes.Subspace
+	paramSubspace paramtypes.Subspace // legacy params subspace, only used by the migrations
 	permAddrs     map[string]types.PermissionsForAddress
 
 	// The prototypical AccountI constructor.
 	proto      func() types.AccountI
 	addressCdc address.Codec
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
 }
 
 var _ AccountKeeperI = &AccountKeeper{}
@@ -72,8 +76,12 @@ var _ AccountKeeperI = &AccountKeeper{}
 // may use auth.Keeper to access the accounts permissions map.
 func NewAccountKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, paramstore paramtypes.Subspace, proto func() types.AccountI,
-	maccPerms map[string][]string, bech32Prefix string,
+	maccPerms map[string][]string, bech32Prefix string, authority string,
 ) AccountKeeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Errorf("invalid auth authority address: %w", err))
+	}
+
 	// set KeyTable if it has not already been set
 	if !paramstore.HasKeyTable() {
 		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
@@ -93,9 +101,15 @@ func NewAccountKeeper(
 		paramSubspace: paramstore,
 		permAddrs:     permAddrs,
 		addressCdc:    bech32Codec,
+		authority:     authority,
 	}
 }
 
+// GetAuthority returns the x/auth module's authority.
+func (ak AccountKeeper) GetAuthority() string {
+	return ak.authority
+}
+
 // Logger returns a module-specific logger.
 func (ak AccountKeeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -5,6 +5,7 @@ import (
 
 	v043 "github.com/cosmos/cosmos-sdk/x/auth/migrations/v043"
 	v046 "github.com/cosmos/cosmos-sdk/x/auth/migrations/v046"
+	v047 "github.com/cosmos/cosmos-sdk/x/auth/migrations/v047"
 
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 
@@ -50,6 +51,14 @@ func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 	return v046.MigrateStore(ctx, m.keeper.key, m.keeper.cdc)
 }
 
+// Migrate3to4 migrates the x/auth module state from the consensus version 3 to
+// version 4. Specifically, it takes the parameters that are currently stored
+// and managed by the x/params modules and stores them directly into the x/auth
+// module state.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v047.MigrateStore(ctx, m.keeper.key, m.keeper.paramSubspace, m.keeper.cdc)
+}
+
 // V45_SetAccount implements V45_SetAccount
 // set the account without map to accAddr to accNumber.
 //
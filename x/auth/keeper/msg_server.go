@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+type msgServer struct {
+	AccountKeeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/auth MsgServer interface.
+func NewMsgServerImpl(ak AccountKeeper) types.MsgServer {
+	return &msgServer{
+		AccountKeeper: ak,
+	}
+}
+
+func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if ms.authority != req.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ms.SetParams(ctx, req.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
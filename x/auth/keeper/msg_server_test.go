@@ -0,0 +1,46 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestMsgUpdateParams(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.AccountKeeper)
+
+	params := types.DefaultParams()
+	params.MaxMemoCharacters = 1000
+
+	testCases := []struct {
+		name      string
+		authority string
+		expErr    bool
+	}{
+		{"invalid authority", sdk.AccAddress("invalid_authority___").String(), true},
+		{"all good", app.AccountKeeper.GetAuthority(), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{
+				Authority: tc.authority,
+				Params:    params,
+			})
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, params, app.AccountKeeper.GetParams(ctx))
+		})
+	}
+}
@@ -7,11 +7,18 @@ import (
 
 // SetParams sets the auth module's parameters.
 func (ak AccountKeeper) SetParams(ctx sdk.Context, params types.Params) {
-	ak.paramSubspace.SetParamSet(ctx, &params)
+	store := ctx.KVStore(ak.key)
+	store.Set(types.ParamsKey, ak.cdc.MustMarshal(&params))
 }
 
 // GetParams gets the auth module's parameters.
 func (ak AccountKeeper) GetParams(ctx sdk.Context) (params types.Params) {
-	ak.paramSubspace.GetParamSet(ctx, &params)
-	return
+	store := ctx.KVStore(ak.key)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	ak.cdc.MustUnmarshal(bz, &params)
+	return params
 }
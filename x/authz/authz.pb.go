@@ -69,6 +69,53 @@ func (m *GenericAuthorization) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenericAuthorization proto.InternalMessageInfo
 
+// MsgFilterAuthorization gives the grantee permissions to execute the provided
+// method on behalf of the granter's account, as long as the executed message
+// matches the filter.
+type MsgFilterAuthorization struct {
+	// Msg, identified by it's type URL, to grant permissions to execute
+	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// filter is a predicate over the fields of the executed message, e.g.
+	// `to_address == "cosmos1..." && amount[0].denom in ["stake", "atom"]`.
+	// Fields are addressed by their proto JSON names and compared with the
+	// ==, !=, <, <=, >, >= and in operators, and comparisons are combined with
+	// the &&, || and ! operators.
+	Filter string `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (m *MsgFilterAuthorization) Reset()         { *m = MsgFilterAuthorization{} }
+func (m *MsgFilterAuthorization) String() string { return proto.CompactTextString(m) }
+func (*MsgFilterAuthorization) ProtoMessage()    {}
+func (*MsgFilterAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{1}
+}
+func (m *MsgFilterAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFilterAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFilterAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFilterAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFilterAuthorization.Merge(m, src)
+}
+func (m *MsgFilterAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFilterAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFilterAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFilterAuthorization proto.InternalMessageInfo
+
 // Grant gives permissions to execute
 // the provide method with expiration time.
 type Grant struct {
@@ -83,7 +130,7 @@ func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{1}
+	return fileDescriptor_544dc2e84b61c637, []int{2}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -125,7 +172,7 @@ func (m *GrantAuthorization) Reset()         { *m = GrantAuthorization{} }
 func (m *GrantAuthorization) String() string { return proto.CompactTextString(m) }
 func (*GrantAuthorization) ProtoMessage()    {}
 func (*GrantAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{2}
+	return fileDescriptor_544dc2e84b61c637, []int{3}
 }
 func (m *GrantAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -164,7 +211,7 @@ func (m *GrantQueueItem) Reset()         { *m = GrantQueueItem{} }
 func (m *GrantQueueItem) String() string { return proto.CompactTextString(m) }
 func (*GrantQueueItem) ProtoMessage()    {}
 func (*GrantQueueItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{3}
+	return fileDescriptor_544dc2e84b61c637, []int{4}
 }
 func (m *GrantQueueItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -195,6 +242,7 @@ var xxx_messageInfo_GrantQueueItem proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*GenericAuthorization)(nil), "cosmos.authz.v1beta1.GenericAuthorization")
+	proto.RegisterType((*MsgFilterAuthorization)(nil), "cosmos.authz.v1beta1.MsgFilterAuthorization")
 	proto.RegisterType((*Grant)(nil), "cosmos.authz.v1beta1.Grant")
 	proto.RegisterType((*GrantAuthorization)(nil), "cosmos.authz.v1beta1.GrantAuthorization")
 	proto.RegisterType((*GrantQueueItem)(nil), "cosmos.authz.v1beta1.GrantQueueItem")
@@ -203,34 +251,35 @@ func init() {
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/authz.proto", fileDescriptor_544dc2e84b61c637) }
 
 var fileDescriptor_544dc2e84b61c637 = []byte{
-	// 417 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x52, 0xbd, 0xae, 0xda, 0x30,
-	0x18, 0x8d, 0x81, 0xfe, 0x60, 0x44, 0xd5, 0x46, 0x19, 0x80, 0x21, 0xa0, 0xa8, 0x03, 0x0b, 0x89,
-	0xa0, 0x9d, 0xda, 0xa5, 0x44, 0x95, 0x50, 0x87, 0x0e, 0x4d, 0xe9, 0xd2, 0x05, 0x25, 0xe0, 0x9a,
-	0xa8, 0x38, 0x8e, 0x6c, 0xa7, 0x22, 0xbc, 0x43, 0x25, 0x1e, 0xa0, 0x8f, 0xc1, 0x43, 0xa0, 0x4e,
-	0xa8, 0x53, 0xa7, 0xfe, 0xc0, 0x8b, 0x5c, 0x61, 0x27, 0xba, 0xe4, 0x66, 0xb8, 0x57, 0xba, 0x53,
-	0xfc, 0x1d, 0x9f, 0x73, 0xfc, 0x7d, 0x27, 0x1f, 0xec, 0xcd, 0x29, 0x27, 0x94, 0x3b, 0x7e, 0x22,
-	0x96, 0x1b, 0xe7, 0xdb, 0x30, 0x40, 0xc2, 0x1f, 0xaa, 0xca, 0x8e, 0x19, 0x15, 0x54, 0x37, 0x14,
-	0xc3, 0x56, 0x58, 0xc6, 0xe8, 0xb4, 0x15, 0x3a, 0x93, 0x1c, 0x27, 0xa3, 0xc8, 0xa2, 0xd3, 0xc5,
-	0x94, 0xe2, 0x15, 0x72, 0x64, 0x15, 0x24, 0x5f, 0x1c, 0x11, 0x12, 0xc4, 0x85, 0x4f, 0xe2, 0x8c,
-	0x60, 0x60, 0x8a, 0xa9, 0x12, 0x9e, 0x4f, 0x19, 0xda, 0xbe, 0x29, 0xf3, 0xa3, 0x54, 0x5d, 0x59,
-	0xaf, 0xa1, 0x31, 0x41, 0x11, 0x62, 0xe1, 0x7c, 0x9c, 0x88, 0x25, 0x65, 0xe1, 0xc6, 0x17, 0x21,
-	0x8d, 0xf4, 0xa7, 0xb0, 0x4a, 0x38, 0x6e, 0x81, 0x1e, 0xe8, 0xd7, 0xbd, 0xf3, 0xf1, 0xd5, 0xb3,
-	0x9f, 0xbb, 0x41, 0xb3, 0x40, 0xb2, 0x7e, 0x00, 0xf8, 0x60, 0xc2, 0xfc, 0x48, 0xe8, 0xef, 0x61,
-	0xd3, 0xbf, 0xbc, 0x92, 0xc2, 0xc6, 0xc8, 0xb0, 0xd5, 0xcb, 0x76, 0xfe, 0xb2, 0x3d, 0x8e, 0x52,
-	0xb7, 0xec, 0xe4, 0x15, 0xd5, 0xfa, 0x5b, 0x08, 0xd1, 0x3a, 0x0e, 0x99, 0xf2, 0xaa, 0x48, 0xaf,
-	0x4e, 0xc9, 0x6b, 0x9a, 0x0f, 0xef, 0x3e, 0xde, 0xff, 0xe9, 0x82, 0xed, 0xdf, 0x2e, 0xf0, 0x2e,
-	0x74, 0xd6, 0xf7, 0x0a, 0xd4, 0x65, 0x7b, 0xc5, 0xd1, 0x46, 0xf0, 0x11, 0x3e, 0xa3, 0x88, 0xa9,
-	0xf1, 0xdc, 0xd6, 0xaf, 0xdd, 0x20, 0xff, 0x15, 0xe3, 0xc5, 0x82, 0x21, 0xce, 0x3f, 0x0a, 0x16,
-	0x46, 0xd8, 0xcb, 0x89, 0xd7, 0x1a, 0x24, 0xbb, 0xb9, 0x83, 0x06, 0x95, 0x33, 0xa9, 0xde, 0x2b,
-	0x93, 0x37, 0x85, 0x4c, 0x6a, 0xb7, 0x66, 0x52, 0x2b, 0xe5, 0xf1, 0x12, 0x3e, 0x91, 0x71, 0x7c,
-	0x48, 0x50, 0x82, 0xde, 0x09, 0x44, 0x74, 0x0b, 0x36, 0x09, 0xc7, 0x33, 0x91, 0xc6, 0x68, 0x96,
-	0xb0, 0x15, 0x6f, 0x81, 0x5e, 0xb5, 0x5f, 0xf7, 0x1a, 0x84, 0xe3, 0x69, 0x1a, 0xa3, 0x4f, 0x6c,
-	0xc5, 0x5d, 0x77, 0xff, 0xdf, 0xd4, 0xf6, 0x47, 0x13, 0x1c, 0x8e, 0x26, 0xf8, 0x77, 0x34, 0xc1,
-	0xf6, 0x64, 0x6a, 0x87, 0x93, 0xa9, 0xfd, 0x3e, 0x99, 0xda, 0xe7, 0xe7, 0x38, 0x14, 0xcb, 0x24,
-	0xb0, 0xe7, 0x94, 0x64, 0xab, 0x9a, 0x7d, 0x06, 0x7c, 0xf1, 0xd5, 0x59, 0xab, 0x75, 0x0f, 0x1e,
-	0xca, 0xfe, 0x5e, 0x5c, 0x05, 0x00, 0x00, 0xff, 0xff, 0xd3, 0xb3, 0xf3, 0x46, 0x13, 0x03, 0x00,
-	0x00,
+	// 441 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x18, 0xcc, 0x26, 0xa5, 0xd0, 0xad, 0x82, 0xc0, 0x8a, 0x2a, 0x37, 0x07, 0x27, 0xb2, 0x38, 0xf4,
+	0x12, 0x5b, 0x2d, 0x9c, 0xe0, 0x42, 0x2c, 0x44, 0xc5, 0xa1, 0x07, 0x4c, 0x7b, 0xe1, 0x12, 0xd9,
+	0xc9, 0xd7, 0xcd, 0x0a, 0xaf, 0xd7, 0xda, 0x5d, 0xa3, 0xba, 0xef, 0x80, 0xd4, 0x07, 0xe0, 0x31,
+	0xfa, 0x10, 0x11, 0xa7, 0x8a, 0x13, 0x27, 0x7e, 0x92, 0x17, 0x41, 0xd9, 0xb5, 0x45, 0x8d, 0x91,
+	0x82, 0xd4, 0x93, 0xf7, 0x9b, 0x9d, 0x19, 0xed, 0x8c, 0xf5, 0xe1, 0xe1, 0x94, 0x4b, 0xc6, 0xa5,
+	0x1f, 0xe5, 0x6a, 0x7e, 0xe9, 0x7f, 0x3c, 0x8c, 0x41, 0x45, 0x87, 0x66, 0xf2, 0x32, 0xc1, 0x15,
+	0xb7, 0x7a, 0x86, 0xe1, 0x19, 0xac, 0x64, 0xf4, 0xf7, 0x0d, 0x3a, 0xd1, 0x1c, 0xbf, 0xa4, 0xe8,
+	0xa1, 0x3f, 0x20, 0x9c, 0x93, 0x04, 0x7c, 0x3d, 0xc5, 0xf9, 0xb9, 0xaf, 0x28, 0x03, 0xa9, 0x22,
+	0x96, 0x95, 0x84, 0x1e, 0xe1, 0x84, 0x1b, 0xe1, 0xfa, 0x54, 0xa2, 0xfb, 0x7f, 0xcb, 0xa2, 0xb4,
+	0x30, 0x57, 0xee, 0x0b, 0xdc, 0x3b, 0x86, 0x14, 0x04, 0x9d, 0x8e, 0x73, 0x35, 0xe7, 0x82, 0x5e,
+	0x46, 0x8a, 0xf2, 0xd4, 0x7a, 0x84, 0x3b, 0x4c, 0x12, 0x1b, 0x0d, 0xd1, 0xc1, 0x4e, 0xb8, 0x3e,
+	0x3e, 0x7f, 0xfc, 0xe5, 0x7a, 0xd4, 0xad, 0x91, 0xdc, 0x33, 0xbc, 0x77, 0x22, 0xc9, 0x6b, 0x9a,
+	0x28, 0x10, 0x1b, 0xe4, 0xd6, 0x1e, 0xde, 0x3e, 0xd7, 0x44, 0xbb, 0xad, 0xc1, 0x72, 0xfa, 0x97,
+	0xed, 0x67, 0x84, 0xef, 0x1d, 0x8b, 0x28, 0x55, 0xd6, 0x09, 0xee, 0x46, 0xb7, 0xaf, 0xb4, 0xe1,
+	0xee, 0x51, 0xcf, 0x33, 0x81, 0xbc, 0x2a, 0x90, 0x37, 0x4e, 0x8b, 0xa0, 0xe9, 0x14, 0xd6, 0xd5,
+	0xd6, 0x2b, 0x8c, 0xe1, 0x22, 0xa3, 0xc2, 0x78, 0xb5, 0xb5, 0x57, 0xbf, 0xe1, 0x75, 0x5a, 0x75,
+	0x1a, 0x3c, 0x58, 0x7c, 0x1f, 0xa0, 0xab, 0x1f, 0x03, 0x14, 0xde, 0xd2, 0xb9, 0x9f, 0xda, 0xd8,
+	0xd2, 0xcf, 0xab, 0x47, 0x3e, 0xc2, 0xf7, 0xc9, 0x1a, 0x05, 0x61, 0x62, 0x07, 0xf6, 0xd7, 0xeb,
+	0x51, 0xf5, 0x87, 0xc7, 0xb3, 0x99, 0x00, 0x29, 0xdf, 0x29, 0x41, 0x53, 0x12, 0x56, 0xc4, 0x3f,
+	0x1a, 0xb0, 0xdb, 0xff, 0xa7, 0x81, 0x66, 0x27, 0x9d, 0x3b, 0x75, 0xf2, 0xb2, 0xd6, 0xc9, 0xd6,
+	0xc6, 0x4e, 0xb6, 0x1a, 0x7d, 0x3c, 0xc3, 0x0f, 0x75, 0x1d, 0x6f, 0x73, 0xc8, 0xe1, 0x8d, 0x02,
+	0x66, 0xb9, 0xb8, 0xcb, 0x24, 0x99, 0xa8, 0x22, 0x83, 0x49, 0x2e, 0x12, 0x69, 0xa3, 0x61, 0xe7,
+	0x60, 0x27, 0xdc, 0x65, 0x92, 0x9c, 0x16, 0x19, 0x9c, 0x89, 0x44, 0x06, 0xc1, 0xe2, 0x97, 0xd3,
+	0x5a, 0x2c, 0x1d, 0x74, 0xb3, 0x74, 0xd0, 0xcf, 0xa5, 0x83, 0xae, 0x56, 0x4e, 0xeb, 0x66, 0xe5,
+	0xb4, 0xbe, 0xad, 0x9c, 0xd6, 0xfb, 0x27, 0x84, 0xaa, 0x79, 0x1e, 0x7b, 0x53, 0xce, 0xca, 0x0d,
+	0x28, 0x3f, 0x23, 0x39, 0xfb, 0xe0, 0x5f, 0x98, 0x2d, 0x8a, 0xb7, 0xf5, 0xfb, 0x9e, 0xfe, 0x1e,
+	0x00, 0x76, 0x1e, 0x55, 0x5b, 0x6a, 0x03, 0x00, 0x00,
 }
 
 func (m *GenericAuthorization) Marshal() (dAtA []byte, err error) {
@@ -263,6 +312,43 @@ func (m *GenericAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgFilterAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFilterAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFilterAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Filter) > 0 {
+		i -= len(m.Filter)
+		copy(dAtA[i:], m.Filter)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Filter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Grant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -423,6 +509,23 @@ func (m *GenericAuthorization) Size() (n int) {
 	return n
 }
 
+func (m *MsgFilterAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	l = len(m.Filter)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
 func (m *Grant) Size() (n int) {
 	if m == nil {
 		return 0
@@ -568,6 +671,120 @@ func (m *GenericAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgFilterAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFilterAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFilterAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Grant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
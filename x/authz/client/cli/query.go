@@ -29,6 +29,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryGrants(),
 		GetQueryGranterGrants(),
 		GetQueryGranteeGrants(),
+		GetQueryGrantsByMsgType(),
 	)
 
 	return authorizationQueryCmd
@@ -192,3 +193,47 @@ $ %s q %s grants-by-grantee cosmos1skj..
 	flags.AddPaginationFlagsToCmd(cmd, "grantee-grants")
 	return cmd
 }
+
+// GetQueryGrantsByMsgType returns cmd to query for all grants of a msg type.
+func GetQueryGrantsByMsgType() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grants-by-msg-type [msg-type-url]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query authorization grants of a msg type",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query authorization grants of a msg type.
+Examples:
+$ %s q %s grants-by-msg-type %s
+`,
+				version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL()),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := authz.NewQueryClient(clientCtx)
+			res, err := queryClient.GrantsByMsgType(
+				cmd.Context(),
+				&authz.QueryGrantsByMsgTypeRequest{
+					MsgTypeUrl: args[0],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "msg-type-grants")
+	return cmd
+}
@@ -16,6 +16,8 @@ import (
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govutils "github.com/cosmos/cosmos-sdk/x/gov/client/utils"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -26,6 +28,11 @@ const (
 	FlagExpiration        = "expiration"
 	FlagAllowedValidators = "allowed-validators"
 	FlagDenyValidators    = "deny-validators"
+	FlagAllowList         = "allow-list"
+	FlagProposalIDs       = "proposal-ids"
+	FlagVoteOptions       = "vote-options"
+	FlagWeightedVote      = "weighted"
+	FlagMsgFilter         = "filter"
 	delegate              = "delegate"
 	redelegate            = "redelegate"
 	unbond                = "unbond"
@@ -45,6 +52,8 @@ func GetTxCmd() *cobra.Command {
 	AuthorizationTxCmd.AddCommand(
 		NewCmdGrantAuthorization(),
 		NewCmdRevokeAuthorization(),
+		NewCmdRevokeAllAuthorizations(),
+		NewCmdRenounceAuthorization(),
 		NewCmdExecAuthorization(),
 	)
 
@@ -54,15 +63,20 @@ func GetTxCmd() *cobra.Command {
 // NewCmdGrantAuthorization returns a CLI command handler for creating a MsgGrant transaction.
 func NewCmdGrantAuthorization() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "grant <grantee> <authorization_type=\"send\"|\"generic\"|\"delegate\"|\"unbond\"|\"redelegate\"> --from <granter>",
+		Use:   "grant <grantee> <authorization_type=\"send\"|\"generic\"|\"vote\"|\"filter\"|\"delegate\"|\"unbond\"|\"redelegate\"> --from <granter>",
 		Short: "Grant authorization to an address",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`create a new grant authorization to an address to execute a transaction on your behalf:
 
 Examples:
  $ %s tx %s grant cosmos1skjw.. send %s --spend-limit=1000stake --from=cosmos1skl..
+ $ %s tx %s grant cosmos1skjw.. send --spend-limit=1000stake --allow-list=cosmos1wer..,cosmos1tyu.. --from=cosmos1skl..
  $ %s tx %s grant cosmos1skjw.. generic --msg-type=/cosmos.gov.v1.MsgVote --from=cosmos1sk..
-	`, version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL(), version.AppName, authz.ModuleName),
+ $ %s tx %s grant cosmos1skjw.. vote --proposal-ids=1,2 --vote-options=yes,abstain --from=cosmos1sk..
+ $ %s tx %s grant cosmos1skjw.. vote --weighted --vote-options=yes,abstain --from=cosmos1sk..
+ $ %s tx %s grant cosmos1skjw.. filter --msg-type=/cosmos.bank.v1beta1.MsgSend --filter='amount[0].denom == "stake" && amount[0].amount <= 1000' --from=cosmos1sk..
+	`, version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL(), version.AppName, authz.ModuleName, version.AppName, authz.ModuleName,
+				version.AppName, authz.ModuleName, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName),
 		),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -93,7 +107,17 @@ Examples:
 					return fmt.Errorf("spend-limit should be greater than zero")
 				}
 
-				authorization = bank.NewSendAuthorization(spendLimit)
+				allowList, err := cmd.Flags().GetStringSlice(FlagAllowList)
+				if err != nil {
+					return err
+				}
+
+				allowed, err := bech32toAccAddresses(allowList)
+				if err != nil {
+					return err
+				}
+
+				authorization = bank.NewSendAuthorization(spendLimit, allowed)
 			case "generic":
 				msgType, err := cmd.Flags().GetString(FlagMsgType)
 				if err != nil {
@@ -101,6 +125,52 @@ Examples:
 				}
 
 				authorization = authz.NewGenericAuthorization(msgType)
+			case "vote":
+				proposalIDs, err := cmd.Flags().GetUintSlice(FlagProposalIDs)
+				if err != nil {
+					return err
+				}
+
+				voteOptions, err := cmd.Flags().GetStringSlice(FlagVoteOptions)
+				if err != nil {
+					return err
+				}
+
+				ids := make([]uint64, len(proposalIDs))
+				for i, id := range proposalIDs {
+					ids[i] = uint64(id)
+				}
+
+				options := make([]govv1.VoteOption, len(voteOptions))
+				for i, option := range voteOptions {
+					options[i], err = govv1.VoteOptionFromString(govutils.NormalizeVoteOption(option))
+					if err != nil {
+						return err
+					}
+				}
+
+				weighted, err := cmd.Flags().GetBool(FlagWeightedVote)
+				if err != nil {
+					return err
+				}
+
+				if weighted {
+					authorization = govv1.NewWeightedVoteAuthorization(ids, options)
+				} else {
+					authorization = govv1.NewVoteAuthorization(ids, options)
+				}
+			case "filter":
+				msgType, err := cmd.Flags().GetString(FlagMsgType)
+				if err != nil {
+					return err
+				}
+
+				filter, err := cmd.Flags().GetString(FlagMsgFilter)
+				if err != nil {
+					return err
+				}
+
+				authorization = authz.NewMsgFilterAuthorization(msgType, filter)
 			case delegate, unbond, redelegate:
 				limit, err := cmd.Flags().GetString(FlagSpendLimit)
 				if err != nil {
@@ -180,8 +250,13 @@ Examples:
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
-	cmd.Flags().String(FlagMsgType, "", "The Msg method name for which we are creating a GenericAuthorization")
+	cmd.Flags().String(FlagMsgType, "", "The Msg method name for which we are creating a GenericAuthorization or a MsgFilterAuthorization")
 	cmd.Flags().String(FlagSpendLimit, "", "SpendLimit for Send Authorization, an array of Coins allowed spend")
+	cmd.Flags().StringSlice(FlagAllowList, []string{}, "Allowed addresses grantee is allowed to send funds separated by ,")
+	cmd.Flags().UintSlice(FlagProposalIDs, []uint{}, "Proposals the grantee is allowed to vote on separated by ,")
+	cmd.Flags().StringSlice(FlagVoteOptions, []string{}, "Vote options the grantee is allowed to vote with separated by ,")
+	cmd.Flags().Bool(FlagWeightedVote, false, "Authorize weighted votes (MsgVoteWeighted) instead of votes (MsgVote)")
+	cmd.Flags().String(FlagMsgFilter, "", "Filter the Msg must match for MsgFilterAuthorization")
 	cmd.Flags().StringSlice(FlagAllowedValidators, []string{}, "Allowed validators addresses separated by ,")
 	cmd.Flags().StringSlice(FlagDenyValidators, []string{}, "Deny validators addresses separated by ,")
 	cmd.Flags().Int64(FlagExpiration, 0, "Expire time as Unix timestamp. Set zero (0) for no expiry. Default is 0.")
@@ -234,6 +309,73 @@ Example:
 	return cmd
 }
 
+// NewCmdRevokeAllAuthorizations returns a CLI command handler for creating a MsgRevokeAll transaction.
+func NewCmdRevokeAllAuthorizations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-all --from=[granter]",
+		Short: "revoke all the authorizations of a granter",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`revoke all the authorizations granted by a granter:
+Example:
+ $ %s tx %s revoke-all --from=cosmos1skj..
+			`, version.AppName, authz.ModuleName),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := authz.NewMsgRevokeAll(clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdRenounceAuthorization returns a CLI command handler for creating a MsgRenounce transaction.
+func NewCmdRenounceAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "renounce [granter] [msg-type-url]? --from=[grantee]",
+		Short: "renounce authorization",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`renounce, as a grantee, the authorization granted by a granter. If msg-type-url
+is not set, all the authorizations granted by the granter are renounced:
+Example:
+ $ %s tx %s renounce cosmos1skj.. %s --from=cosmos1skj..
+ $ %s tx %s renounce cosmos1skj.. --from=cosmos1skj..
+			`, version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL(), version.AppName, authz.ModuleName),
+		),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msgTypeURL := ""
+			if len(args) > 1 {
+				msgTypeURL = args[1]
+			}
+
+			grantee := clientCtx.GetFromAddress()
+			msg := authz.NewMsgRenounce(grantee, granter, msgTypeURL)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewCmdExecAuthorization returns a CLI command handler for creating a MsgExec transaction.
 func NewCmdExecAuthorization() *cobra.Command {
 	cmd := &cobra.Command{
@@ -273,6 +415,19 @@ Example:
 	return cmd
 }
 
+// bech32toAccAddresses returns []AccAddress from a list of Bech32 string addresses.
+func bech32toAccAddresses(accAddrs []string) ([]sdk.AccAddress, error) {
+	addrs := make([]sdk.AccAddress, len(accAddrs))
+	for i, addr := range accAddrs {
+		accAddr, err := sdk.AccAddressFromBech32(addr)
+		if err != nil {
+			return nil, err
+		}
+		addrs[i] = accAddr
+	}
+	return addrs, nil
+}
+
 func bech32toValidatorAddresses(validators []string) ([]sdk.ValAddress, error) {
 	vals := make([]sdk.ValAddress, len(validators))
 	for i, validator := range validators {
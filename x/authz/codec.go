@@ -15,9 +15,12 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgGrant{}, "cosmos-sdk/MsgGrant")
 	legacy.RegisterAminoMsg(cdc, &MsgRevoke{}, "cosmos-sdk/MsgRevoke")
 	legacy.RegisterAminoMsg(cdc, &MsgExec{}, "cosmos-sdk/MsgExec")
+	legacy.RegisterAminoMsg(cdc, &MsgRevokeAll{}, "cosmos-sdk/MsgRevokeAll")
+	legacy.RegisterAminoMsg(cdc, &MsgRenounce{}, "cosmos-sdk/MsgRenounce")
 
 	cdc.RegisterInterface((*Authorization)(nil), nil)
 	cdc.RegisterConcrete(&GenericAuthorization{}, "cosmos-sdk/GenericAuthorization", nil)
+	cdc.RegisterConcrete(&MsgFilterAuthorization{}, "cosmos-sdk/MsgFilterAuthorization", nil)
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry
@@ -26,12 +29,15 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgGrant{},
 		&MsgRevoke{},
 		&MsgExec{},
+		&MsgRevokeAll{},
+		&MsgRenounce{},
 	)
 
 	registry.RegisterInterface(
 		"cosmos.v1beta1.Authorization",
 		(*Authorization)(nil),
 		&GenericAuthorization{},
+		&MsgFilterAuthorization{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, MsgServiceDesc())
@@ -22,4 +22,6 @@ var (
 	ErrAuthorizationNumOfSigners = sdkerrors.Register(ModuleName, 9, "authorization can be given to msg with only one signer")
 	// ErrNegativeMaxTokens error if the max tokens is negative
 	ErrNegativeMaxTokens = sdkerrors.Register(ModuleName, 12, "max tokens should be positive")
+	// ErrInvalidMsgFilter error if a msg filter is invalid or can't be evaluated
+	ErrInvalidMsgFilter = sdkerrors.Register(ModuleName, 13, "invalid msg filter")
 )
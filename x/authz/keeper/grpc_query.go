@@ -182,3 +182,51 @@ func (k Keeper) GranteeGrants(c context.Context, req *authz.QueryGranteeGrantsRe
 		Pagination: pageRes,
 	}, nil
 }
+
+// GrantsByMsgType implements the Query/GrantsByMsgType gRPC method.
+func (k Keeper) GrantsByMsgType(c context.Context, req *authz.QueryGrantsByMsgTypeRequest) (*authz.QueryGrantsByMsgTypeResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.MsgTypeUrl == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "empty msg type url")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), GrantKey)
+
+	authorizations, pageRes, err := query.GenericFilteredPaginate(k.cdc, store, req.Pagination, func(key []byte, auth *authz.Grant) (*authz.GrantAuthorization, error) {
+		granter, grantee, msgType := parseGrantStoreKey(append(GrantKey, key...))
+		if msgType != req.MsgTypeUrl {
+			return nil, nil
+		}
+
+		auth1, err := auth.GetAuthorization()
+		if err != nil {
+			return nil, err
+		}
+
+		authorizationAny, err := codectypes.NewAnyWithValue(auth1)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+
+		return &authz.GrantAuthorization{
+			Authorization: authorizationAny,
+			Expiration:    auth.Expiration,
+			Granter:       granter.String(),
+			Grantee:       grantee.String(),
+		}, nil
+	}, func() *authz.Grant {
+		return &authz.Grant{}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &authz.QueryGrantsByMsgTypeResponse{
+		Grants:     authorizations,
+		Pagination: pageRes,
+	}, nil
+}
@@ -302,6 +302,78 @@ func (suite *TestSuite) TestGRPCQueryGranteeGrants() {
 	}
 }
 
+func (suite *TestSuite) TestGRPCQueryGrantsByMsgType() {
+	require := suite.Require()
+	queryClient, addrs := suite.queryClient, suite.addrs
+
+	testCases := []struct {
+		msg      string
+		preRun   func()
+		expError bool
+		request  authz.QueryGrantsByMsgTypeRequest
+		numItems int
+	}{
+		{
+			"fail empty msg type url",
+			func() {},
+			true,
+			authz.QueryGrantsByMsgTypeRequest{},
+			0,
+		},
+		{
+			"valid case, no authorization found",
+			func() {},
+			false,
+			authz.QueryGrantsByMsgTypeRequest{
+				MsgTypeUrl: bankSendAuthMsgType,
+			},
+			0,
+		},
+		{
+			"valid case, multiple authorizations",
+			func() {
+				suite.createSendAuthorization(addrs[0], addrs[1])
+				suite.createSendAuthorization(addrs[2], addrs[3])
+				err := suite.app.AuthzKeeper.SaveGrant(suite.ctx, addrs[0], addrs[1], authz.NewGenericAuthorization("/cosmos.gov.v1.MsgVote"), nil)
+				require.NoError(err)
+			},
+			false,
+			authz.QueryGrantsByMsgTypeRequest{
+				MsgTypeUrl: bankSendAuthMsgType,
+			},
+			2,
+		},
+		{
+			"valid case, pagination",
+			func() {},
+			false,
+			authz.QueryGrantsByMsgTypeRequest{
+				MsgTypeUrl: bankSendAuthMsgType,
+				Pagination: &query.PageRequest{
+					Limit: 1,
+				},
+			},
+			1,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			tc.preRun()
+			result, err := queryClient.GrantsByMsgType(gocontext.Background(), &tc.request)
+			if tc.expError {
+				require.Error(err)
+			} else {
+				require.NoError(err)
+				require.Len(result.Grants, tc.numItems)
+				for _, grant := range result.Grants {
+					require.Equal("/cosmos.bank.v1beta1.SendAuthorization", grant.Authorization.TypeUrl)
+				}
+			}
+		})
+	}
+}
+
 func (suite *TestSuite) createSendAuthorization(a1, a2 sdk.AccAddress) authz.Authorization {
 	exp := suite.ctx.BlockHeader().Time.Add(time.Hour)
 	newCoins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
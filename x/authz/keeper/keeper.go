@@ -226,6 +226,38 @@ func (k Keeper) DeleteGrant(ctx sdk.Context, grantee sdk.AccAddress, granter sdk
 	})
 }
 
+// DeleteAllGrants revokes all the authorizations granted by the granter, or
+// only the ones granted to the grantee if it isn't nil.
+func (k Keeper) DeleteAllGrants(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, grantStoreKey(grantee, granter, ""))
+	defer iter.Close()
+
+	type grantKey struct {
+		grantee sdk.AccAddress
+		msgType string
+	}
+	var keys []grantKey
+	for ; iter.Valid(); iter.Next() {
+		// copy the key as parseGrantStoreKey doesn't copy the address and msg
+		// type bytes
+		_, granteeAddr, msgType := parseGrantStoreKey(append([]byte{}, iter.Key()...))
+		keys = append(keys, grantKey{granteeAddr, msgType})
+	}
+
+	if len(keys) == 0 {
+		return sdkerrors.Wrapf(authz.ErrNoAuthorizationFound, "no grants found for granter %s", granter)
+	}
+
+	for _, key := range keys {
+		if err := k.DeleteGrant(ctx, key.grantee, granter, key.msgType); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // GetAuthorizations Returns list of `Authorizations` granted to the grantee by the granter.
 func (k Keeper) GetAuthorizations(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress) ([]authz.Authorization, error) {
 	store := ctx.KVStore(k.storeKey)
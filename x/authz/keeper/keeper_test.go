@@ -102,6 +102,60 @@ func (s *TestSuite) TestKeeper() {
 	s.Require().Error(err)
 }
 
+func (s *TestSuite) TestRevokeAllAndRenounce() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	require := s.Require()
+	msgServer := app.AuthzKeeper
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	granterAddr := addrs[0]
+	granteeAddr := addrs[1]
+	grantee2Addr := addrs[2]
+	granter2Addr := addrs[3]
+	genericMsgType := "/cosmos.gov.v1.MsgVote"
+	e := ctx.BlockTime().AddDate(1, 0, 0)
+
+	require.NoError(app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, banktypes.NewSendAuthorization(coins100, nil), &e))
+	require.NoError(app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, authz.NewGenericAuthorization(genericMsgType), nil))
+	require.NoError(app.AuthzKeeper.SaveGrant(ctx, grantee2Addr, granterAddr, banktypes.NewSendAuthorization(coins100, nil), &e))
+	require.NoError(app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granter2Addr, banktypes.NewSendAuthorization(coins100, nil), &e))
+	require.NoError(app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granter2Addr, authz.NewGenericAuthorization(genericMsgType), nil))
+
+	s.T().Log("verify the grantee can renounce a single grant")
+	renounce := authz.NewMsgRenounce(granteeAddr, granter2Addr, bankSendAuthMsgType)
+	_, err := msgServer.Renounce(goCtx, &renounce)
+	require.NoError(err)
+	authorizations, err := app.AuthzKeeper.GetAuthorizations(ctx, granteeAddr, granter2Addr)
+	require.NoError(err)
+	require.Len(authorizations, 1)
+
+	s.T().Log("verify the grantee can renounce all the grants of a granter")
+	renounce = authz.NewMsgRenounce(granteeAddr, granter2Addr, "")
+	_, err = msgServer.Renounce(goCtx, &renounce)
+	require.NoError(err)
+	authorizations, err = app.AuthzKeeper.GetAuthorizations(ctx, granteeAddr, granter2Addr)
+	require.NoError(err)
+	require.Len(authorizations, 0)
+	_, err = msgServer.Renounce(goCtx, &renounce)
+	require.ErrorIs(err, authz.ErrNoAuthorizationFound)
+
+	s.T().Log("verify the granter can revoke all its grants")
+	revokeAll := authz.NewMsgRevokeAll(granterAddr)
+	_, err = msgServer.RevokeAll(goCtx, &revokeAll)
+	require.NoError(err)
+	for _, grantee := range []sdk.AccAddress{granteeAddr, grantee2Addr} {
+		authorizations, err = app.AuthzKeeper.GetAuthorizations(ctx, grantee, granterAddr)
+		require.NoError(err)
+		require.Len(authorizations, 0)
+	}
+
+	// the revoked grants are removed from the grant queue
+	require.NoError(app.AuthzKeeper.DequeueAndDeleteExpiredGrants(ctx.WithBlockTime(e.AddDate(0, 0, 1))))
+
+	_, err = msgServer.RevokeAll(goCtx, &revokeAll)
+	require.ErrorIs(err, authz.ErrNoAuthorizationFound)
+}
+
 func (s *TestSuite) TestKeeperIter() {
 	app, ctx, addrs := s.app, s.ctx, s.addrs
 
@@ -110,8 +164,8 @@ func (s *TestSuite) TestKeeperIter() {
 	granter2Addr := addrs[2]
 	e := ctx.BlockTime().AddDate(1, 0, 0)
 
-	s.app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, banktypes.NewSendAuthorization(coins100), &e)
-	s.app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granter2Addr, banktypes.NewSendAuthorization(coins100), &e)
+	s.app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, banktypes.NewSendAuthorization(coins100, nil), &e)
+	s.app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granter2Addr, banktypes.NewSendAuthorization(coins100, nil), &e)
 
 	app.AuthzKeeper.IterateGrants(ctx, func(granter, grantee sdk.AccAddress, grant authz.Grant) bool {
 		s.Require().Equal(granteeAddr, grantee)
@@ -128,7 +182,7 @@ func (s *TestSuite) TestDispatchAction() {
 	granterAddr := addrs[0]
 	granteeAddr := addrs[1]
 	recipientAddr := addrs[2]
-	a := banktypes.NewSendAuthorization(coins100)
+	a := banktypes.NewSendAuthorization(coins100, nil)
 
 	require.NoError(testutil.FundAccount(app.BankKeeper, s.ctx, granterAddr, coins1000))
 
@@ -374,7 +428,7 @@ func (s *TestSuite) TestGetAuthorization() {
 
 	genAuthMulti := authz.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgMultiSend{}))
 	genAuthSend := authz.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgSend{}))
-	sendAuth := banktypes.NewSendAuthorization(coins10)
+	sendAuth := banktypes.NewSendAuthorization(coins10, nil)
 
 	start := s.ctx.BlockHeader().Time
 	expired := start.Add(time.Duration(1) * time.Second)
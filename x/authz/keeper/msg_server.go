@@ -68,6 +68,46 @@ func (k Keeper) Revoke(goCtx context.Context, msg *authz.MsgRevoke) (*authz.MsgR
 	return &authz.MsgRevokeResponse{}, nil
 }
 
+// RevokeAll implements the MsgServer.RevokeAll method.
+func (k Keeper) RevokeAll(goCtx context.Context, msg *authz.MsgRevokeAll) (*authz.MsgRevokeAllResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.DeleteAllGrants(ctx, nil, granter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authz.MsgRevokeAllResponse{}, nil
+}
+
+// Renounce implements the MsgServer.Renounce method.
+func (k Keeper) Renounce(goCtx context.Context, msg *authz.MsgRenounce) (*authz.MsgRenounceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.MsgTypeUrl == "" {
+		err = k.DeleteAllGrants(ctx, grantee, granter)
+	} else {
+		err = k.DeleteGrant(ctx, grantee, granter, msg.MsgTypeUrl)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &authz.MsgRenounceResponse{}, nil
+}
+
 // Exec implements the MsgServer.Exec method.
 func (k Keeper) Exec(goCtx context.Context, msg *authz.MsgExec) (*authz.MsgExecResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -44,7 +44,7 @@ func TestMigration(t *testing.T) {
 			grantee1,
 			sendMsgType,
 			func() authz.Grant {
-				any, err := codectypes.NewAnyWithValue(banktypes.NewSendAuthorization(coins100))
+				any, err := codectypes.NewAnyWithValue(banktypes.NewSendAuthorization(coins100, nil))
 				require.NoError(t, err)
 				return authz.Grant{
 					Authorization: any,
@@ -57,7 +57,7 @@ func TestMigration(t *testing.T) {
 			grantee2,
 			sendMsgType,
 			func() authz.Grant {
-				any, err := codectypes.NewAnyWithValue(banktypes.NewSendAuthorization(coins100))
+				any, err := codectypes.NewAnyWithValue(banktypes.NewSendAuthorization(coins100, nil))
 				require.NoError(t, err)
 				return authz.Grant{
 					Authorization: any,
@@ -0,0 +1,510 @@
+package authz
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxMsgFilterLength is the maximum length of a MsgFilterAuthorization filter.
+const MaxMsgFilterLength = 1024
+
+// MaxMsgFilterNumberLength is the maximum length of a number compared by a
+// MsgFilterAuthorization filter.
+const MaxMsgFilterNumberLength = 128
+
+// msgFilter is a node of a parsed MsgFilterAuthorization filter, evaluated
+// against the proto JSON fields of a message. The comparisons consume gas
+// according to the size of their operands.
+type msgFilter interface {
+	eval(gasMeter sdk.GasMeter, fields interface{}) (bool, error)
+}
+
+type andFilter struct{ left, right msgFilter }
+
+func (f andFilter) eval(gasMeter sdk.GasMeter, fields interface{}) (bool, error) {
+	ok, err := f.left.eval(gasMeter, fields)
+	if err != nil || !ok {
+		return false, err
+	}
+	return f.right.eval(gasMeter, fields)
+}
+
+type orFilter struct{ left, right msgFilter }
+
+func (f orFilter) eval(gasMeter sdk.GasMeter, fields interface{}) (bool, error) {
+	ok, err := f.left.eval(gasMeter, fields)
+	if err != nil || ok {
+		return ok, err
+	}
+	return f.right.eval(gasMeter, fields)
+}
+
+type notFilter struct{ filter msgFilter }
+
+func (f notFilter) eval(gasMeter sdk.GasMeter, fields interface{}) (bool, error) {
+	ok, err := f.filter.eval(gasMeter, fields)
+	return !ok, err
+}
+
+// filterValue is a literal of a filter. Numbers are kept as strings so that
+// they are compared with arbitrary precision.
+type filterValue struct {
+	str    string
+	number bool
+	list   []filterValue
+}
+
+// comparisonFilter compares the value of a message field with a literal.
+type comparisonFilter struct {
+	path  []interface{} // field names (string) and list indexes (int)
+	op    string
+	value filterValue
+}
+
+func (f comparisonFilter) eval(gasMeter sdk.GasMeter, fields interface{}) (bool, error) {
+	field := fields
+	for _, elem := range f.path {
+		switch elem := elem.(type) {
+		case string:
+			m, ok := field.(map[string]interface{})
+			if !ok {
+				return false, fmt.Errorf("cannot get field %s of a non-object value", elem)
+			}
+			if field, ok = m[elem]; !ok {
+				return false, fmt.Errorf("unknown field %s", elem)
+			}
+		case int:
+			l, ok := field.([]interface{})
+			if !ok {
+				return false, fmt.Errorf("cannot index a non-list value")
+			}
+			if elem >= len(l) {
+				return false, fmt.Errorf("index %d out of range", elem)
+			}
+			field = l[elem]
+		}
+	}
+
+	var str string
+	switch field := field.(type) {
+	case string:
+		str = field
+	case json.Number:
+		str = field.String()
+	case bool:
+		str = strconv.FormatBool(field)
+	case nil:
+		str = ""
+	default:
+		return false, fmt.Errorf("cannot compare a non-scalar field")
+	}
+
+	switch f.op {
+	case "==":
+		return f.value.equals(gasMeter, str)
+	case "!=":
+		equal, err := f.value.equals(gasMeter, str)
+		return !equal, err
+	case "in":
+		for _, v := range f.value.list {
+			equal, err := v.equals(gasMeter, str)
+			if err != nil || equal {
+				return equal, err
+			}
+		}
+		return false, nil
+	default:
+		cmp, err := compareNumbers(gasMeter, str, f.value.str)
+		if err != nil {
+			return false, err
+		}
+		switch f.op {
+		case "<":
+			return cmp < 0, nil
+		case "<=":
+			return cmp <= 0, nil
+		case ">":
+			return cmp > 0, nil
+		default:
+			return cmp >= 0, nil
+		}
+	}
+}
+
+// equals compares a field with a literal: numerically if the literal is a
+// number, as strings otherwise.
+func (v filterValue) equals(gasMeter sdk.GasMeter, str string) (bool, error) {
+	if !v.number {
+		gasMeter.ConsumeGas(gasCostPerFilterByte*uint64(len(str)+len(v.str)), "msg filter comparison")
+		return v.str == str, nil
+	}
+	cmp, err := compareNumbers(gasMeter, str, v.str)
+	return cmp == 0, err
+}
+
+func compareNumbers(gasMeter sdk.GasMeter, x, y string) (int, error) {
+	gasMeter.ConsumeGas(gasCostPerFilterByte*uint64(len(x)+len(y)), "msg filter comparison")
+	xRat, err := parseFilterNumber(x)
+	if err != nil {
+		return 0, err
+	}
+	yRat, err := parseFilterNumber(y)
+	if err != nil {
+		return 0, err
+	}
+	return xRat.Cmp(yRat), nil
+}
+
+// parseFilterNumber parses a decimal number of at most
+// MaxMsgFilterNumberLength characters. The exponent and fraction notations
+// accepted by big.Rat are rejected, as they let short strings denote numbers
+// too large to be compared.
+func parseFilterNumber(str string) (*big.Rat, error) {
+	if len(str) > MaxMsgFilterNumberLength {
+		return nil, fmt.Errorf("number length %d exceeds the maximum %d", len(str), MaxMsgFilterNumberLength)
+	}
+
+	intPart, fracPart, hasFrac := strings.Cut(strings.TrimPrefix(str, "-"), ".")
+	if !isFilterDigits(intPart) || (hasFrac && !isFilterDigits(fracPart)) {
+		return nil, fmt.Errorf("%q is not a number", str)
+	}
+
+	n, ok := new(big.Rat).SetString(str)
+	if !ok {
+		return nil, fmt.Errorf("%q is not a number", str)
+	}
+	return n, nil
+}
+
+func isFilterDigits(str string) bool {
+	for i := 0; i < len(str); i++ {
+		if str[i] < '0' || str[i] > '9' {
+			return false
+		}
+	}
+	return str != ""
+}
+
+// parseMsgFilter parses a MsgFilterAuthorization filter.
+func parseMsgFilter(filter string) (msgFilter, error) {
+	if len(filter) > MaxMsgFilterLength {
+		return nil, sdkerrors.Wrapf(ErrInvalidMsgFilter, "filter length %d exceeds the maximum %d", len(filter), MaxMsgFilterLength)
+	}
+
+	tokens, err := tokenizeMsgFilter(filter)
+	if err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalidMsgFilter, err.Error())
+	}
+
+	p := &msgFilterParser{tokens: tokens}
+	f, err := p.parseOr()
+	if err == nil && p.pos < len(p.tokens) {
+		err = fmt.Errorf("unexpected %q", p.tokens[p.pos])
+	}
+	if err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalidMsgFilter, err.Error())
+	}
+	return f, nil
+}
+
+// evalMsgFilter evaluates a parsed filter against the proto JSON fields of a
+// message.
+func evalMsgFilter(gasMeter sdk.GasMeter, f msgFilter, msg proto.Message) (bool, error) {
+	bz, err := codec.ProtoMarshalJSON(msg, nil)
+	if err != nil {
+		return false, err
+	}
+
+	var fields interface{}
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+	if err := dec.Decode(&fields); err != nil {
+		return false, err
+	}
+
+	ok, err := f.eval(gasMeter, fields)
+	if err != nil {
+		return false, sdkerrors.Wrap(ErrInvalidMsgFilter, err.Error())
+	}
+	return ok, nil
+}
+
+// tokenizeMsgFilter splits a filter into identifiers, literals and operators.
+// String literals are kept quoted to be told apart from identifiers.
+func tokenizeMsgFilter(filter string) ([]string, error) {
+	var tokens []string
+	for i := 0; i < len(filter); {
+		c := filter[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n':
+			i++
+		case strings.HasPrefix(filter[i:], "==") || strings.HasPrefix(filter[i:], "!=") ||
+			strings.HasPrefix(filter[i:], "<=") || strings.HasPrefix(filter[i:], ">=") ||
+			strings.HasPrefix(filter[i:], "&&") || strings.HasPrefix(filter[i:], "||"):
+			tokens = append(tokens, filter[i:i+2])
+			i += 2
+		case strings.ContainsRune("<>!()[],.", rune(c)):
+			tokens = append(tokens, filter[i:i+1])
+			i++
+		case c == '"':
+			j := i + 1
+			for ; j < len(filter) && filter[j] != '"'; j++ {
+				if filter[j] == '\\' {
+					j++
+				}
+			}
+			if j >= len(filter) {
+				return nil, fmt.Errorf("unterminated string")
+			}
+			tokens = append(tokens, filter[i:j+1])
+			i = j + 1
+		case c == '-' || isFilterIdentChar(c):
+			j := i + 1
+			for j < len(filter) && (isFilterIdentChar(filter[j]) || (c != '-' && c >= '0' && c <= '9' && filter[j] == '.') || (c == '-' && filter[j] == '.')) {
+				j++
+			}
+			tokens = append(tokens, filter[i:j])
+			i = j
+		default:
+			return nil, fmt.Errorf("unexpected character %q", c)
+		}
+	}
+	return tokens, nil
+}
+
+func isFilterIdentChar(c byte) bool {
+	return c == '_' || c < unicode.MaxASCII && (unicode.IsLetter(rune(c)) || unicode.IsDigit(rune(c)))
+}
+
+func isFilterNumber(token string) bool {
+	_, err := parseFilterNumber(token)
+	return err == nil
+}
+
+// msgFilterParser is a recursive descent parser of the filter grammar:
+//
+//	or         = and { "||" and }
+//	and        = unary { "&&" unary }
+//	unary      = "!" unary | "(" or ")" | comparison
+//	comparison = path ( "==" | "!=" | "<" | "<=" | ">" | ">=" ) literal | path "in" list
+//	path       = ident { "." ident | "[" integer "]" }
+//	list       = "[" [ literal { "," literal } ] "]"
+//	literal    = string | number | "true" | "false"
+type msgFilterParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *msgFilterParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *msgFilterParser) next() (string, error) {
+	if p.pos >= len(p.tokens) {
+		return "", fmt.Errorf("unexpected end of filter")
+	}
+	p.pos++
+	return p.tokens[p.pos-1], nil
+}
+
+func (p *msgFilterParser) expect(token string) error {
+	t, err := p.next()
+	if err != nil {
+		return err
+	}
+	if t != token {
+		return fmt.Errorf("expected %q, got %q", token, t)
+	}
+	return nil
+}
+
+func (p *msgFilterParser) parseOr() (msgFilter, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "||" {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orFilter{left, right}
+	}
+	return left, nil
+}
+
+func (p *msgFilterParser) parseAnd() (msgFilter, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "&&" {
+		p.pos++
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = andFilter{left, right}
+	}
+	return left, nil
+}
+
+func (p *msgFilterParser) parseUnary() (msgFilter, error) {
+	switch p.peek() {
+	case "!":
+		p.pos++
+		f, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notFilter{f}, nil
+	case "(":
+		p.pos++
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		return f, p.expect(")")
+	default:
+		return p.parseComparison()
+	}
+}
+
+func (p *msgFilterParser) parseComparison() (msgFilter, error) {
+	path, err := p.parsePath()
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+
+	var value filterValue
+	switch op {
+	case "==", "!=":
+		value, err = p.parseLiteral()
+	case "<", "<=", ">", ">=":
+		value, err = p.parseLiteral()
+		if err == nil && !value.number {
+			err = fmt.Errorf("operator %s expects a number", op)
+		}
+	case "in":
+		value, err = p.parseList()
+	default:
+		err = fmt.Errorf("unknown operator %q", op)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return comparisonFilter{path: path, op: op, value: value}, nil
+}
+
+func (p *msgFilterParser) parsePath() ([]interface{}, error) {
+	ident, err := p.parseIdent()
+	if err != nil {
+		return nil, err
+	}
+
+	path := []interface{}{ident}
+	for {
+		switch p.peek() {
+		case ".":
+			p.pos++
+			ident, err := p.parseIdent()
+			if err != nil {
+				return nil, err
+			}
+			path = append(path, ident)
+		case "[":
+			p.pos++
+			t, err := p.next()
+			if err != nil {
+				return nil, err
+			}
+			index, err := strconv.ParseUint(t, 10, 16)
+			if err != nil {
+				return nil, fmt.Errorf("invalid index %q", t)
+			}
+			if err := p.expect("]"); err != nil {
+				return nil, err
+			}
+			path = append(path, int(index))
+		default:
+			return path, nil
+		}
+	}
+}
+
+func (p *msgFilterParser) parseIdent() (string, error) {
+	t, err := p.next()
+	if err != nil {
+		return "", err
+	}
+	if t == "in" || t == "true" || t == "false" || !isFilterIdentChar(t[0]) || unicode.IsDigit(rune(t[0])) || strings.Contains(t, ".") {
+		return "", fmt.Errorf("expected a field name, got %q", t)
+	}
+	return t, nil
+}
+
+func (p *msgFilterParser) parseLiteral() (filterValue, error) {
+	t, err := p.next()
+	if err != nil {
+		return filterValue{}, err
+	}
+
+	switch {
+	case t[0] == '"':
+		str, err := strconv.Unquote(t)
+		if err != nil {
+			return filterValue{}, fmt.Errorf("invalid string %s", t)
+		}
+		return filterValue{str: str}, nil
+	case t == "true" || t == "false":
+		return filterValue{str: t}, nil
+	case isFilterNumber(t):
+		return filterValue{str: t, number: true}, nil
+	default:
+		return filterValue{}, fmt.Errorf("expected a literal, got %q", t)
+	}
+}
+
+func (p *msgFilterParser) parseList() (filterValue, error) {
+	if err := p.expect("["); err != nil {
+		return filterValue{}, err
+	}
+
+	var list []filterValue
+	for p.peek() != "]" {
+		if len(list) > 0 {
+			if err := p.expect(","); err != nil {
+				return filterValue{}, err
+			}
+		}
+		v, err := p.parseLiteral()
+		if err != nil {
+			return filterValue{}, err
+		}
+		list = append(list, v)
+	}
+	p.pos++
+
+	return filterValue{list: list}, nil
+}
@@ -0,0 +1,61 @@
+package authz
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// gasCostPerFilterByte is the gas charged per byte of filter parsed and of values compared.
+const gasCostPerFilterByte = uint64(1)
+
+var _ Authorization = &MsgFilterAuthorization{}
+
+// NewMsgFilterAuthorization creates a new MsgFilterAuthorization object.
+func NewMsgFilterAuthorization(msgTypeURL string, filter string) *MsgFilterAuthorization {
+	return &MsgFilterAuthorization{
+		Msg:    msgTypeURL,
+		Filter: filter,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a MsgFilterAuthorization) MsgTypeURL() string {
+	return a.Msg
+}
+
+// Accept implements Authorization.Accept. The msg is accepted if it matches
+// the filter.
+func (a MsgFilterAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (AcceptResponse, error) {
+	if sdk.MsgTypeURL(msg) != a.Msg {
+		return AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	ctx.GasMeter().ConsumeGas(gasCostPerFilterByte*uint64(len(a.Filter)), "msg filter authorization")
+	f, err := parseMsgFilter(a.Filter)
+	if err != nil {
+		return AcceptResponse{}, err
+	}
+
+	ok, err := evalMsgFilter(ctx.GasMeter(), f, msg)
+	if err != nil {
+		return AcceptResponse{}, err
+	}
+	if !ok {
+		return AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("msg doesn't match the filter %s", a.Filter)
+	}
+
+	return AcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a MsgFilterAuthorization) ValidateBasic() error {
+	if a.Msg == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("msg type URL cannot be empty")
+	}
+	if a.Filter == "" {
+		return sdkerrors.Wrap(ErrInvalidMsgFilter, "filter cannot be empty")
+	}
+
+	_, err := parseMsgFilter(a.Filter)
+	return err
+}
@@ -0,0 +1,94 @@
+package authz_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMsgFilterAuthorization(t *testing.T) {
+	ctx := sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
+	fromAddr := sdk.AccAddress("_____from _____")
+	toAddr := sdk.AccAddress("_______to________")
+	otherAddr := sdk.AccAddress("_____other_______")
+	msgTypeURL := banktypes.SendAuthorization{}.MsgTypeURL()
+	send := banktypes.NewMsgSend(fromAddr, toAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	exponentSend := banktypes.NewMsgSend(fromAddr, toAddr, sdk.Coins{{Denom: "1e999999", Amount: sdk.NewInt(100)}})
+
+	testCases := []struct {
+		name      string
+		filter    string
+		msg       sdk.Msg
+		expValid  bool
+		expAccept bool
+	}{
+		{"equal", `to_address == "` + toAddr.String() + `"`, send, true, true},
+		{"not equal", `to_address != "` + toAddr.String() + `"`, send, true, false},
+		{"numeric comparison", `amount[0].amount <= 100 && amount[0].amount > 99.5`, send, true, true},
+		{"numeric comparison fails", `amount[0].amount < 100`, send, true, false},
+		{"in", `to_address in ["` + otherAddr.String() + `", "` + toAddr.String() + `"] && amount[0].denom in ["stake"]`, send, true, true},
+		{"not in", `!(to_address in ["` + otherAddr.String() + `"])`, send, true, true},
+		{"or", `amount[0].denom == "atom" || from_address == "` + fromAddr.String() + `"`, send, true, true},
+		{"index out of range", `amount[1].denom == "stake"`, send, true, false},
+		{"unknown field", `recipient == "foo"`, send, true, false},
+		{"wrong msg type", `delegator_address == "foo"`, stakingtypes.NewMsgDelegate(fromAddr, sdk.ValAddress(toAddr), sdk.NewInt64Coin("stake", 1)), true, false},
+		{"empty filter", ``, send, false, false},
+		{"unterminated string", `to_address == "foo`, send, false, false},
+		{"missing operand", `to_address ==`, send, false, false},
+		{"unknown operator", `to_address ~ "foo"`, send, false, false},
+		{"ordering a string", `to_address < "foo"`, send, false, false},
+		{"unbalanced parenthesis", `(to_address == "foo"`, send, false, false},
+		{"trailing token", `to_address == "foo" "bar"`, send, false, false},
+		{"exponent literal", `amount[0].amount < 1e999999`, send, false, false},
+		{"number literal too long", `amount[0].amount < ` + strings.Repeat("9", authz.MaxMsgFilterNumberLength+1), send, false, false},
+		{"exponent field", `amount[0].denom > 1`, exponentSend, true, false},
+		{"exponent field compared as string", `amount[0].denom == "1e999999"`, exponentSend, true, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := authz.NewMsgFilterAuthorization(msgTypeURL, tc.filter)
+			require.Equal(t, msgTypeURL, a.MsgTypeURL())
+			err := a.ValidateBasic()
+			if !tc.expValid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			resp, err := a.Accept(ctx, tc.msg)
+			if tc.expAccept {
+				require.NoError(t, err)
+				require.True(t, resp.Accept)
+				require.False(t, resp.Delete)
+				require.Nil(t, resp.Updated)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgFilterAuthorizationGas(t *testing.T) {
+	fromAddr := sdk.AccAddress("_____from _____")
+	toAddr := sdk.AccAddress("_______to________")
+	msgTypeURL := banktypes.SendAuthorization{}.MsgTypeURL()
+	filter := `amount[0].amount <= 100`
+	a := authz.NewMsgFilterAuthorization(msgTypeURL, filter)
+
+	// the filter bytes and the bytes of the compared operands are charged
+	ctx := sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
+	_, err := a.Accept(ctx, banktypes.NewMsgSend(fromAddr, toAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(filter)+len("100")+len("100")), ctx.GasMeter().GasConsumed())
+
+	ctx = sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
+	_, err = a.Accept(ctx, banktypes.NewMsgSend(fromAddr, toAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))))
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(filter)+len("10")+len("100")), ctx.GasMeter().GasConsumed())
+}
@@ -17,11 +17,15 @@ var (
 	_ sdk.Msg = &MsgGrant{}
 	_ sdk.Msg = &MsgRevoke{}
 	_ sdk.Msg = &MsgExec{}
+	_ sdk.Msg = &MsgRevokeAll{}
+	_ sdk.Msg = &MsgRenounce{}
 
 	// For amino support.
 	_ legacytx.LegacyMsg = &MsgGrant{}
 	_ legacytx.LegacyMsg = &MsgRevoke{}
 	_ legacytx.LegacyMsg = &MsgExec{}
+	_ legacytx.LegacyMsg = &MsgRevokeAll{}
+	_ legacytx.LegacyMsg = &MsgRenounce{}
 
 	_ cdctypes.UnpackInterfacesMessage = &MsgGrant{}
 	_ cdctypes.UnpackInterfacesMessage = &MsgExec{}
@@ -239,3 +243,92 @@ func (msg MsgExec) Route() string {
 func (msg MsgExec) GetSignBytes() []byte {
 	return sdk.MustSortJSON(authzcodec.ModuleCdc.MustMarshalJSON(&msg))
 }
+
+// NewMsgRevokeAll creates a new MsgRevokeAll
+//
+//nolint:interfacer
+func NewMsgRevokeAll(granter sdk.AccAddress) MsgRevokeAll {
+	return MsgRevokeAll{
+		Granter: granter.String(),
+	}
+}
+
+// GetSigners implements Msg
+func (msg MsgRevokeAll) GetSigners() []sdk.AccAddress {
+	granter, _ := sdk.AccAddressFromBech32(msg.Granter)
+	return []sdk.AccAddress{granter}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgRevokeAll) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Granter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid granter address: %s", err)
+	}
+
+	return nil
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgRevokeAll) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgRevokeAll) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgRevokeAll) GetSignBytes() []byte {
+	return sdk.MustSortJSON(authzcodec.ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgRenounce creates a new MsgRenounce
+//
+//nolint:interfacer
+func NewMsgRenounce(grantee sdk.AccAddress, granter sdk.AccAddress, msgTypeURL string) MsgRenounce {
+	return MsgRenounce{
+		Grantee:    grantee.String(),
+		Granter:    granter.String(),
+		MsgTypeUrl: msgTypeURL,
+	}
+}
+
+// GetSigners implements Msg
+func (msg MsgRenounce) GetSigners() []sdk.AccAddress {
+	grantee, _ := sdk.AccAddressFromBech32(msg.Grantee)
+	return []sdk.AccAddress{grantee}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgRenounce) ValidateBasic() error {
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", err)
+	}
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid granter address: %s", err)
+	}
+
+	if granter.Equals(grantee) {
+		return ErrGranteeIsGranter
+	}
+
+	return nil
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgRenounce) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgRenounce) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgRenounce) GetSignBytes() []byte {
+	return sdk.MustSortJSON(authzcodec.ModuleCdc.MustMarshalJSON(&msg))
+}
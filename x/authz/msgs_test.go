@@ -70,6 +70,34 @@ func TestMsgRevokeAuthorization(t *testing.T) {
 	}
 }
 
+func TestMsgRevokeAll(t *testing.T) {
+	require.Error(t, authz.NewMsgRevokeAll(nil).ValidateBasic())
+	require.NoError(t, authz.NewMsgRevokeAll(granter).ValidateBasic())
+}
+
+func TestMsgRenounce(t *testing.T) {
+	tests := []struct {
+		title            string
+		grantee, granter sdk.AccAddress
+		msgType          string
+		expectPass       bool
+	}{
+		{"nil Grantee address", nil, granter, "hello", false},
+		{"nil Granter address", grantee, nil, "hello", false},
+		{"same Granter and Grantee", grantee, grantee, "hello", false},
+		{"valid test case", grantee, granter, "hello", true},
+		{"valid test case without msg type", grantee, granter, "", true},
+	}
+	for i, tc := range tests {
+		msg := authz.NewMsgRenounce(tc.grantee, tc.granter, tc.msgType)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
 // add time interval to a time object and returns a pointer
 func addDatePtr(t *time.Time, months, days int) *time.Time {
 	t2 := t.AddDate(0, months, days)
@@ -167,7 +195,7 @@ func TestAminoJSON(t *testing.T) {
 	require.NoError(t, err)
 	grant, err := authz.NewGrant(blockTime, authz.NewGenericAuthorization(typeURL), &expiresAt)
 	require.NoError(t, err)
-	sendGrant, err := authz.NewGrant(blockTime, banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000)))), &expiresAt)
+	sendGrant, err := authz.NewGrant(blockTime, banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000))), nil), &expiresAt)
 	require.NoError(t, err)
 	valAddr, err := sdk.ValAddressFromBech32("cosmosvaloper1xcy3els9ua75kdm783c3qu0rfa2eples6eavqq")
 	require.NoError(t, err)
@@ -374,6 +374,115 @@ func (m *QueryGranteeGrantsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryGrantsByMsgTypeRequest is the request type for the Query/GrantsByMsgType RPC method.
+type QueryGrantsByMsgTypeRequest struct {
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// pagination defines an pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGrantsByMsgTypeRequest) Reset()         { *m = QueryGrantsByMsgTypeRequest{} }
+func (m *QueryGrantsByMsgTypeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGrantsByMsgTypeRequest) ProtoMessage()    {}
+func (*QueryGrantsByMsgTypeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376d714ffdeb1545, []int{6}
+}
+func (m *QueryGrantsByMsgTypeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGrantsByMsgTypeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGrantsByMsgTypeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGrantsByMsgTypeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGrantsByMsgTypeRequest.Merge(m, src)
+}
+func (m *QueryGrantsByMsgTypeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGrantsByMsgTypeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGrantsByMsgTypeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGrantsByMsgTypeRequest proto.InternalMessageInfo
+
+func (m *QueryGrantsByMsgTypeRequest) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *QueryGrantsByMsgTypeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGrantsByMsgTypeResponse is the response type for the Query/GrantsByMsgType RPC method.
+type QueryGrantsByMsgTypeResponse struct {
+	// grants is a list of grants of the Msg type.
+	Grants []*GrantAuthorization `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants,omitempty"`
+	// pagination defines an pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGrantsByMsgTypeResponse) Reset()         { *m = QueryGrantsByMsgTypeResponse{} }
+func (m *QueryGrantsByMsgTypeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGrantsByMsgTypeResponse) ProtoMessage()    {}
+func (*QueryGrantsByMsgTypeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376d714ffdeb1545, []int{7}
+}
+func (m *QueryGrantsByMsgTypeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGrantsByMsgTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGrantsByMsgTypeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGrantsByMsgTypeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGrantsByMsgTypeResponse.Merge(m, src)
+}
+func (m *QueryGrantsByMsgTypeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGrantsByMsgTypeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGrantsByMsgTypeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGrantsByMsgTypeResponse proto.InternalMessageInfo
+
+func (m *QueryGrantsByMsgTypeResponse) GetGrants() []*GrantAuthorization {
+	if m != nil {
+		return m.Grants
+	}
+	return nil
+}
+
+func (m *QueryGrantsByMsgTypeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryGrantsRequest)(nil), "cosmos.authz.v1beta1.QueryGrantsRequest")
 	proto.RegisterType((*QueryGrantsResponse)(nil), "cosmos.authz.v1beta1.QueryGrantsResponse")
@@ -381,46 +490,51 @@ func init() {
 	proto.RegisterType((*QueryGranterGrantsResponse)(nil), "cosmos.authz.v1beta1.QueryGranterGrantsResponse")
 	proto.RegisterType((*QueryGranteeGrantsRequest)(nil), "cosmos.authz.v1beta1.QueryGranteeGrantsRequest")
 	proto.RegisterType((*QueryGranteeGrantsResponse)(nil), "cosmos.authz.v1beta1.QueryGranteeGrantsResponse")
+	proto.RegisterType((*QueryGrantsByMsgTypeRequest)(nil), "cosmos.authz.v1beta1.QueryGrantsByMsgTypeRequest")
+	proto.RegisterType((*QueryGrantsByMsgTypeResponse)(nil), "cosmos.authz.v1beta1.QueryGrantsByMsgTypeResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/query.proto", fileDescriptor_376d714ffdeb1545) }
 
 var fileDescriptor_376d714ffdeb1545 = []byte{
-	// 529 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0x41, 0x6f, 0xd3, 0x3e,
-	0x18, 0xc6, 0xeb, 0xf6, 0xff, 0x2f, 0xc2, 0x83, 0x8b, 0xe1, 0x90, 0x85, 0x29, 0x8a, 0xaa, 0x09,
-	0x0a, 0xd2, 0xec, 0xad, 0x93, 0x38, 0x22, 0xb6, 0xc3, 0x76, 0x85, 0x00, 0x17, 0x2e, 0x55, 0xba,
-	0xbe, 0x72, 0x23, 0xda, 0x38, 0xb3, 0x1d, 0x44, 0x87, 0x76, 0x81, 0x2f, 0x80, 0xb4, 0x03, 0x1f,
-	0x01, 0x89, 0x33, 0x1f, 0x82, 0xe3, 0x04, 0x17, 0x8e, 0xa8, 0x45, 0xf0, 0x35, 0x50, 0x6d, 0x97,
-	0xae, 0x23, 0xdb, 0x02, 0xd3, 0x24, 0x4e, 0xad, 0xa3, 0xe7, 0x7d, 0xde, 0xdf, 0xfb, 0x38, 0x76,
-	0x70, 0xb8, 0x23, 0xd4, 0x40, 0x28, 0x16, 0xe7, 0xba, 0xb7, 0xc7, 0x9e, 0xaf, 0x75, 0x40, 0xc7,
-	0x6b, 0x6c, 0x37, 0x07, 0x39, 0xa4, 0x99, 0x14, 0x5a, 0x90, 0xeb, 0x56, 0x41, 0x8d, 0x82, 0x3a,
-	0x85, 0xbf, 0xc4, 0x85, 0xe0, 0x7d, 0x60, 0x71, 0x96, 0xb0, 0x38, 0x4d, 0x85, 0x8e, 0x75, 0x22,
-	0x52, 0x65, 0x6b, 0xfc, 0x3b, 0xce, 0xb5, 0x13, 0x2b, 0xb0, 0x66, 0xbf, 0xac, 0xb3, 0x98, 0x27,
-	0xa9, 0x11, 0x3b, 0x6d, 0x31, 0x81, 0xed, 0x66, 0x15, 0x8b, 0x56, 0xd1, 0x36, 0x2b, 0xe6, 0x70,
-	0xcc, 0xa2, 0xf1, 0x1d, 0x61, 0xf2, 0x70, 0xe2, 0xbf, 0x2d, 0xe3, 0x54, 0xab, 0x08, 0x76, 0x73,
-	0x50, 0x9a, 0xb4, 0xf0, 0x25, 0x3e, 0x79, 0x00, 0xd2, 0x43, 0x21, 0x6a, 0x5e, 0xde, 0xf4, 0x3e,
-	0x7d, 0x58, 0x99, 0x0e, 0xb2, 0xd1, 0xed, 0x4a, 0x50, 0xea, 0x91, 0x96, 0x49, 0xca, 0xa3, 0xa9,
-	0x70, 0x56, 0x03, 0x5e, 0xb5, 0x5c, 0x0d, 0x90, 0x10, 0x5f, 0x19, 0x28, 0xde, 0xd6, 0xc3, 0x0c,
-	0xda, 0xb9, 0xec, 0x7b, 0xb5, 0x49, 0x61, 0x84, 0x07, 0x8a, 0x3f, 0x1e, 0x66, 0xf0, 0x44, 0xf6,
-	0xc9, 0x16, 0xc6, 0xb3, 0x89, 0xbd, 0xff, 0x42, 0xd4, 0x5c, 0x68, 0xdd, 0xa4, 0xce, 0x75, 0x12,
-	0x0f, 0xb5, 0x59, 0xbb, 0xb9, 0xe9, 0x83, 0x98, 0x83, 0x9b, 0x22, 0x3a, 0x52, 0xd9, 0x38, 0x40,
-	0xf8, 0xda, 0xdc, 0xa0, 0x2a, 0x13, 0xa9, 0x02, 0xb2, 0x8e, 0xeb, 0x06, 0x46, 0x79, 0x28, 0xac,
-	0x35, 0x17, 0x5a, 0x37, 0x68, 0xd1, 0x76, 0x51, 0x53, 0x15, 0x39, 0x29, 0xd9, 0x9e, 0x83, 0xaa,
-	0x1a, 0xa8, 0x5b, 0x67, 0x42, 0xd9, 0x8e, 0x73, 0x54, 0x6f, 0x11, 0x5e, 0x9c, 0x51, 0x81, 0x3c,
-	0xff, 0x2e, 0x6c, 0x15, 0xa0, 0xfd, 0x4d, 0x5e, 0xef, 0x10, 0xf6, 0x8b, 0xc8, 0x5c, 0x6c, 0xf7,
-	0x8f, 0xc5, 0xd6, 0x3c, 0x25, 0xb6, 0x8d, 0x5c, 0xf7, 0x84, 0x4c, 0xf6, 0x8c, 0xf1, 0x85, 0x67,
-	0x08, 0x27, 0x64, 0x08, 0x65, 0x33, 0x84, 0x8b, 0xca, 0x10, 0xfe, 0xd9, 0x0c, 0x5b, 0x3f, 0x6a,
-	0xf8, 0x7f, 0x43, 0x4a, 0x5e, 0x23, 0x5c, 0xb7, 0x9c, 0xe4, 0x04, 0x9e, 0xdf, 0xaf, 0x0b, 0xff,
-	0x76, 0x09, 0xa5, 0xed, 0xda, 0x58, 0x7e, 0xf5, 0xf9, 0xdb, 0x41, 0x35, 0x20, 0x4b, 0xac, 0xf0,
-	0xda, 0x72, 0x83, 0xbd, 0x47, 0xf8, 0xea, 0xdc, 0x8b, 0x47, 0xd8, 0x59, 0x2d, 0x8e, 0x1d, 0x1e,
-	0x7f, 0xb5, 0x7c, 0x81, 0x43, 0xbb, 0x6b, 0xd0, 0x56, 0x09, 0x3d, 0x0d, 0x8d, 0xb9, 0x83, 0xc6,
-	0x5e, 0xba, 0x3f, 0xfb, 0x47, 0x60, 0xa1, 0x34, 0x2c, 0xfc, 0x29, 0x2c, 0x9c, 0x03, 0x16, 0xa6,
-	0xb0, 0xb0, 0xbf, 0x79, 0xef, 0xe3, 0x28, 0x40, 0x87, 0xa3, 0x00, 0x7d, 0x1d, 0x05, 0xe8, 0xcd,
-	0x38, 0xa8, 0x1c, 0x8e, 0x83, 0xca, 0x97, 0x71, 0x50, 0x79, 0xba, 0xcc, 0x13, 0xdd, 0xcb, 0x3b,
-	0x74, 0x47, 0x0c, 0xa6, 0x9e, 0xf6, 0x67, 0x45, 0x75, 0x9f, 0xb1, 0x17, 0xb6, 0x41, 0xa7, 0x6e,
-	0xbe, 0x1b, 0xeb, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x3e, 0x8b, 0x47, 0x69, 0xf8, 0x06, 0x00,
-	0x00,
+	// 586 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0x4f, 0x6b, 0x13, 0x41,
+	0x18, 0xc6, 0x33, 0xa9, 0x8d, 0x38, 0x55, 0x84, 0xd1, 0xc3, 0x76, 0x1b, 0x96, 0x10, 0x8a, 0xc6,
+	0x42, 0x77, 0x92, 0x14, 0x3c, 0x8a, 0xcd, 0xa1, 0x3d, 0x09, 0x1a, 0xf5, 0xe2, 0x25, 0x6c, 0x9a,
+	0x97, 0xcd, 0x62, 0xb2, 0xb3, 0x9d, 0x99, 0x15, 0x53, 0xf1, 0xa2, 0x07, 0xaf, 0x42, 0x05, 0x3f,
+	0x82, 0xe8, 0xd9, 0x0f, 0xe1, 0xb1, 0xe8, 0xc5, 0xa3, 0x24, 0xe2, 0xe7, 0x90, 0xcc, 0xcc, 0x9a,
+	0x3f, 0x6e, 0x93, 0xb4, 0xa5, 0xd0, 0xd3, 0x66, 0x92, 0xe7, 0x7d, 0xe6, 0xf7, 0x3e, 0x93, 0x77,
+	0x58, 0x5c, 0xd8, 0x63, 0xa2, 0xcb, 0x04, 0xf5, 0x62, 0xd9, 0x3e, 0xa0, 0x2f, 0x2a, 0x4d, 0x90,
+	0x5e, 0x85, 0xee, 0xc7, 0xc0, 0x7b, 0x6e, 0xc4, 0x99, 0x64, 0xe4, 0xa6, 0x56, 0xb8, 0x4a, 0xe1,
+	0x1a, 0x85, 0x9d, 0xf7, 0x19, 0xf3, 0x3b, 0x40, 0xbd, 0x28, 0xa0, 0x5e, 0x18, 0x32, 0xe9, 0xc9,
+	0x80, 0x85, 0x42, 0xd7, 0xd8, 0x1b, 0xc6, 0xb5, 0xe9, 0x09, 0xd0, 0x66, 0xff, 0xac, 0x23, 0xcf,
+	0x0f, 0x42, 0x25, 0x36, 0xda, 0x74, 0x02, 0xbd, 0x9b, 0x56, 0xac, 0x6a, 0x45, 0x43, 0xad, 0xa8,
+	0x5e, 0xe8, 0x9f, 0x8a, 0x7f, 0x10, 0x26, 0x8f, 0x86, 0xfe, 0xbb, 0xdc, 0x0b, 0xa5, 0xa8, 0xc3,
+	0x7e, 0x0c, 0x42, 0x92, 0x2a, 0xbe, 0xec, 0x0f, 0xbf, 0x00, 0x6e, 0xa1, 0x02, 0x2a, 0x5d, 0xa9,
+	0x59, 0xdf, 0xbf, 0x6e, 0x26, 0x8d, 0x6c, 0xb7, 0x5a, 0x1c, 0x84, 0x78, 0x2c, 0x79, 0x10, 0xfa,
+	0xf5, 0x44, 0x38, 0xaa, 0x01, 0x2b, 0xbb, 0x58, 0x0d, 0x90, 0x02, 0xbe, 0xda, 0x15, 0x7e, 0x43,
+	0xf6, 0x22, 0x68, 0xc4, 0xbc, 0x63, 0x2d, 0x0d, 0x0b, 0xeb, 0xb8, 0x2b, 0xfc, 0x27, 0xbd, 0x08,
+	0x9e, 0xf2, 0x0e, 0xd9, 0xc1, 0x78, 0xd4, 0xb1, 0x75, 0xa9, 0x80, 0x4a, 0x2b, 0xd5, 0x5b, 0xae,
+	0x71, 0x1d, 0xc6, 0xe3, 0xea, 0xac, 0x4d, 0xdf, 0xee, 0x43, 0xcf, 0x07, 0xd3, 0x45, 0x7d, 0xac,
+	0xb2, 0x78, 0x88, 0xf0, 0x8d, 0x89, 0x46, 0x45, 0xc4, 0x42, 0x01, 0x64, 0x0b, 0xe7, 0x14, 0x8c,
+	0xb0, 0x50, 0x61, 0xa9, 0xb4, 0x52, 0x5d, 0x73, 0xd3, 0x8e, 0xcb, 0x55, 0x55, 0x75, 0x23, 0x25,
+	0xbb, 0x13, 0x50, 0x59, 0x05, 0x75, 0x7b, 0x2e, 0x94, 0xde, 0x71, 0x82, 0xea, 0x23, 0xc2, 0xab,
+	0x23, 0x2a, 0xe0, 0x67, 0x3f, 0x85, 0x9d, 0x14, 0xb4, 0xd3, 0xe4, 0xf5, 0x09, 0x61, 0x3b, 0x8d,
+	0xcc, 0xc4, 0x76, 0x7f, 0x2a, 0xb6, 0xd2, 0x8c, 0xd8, 0xb6, 0x63, 0xd9, 0x66, 0x3c, 0x38, 0x50,
+	0xc6, 0xe7, 0x9e, 0x21, 0x1c, 0x93, 0x21, 0x2c, 0x9a, 0x21, 0x9c, 0x57, 0x86, 0x70, 0x71, 0x33,
+	0x7c, 0x87, 0xf0, 0xda, 0xd8, 0x74, 0xd4, 0x7a, 0x0f, 0xf4, 0x04, 0x26, 0x29, 0x4e, 0xcf, 0x29,
+	0x9a, 0x33, 0xa7, 0xa7, 0xcf, 0xec, 0x33, 0xc2, 0xf9, 0x74, 0x92, 0x0b, 0x97, 0x5a, 0xf5, 0xc3,
+	0x32, 0x5e, 0x56, 0xac, 0xe4, 0x2d, 0xc2, 0x39, 0x0d, 0x4c, 0x8e, 0xe1, 0xf9, 0xff, 0x92, 0xb5,
+	0xef, 0x2c, 0xa0, 0xd4, 0xbb, 0x16, 0xd7, 0xdf, 0xfc, 0xf8, 0x7d, 0x98, 0x75, 0x48, 0x9e, 0xa6,
+	0x5e, 0xf6, 0xa6, 0xb1, 0x2f, 0x08, 0x5f, 0x9b, 0x18, 0x57, 0x42, 0xe7, 0x6d, 0x31, 0x75, 0xe5,
+	0xd8, 0xe5, 0xc5, 0x0b, 0x0c, 0xda, 0x5d, 0x85, 0x56, 0x26, 0xee, 0x2c, 0x34, 0xfd, 0x00, 0x4e,
+	0x5f, 0x99, 0x0f, 0xaf, 0xc7, 0x60, 0x61, 0x61, 0x58, 0x38, 0x29, 0x2c, 0x9c, 0x01, 0x16, 0x12,
+	0x58, 0x50, 0xb0, 0xd7, 0xa7, 0xfe, 0x90, 0xa4, 0x32, 0xf7, 0xf8, 0xa6, 0xc7, 0xc8, 0xae, 0x9e,
+	0xa4, 0xc4, 0x20, 0x97, 0x15, 0xf2, 0x06, 0x29, 0xcd, 0x44, 0x6e, 0xf6, 0x1a, 0xc9, 0x80, 0xd6,
+	0xee, 0x7d, 0xeb, 0x3b, 0xe8, 0xa8, 0xef, 0xa0, 0x5f, 0x7d, 0x07, 0xbd, 0x1f, 0x38, 0x99, 0xa3,
+	0x81, 0x93, 0xf9, 0x39, 0x70, 0x32, 0xcf, 0xd6, 0xfd, 0x40, 0xb6, 0xe3, 0xa6, 0xbb, 0xc7, 0xba,
+	0x89, 0x9b, 0x7e, 0x6c, 0x8a, 0xd6, 0x73, 0xfa, 0x52, 0x5b, 0x37, 0x73, 0xea, 0xd5, 0x60, 0xeb,
+	0xef, 0x00, 0x22, 0xa3, 0x64, 0x21, 0xdb, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -445,6 +559,8 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	GranteeGrants(ctx context.Context, in *QueryGranteeGrantsRequest, opts ...grpc.CallOption) (*QueryGranteeGrantsResponse, error)
+	// GrantsByMsgType returns a list of `GrantAuthorization` of a Msg type.
+	GrantsByMsgType(ctx context.Context, in *QueryGrantsByMsgTypeRequest, opts ...grpc.CallOption) (*QueryGrantsByMsgTypeResponse, error)
 }
 
 type queryClient struct {
@@ -482,6 +598,15 @@ func (c *queryClient) GranteeGrants(ctx context.Context, in *QueryGranteeGrantsR
 	return out, nil
 }
 
+func (c *queryClient) GrantsByMsgType(ctx context.Context, in *QueryGrantsByMsgTypeRequest, opts ...grpc.CallOption) (*QueryGrantsByMsgTypeResponse, error) {
+	out := new(QueryGrantsByMsgTypeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.authz.v1beta1.Query/GrantsByMsgType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Returns list of `Authorization`, granted to the grantee by the granter.
@@ -494,6 +619,8 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	GranteeGrants(context.Context, *QueryGranteeGrantsRequest) (*QueryGranteeGrantsResponse, error)
+	// GrantsByMsgType returns a list of `GrantAuthorization` of a Msg type.
+	GrantsByMsgType(context.Context, *QueryGrantsByMsgTypeRequest) (*QueryGrantsByMsgTypeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -509,6 +636,9 @@ func (*UnimplementedQueryServer) GranterGrants(ctx context.Context, req *QueryGr
 func (*UnimplementedQueryServer) GranteeGrants(ctx context.Context, req *QueryGranteeGrantsRequest) (*QueryGranteeGrantsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GranteeGrants not implemented")
 }
+func (*UnimplementedQueryServer) GrantsByMsgType(ctx context.Context, req *QueryGrantsByMsgTypeRequest) (*QueryGrantsByMsgTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantsByMsgType not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -568,6 +698,24 @@ func _Query_GranteeGrants_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GrantsByMsgType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGrantsByMsgTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GrantsByMsgType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.authz.v1beta1.Query/GrantsByMsgType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GrantsByMsgType(ctx, req.(*QueryGrantsByMsgTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.authz.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -584,6 +732,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GranteeGrants",
 			Handler:    _Query_GranteeGrants_Handler,
 		},
+		{
+			MethodName: "GrantsByMsgType",
+			Handler:    _Query_GrantsByMsgType_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/authz/v1beta1/query.proto",
@@ -876,6 +1028,97 @@ func (m *QueryGranteeGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryGrantsByMsgTypeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGrantsByMsgTypeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGrantsByMsgTypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGrantsByMsgTypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGrantsByMsgTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGrantsByMsgTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1003,6 +1246,42 @@ func (m *QueryGranteeGrantsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryGrantsByMsgTypeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGrantsByMsgTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1787,6 +2066,244 @@ func (m *QueryGranteeGrantsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryGrantsByMsgTypeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGrantsByMsgTypeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGrantsByMsgTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGrantsByMsgTypeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGrantsByMsgTypeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGrantsByMsgTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, &GrantAuthorization{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -269,6 +269,161 @@ func (m *MsgRevokeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRevokeResponse proto.InternalMessageInfo
 
+// MsgRevokeAll revokes all the authorizations granted by the granter.
+type MsgRevokeAll struct {
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+}
+
+func (m *MsgRevokeAll) Reset()         { *m = MsgRevokeAll{} }
+func (m *MsgRevokeAll) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeAll) ProtoMessage()    {}
+func (*MsgRevokeAll) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{6}
+}
+func (m *MsgRevokeAll) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeAll) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeAll.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeAll) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeAll.Merge(m, src)
+}
+func (m *MsgRevokeAll) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeAll) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeAll.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeAll proto.InternalMessageInfo
+
+// MsgRevokeAllResponse defines the Msg/MsgRevokeAllResponse response type.
+type MsgRevokeAllResponse struct {
+}
+
+func (m *MsgRevokeAllResponse) Reset()         { *m = MsgRevokeAllResponse{} }
+func (m *MsgRevokeAllResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeAllResponse) ProtoMessage()    {}
+func (*MsgRevokeAllResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{7}
+}
+func (m *MsgRevokeAllResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeAllResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeAllResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeAllResponse.Merge(m, src)
+}
+func (m *MsgRevokeAllResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeAllResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeAllResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeAllResponse proto.InternalMessageInfo
+
+// MsgRenounce revokes the authorization with the provided sdk.Msg type granted
+// to the grantee by the granter, on behalf of the grantee. If msg_type_url is
+// empty, all the authorizations granted to the grantee by the granter are
+// revoked.
+type MsgRenounce struct {
+	Grantee    string `protobuf:"bytes,1,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	Granter    string `protobuf:"bytes,2,opt,name=granter,proto3" json:"granter,omitempty"`
+	MsgTypeUrl string `protobuf:"bytes,3,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+}
+
+func (m *MsgRenounce) Reset()         { *m = MsgRenounce{} }
+func (m *MsgRenounce) String() string { return proto.CompactTextString(m) }
+func (*MsgRenounce) ProtoMessage()    {}
+func (*MsgRenounce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{8}
+}
+func (m *MsgRenounce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRenounce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRenounce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRenounce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRenounce.Merge(m, src)
+}
+func (m *MsgRenounce) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRenounce) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRenounce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRenounce proto.InternalMessageInfo
+
+// MsgRenounceResponse defines the Msg/MsgRenounceResponse response type.
+type MsgRenounceResponse struct {
+}
+
+func (m *MsgRenounceResponse) Reset()         { *m = MsgRenounceResponse{} }
+func (m *MsgRenounceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRenounceResponse) ProtoMessage()    {}
+func (*MsgRenounceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{9}
+}
+func (m *MsgRenounceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRenounceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRenounceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRenounceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRenounceResponse.Merge(m, src)
+}
+func (m *MsgRenounceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRenounceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRenounceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRenounceResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgGrant)(nil), "cosmos.authz.v1beta1.MsgGrant")
 	proto.RegisterType((*MsgExecResponse)(nil), "cosmos.authz.v1beta1.MsgExecResponse")
@@ -276,45 +431,54 @@ func init() {
 	proto.RegisterType((*MsgGrantResponse)(nil), "cosmos.authz.v1beta1.MsgGrantResponse")
 	proto.RegisterType((*MsgRevoke)(nil), "cosmos.authz.v1beta1.MsgRevoke")
 	proto.RegisterType((*MsgRevokeResponse)(nil), "cosmos.authz.v1beta1.MsgRevokeResponse")
+	proto.RegisterType((*MsgRevokeAll)(nil), "cosmos.authz.v1beta1.MsgRevokeAll")
+	proto.RegisterType((*MsgRevokeAllResponse)(nil), "cosmos.authz.v1beta1.MsgRevokeAllResponse")
+	proto.RegisterType((*MsgRenounce)(nil), "cosmos.authz.v1beta1.MsgRenounce")
+	proto.RegisterType((*MsgRenounceResponse)(nil), "cosmos.authz.v1beta1.MsgRenounceResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/tx.proto", fileDescriptor_3ceddab7d8589ad1) }
 
 var fileDescriptor_3ceddab7d8589ad1 = []byte{
-	// 519 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x53, 0xcd, 0x8e, 0x12, 0x41,
-	0x10, 0xa6, 0x17, 0x76, 0x91, 0x5e, 0x12, 0x75, 0x24, 0x71, 0x16, 0xdd, 0xd9, 0xc9, 0xc4, 0x1f,
-	0xa2, 0xd2, 0x13, 0xf0, 0x60, 0xe2, 0x8d, 0x49, 0x8c, 0x89, 0x71, 0x62, 0x32, 0xea, 0xc5, 0x0b,
-	0x19, 0xa0, 0x6d, 0x08, 0xcc, 0x34, 0x99, 0xea, 0x21, 0xb0, 0x47, 0x9f, 0xc0, 0x9b, 0x0f, 0xe0,
-	0x0b, 0x78, 0xd8, 0x8b, 0x6f, 0x40, 0x3c, 0x6d, 0x3c, 0x79, 0x32, 0x0a, 0x07, 0x5f, 0xc1, 0xa3,
-	0xa1, 0x7b, 0x1a, 0x7f, 0xc2, 0xae, 0x7b, 0xda, 0x53, 0x77, 0xd5, 0xf7, 0x55, 0xf5, 0x57, 0xfd,
-	0x75, 0xe3, 0xfd, 0x2e, 0x87, 0x88, 0x83, 0x1b, 0xa6, 0xa2, 0x7f, 0xe8, 0x4e, 0x1a, 0x1d, 0x2a,
-	0xc2, 0x86, 0x2b, 0xa6, 0x64, 0x9c, 0x70, 0xc1, 0x8d, 0x8a, 0x82, 0x89, 0x84, 0x49, 0x06, 0x57,
-	0xf7, 0x54, 0xb6, 0x2d, 0x39, 0x6e, 0x46, 0x91, 0x41, 0xb5, 0xc2, 0x38, 0xe3, 0x2a, 0xbf, 0xda,
-	0x65, 0xd9, 0x3d, 0xc6, 0x39, 0x1b, 0x51, 0x57, 0x46, 0x9d, 0xf4, 0xb5, 0x1b, 0xc6, 0xb3, 0x0c,
-	0xb2, 0x37, 0x0a, 0x50, 0xe7, 0x29, 0xc6, 0xd5, 0x8c, 0x11, 0x01, 0x73, 0x27, 0x8d, 0xd5, 0xa2,
-	0x00, 0xe7, 0x23, 0xc2, 0x17, 0x7c, 0x60, 0x8f, 0x93, 0x30, 0x16, 0x46, 0x13, 0x17, 0xd9, 0x6a,
-	0x43, 0x13, 0x13, 0xd9, 0xa8, 0x56, 0xf2, 0xcc, 0xcf, 0x47, 0x75, 0x2d, 0xbf, 0xd5, 0xeb, 0x25,
-	0x14, 0xe0, 0xb9, 0x48, 0x06, 0x31, 0x0b, 0x34, 0xf1, 0x77, 0x0d, 0x35, 0xb7, 0xce, 0x56, 0x43,
-	0x8d, 0x07, 0x78, 0x5b, 0x6e, 0xcd, 0xbc, 0x8d, 0x6a, 0xbb, 0xcd, 0x6b, 0x64, 0xd3, 0x0d, 0x11,
-	0xa9, 0xc9, 0x2b, 0xcc, 0xbf, 0x1e, 0xe4, 0x02, 0xc5, 0x7f, 0x58, 0x7e, 0xf3, 0xe3, 0xc3, 0x1d,
-	0x7d, 0xb4, 0x73, 0x17, 0x5f, 0xf4, 0x81, 0x3d, 0x9a, 0xd2, 0x6e, 0x40, 0x61, 0xcc, 0x63, 0xa0,
-	0x86, 0x89, 0x8b, 0x09, 0x85, 0x74, 0x24, 0xc0, 0x44, 0x76, 0xbe, 0x56, 0x0e, 0x74, 0xe8, 0xbc,
-	0x43, 0xb8, 0x98, 0xb1, 0xff, 0xd4, 0x8c, 0xce, 0xaa, 0xf9, 0x09, 0x2e, 0x44, 0xc0, 0xc0, 0xdc,
-	0xb2, 0xf3, 0xb5, 0xdd, 0x66, 0x85, 0x28, 0x37, 0x88, 0x76, 0x83, 0xb4, 0xe2, 0x99, 0x67, 0x7f,
-	0x3a, 0xaa, 0x5f, 0x87, 0xde, 0x90, 0xf8, 0xc0, 0xee, 0xd9, 0x6a, 0x9a, 0x56, 0x2a, 0xfa, 0x3c,
-	0x19, 0x1c, 0x86, 0x62, 0xc0, 0xe3, 0x40, 0xf6, 0xf8, 0x6b, 0x0c, 0xea, 0x18, 0xf8, 0x92, 0x76,
-	0x40, 0xcf, 0xe1, 0xbc, 0x47, 0xb8, 0xe4, 0x03, 0x0b, 0xe8, 0x84, 0x0f, 0xe9, 0xb9, 0xf9, 0x62,
-	0xe3, 0x72, 0x04, 0xac, 0x2d, 0x66, 0x63, 0xda, 0x4e, 0x93, 0x91, 0xb4, 0xa7, 0x14, 0xe0, 0x08,
-	0xd8, 0x8b, 0xd9, 0x98, 0xbe, 0x4c, 0x46, 0xff, 0x18, 0x70, 0x05, 0x5f, 0x5e, 0x8b, 0xd4, 0xd2,
-	0x9b, 0x3f, 0x11, 0xce, 0xfb, 0xc0, 0x8c, 0x67, 0x78, 0x5b, 0xbd, 0x2a, 0x6b, 0xb3, 0xbd, 0x7a,
-	0xe6, 0xea, 0xad, 0xd3, 0xf1, 0xb5, 0xb7, 0x4f, 0x71, 0x41, 0xba, 0xb7, 0x7f, 0x22, 0x7f, 0x05,
-	0x57, 0x6f, 0x9e, 0x0a, 0xaf, 0xbb, 0x05, 0x78, 0x27, 0xbb, 0xdd, 0x83, 0x13, 0x0b, 0x14, 0xa1,
-	0x7a, 0xfb, 0x3f, 0x04, 0xdd, 0xd3, 0xf3, 0xe6, 0xdf, 0xad, 0xdc, 0x7c, 0x61, 0xa1, 0xe3, 0x85,
-	0x85, 0xbe, 0x2d, 0x2c, 0xf4, 0x76, 0x69, 0xe5, 0x8e, 0x97, 0x56, 0xee, 0xcb, 0xd2, 0xca, 0xbd,
-	0xba, 0xc1, 0x06, 0xa2, 0x9f, 0x76, 0x48, 0x97, 0x47, 0xd9, 0x7f, 0xcf, 0x96, 0x3a, 0xf4, 0x86,
-	0xee, 0x54, 0xfd, 0xd7, 0xce, 0x8e, 0x7c, 0x51, 0xf7, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x59,
-	0x66, 0xa5, 0x3e, 0x55, 0x04, 0x00, 0x00,
+	// 601 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xcd, 0x34, 0x6d, 0xd3, 0xdc, 0x44, 0xfa, 0x3e, 0xdc, 0x00, 0xae, 0xa1, 0xae, 0xb1, 0xf8,
+	0x09, 0x85, 0xd8, 0x4a, 0x58, 0x20, 0xb1, 0x4b, 0x24, 0x84, 0x84, 0x88, 0x40, 0x06, 0x24, 0x04,
+	0x8b, 0x28, 0x3f, 0xc3, 0x24, 0x8a, 0xed, 0x89, 0x3c, 0x76, 0x94, 0x74, 0xc9, 0x13, 0xb0, 0xe3,
+	0x01, 0x10, 0x6b, 0x58, 0x74, 0xc3, 0x1b, 0x44, 0xac, 0x2a, 0x56, 0xac, 0x10, 0x24, 0x0b, 0x5e,
+	0x03, 0x65, 0xc6, 0x76, 0x43, 0x95, 0x26, 0xa1, 0x0b, 0x56, 0xf3, 0x73, 0xce, 0xbd, 0xf7, 0xcc,
+	0x9c, 0x3b, 0x03, 0xbb, 0x4d, 0xca, 0x1c, 0xca, 0xcc, 0x7a, 0xe0, 0xb7, 0x0f, 0xcc, 0x7e, 0xb1,
+	0x81, 0xfd, 0x7a, 0xd1, 0xf4, 0x07, 0x46, 0xcf, 0xa3, 0x3e, 0x95, 0x72, 0x02, 0x36, 0x38, 0x6c,
+	0x84, 0xb0, 0xb2, 0x23, 0x76, 0x6b, 0x9c, 0x63, 0x86, 0x14, 0xbe, 0x50, 0x72, 0x84, 0x12, 0x2a,
+	0xf6, 0xa7, 0xb3, 0x70, 0x77, 0x87, 0x50, 0x4a, 0x6c, 0x6c, 0xf2, 0x55, 0x23, 0x78, 0x6d, 0xd6,
+	0xdd, 0x61, 0x08, 0x69, 0x73, 0x05, 0x88, 0x7a, 0x82, 0x71, 0x31, 0x64, 0x38, 0x8c, 0x98, 0xfd,
+	0xe2, 0x74, 0x10, 0x80, 0xfe, 0x19, 0xc1, 0x56, 0x95, 0x91, 0x07, 0x5e, 0xdd, 0xf5, 0xa5, 0x12,
+	0xa4, 0xc8, 0x74, 0x82, 0x3d, 0x19, 0x69, 0x28, 0x9f, 0xae, 0xc8, 0x5f, 0x0f, 0x0b, 0x91, 0xfc,
+	0x72, 0xab, 0xe5, 0x61, 0xc6, 0x9e, 0xfa, 0x5e, 0xc7, 0x25, 0x56, 0x44, 0x3c, 0x8e, 0xc1, 0xf2,
+	0xda, 0x6a, 0x31, 0x58, 0xba, 0x0b, 0x1b, 0x7c, 0x2a, 0x27, 0x35, 0x94, 0xcf, 0x94, 0x2e, 0x19,
+	0xf3, 0x6e, 0xc8, 0xe0, 0x9a, 0x2a, 0xeb, 0xa3, 0xef, 0x7b, 0x09, 0x4b, 0xf0, 0xef, 0x65, 0xdf,
+	0xfc, 0xfa, 0xb4, 0x1f, 0x95, 0xd6, 0x6f, 0xc1, 0x7f, 0x55, 0x46, 0xee, 0x0f, 0x70, 0xd3, 0xc2,
+	0xac, 0x47, 0x5d, 0x86, 0x25, 0x19, 0x52, 0x1e, 0x66, 0x81, 0xed, 0x33, 0x19, 0x69, 0xc9, 0x7c,
+	0xd6, 0x8a, 0x96, 0xfa, 0x3b, 0x04, 0xa9, 0x90, 0x3d, 0xab, 0x19, 0xad, 0xaa, 0xf9, 0x21, 0xac,
+	0x3b, 0x8c, 0x30, 0x79, 0x4d, 0x4b, 0xe6, 0x33, 0xa5, 0x9c, 0x21, 0xdc, 0x30, 0x22, 0x37, 0x8c,
+	0xb2, 0x3b, 0xac, 0x68, 0x5f, 0x0e, 0x0b, 0x97, 0x59, 0xab, 0x6b, 0x54, 0x19, 0xb9, 0xad, 0x89,
+	0xd3, 0x94, 0x03, 0xbf, 0x4d, 0xbd, 0xce, 0x41, 0xdd, 0xef, 0x50, 0xd7, 0xe2, 0x39, 0xfe, 0x38,
+	0x06, 0xd6, 0x25, 0xf8, 0x3f, 0x72, 0x20, 0x3a, 0x87, 0xfe, 0x1e, 0x41, 0xba, 0xca, 0x88, 0x85,
+	0xfb, 0xb4, 0x8b, 0xff, 0x99, 0x2f, 0x1a, 0x64, 0x1d, 0x46, 0x6a, 0xfe, 0xb0, 0x87, 0x6b, 0x81,
+	0x67, 0x73, 0x7b, 0xd2, 0x16, 0x38, 0x8c, 0x3c, 0x1b, 0xf6, 0xf0, 0x73, 0xcf, 0x3e, 0x61, 0xc0,
+	0x36, 0x9c, 0x8b, 0x45, 0xc6, 0xd2, 0x9f, 0x40, 0x36, 0xde, 0x2c, 0xdb, 0xf6, 0x59, 0xc4, 0x9f,
+	0x28, 0x73, 0x01, 0x72, 0xb3, 0x19, 0xe3, 0x4a, 0x1f, 0x10, 0x64, 0x38, 0xe0, 0xd2, 0xc0, 0x6d,
+	0xe2, 0x33, 0xd9, 0x3a, 0xa3, 0x6e, 0x6d, 0xd5, 0xab, 0xfd, 0xbb, 0x6b, 0xc2, 0xfa, 0x79, 0xd8,
+	0x9e, 0x91, 0x19, 0xc9, 0x2f, 0x7d, 0x4c, 0x42, 0xb2, 0xca, 0x88, 0xf4, 0x18, 0x36, 0xc4, 0xf3,
+	0x53, 0xe7, 0xbf, 0x83, 0xa8, 0x39, 0x94, 0xeb, 0x8b, 0xf1, 0xf8, 0x11, 0x3c, 0x82, 0x75, 0xde,
+	0xe6, 0xbb, 0xa7, 0xf2, 0xa7, 0xb0, 0x72, 0x6d, 0x21, 0x1c, 0x67, 0xb3, 0x60, 0x33, 0x6c, 0xc3,
+	0xbd, 0x53, 0x03, 0x04, 0x41, 0xb9, 0xb1, 0x84, 0x10, 0xe7, 0x7c, 0x05, 0xe9, 0xe3, 0x06, 0xd1,
+	0x97, 0x44, 0x95, 0x6d, 0x5b, 0xd9, 0x5f, 0xce, 0x89, 0x93, 0xbf, 0x80, 0xad, 0xb8, 0x25, 0xae,
+	0x2c, 0x88, 0x13, 0x14, 0xe5, 0xe6, 0x52, 0x4a, 0x94, 0xb9, 0x52, 0x19, 0xfd, 0x54, 0x13, 0xa3,
+	0xb1, 0x8a, 0x8e, 0xc6, 0x2a, 0xfa, 0x31, 0x56, 0xd1, 0xdb, 0x89, 0x9a, 0x38, 0x9a, 0xa8, 0x89,
+	0x6f, 0x13, 0x35, 0xf1, 0xf2, 0x2a, 0xe9, 0xf8, 0xed, 0xa0, 0x61, 0x34, 0xa9, 0x13, 0xfe, 0xe7,
+	0xe1, 0x50, 0x60, 0xad, 0xae, 0x39, 0x10, 0xff, 0x71, 0x63, 0x93, 0xff, 0x18, 0x77, 0x7e, 0x0f,
+	0x00, 0xe5, 0xfe, 0x0d, 0xf7, 0x35, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -341,6 +505,11 @@ type MsgClient interface {
 	// Revoke revokes any authorization corresponding to the provided method name on the
 	// granter's account that has been granted to the grantee.
 	Revoke(ctx context.Context, in *MsgRevoke, opts ...grpc.CallOption) (*MsgRevokeResponse, error)
+	// RevokeAll revokes all the authorizations granted by the granter.
+	RevokeAll(ctx context.Context, in *MsgRevokeAll, opts ...grpc.CallOption) (*MsgRevokeAllResponse, error)
+	// Renounce revokes, on behalf of the grantee, the authorizations granted to
+	// the grantee by the granter.
+	Renounce(ctx context.Context, in *MsgRenounce, opts ...grpc.CallOption) (*MsgRenounceResponse, error)
 }
 
 type msgClient struct {
@@ -378,6 +547,24 @@ func (c *msgClient) Revoke(ctx context.Context, in *MsgRevoke, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) RevokeAll(ctx context.Context, in *MsgRevokeAll, opts ...grpc.CallOption) (*MsgRevokeAllResponse, error) {
+	out := new(MsgRevokeAllResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.authz.v1beta1.Msg/RevokeAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Renounce(ctx context.Context, in *MsgRenounce, opts ...grpc.CallOption) (*MsgRenounceResponse, error) {
+	out := new(MsgRenounceResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.authz.v1beta1.Msg/Renounce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Grant grants the provided authorization to the grantee on the granter's
@@ -392,6 +579,11 @@ type MsgServer interface {
 	// Revoke revokes any authorization corresponding to the provided method name on the
 	// granter's account that has been granted to the grantee.
 	Revoke(context.Context, *MsgRevoke) (*MsgRevokeResponse, error)
+	// RevokeAll revokes all the authorizations granted by the granter.
+	RevokeAll(context.Context, *MsgRevokeAll) (*MsgRevokeAllResponse, error)
+	// Renounce revokes, on behalf of the grantee, the authorizations granted to
+	// the grantee by the granter.
+	Renounce(context.Context, *MsgRenounce) (*MsgRenounceResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -407,6 +599,12 @@ func (*UnimplementedMsgServer) Exec(ctx context.Context, req *MsgExec) (*MsgExec
 func (*UnimplementedMsgServer) Revoke(ctx context.Context, req *MsgRevoke) (*MsgRevokeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Revoke not implemented")
 }
+func (*UnimplementedMsgServer) RevokeAll(ctx context.Context, req *MsgRevokeAll) (*MsgRevokeAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAll not implemented")
+}
+func (*UnimplementedMsgServer) Renounce(ctx context.Context, req *MsgRenounce) (*MsgRenounceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Renounce not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -466,6 +664,42 @@ func _Msg_Revoke_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RevokeAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeAll)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.authz.v1beta1.Msg/RevokeAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeAll(ctx, req.(*MsgRevokeAll))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Renounce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRenounce)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Renounce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.authz.v1beta1.Msg/Renounce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Renounce(ctx, req.(*MsgRenounce))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.authz.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -482,6 +716,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Revoke",
 			Handler:    _Msg_Revoke_Handler,
 		},
+		{
+			MethodName: "RevokeAll",
+			Handler:    _Msg_RevokeAll_Handler,
+		},
+		{
+			MethodName: "Renounce",
+			Handler:    _Msg_Renounce_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/authz/v1beta1/tx.proto",
@@ -700,6 +942,126 @@ func (m *MsgRevokeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRevokeAll) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeAll) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeAll) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeAllResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeAllResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRenounce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenounce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenounce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRenounceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenounceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenounceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -803,15 +1165,67 @@ func (m *MsgRevokeResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgRevokeAll) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
 }
-func (m *MsgGrant) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
+
+func (m *MsgRevokeAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRenounce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRenounceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
@@ -1400,6 +1814,334 @@ func (m *MsgRevokeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRevokeAll) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeAll: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeAll: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRenounce) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRenounce: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRenounce: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRenounceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRenounceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRenounceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -32,7 +33,10 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetBalancesCmd(),
 		GetCmdQueryTotalSupply(),
+		GetCmdQueryBurnedSupply(),
+		GetCmdQuerySupplyAtHeight(),
 		GetCmdDenomsMetadata(),
+		GetCmdQuerySendEnabled(),
 	)
 
 	return cmd
@@ -213,3 +217,184 @@ To query for the total supply of a specific coin denomination use:
 
 	return cmd
 }
+
+// GetCmdQueryBurnedSupply defines the cobra command to query the cumulative
+// burned amount of the coins.
+func GetCmdQueryBurnedSupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burned",
+		Short: "Query the cumulative burned amount of coins of the chain",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the cumulative amount of coins burned on the chain.
+
+Example:
+  $ %s query %s burned
+
+To query for the burned amount of a specific coin denomination use:
+  $ %s query %s burned --denom=[denom]
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			ctx := cmd.Context()
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if denom == "" {
+				res, err := queryClient.BurnedSupply(ctx, &types.QueryBurnedSupplyRequest{Pagination: pageReq})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			res, err := queryClient.BurnedSupplyOf(ctx, &types.QueryBurnedSupplyOfRequest{Denom: denom})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Amount)
+		},
+	}
+
+	cmd.Flags().String(FlagDenom, "", "The specific denomination to query the burned amount for")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all burned amounts")
+
+	return cmd
+}
+
+// GetCmdQuerySupplyAtHeight defines the cobra command to query the total
+// supply recorded by the supply snapshots at a given height.
+func GetCmdQuerySupplyAtHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply-at-height [height]",
+		Short: "Query the total supply of coins of the chain at a given height",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the total supply of coins recorded by the most recent supply snapshot
+taken at or below the given height. Supply snapshots are only recorded when
+enabled in the bank module params.
+
+Example:
+  $ %s query %s supply-at-height [height]
+
+To query for the supply of a specific coin denomination use:
+  $ %s query %s supply-at-height [height] --denom=[denom]
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %s: %w", args[0], err)
+			}
+
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SupplyAtHeight(cmd.Context(), &types.QuerySupplyAtHeightRequest{
+				Height:     height,
+				Denom:      denom,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagDenom, "", "The specific denomination to query the supply for")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all supply totals")
+
+	return cmd
+}
+
+// GetCmdQuerySendEnabled defines the cobra command to query the SendEnabled
+// entries of the given denominations, or all of them.
+func GetCmdQuerySendEnabled() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-enabled [denom1 ...]",
+		Short: "Query for send enabled entries",
+		Long: strings.TrimSpace(`Query for send enabled entries that have been specifically set.
+
+To look up one or more specific denoms, supply them as arguments to this command.
+To look up all denoms, do not provide any arguments.
+`,
+		),
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`Getting one specific entry:
+  $ %[1]s query %[2]s send-enabled foocoin
+
+Getting two specific entries:
+  $ %[1]s query %[2]s send-enabled foocoin barcoin
+
+Getting all entries:
+  $ %[1]s query %[2]s send-enabled
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			reqPag, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QuerySendEnabledRequest{
+				Denoms:     args,
+				Pagination: reqPag,
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SendEnabled(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "send enabled entries")
+
+	return cmd
+}
@@ -28,6 +28,7 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		NewSendTxCmd(),
 		NewMultiSendTxCmd(),
+		NewBurnTxCmd(),
 	)
 
 	return txCmd
@@ -145,3 +146,37 @@ When using '--dry-run' a key name cannot be used, only a bech32 address.`,
 
 	return cmd
 }
+
+// NewBurnTxCmd returns a CLI command handler for creating a MsgBurn transaction.
+func NewBurnTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn [from_key_or_address] [amount]",
+		Short: "Burn funds from an account, removing them from the total supply.",
+		Long: `Burn funds from an account, removing them from the total supply.
+Note, the '--from' flag is ignored as it is implied from [from_key_or_address].
+When using '--dry-run' a key name cannot be used, only a bech32 address.
+`,
+		Example: fmt.Sprintf("%s tx bank burn cosmos1... 10stake", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBurn(clientCtx.GetFromAddress(), coins)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
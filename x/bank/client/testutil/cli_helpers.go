@@ -19,6 +19,13 @@ func MsgSendExec(clientCtx client.Context, from, to, amount fmt.Stringer, extraA
 	return clitestutil.ExecTestCLICmd(clientCtx, bankcli.NewSendTxCmd(), args)
 }
 
+func MsgBurnExec(clientCtx client.Context, from, amount fmt.Stringer, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{from.String(), amount.String()}
+	args = append(args, extraArgs...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, bankcli.NewBurnTxCmd(), args)
+}
+
 func MsgMultiSendExec(clientCtx client.Context, from sdk.AccAddress, to []sdk.AccAddress, amount fmt.Stringer, extraArgs ...string) (testutil.BufferWriter, error) {
 	args := []string{from.String()}
 	for _, addr := range to {
@@ -78,6 +78,13 @@ func (s *IntegrationTestSuite) SetupSuite() {
 		},
 	}
 
+	bankGenesis.SendEnabled = []types.SendEnabled{
+		{Denom: "disabledcoin", Enabled: false},
+		{Denom: "enabledcoin", Enabled: true},
+	}
+
+	bankGenesis.Params.SupplySnapshotInterval = 2
+
 	bankGenesisBz, err := s.cfg.Codec.MarshalJSON(&bankGenesis)
 	s.Require().NoError(err)
 	genesisState[types.ModuleName] = bankGenesisBz
@@ -86,7 +93,7 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	s.network, err = network.New(s.T(), s.T().TempDir(), s.cfg)
 	s.Require().NoError(err)
 
-	_, err = s.network.WaitForHeight(1)
+	_, err = s.network.WaitForHeight(2)
 	s.Require().NoError(err)
 }
 
@@ -238,6 +245,79 @@ func (s *IntegrationTestSuite) TestGetCmdQueryTotalSupply() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdQuerySupplyAtHeight() {
+	val := s.network.Validators[0]
+
+	// the first snapshot is taken at height 2, after the tokens minted in
+	// that block, so its supply is the total supply at height 2
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdQueryTotalSupply(), []string{
+		fmt.Sprintf("--%s=2", flags.FlagHeight),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	})
+	s.Require().NoError(err)
+	var totalSupply types.QueryTotalSupplyResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &totalSupply))
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		expected  *types.QuerySupplyAtHeightResponse
+	}{
+		{
+			name:      "invalid height",
+			args:      []string{"abc", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			expectErr: true,
+		},
+		{
+			name:      "height before the first snapshot",
+			args:      []string{"1", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			expectErr: true,
+		},
+		{
+			name: "total supply",
+			args: []string{"3", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			expected: &types.QuerySupplyAtHeightResponse{
+				SnapshotHeight: 2,
+				Supply:         totalSupply.Supply,
+				Pagination:     &query.PageResponse{Total: 0},
+			},
+		},
+		{
+			name: "total supply of a specific denomination",
+			args: []string{
+				"2",
+				fmt.Sprintf("--%s=%s", cli.FlagDenom, s.cfg.BondDenom),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			expected: &types.QuerySupplyAtHeightResponse{
+				SnapshotHeight: 2,
+				Supply:         sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, totalSupply.Supply.AmountOf(s.cfg.BondDenom))),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQuerySupplyAtHeight()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+
+				var resp types.QuerySupplyAtHeightResponse
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp))
+				s.Require().Equal(tc.expected, &resp)
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestGetCmdQueryDenomsMetadata() {
 	val := s.network.Validators[0]
 
@@ -365,6 +445,81 @@ func (s *IntegrationTestSuite) TestGetCmdQueryDenomsMetadata() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdQuerySendEnabled() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name     string
+		args     []string
+		expected *types.QuerySendEnabledResponse
+	}{
+		{
+			name: "all entries",
+			args: []string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			expected: &types.QuerySendEnabledResponse{
+				SendEnabled: []*types.SendEnabled{
+					types.NewSendEnabled("disabledcoin", false),
+					types.NewSendEnabled("enabledcoin", true),
+				},
+				Pagination: &query.PageResponse{Total: 0},
+			},
+		},
+		{
+			name: "specific denoms",
+			args: []string{"enabledcoin", "unknowncoin", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			expected: &types.QuerySendEnabledResponse{
+				SendEnabled: []*types.SendEnabled{
+					types.NewSendEnabled("enabledcoin", true),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQuerySendEnabled()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			s.Require().NoError(err)
+
+			var resp types.QuerySendEnabledResponse
+			s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp))
+			s.Require().Equal(tc.expected, &resp)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewBurnTxCmd() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+	denom := fmt.Sprintf("%stoken", val.Moniker)
+	amount := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(10)))
+
+	bz, err := MsgBurnExec(clientCtx, val.Address, amount,
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	)
+	s.Require().NoError(err)
+
+	var txResp sdk.TxResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(bz.Bytes(), &txResp), bz.String())
+	s.Require().Equal(uint32(0), txResp.Code, txResp.RawLog)
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryBurnedSupply(), []string{
+		fmt.Sprintf("--%s=%s", cli.FlagDenom, denom),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	})
+	s.Require().NoError(err)
+
+	var burned sdk.Coin
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &burned))
+	s.Require().Equal(amount[0], burned)
+}
+
 func (s *IntegrationTestSuite) TestNewSendTxCmdGenOnly() {
 	val := s.network.Validators[0]
 
@@ -12,6 +12,10 @@ import (
 func (k BaseKeeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	k.SetParams(ctx, genState.Params)
 
+	for _, se := range genState.GetAllSendEnabled() {
+		k.SetSendEnabled(ctx, se.Denom, se.Enabled)
+	}
+
 	totalSupply := sdk.Coins{}
 	genState.Balances = types.SanitizeGenesisBalances(genState.Balances)
 
@@ -36,6 +40,10 @@ func (k BaseKeeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	for _, meta := range genState.DenomMetadata {
 		k.SetDenomMetaData(ctx, meta)
 	}
+
+	for _, burned := range genState.BurnedSupply {
+		k.setBurnedSupply(ctx, burned)
+	}
 }
 
 // ExportGenesis returns the bank module's genesis state.
@@ -45,10 +53,19 @@ func (k BaseKeeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(fmt.Errorf("unable to fetch total supply %v", err))
 	}
 
-	return types.NewGenesisState(
+	burnedSupply, _, err := k.GetPaginatedBurnedSupply(ctx, &query.PageRequest{Limit: query.MaxLimit})
+	if err != nil {
+		panic(fmt.Errorf("unable to fetch burned supply %v", err))
+	}
+
+	genState := types.NewGenesisState(
 		k.GetParams(ctx),
 		k.GetAccountsBalances(ctx),
 		totalSupply,
 		k.GetAllDenomMetaData(ctx),
+		k.GetAllSendEnabledEntries(ctx),
 	)
+	genState.BurnedSupply = burnedSupply
+
+	return genState
 }
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
@@ -33,6 +34,7 @@ func (suite *IntegrationTestSuite) TestExportGenesis() {
 			NoError(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, accAddr, expectedBalances[i].Coins))
 	}
 	app.BankKeeper.SetParams(ctx, types.DefaultParams())
+	app.BankKeeper.SetSendEnabled(ctx, "testcoin1", false)
 
 	exportGenesis := app.BankKeeper.ExportGenesis(ctx)
 
@@ -41,6 +43,38 @@ func (suite *IntegrationTestSuite) TestExportGenesis() {
 	suite.Require().Equal(expTotalSupply, exportGenesis.Supply)
 	suite.Require().Subset(exportGenesis.Balances, expectedBalances)
 	suite.Require().Equal(expectedMetadata, exportGenesis.DenomMetadata)
+	suite.Require().Equal([]types.SendEnabled{{Denom: "testcoin1", Enabled: false}}, exportGenesis.SendEnabled)
+}
+
+func (suite *IntegrationTestSuite) TestBurnedSupplyGenesis() {
+	app, ctx := suite.app, suite.ctx
+
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	burned := sdk.NewCoins(sdk.NewInt64Coin("testcoin1", 10))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr, burned))
+	suite.Require().NoError(app.BankKeeper.BurnAccountCoins(ctx, addr, burned))
+
+	exportGenesis := app.BankKeeper.ExportGenesis(ctx)
+	suite.Require().Equal(burned, exportGenesis.BurnedSupply)
+
+	genesis := types.DefaultGenesisState()
+	genesis.BurnedSupply = sdk.NewCoins(sdk.NewInt64Coin("testcoin2", 20))
+	app.BankKeeper.InitGenesis(ctx, genesis)
+	suite.Require().Equal(sdk.NewInt64Coin("testcoin2", 20), app.BankKeeper.GetBurnedSupply(ctx, "testcoin2"))
+}
+
+func (suite *IntegrationTestSuite) TestInitGenesisSendEnabled() {
+	app, ctx := suite.app, suite.ctx
+
+	genesis := types.DefaultGenesisState()
+	genesis.Params.SendEnabled = []*types.SendEnabled{types.NewSendEnabled("paramcoin", false)}
+	genesis.SendEnabled = []types.SendEnabled{{Denom: "genesiscoin", Enabled: false}}
+	app.BankKeeper.InitGenesis(ctx, genesis)
+
+	suite.Require().Empty(app.BankKeeper.GetParams(ctx).SendEnabled)
+	suite.Require().False(app.BankKeeper.IsSendEnabledDenom(ctx, "paramcoin"))
+	suite.Require().False(app.BankKeeper.IsSendEnabledDenom(ctx, "genesiscoin"))
+	suite.Require().True(app.BankKeeper.IsSendEnabledDenom(ctx, "othercoin"))
 }
 
 func (suite *IntegrationTestSuite) getTestBalancesAndSupply() ([]types.Balance, sdk.Coins) {
@@ -92,17 +126,17 @@ func (suite *IntegrationTestSuite) TestTotalSupply() {
 	}{
 		{
 			"calculation NOT matching genesis Supply field",
-			types.NewGenesisState(defaultGenesis.Params, balances, sdk.NewCoins(sdk.NewCoin("wrongcoin", sdk.NewInt(1))), defaultGenesis.DenomMetadata),
+			types.NewGenesisState(defaultGenesis.Params, balances, sdk.NewCoins(sdk.NewCoin("wrongcoin", sdk.NewInt(1))), defaultGenesis.DenomMetadata, defaultGenesis.SendEnabled),
 			nil, true, "genesis supply is incorrect, expected 1wrongcoin, got 21barcoin,11foocoin",
 		},
 		{
 			"calculation matches genesis Supply field",
-			types.NewGenesisState(defaultGenesis.Params, balances, totalSupply, defaultGenesis.DenomMetadata),
+			types.NewGenesisState(defaultGenesis.Params, balances, totalSupply, defaultGenesis.DenomMetadata, defaultGenesis.SendEnabled),
 			totalSupply, false, "",
 		},
 		{
 			"calculation is correct, empty genesis Supply field",
-			types.NewGenesisState(defaultGenesis.Params, balances, nil, defaultGenesis.DenomMetadata),
+			types.NewGenesisState(defaultGenesis.Params, balances, nil, defaultGenesis.DenomMetadata, defaultGenesis.SendEnabled),
 			totalSupply, false, "",
 		},
 	}
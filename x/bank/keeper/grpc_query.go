@@ -138,6 +138,72 @@ func (k BaseKeeper) SupplyOf(c context.Context, req *types.QuerySupplyOfRequest)
 	return &types.QuerySupplyOfResponse{Amount: sdk.NewCoin(req.Denom, supply.Amount)}, nil
 }
 
+// BurnedSupply implements the Query/BurnedSupply gRPC method
+func (k BaseKeeper) BurnedSupply(c context.Context, req *types.QueryBurnedSupplyRequest) (*types.QueryBurnedSupplyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	burned, pageRes, err := k.GetPaginatedBurnedSupply(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBurnedSupplyResponse{Burned: burned, Pagination: pageRes}, nil
+}
+
+// BurnedSupplyOf implements the Query/BurnedSupplyOf gRPC method
+func (k BaseKeeper) BurnedSupplyOf(c context.Context, req *types.QueryBurnedSupplyOfRequest) (*types.QueryBurnedSupplyOfResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryBurnedSupplyOfResponse{Amount: k.GetBurnedSupply(ctx, req.Denom)}, nil
+}
+
+// SupplyAtHeight implements the Query/SupplyAtHeight gRPC method
+func (k BaseKeeper) SupplyAtHeight(c context.Context, req *types.QuerySupplyAtHeightRequest) (*types.QuerySupplyAtHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Height <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "height must be positive")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req.Denom != "" {
+		if err := sdk.ValidateDenom(req.Denom); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid denom")
+		}
+
+		coin, snapshotHeight, found := k.GetSupplyAtHeight(ctx, req.Height, req.Denom)
+		if !found {
+			return nil, status.Errorf(codes.NotFound, "no supply snapshot at or below height %d", req.Height)
+		}
+
+		return &types.QuerySupplyAtHeightResponse{SnapshotHeight: snapshotHeight, Supply: sdk.NewCoins(coin)}, nil
+	}
+
+	supply, snapshotHeight, pageRes, found, err := k.GetPaginatedSupplyAtHeight(ctx, req.Height, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no supply snapshot at or below height %d", req.Height)
+	}
+
+	return &types.QuerySupplyAtHeightResponse{SnapshotHeight: snapshotHeight, Supply: supply, Pagination: pageRes}, nil
+}
+
 // Params implements the gRPC service handler for querying x/bank parameters.
 func (k BaseKeeper) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	if req == nil {
@@ -243,3 +309,40 @@ func (k BaseKeeper) DenomOwners(
 
 	return &types.QueryDenomOwnersResponse{DenomOwners: denomOwners, Pagination: pageRes}, nil
 }
+
+// SendEnabled implements the Query/SendEnabled gRPC method. The requested
+// denoms are looked up directly, without pagination; with no denoms, all the
+// SendEnabled entries are returned, paginated.
+func (k BaseKeeper) SendEnabled(goCtx context.Context, req *types.QuerySendEnabledRequest) (*types.QuerySendEnabledResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	resp := &types.QuerySendEnabledResponse{}
+	if len(req.Denoms) > 0 {
+		store := ctx.KVStore(k.storeKey)
+		for _, denom := range req.Denoms {
+			if se, ok := k.getSendEnabled(store, denom); ok {
+				resp.SendEnabled = append(resp.SendEnabled, types.NewSendEnabled(denom, se))
+			}
+		}
+	} else {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SendEnabledPrefix)
+		var err error
+
+		resp.Pagination, err = query.Paginate(
+			store,
+			req.Pagination,
+			func(key, value []byte) error {
+				resp.SendEnabled = append(resp.SendEnabled, types.NewSendEnabled(string(key), types.IsTrueB(value)))
+				return nil
+			},
+		)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return resp, nil
+}
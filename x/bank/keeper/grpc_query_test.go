@@ -179,6 +179,73 @@ func (suite *IntegrationTestSuite) TestQueryTotalSupplyOf() {
 	suite.Require().Equal(test1Supply, res.Amount)
 }
 
+func (suite *IntegrationTestSuite) TestQueryBurnedSupply() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	burned := sdk.NewCoins(sdk.NewInt64Coin("test1", 400), sdk.NewInt64Coin("test2", 700))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr, burned))
+	suite.Require().NoError(app.BankKeeper.BurnAccountCoins(ctx, addr, burned))
+
+	res, err := queryClient.BurnedSupply(gocontext.Background(), &types.QueryBurnedSupplyRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(burned, res.Burned)
+
+	res, err = queryClient.BurnedSupply(gocontext.Background(), &types.QueryBurnedSupplyRequest{Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	suite.Require().NoError(err)
+	suite.Require().Equal(burned[:1], res.Burned)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	_, err = queryClient.BurnedSupplyOf(gocontext.Background(), &types.QueryBurnedSupplyOfRequest{})
+	suite.Require().Error(err)
+
+	resOf, err := queryClient.BurnedSupplyOf(gocontext.Background(), &types.QueryBurnedSupplyOfRequest{Denom: "test1"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin("test1", 400), resOf.Amount)
+
+	resOf, err = queryClient.BurnedSupplyOf(gocontext.Background(), &types.QueryBurnedSupplyOfRequest{Denom: "test3"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin("test3", 0), resOf.Amount)
+}
+
+func (suite *IntegrationTestSuite) TestQuerySupplyAtHeight() {
+	app, queryClient := suite.app, suite.queryClient
+	ctx := suite.ctx.WithBlockHeight(10)
+
+	_, err := queryClient.SupplyAtHeight(gocontext.Background(), &types.QuerySupplyAtHeightRequest{})
+	suite.Require().Error(err)
+
+	_, err = queryClient.SupplyAtHeight(gocontext.Background(), &types.QuerySupplyAtHeightRequest{Height: 10})
+	suite.Require().Error(err)
+
+	params := app.BankKeeper.GetParams(ctx)
+	params.SupplySnapshotInterval = 5
+	app.BankKeeper.SetParams(ctx, params)
+	app.BankKeeper.TrackSupplySnapshot(ctx)
+	supply, _, err := app.BankKeeper.GetPaginatedTotalSupply(ctx, &query.PageRequest{})
+	suite.Require().NoError(err)
+
+	_, err = queryClient.SupplyAtHeight(gocontext.Background(), &types.QuerySupplyAtHeightRequest{Height: 9})
+	suite.Require().Error(err)
+
+	res, err := queryClient.SupplyAtHeight(gocontext.Background(), &types.QuerySupplyAtHeightRequest{Height: 15})
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(10), res.SnapshotHeight)
+	suite.Require().Equal(supply, res.Supply)
+
+	res, err = queryClient.SupplyAtHeight(gocontext.Background(), &types.QuerySupplyAtHeightRequest{Height: 15, Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	suite.Require().NoError(err)
+	suite.Require().Equal(supply[:1], res.Supply)
+	suite.Require().Equal(uint64(len(supply)), res.Pagination.Total)
+
+	res, err = queryClient.SupplyAtHeight(gocontext.Background(), &types.QuerySupplyAtHeightRequest{Height: 10, Denom: supply[0].Denom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(supply[0]), res.Supply)
+
+	_, err = queryClient.SupplyAtHeight(gocontext.Background(), &types.QuerySupplyAtHeightRequest{Height: 10, Denom: "1"})
+	suite.Require().Error(err)
+}
+
 func (suite *IntegrationTestSuite) TestQueryParams() {
 	res, err := suite.queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
 	suite.Require().NoError(err)
@@ -186,6 +253,74 @@ func (suite *IntegrationTestSuite) TestQueryParams() {
 	suite.Require().Equal(suite.app.BankKeeper.GetParams(suite.ctx), res.GetParams())
 }
 
+func (suite *IntegrationTestSuite) TestQuerySendEnabled() {
+	ctx, bankKeeper := suite.ctx, suite.app.BankKeeper
+
+	bankKeeper.SetSendEnabled(ctx, "falsestcoin", false)
+	bankKeeper.SetSendEnabled(ctx, "truestcoin", true)
+
+	tests := []struct {
+		req *types.QuerySendEnabledRequest
+		exp *types.QuerySendEnabledResponse
+	}{
+		{
+			req: &types.QuerySendEnabledRequest{},
+			exp: &types.QuerySendEnabledResponse{
+				SendEnabled: []*types.SendEnabled{
+					{Denom: "falsestcoin", Enabled: false},
+					{Denom: "truestcoin", Enabled: true},
+				},
+				Pagination: &query.PageResponse{
+					NextKey: nil,
+					Total:   2,
+				},
+			},
+		},
+		{
+			req: &types.QuerySendEnabledRequest{Denoms: []string{"truestcoin"}},
+			exp: &types.QuerySendEnabledResponse{
+				SendEnabled: []*types.SendEnabled{
+					{Denom: "truestcoin", Enabled: true},
+				},
+				Pagination: nil,
+			},
+		},
+		{
+			req: &types.QuerySendEnabledRequest{Denoms: []string{"falsestcoin", "unknowncoin", "truestcoin"}},
+			exp: &types.QuerySendEnabledResponse{
+				SendEnabled: []*types.SendEnabled{
+					{Denom: "falsestcoin", Enabled: false},
+					{Denom: "truestcoin", Enabled: true},
+				},
+				Pagination: nil,
+			},
+		},
+		{
+			req: &types.QuerySendEnabledRequest{Pagination: &query.PageRequest{Limit: 1, CountTotal: true}},
+			exp: &types.QuerySendEnabledResponse{
+				SendEnabled: []*types.SendEnabled{
+					{Denom: "falsestcoin", Enabled: false},
+				},
+				Pagination: &query.PageResponse{
+					NextKey: []byte("truestcoin"),
+					Total:   2,
+				},
+			},
+		},
+	}
+
+	for i, tc := range tests {
+		suite.Run(fmt.Sprintf("Case %d", i), func() {
+			resp, err := suite.queryClient.SendEnabled(gocontext.Background(), tc.req)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.exp, resp)
+		})
+	}
+
+	_, err := bankKeeper.SendEnabled(sdk.WrapSDKContext(ctx), nil)
+	suite.Require().Error(err)
+}
+
 func (suite *IntegrationTestSuite) QueryDenomsMetadataRequest() {
 	var (
 		req         *types.QueryDenomsMetadataRequest
@@ -31,6 +31,11 @@ type Keeper interface {
 	HasSupply(ctx sdk.Context, denom string) bool
 	GetPaginatedTotalSupply(ctx sdk.Context, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error)
 	IterateTotalSupply(ctx sdk.Context, cb func(sdk.Coin) bool)
+	GetBurnedSupply(ctx sdk.Context, denom string) sdk.Coin
+	GetPaginatedBurnedSupply(ctx sdk.Context, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error)
+	TrackSupplySnapshot(ctx sdk.Context)
+	GetSupplyAtHeight(ctx sdk.Context, height int64, denom string) (sdk.Coin, int64, bool)
+	GetPaginatedSupplyAtHeight(ctx sdk.Context, height int64, pagination *query.PageRequest) (sdk.Coins, int64, *query.PageResponse, bool, error)
 	GetDenomMetaData(ctx sdk.Context, denom string) (types.Metadata, bool)
 	HasDenomMetaData(ctx sdk.Context, denom string) bool
 	SetDenomMetaData(ctx sdk.Context, denomMetaData types.Metadata)
@@ -43,6 +48,7 @@ type Keeper interface {
 	UndelegateCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+	BurnAccountCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) error
 
 	DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error
 	UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error
@@ -57,7 +63,7 @@ type BaseKeeper struct {
 	ak                     types.AccountKeeper
 	cdc                    codec.BinaryCodec
 	storeKey               storetypes.StoreKey
-	paramSpace             paramtypes.Subspace
+	paramSpace             paramtypes.Subspace // legacy params subspace, only used by the migrations
 	mintCoinsRestrictionFn MintingRestrictionFn
 }
 
@@ -93,13 +99,16 @@ func (k BaseKeeper) GetPaginatedTotalSupply(ctx sdk.Context, pagination *query.P
 // store and fetch module parameters. The BaseKeeper also accepts a
 // blocklist map. This blocklist describes the set of addresses that are not allowed
 // to receive funds through direct and explicit actions, for example, by using a MsgSend or
-// by using a SendCoinsFromModuleToAccount execution.
+// by using a SendCoinsFromModuleToAccount execution. The authority is the
+// address allowed to execute the governance messages of the module, typically
+// the x/gov module account.
 func NewBaseKeeper(
 	cdc codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
 	ak types.AccountKeeper,
 	paramSpace paramtypes.Subspace,
 	blockedAddrs map[string]bool,
+	authority string,
 ) BaseKeeper {
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
@@ -107,7 +116,7 @@ func NewBaseKeeper(
 	}
 
 	return BaseKeeper{
-		BaseSendKeeper:         NewBaseSendKeeper(cdc, storeKey, ak, paramSpace, blockedAddrs),
+		BaseSendKeeper:         NewBaseSendKeeper(cdc, storeKey, ak, paramSpace, blockedAddrs, authority),
 		ak:                     ak,
 		cdc:                    cdc,
 		storeKey:               storeKey,
@@ -449,7 +458,41 @@ func (k BaseKeeper) BurnCoins(ctx sdk.Context, moduleName string, amounts sdk.Co
 		panic(sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "module account %s does not have permissions to burn tokens", moduleName))
 	}
 
-	err := k.subUnlockedCoins(ctx, acc.GetAddress(), amounts)
+	err := k.burnCoins(ctx, acc.GetAddress(), amounts)
+	if err != nil {
+		return err
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info("burned tokens from module account", "amount", amounts.String(), "from", moduleName)
+
+	return nil
+}
+
+// BurnAccountCoins burns coins from the given account, deleting them from its
+// balance and from the total supply. Unlike BurnCoins, it doesn't require a
+// module account with the Burner permission, so that any account can burn its
+// own unlocked coins.
+func (k BaseKeeper) BurnAccountCoins(ctx sdk.Context, addr sdk.AccAddress, amounts sdk.Coins) error {
+	if !amounts.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amounts.String())
+	}
+
+	err := k.burnCoins(ctx, addr, amounts)
+	if err != nil {
+		return err
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info("burned tokens from account", "amount", amounts.String(), "from", addr.String())
+
+	return nil
+}
+
+// burnCoins deletes the coins from the balance of the given address and from
+// the total supply, and adds them to the cumulative burned supply.
+func (k BaseKeeper) burnCoins(ctx sdk.Context, addr sdk.AccAddress, amounts sdk.Coins) error {
+	err := k.subUnlockedCoins(ctx, addr, amounts)
 	if err != nil {
 		return err
 	}
@@ -458,19 +501,77 @@ func (k BaseKeeper) BurnCoins(ctx sdk.Context, moduleName string, amounts sdk.Co
 		supply := k.GetSupply(ctx, amount.GetDenom())
 		supply = supply.Sub(amount)
 		k.setSupply(ctx, supply)
-	}
 
-	logger := k.Logger(ctx)
-	logger.Info("burned tokens from module account", "amount", amounts.String(), "from", moduleName)
+		burned := k.GetBurnedSupply(ctx, amount.GetDenom())
+		k.setBurnedSupply(ctx, burned.Add(amount))
+	}
 
 	// emit burn event
 	ctx.EventManager().EmitEvent(
-		types.NewCoinBurnEvent(acc.GetAddress(), amounts),
+		types.NewCoinBurnEvent(addr, amounts),
 	)
 
 	return nil
 }
 
+// GetBurnedSupply retrieves the cumulative burned amount of the given denom
+// from the store.
+func (k BaseKeeper) GetBurnedSupply(ctx sdk.Context, denom string) sdk.Coin {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BurnedSupplyPrefix)
+
+	bz := store.Get(conv.UnsafeStrToBytes(denom))
+	if bz == nil {
+		return sdk.NewCoin(denom, sdk.ZeroInt())
+	}
+
+	var amount math.Int
+	err := amount.Unmarshal(bz)
+	if err != nil {
+		panic(fmt.Errorf("unable to unmarshal burned supply value %v", err))
+	}
+
+	return sdk.NewCoin(denom, amount)
+}
+
+// GetPaginatedBurnedSupply queries for the cumulative burned amounts, with a
+// given pagination.
+func (k BaseKeeper) GetPaginatedBurnedSupply(ctx sdk.Context, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BurnedSupplyPrefix)
+
+	burned := sdk.NewCoins()
+
+	pageRes, err := query.Paginate(store, pagination, func(key, value []byte) error {
+		var amount math.Int
+		err := amount.Unmarshal(value)
+		if err != nil {
+			return fmt.Errorf("unable to convert amount string to Int %v", err)
+		}
+
+		burned = burned.Add(sdk.NewCoin(string(key), amount))
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return burned, pageRes, nil
+}
+
+// setBurnedSupply sets the cumulative burned amount of the given coin.
+func (k BaseKeeper) setBurnedSupply(ctx sdk.Context, coin sdk.Coin) {
+	intBytes, err := coin.Amount.Marshal()
+	if err != nil {
+		panic(fmt.Errorf("unable to marshal amount value %v", err))
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BurnedSupplyPrefix)
+	if coin.IsZero() {
+		store.Delete(conv.UnsafeStrToBytes(coin.GetDenom()))
+	} else {
+		store.Set([]byte(coin.GetDenom()), intBytes)
+	}
+}
+
 // setSupply sets the supply for the given coin
 func (k BaseKeeper) setSupply(ctx sdk.Context, coin sdk.Coin) {
 	intBytes, err := coin.Amount.Marshal()
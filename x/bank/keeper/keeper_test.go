@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -20,6 +21,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -83,11 +85,11 @@ func (suite *IntegrationTestSuite) initKeepersWithmAccPerms(blockedAddrs map[str
 	maccPerms[randomPerm] = []string{"random"}
 	authKeeper := authkeeper.NewAccountKeeper(
 		appCodec, app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName),
-		authtypes.ProtoBaseAccount, maccPerms, sdk.Bech32MainPrefix,
+		authtypes.ProtoBaseAccount, maccPerms, sdk.Bech32MainPrefix, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	keeper := keeper.NewBaseKeeper(
 		appCodec, app.GetKey(types.StoreKey), authKeeper,
-		app.GetSubspace(types.ModuleName), blockedAddrs,
+		app.GetSubspace(types.ModuleName), blockedAddrs, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	return authKeeper, keeper
@@ -307,6 +309,146 @@ func (suite *IntegrationTestSuite) TestSupply_BurnCoins() {
 	suite.Require().Equal(supplyAfterInflation.Sub(initCoins...), supplyAfterBurn)
 }
 
+func (suite *IntegrationTestSuite) TestSupply_BurnedSupply() {
+	ctx := suite.ctx
+	authKeeper, keeper := suite.initKeepersWithmAccPerms(make(map[string]bool))
+	authKeeper.SetModuleAccount(ctx, burnerAcc)
+
+	suite.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()), keeper.GetBurnedSupply(ctx, sdk.DefaultBondDenom))
+
+	suite.Require().NoError(keeper.MintCoins(ctx, authtypes.Minter, initCoins))
+	suite.Require().NoError(keeper.SendCoinsFromModuleToModule(ctx, authtypes.Minter, authtypes.Burner, initCoins))
+
+	half := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initTokens.QuoRaw(2)))
+	suite.Require().NoError(keeper.BurnCoins(ctx, authtypes.Burner, half))
+	suite.Require().Equal(half[0], keeper.GetBurnedSupply(ctx, sdk.DefaultBondDenom))
+
+	// the burned amounts accumulate
+	suite.Require().NoError(keeper.BurnCoins(ctx, authtypes.Burner, half))
+	suite.Require().Equal(initCoins[0], keeper.GetBurnedSupply(ctx, sdk.DefaultBondDenom))
+
+	// a failed burn isn't accounted
+	suite.Require().Error(keeper.BurnCoins(ctx, authtypes.Burner, half))
+	suite.Require().Equal(initCoins[0], keeper.GetBurnedSupply(ctx, sdk.DefaultBondDenom))
+
+	burned, _, err := keeper.GetPaginatedBurnedSupply(ctx, &query.PageRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(initCoins, burned)
+}
+
+func (suite *IntegrationTestSuite) TestTrackSupplySnapshot() {
+	app := suite.app
+	ctx := suite.ctx.WithBlockHeight(1)
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+
+	// supply snapshots are disabled by default
+	app.BankKeeper.TrackSupplySnapshot(ctx)
+	_, _, found := app.BankKeeper.GetSupplyAtHeight(ctx, 1, sdk.DefaultBondDenom)
+	suite.Require().False(found)
+
+	params := app.BankKeeper.GetParams(ctx)
+	params.SupplySnapshotInterval = 10
+	params.SupplySnapshotKeepRecent = 2
+	app.BankKeeper.SetParams(ctx, params)
+
+	supplies := make(map[int64]sdk.Coin)
+	for height := int64(1); height <= 30; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("snapcoin", 5))))
+		app.BankKeeper.TrackSupplySnapshot(ctx)
+		supplies[height] = app.BankKeeper.GetSupply(ctx, "snapcoin")
+	}
+
+	// the snapshot taken at height 10 is pruned
+	_, _, found = app.BankKeeper.GetSupplyAtHeight(ctx, 15, "snapcoin")
+	suite.Require().False(found)
+
+	coin, snapshotHeight, found := app.BankKeeper.GetSupplyAtHeight(ctx, 25, "snapcoin")
+	suite.Require().True(found)
+	suite.Require().Equal(int64(20), snapshotHeight)
+	suite.Require().Equal(supplies[20], coin)
+
+	coin, snapshotHeight, found = app.BankKeeper.GetSupplyAtHeight(ctx, 30, "snapcoin")
+	suite.Require().True(found)
+	suite.Require().Equal(int64(30), snapshotHeight)
+	suite.Require().Equal(supplies[30], coin)
+
+	// denoms missing from a snapshot have a zero supply
+	coin, _, found = app.BankKeeper.GetSupplyAtHeight(ctx, 30, "nonexistent")
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt64Coin("nonexistent", 0), coin)
+
+	supply, snapshotHeight, _, found, err := app.BankKeeper.GetPaginatedSupplyAtHeight(ctx, 100, &query.PageRequest{})
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+	suite.Require().Equal(int64(30), snapshotHeight)
+	total, _, err := app.BankKeeper.GetPaginatedTotalSupply(ctx, &query.PageRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(total, supply)
+}
+
+func (suite *IntegrationTestSuite) TestBurnAccountCoins() {
+	app, ctx := suite.app, suite.ctx
+	now := tmtime.Now()
+	ctx = ctx.WithBlockHeader(tmproto.Header{Time: now})
+	endTime := now.Add(24 * time.Hour)
+
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	burnCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, origCoins))
+	supplyBefore := app.BankKeeper.GetSupply(ctx, "stake")
+
+	suite.Require().NoError(app.BankKeeper.BurnAccountCoins(ctx, addr1, burnCoins))
+	suite.Require().Equal(burnCoins, app.BankKeeper.GetAllBalances(ctx, addr1))
+	suite.Require().Equal(supplyBefore.Sub(burnCoins[0]), app.BankKeeper.GetSupply(ctx, "stake"))
+	suite.Require().Equal(burnCoins[0], app.BankKeeper.GetBurnedSupply(ctx, "stake"))
+
+	// cannot burn more than the balance
+	suite.Require().Error(app.BankKeeper.BurnAccountCoins(ctx, addr1, origCoins))
+	suite.Require().Error(app.BankKeeper.BurnAccountCoins(ctx, addr1, sdk.Coins{sdk.NewInt64Coin("stake", 0)}))
+
+	// cannot burn locked vesting coins
+	bacc := authtypes.NewBaseAccountWithAddress(addr2)
+	vacc := vesting.NewContinuousVestingAccount(bacc, origCoins, now.Unix(), endTime.Unix())
+	app.AccountKeeper.SetAccount(ctx, vacc)
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr2, origCoins))
+	suite.Require().Error(app.BankKeeper.BurnAccountCoins(ctx, addr2, burnCoins))
+
+	ctx = ctx.WithBlockTime(now.Add(12 * time.Hour))
+	suite.Require().NoError(app.BankKeeper.BurnAccountCoins(ctx, addr2, burnCoins))
+	suite.Require().Equal(burnCoins, app.BankKeeper.GetAllBalances(ctx, addr2))
+	suite.Require().Equal(origCoins[0], app.BankKeeper.GetBurnedSupply(ctx, "stake"))
+}
+
+func (suite *IntegrationTestSuite) TestMsgBurn() {
+	app, ctx := suite.app, suite.ctx
+	msgServer := keeper.NewMsgServerImpl(app.BankKeeper)
+
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr, origCoins))
+
+	_, err := msgServer.Burn(sdk.WrapSDKContext(ctx), types.NewMsgBurn(addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 101))))
+	suite.Require().Error(err)
+
+	_, err = msgServer.Burn(sdk.WrapSDKContext(ctx), types.NewMsgBurn(addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 40))))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 60)), app.BankKeeper.GetAllBalances(ctx, addr))
+	suite.Require().Equal(sdk.NewInt64Coin("stake", 40), app.BankKeeper.GetBurnedSupply(ctx, "stake"))
+
+	var burnEvent bool
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		if event.Type == types.EventTypeCoinBurn {
+			burnEvent = true
+		}
+	}
+	suite.Require().True(burnEvent)
+}
+
 func (suite *IntegrationTestSuite) TestSendCoinsNewAccount() {
 	app, ctx := suite.app, suite.ctx
 	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
@@ -527,6 +669,157 @@ func (suite *IntegrationTestSuite) TestSendEnabled() {
 	suite.Require().Error(err)
 }
 
+func (suite *IntegrationTestSuite) TestSendEnabledEntries() {
+	app, ctx := suite.app, suite.ctx
+	bankKeeper := app.BankKeeper
+
+	bankKeeper.SetParams(ctx, types.NewParams(true, nil))
+
+	_, found := bankKeeper.GetSendEnabledEntry(ctx, "foocoin")
+	suite.Require().False(found)
+	suite.Require().True(bankKeeper.IsSendEnabledDenom(ctx, "foocoin"))
+
+	bankKeeper.SetSendEnabled(ctx, "foocoin", false)
+	bankKeeper.SetAllSendEnabled(ctx, []*types.SendEnabled{
+		types.NewSendEnabled("barcoin", true),
+		types.NewSendEnabled("bazcoin", false),
+	})
+
+	entry, found := bankKeeper.GetSendEnabledEntry(ctx, "foocoin")
+	suite.Require().True(found)
+	suite.Require().Equal(types.SendEnabled{Denom: "foocoin", Enabled: false}, entry)
+	suite.Require().False(bankKeeper.IsSendEnabledDenom(ctx, "foocoin"))
+	suite.Require().True(bankKeeper.IsSendEnabledDenom(ctx, "barcoin"))
+	suite.Require().False(bankKeeper.IsSendEnabledDenom(ctx, "bazcoin"))
+
+	// the entries override the default in both directions
+	bankKeeper.SetParams(ctx, types.NewParams(false, nil))
+	suite.Require().True(bankKeeper.IsSendEnabledDenom(ctx, "barcoin"))
+	suite.Require().False(bankKeeper.IsSendEnabledDenom(ctx, "quxcoin"))
+
+	suite.Require().Equal([]types.SendEnabled{
+		{Denom: "barcoin", Enabled: true},
+		{Denom: "bazcoin", Enabled: false},
+		{Denom: "foocoin", Enabled: false},
+	}, bankKeeper.GetAllSendEnabledEntries(ctx))
+
+	var iterated []string
+	bankKeeper.IterateSendEnabledEntries(ctx, func(denom string, _ bool) bool {
+		iterated = append(iterated, denom)
+		return len(iterated) == 2
+	})
+	suite.Require().Equal([]string{"barcoin", "bazcoin"}, iterated)
+
+	// deleting an entry restores the default, unknown denoms are ignored
+	bankKeeper.DeleteSendEnabled(ctx, "barcoin", "quxcoin")
+	_, found = bankKeeper.GetSendEnabledEntry(ctx, "barcoin")
+	suite.Require().False(found)
+	suite.Require().False(bankKeeper.IsSendEnabledDenom(ctx, "barcoin"))
+	suite.Require().Len(bankKeeper.GetAllSendEnabledEntries(ctx), 2)
+}
+
+func (suite *IntegrationTestSuite) TestSetParamsMovesSendEnabled() {
+	app, ctx := suite.app, suite.ctx
+
+	params := types.NewParams(true, types.SendEnabledParams{types.NewSendEnabled("foocoin", false)})
+	app.BankKeeper.SetParams(ctx, params)
+
+	suite.Require().Empty(app.BankKeeper.GetParams(ctx).SendEnabled)
+	entry, found := app.BankKeeper.GetSendEnabledEntry(ctx, "foocoin")
+	suite.Require().True(found)
+	suite.Require().False(entry.Enabled)
+}
+
+func (suite *IntegrationTestSuite) TestMsgSetSendEnabled() {
+	app, ctx := suite.app, suite.ctx
+	msgServer := keeper.NewMsgServerImpl(app.BankKeeper)
+	authority := app.BankKeeper.GetAuthority()
+
+	app.BankKeeper.SetSendEnabled(ctx, "barcoin", false)
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgSetSendEnabled
+		expErr   bool
+		expected map[string]bool
+	}{
+		{
+			name:   "invalid authority",
+			msg:    types.NewMsgSetSendEnabled(suite.app.AccountKeeper.GetModuleAddress(minttypes.ModuleName).String(), []*types.SendEnabled{types.NewSendEnabled("foocoin", false)}, nil),
+			expErr: true,
+		},
+		{
+			name:     "add an entry",
+			msg:      types.NewMsgSetSendEnabled(authority, []*types.SendEnabled{types.NewSendEnabled("foocoin", false)}, nil),
+			expected: map[string]bool{"foocoin": false, "barcoin": false},
+		},
+		{
+			name:     "update and delete entries",
+			msg:      types.NewMsgSetSendEnabled(authority, []*types.SendEnabled{types.NewSendEnabled("foocoin", true)}, []string{"barcoin", "bazcoin"}),
+			expected: map[string]bool{"foocoin": true},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := msgServer.SetSendEnabled(sdk.WrapSDKContext(ctx), tc.msg)
+			if tc.expErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			entries := app.BankKeeper.GetAllSendEnabledEntries(ctx)
+			suite.Require().Len(entries, len(tc.expected))
+			for _, entry := range entries {
+				suite.Require().Equal(tc.expected[entry.Denom], entry.Enabled, entry.Denom)
+			}
+		})
+	}
+}
+
+func (suite *IntegrationTestSuite) TestMsgUpdateParams() {
+	app, ctx := suite.app, suite.ctx
+	msgServer := keeper.NewMsgServerImpl(app.BankKeeper)
+
+	params := types.DefaultParams()
+	params.DefaultSendEnabled = false
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgUpdateParams
+		expErr bool
+	}{
+		{
+			name: "invalid authority",
+			msg: &types.MsgUpdateParams{
+				Authority: app.AccountKeeper.GetModuleAddress(minttypes.ModuleName).String(),
+				Params:    params,
+			},
+			expErr: true,
+		},
+		{
+			name: "all good",
+			msg: &types.MsgUpdateParams{
+				Authority: app.BankKeeper.GetAuthority(),
+				Params:    params,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), tc.msg)
+			if tc.expErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(params.DefaultSendEnabled, app.BankKeeper.GetParams(ctx).DefaultSendEnabled)
+		})
+	}
+}
+
 func (suite *IntegrationTestSuite) TestHasBalance() {
 	app, ctx := suite.app, suite.ctx
 	addr := sdk.AccAddress([]byte("addr1_______________"))
@@ -1050,11 +1343,11 @@ func (suite *IntegrationTestSuite) TestBalanceTrackingEvents() {
 
 	suite.app.AccountKeeper = authkeeper.NewAccountKeeper(
 		suite.app.AppCodec(), suite.app.GetKey(authtypes.StoreKey), suite.app.GetSubspace(authtypes.ModuleName),
-		authtypes.ProtoBaseAccount, maccPerms, sdk.Bech32MainPrefix,
+		authtypes.ProtoBaseAccount, maccPerms, sdk.Bech32MainPrefix, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	suite.app.BankKeeper = keeper.NewBaseKeeper(suite.app.AppCodec(), suite.app.GetKey(types.StoreKey),
-		suite.app.AccountKeeper, suite.app.GetSubspace(types.ModuleName), nil)
+		suite.app.AccountKeeper, suite.app.GetSubspace(types.ModuleName), nil, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 	// set account with multiple permissions
 	suite.app.AccountKeeper.SetModuleAccount(suite.ctx, multiPermAcc)
@@ -1176,7 +1469,7 @@ func (suite *IntegrationTestSuite) TestMintCoinRestrictions() {
 
 	suite.app.AccountKeeper = authkeeper.NewAccountKeeper(
 		suite.app.AppCodec(), suite.app.GetKey(authtypes.StoreKey), suite.app.GetSubspace(authtypes.ModuleName),
-		authtypes.ProtoBaseAccount, maccPerms, sdk.Bech32MainPrefix,
+		authtypes.ProtoBaseAccount, maccPerms, sdk.Bech32MainPrefix, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	suite.app.AccountKeeper.SetModuleAccount(suite.ctx, multiPermAcc)
 
@@ -1215,7 +1508,7 @@ func (suite *IntegrationTestSuite) TestMintCoinRestrictions() {
 
 	for _, test := range tests {
 		suite.app.BankKeeper = keeper.NewBaseKeeper(suite.app.AppCodec(), suite.app.GetKey(types.StoreKey),
-			suite.app.AccountKeeper, suite.app.GetSubspace(types.ModuleName), nil).WithMintCoinsRestriction(keeper.MintingRestrictionFn(test.restrictionFn))
+			suite.app.AccountKeeper, suite.app.GetSubspace(types.ModuleName), nil, authtypes.NewModuleAddress(govtypes.ModuleName).String()).WithMintCoinsRestriction(keeper.MintingRestrictionFn(test.restrictionFn))
 		for _, testCase := range test.testCases {
 			if testCase.expectPass {
 				suite.Require().NoError(
@@ -1241,3 +1534,164 @@ func (suite *IntegrationTestSuite) TestMintCoinRestrictions() {
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
+
+func (suite *IntegrationTestSuite) TestSendRestrictions() {
+	app, ctx := suite.app, suite.ctx
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	addr3 := sdk.AccAddress("addr3_______________")
+	sanctioned := sdk.AccAddress("sanctioned__________")
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, balances))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, sanctioned, balances))
+
+	// a copy of the keeper, as held by other modules, shares the restrictions
+	bankKeeper := app.BankKeeper
+
+	var calls int
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		calls++
+		if fromAddr.Equals(sanctioned) || toAddr.Equals(sanctioned) {
+			return nil, fmt.Errorf("%s is sanctioned", sanctioned)
+		}
+		return toAddr, nil
+	})
+	// redirect the transfers to addr2 to addr3, before the sanctions check
+	app.BankKeeper.PrependSendRestriction(func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		if toAddr.Equals(addr2) {
+			return addr3, nil
+		}
+		return toAddr, nil
+	})
+
+	sendAmt := sdk.NewCoins(newFooCoin(10))
+	suite.Require().Error(bankKeeper.SendCoins(ctx, sanctioned, addr1, sendAmt))
+	suite.Require().Error(bankKeeper.SendCoins(ctx, addr1, sanctioned, sendAmt))
+	suite.Require().Equal(balances, app.BankKeeper.GetAllBalances(ctx, sanctioned))
+	suite.Require().Equal(2, calls)
+
+	suite.Require().NoError(bankKeeper.SendCoins(ctx, addr1, addr2, sendAmt))
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, addr2).IsZero())
+	suite.Require().Equal(sendAmt, app.BankKeeper.GetAllBalances(ctx, addr3))
+	suite.Require().True(app.AccountKeeper.HasAccount(ctx, addr3))
+
+	// the module account paths are restricted as well
+	suite.Require().NoError(bankKeeper.SendCoinsFromAccountToModule(ctx, addr1, minttypes.ModuleName, sendAmt))
+	suite.Require().Error(bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, sanctioned, sendAmt))
+
+	// the restrictions can be bypassed by the context
+	suite.Require().NoError(bankKeeper.SendCoinsFromModuleToAccount(types.WithBypass(ctx), minttypes.ModuleName, sanctioned, sendAmt))
+
+	app.BankKeeper.ClearSendRestriction()
+	suite.Require().NoError(bankKeeper.SendCoins(ctx, sanctioned, addr2, sendAmt))
+	suite.Require().Equal(sendAmt, app.BankKeeper.GetAllBalances(ctx, addr2))
+}
+
+func (suite *IntegrationTestSuite) TestSendRestrictionBlockedRecipient() {
+	app, ctx := suite.app, suite.ctx
+	balances := sdk.NewCoins(newFooCoin(100))
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	mintAddr := authtypes.NewModuleAddress(minttypes.ModuleName)
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, balances))
+	suite.Require().True(app.BankKeeper.BlockedAddr(mintAddr))
+
+	// redirect the transfers to addr2 to a blocked module account
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		if toAddr.Equals(addr2) {
+			return mintAddr, nil
+		}
+		return toAddr, nil
+	})
+	defer app.BankKeeper.ClearSendRestriction()
+
+	sendAmt := sdk.NewCoins(newFooCoin(10))
+	suite.Require().ErrorIs(app.BankKeeper.SendCoins(ctx, addr1, addr2, sendAmt), sdkerrors.ErrUnauthorized)
+
+	inputs := []types.Input{{Address: addr1.String(), Coins: sendAmt}}
+	outputs := []types.Output{{Address: addr2.String(), Coins: sendAmt}}
+	suite.Require().ErrorIs(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs), sdkerrors.ErrUnauthorized)
+	suite.Require().Equal(balances, app.BankKeeper.GetAllBalances(ctx, addr1))
+
+	// the module account can still receive the coins sent to it directly
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromAccountToModule(ctx, addr1, minttypes.ModuleName, sendAmt))
+}
+
+func (suite *IntegrationTestSuite) TestInputOutputCoinsSendRestrictions() {
+	app, ctx := suite.app, suite.ctx
+	balances := sdk.NewCoins(newFooCoin(100))
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	addr3 := sdk.AccAddress("addr3_______________")
+	addr4 := sdk.AccAddress("addr4_______________")
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, balances))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr2, balances))
+
+	type restrictionArgs struct {
+		fromAddr, toAddr sdk.AccAddress
+		amt              sdk.Coins
+	}
+	var calls []restrictionArgs
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		calls = append(calls, restrictionArgs{fromAddr, toAddr, amt})
+		if toAddr.Equals(addr4) {
+			return nil, fmt.Errorf("%s cannot receive funds", addr4)
+		}
+		if toAddr.Equals(addr3) {
+			return addr4, nil
+		}
+		return toAddr, nil
+	})
+	defer app.BankKeeper.ClearSendRestriction()
+
+	// the restriction is applied once to each output, with the original
+	// recipient, and the redirected address is not checked again
+	inputs := []types.Input{{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(20))}}
+	outputs := []types.Output{
+		{Address: addr2.String(), Coins: sdk.NewCoins(newFooCoin(10))},
+		{Address: addr3.String(), Coins: sdk.NewCoins(newFooCoin(10))},
+	}
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().Equal([]restrictionArgs{
+		{addr1, addr2, sdk.NewCoins(newFooCoin(10))},
+		{addr1, addr3, sdk.NewCoins(newFooCoin(10))},
+	}, calls)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(110)), app.BankKeeper.GetAllBalances(ctx, addr2))
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, addr3).IsZero())
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(10)), app.BankKeeper.GetAllBalances(ctx, addr4))
+
+	// nothing moves if a transfer is rejected
+	calls = nil
+	outputs = []types.Output{
+		{Address: addr2.String(), Coins: sdk.NewCoins(newFooCoin(10))},
+		{Address: addr4.String(), Coins: sdk.NewCoins(newFooCoin(10))},
+	}
+	suite.Require().Error(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().Len(calls, 2)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(80)), app.BankKeeper.GetAllBalances(ctx, addr1))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(110)), app.BankKeeper.GetAllBalances(ctx, addr2))
+
+	// a multi-send with several inputs cannot be restricted, unless the
+	// context bypasses the restriction
+	calls = nil
+	inputs = []types.Input{
+		{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(10))},
+		{Address: addr2.String(), Coins: sdk.NewCoins(newFooCoin(10))},
+	}
+	outputs = []types.Output{{Address: addr3.String(), Coins: sdk.NewCoins(newFooCoin(20))}}
+	suite.Require().ErrorIs(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs), types.ErrMultipleSenders)
+	suite.Require().Empty(calls)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(80)), app.BankKeeper.GetAllBalances(ctx, addr1))
+
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(types.WithBypass(ctx), inputs, outputs))
+	suite.Require().Empty(calls)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(20)), app.BankKeeper.GetAllBalances(ctx, addr3))
+
+	// without a restriction, a multi-send can have several inputs
+	app.BankKeeper.ClearSendRestriction()
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(40)), app.BankKeeper.GetAllBalances(ctx, addr3))
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -23,10 +25,23 @@ type SendKeeper interface {
 	GetParams(ctx sdk.Context) types.Params
 	SetParams(ctx sdk.Context, params types.Params)
 
+	IsSendEnabledDenom(ctx sdk.Context, denom string) bool
+	GetSendEnabledEntry(ctx sdk.Context, denom string) (types.SendEnabled, bool)
+	SetSendEnabled(ctx sdk.Context, denom string, value bool)
+	SetAllSendEnabled(ctx sdk.Context, sendEnableds []*types.SendEnabled)
+	DeleteSendEnabled(ctx sdk.Context, denoms ...string)
+	IterateSendEnabledEntries(ctx sdk.Context, cb func(denom string, sendEnabled bool) (stop bool))
+	GetAllSendEnabledEntries(ctx sdk.Context) []types.SendEnabled
+
 	IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool
 	IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
 
 	BlockedAddr(addr sdk.AccAddress) bool
+	GetAuthority() string
+
+	AppendSendRestriction(restriction types.SendRestrictionFn)
+	PrependSendRestriction(restriction types.SendRestrictionFn)
+	ClearSendRestriction()
 }
 
 var _ SendKeeper = (*BaseSendKeeper)(nil)
@@ -39,34 +54,85 @@ type BaseSendKeeper struct {
 	cdc        codec.BinaryCodec
 	ak         types.AccountKeeper
 	storeKey   storetypes.StoreKey
-	paramSpace paramtypes.Subspace
+	paramSpace paramtypes.Subspace // legacy params subspace, only used by the migrations
 
 	// list of addresses that are restricted from receiving transactions
 	blockedAddrs map[string]bool
+
+	// the address capable of executing the MsgSetSendEnabled and MsgUpdateParams
+	// messages. Typically, this should be the x/gov module account.
+	authority string
+
+	// sendRestriction is shared by the copies of the keeper, so that the
+	// restrictions added after the keeper is passed to other modules apply
+	sendRestriction *sendRestriction
 }
 
 func NewBaseSendKeeper(
-	cdc codec.BinaryCodec, storeKey storetypes.StoreKey, ak types.AccountKeeper, paramSpace paramtypes.Subspace, blockedAddrs map[string]bool,
+	cdc codec.BinaryCodec, storeKey storetypes.StoreKey, ak types.AccountKeeper, paramSpace paramtypes.Subspace, blockedAddrs map[string]bool, authority string,
 ) BaseSendKeeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Errorf("invalid bank authority address: %w", err))
+	}
+
 	return BaseSendKeeper{
-		BaseViewKeeper: NewBaseViewKeeper(cdc, storeKey, ak),
-		cdc:            cdc,
-		ak:             ak,
-		storeKey:       storeKey,
-		paramSpace:     paramSpace,
-		blockedAddrs:   blockedAddrs,
+		BaseViewKeeper:  NewBaseViewKeeper(cdc, storeKey, ak),
+		cdc:             cdc,
+		ak:              ak,
+		storeKey:        storeKey,
+		paramSpace:      paramSpace,
+		blockedAddrs:    blockedAddrs,
+		authority:       authority,
+		sendRestriction: newSendRestriction(),
 	}
 }
 
+// AppendSendRestriction adds the provided SendRestrictionFn to run after the
+// previously provided restrictions.
+func (k BaseSendKeeper) AppendSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendRestriction.append(restriction)
+}
+
+// PrependSendRestriction adds the provided SendRestrictionFn to run before the
+// previously provided restrictions.
+func (k BaseSendKeeper) PrependSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendRestriction.prepend(restriction)
+}
+
+// ClearSendRestriction removes the send restriction (if there is one).
+func (k BaseSendKeeper) ClearSendRestriction() {
+	k.sendRestriction.clear()
+}
+
 // GetParams returns the total set of bank parameters.
 func (k BaseSendKeeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
 	return params
 }
 
+// GetAuthority returns the x/bank module's authority.
+func (k BaseSendKeeper) GetAuthority() string {
+	return k.authority
+}
+
 // SetParams sets the total set of bank parameters.
+//
+// Note: the deprecated params.SendEnabled entries are moved to the SendEnabled
+// store entries and are not kept in the params.
 func (k BaseSendKeeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramSpace.SetParamSet(ctx, &params)
+	if len(params.SendEnabled) > 0 {
+		k.SetAllSendEnabled(ctx, params.SendEnabled)
+		params.SendEnabled = nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ParamsKey, k.cdc.MustMarshal(&params))
 }
 
 // InputOutputCoins performs multi-send functionality. It accepts a series of
@@ -79,6 +145,31 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		return err
 	}
 
+	// the send restriction is applied once per output with the single input as
+	// the sender, so a multi-send with several inputs cannot be restricted
+	if len(inputs) > 1 && k.sendRestriction.isSet(ctx) {
+		return types.ErrMultipleSenders
+	}
+
+	inAddress, err := sdk.AccAddressFromBech32(inputs[0].Address)
+	if err != nil {
+		return err
+	}
+
+	// apply the send restriction to every output before moving any coin
+	outAddresses := make([]sdk.AccAddress, len(outputs))
+	for i, out := range outputs {
+		outAddress, err := sdk.AccAddressFromBech32(out.Address)
+		if err != nil {
+			return err
+		}
+
+		outAddresses[i], err = k.applySendRestriction(ctx, inAddress, outAddress, out.Coins)
+		if err != nil {
+			return err
+		}
+	}
+
 	for _, in := range inputs {
 		inAddress, err := sdk.AccAddressFromBech32(in.Address)
 		if err != nil {
@@ -98,12 +189,9 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		)
 	}
 
-	for _, out := range outputs {
-		outAddress, err := sdk.AccAddressFromBech32(out.Address)
-		if err != nil {
-			return err
-		}
-		err = k.addCoins(ctx, outAddress, out.Coins)
+	for i, out := range outputs {
+		outAddress := outAddresses[i]
+		err := k.addCoins(ctx, outAddress, out.Coins)
 		if err != nil {
 			return err
 		}
@@ -111,7 +199,7 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeTransfer,
-				sdk.NewAttribute(types.AttributeKeyRecipient, out.Address),
+				sdk.NewAttribute(types.AttributeKeyRecipient, outAddress.String()),
 				sdk.NewAttribute(sdk.AttributeKeyAmount, out.Coins.String()),
 			),
 		)
@@ -131,9 +219,15 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 }
 
 // SendCoins transfers amt coins from a sending account to a receiving account.
-// An error is returned upon failure.
+// The send restrictions may redirect the coins to another account or reject the
+// transfer. An error is returned upon failure.
 func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
-	err := k.subUnlockedCoins(ctx, fromAddr, amt)
+	toAddr, err := k.applySendRestriction(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return err
+	}
+
+	err = k.subUnlockedCoins(ctx, fromAddr, amt)
 	if err != nil {
 		return err
 	}
@@ -314,7 +408,110 @@ func (k BaseSendKeeper) IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) e
 
 // IsSendEnabledCoin returns the current SendEnabled status of the provided coin's denom
 func (k BaseSendKeeper) IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool {
-	return k.GetParams(ctx).SendEnabledDenom(coin.Denom)
+	return k.IsSendEnabledDenom(ctx, coin.Denom)
+}
+
+// IsSendEnabledDenom returns the current SendEnabled status of the provided
+// denom. A denom without a SendEnabled entry uses params.DefaultSendEnabled.
+func (k BaseSendKeeper) IsSendEnabledDenom(ctx sdk.Context, denom string) bool {
+	return k.getSendEnabledOrDefault(ctx.KVStore(k.storeKey), denom, func() bool {
+		return k.GetParams(ctx).DefaultSendEnabled
+	})
+}
+
+// GetSendEnabledEntry gets a SendEnabled entry for the given denom.
+// The second return argument is true iff a specific entry exists for the given denom.
+func (k BaseSendKeeper) GetSendEnabledEntry(ctx sdk.Context, denom string) (types.SendEnabled, bool) {
+	sendEnabled, found := k.getSendEnabled(ctx.KVStore(k.storeKey), denom)
+	if !found {
+		return types.SendEnabled{}, false
+	}
+
+	return types.SendEnabled{Denom: denom, Enabled: sendEnabled}, true
+}
+
+// SetSendEnabled sets the SendEnabled flag for a denom to the provided value.
+func (k BaseSendKeeper) SetSendEnabled(ctx sdk.Context, denom string, value bool) {
+	store := ctx.KVStore(k.storeKey)
+	k.setSendEnabledEntry(store, denom, value)
+}
+
+// SetAllSendEnabled sets all the provided SendEnabled entries in the bank store.
+func (k BaseSendKeeper) SetAllSendEnabled(ctx sdk.Context, sendEnableds []*types.SendEnabled) {
+	store := ctx.KVStore(k.storeKey)
+	for _, se := range sendEnableds {
+		k.setSendEnabledEntry(store, se.Denom, se.Enabled)
+	}
+}
+
+// DeleteSendEnabled deletes the SendEnabled flags for one or more denoms.
+// If a denom is provided that doesn't have a SendEnabled flag, it is ignored.
+func (k BaseSendKeeper) DeleteSendEnabled(ctx sdk.Context, denoms ...string) {
+	store := ctx.KVStore(k.storeKey)
+	for _, denom := range denoms {
+		store.Delete(types.CreateSendEnabledKey(denom))
+	}
+}
+
+// IterateSendEnabledEntries iterates over all the SendEnabled entries.
+func (k BaseSendKeeper) IterateSendEnabledEntries(ctx sdk.Context, cb func(denom string, sendEnabled bool) bool) {
+	seStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SendEnabledPrefix)
+
+	iterator := seStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		denom := string(iterator.Key())
+		val := types.IsTrueB(iterator.Value())
+		if cb(denom, val) {
+			break
+		}
+	}
+}
+
+// GetAllSendEnabledEntries gets all the SendEnabled entries that are stored.
+// Any denominations not returned use the default value (set in Params).
+func (k BaseSendKeeper) GetAllSendEnabledEntries(ctx sdk.Context) []types.SendEnabled {
+	var rv []types.SendEnabled
+	k.IterateSendEnabledEntries(ctx, func(denom string, sendEnabled bool) bool {
+		rv = append(rv, types.SendEnabled{Denom: denom, Enabled: sendEnabled})
+		return false
+	})
+
+	return rv
+}
+
+// getSendEnabled returns the SendEnabled status of the provided denom and whether
+// or not it was found. If not found, false is returned.
+func (k BaseSendKeeper) getSendEnabled(store sdk.KVStore, denom string) (bool, bool) {
+	key := types.CreateSendEnabledKey(denom)
+	if !store.Has(key) {
+		return false, false
+	}
+
+	bz := store.Get(key)
+	if bz == nil {
+		return false, false
+	}
+
+	return types.IsTrueB(bz), true
+}
+
+// getSendEnabledOrDefault gets the SendEnabled value for a denom. If it's not
+// in the store, this will return the result of the getDefault function.
+func (k BaseSendKeeper) getSendEnabledOrDefault(store sdk.KVStore, denom string, getDefault func() bool) bool {
+	sendEnabled, found := k.getSendEnabled(store, denom)
+	if found {
+		return sendEnabled
+	}
+
+	return getDefault()
+}
+
+// setSendEnabledEntry sets SendEnabled for the given denom to the give value in the provided store.
+func (k BaseSendKeeper) setSendEnabledEntry(store sdk.KVStore, denom string, value bool) {
+	key := types.CreateSendEnabledKey(denom)
+	store.Set(key, types.ToBoolB(value))
 }
 
 // BlockedAddr checks if a given address is restricted from
@@ -322,3 +519,66 @@ func (k BaseSendKeeper) IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool {
 func (k BaseSendKeeper) BlockedAddr(addr sdk.AccAddress) bool {
 	return k.blockedAddrs[addr.String()]
 }
+
+// applySendRestriction applies the send restriction to a transfer and returns
+// the recipient of the coins. A recipient set by the restriction is rejected if
+// it is blocked from receiving funds.
+func (k BaseSendKeeper) applySendRestriction(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	newToAddr, err := k.sendRestriction.apply(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return nil, err
+	}
+
+	if !newToAddr.Equals(toAddr) && k.BlockedAddr(newToAddr) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", newToAddr)
+	}
+
+	return newToAddr, nil
+}
+
+// sendRestriction is a struct that houses a SendRestrictionFn.
+// It exists so that the SendRestrictionFn can be updated in the SendKeeper
+// without needing to have a pointer to the SendKeeper.
+type sendRestriction struct {
+	fn types.SendRestrictionFn
+}
+
+// newSendRestriction creates a new sendRestriction with nil send restriction.
+func newSendRestriction() *sendRestriction {
+	return &sendRestriction{
+		fn: nil,
+	}
+}
+
+// append adds the provided restriction to this, to be run after the existing function.
+func (r *sendRestriction) append(restriction types.SendRestrictionFn) {
+	r.fn = r.fn.Then(restriction)
+}
+
+// prepend adds the provided restriction to this, to be run before the existing function.
+func (r *sendRestriction) prepend(restriction types.SendRestrictionFn) {
+	r.fn = restriction.Then(r.fn)
+}
+
+// clear removes the send restriction (sets it to nil).
+func (r *sendRestriction) clear() {
+	r.fn = nil
+}
+
+var _ types.SendRestrictionFn = (*sendRestriction)(nil).apply
+
+// isSet returns true if there is a send restriction and the context doesn't
+// bypass it.
+func (r *sendRestriction) isSet(ctx sdk.Context) bool {
+	return r != nil && r.fn != nil && !types.HasBypass(ctx)
+}
+
+// apply applies the send restriction if there is one. If not, it's a no-op.
+// The restrictions are skipped if the context bypasses them.
+func (r *sendRestriction) apply(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	if !r.isSet(ctx) {
+		return toAddr, nil
+	}
+
+	return r.fn(ctx, fromAddr, toAddr, amt)
+}
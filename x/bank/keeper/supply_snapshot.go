@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"fmt"
+	gomath "math"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// TrackSupplySnapshot records a snapshot of the total supply at the current
+// block height if supply snapshots are enabled and the height is a multiple of
+// the snapshot interval. Snapshots falling out of the retention window are
+// pruned afterwards.
+func (k BaseKeeper) TrackSupplySnapshot(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if !params.SupplySnapshotEnabled() {
+		return
+	}
+
+	height := ctx.BlockHeight()
+	interval := int64(params.SupplySnapshotInterval)
+	if height <= 0 || height%interval != 0 {
+		return
+	}
+
+	k.SnapshotSupply(ctx)
+
+	if params.SupplySnapshotKeepRecent == 0 {
+		return
+	}
+
+	// keep the snapshot taken at this height along with the previous
+	// SupplySnapshotKeepRecent-1 ones.
+	retain := int64(params.SupplySnapshotKeepRecent-1) * interval
+	if retain < height {
+		k.PruneSupplySnapshots(ctx, height-retain)
+	}
+}
+
+// SnapshotSupply records the total supply of every denom at the current block
+// height.
+func (k BaseKeeper) SnapshotSupply(ctx sdk.Context) {
+	height := ctx.BlockHeight()
+	store := ctx.KVStore(k.storeKey)
+
+	k.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+		intBytes, err := coin.Amount.Marshal()
+		if err != nil {
+			panic(fmt.Errorf("unable to marshal amount value %v", err))
+		}
+
+		store.Set(types.CreateSupplySnapshotKey(height, coin.Denom), intBytes)
+		return false
+	})
+
+	k.Logger(ctx).Debug("recorded supply snapshot", "height", height)
+}
+
+// PruneSupplySnapshots deletes all supply snapshots taken below the given
+// height.
+func (k BaseKeeper) PruneSupplySnapshots(ctx sdk.Context, before int64) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(types.SupplySnapshotPrefix, types.CreateSupplySnapshotPrefix(before))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetSupplySnapshotHeight returns the height of the most recent supply snapshot
+// taken at or below the given height.
+func (k BaseKeeper) GetSupplySnapshotHeight(ctx sdk.Context, height int64) (int64, bool) {
+	if height < 0 {
+		return 0, false
+	}
+
+	end := sdk.PrefixEndBytes(types.SupplySnapshotPrefix)
+	if height < gomath.MaxInt64 {
+		end = types.CreateSupplySnapshotPrefix(height + 1)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.ReverseIterator(types.SupplySnapshotPrefix, end)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0, false
+	}
+
+	snapshotHeight, _ := types.SplitSupplySnapshotKey(iterator.Key()[len(types.SupplySnapshotPrefix):])
+	return snapshotHeight, true
+}
+
+// GetSupplyAtHeight returns the supply of a denom recorded by the most recent
+// supply snapshot taken at or below the given height, along with the height of
+// that snapshot. It returns false if no such snapshot exists.
+func (k BaseKeeper) GetSupplyAtHeight(ctx sdk.Context, height int64, denom string) (sdk.Coin, int64, bool) {
+	snapshotHeight, found := k.GetSupplySnapshotHeight(ctx, height)
+	if !found {
+		return sdk.Coin{}, 0, false
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CreateSupplySnapshotKey(snapshotHeight, denom))
+	if bz == nil {
+		return sdk.NewCoin(denom, sdk.ZeroInt()), snapshotHeight, true
+	}
+
+	var amount math.Int
+	err := amount.Unmarshal(bz)
+	if err != nil {
+		panic(fmt.Errorf("unable to unmarshal supply snapshot value %v", err))
+	}
+
+	return sdk.NewCoin(denom, amount), snapshotHeight, true
+}
+
+// GetPaginatedSupplyAtHeight queries the supply of all denoms recorded by the
+// most recent supply snapshot taken at or below the given height, with a given
+// pagination. It returns false if no such snapshot exists.
+func (k BaseKeeper) GetPaginatedSupplyAtHeight(
+	ctx sdk.Context, height int64, pagination *query.PageRequest,
+) (sdk.Coins, int64, *query.PageResponse, bool, error) {
+	snapshotHeight, found := k.GetSupplySnapshotHeight(ctx, height)
+	if !found {
+		return nil, 0, nil, false, nil
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateSupplySnapshotPrefix(snapshotHeight))
+
+	supply := sdk.NewCoins()
+
+	pageRes, err := query.Paginate(store, pagination, func(key, value []byte) error {
+		var amount math.Int
+		err := amount.Unmarshal(value)
+		if err != nil {
+			return fmt.Errorf("unable to convert amount string to Int %v", err)
+		}
+
+		supply = supply.Add(sdk.NewCoin(string(key), amount))
+		return nil
+	})
+	if err != nil {
+		return nil, 0, nil, false, err
+	}
+
+	return supply, snapshotHeight, pageRes, true, nil
+}
@@ -72,11 +72,15 @@ func TestMigrateJSON(t *testing.T) {
 			]
 		}
 	],
+	"burned_supply": [],
 	"denom_metadata": [],
 	"params": {
 		"default_send_enabled": false,
-		"send_enabled": []
+		"send_enabled": [],
+		"supply_snapshot_interval": "0",
+		"supply_snapshot_keep_recent": "0"
 	},
+	"send_enabled": [],
 	"supply": [
 		{
 			"amount": "20",
@@ -0,0 +1,42 @@
+package v047
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.46 to v0.47.
+// The migration includes:
+//
+// - Moving the SendEnabled entries from the params to the bank store.
+// - Moving the params from the x/params subspace to the bank store, setting
+// the supply snapshot params to their defaults, which disables supply
+// snapshots.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, paramstore paramtypes.Subspace, cdc codec.BinaryCodec) error {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+
+	store := ctx.KVStore(storeKey)
+
+	params := types.DefaultParams()
+	paramstore.GetParamSetIfExists(ctx, &params)
+
+	moveSendEnabledToStore(store, params.SendEnabled)
+	params.SendEnabled = nil
+
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	return nil
+}
+
+// moveSendEnabledToStore writes the SendEnabled entries of the legacy params to
+// the bank store.
+func moveSendEnabledToStore(store sdk.KVStore, sendEnabled []*types.SendEnabled) {
+	for _, se := range sendEnabled {
+		store.Set(types.CreateSendEnabledKey(se.Denom), types.ToBoolB(se.Enabled))
+	}
+}
@@ -0,0 +1,46 @@
+package v047_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v047 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v047"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	bankKey := sdk.NewKVStoreKey("bank")
+	tBankKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(bankKey, tBankKey)
+	store := ctx.KVStore(bankKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, bankKey, tBankKey, "bank").
+		WithKeyTable(types.ParamKeyTable())
+
+	params := types.NewParams(false, types.SendEnabledParams{
+		types.NewSendEnabled("foo", true),
+		types.NewSendEnabled("bar", false),
+	})
+	// params of v0.46 chains don't include the supply snapshot params.
+	paramstore.Set(ctx, types.KeySendEnabled, params.SendEnabled)
+	paramstore.Set(ctx, types.KeyDefaultSendEnabled, params.DefaultSendEnabled)
+	require.False(t, paramstore.Has(ctx, types.KeySupplySnapshotInterval))
+
+	require.NoError(t, v047.MigrateStore(ctx, bankKey, paramstore, encCfg.Codec))
+
+	require.True(t, types.IsTrueB(store.Get(types.CreateSendEnabledKey("foo"))))
+	require.Equal(t, []byte{0x00}, store.Get(types.CreateSendEnabledKey("bar")))
+	require.False(t, store.Has(types.CreateSendEnabledKey("baz")))
+
+	var migrated types.Params
+	encCfg.Codec.MustUnmarshal(store.Get(types.ParamsKey), &migrated)
+	require.Empty(t, migrated.SendEnabled)
+	require.False(t, migrated.DefaultSendEnabled)
+	require.Equal(t, types.DefaultSupplySnapshotInterval, migrated.SupplySnapshotInterval)
+	require.Equal(t, types.DefaultSupplySnapshotKeepRecent, migrated.SupplySnapshotKeepRecent)
+}
@@ -3,7 +3,6 @@ package simulation
 // DONTCOVER
 
 import (
-	"encoding/json"
 	"fmt"
 	"math/rand"
 
@@ -14,18 +13,10 @@ import (
 )
 
 // ParamChanges defines the parameters that can be modified by param change proposals
-// on the simulation
+// on the simulation. The SendEnabled entries are not params anymore, they are
+// updated with MsgSetSendEnabled.
 func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 	return []simtypes.ParamChange{
-		simulation.NewSimParamChange(types.ModuleName, string(types.KeySendEnabled),
-			func(r *rand.Rand) string {
-				paramsBytes, err := json.Marshal(RandomGenesisSendParams(r))
-				if err != nil {
-					panic(err)
-				}
-				return string(paramsBytes)
-			},
-		),
 		simulation.NewSimParamChange(types.ModuleName, string(types.KeyDefaultSendEnabled),
 			func(r *rand.Rand) string {
 				return fmt.Sprintf("%v", RandomGenesisDefaultSendParam(r))
@@ -29,8 +29,19 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the bank module.
 type Params struct {
-	SendEnabled        []*SendEnabled `protobuf:"bytes,1,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"`
+	// Deprecated: Use of SendEnabled in params is deprecated.
+	// For genesis, use the newly added send_enabled field in the genesis object.
+	// Storage, lookup, and manipulation of this information is now in the keeper.
+	//
+	// As of cosmos-sdk 0.47, this only exists for backwards compatibility of genesis files.
+	SendEnabled        []*SendEnabled `protobuf:"bytes,1,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"` // Deprecated: Do not use.
 	DefaultSendEnabled bool           `protobuf:"varint,2,opt,name=default_send_enabled,json=defaultSendEnabled,proto3" json:"default_send_enabled,omitempty"`
+	// supply_snapshot_interval is the number of blocks between two supply
+	// snapshots. A value of 0 disables supply snapshots.
+	SupplySnapshotInterval uint64 `protobuf:"varint,3,opt,name=supply_snapshot_interval,json=supplySnapshotInterval,proto3" json:"supply_snapshot_interval,omitempty"`
+	// supply_snapshot_keep_recent is the number of most recent supply snapshots
+	// to keep. A value of 0 keeps all supply snapshots.
+	SupplySnapshotKeepRecent uint64 `protobuf:"varint,4,opt,name=supply_snapshot_keep_recent,json=supplySnapshotKeepRecent,proto3" json:"supply_snapshot_keep_recent,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -65,6 +76,7 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// Deprecated: Do not use.
 func (m *Params) GetSendEnabled() []*SendEnabled {
 	if m != nil {
 		return m.SendEnabled
@@ -79,6 +91,20 @@ func (m *Params) GetDefaultSendEnabled() bool {
 	return false
 }
 
+func (m *Params) GetSupplySnapshotInterval() uint64 {
+	if m != nil {
+		return m.SupplySnapshotInterval
+	}
+	return 0
+}
+
+func (m *Params) GetSupplySnapshotKeepRecent() uint64 {
+	if m != nil {
+		return m.SupplySnapshotKeepRecent
+	}
+	return 0
+}
+
 // SendEnabled maps coin denom to a send_enabled status (whether a denom is
 // sendable).
 type SendEnabled struct {
@@ -454,48 +480,52 @@ func init() {
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
 
 var fileDescriptor_dd052eee12edf988 = []byte{
-	// 649 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xce, 0x34, 0x4d, 0xb2, 0x9d, 0xe8, 0x65, 0x0c, 0x3a, 0xed, 0x61, 0x13, 0xf6, 0x20, 0xb1,
-	0xd0, 0x24, 0xad, 0x9e, 0x82, 0x20, 0xb6, 0x8a, 0x46, 0x10, 0x65, 0x4b, 0x11, 0xbc, 0x84, 0x49,
-	0x76, 0x4c, 0x86, 0xee, 0xce, 0x2c, 0x3b, 0xb3, 0xa5, 0xb9, 0x0a, 0x82, 0x78, 0xf2, 0xe8, 0xb1,
-	0x57, 0x3d, 0x79, 0x28, 0xf8, 0x17, 0x8a, 0xa7, 0xe2, 0xc9, 0x53, 0x95, 0xf4, 0xa0, 0x3f, 0x43,
-	0x66, 0x66, 0x37, 0x4d, 0xa1, 0x8a, 0x07, 0x0f, 0x9e, 0xf6, 0x7d, 0xef, 0x7b, 0xf3, 0xbd, 0xef,
-	0xed, 0xbc, 0x5d, 0xe8, 0x0e, 0x85, 0x8c, 0x84, 0x6c, 0x0f, 0x08, 0xdf, 0x6d, 0xef, 0xad, 0x0f,
-	0xa8, 0x22, 0xeb, 0x06, 0xb4, 0xe2, 0x44, 0x28, 0x81, 0xae, 0x58, 0xbe, 0x65, 0x52, 0x19, 0xbf,
-	0x52, 0x1b, 0x89, 0x91, 0x30, 0x7c, 0x5b, 0x47, 0xb6, 0x74, 0x65, 0xd9, 0x96, 0xf6, 0x2d, 0x91,
-	0x9d, 0xb3, 0xd4, 0x59, 0x17, 0x49, 0x67, 0x5d, 0x86, 0x82, 0xf1, 0x8c, 0xbf, 0x96, 0xf1, 0x91,
-	0x1c, 0xb5, 0xf7, 0xd6, 0xf5, 0xc3, 0x12, 0xde, 0x2b, 0x00, 0xcb, 0x4f, 0x49, 0x42, 0x22, 0x89,
-	0xb6, 0xe0, 0x25, 0x49, 0x79, 0xd0, 0xa7, 0x9c, 0x0c, 0x42, 0x1a, 0x60, 0xd0, 0x28, 0x36, 0xab,
-	0x1b, 0x8d, 0xd6, 0x05, 0x06, 0x5b, 0xdb, 0x94, 0x07, 0xf7, 0x6d, 0x9d, 0x5f, 0x95, 0x67, 0x00,
-	0x75, 0x60, 0x2d, 0xa0, 0x2f, 0x48, 0x1a, 0xaa, 0xfe, 0x39, 0xb1, 0x85, 0x06, 0x68, 0x3a, 0x3e,
-	0xca, 0xb8, 0xb9, 0xe3, 0xdd, 0xc5, 0x77, 0x07, 0xf5, 0x82, 0xf7, 0x00, 0x56, 0xe7, 0x92, 0xa8,
-	0x06, 0x4b, 0x01, 0xe5, 0x22, 0xc2, 0xa0, 0x01, 0x9a, 0x4b, 0xbe, 0x05, 0x08, 0xc3, 0xca, 0x79,
-	0xbd, 0x1c, 0x76, 0x1d, 0x2d, 0xf2, 0xf3, 0xa0, 0x0e, 0xbc, 0x43, 0x00, 0x4b, 0x3d, 0x1e, 0xa7,
-	0x0a, 0x6d, 0xc0, 0x0a, 0x09, 0x82, 0x84, 0x4a, 0x69, 0x55, 0x36, 0xf1, 0x97, 0xc3, 0xb5, 0x5a,
-	0x36, 0xcd, 0x5d, 0xcb, 0x6c, 0xab, 0x84, 0xf1, 0x91, 0x9f, 0x17, 0x22, 0x02, 0x4b, 0xfa, 0xad,
-	0x49, 0xbc, 0x60, 0x86, 0x5f, 0x3e, 0x1b, 0x5e, 0xd2, 0xd9, 0xf0, 0x5b, 0x82, 0xf1, 0xcd, 0xce,
-	0xd1, 0x49, 0xbd, 0xf0, 0xe1, 0x5b, 0xbd, 0x39, 0x62, 0x6a, 0x9c, 0x0e, 0x5a, 0x43, 0x11, 0x65,
-	0x57, 0x92, 0x3d, 0xd6, 0x64, 0xb0, 0xdb, 0x56, 0x93, 0x98, 0x4a, 0x73, 0x40, 0xfa, 0x56, 0xb9,
-	0x5b, 0x7b, 0x6d, 0xad, 0x16, 0x5e, 0xfe, 0xf8, 0xb8, 0x9a, 0x37, 0xf6, 0xde, 0x03, 0x58, 0x7e,
-	0x92, 0xaa, 0xff, 0xd8, 0xb7, 0x93, 0xfb, 0xf6, 0x3e, 0x01, 0x58, 0xde, 0x4e, 0xe3, 0x38, 0x9c,
-	0xe8, 0xbe, 0x4a, 0x28, 0x12, 0x66, 0xcb, 0xf2, 0x6f, 0xfb, 0x1a, 0xe5, 0xee, 0xa3, 0xac, 0x2f,
-	0xf8, 0x7c, 0xb8, 0x76, 0x7b, 0xf5, 0x8f, 0xa7, 0xf7, 0xed, 0x57, 0x16, 0xb1, 0x51, 0x42, 0x14,
-	0x13, 0x5c, 0xb6, 0xf7, 0x3a, 0xb7, 0x3a, 0x2d, 0xeb, 0xb5, 0x87, 0x81, 0xf7, 0x0c, 0x2e, 0xdd,
-	0xd3, 0x9b, 0xb4, 0xc3, 0x99, 0xfa, 0xcd, 0x8e, 0xad, 0x40, 0x87, 0xee, 0xc7, 0x82, 0x53, 0xae,
-	0xcc, 0x92, 0x5d, 0xf6, 0x67, 0x58, 0xef, 0x1f, 0x09, 0x19, 0x91, 0x54, 0xe2, 0x62, 0xa3, 0xd8,
-	0x5c, 0xf2, 0x73, 0xe8, 0xbd, 0x59, 0x80, 0xce, 0x63, 0xaa, 0x48, 0x40, 0x14, 0x41, 0x0d, 0x58,
-	0x0d, 0xa8, 0x1c, 0x26, 0x2c, 0xd6, 0x26, 0x32, 0xf9, 0xf9, 0x14, 0xba, 0xa3, 0x2b, 0xb8, 0x88,
-	0xfa, 0x29, 0x67, 0x2a, 0xbf, 0x34, 0xf7, 0xc2, 0x2f, 0x6d, 0xe6, 0xd7, 0x87, 0x41, 0x1e, 0x4a,
-	0x84, 0xe0, 0xa2, 0x7e, 0xc5, 0xb8, 0x68, 0xb4, 0x4d, 0xac, 0xdd, 0x05, 0x4c, 0xc6, 0x21, 0x99,
-	0xe0, 0x45, 0x93, 0xce, 0xa1, 0xae, 0xe6, 0x24, 0xa2, 0xb8, 0x64, 0xab, 0x75, 0x8c, 0xae, 0xc2,
-	0xb2, 0x9c, 0x44, 0x03, 0x11, 0xe2, 0xb2, 0xc9, 0x66, 0x08, 0x2d, 0xc3, 0x62, 0x9a, 0x30, 0x5c,
-	0x31, 0x9b, 0x57, 0x99, 0x9e, 0xd4, 0x8b, 0x3b, 0x7e, 0xcf, 0xd7, 0x39, 0x74, 0x1d, 0x3a, 0x69,
-	0xc2, 0xfa, 0x63, 0x22, 0xc7, 0xd8, 0x31, 0x7c, 0x75, 0x7a, 0x52, 0xaf, 0xec, 0xf8, 0xbd, 0x87,
-	0x44, 0x8e, 0xfd, 0x4a, 0x9a, 0x30, 0x1d, 0x6c, 0x6e, 0x1d, 0x4d, 0x5d, 0x70, 0x3c, 0x75, 0xc1,
-	0xf7, 0xa9, 0x0b, 0xde, 0x9e, 0xba, 0x85, 0xe3, 0x53, 0xb7, 0xf0, 0xf5, 0xd4, 0x2d, 0x3c, 0xbf,
-	0xf1, 0x37, 0xd7, 0x67, 0x76, 0x60, 0x50, 0x36, 0xff, 0xa7, 0x9b, 0xbf, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x8f, 0x9f, 0xc3, 0x47, 0x40, 0x05, 0x00, 0x00,
+	// 712 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xcf, 0x6b, 0x1b, 0x47,
+	0x14, 0xd6, 0xe8, 0xb7, 0x46, 0xed, 0x65, 0x2a, 0xdc, 0xb1, 0x0b, 0x2b, 0xa1, 0x43, 0x51, 0x0d,
+	0x96, 0x64, 0xb7, 0x87, 0x22, 0x5a, 0x4a, 0xe5, 0x16, 0x57, 0x2d, 0xa5, 0x65, 0x84, 0x29, 0xf4,
+	0xb2, 0x8c, 0xb4, 0x53, 0x69, 0xf1, 0xee, 0xcc, 0xb2, 0x33, 0x2b, 0xac, 0xab, 0x4f, 0x25, 0xa7,
+	0x1c, 0x73, 0xf4, 0x35, 0x39, 0xe5, 0x60, 0xc8, 0xbf, 0x60, 0x72, 0x32, 0x39, 0xe5, 0xe4, 0x04,
+	0xf9, 0x90, 0xfc, 0x19, 0x61, 0x66, 0x76, 0x65, 0x3b, 0x38, 0x21, 0x97, 0x40, 0x4e, 0xfb, 0xde,
+	0xfb, 0xde, 0x8f, 0x4f, 0x6f, 0xbe, 0x27, 0xe8, 0x4c, 0x85, 0x0c, 0x85, 0xec, 0x4d, 0x28, 0x3f,
+	0xea, 0x2d, 0x76, 0x27, 0x4c, 0xd1, 0x5d, 0xe3, 0x74, 0xa3, 0x58, 0x28, 0x81, 0xbe, 0xb0, 0x78,
+	0xd7, 0x84, 0x52, 0x7c, 0xab, 0x31, 0x13, 0x33, 0x61, 0xf0, 0x9e, 0xb6, 0x6c, 0xea, 0xd6, 0xa6,
+	0x4d, 0x75, 0x2d, 0x90, 0xd6, 0x59, 0xe8, 0x7a, 0x8a, 0x64, 0xeb, 0x29, 0x53, 0xe1, 0xf3, 0x14,
+	0xff, 0x32, 0xc5, 0x43, 0x39, 0xeb, 0x2d, 0x76, 0xf5, 0xc7, 0x02, 0xed, 0x93, 0x3c, 0x2c, 0xff,
+	0x4d, 0x63, 0x1a, 0x4a, 0x74, 0x00, 0x3f, 0x93, 0x8c, 0x7b, 0x2e, 0xe3, 0x74, 0x12, 0x30, 0x0f,
+	0x83, 0x56, 0xa1, 0x53, 0xdf, 0x6b, 0x75, 0xef, 0x20, 0xd8, 0x1d, 0x33, 0xee, 0xfd, 0x6a, 0xf3,
+	0x86, 0x79, 0x0c, 0x48, 0x5d, 0x5e, 0x07, 0x50, 0x1f, 0x36, 0x3c, 0xf6, 0x1f, 0x4d, 0x02, 0xe5,
+	0xde, 0x6a, 0x98, 0x6f, 0x81, 0x4e, 0x95, 0xa0, 0x14, 0xbb, 0xd1, 0x02, 0x7d, 0x0f, 0xb1, 0x4c,
+	0xa2, 0x28, 0x58, 0xba, 0x92, 0xd3, 0x48, 0xce, 0x85, 0x72, 0x7d, 0xae, 0x58, 0xbc, 0xa0, 0x01,
+	0x2e, 0xb4, 0x40, 0xa7, 0x48, 0x36, 0x2c, 0x3e, 0x4e, 0xe1, 0x51, 0x8a, 0xa2, 0x1f, 0xe1, 0x57,
+	0x6f, 0x57, 0x1e, 0x31, 0x16, 0xb9, 0x31, 0x9b, 0x32, 0xae, 0x70, 0xd1, 0x14, 0xe3, 0xdb, 0xc5,
+	0x7f, 0x30, 0x16, 0x11, 0x83, 0x0f, 0x8a, 0x0f, 0x4e, 0x9b, 0xb9, 0xf6, 0x01, 0xac, 0xdf, 0x64,
+	0xd3, 0x80, 0x25, 0x8f, 0x71, 0x11, 0x62, 0xd0, 0x02, 0x9d, 0x1a, 0xb1, 0x0e, 0xc2, 0xb0, 0x72,
+	0xfb, 0x87, 0x64, 0xee, 0xa0, 0xaa, 0x9b, 0xbc, 0x3e, 0x6d, 0x82, 0xf6, 0x19, 0x80, 0xa5, 0x11,
+	0x8f, 0x12, 0x85, 0xf6, 0x60, 0x85, 0x7a, 0x5e, 0xcc, 0xa4, 0xb4, 0x5d, 0x86, 0xf8, 0xd9, 0xd9,
+	0x4e, 0x23, 0x5d, 0xe5, 0xcf, 0x16, 0x19, 0xab, 0xd8, 0xe7, 0x33, 0x92, 0x25, 0x22, 0x0a, 0x4b,
+	0xfa, 0xc9, 0x24, 0xce, 0x9b, 0xcd, 0x6f, 0x5e, 0x6f, 0x5e, 0xb2, 0xf5, 0xe6, 0xf7, 0x85, 0xcf,
+	0x87, 0xfd, 0xf3, 0xcb, 0x66, 0xee, 0xd1, 0x8b, 0x66, 0x67, 0xe6, 0xab, 0x79, 0x32, 0xe9, 0x4e,
+	0x45, 0x98, 0xea, 0x21, 0xfd, 0xec, 0x48, 0xef, 0xa8, 0xa7, 0x96, 0x11, 0x93, 0xa6, 0x40, 0x12,
+	0xdb, 0x79, 0xd0, 0xf8, 0xdf, 0x52, 0xcd, 0x9d, 0xbc, 0x7a, 0xbc, 0x9d, 0x0d, 0x6e, 0x3f, 0x04,
+	0xb0, 0xfc, 0x57, 0xa2, 0x3e, 0x61, 0xde, 0xd5, 0x8c, 0x77, 0xfb, 0x09, 0x80, 0xe5, 0xb1, 0x79,
+	0x4e, 0x3d, 0x57, 0x09, 0x45, 0x03, 0x0c, 0x3e, 0xc2, 0x5c, 0xd3, 0x79, 0xf0, 0x7b, 0x3a, 0x17,
+	0x3c, 0x3d, 0xdb, 0xf9, 0x61, 0xfb, 0xbd, 0xd5, 0xc7, 0xf6, 0xc4, 0x43, 0x7f, 0x16, 0x53, 0xe5,
+	0x0b, 0x2e, 0x7b, 0x8b, 0xfe, 0x77, 0xfd, 0xae, 0xe5, 0x3a, 0xc2, 0xa0, 0xfd, 0x0f, 0xac, 0xfd,
+	0xa2, 0x95, 0x74, 0xc8, 0x7d, 0xf5, 0x0e, 0x8d, 0x6d, 0xc1, 0x2a, 0x3b, 0x8e, 0x04, 0xd7, 0xd2,
+	0xd5, 0x22, 0xfb, 0x9c, 0xac, 0x7d, 0xad, 0x3f, 0x1a, 0xf8, 0x54, 0x32, 0x89, 0x0b, 0xad, 0x42,
+	0xa7, 0x46, 0x32, 0xb7, 0x7d, 0x2f, 0x0f, 0xab, 0x7f, 0x32, 0x45, 0x3d, 0xaa, 0x28, 0x6a, 0xc1,
+	0xba, 0xc7, 0xe4, 0x34, 0xf6, 0x23, 0x4d, 0x22, 0x6d, 0x7f, 0x33, 0x84, 0x7e, 0xd2, 0x19, 0x5c,
+	0x84, 0x6e, 0xc2, 0x7d, 0x95, 0x3d, 0x9a, 0x73, 0xe7, 0x99, 0xaf, 0xf9, 0x12, 0xe8, 0x65, 0xa6,
+	0x44, 0x08, 0x16, 0xf5, 0x8a, 0xcd, 0x65, 0xd6, 0x88, 0xb1, 0x35, 0x3b, 0xcf, 0x97, 0x51, 0x40,
+	0x97, 0xe6, 0xe6, 0x6a, 0x24, 0x73, 0x75, 0x36, 0xa7, 0x21, 0xc3, 0x25, 0x9b, 0xad, 0x6d, 0xb4,
+	0x01, 0xcb, 0x72, 0x19, 0x4e, 0x44, 0x80, 0xcb, 0x26, 0x9a, 0x7a, 0x68, 0x13, 0x16, 0x92, 0xd8,
+	0xc7, 0x15, 0xa3, 0xbc, 0xca, 0xea, 0xb2, 0x59, 0x38, 0x24, 0x23, 0xa2, 0x63, 0xe8, 0x6b, 0x58,
+	0x4d, 0x62, 0xdf, 0x9d, 0x53, 0x39, 0xc7, 0x55, 0x83, 0xd7, 0x57, 0x97, 0xcd, 0xca, 0x21, 0x19,
+	0xfd, 0x46, 0xe5, 0x9c, 0x54, 0x92, 0xd8, 0xd7, 0xc6, 0x70, 0xff, 0x7c, 0xe5, 0x80, 0x8b, 0x95,
+	0x03, 0x5e, 0xae, 0x1c, 0x70, 0xff, 0xca, 0xc9, 0x5d, 0x5c, 0x39, 0xb9, 0xe7, 0x57, 0x4e, 0xee,
+	0xdf, 0x6f, 0x3e, 0xe4, 0xf9, 0x8c, 0x06, 0x26, 0x65, 0xf3, 0xe7, 0xf8, 0xed, 0x9b, 0x01, 0x00,
+	0xaa, 0xa2, 0xaf, 0x22, 0xbd, 0x05, 0x00, 0x00,
 }
 
 func (this *SendEnabled) Equal(that interface{}) bool {
@@ -574,6 +604,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SupplySnapshotKeepRecent != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.SupplySnapshotKeepRecent))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SupplySnapshotInterval != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.SupplySnapshotInterval))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.DefaultSendEnabled {
 		i--
 		if m.DefaultSendEnabled {
@@ -922,6 +962,12 @@ func (m *Params) Size() (n int) {
 	if m.DefaultSendEnabled {
 		n += 2
 	}
+	if m.SupplySnapshotInterval != 0 {
+		n += 1 + sovBank(uint64(m.SupplySnapshotInterval))
+	}
+	if m.SupplySnapshotKeepRecent != 0 {
+		n += 1 + sovBank(uint64(m.SupplySnapshotKeepRecent))
+	}
 	return n
 }
 
@@ -1148,6 +1194,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.DefaultSendEnabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplySnapshotInterval", wireType)
+			}
+			m.SupplySnapshotInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SupplySnapshotInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplySnapshotKeepRecent", wireType)
+			}
+			m.SupplySnapshotKeepRecent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SupplySnapshotKeepRecent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBank(dAtA[iNdEx:])
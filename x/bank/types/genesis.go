@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -15,6 +16,21 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	if len(gs.Params.SendEnabled) > 0 && len(gs.SendEnabled) > 0 {
+		return errors.New("send_enabled defined in both the send_enabled field and in params (deprecated)")
+	}
+
+	seenSendEnabled := make(map[string]bool)
+	for _, p := range gs.GetAllSendEnabled() {
+		if _, exists := seenSendEnabled[p.Denom]; exists {
+			return fmt.Errorf("duplicate send enabled found: '%s'", p.Denom)
+		}
+		if err := p.Validate(); err != nil {
+			return err
+		}
+		seenSendEnabled[p.Denom] = true
+	}
+
 	seenBalances := make(map[string]bool)
 	seenMetadatas := make(map[string]bool)
 
@@ -46,6 +62,10 @@ func (gs GenesisState) Validate() error {
 		seenMetadatas[metadata.Base] = true
 	}
 
+	if err := gs.BurnedSupply.Validate(); err != nil {
+		return fmt.Errorf("invalid burned supply: %w", err)
+	}
+
 	if !gs.Supply.Empty() {
 		// NOTE: this errors if supply for any given coin is zero
 		err := gs.Supply.Validate()
@@ -62,18 +82,50 @@ func (gs GenesisState) Validate() error {
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(params Params, balances []Balance, supply sdk.Coins, denomMetaData []Metadata) *GenesisState {
-	return &GenesisState{
+func NewGenesisState(params Params, balances []Balance, supply sdk.Coins, denomMetaData []Metadata, sendEnabled []SendEnabled) *GenesisState {
+	rv := &GenesisState{
 		Params:        params,
 		Balances:      balances,
 		Supply:        supply,
 		DenomMetadata: denomMetaData,
+		SendEnabled:   sendEnabled,
+	}
+	rv.MigrateSendEnabled()
+	return rv
+}
+
+// MigrateSendEnabled moves the SendEnabled info from Params into the GenesisState.SendEnabled field and removes them from Params.
+// If the Params.SendEnabled slice is empty, this is a noop.
+// If the main SendEnabled slice already has entries, the Params.SendEnabled entries are added.
+// In case of the same demon in both, preference is given to the existing (main GenesisState field) entry.
+func (gs *GenesisState) MigrateSendEnabled() {
+	gs.SendEnabled = gs.GetAllSendEnabled()
+	gs.Params.SendEnabled = nil
+}
+
+// GetAllSendEnabled returns all the SendEnabled entries from both the SendEnabled field and the Params.
+// If a denom has an entry in both, the entry in the SendEnabled field takes precedence over one in Params.
+func (gs GenesisState) GetAllSendEnabled() []SendEnabled {
+	if len(gs.Params.SendEnabled) == 0 {
+		return gs.SendEnabled
+	}
+	rv := make([]SendEnabled, len(gs.SendEnabled))
+	knownSendEnabled := map[string]bool{}
+	for i, se := range gs.SendEnabled {
+		rv[i] = se
+		knownSendEnabled[se.Denom] = true
+	}
+	for _, se := range gs.Params.SendEnabled {
+		if _, known := knownSendEnabled[se.Denom]; !known {
+			rv = append(rv, *se)
+		}
 	}
+	return rv
 }
 
 // DefaultGenesisState returns a default bank module genesis state.
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState(DefaultParams(), []Balance{}, sdk.Coins{}, []Metadata{})
+	return NewGenesisState(DefaultParams(), []Balance{}, sdk.Coins{}, []Metadata{}, []SendEnabled{})
 }
 
 // GetGenesisStateFromAppState returns x/bank GenesisState given raw application
@@ -54,6 +54,56 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid send enabled",
+			GenesisState{
+				SendEnabled: []SendEnabled{
+					{"foocoin", true},
+					{"barcoin", false},
+				},
+			},
+			false,
+		},
+		{
+			"invalid send enabled denom",
+			GenesisState{
+				SendEnabled: []SendEnabled{
+					{"", true},
+				},
+			},
+			true,
+		},
+		{
+			"dup send enabled",
+			GenesisState{
+				SendEnabled: []SendEnabled{
+					{"foocoin", true},
+					{"foocoin", false},
+				},
+			},
+			true,
+		},
+		{
+			"send enabled in both params and genesis",
+			GenesisState{
+				Params: Params{
+					SendEnabled: []*SendEnabled{
+						{"foocoin", true},
+					},
+				},
+				SendEnabled: []SendEnabled{
+					{"barcoin", true},
+				},
+			},
+			true,
+		},
+		{
+			"invalid burned supply",
+			GenesisState{
+				BurnedSupply: sdk.Coins{sdk.Coin{Denom: "uatom", Amount: sdk.NewInt(-1)}},
+			},
+			true,
+		},
 		{
 			"dup balances",
 			GenesisState{
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"encoding/binary"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/cosmos-sdk/types/kv"
@@ -20,12 +22,30 @@ const (
 	QuerierRoute = ModuleName
 )
 
+// SendEnabled store values
+var (
+	trueB  = []byte{0x01}
+	falseB = []byte{0x00}
+)
+
 // KVStore keys
 var (
 	SupplyKey           = []byte{0x00}
 	DenomMetadataPrefix = []byte{0x1}
 	DenomAddressPrefix  = []byte{0x03}
 
+	// SendEnabledPrefix is the prefix for the SendDisabled flags for a Denom.
+	SendEnabledPrefix = []byte{0x04}
+
+	// BurnedSupplyPrefix is the prefix for the cumulative burned amount of a denom.
+	BurnedSupplyPrefix = []byte{0x05}
+
+	// SupplySnapshotPrefix is the prefix for the supply snapshots index.
+	SupplySnapshotPrefix = []byte{0x06}
+
+	// ParamsKey is the key of the x/bank module params.
+	ParamsKey = []byte{0x07}
+
 	// BalancesPrefix is the prefix for the account balances store. We use a byte
 	// (instead of `[]byte("balances")` to save some disk space).
 	BalancesPrefix = []byte{0x02}
@@ -73,3 +93,47 @@ func CreateDenomAddressPrefix(denom string) []byte {
 	copy(key[len(DenomAddressPrefix):], denom)
 	return key
 }
+
+// CreateSendEnabledKey creates the key of the SendEnabled flag for a denom.
+func CreateSendEnabledKey(denom string) []byte {
+	key := make([]byte, len(SendEnabledPrefix)+len(denom))
+	copy(key, SendEnabledPrefix)
+	copy(key[len(SendEnabledPrefix):], denom)
+	return key
+}
+
+// CreateSupplySnapshotPrefix creates the prefix of the supply snapshot taken at
+// the given height.
+func CreateSupplySnapshotPrefix(height int64) []byte {
+	key := make([]byte, len(SupplySnapshotPrefix)+8)
+	copy(key, SupplySnapshotPrefix)
+	binary.BigEndian.PutUint64(key[len(SupplySnapshotPrefix):], uint64(height))
+	return key
+}
+
+// CreateSupplySnapshotKey creates the key of the supply of a denom in the supply
+// snapshot taken at the given height.
+func CreateSupplySnapshotKey(height int64, denom string) []byte {
+	return append(CreateSupplySnapshotPrefix(height), denom...)
+}
+
+// SplitSupplySnapshotKey returns the height and denom of a supply snapshot key
+// without the SupplySnapshotPrefix.
+func SplitSupplySnapshotKey(key []byte) (int64, string) {
+	kv.AssertKeyAtLeastLength(key, 9)
+	return int64(binary.BigEndian.Uint64(key[:8])), string(key[8:])
+}
+
+// IsTrueB returns true if the provided byte slice has exactly one byte, and it is equal to 0x01.
+func IsTrueB(bz []byte) bool {
+	return len(bz) == 1 && bz[0] == trueB[0]
+}
+
+// ToBoolB returns the byte slice representation of the provided boolean.
+func ToBoolB(val bool) []byte {
+	if val {
+		return trueB
+	}
+
+	return falseB
+}
@@ -68,3 +68,18 @@ func TestCreateDenomAddressPrefix(t *testing.T) {
 	require.Len(key, len(types.DenomAddressPrefix)+4)
 	require.Equal(append(types.DenomAddressPrefix, 'a', 'b', 'c', 0), key)
 }
+
+func TestSupplySnapshotKey(t *testing.T) {
+	require := require.New(t)
+
+	key := types.CreateSupplySnapshotKey(42, "stake")
+	require.Equal(types.SupplySnapshotPrefix, key[:1])
+	require.Equal(types.CreateSupplySnapshotPrefix(42), key[:9])
+
+	height, denom := types.SplitSupplySnapshotKey(key[1:])
+	require.Equal(int64(42), height)
+	require.Equal("stake", denom)
+
+	// keys are ordered by height
+	require.Less(string(types.CreateSupplySnapshotKey(255, "stake")), string(types.CreateSupplySnapshotKey(256, "atom")))
+}
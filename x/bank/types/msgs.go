@@ -7,8 +7,11 @@ import (
 
 // bank message types
 const (
-	TypeMsgSend      = "send"
-	TypeMsgMultiSend = "multisend"
+	TypeMsgSend           = "send"
+	TypeMsgMultiSend      = "multisend"
+	TypeMsgSetSendEnabled = "set_send_enabled"
+	TypeMsgBurn           = "burn"
+	TypeMsgUpdateParams   = "update_params"
 )
 
 var _ sdk.Msg = &MsgSend{}
@@ -102,6 +105,141 @@ func (msg MsgMultiSend) GetSigners() []sdk.AccAddress {
 	return addrs
 }
 
+var _ sdk.Msg = &MsgBurn{}
+
+// NewMsgBurn - construct a msg to burn coins from an account.
+//
+//nolint:interfacer
+func NewMsgBurn(fromAddr sdk.AccAddress, amount sdk.Coins) *MsgBurn {
+	return &MsgBurn{FromAddress: fromAddr.String(), Amount: amount}
+}
+
+// Route Implements Msg.
+func (msg MsgBurn) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgBurn) Type() string { return TypeMsgBurn }
+
+// ValidateBasic Implements Msg.
+func (msg MsgBurn) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid from address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	if !msg.Amount.IsAllPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgBurn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgBurn) GetSigners() []sdk.AccAddress {
+	fromAddress, _ := sdk.AccAddressFromBech32(msg.FromAddress)
+	return []sdk.AccAddress{fromAddress}
+}
+
+var _ sdk.Msg = &MsgSetSendEnabled{}
+
+// NewMsgSetSendEnabled construct a message to set one or more SendEnabled entries.
+func NewMsgSetSendEnabled(authority string, sendEnabled []*SendEnabled, useDefaultFor []string) *MsgSetSendEnabled {
+	return &MsgSetSendEnabled{
+		Authority:     authority,
+		SendEnabled:   sendEnabled,
+		UseDefaultFor: useDefaultFor,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgSetSendEnabled) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgSetSendEnabled) Type() string { return TypeMsgSetSendEnabled }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgSetSendEnabled) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgSetSendEnabled.
+func (msg MsgSetSendEnabled) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic runs basic validation on this MsgSetSendEnabled.
+func (msg MsgSetSendEnabled) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	seen := map[string]bool{}
+	for _, se := range msg.SendEnabled {
+		if se == nil {
+			return sdkerrors.ErrInvalidRequest.Wrap("nil send enabled entry")
+		}
+		if _, alreadySeen := seen[se.Denom]; alreadySeen {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate denom entries found for %q", se.Denom)
+		}
+
+		seen[se.Denom] = true
+
+		if err := se.Validate(); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid SendEnabled denom %q: %s", se.Denom, err)
+		}
+	}
+
+	for _, denom := range msg.UseDefaultFor {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid UseDefaultFor denom %q: %s", denom, err)
+		}
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgUpdateParams{}
+
+// Route Implements Msg
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	// the SendEnabled entries are managed with MsgSetSendEnabled
+	if len(msg.Params.SendEnabled) > 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("use of send_enabled in params is no longer supported, use MsgSetSendEnabled instead")
+	}
+
+	return msg.Params.Validate()
+}
+
 // ValidateBasic - validate transaction input
 func (in Input) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(in.Address); err != nil {
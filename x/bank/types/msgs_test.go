@@ -276,3 +276,149 @@ func TestMsgSendGetSigners(t *testing.T) {
 	require.Equal(t, 1, len(res))
 	require.True(t, from.Equals(res[0]))
 }
+
+func TestMsgBurnValidation(t *testing.T) {
+	addr := sdk.AccAddress([]byte("from________________"))
+
+	cases := []struct {
+		expectedErr string
+		msg         *MsgBurn
+	}{
+		{"", NewMsgBurn(addr, sdk.NewCoins(sdk.NewInt64Coin("atom", 123)))},
+		{"invalid from address: empty address string is not allowed: invalid address", NewMsgBurn(sdk.AccAddress{}, sdk.NewCoins(sdk.NewInt64Coin("atom", 123)))},
+		{": invalid coins", NewMsgBurn(addr, sdk.Coins{})},
+		{"0atom: invalid coins", NewMsgBurn(addr, sdk.Coins{sdk.NewInt64Coin("atom", 0)})},
+		{"-1atom: invalid coins", NewMsgBurn(addr, sdk.Coins{sdk.Coin{Denom: "atom", Amount: sdk.NewInt(-1)}})},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.NoError(t, err)
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgBurnGetSignersAndRoute(t *testing.T) {
+	addr := sdk.AccAddress([]byte("from________________"))
+	msg := NewMsgBurn(addr, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)))
+
+	require.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, TypeMsgBurn, msg.Type())
+	require.Equal(t, `{"type":"cosmos-sdk/MsgBurn","value":{"amount":[{"amount":"10","denom":"atom"}],"from_address":"cosmos1veex7m2lta047h6lta047h6lta047h6lt50pqc"}}`, string(msg.GetSignBytes()))
+}
+
+func TestMsgSetSendEnabledValidation(t *testing.T) {
+	authority := sdk.AccAddress([]byte("authority___________")).String()
+
+	testCases := []struct {
+		name   string
+		msg    *MsgSetSendEnabled
+		expErr string
+	}{
+		{
+			"valid",
+			NewMsgSetSendEnabled(authority, []*SendEnabled{NewSendEnabled("foocoin", true)}, []string{"barcoin"}),
+			"",
+		},
+		{
+			"empty message",
+			NewMsgSetSendEnabled(authority, nil, nil),
+			"",
+		},
+		{
+			"invalid authority",
+			NewMsgSetSendEnabled("invalid", nil, nil),
+			"invalid authority address",
+		},
+		{
+			"nil entry",
+			NewMsgSetSendEnabled(authority, []*SendEnabled{nil}, nil),
+			"nil send enabled entry",
+		},
+		{
+			"duplicate denom",
+			NewMsgSetSendEnabled(authority, []*SendEnabled{NewSendEnabled("foocoin", true), NewSendEnabled("foocoin", false)}, nil),
+			"duplicate denom entries found for \"foocoin\"",
+		},
+		{
+			"invalid send enabled denom",
+			NewMsgSetSendEnabled(authority, []*SendEnabled{NewSendEnabled("0foo", true)}, nil),
+			"invalid SendEnabled denom \"0foo\"",
+		},
+		{
+			"invalid use default for denom",
+			NewMsgSetSendEnabled(authority, nil, []string{"0foo"}),
+			"invalid UseDefaultFor denom \"0foo\"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestMsgSetSendEnabledGetSigners(t *testing.T) {
+	authority := sdk.AccAddress([]byte("authority___________"))
+	msg := NewMsgSetSendEnabled(authority.String(), nil, nil)
+
+	require.Equal(t, []sdk.AccAddress{authority}, msg.GetSigners())
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, TypeMsgSetSendEnabled, msg.Type())
+}
+
+func TestMsgUpdateParamsValidation(t *testing.T) {
+	authority := sdk.AccAddress([]byte("authority___________")).String()
+
+	testCases := []struct {
+		name   string
+		msg    *MsgUpdateParams
+		expErr string
+	}{
+		{
+			"valid",
+			&MsgUpdateParams{Authority: authority, Params: DefaultParams()},
+			"",
+		},
+		{
+			"invalid authority",
+			&MsgUpdateParams{Authority: "invalid", Params: DefaultParams()},
+			"invalid authority address",
+		},
+		{
+			"send enabled in params",
+			&MsgUpdateParams{Authority: authority, Params: DefaultParams().SetSendEnabledParam("foocoin", false)},
+			"use of send_enabled in params is no longer supported",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestMsgUpdateParamsGetSigners(t *testing.T) {
+	authority := sdk.AccAddress([]byte("authority___________"))
+	msg := MsgUpdateParams{Authority: authority.String(), Params: DefaultParams()}
+
+	require.Equal(t, []sdk.AccAddress{authority}, msg.GetSigners())
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, TypeMsgUpdateParams, msg.Type())
+}
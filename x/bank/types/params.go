@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"math"
 
 	"sigs.k8s.io/yaml"
 
@@ -13,6 +14,10 @@ import (
 const (
 	// DefaultSendEnabled enabled
 	DefaultSendEnabled = true
+	// DefaultSupplySnapshotInterval disables supply snapshots
+	DefaultSupplySnapshotInterval uint64 = 0
+	// DefaultSupplySnapshotKeepRecent keeps all supply snapshots
+	DefaultSupplySnapshotKeepRecent uint64 = 0
 )
 
 var (
@@ -20,6 +25,10 @@ var (
 	KeySendEnabled = []byte("SendEnabled")
 	// KeyDefaultSendEnabled is store's key for the DefaultSendEnabled option
 	KeyDefaultSendEnabled = []byte("DefaultSendEnabled")
+	// KeySupplySnapshotInterval is store's key for the SupplySnapshotInterval option
+	KeySupplySnapshotInterval = []byte("SupplySnapshotInterval")
+	// KeySupplySnapshotKeepRecent is store's key for the SupplySnapshotKeepRecent option
+	KeySupplySnapshotKeepRecent = []byte("SupplySnapshotKeepRecent")
 )
 
 // ParamKeyTable for bank module.
@@ -40,7 +49,9 @@ func DefaultParams() Params {
 	return Params{
 		SendEnabled: SendEnabledParams{},
 		// The default send enabled value allows send transfers for all coin denoms
-		DefaultSendEnabled: true,
+		DefaultSendEnabled:       true,
+		SupplySnapshotInterval:   DefaultSupplySnapshotInterval,
+		SupplySnapshotKeepRecent: DefaultSupplySnapshotKeepRecent,
 	}
 }
 
@@ -49,7 +60,26 @@ func (p Params) Validate() error {
 	if err := validateSendEnabledParams(p.SendEnabled); err != nil {
 		return err
 	}
-	return validateIsBool(p.DefaultSendEnabled)
+	if err := validateIsBool(p.DefaultSendEnabled); err != nil {
+		return err
+	}
+	if err := validateSupplySnapshotInterval(p.SupplySnapshotInterval); err != nil {
+		return err
+	}
+	if err := validateSupplySnapshotKeepRecent(p.SupplySnapshotKeepRecent); err != nil {
+		return err
+	}
+
+	// the heights spanned by the kept snapshots must fit in a block height
+	if p.SupplySnapshotInterval > 0 && p.SupplySnapshotKeepRecent > 1 &&
+		p.SupplySnapshotKeepRecent-1 > math.MaxInt64/p.SupplySnapshotInterval {
+		return fmt.Errorf(
+			"supply snapshot keep recent %d times the interval %d overflows the block height",
+			p.SupplySnapshotKeepRecent, p.SupplySnapshotInterval,
+		)
+	}
+
+	return nil
 }
 
 // String implements the Stringer interface.
@@ -78,7 +108,15 @@ func (p Params) SetSendEnabledParam(denom string, sendEnabled bool) Params {
 		}
 	}
 	sendParams = append(sendParams, NewSendEnabled(denom, sendEnabled))
-	return NewParams(p.DefaultSendEnabled, sendParams)
+	params := NewParams(p.DefaultSendEnabled, sendParams)
+	params.SupplySnapshotInterval = p.SupplySnapshotInterval
+	params.SupplySnapshotKeepRecent = p.SupplySnapshotKeepRecent
+	return params
+}
+
+// SupplySnapshotEnabled returns true if supply snapshots are recorded.
+func (p Params) SupplySnapshotEnabled() bool {
+	return p.SupplySnapshotInterval > 0
 }
 
 // ParamSetPairs implements params.ParamSet
@@ -86,6 +124,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeySendEnabled, &p.SendEnabled, validateSendEnabledParams),
 		paramtypes.NewParamSetPair(KeyDefaultSendEnabled, &p.DefaultSendEnabled, validateIsBool),
+		paramtypes.NewParamSetPair(KeySupplySnapshotInterval, &p.SupplySnapshotInterval, validateSupplySnapshotInterval),
+		paramtypes.NewParamSetPair(KeySupplySnapshotKeepRecent, &p.SupplySnapshotKeepRecent, validateSupplySnapshotKeepRecent),
 	}
 }
 
@@ -135,6 +175,11 @@ func (se SendEnabled) String() string {
 	return string(out)
 }
 
+// Validate gets any errors with this SendEnabled entry.
+func (se SendEnabled) Validate() error {
+	return sdk.ValidateDenom(se.Denom)
+}
+
 func validateSendEnabled(i interface{}) error {
 	param, ok := i.(SendEnabled)
 	if !ok {
@@ -150,3 +195,25 @@ func validateIsBool(i interface{}) error {
 	}
 	return nil
 }
+
+func validateSupplySnapshotInterval(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v > math.MaxInt64 {
+		return fmt.Errorf("supply snapshot interval too large: %d", v)
+	}
+	return nil
+}
+
+func validateSupplySnapshotKeepRecent(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v > math.MaxInt64 {
+		return fmt.Errorf("supply snapshot keep recent too large: %d", v)
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -112,3 +113,34 @@ func Test_validateParams(t *testing.T) {
 
 	require.Error(t, validateSendEnabledParams(SendEnabledParams{NewSendEnabled("INVALIDDENOM", true)}))
 }
+
+func Test_validateSupplySnapshotParams(t *testing.T) {
+	tests := []struct {
+		name       string
+		interval   uint64
+		keepRecent uint64
+		wantErr    bool
+	}{
+		{"disabled", 0, 0, false},
+		{"disabled with keep recent", 0, math.MaxUint64, true},
+		{"keep all", 100, 0, false},
+		{"keep recent", 100, 10, false},
+		{"max interval", math.MaxInt64, 1, false},
+		{"interval overflows the block height", math.MaxInt64 + 1, 0, true},
+		{"keep recent overflows the block height", 1, math.MaxInt64 + 1, true},
+		{"max retained heights", 2, math.MaxInt64/2 + 1, false},
+		{"retained heights overflow the block height", 2, math.MaxInt64/2 + 2, true},
+		{"large interval and keep recent", math.MaxInt64, 3, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := DefaultParams()
+			params.SupplySnapshotInterval = tt.interval
+			params.SupplySnapshotKeepRecent = tt.keepRecent
+			require.Equal(t, tt.wantErr, params.Validate() != nil)
+		})
+	}
+
+	require.Error(t, validateSupplySnapshotInterval(int64(1)))
+	require.Error(t, validateSupplySnapshotKeepRecent(int64(1)))
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SendRestrictionFn can restrict sends and/or provide a new receiver address.
+// It is called before the coins are moved, with the sender and receiver of the
+// transfer. The returned address is the one that receives the coins, which
+// allows redirecting a transfer. An error rejects the transfer.
+type SendRestrictionFn func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (newToAddr sdk.AccAddress, err error)
+
+var _ SendRestrictionFn = NoOpSendRestrictionFn
+
+// NoOpSendRestrictionFn is a no-op SendRestrictionFn.
+func NoOpSendRestrictionFn(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+	return toAddr, nil
+}
+
+// Then creates a composite restriction that runs this one then the provided
+// second one, with the receiver address returned by this one.
+func (r SendRestrictionFn) Then(second SendRestrictionFn) SendRestrictionFn {
+	return ComposeSendRestrictions(r, second)
+}
+
+// ComposeSendRestrictions combines multiple send restrictions into one. The
+// restrictions are run in order, each one receiving the receiver address
+// returned by the previous one, and the first error is returned. Nil entries
+// are ignored.
+func ComposeSendRestrictions(restrictions ...SendRestrictionFn) SendRestrictionFn {
+	toRun := make([]SendRestrictionFn, 0, len(restrictions))
+	for _, r := range restrictions {
+		if r != nil {
+			toRun = append(toRun, r)
+		}
+	}
+
+	switch len(toRun) {
+	case 0:
+		return nil
+	case 1:
+		return toRun[0]
+	}
+
+	return func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		var err error
+		for _, r := range toRun {
+			toAddr, err = r(ctx, fromAddr, toAddr, amt)
+			if err != nil {
+				return toAddr, err
+			}
+		}
+
+		return toAddr, nil
+	}
+}
+
+// bypassKey is the context key for the send restrictions bypass.
+type bypassKey struct{}
+
+// WithBypass returns a new context that skips the send restrictions. It should
+// only be used by modules moving coins on behalf of the protocol.
+func WithBypass(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(bypassKey{}, true)
+}
+
+// WithoutBypass returns a new context that applies the send restrictions.
+func WithoutBypass(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(bypassKey{}, false)
+}
+
+// HasBypass returns true if the context skips the send restrictions.
+func HasBypass(ctx sdk.Context) bool {
+	bypass, ok := ctx.Value(bypassKey{}).(bool)
+	return ok && bypass
+}
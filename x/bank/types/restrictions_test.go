@@ -0,0 +1,59 @@
+package types_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestComposeSendRestrictions(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	addr3 := sdk.AccAddress("addr3_______________")
+	amt := sdk.NewCoins(sdk.NewInt64Coin("foo", 10))
+
+	var order []string
+	redirect := func(name string, to sdk.AccAddress) types.SendRestrictionFn {
+		return func(_ sdk.Context, _, _ sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+			order = append(order, name)
+			return to, nil
+		}
+	}
+	reject := func(_ sdk.Context, _, _ sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		order = append(order, "reject")
+		return nil, errors.New("rejected")
+	}
+
+	require.Nil(t, types.ComposeSendRestrictions())
+	require.Nil(t, types.ComposeSendRestrictions(nil, nil))
+
+	toAddr, err := types.NoOpSendRestrictionFn(sdk.Context{}, addr1, addr2, amt)
+	require.NoError(t, err)
+	require.Equal(t, addr2, toAddr)
+
+	// each restriction receives the address returned by the previous one
+	composed := types.ComposeSendRestrictions(redirect("first", addr3), nil, redirect("second", addr1))
+	toAddr, err = composed(sdk.Context{}, addr1, addr2, amt)
+	require.NoError(t, err)
+	require.Equal(t, addr1, toAddr)
+	require.Equal(t, []string{"first", "second"}, order)
+
+	// the first error stops the chain
+	order = nil
+	composed = types.SendRestrictionFn(reject).Then(redirect("after", addr3))
+	_, err = composed(sdk.Context{}, addr1, addr2, amt)
+	require.Error(t, err)
+	require.Equal(t, []string{"reject"}, order)
+}
+
+func TestSendRestrictionBypass(t *testing.T) {
+	ctx := sdk.Context{}.WithContext(context.Background())
+	require.False(t, types.HasBypass(ctx))
+	require.True(t, types.HasBypass(types.WithBypass(ctx)))
+	require.False(t, types.HasBypass(types.WithoutBypass(types.WithBypass(ctx))))
+}
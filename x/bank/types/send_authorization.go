@@ -8,10 +8,14 @@ import (
 
 var _ authz.Authorization = &SendAuthorization{}
 
+// gasCostPerAllowListEntry is the gas charged for each allow list address compared to the recipient.
+const gasCostPerAllowListEntry = uint64(10)
+
 // NewSendAuthorization creates a new SendAuthorization object.
-func NewSendAuthorization(spendLimit sdk.Coins) *SendAuthorization {
+func NewSendAuthorization(spendLimit sdk.Coins, allowed []sdk.AccAddress) *SendAuthorization {
 	return &SendAuthorization{
 		SpendLimit: spendLimit,
+		AllowList:  toBech32Addresses(allowed),
 	}
 }
 
@@ -30,11 +34,25 @@ func (a SendAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptRes
 	if isNegative {
 		return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("requested amount is more than spend limit")
 	}
+
+	isAddrExists := false
+	for _, addr := range a.AllowList {
+		ctx.GasMeter().ConsumeGas(gasCostPerAllowListEntry, "send authorization")
+		if addr == mSend.ToAddress {
+			isAddrExists = true
+			break
+		}
+	}
+
+	if len(a.AllowList) > 0 && !isAddrExists {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot send to %s address", mSend.ToAddress)
+	}
+
 	if limitLeft.IsZero() {
 		return authz.AcceptResponse{Accept: true, Delete: true}, nil
 	}
 
-	return authz.AcceptResponse{Accept: true, Delete: false, Updated: &SendAuthorization{SpendLimit: limitLeft}}, nil
+	return authz.AcceptResponse{Accept: true, Delete: false, Updated: &SendAuthorization{SpendLimit: limitLeft, AllowList: a.AllowList}}, nil
 }
 
 // ValidateBasic implements Authorization.ValidateBasic.
@@ -45,5 +63,30 @@ func (a SendAuthorization) ValidateBasic() error {
 	if !a.SpendLimit.IsAllPositive() {
 		return sdkerrors.ErrInvalidCoins.Wrapf("spend limit must be positive")
 	}
+
+	found := make(map[string]bool, 0)
+	for _, addr := range a.AllowList {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid allow list address %s: %s", addr, err)
+		}
+		if found[addr] {
+			return sdkerrors.ErrInvalidAddress.Wrapf("duplicate entry found %s", addr)
+		}
+
+		found[addr] = true
+	}
+
 	return nil
 }
+
+func toBech32Addresses(allowed []sdk.AccAddress) []string {
+	if len(allowed) == 0 {
+		return nil
+	}
+
+	allowedAddrs := make([]string, len(allowed))
+	for i, addr := range allowed {
+		allowedAddrs[i] = addr.String()
+	}
+	return allowedAddrs
+}
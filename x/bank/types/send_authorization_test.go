@@ -21,7 +21,7 @@ var (
 func TestSendAuthorization(t *testing.T) {
 	app := simapp.Setup(t, false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
-	authorization := types.NewSendAuthorization(coins1000)
+	authorization := types.NewSendAuthorization(coins1000, nil)
 
 	t.Log("verify authorization returns valid method name")
 	require.Equal(t, authorization.MsgTypeURL(), "/cosmos.bank.v1beta1.MsgSend")
@@ -36,7 +36,7 @@ func TestSendAuthorization(t *testing.T) {
 	require.True(t, resp.Delete)
 	require.Nil(t, resp.Updated)
 
-	authorization = types.NewSendAuthorization(coins1000)
+	authorization = types.NewSendAuthorization(coins1000, nil)
 	require.Equal(t, authorization.MsgTypeURL(), "/cosmos.bank.v1beta1.MsgSend")
 	require.NoError(t, authorization.ValidateBasic())
 	send = types.NewMsgSend(fromAddr, toAddr, coins500)
@@ -47,7 +47,7 @@ func TestSendAuthorization(t *testing.T) {
 	require.NoError(t, err)
 	require.False(t, resp.Delete)
 	require.NotNil(t, resp.Updated)
-	sendAuth := types.NewSendAuthorization(coins500)
+	sendAuth := types.NewSendAuthorization(coins500, nil)
 	require.Equal(t, sendAuth.String(), resp.Updated.String())
 
 	t.Log("expect updated authorization nil after spending remaining amount")
@@ -56,3 +56,27 @@ func TestSendAuthorization(t *testing.T) {
 	require.True(t, resp.Delete)
 	require.Nil(t, resp.Updated)
 }
+
+func TestSendAuthorizationAllowList(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	unknownAddr := sdk.AccAddress("_____unknown_____")
+	authorization := types.NewSendAuthorization(coins1000, []sdk.AccAddress{toAddr})
+	require.NoError(t, authorization.ValidateBasic())
+
+	t.Log("expect error when sending to an address out of the allow list")
+	_, err := authorization.Accept(ctx, types.NewMsgSend(fromAddr, unknownAddr, coins500))
+	require.Error(t, err)
+
+	t.Log("verify updated authorization keeps the allow list")
+	resp, err := authorization.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins500))
+	require.NoError(t, err)
+	require.False(t, resp.Delete)
+	require.Equal(t, types.NewSendAuthorization(coins500, []sdk.AccAddress{toAddr}).String(), resp.Updated.String())
+
+	t.Log("expect error on duplicate or invalid allow list addresses")
+	authorization = types.NewSendAuthorization(coins1000, []sdk.AccAddress{toAddr, toAddr})
+	require.Error(t, authorization.ValidateBasic())
+	authorization = &types.SendAuthorization{SpendLimit: coins1000, AllowList: []string{"foo"}}
+	require.Error(t, authorization.ValidateBasic())
+}
@@ -0,0 +1,123 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// GetQueryCmd returns the cli query commands for the circuit module.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the circuit module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdQueryAccount(),
+		GetCmdQueryAccounts(),
+		GetCmdQueryDisabledList(),
+	)
+
+	return queryCmd
+}
+
+// GetCmdQueryAccount implements the query account permissions command.
+func GetCmdQueryAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "account [address]",
+		Short:   "Query the circuit breaker permissions of an account",
+		Example: fmt.Sprintf(`$ %s query %s account <address>`, version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Account(cmd.Context(), &types.QueryAccountRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryAccounts implements the query all accounts permissions command.
+func GetCmdQueryAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "accounts",
+		Short:   "Query all the accounts with circuit breaker permissions",
+		Example: fmt.Sprintf(`$ %s query %s accounts`, version.AppName, types.ModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Accounts(cmd.Context(), &types.QueryAccountsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "accounts")
+	return cmd
+}
+
+// GetCmdQueryDisabledList implements the query disabled Msg type URLs command.
+func GetCmdQueryDisabledList() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "disabled-list",
+		Short:   "Query the Msg type URLs disabled by the circuit breaker",
+		Example: fmt.Sprintf(`$ %s query %s disabled-list`, version.AppName, types.ModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.DisabledList(cmd.Context(), &types.QueryDisabledListRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
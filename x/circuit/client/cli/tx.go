@@ -0,0 +1,154 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// GetTxCmd returns the transaction commands for the circuit module.
+func GetTxCmd() *cobra.Command {
+	circuitTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "circuit transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	circuitTxCmd.AddCommand(
+		NewAuthorizeCircuitBreakerCmd(),
+		NewTripCircuitBreakerCmd(),
+		NewResetCircuitBreakerCmd(),
+	)
+
+	return circuitTxCmd
+}
+
+// NewAuthorizeCircuitBreakerCmd returns a CLI command handler for creating a
+// MsgAuthorizeCircuitBreaker transaction.
+func NewAuthorizeCircuitBreakerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authorize [grantee] [permission_level] [limit_type_urls] --from [granter]",
+		Short: "Authorize an account to trip the circuit breaker.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Authorize an account to trip the circuit breaker.
+The permission level is one of: "none", "some-msgs", "all-msgs" or "super-admin".
+limit_type_urls is a comma separated list of Msg type URLs and is only used with "some-msgs".
+
+Example:
+$ %s tx %s authorize <grantee> some-msgs "/cosmos.bank.v1beta1.MsgSend,/cosmos.bank.v1beta1.MsgMultiSend" --from <granter>
+`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			level, err := parsePermissionLevel(args[1])
+			if err != nil {
+				return err
+			}
+
+			var typeURLs []string
+			if len(args) == 3 {
+				typeURLs = strings.Split(args[2], ",")
+			}
+
+			permission := types.Permissions{Level: level, LimitTypeUrls: typeURLs}
+
+			msg := types.NewMsgAuthorizeCircuitBreaker(clientCtx.GetFromAddress().String(), args[0], &permission)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewTripCircuitBreakerCmd returns a CLI command handler for creating a
+// MsgTripCircuitBreaker transaction.
+func NewTripCircuitBreakerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disable [type_url...] --from [authority]",
+		Short: "Disable a message from being executed",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Disable one or more Msg type URLs from being executed.
+
+Example:
+$ %s tx %s disable /cosmos.bank.v1beta1.MsgSend /cosmos.authz.v1beta1.MsgExec --from <authority>
+`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTripCircuitBreaker(clientCtx.GetFromAddress().String(), args)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewResetCircuitBreakerCmd returns a CLI command handler for creating a
+// MsgResetCircuitBreaker transaction.
+func NewResetCircuitBreakerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset [type_url...] --from [authority]",
+		Short: "Enable a message to be executed",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Re-enable one or more previously disabled Msg type URLs. If no type URL is
+given, all the disabled type URLs the account is authorized for are re-enabled.
+
+Example:
+$ %s tx %s reset /cosmos.bank.v1beta1.MsgSend --from <authority>
+`, version.AppName, types.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgResetCircuitBreaker(clientCtx.GetFromAddress().String(), args)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parsePermissionLevel parses a human readable permission level into its
+// Permissions_Level value.
+func parsePermissionLevel(level string) (types.Permissions_Level, error) {
+	switch strings.ToLower(level) {
+	case "none":
+		return types.Permissions_LEVEL_NONE_UNSPECIFIED, nil
+	case "some-msgs":
+		return types.Permissions_LEVEL_SOME_MSGS, nil
+	case "all-msgs":
+		return types.Permissions_LEVEL_ALL_MSGS, nil
+	case "super-admin":
+		return types.Permissions_LEVEL_SUPER_ADMIN, nil
+	default:
+		return 0, fmt.Errorf("invalid permission level %s, must be one of none, some-msgs, all-msgs or super-admin", level)
+	}
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// InitGenesis initializes the circuit module's state from a given genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	for _, accounts := range genState.AccountPermissions {
+		addr, err := sdk.AccAddressFromBech32(accounts.Address)
+		if err != nil {
+			panic(err)
+		}
+
+		k.SetPermissions(ctx, addr, accounts.Permissions)
+	}
+
+	for _, url := range genState.DisabledTypeUrls {
+		k.DisableMsg(ctx, url)
+	}
+}
+
+// ExportGenesis returns the circuit module's genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	var accounts []*types.GenesisAccountPermissions
+	k.IteratePermissions(ctx, func(address sdk.AccAddress, perms types.Permissions) bool {
+		accounts = append(accounts, &types.GenesisAccountPermissions{
+			Address:     address.String(),
+			Permissions: &perms,
+		})
+		return false
+	})
+
+	var disabledMsgs []string
+	k.IterateDisableLists(ctx, func(msgURL string) bool {
+		disabledMsgs = append(disabledMsgs, msgURL)
+		return false
+	})
+
+	return types.NewGenesisState(accounts, disabledMsgs)
+}
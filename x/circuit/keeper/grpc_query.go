@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Account returns the circuit breaker permissions of the given account.
+func (k Keeper) Account(c context.Context, req *types.QueryAccountRequest) (*types.AccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	perms := k.GetPermissions(ctx, addr)
+
+	return &types.AccountResponse{Permission: &perms}, nil
+}
+
+// Accounts returns all the accounts with circuit breaker permissions.
+func (k Keeper) Accounts(c context.Context, req *types.QueryAccountsRequest) (*types.AccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountPermissionPrefix)
+
+	var accounts []*types.GenesisAccountPermissions
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var perms types.Permissions
+		if err := k.cdc.Unmarshal(value, &perms); err != nil {
+			return err
+		}
+
+		accounts = append(accounts, &types.GenesisAccountPermissions{
+			Address:     types.AddressFromPermissionsStore(key).String(),
+			Permissions: &perms,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.AccountsResponse{Accounts: accounts, Pagination: pageRes}, nil
+}
+
+// DisabledList returns all the Msg type URLs disabled by the circuit breaker.
+func (k Keeper) DisabledList(c context.Context, req *types.QueryDisabledListRequest) (*types.DisabledListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var msgs []string
+	k.IterateDisableLists(ctx, func(msgURL string) bool {
+		msgs = append(msgs, msgURL)
+		return false
+	})
+
+	return &types.DisabledListResponse{DisabledList: msgs}, nil
+}
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+var _ baseapp.CircuitBreaker = Keeper{}
+
+// Keeper defines the circuit module's keeper.
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+
+	// the address capable of executing circuit breaker messages without
+	// any explicit permissions. Typically, this should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper constructs a new Circuit Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, authority string) Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(err)
+	}
+
+	return Keeper{
+		storeKey:  storeKey,
+		cdc:       cdc,
+		authority: authority,
+	}
+}
+
+// GetAuthority returns the x/circuit module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// IsAllowed implements baseapp.CircuitBreaker. It returns false if the given
+// Msg type URL has been disabled through the circuit breaker.
+func (k Keeper) IsAllowed(goCtx context.Context, msgURL string) (bool, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return !k.IsMsgDisabled(ctx, msgURL), nil
+}
+
+// GetPermissions returns the circuit breaker permissions of the given account.
+// An account without stored permissions is returned LEVEL_NONE_UNSPECIFIED
+// permissions.
+func (k Keeper) GetPermissions(ctx sdk.Context, address sdk.AccAddress) types.Permissions {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.CreateAddressPrefix(address))
+	if bz == nil {
+		return types.Permissions{}
+	}
+
+	var perms types.Permissions
+	k.cdc.MustUnmarshal(bz, &perms)
+	return perms
+}
+
+// SetPermissions sets the circuit breaker permissions of the given account.
+// Setting LEVEL_NONE_UNSPECIFIED permissions removes the account's entry.
+func (k Keeper) SetPermissions(ctx sdk.Context, address sdk.AccAddress, perms *types.Permissions) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.CreateAddressPrefix(address)
+
+	if perms == nil || perms.Level == types.Permissions_LEVEL_NONE_UNSPECIFIED {
+		store.Delete(key)
+		return
+	}
+
+	store.Set(key, k.cdc.MustMarshal(perms))
+}
+
+// IsMsgDisabled returns true if the given Msg type URL is disabled.
+func (k Keeper) IsMsgDisabled(ctx sdk.Context, msgURL string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.CreateDisableMsgPrefix(msgURL))
+}
+
+// DisableMsg disables execution of the given Msg type URL.
+func (k Keeper) DisableMsg(ctx sdk.Context, msgURL string) {
+	ctx.KVStore(k.storeKey).Set(types.CreateDisableMsgPrefix(msgURL), []byte{0x01})
+}
+
+// EnableMsg re-enables execution of the given Msg type URL.
+func (k Keeper) EnableMsg(ctx sdk.Context, msgURL string) {
+	ctx.KVStore(k.storeKey).Delete(types.CreateDisableMsgPrefix(msgURL))
+}
+
+// IteratePermissions iterates over all the accounts with circuit breaker
+// permissions and calls the provided callback. If the callback returns true,
+// iteration is halted.
+func (k Keeper) IteratePermissions(ctx sdk.Context, cb func(address sdk.AccAddress, perms types.Permissions) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountPermissionPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var perms types.Permissions
+		k.cdc.MustUnmarshal(iterator.Value(), &perms)
+
+		if cb(types.AddressFromPermissionsStore(iterator.Key()), perms) {
+			break
+		}
+	}
+}
+
+// IterateDisableLists iterates over all the disabled Msg type URLs and calls
+// the provided callback. If the callback returns true, iteration is halted.
+func (k Keeper) IterateDisableLists(ctx sdk.Context, cb func(msgURL string) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DisableListPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(string(iterator.Key())) {
+			break
+		}
+	}
+}
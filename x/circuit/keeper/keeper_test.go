@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/keeper"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var msgSendURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	msgServer   types.MsgServer
+	queryClient types.QueryClient
+	authority   string
+	addrs       []sdk.AccAddress
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(s.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.CircuitKeeper)
+
+	s.app = app
+	s.ctx = ctx
+	s.msgServer = keeper.NewMsgServerImpl(app.CircuitKeeper)
+	s.queryClient = types.NewQueryClient(queryHelper)
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	s.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) TestPermissions() {
+	k := s.app.CircuitKeeper
+	addr := s.addrs[0]
+
+	perms := k.GetPermissions(s.ctx, addr)
+	s.Require().Equal(types.Permissions_LEVEL_NONE_UNSPECIFIED, perms.Level)
+
+	expected := types.Permissions{Level: types.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{msgSendURL}}
+	k.SetPermissions(s.ctx, addr, &expected)
+	s.Require().Equal(expected, k.GetPermissions(s.ctx, addr))
+
+	k.SetPermissions(s.ctx, addr, &types.Permissions{})
+	s.Require().Equal(types.Permissions{}, k.GetPermissions(s.ctx, addr))
+
+	var count int
+	k.IteratePermissions(s.ctx, func(sdk.AccAddress, types.Permissions) bool {
+		count++
+		return false
+	})
+	s.Require().Zero(count)
+}
+
+func (s *KeeperTestSuite) TestIsAllowed() {
+	k := s.app.CircuitKeeper
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	allowed, err := k.IsAllowed(goCtx, msgSendURL)
+	s.Require().NoError(err)
+	s.Require().True(allowed)
+
+	k.DisableMsg(s.ctx, msgSendURL)
+	allowed, err = k.IsAllowed(goCtx, msgSendURL)
+	s.Require().NoError(err)
+	s.Require().False(allowed)
+
+	k.EnableMsg(s.ctx, msgSendURL)
+	allowed, err = k.IsAllowed(goCtx, msgSendURL)
+	s.Require().NoError(err)
+	s.Require().True(allowed)
+}
+
+func (s *KeeperTestSuite) TestCircuitBreakerBlocksMsgExecution() {
+	msg := banktypes.NewMsgSend(s.addrs[0], s.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))
+	handler := s.app.MsgServiceRouter().Handler(msg)
+	s.Require().NotNil(handler)
+
+	_, err := handler(s.ctx, msg)
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.TripCircuitBreaker(sdk.WrapSDKContext(s.ctx), types.NewMsgTripCircuitBreaker(s.authority, []string{msgSendURL}))
+	s.Require().NoError(err)
+
+	_, err = handler(s.ctx, msg)
+	s.Require().ErrorContains(err, "circuit breaker disables execution of this message")
+
+	_, err = s.msgServer.ResetCircuitBreaker(sdk.WrapSDKContext(s.ctx), types.NewMsgResetCircuitBreaker(s.authority, []string{msgSendURL}))
+	s.Require().NoError(err)
+
+	_, err = handler(s.ctx, msg)
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueries() {
+	k := s.app.CircuitKeeper
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	perms := types.Permissions{Level: types.Permissions_LEVEL_ALL_MSGS}
+	k.SetPermissions(s.ctx, s.addrs[0], &perms)
+	k.DisableMsg(s.ctx, msgSendURL)
+
+	accRes, err := s.queryClient.Account(goCtx, &types.QueryAccountRequest{Address: s.addrs[0].String()})
+	s.Require().NoError(err)
+	s.Require().Equal(&perms, accRes.Permission)
+
+	_, err = s.queryClient.Account(goCtx, &types.QueryAccountRequest{Address: "invalid"})
+	s.Require().Error(err)
+
+	accsRes, err := s.queryClient.Accounts(goCtx, &types.QueryAccountsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(accsRes.Accounts, 1)
+	s.Require().Equal(s.addrs[0].String(), accsRes.Accounts[0].Address)
+	s.Require().Equal(&perms, accsRes.Accounts[0].Permissions)
+
+	listRes, err := s.queryClient.DisabledList(goCtx, &types.QueryDisabledListRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{msgSendURL}, listRes.DisabledList)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	k := s.app.CircuitKeeper
+
+	genesis := types.NewGenesisState(
+		[]*types.GenesisAccountPermissions{
+			{Address: s.addrs[0].String(), Permissions: &types.Permissions{Level: types.Permissions_LEVEL_SUPER_ADMIN}},
+			{Address: s.addrs[1].String(), Permissions: &types.Permissions{Level: types.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{msgSendURL}}},
+		},
+		[]string{msgSendURL},
+	)
+	s.Require().NoError(genesis.Validate())
+
+	k.InitGenesis(s.ctx, genesis)
+	exported := k.ExportGenesis(s.ctx)
+
+	s.Require().ElementsMatch(genesis.AccountPermissions, exported.AccountPermissions)
+	s.Require().Equal(genesis.DisabledTypeUrls, exported.DisabledTypeUrls)
+}
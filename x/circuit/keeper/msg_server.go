@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the circuit MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// AuthorizeCircuitBreaker implements the Msg/AuthorizeCircuitBreaker Msg service.
+func (k msgServer) AuthorizeCircuitBreaker(goCtx context.Context, msg *types.MsgAuthorizeCircuitBreaker) (*types.MsgAuthorizeCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	// the authority is implicitly a super admin, every other granter must have
+	// been explicitly granted LEVEL_SUPER_ADMIN.
+	if msg.Granter != k.authority {
+		perms := k.GetPermissions(ctx, granter)
+		if perms.Level != types.Permissions_LEVEL_SUPER_ADMIN {
+			return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "only super admins can authorize users, got %s", perms.Level)
+		}
+	}
+
+	if err := msg.Permissions.Validate(); err != nil {
+		return nil, err
+	}
+
+	k.SetPermissions(ctx, grantee, msg.Permissions)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuthorizeCircuitBreaker,
+			sdk.NewAttribute(types.AttributeKeyGranter, msg.Granter),
+			sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee),
+			sdk.NewAttribute(types.AttributeKeyPermission, msg.Permissions.String()),
+		),
+	)
+
+	return &types.MsgAuthorizeCircuitBreakerResponse{Success: true}, nil
+}
+
+// TripCircuitBreaker implements the Msg/TripCircuitBreaker Msg service.
+func (k msgServer) TripCircuitBreaker(goCtx context.Context, msg *types.MsgTripCircuitBreaker) (*types.MsgTripCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	perms, err := k.permissionsOf(ctx, msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, msgTypeURL := range msg.MsgTypeUrls {
+		if k.IsMsgDisabled(ctx, msgTypeURL) {
+			return nil, sdkerrors.Wrapf(types.ErrAlreadyDisabled, "%s", msgTypeURL)
+		}
+
+		if !perms.CanTrip(msgTypeURL) {
+			return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "account does not have permission to trip circuit breaker for %s", msgTypeURL)
+		}
+
+		k.DisableMsg(ctx, msgTypeURL)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTripCircuitBreaker,
+			sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURLs, strings.Join(msg.MsgTypeUrls, ",")),
+		),
+	)
+
+	return &types.MsgTripCircuitBreakerResponse{Success: true}, nil
+}
+
+// ResetCircuitBreaker implements the Msg/ResetCircuitBreaker Msg service.
+func (k msgServer) ResetCircuitBreaker(goCtx context.Context, msg *types.MsgResetCircuitBreaker) (*types.MsgResetCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	perms, err := k.permissionsOf(ctx, msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	msgTypeURLs := msg.MsgTypeUrls
+	if len(msgTypeURLs) == 0 {
+		// reset every disabled type URL the account is allowed to reset.
+		k.IterateDisableLists(ctx, func(msgURL string) bool {
+			if perms.CanTrip(msgURL) {
+				msgTypeURLs = append(msgTypeURLs, msgURL)
+			}
+			return false
+		})
+	}
+
+	for _, msgTypeURL := range msgTypeURLs {
+		if !k.IsMsgDisabled(ctx, msgTypeURL) {
+			return nil, sdkerrors.Wrapf(types.ErrNotDisabled, "%s", msgTypeURL)
+		}
+
+		if !perms.CanTrip(msgTypeURL) {
+			return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "account does not have permission to reset circuit breaker for %s", msgTypeURL)
+		}
+
+		k.EnableMsg(ctx, msgTypeURL)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeResetCircuitBreaker,
+			sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURLs, strings.Join(msgTypeURLs, ",")),
+		),
+	)
+
+	return &types.MsgResetCircuitBreakerResponse{Success: true}, nil
+}
+
+// permissionsOf returns the effective permissions of the given account. The
+// module authority always acts as a super admin.
+func (k msgServer) permissionsOf(ctx sdk.Context, account string) (types.Permissions, error) {
+	if account == k.authority {
+		return types.Permissions{Level: types.Permissions_LEVEL_SUPER_ADMIN}, nil
+	}
+
+	addr, err := sdk.AccAddressFromBech32(account)
+	if err != nil {
+		return types.Permissions{}, err
+	}
+
+	return k.GetPermissions(ctx, addr), nil
+}
@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+func (s *KeeperTestSuite) TestAuthorizeCircuitBreaker() {
+	superAdmin, someMsgs, grantee := s.addrs[0].String(), s.addrs[1].String(), s.addrs[2].String()
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgAuthorizeCircuitBreaker
+		expErr bool
+	}{
+		{
+			"authority grants super admin",
+			types.NewMsgAuthorizeCircuitBreaker(s.authority, superAdmin, &types.Permissions{Level: types.Permissions_LEVEL_SUPER_ADMIN}),
+			false,
+		},
+		{
+			"super admin grants some msgs",
+			types.NewMsgAuthorizeCircuitBreaker(superAdmin, someMsgs, &types.Permissions{Level: types.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{msgSendURL}}),
+			false,
+		},
+		{
+			"non super admin cannot grant",
+			types.NewMsgAuthorizeCircuitBreaker(someMsgs, grantee, &types.Permissions{Level: types.Permissions_LEVEL_ALL_MSGS}),
+			true,
+		},
+		{
+			"invalid permissions",
+			types.NewMsgAuthorizeCircuitBreaker(superAdmin, grantee, &types.Permissions{Level: types.Permissions_LEVEL_SOME_MSGS}),
+			true,
+		},
+		{
+			"super admin revokes permissions",
+			types.NewMsgAuthorizeCircuitBreaker(superAdmin, someMsgs, &types.Permissions{}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.AuthorizeCircuitBreaker(sdk.WrapSDKContext(s.ctx), tc.msg)
+			if tc.expErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			grantee, err := sdk.AccAddressFromBech32(tc.msg.Grantee)
+			s.Require().NoError(err)
+			s.Require().Equal(*tc.msg.Permissions, s.app.CircuitKeeper.GetPermissions(s.ctx, grantee))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestTripAndResetCircuitBreaker() {
+	k := s.app.CircuitKeeper
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	someMsgs, allMsgs, none := s.addrs[0], s.addrs[1], s.addrs[2]
+	otherURL := "/cosmos.bank.v1beta1.MsgMultiSend"
+
+	k.SetPermissions(s.ctx, someMsgs, &types.Permissions{Level: types.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{msgSendURL}})
+	k.SetPermissions(s.ctx, allMsgs, &types.Permissions{Level: types.Permissions_LEVEL_ALL_MSGS})
+
+	// an account without permissions cannot trip the circuit breaker
+	_, err := s.msgServer.TripCircuitBreaker(goCtx, types.NewMsgTripCircuitBreaker(none.String(), []string{msgSendURL}))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// some msgs accounts can only trip the allowed type URLs
+	_, err = s.msgServer.TripCircuitBreaker(goCtx, types.NewMsgTripCircuitBreaker(someMsgs.String(), []string{otherURL}))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.TripCircuitBreaker(goCtx, types.NewMsgTripCircuitBreaker(someMsgs.String(), []string{msgSendURL}))
+	s.Require().NoError(err)
+	s.Require().True(k.IsMsgDisabled(s.ctx, msgSendURL))
+
+	// tripping twice fails
+	_, err = s.msgServer.TripCircuitBreaker(goCtx, types.NewMsgTripCircuitBreaker(allMsgs.String(), []string{msgSendURL}))
+	s.Require().ErrorIs(err, types.ErrAlreadyDisabled)
+
+	_, err = s.msgServer.TripCircuitBreaker(goCtx, types.NewMsgTripCircuitBreaker(allMsgs.String(), []string{otherURL}))
+	s.Require().NoError(err)
+	s.Require().True(k.IsMsgDisabled(s.ctx, otherURL))
+
+	// resetting a type URL that is not disabled fails
+	_, err = s.msgServer.ResetCircuitBreaker(goCtx, types.NewMsgResetCircuitBreaker(allMsgs.String(), []string{"/cosmos.gov.v1.MsgVote"}))
+	s.Require().ErrorIs(err, types.ErrNotDisabled)
+
+	_, err = s.msgServer.ResetCircuitBreaker(goCtx, types.NewMsgResetCircuitBreaker(someMsgs.String(), []string{otherURL}))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// an empty reset only resets the type URLs the account is allowed to reset
+	_, err = s.msgServer.ResetCircuitBreaker(goCtx, types.NewMsgResetCircuitBreaker(someMsgs.String(), nil))
+	s.Require().NoError(err)
+	s.Require().False(k.IsMsgDisabled(s.ctx, msgSendURL))
+	s.Require().True(k.IsMsgDisabled(s.ctx, otherURL))
+
+	_, err = s.msgServer.ResetCircuitBreaker(goCtx, types.NewMsgResetCircuitBreaker(s.authority, nil))
+	s.Require().NoError(err)
+	s.Require().False(k.IsMsgDisabled(s.ctx, otherURL))
+}
@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/circuit module sentinel errors
+var (
+	ErrInvalidPermissions = sdkerrors.Register(ModuleName, 2, "invalid permissions")
+	ErrUnauthorized       = sdkerrors.Register(ModuleName, 3, "account is not authorized")
+	ErrAlreadyDisabled    = sdkerrors.Register(ModuleName, 4, "message is already disabled")
+	ErrNotDisabled        = sdkerrors.Register(ModuleName, 5, "message is not disabled")
+)
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(accounts []*GenesisAccountPermissions, disabledTypeURLs []string) *GenesisState {
+	return &GenesisState{
+		AccountPermissions: accounts,
+		DisabledTypeUrls:   disabledTypeURLs,
+	}
+}
+
+// DefaultGenesisState returns a default circuit module genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs *GenesisState) Validate() error {
+	seenAccounts := make(map[string]bool, len(gs.AccountPermissions))
+	for _, account := range gs.AccountPermissions {
+		if _, err := sdk.AccAddressFromBech32(account.Address); err != nil {
+			return fmt.Errorf("invalid account address %s: %w", account.Address, err)
+		}
+
+		if seenAccounts[account.Address] {
+			return fmt.Errorf("duplicate permissions for account %s", account.Address)
+		}
+		seenAccounts[account.Address] = true
+
+		if err := account.Permissions.Validate(); err != nil {
+			return fmt.Errorf("invalid permissions for account %s: %w", account.Address, err)
+		}
+	}
+
+	seenURLs := make(map[string]bool, len(gs.DisabledTypeUrls))
+	for _, url := range gs.DisabledTypeUrls {
+		if url == "" {
+			return fmt.Errorf("disabled type url cannot be empty")
+		}
+
+		if seenURLs[url] {
+			return fmt.Errorf("duplicate disabled type url %s", url)
+		}
+		seenURLs[url] = true
+	}
+
+	return nil
+}
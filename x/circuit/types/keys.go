@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "circuit"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+)
+
+// KVStore keys
+var (
+	AccountPermissionPrefix = []byte{0x01}
+	DisableListPrefix       = []byte{0x02}
+)
+
+// CreateAddressPrefix returns the store key under which the permissions of
+// the given account are stored.
+func CreateAddressPrefix(account sdk.AccAddress) []byte {
+	return append(AccountPermissionPrefix, address.MustLengthPrefix(account)...)
+}
+
+// CreateDisableMsgPrefix returns the store key used to mark the given Msg type
+// URL as disabled.
+func CreateDisableMsgPrefix(msgURL string) []byte {
+	return append(DisableListPrefix, []byte(msgURL)...)
+}
+
+// AddressFromPermissionsStore returns an account address from a permissions
+// prefix store key. The key must not contain the prefix AccountPermissionPrefix
+// as the prefix store iterator discards the actual prefix.
+func AddressFromPermissionsStore(key []byte) sdk.AccAddress {
+	kv.AssertKeyAtLeastLength(key, 1)
+	addrLen := int(key[0])
+	kv.AssertKeyLength(key[1:], addrLen)
+
+	return key[1 : addrLen+1]
+}
@@ -0,0 +1,139 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_, _, _ sdk.Msg            = &MsgAuthorizeCircuitBreaker{}, &MsgTripCircuitBreaker{}, &MsgResetCircuitBreaker{}
+	_, _, _ legacytx.LegacyMsg = &MsgAuthorizeCircuitBreaker{}, &MsgTripCircuitBreaker{}, &MsgResetCircuitBreaker{}
+)
+
+// NewMsgAuthorizeCircuitBreaker creates a new MsgAuthorizeCircuitBreaker instance.
+func NewMsgAuthorizeCircuitBreaker(granter, grantee string, permissions *Permissions) *MsgAuthorizeCircuitBreaker {
+	return &MsgAuthorizeCircuitBreaker{
+		Granter:     granter,
+		Grantee:     grantee,
+		Permissions: permissions,
+	}
+}
+
+// Route implements the LegacyMsg interface.
+func (m MsgAuthorizeCircuitBreaker) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgAuthorizeCircuitBreaker) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgAuthorizeCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgAuthorizeCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Granter); err != nil {
+		return sdkerrors.Wrap(err, "granter")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Grantee); err != nil {
+		return sdkerrors.Wrap(err, "grantee")
+	}
+
+	return m.Permissions.Validate()
+}
+
+// GetSigners returns the expected signers for MsgAuthorizeCircuitBreaker.
+func (m *MsgAuthorizeCircuitBreaker) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Granter)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgTripCircuitBreaker creates a new MsgTripCircuitBreaker instance.
+func NewMsgTripCircuitBreaker(authority string, urls []string) *MsgTripCircuitBreaker {
+	return &MsgTripCircuitBreaker{
+		Authority:   authority,
+		MsgTypeUrls: urls,
+	}
+}
+
+// Route implements the LegacyMsg interface.
+func (m MsgTripCircuitBreaker) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgTripCircuitBreaker) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgTripCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgTripCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrap(err, "authority")
+	}
+
+	if len(m.MsgTypeUrls) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "msg type urls cannot be empty")
+	}
+
+	return validateTypeURLs(m.MsgTypeUrls)
+}
+
+// GetSigners returns the expected signers for MsgTripCircuitBreaker.
+func (m *MsgTripCircuitBreaker) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgResetCircuitBreaker creates a new MsgResetCircuitBreaker instance.
+func NewMsgResetCircuitBreaker(authority string, urls []string) *MsgResetCircuitBreaker {
+	return &MsgResetCircuitBreaker{
+		Authority:   authority,
+		MsgTypeUrls: urls,
+	}
+}
+
+// Route implements the LegacyMsg interface.
+func (m MsgResetCircuitBreaker) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgResetCircuitBreaker) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgResetCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgResetCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrap(err, "authority")
+	}
+
+	return validateTypeURLs(m.MsgTypeUrls)
+}
+
+// GetSigners returns the expected signers for MsgResetCircuitBreaker.
+func (m *MsgResetCircuitBreaker) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func validateTypeURLs(urls []string) error {
+	seen := make(map[string]bool, len(urls))
+	for _, url := range urls {
+		if url == "" {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "msg type url cannot be empty")
+		}
+
+		if seen[url] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate msg type url %s", url)
+		}
+		seen[url] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+var (
+	granter = sdk.AccAddress("granter").String()
+	grantee = sdk.AccAddress("grantee").String()
+	msgURL  = "/cosmos.bank.v1beta1.MsgSend"
+)
+
+func TestMsgAuthorizeCircuitBreaker(t *testing.T) {
+	testCases := []struct {
+		name   string
+		msg    *types.MsgAuthorizeCircuitBreaker
+		expErr bool
+	}{
+		{"valid", types.NewMsgAuthorizeCircuitBreaker(granter, grantee, &types.Permissions{Level: types.Permissions_LEVEL_ALL_MSGS}), false},
+		{"valid revoke", types.NewMsgAuthorizeCircuitBreaker(granter, grantee, &types.Permissions{}), false},
+		{"valid some msgs", types.NewMsgAuthorizeCircuitBreaker(granter, grantee, &types.Permissions{Level: types.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{msgURL}}), false},
+		{"invalid granter", types.NewMsgAuthorizeCircuitBreaker("granter", grantee, &types.Permissions{}), true},
+		{"invalid grantee", types.NewMsgAuthorizeCircuitBreaker(granter, "grantee", &types.Permissions{}), true},
+		{"nil permissions", types.NewMsgAuthorizeCircuitBreaker(granter, grantee, nil), true},
+		{"some msgs without type urls", types.NewMsgAuthorizeCircuitBreaker(granter, grantee, &types.Permissions{Level: types.Permissions_LEVEL_SOME_MSGS}), true},
+		{"type urls with all msgs", types.NewMsgAuthorizeCircuitBreaker(granter, grantee, &types.Permissions{Level: types.Permissions_LEVEL_ALL_MSGS, LimitTypeUrls: []string{msgURL}}), true},
+		{"unknown level", types.NewMsgAuthorizeCircuitBreaker(granter, grantee, &types.Permissions{Level: 42}), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgTripAndResetCircuitBreaker(t *testing.T) {
+	require.NoError(t, types.NewMsgTripCircuitBreaker(granter, []string{msgURL}).ValidateBasic())
+	require.Error(t, types.NewMsgTripCircuitBreaker(granter, nil).ValidateBasic())
+	require.Error(t, types.NewMsgTripCircuitBreaker("granter", []string{msgURL}).ValidateBasic())
+	require.Error(t, types.NewMsgTripCircuitBreaker(granter, []string{msgURL, msgURL}).ValidateBasic())
+	require.Error(t, types.NewMsgTripCircuitBreaker(granter, []string{""}).ValidateBasic())
+
+	require.NoError(t, types.NewMsgResetCircuitBreaker(granter, []string{msgURL}).ValidateBasic())
+	require.NoError(t, types.NewMsgResetCircuitBreaker(granter, nil).ValidateBasic())
+	require.Error(t, types.NewMsgResetCircuitBreaker("granter", nil).ValidateBasic())
+	require.Error(t, types.NewMsgResetCircuitBreaker(granter, []string{""}).ValidateBasic())
+}
+
+func TestGenesisStateValidate(t *testing.T) {
+	require.NoError(t, types.DefaultGenesisState().Validate())
+
+	perms := &types.Permissions{Level: types.Permissions_LEVEL_SUPER_ADMIN}
+	require.NoError(t, types.NewGenesisState([]*types.GenesisAccountPermissions{{Address: granter, Permissions: perms}}, []string{msgURL}).Validate())
+	require.Error(t, types.NewGenesisState([]*types.GenesisAccountPermissions{{Address: "granter", Permissions: perms}}, nil).Validate())
+	require.Error(t, types.NewGenesisState([]*types.GenesisAccountPermissions{{Address: granter, Permissions: perms}, {Address: granter, Permissions: perms}}, nil).Validate())
+	require.Error(t, types.NewGenesisState([]*types.GenesisAccountPermissions{{Address: granter}}, nil).Validate())
+	require.Error(t, types.NewGenesisState(nil, []string{msgURL, msgURL}).Validate())
+	require.Error(t, types.NewGenesisState(nil, []string{""}).Validate())
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate performs basic validation of the permissions. LimitTypeUrls may
+// only be set, and must be set, when the level is LEVEL_SOME_MSGS.
+func (p *Permissions) Validate() error {
+	if p == nil {
+		return sdkerrors.Wrap(ErrInvalidPermissions, "permissions cannot be nil")
+	}
+
+	if _, ok := Permissions_Level_name[int32(p.Level)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidPermissions, "unknown permission level %d", p.Level)
+	}
+
+	switch p.Level {
+	case Permissions_LEVEL_SOME_MSGS:
+		if len(p.LimitTypeUrls) == 0 {
+			return sdkerrors.Wrap(ErrInvalidPermissions, "limit_type_urls must be set for LEVEL_SOME_MSGS")
+		}
+	default:
+		if len(p.LimitTypeUrls) != 0 {
+			return sdkerrors.Wrapf(ErrInvalidPermissions, "limit_type_urls can only be used with LEVEL_SOME_MSGS, got %s", p.Level)
+		}
+	}
+
+	for _, url := range p.LimitTypeUrls {
+		if url == "" {
+			return sdkerrors.Wrap(ErrInvalidPermissions, "limit_type_urls cannot contain empty type urls")
+		}
+	}
+
+	return nil
+}
+
+// CanTrip returns true if the permissions allow tripping or resetting the
+// circuit breaker for the given Msg type URL.
+func (p Permissions) CanTrip(msgURL string) bool {
+	switch p.Level {
+	case Permissions_LEVEL_SUPER_ADMIN, Permissions_LEVEL_ALL_MSGS:
+		return true
+	case Permissions_LEVEL_SOME_MSGS:
+		for _, url := range p.LimitTypeUrls {
+			if url == msgURL {
+				return true
+			}
+		}
+	}
+
+	return false
+}
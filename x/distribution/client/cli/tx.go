@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -41,6 +42,7 @@ func NewTxCmd() *cobra.Command {
 		NewWithdrawAllRewardsCmd(),
 		NewSetWithdrawAddrCmd(),
 		NewFundCommunityPoolCmd(),
+		NewWithdrawTokenizeShareRecordRewardCmd(),
 	)
 
 	return distTxCmd
@@ -260,6 +262,43 @@ $ %s tx distribution fund-community-pool 100uatom --from mykey
 	return cmd
 }
 
+// NewWithdrawTokenizeShareRecordRewardCmd returns a CLI command handler for
+// creating a MsgWithdrawTokenizeShareRecordReward transaction.
+func NewWithdrawTokenizeShareRecordRewardCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-tokenize-share-record-reward [record-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Withdraw the rewards of a tokenize share record to its owner",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw the rewards of a tokenize share record owned by the sender.
+
+Example:
+$ %s tx distribution withdraw-tokenize-share-record-reward 1 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			recordID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawTokenizeShareRecordReward(clientCtx.GetFromAddress(), recordID)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdSubmitProposal implements the command to submit a community-pool-spend proposal
 func GetCmdSubmitProposal() *cobra.Command {
 	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -121,3 +122,14 @@ func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _
 func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
 	return nil
 }
+
+func (h Hooks) AfterConsensusPubKeyUpdate(_ sdk.Context, _, _ cryptotypes.PubKey, _ sdk.Coin) error {
+	return nil
+}
+
+// BeforeTokenizeShareRecordRedeemed pays the pending rewards of a tokenize
+// share record to its owner, as the record module account cannot withdraw them
+func (h Hooks) BeforeTokenizeShareRecordRedeemed(ctx sdk.Context, recordID uint64) error {
+	_, err := h.k.WithdrawTokenizeShareRecordReward(ctx, recordID)
+	return err
+}
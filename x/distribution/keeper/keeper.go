@@ -17,19 +17,23 @@ import (
 type Keeper struct {
 	storeKey      storetypes.StoreKey
 	cdc           codec.BinaryCodec
-	paramSpace    paramtypes.Subspace
+	paramSpace    paramtypes.Subspace // legacy params subspace, only used by the migrations
 	authKeeper    types.AccountKeeper
 	bankKeeper    types.BankKeeper
 	stakingKeeper types.StakingKeeper
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
 }
 
 // NewKeeper creates a new distribution Keeper instance
 func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
 	ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper,
-	feeCollectorName string,
+	feeCollectorName string, authority string,
 ) Keeper {
 	// ensure distribution module account is set
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
@@ -49,9 +53,15 @@ func NewKeeper(
 		bankKeeper:       bk,
 		stakingKeeper:    sk,
 		feeCollectorName: feeCollectorName,
+		authority:        authority,
 	}
 }
 
+// GetAuthority returns the x/distribution module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -109,6 +119,52 @@ func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddres
 	return rewards, nil
 }
 
+// WithdrawTokenizeShareRecordReward withdraws the rewards of the delegation
+// of a tokenize share record and sends them, along with any rewards already
+// withdrawn to the record module account, to the record owner.
+func (k Keeper) WithdrawTokenizeShareRecordReward(ctx sdk.Context, recordID uint64) (sdk.Coins, error) {
+	record, err := k.stakingKeeper.GetTokenizeShareRecord(ctx, recordID)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(record.Owner)
+	if err != nil {
+		return nil, err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	// the rewards of the record delegation are withdrawn to the record module
+	// account, which cannot sign to move them
+	moduleAddr := record.GetModuleAddress()
+	if k.stakingKeeper.Validator(ctx, valAddr) != nil && k.stakingKeeper.Delegation(ctx, moduleAddr, valAddr) != nil {
+		if _, err := k.WithdrawDelegationRewards(ctx, moduleAddr, valAddr); err != nil {
+			return nil, err
+		}
+	}
+
+	rewards := k.bankKeeper.GetAllBalances(ctx, moduleAddr)
+	if !rewards.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, moduleAddr, owner, rewards); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawTokenizeShareRecordReward,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, rewards.String()),
+			sdk.NewAttribute(types.AttributeKeyRecordID, fmt.Sprintf("%d", recordID)),
+			sdk.NewAttribute(types.AttributeKeyWithdrawAddress, record.Owner),
+		),
+	)
+
+	return rewards, nil
+}
+
 // withdraw validator commission
 func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddress) (sdk.Coins, error) {
 	// fetch validator accumulated commission
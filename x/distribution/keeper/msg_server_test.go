@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMsgUpdateParams(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.DistrKeeper)
+
+	params := types.DefaultParams()
+	params.CommunityTax = sdk.NewDecWithPrec(5, 2)
+
+	testCases := []struct {
+		name      string
+		authority string
+		expErr    bool
+	}{
+		{"invalid authority", sdk.AccAddress("invalid_authority___").String(), true},
+		{"all good", app.DistrKeeper.GetAuthority(), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{
+				Authority: tc.authority,
+				Params:    params,
+			})
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, params, app.DistrKeeper.GetParams(ctx))
+		})
+	}
+}
+
+func TestMsgWithdrawTokenizeShareRecordReward(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.DistrKeeper)
+	stakingMsgServer := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+
+	delTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	addr := simapp.AddTestAddrs(app, ctx, 1, delTokens)[0]
+	valAddr := sdk.ValAddress(addr)
+	owner := sdk.AccAddress("owner_______________")
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	tstaking.CreateValidator(valAddr, valConsPk1, delTokens, true)
+
+	// end block to bond validator and start new block
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// tokenize half of the self-delegation to a record owned by owner
+	tokenizeAmount := sdk.NewCoin(sdk.DefaultBondDenom, delTokens.QuoRaw(2))
+	res, err := stakingMsgServer.TokenizeShares(sdk.WrapSDKContext(ctx), stakingtypes.NewMsgTokenizeShares(addr, valAddr, tokenizeAmount, owner))
+	require.NoError(t, err)
+	record, err := app.StakingKeeper.GetTokenizeShareRecordByDenom(ctx, res.Amount.Denom)
+	require.NoError(t, err)
+
+	// the record delegation earns rewards from the next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate rewards, half of which go to the record delegation
+	allocate := func() {
+		rewards := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+		require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, types.ModuleName, rewards))
+		app.DistrKeeper.AllocateTokensToValidator(ctx, app.StakingKeeper.Validator(ctx, valAddr), sdk.NewDecCoinsFromCoins(rewards...))
+	}
+	allocate()
+
+	// only the record owner can withdraw the record rewards
+	_, err = msgServer.WithdrawTokenizeShareRecordReward(sdk.WrapSDKContext(ctx), types.NewMsgWithdrawTokenizeShareRecordReward(addr, record.Id))
+	require.ErrorIs(t, err, types.ErrNotTokenizeShareRecordOwner)
+
+	withdrawRes, err := msgServer.WithdrawTokenizeShareRecordReward(sdk.WrapSDKContext(ctx), types.NewMsgWithdrawTokenizeShareRecordReward(owner, record.Id))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)), withdrawRes.Amount)
+	require.Equal(t, withdrawRes.Amount, app.BankKeeper.GetAllBalances(ctx, owner))
+
+	// the pending rewards are paid to the owner when the record is redeemed
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	allocate()
+	_, err = stakingMsgServer.RedeemTokensForShares(sdk.WrapSDKContext(ctx), stakingtypes.NewMsgRedeemTokensForShares(addr, res.Amount))
+	require.NoError(t, err)
+
+	_, err = app.StakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+	require.ErrorIs(t, err, stakingtypes.ErrTokenizeShareRecordNotExists)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)), app.BankKeeper.GetAllBalances(ctx, owner))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, record.GetModuleAddress()).IsZero())
+}
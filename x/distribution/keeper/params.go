@@ -6,38 +6,41 @@ import (
 )
 
 // GetParams returns the total set of distribution parameters.
-func (k Keeper) GetParams(clientCtx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(clientCtx, &params)
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
 	return params
 }
 
-// SetParams sets the distribution parameters to the param space.
+// SetParams sets the distribution parameters.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramSpace.SetParamSet(ctx, &params)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ParamsKey, k.cdc.MustMarshal(&params))
 }
 
 // GetCommunityTax returns the current distribution community tax.
-func (k Keeper) GetCommunityTax(ctx sdk.Context) (percent sdk.Dec) {
-	k.paramSpace.Get(ctx, types.ParamStoreKeyCommunityTax, &percent)
-	return percent
+func (k Keeper) GetCommunityTax(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).CommunityTax
 }
 
 // GetBaseProposerReward returns the current distribution base proposer rate.
-func (k Keeper) GetBaseProposerReward(ctx sdk.Context) (percent sdk.Dec) {
-	k.paramSpace.Get(ctx, types.ParamStoreKeyBaseProposerReward, &percent)
-	return percent
+func (k Keeper) GetBaseProposerReward(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).BaseProposerReward
 }
 
 // GetBonusProposerReward returns the current distribution bonus proposer reward
 // rate.
-func (k Keeper) GetBonusProposerReward(ctx sdk.Context) (percent sdk.Dec) {
-	k.paramSpace.Get(ctx, types.ParamStoreKeyBonusProposerReward, &percent)
-	return percent
+func (k Keeper) GetBonusProposerReward(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).BonusProposerReward
 }
 
 // GetWithdrawAddrEnabled returns the current distribution withdraw address
 // enabled parameter.
-func (k Keeper) GetWithdrawAddrEnabled(ctx sdk.Context) (enabled bool) {
-	k.paramSpace.Get(ctx, types.ParamStoreKeyWithdrawAddrEnabled, &enabled)
-	return enabled
+func (k Keeper) GetWithdrawAddrEnabled(ctx sdk.Context) bool {
+	return k.GetParams(ctx).WithdrawAddrEnabled
 }
@@ -0,0 +1,35 @@
+package v047_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v047 "github.com/cosmos/cosmos-sdk/x/distribution/migrations/v047"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	distrKey := sdk.NewKVStoreKey(types.StoreKey)
+	tDistrKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(distrKey, tDistrKey)
+	store := ctx.KVStore(distrKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, distrKey, tDistrKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	legacyParams := types.DefaultParams()
+	legacyParams.CommunityTax = sdk.NewDecWithPrec(5, 2)
+	paramstore.SetParamSet(ctx, &legacyParams)
+	require.False(t, store.Has(types.ParamsKey))
+
+	require.NoError(t, v047.MigrateStore(ctx, distrKey, paramstore, encCfg.Codec))
+
+	var params types.Params
+	encCfg.Codec.MustUnmarshal(store.Get(types.ParamsKey), &params)
+	require.Equal(t, legacyParams, params)
+}
@@ -9,8 +9,11 @@ const (
 	EventTypeWithdrawCommission = "withdraw_commission"
 	EventTypeProposerReward     = "proposer_reward"
 
+	EventTypeWithdrawTokenizeShareRecordReward = "withdraw_tokenize_share_record_reward"
+
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
 	AttributeKeyDelegator       = "delegator"
+	AttributeKeyRecordID        = "record_id"
 	AttributeValueCategory      = ModuleName
 )
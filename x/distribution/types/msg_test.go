@@ -93,3 +93,47 @@ func TestMsgDepositIntoCommunityPool(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgUpdateParams
+func TestMsgUpdateParams(t *testing.T) {
+	invalidParams := DefaultParams()
+	invalidParams.CommunityTax = sdk.NewDec(2)
+
+	tests := []struct {
+		authority  string
+		params     Params
+		expectPass bool
+	}{
+		{delAddr1.String(), DefaultParams(), true},
+		{"invalid", DefaultParams(), false},
+		{delAddr1.String(), invalidParams, false},
+	}
+	for i, tc := range tests {
+		msg := MsgUpdateParams{Authority: tc.authority, Params: tc.params}
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgWithdrawTokenizeShareRecordReward
+func TestMsgWithdrawTokenizeShareRecordReward(t *testing.T) {
+	tests := []struct {
+		ownerAddr  sdk.AccAddress
+		recordID   uint64
+		expectPass bool
+	}{
+		{delAddr1, 1, true},
+		{emptyDelAddr, 1, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgWithdrawTokenizeShareRecordReward(tc.ownerAddr, tc.recordID)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package epoching
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/keeper"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// EndBlocker executes the queued messages at the end of each epoch and moves
+// to the next epoch.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	if !k.IsEpochEnd(ctx) {
+		return
+	}
+
+	epochNumber := k.GetEpochNumber(ctx)
+	k.ExecuteEpochActions(ctx)
+	k.IncreaseEpochNumber(ctx)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEpochEnd,
+			sdk.NewAttribute(types.AttributeKeyEpochNumber, fmt.Sprint(epochNumber)),
+		),
+	)
+}
@@ -0,0 +1,118 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// GetQueryCmd returns the cli query commands for the epoching module.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the epoching module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQueryCurrentEpoch(),
+		GetCmdQueryQueuedMessages(),
+	)
+
+	return queryCmd
+}
+
+// GetCmdQueryParams implements the query params command.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Short:   "Query the current epoching parameters",
+		Example: fmt.Sprintf(`$ %s query %s params`, version.AppName, types.ModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryCurrentEpoch implements the query current epoch command.
+func GetCmdQueryCurrentEpoch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "current-epoch",
+		Short:   "Query the current epoch number and the end of the epoch",
+		Example: fmt.Sprintf(`$ %s query %s current-epoch`, version.AppName, types.ModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CurrentEpoch(cmd.Context(), &types.QueryCurrentEpochRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryQueuedMessages implements the query queued messages command.
+func GetCmdQueryQueuedMessages() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "queued-messages",
+		Short:   "Query the messages pending execution at the end of the epoch",
+		Example: fmt.Sprintf(`$ %s query %s queued-messages`, version.AppName, types.ModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.QueuedMessages(cmd.Context(), &types.QueryQueuedMessagesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "queued messages")
+	return cmd
+}
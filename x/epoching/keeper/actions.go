@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// GetQueuedMessages returns all the queued messages along with their epoch
+// number and action ID.
+func (k Keeper) GetQueuedMessages(ctx sdk.Context) []types.QueuedMessage {
+	var msgs []types.QueuedMessage
+
+	iterator := k.GetEpochActionsIterator(ctx)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		epochNumber, actionID := ParseActionStoreKey(iterator.Key()[len(EpochActionQueuePrefix):])
+		queued, err := types.NewQueuedMessage(actionID, epochNumber, k.GetEpochActionByIterator(iterator))
+		if err != nil {
+			panic(err)
+		}
+
+		msgs = append(msgs, queued)
+	}
+
+	return msgs
+}
+
+// IsEpochEnd returns true if the current block is the last block of an epoch.
+func (k Keeper) IsEpochEnd(ctx sdk.Context) bool {
+	return ctx.BlockHeight()%k.EpochLength(ctx) == 0
+}
+
+// ExecuteEpochActions runs the messages queued for the current epoch, and for
+// any earlier epoch, through the MsgServiceRouter and removes them from the
+// queue. Messages queued for a later epoch are left untouched. Each message is
+// executed in its own cached context: the state changes of a message are only
+// committed if it succeeds, and a failing message does not prevent the
+// execution of the following ones. A success or failure event is emitted for
+// every message.
+func (k Keeper) ExecuteEpochActions(ctx sdk.Context) {
+	epochNumber := k.GetEpochNumber(ctx)
+
+	// collect the keys first so that the store is not written to while
+	// iterating over it
+	var keys [][]byte
+	iterator := k.GetEpochActionsUntilIterator(ctx, epochNumber)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		actionEpoch, actionID := ParseActionStoreKey(key[len(EpochActionQueuePrefix):])
+		msg := k.getEpochActionByKey(ctx, key)
+		k.DeleteByKey(ctx, key)
+
+		if err := k.executeEpochAction(ctx, msg); err != nil {
+			k.Logger(ctx).Info("failed to execute epoch action", "epoch", actionEpoch, "action", actionID, "err", err)
+
+			// revert the side effects of queueing the message, the state would
+			// be inconsistent if this fails
+			if msg != nil {
+				if hookErr := k.AfterEpochActionFailure(ctx, msg); hookErr != nil {
+					panic(fmt.Sprintf("failed to revert epoch action %d: %v", actionID, hookErr))
+				}
+			}
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeEpochActionFailure,
+					sdk.NewAttribute(types.AttributeKeyEpochNumber, fmt.Sprint(actionEpoch)),
+					sdk.NewAttribute(types.AttributeKeyActionID, fmt.Sprint(actionID)),
+					sdk.NewAttribute(types.AttributeKeyMsgTypeURL, sdk.MsgTypeURL(msg)),
+					sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+				),
+			)
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeEpochActionSuccess,
+				sdk.NewAttribute(types.AttributeKeyEpochNumber, fmt.Sprint(actionEpoch)),
+				sdk.NewAttribute(types.AttributeKeyActionID, fmt.Sprint(actionID)),
+				sdk.NewAttribute(types.AttributeKeyMsgTypeURL, sdk.MsgTypeURL(msg)),
+			),
+		)
+	}
+}
+
+type executingEpochActionKey struct{}
+
+// IsExecutingEpochAction returns true if the context is the one of a queued
+// message being executed at the end of an epoch. Modules queueing messages use
+// it to tell a message being queued from a queued message being executed.
+func (k Keeper) IsExecutingEpochAction(ctx sdk.Context) bool {
+	executing, _ := ctx.Value(executingEpochActionKey{}).(bool)
+	return executing
+}
+
+// executeEpochAction executes a single queued message in a cached context and
+// writes its state changes and events if it succeeds.
+func (k Keeper) executeEpochAction(ctx sdk.Context, msg sdk.Msg) (err error) {
+	if msg == nil {
+		return sdkerrors.ErrInvalidType.Wrap("queued action is not a sdk.Msg")
+	}
+
+	handler := k.router.Handler(msg)
+	if handler == nil {
+		return sdkerrors.ErrUnknownRequest.Wrapf("unrecognized message route: %s", sdk.MsgTypeURL(msg))
+	}
+
+	// a panicking handler must not halt the chain in EndBlock
+	defer func() {
+		if r := recover(); r != nil {
+			err = sdkerrors.ErrPanic.Wrapf("%v", r)
+		}
+	}()
+
+	cacheCtx, write := ctx.CacheContext()
+	res, err := handler(cacheCtx.WithValue(executingEpochActionKey{}, true), msg)
+	if err != nil {
+		return err
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(res.GetEvents())
+
+	return nil
+}
+
+// getEpochActionByKey returns the queued message stored under the given key.
+func (k Keeper) getEpochActionByKey(ctx sdk.Context, key []byte) sdk.Msg {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return nil
+	}
+
+	var action sdk.Msg
+	if err := k.cdc.UnmarshalInterface(bz, &action); err != nil {
+		return nil
+	}
+
+	return action
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// InitGenesis initializes the epoching module's state from a given genesis
+// state. Queued messages are queued for the current epoch.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+	k.SetEpochNumber(ctx, genState.EpochNumber)
+
+	for _, action := range genState.QueuedMsgs {
+		k.RestoreEpochAction(ctx, genState.EpochNumber, action)
+	}
+}
+
+// ExportGenesis returns the epoching module's genesis state. Queued messages
+// are exported in execution order without their epoch number.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	queued := k.GetQueuedMessages(ctx)
+
+	genState := types.NewGenesisState(k.GetParams(ctx), k.GetEpochNumber(ctx), nil)
+	for _, m := range queued {
+		genState.QueuedMsgs = append(genState.QueuedMsgs, m.Msg)
+	}
+
+	return genState
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params queries the parameters of the epoching module.
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// CurrentEpoch queries the current epoch number and the end of the epoch.
+func (k Keeper) CurrentEpoch(c context.Context, req *types.QueryCurrentEpochRequest) (*types.QueryCurrentEpochResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	epochLength := k.EpochLength(ctx)
+
+	return &types.QueryCurrentEpochResponse{
+		CurrentEpoch:    k.GetEpochNumber(ctx),
+		NextEpochHeight: k.GetNextEpochHeight(ctx, epochLength),
+		NextEpochTime:   k.GetNextEpochTime(ctx, epochLength),
+	}, nil
+}
+
+// QueuedMessages queries the messages pending execution at the end of an epoch.
+func (k Keeper) QueuedMessages(c context.Context, req *types.QueryQueuedMessagesRequest) (*types.QueryQueuedMessagesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), EpochActionQueuePrefix)
+
+	var msgs []types.QueuedMessage
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var msg sdk.Msg
+		if err := k.cdc.UnmarshalInterface(value, &msg); err != nil {
+			return err
+		}
+
+		epochNumber, actionID := ParseActionStoreKey(key)
+		queued, err := types.NewQueuedMessage(actionID, epochNumber, msg)
+		if err != nil {
+			return err
+		}
+
+		msgs = append(msgs, queued)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryQueuedMessagesResponse{Msgs: msgs, Pagination: pageRes}, nil
+}
@@ -0,0 +1,18 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// Implements EpochingHooks interface
+var _ types.EpochingHooks = Keeper{}
+
+// AfterEpochActionFailure - call hook if registered
+func (k Keeper) AfterEpochActionFailure(ctx sdk.Context, msg sdk.Msg) error {
+	if k.hooks != nil {
+		return k.hooks.AfterEpochActionFailure(ctx, msg)
+	}
+
+	return nil
+}
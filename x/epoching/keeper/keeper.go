@@ -1,13 +1,19 @@
 package keeper
 
 import (
+	"fmt"
 	"time"
 
+	"github.com/tendermint/tendermint/libs/log"
+	db "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	db "github.com/tendermint/tm-db"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 const (
@@ -23,33 +29,62 @@ var (
 
 // Keeper of the store
 type Keeper struct {
-	storeKey storetypes.StoreKey
-	cdc      codec.BinaryCodec
+	storeKey   storetypes.StoreKey
+	cdc        codec.BinaryCodec
+	paramSpace paramtypes.Subspace
+	router     *baseapp.MsgServiceRouter
+	hooks      types.EpochingHooks
 	// Used to calculate the estimated next epoch time.
 	// This is local to every node
 	// TODO: remove in favor of consensus param when its added
 	commitTimeout time.Duration
 }
 
-// NewKeeper creates a epoch queue manager
-func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, commitTimeout time.Duration) Keeper {
+// NewKeeper creates a epoch queue manager. The router is used to execute the
+// queued messages at the end of each epoch.
+func NewKeeper(
+	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
+	router *baseapp.MsgServiceRouter, commitTimeout time.Duration,
+) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
 		storeKey:      key,
 		cdc:           cdc,
+		paramSpace:    paramSpace,
+		router:        router,
 		commitTimeout: commitTimeout,
 	}
 }
 
+// SetHooks sets the epoching hooks
+func (k *Keeper) SetHooks(eh types.EpochingHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set epoching hooks twice")
+	}
+
+	k.hooks = eh
+
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
 // GetNewActionID returns ID to be used for next epoch
 func (k Keeper) GetNewActionID(ctx sdk.Context) uint64 {
 	store := ctx.KVStore(k.storeKey)
 
-	bz := store.Get(NextEpochActionID)
-	if bz == nil {
-		// return default action ID to 1
-		return DefaultEpochActionID
+	// default action ID is 1
+	id := uint64(DefaultEpochActionID)
+	if bz := store.Get(NextEpochActionID); bz != nil {
+		id = sdk.BigEndianToUint64(bz)
 	}
-	id := sdk.BigEndianToUint64(bz)
 
 	// increment next action ID
 	store.Set(NextEpochActionID, sdk.Uint64ToBigEndian(id+1))
@@ -57,9 +92,34 @@ func (k Keeper) GetNewActionID(ctx sdk.Context) uint64 {
 	return id
 }
 
-// ActionStoreKey returns action store key from ID
+// EpochActionsPrefix returns the prefix of the actions queued for an epoch.
+//
+// Key format:
+// - <0x13><epoch_number_bytes>
+func EpochActionsPrefix(epochNumber int64) []byte {
+	key := make([]byte, 0, len(EpochActionQueuePrefix)+8)
+	key = append(key, EpochActionQueuePrefix...)
+	return append(key, sdk.Uint64ToBigEndian(uint64(epochNumber))...)
+}
+
+// ActionStoreKey returns action store key from ID. Both the epoch number and
+// the action ID are big-endian encoded so that the actions are iterated in
+// epoch then queue order.
+//
+// Key format:
+// - <0x13><epoch_number_bytes><action_id_bytes>
 func ActionStoreKey(epochNumber int64, actionID uint64) []byte {
-	return append(EpochActionQueuePrefix, byte(epochNumber), byte(actionID))
+	return append(EpochActionsPrefix(epochNumber), sdk.Uint64ToBigEndian(actionID)...)
+}
+
+// ParseActionStoreKey returns the epoch number and action ID encoded in an
+// action store key, without its EpochActionQueuePrefix.
+func ParseActionStoreKey(key []byte) (epochNumber int64, actionID uint64) {
+	if len(key) != 16 {
+		panic(fmt.Sprintf("unexpected action store key length (%d ≠ 16)", len(key)))
+	}
+
+	return int64(sdk.BigEndianToUint64(key[:8])), sdk.BigEndianToUint64(key[8:])
 }
 
 // QueueMsgForEpoch save the actions that need to be executed on next epoch
@@ -79,8 +139,9 @@ func (k Keeper) QueueMsgForEpoch(ctx sdk.Context, epochNumber int64, msg sdk.Msg
 func (k Keeper) RestoreEpochAction(ctx sdk.Context, epochNumber int64, action *codectypes.Any) {
 	store := ctx.KVStore(k.storeKey)
 
-	// reference from TestMarshalAny(t *testing.T)
-	bz, err := k.cdc.MarshalInterface(action)
+	// the action is already packed, store it as is so that it can be read back
+	// with UnmarshalInterface
+	bz, err := k.cdc.Marshal(action)
 	if err != nil {
 		panic(err)
 	}
@@ -125,6 +186,33 @@ func (k Keeper) GetEpochActionsIterator(ctx sdk.Context) db.Iterator {
 	return sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), EpochActionQueuePrefix)
 }
 
+// GetEpochActionsIteratorByEpoch returns an iterator over the actions queued
+// for the given epoch, in queue order.
+func (k Keeper) GetEpochActionsIteratorByEpoch(ctx sdk.Context, epochNumber int64) db.Iterator {
+	return sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), EpochActionsPrefix(epochNumber))
+}
+
+// GetEpochActionsUntilIterator returns an iterator over the actions queued for
+// all the epochs up to and including the given one, in epoch then queue order.
+func (k Keeper) GetEpochActionsUntilIterator(ctx sdk.Context, epochNumber int64) db.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(EpochActionQueuePrefix, sdk.PrefixEndBytes(EpochActionsPrefix(epochNumber)))
+}
+
+// GetEpochActionsByEpoch returns the actions queued for the given epoch, in
+// queue order.
+func (k Keeper) GetEpochActionsByEpoch(ctx sdk.Context, epochNumber int64) []sdk.Msg {
+	actions := []sdk.Msg{}
+	iterator := k.GetEpochActionsIteratorByEpoch(ctx, epochNumber)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		actions = append(actions, k.GetEpochActionByIterator(iterator))
+	}
+
+	return actions
+}
+
 // DequeueEpochActions dequeue all the actions store on epoch
 func (k Keeper) DequeueEpochActions(ctx sdk.Context) {
 	store := ctx.KVStore(k.storeKey)
@@ -0,0 +1,187 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+	"github.com/cosmos/cosmos-sdk/x/epoching/keeper"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	queryClient types.QueryClient
+	addrs       []sdk.AccAddress
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(s.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.EpochingKeeper)
+
+	s.app = app
+	s.ctx = ctx
+	s.queryClient = types.NewQueryClient(queryHelper)
+	s.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) sendMsg(from, to sdk.AccAddress, amount int64) *banktypes.MsgSend {
+	return banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)))
+}
+
+func (s *KeeperTestSuite) TestQueueMsgForEpoch() {
+	k := s.app.EpochingKeeper
+
+	msg := s.sendMsg(s.addrs[0], s.addrs[1], 100)
+	k.QueueMsgForEpoch(s.ctx, 0, msg)
+
+	queued := k.GetQueuedMessages(s.ctx)
+	s.Require().Len(queued, 1)
+	s.Require().Equal(int64(0), queued[0].EpochNumber)
+	s.Require().Equal(uint64(1), queued[0].ActionId)
+	queuedMsg, err := queued[0].GetMessage()
+	s.Require().NoError(err)
+	s.Require().Equal(msg, queuedMsg)
+	s.Require().Equal(msg, k.GetEpochMsg(s.ctx, 0, 1))
+}
+
+func (s *KeeperTestSuite) TestActionStoreKey() {
+	k := s.app.EpochingKeeper
+
+	// keys must not collide once the epoch number or action ID exceed a byte
+	for i := int64(1); i <= 300; i++ {
+		k.QueueMsgForEpoch(s.ctx, 256, s.sendMsg(s.addrs[0], s.addrs[1], i))
+	}
+	k.QueueMsgForEpoch(s.ctx, 0, s.sendMsg(s.addrs[0], s.addrs[1], 1000))
+
+	actions := k.GetEpochActionsByEpoch(s.ctx, 256)
+	s.Require().Len(actions, 300)
+	for i, action := range actions {
+		s.Require().Equal(s.sendMsg(s.addrs[0], s.addrs[1], int64(i+1)), action)
+	}
+	s.Require().Len(k.GetEpochActionsByEpoch(s.ctx, 0), 1)
+	s.Require().Len(k.GetQueuedMessages(s.ctx), 301)
+
+	epochNumber, actionID := keeper.ParseActionStoreKey(keeper.ActionStoreKey(256, 300)[len(keeper.EpochActionQueuePrefix):])
+	s.Require().Equal(int64(256), epochNumber)
+	s.Require().Equal(uint64(300), actionID)
+}
+
+func (s *KeeperTestSuite) TestEndBlockerSkipsLaterEpochs() {
+	k := s.app.EpochingKeeper
+
+	k.QueueMsgForEpoch(s.ctx, 0, s.sendMsg(s.addrs[0], s.addrs[1], 100))
+	k.QueueMsgForEpoch(s.ctx, 1, s.sendMsg(s.addrs[0], s.addrs[1], 200))
+
+	ctx := s.ctx.WithBlockHeight(k.EpochLength(s.ctx))
+	epoching.EndBlocker(ctx, k)
+
+	s.Require().Empty(k.GetEpochActionsByEpoch(ctx, 0))
+	s.Require().Len(k.GetEpochActionsByEpoch(ctx, 1), 1)
+}
+
+func (s *KeeperTestSuite) TestEndBlocker() {
+	k := s.app.EpochingKeeper
+	epochLength := k.EpochLength(s.ctx)
+
+	ok := s.sendMsg(s.addrs[0], s.addrs[1], 100)
+	tooMuch := s.sendMsg(s.addrs[1], s.addrs[2], 100000000)
+	k.QueueMsgForEpoch(s.ctx, 0, ok)
+	k.QueueMsgForEpoch(s.ctx, 0, tooMuch)
+
+	// nothing is executed in the middle of an epoch
+	epoching.EndBlocker(s.ctx, k)
+	s.Require().Len(k.GetQueuedMessages(s.ctx), 2)
+	s.Require().Equal(int64(0), k.GetEpochNumber(s.ctx))
+
+	before := s.app.BankKeeper.GetBalance(s.ctx, s.addrs[1], sdk.DefaultBondDenom)
+
+	ctx := s.ctx.WithBlockHeight(epochLength).WithEventManager(sdk.NewEventManager())
+	epoching.EndBlocker(ctx, k)
+
+	s.Require().Empty(k.GetQueuedMessages(ctx))
+	s.Require().Equal(int64(1), k.GetEpochNumber(ctx))
+
+	// the first message succeeded, the second one failed without side effects
+	after := s.app.BankKeeper.GetBalance(ctx, s.addrs[1], sdk.DefaultBondDenom)
+	s.Require().Equal(before.Amount.AddRaw(100), after.Amount)
+
+	var success, failure, end int
+	for _, event := range ctx.EventManager().Events() {
+		switch event.Type {
+		case types.EventTypeEpochActionSuccess:
+			success++
+		case types.EventTypeEpochActionFailure:
+			failure++
+		case types.EventTypeEpochEnd:
+			end++
+		}
+	}
+	s.Require().Equal(1, success)
+	s.Require().Equal(1, failure)
+	s.Require().Equal(1, end)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	k := s.app.EpochingKeeper
+
+	k.SetEpochNumber(s.ctx, 3)
+	k.QueueMsgForEpoch(s.ctx, 3, s.sendMsg(s.addrs[0], s.addrs[1], 100))
+	k.QueueMsgForEpoch(s.ctx, 3, s.sendMsg(s.addrs[1], s.addrs[2], 200))
+
+	genState := k.ExportGenesis(s.ctx)
+	s.Require().Equal(int64(3), genState.EpochNumber)
+	s.Require().Len(genState.QueuedMsgs, 2)
+	s.Require().NoError(genState.Validate())
+
+	app := simapp.Setup(s.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	app.EpochingKeeper.InitGenesis(ctx, *genState)
+
+	s.Require().Equal(genState, app.EpochingKeeper.ExportGenesis(ctx))
+	s.Require().Len(app.EpochingKeeper.GetQueuedMessages(ctx), 2)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueries() {
+	k := s.app.EpochingKeeper
+
+	params, err := s.queryClient.Params(s.ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultParams(), params.Params)
+
+	epoch, err := s.queryClient.CurrentEpoch(s.ctx, &types.QueryCurrentEpochRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(0), epoch.CurrentEpoch)
+	s.Require().Equal(types.DefaultEpochLength, epoch.NextEpochHeight)
+
+	for i := int64(1); i <= 3; i++ {
+		k.QueueMsgForEpoch(s.ctx, 0, s.sendMsg(s.addrs[0], s.addrs[1], i))
+	}
+
+	res, err := s.queryClient.QueuedMessages(s.ctx, &types.QueryQueuedMessagesRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Msgs, 2)
+	s.Require().Equal(uint64(3), res.Pagination.Total)
+	first, err := res.Msgs[0].GetMessage()
+	s.Require().NoError(err)
+	s.Require().Equal(s.sendMsg(s.addrs[0], s.addrs[1], 1), first)
+}
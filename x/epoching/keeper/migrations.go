@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v047 "github.com/cosmos/cosmos-sdk/x/epoching/migrations/v047"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates x/epoching storage from version 1 to 2.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v047.MigrateStore(ctx, m.keeper.storeKey)
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// GetParams returns the total set of epoching parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of epoching parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// EpochLength returns the number of blocks in an epoch.
+func (k Keeper) EpochLength(ctx sdk.Context) int64 {
+	var epochLength int64
+	k.paramSpace.Get(ctx, types.KeyEpochLength, &epochLength)
+	return epochLength
+}
@@ -0,0 +1,22 @@
+package v047
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	NextEpochActionID      = []byte{0x11}
+	EpochNumberID          = []byte{0x12}
+	EpochActionQueuePrefix = []byte{0x13}
+)
+
+// ActionStoreKey returns the action store key used from v0.47 on.
+//
+// Key format:
+// - <0x13><epoch_number_bytes><action_id_bytes>
+func ActionStoreKey(epochNumber int64, actionID uint64) []byte {
+	key := make([]byte, 0, len(EpochActionQueuePrefix)+16)
+	key = append(key, EpochActionQueuePrefix...)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(epochNumber))...)
+	return append(key, sdk.Uint64ToBigEndian(actionID)...)
+}
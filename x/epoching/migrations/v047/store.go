@@ -0,0 +1,68 @@
+package v047
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// legacyActionKeyLen is the length of the action store keys before v0.47,
+// without their prefix: one byte for the epoch number and one byte for the
+// action ID.
+const legacyActionKeyLen = 2
+
+// migrateActionKeys re-keys the queued actions from the legacy
+// <0x13><epoch_number_byte><action_id_byte> format to the full-width
+// <0x13><epoch_number_bytes><action_id_bytes> format.
+//
+// The legacy keys only hold the lowest byte of the epoch number and of the
+// action ID, so they are not enough to recover the original values. As the
+// queue is drained at the end of every epoch, all the queued actions are moved
+// to the current epoch and given new action IDs, preserving their iteration
+// order.
+func migrateActionKeys(store storetypes.KVStore) {
+	epochNumber := int64(0)
+	if bz := store.Get(EpochNumberID); bz != nil {
+		epochNumber = int64(sdk.BigEndianToUint64(bz))
+	}
+
+	nextActionID := uint64(1)
+	if bz := store.Get(NextEpochActionID); bz != nil {
+		nextActionID = sdk.BigEndianToUint64(bz)
+	}
+
+	queueStore := prefix.NewStore(store, EpochActionQueuePrefix)
+	iterator := queueStore.Iterator(nil, nil)
+
+	var (
+		oldKeys [][]byte
+		values  [][]byte
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		if len(iterator.Key()) != legacyActionKeyLen {
+			continue
+		}
+
+		oldKeys = append(oldKeys, iterator.Key())
+		values = append(values, iterator.Value())
+	}
+	iterator.Close()
+
+	for i, oldKey := range oldKeys {
+		queueStore.Delete(oldKey)
+		store.Set(ActionStoreKey(epochNumber, nextActionID), values[i])
+		nextActionID++
+	}
+
+	store.Set(NextEpochActionID, sdk.Uint64ToBigEndian(nextActionID))
+}
+
+// MigrateStore performs in-place store migrations from v0.46 to v0.47. The
+// migration includes:
+//
+// - Change the queued action keys to big-endian encoded epoch numbers and
+// action IDs.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey) error {
+	migrateActionKeys(ctx.KVStore(storeKey))
+	return nil
+}
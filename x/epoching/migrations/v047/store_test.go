@@ -0,0 +1,39 @@
+package v047_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v047 "github.com/cosmos/cosmos-sdk/x/epoching/migrations/v047"
+)
+
+func TestMigrateStore(t *testing.T) {
+	epochingKey := sdk.NewKVStoreKey("epoching")
+	ctx := testutil.DefaultContext(epochingKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(epochingKey)
+
+	store.Set(v047.EpochNumberID, sdk.Uint64ToBigEndian(300))
+	store.Set(v047.NextEpochActionID, sdk.Uint64ToBigEndian(260))
+
+	// legacy keys hold the lowest byte of the epoch number and action ID
+	legacyKey := func(epochNumber, actionID byte) []byte {
+		return append(v047.EpochActionQueuePrefix, epochNumber, actionID)
+	}
+	store.Set(legacyKey(44, 1), []byte("first"))
+	store.Set(legacyKey(44, 2), []byte("second"))
+	store.Set(legacyKey(44, 3), []byte("third"))
+
+	require.NoError(t, v047.MigrateStore(ctx, epochingKey))
+
+	require.Nil(t, store.Get(legacyKey(44, 1)))
+	require.Nil(t, store.Get(legacyKey(44, 2)))
+	require.Nil(t, store.Get(legacyKey(44, 3)))
+
+	require.Equal(t, []byte("first"), store.Get(v047.ActionStoreKey(300, 260)))
+	require.Equal(t, []byte("second"), store.Get(v047.ActionStoreKey(300, 261)))
+	require.Equal(t, []byte("third"), store.Get(v047.ActionStoreKey(300, 262)))
+	require.Equal(t, sdk.Uint64ToBigEndian(263), store.Get(v047.NextEpochActionID))
+}
@@ -0,0 +1,569 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/epoching/v1beta1/epoching.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the epoching module.
+type Params struct {
+	// epoch_length is the number of blocks in an epoch. Queued messages are
+	// executed at the end of the last block of each epoch.
+	EpochLength int64 `protobuf:"varint,1,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty" yaml:"epoch_length"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_525f09a6ad1d0fea, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEpochLength() int64 {
+	if m != nil {
+		return m.EpochLength
+	}
+	return 0
+}
+
+// QueuedMessage is a message queued for execution at the end of an epoch.
+type QueuedMessage struct {
+	// action_id is the unique identifier of the queued message.
+	ActionId uint64 `protobuf:"varint,1,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
+	// epoch_number is the epoch at the end of which the message is executed.
+	EpochNumber int64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// msg is the queued message.
+	Msg *types.Any `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (m *QueuedMessage) Reset()         { *m = QueuedMessage{} }
+func (m *QueuedMessage) String() string { return proto.CompactTextString(m) }
+func (*QueuedMessage) ProtoMessage()    {}
+func (*QueuedMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_525f09a6ad1d0fea, []int{1}
+}
+func (m *QueuedMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueuedMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueuedMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueuedMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedMessage.Merge(m, src)
+}
+func (m *QueuedMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuedMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueuedMessage proto.InternalMessageInfo
+
+func (m *QueuedMessage) GetActionId() uint64 {
+	if m != nil {
+		return m.ActionId
+	}
+	return 0
+}
+
+func (m *QueuedMessage) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *QueuedMessage) GetMsg() *types.Any {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "cosmos.epoching.v1beta1.Params")
+	proto.RegisterType((*QueuedMessage)(nil), "cosmos.epoching.v1beta1.QueuedMessage")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/epoching/v1beta1/epoching.proto", fileDescriptor_525f09a6ad1d0fea)
+}
+
+var fileDescriptor_525f09a6ad1d0fea = []byte{
+	// 326 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xb1, 0x4e, 0x02, 0x31,
+	0x1c, 0xc6, 0xaf, 0x42, 0x50, 0x0f, 0x5d, 0x4e, 0x12, 0x10, 0x93, 0x82, 0x37, 0x18, 0x16, 0xda,
+	0x20, 0x1b, 0x9b, 0x2c, 0x46, 0x23, 0x46, 0x19, 0x5d, 0x48, 0xef, 0xae, 0x96, 0x0b, 0xb4, 0x25,
+	0xb4, 0x67, 0xbc, 0xdd, 0x07, 0x70, 0x74, 0xf4, 0x21, 0x7c, 0x08, 0xe3, 0xc4, 0xe8, 0x64, 0x0c,
+	0xbc, 0x81, 0x4f, 0x60, 0x68, 0xef, 0xd0, 0xe9, 0xee, 0xfb, 0xfe, 0xbf, 0x7e, 0x5f, 0xfe, 0xad,
+	0x7b, 0x12, 0x4a, 0xc5, 0xa5, 0xc2, 0x74, 0x26, 0xc3, 0x71, 0x2c, 0x18, 0x7e, 0xe8, 0x04, 0x54,
+	0x93, 0xce, 0xc6, 0x40, 0xb3, 0xb9, 0xd4, 0xd2, 0xab, 0x5a, 0x0e, 0x6d, 0xec, 0x8c, 0xab, 0x57,
+	0x98, 0x64, 0xd2, 0x30, 0x78, 0xfd, 0x67, 0xf1, 0xfa, 0x21, 0x93, 0x92, 0x4d, 0x29, 0x36, 0x2a,
+	0x48, 0xee, 0x31, 0x11, 0x69, 0x3e, 0xb2, 0x49, 0x23, 0x7b, 0x26, 0x8b, 0x35, 0xc2, 0xbf, 0x74,
+	0x4b, 0x37, 0x64, 0x4e, 0xb8, 0xf2, 0x7a, 0xee, 0x9e, 0x69, 0x1a, 0x4d, 0xa9, 0x60, 0x7a, 0x5c,
+	0x03, 0x4d, 0xd0, 0x2a, 0xf4, 0xab, 0x3f, 0x5f, 0x8d, 0x83, 0x94, 0xf0, 0x69, 0xcf, 0xff, 0x3f,
+	0xf5, 0x87, 0x65, 0x23, 0xaf, 0x8c, 0xea, 0x15, 0x5f, 0x5e, 0x1b, 0x8e, 0xff, 0x04, 0xdc, 0xfd,
+	0xdb, 0x84, 0x26, 0x34, 0x1a, 0x50, 0xa5, 0x08, 0xa3, 0xde, 0x91, 0xbb, 0x4b, 0x42, 0x1d, 0x4b,
+	0x31, 0x8a, 0x23, 0x13, 0x58, 0x1c, 0xee, 0x58, 0xe3, 0x22, 0xf2, 0x8e, 0xf3, 0x42, 0x91, 0xf0,
+	0x80, 0xce, 0x6b, 0x5b, 0xeb, 0xc2, 0x2c, 0xf7, 0xda, 0x58, 0x5e, 0xd7, 0x2d, 0x70, 0xc5, 0x6a,
+	0x85, 0x26, 0x68, 0x95, 0x4f, 0x2b, 0xc8, 0x6e, 0x88, 0xf2, 0x0d, 0xd1, 0x99, 0x48, 0xfb, 0xe5,
+	0x8f, 0xb7, 0xf6, 0xb6, 0x8a, 0x26, 0x68, 0xa0, 0xd8, 0x70, 0x4d, 0xf7, 0xcf, 0xdf, 0x97, 0x10,
+	0x2c, 0x96, 0x10, 0x7c, 0x2f, 0x21, 0x78, 0x5e, 0x41, 0x67, 0xb1, 0x82, 0xce, 0xe7, 0x0a, 0x3a,
+	0x77, 0x6d, 0x16, 0xeb, 0x71, 0x12, 0xa0, 0x50, 0xf2, 0xec, 0x16, 0xb2, 0x4f, 0x5b, 0x45, 0x13,
+	0xfc, 0xf8, 0xf7, 0x22, 0x3a, 0x9d, 0x51, 0x15, 0x94, 0x4c, 0x51, 0xf7, 0x77, 0x00, 0x07, 0x0c,
+	0x54, 0x9e, 0xb1, 0x01, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochLength != 0 {
+		i = encodeVarintEpoching(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueuedMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEpoching(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintEpoching(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ActionId != 0 {
+		i = encodeVarintEpoching(dAtA, i, uint64(m.ActionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEpoching(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEpoching(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochLength != 0 {
+		n += 1 + sovEpoching(uint64(m.EpochLength))
+	}
+	return n
+}
+
+func (m *QueuedMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActionId != 0 {
+		n += 1 + sovEpoching(uint64(m.ActionId))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovEpoching(uint64(m.EpochNumber))
+	}
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovEpoching(uint64(l))
+	}
+	return n
+}
+
+func sovEpoching(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEpoching(x uint64) (n int) {
+	return sovEpoching(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEpoching
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEpoching(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEpoching
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuedMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEpoching
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionId", wireType)
+			}
+			m.ActionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEpoching
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEpoching
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &types.Any{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEpoching(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEpoching
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEpoching(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEpoching
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEpoching
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEpoching
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEpoching
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEpoching
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEpoching        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEpoching          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEpoching = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,13 @@
+package types
+
+// epoching module event types
+const (
+	EventTypeEpochEnd           = "epoch_end"
+	EventTypeEpochActionSuccess = "epoch_action_success"
+	EventTypeEpochActionFailure = "epoch_action_failure"
+
+	AttributeKeyEpochNumber = "epoch_number"
+	AttributeKeyActionID    = "action_id"
+	AttributeKeyMsgTypeURL  = "msg_type_url"
+	AttributeKeyError       = "error"
+)
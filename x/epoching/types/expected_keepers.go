@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EpochingHooks event hooks for queued epoch actions (noalias)
+type EpochingHooks interface {
+	// AfterEpochActionFailure is called, outside of the reverted cached context,
+	// when a queued message fails so that the module that queued it can revert
+	// the side effects of queueing it (e.g. escrowed funds). The chain halts if
+	// the hook returns an error.
+	AfterEpochActionFailure(ctx sdk.Context, msg sdk.Msg) error
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = GenesisState{}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, epochNumber int64, queuedMsgs []*codectypes.Any) *GenesisState {
+	return &GenesisState{
+		Params:      params,
+		EpochNumber: epochNumber,
+		QueuedMsgs:  queuedMsgs,
+	}
+}
+
+// DefaultGenesisState returns a default epoching module genesis state.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), 0, nil)
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if gs.EpochNumber < 0 {
+		return fmt.Errorf("epoch number cannot be negative: %d", gs.EpochNumber)
+	}
+
+	for i, any := range gs.QueuedMsgs {
+		msg, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return fmt.Errorf("queued message %d is not a sdk.Msg: %s", i, any.TypeUrl)
+		}
+
+		if err := msg.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid queued message %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, any := range gs.QueuedMsgs {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(any, &msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ EpochingHooks = MultiEpochingHooks{}
+
+// combine multiple epoching hooks, all hook functions are run in array sequence
+type MultiEpochingHooks []EpochingHooks
+
+func NewMultiEpochingHooks(hooks ...EpochingHooks) MultiEpochingHooks {
+	return hooks
+}
+
+func (h MultiEpochingHooks) AfterEpochActionFailure(ctx sdk.Context, msg sdk.Msg) error {
+	for i := range h {
+		if err := h[i].AfterEpochActionFailure(ctx, msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// DefaultEpochLength is the default number of blocks in an epoch.
+const DefaultEpochLength int64 = 10
+
+// KeyEpochLength is the parameter store key for the epoch length.
+var KeyEpochLength = []byte("EpochLength")
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable returns the parameter key table for the epoching module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance.
+func NewParams(epochLength int64) Params {
+	return Params{
+		EpochLength: epochLength,
+	}
+}
+
+// DefaultParams returns the default epoching module parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultEpochLength)
+}
+
+// ParamSetPairs implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyEpochLength, &p.EpochLength, validateEpochLength),
+	}
+}
+
+// Validate validates the set of params.
+func (p Params) Validate() error {
+	return validateEpochLength(p.EpochLength)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+func validateEpochLength(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("epoch length must be positive: %d", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ codectypes.UnpackInterfacesMessage = QueuedMessage{}
+
+// NewQueuedMessage creates a new QueuedMessage instance.
+func NewQueuedMessage(actionID uint64, epochNumber int64, msg sdk.Msg) (QueuedMessage, error) {
+	any, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return QueuedMessage{}, err
+	}
+
+	return QueuedMessage{
+		ActionId:    actionID,
+		EpochNumber: epochNumber,
+		Msg:         any,
+	}, nil
+}
+
+// GetMessage returns the cached sdk.Msg of the queued message.
+func (m QueuedMessage) GetMessage() (sdk.Msg, error) {
+	msg, ok := m.Msg.GetCachedValue().(sdk.Msg)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("expected %T, got %T", (sdk.Msg)(nil), m.Msg.GetCachedValue())
+	}
+
+	return msg, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m QueuedMessage) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var msg sdk.Msg
+	return unpacker.UnpackAny(m.Msg, &msg)
+}
+
+var _ codectypes.UnpackInterfacesMessage = QueryQueuedMessagesResponse{}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r QueryQueuedMessagesResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, m := range r.Msgs {
+		if err := m.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -70,6 +70,14 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 		return
 	}
 
+	// The double-sign may have been committed with a consensus key the
+	// validator rotated since, the signing info and the tombstone status are
+	// tracked under the current consensus address of the validator.
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		panic(err)
+	}
+
 	if ok := k.slashingKeeper.HasValidatorSigningInfo(ctx, consAddr); !ok {
 		panic(fmt.Sprintf("expected signing info for validator %s but not found", consAddr))
 	}
@@ -75,6 +75,40 @@ func (suite *KeeperTestSuite) TestHandleDoubleSign() {
 	suite.Len(evidences, 1)
 }
 
+func (suite *KeeperTestSuite) TestHandleDoubleSign_RotatedConsPubKey() {
+	ctx := suite.ctx.WithIsCheckTx(false).WithBlockHeight(1)
+	suite.populateValidators(ctx)
+
+	power := int64(100)
+	operatorAddr, oldPk, newPk := valAddresses[0], pubkeys[0], pubkeys[2]
+	tstaking := teststaking.NewHelper(suite.T(), ctx, suite.app.StakingKeeper)
+
+	selfDelegation := tstaking.CreateValidatorWithValPower(operatorAddr, oldPk, power, true)
+	staking.EndBlocker(ctx, suite.app.StakingKeeper)
+	suite.app.SlashingKeeper.HandleValidatorSignature(ctx, oldPk.Address(), selfDelegation.Int64(), true)
+
+	// rotate the consensus pubkey of the validator
+	validator, found := suite.app.StakingKeeper.GetValidator(ctx, operatorAddr)
+	suite.True(found)
+	suite.NoError(suite.app.StakingKeeper.RotateConsPubKey(ctx, validator, newPk))
+	staking.EndBlocker(ctx, suite.app.StakingKeeper)
+
+	// double sign with the old pubkey within the unbonding period
+	oldTokens := suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens()
+	evidence := &types.Equivocation{
+		Height:           0,
+		Time:             time.Unix(0, 0),
+		Power:            power,
+		ConsensusAddress: sdk.ConsAddress(oldPk.Address()).String(),
+	}
+	suite.app.EvidenceKeeper.HandleEquivocationEvidence(ctx, evidence)
+
+	// the validator is slashed, jailed and tombstoned under its new consensus address
+	suite.True(suite.app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
+	suite.True(suite.app.SlashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(newPk.Address())))
+	suite.True(suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens().LT(oldTokens))
+}
+
 func (suite *KeeperTestSuite) TestHandleDoubleSign_TooOld() {
 	ctx := suite.ctx.WithIsCheckTx(false).WithBlockHeight(1).WithBlockTime(time.Now())
 	suite.populateValidators(ctx)
@@ -36,7 +36,7 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 		logger.Info(
 			"proposal did not meet minimum deposit; deleted",
 			"proposal", proposal.Id,
-			"min_deposit", sdk.NewCoins(keeper.GetDepositParams(ctx).MinDeposit...).String(),
+			"min_deposit", keeper.GetDepositParams(ctx).MinDepositFor(proposal.Expedited).String(),
 			"total_deposit", sdk.NewCoins(proposal.TotalDeposit...).String(),
 		)
 
@@ -47,7 +47,43 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 	keeper.IterateActiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal v1.Proposal) bool {
 		var tagValue, logMsg string
 
-		passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
+		// Tallying deletes the votes of the proposal. They are kept when an
+		// expedited proposal is converted into a regular one, so the tally is
+		// run on a cached context.
+		tallyCtx, writeTally := ctx.CacheContext()
+		passes, burnDeposits, tallyResults := keeper.Tally(tallyCtx, proposal)
+
+		// an expedited proposal that did not pass is converted into a regular
+		// proposal; its deposits are kept and its voting period is extended to
+		// the regular voting period.
+		if proposal.Expedited && !passes {
+			keeper.RemoveFromActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+
+			votingPeriod := keeper.GetVotingParams(ctx).VotingPeriod
+			endTime := proposal.VotingStartTime.Add(*votingPeriod)
+			proposal.VotingEndTime = &endTime
+			proposal.Expedited = false
+
+			keeper.InsertActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+			keeper.SetProposal(ctx, proposal)
+
+			logger.Info(
+				"expedited proposal converted to regular",
+				"proposal", proposal.Id,
+				"voting_end_time", endTime,
+			)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeActiveProposal,
+					sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+					sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueExpeditedProposalRejected),
+				),
+			)
+			return false
+		}
+
+		writeTally()
 
 		if burnDeposits {
 			keeper.DeleteAndBurnDeposits(ctx, proposal.Id)
@@ -119,13 +155,20 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 			"results", logMsg,
 		)
 
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				types.EventTypeActiveProposal,
-				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
-				sdk.NewAttribute(types.AttributeKeyProposalResult, tagValue),
-			),
-		)
+		attrs := []sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+			sdk.NewAttribute(types.AttributeKeyProposalResult, tagValue),
+		}
+
+		// a passed multiple-choice proposal has no messages to execute; its
+		// result is the name of the option with the most votes.
+		if proposal.ProposalType == v1.ProposalTypeMultipleChoice && passes {
+			if winner, ok := tallyResults.PluralityOption(); ok {
+				attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyWinningOption, proposal.VoteOptions.Name(winner)))
+			}
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeActiveProposal, attrs...))
 		return false
 	})
 }
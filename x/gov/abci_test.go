@@ -295,7 +295,7 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	require.NotNil(t, macc)
 	initialModuleAccCoins := app.BankKeeper.GetAllBalances(ctx, macc.GetAddress())
 
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "", addrs[0], false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10))}
@@ -326,6 +326,63 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	require.True(t, app.BankKeeper.GetAllBalances(ctx, macc.GetAddress()).IsEqual(initialModuleAccCoins))
 }
 
+func TestMultipleChoiceProposalPassedEndblocker(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 10, valTokens)
+
+	SortAddresses(addrs)
+
+	govMsgSvr := keeper.NewMsgServerImpl(app.GovKeeper)
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	valAddr := sdk.ValAddress(addrs[0])
+
+	createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	options, err := v1.NewProposalVoteOptions("red", "green", "blue")
+	require.NoError(t, err)
+
+	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10))}
+	msg, err := v1.NewMsgSubmitProposal(nil, proposalCoins, addrs[0].String(), "poll")
+	require.NoError(t, err)
+	msg.ProposalType = v1.ProposalTypeMultipleChoice
+	msg.VoteOptions = options
+
+	res, err := govMsgSvr.SubmitProposal(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	err = app.GovKeeper.AddVote(ctx, res.ProposalId, addrs[0], v1.NewNonSplitMultipleChoiceOption(v1.OptionTwo), "")
+	require.NoError(t, err)
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(*app.GovKeeper.GetVotingParams(ctx).VotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader).WithEventManager(sdk.NewEventManager())
+
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, res.ProposalId)
+	require.True(t, ok)
+	require.Equal(t, v1.StatusPassed, proposal.Status)
+
+	var winningOption string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeActiveProposal {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeyWinningOption {
+				winningOption = string(attr.Value)
+			}
+		}
+	}
+	require.Equal(t, "green", winningOption)
+}
+
 func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	app := simapp.Setup(t, false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
@@ -343,7 +400,7 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	staking.EndBlocker(ctx, app.StakingKeeper)
 
 	msg := banktypes.NewMsgSend(authtypes.NewModuleAddress(types.ModuleName), addrs[0], sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000))))
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msg}, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msg}, "", addrs[0], false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
@@ -369,6 +426,149 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	require.Equal(t, v1.StatusFailed, proposal.Status)
 }
 
+func TestExpeditedProposalPassAndConversionToRegular(t *testing.T) {
+	testcases := []struct {
+		name                     string
+		expeditedPasses          bool
+		regularEventuallyPassing bool
+	}{
+		{
+			name:            "expedited passes",
+			expeditedPasses: true,
+		},
+		{
+			name:                     "expedited fails, converted to regular, regular passes",
+			regularEventuallyPassing: true,
+		},
+		{
+			name: "expedited fails, converted to regular, regular fails",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := simapp.Setup(t, false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+			addrs := simapp.AddTestAddrs(app, ctx, 10, valTokens)
+
+			SortAddresses(addrs)
+
+			govMsgSvr := keeper.NewMsgServerImpl(app.GovKeeper)
+			stakingMsgSvr := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+
+			header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+			app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+			valAddr := sdk.ValAddress(addrs[0])
+
+			createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+			staking.EndBlocker(ctx, app.StakingKeeper)
+
+			macc := app.GovKeeper.GetGovernanceAccount(ctx)
+			require.NotNil(t, macc)
+			initialModuleAccCoins := app.BankKeeper.GetAllBalances(ctx, macc.GetAddress())
+
+			proposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "", addrs[0], true)
+			require.NoError(t, err)
+			require.True(t, proposal.Expedited)
+
+			// the regular minimum deposit is not enough to activate an expedited proposal
+			depositParams := app.GovKeeper.GetDepositParams(ctx)
+			_, err = govMsgSvr.Deposit(sdk.WrapSDKContext(ctx), v1.NewMsgDeposit(addrs[1], proposal.Id, depositParams.MinDeposit))
+			require.NoError(t, err)
+			proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.Id)
+			require.True(t, ok)
+			require.Equal(t, v1.StatusDepositPeriod, proposal.Status)
+
+			_, err = govMsgSvr.Deposit(sdk.WrapSDKContext(ctx), v1.NewMsgDeposit(addrs[2], proposal.Id, sdk.NewCoins(depositParams.ExpeditedMinDeposit...).Sub(depositParams.MinDeposit...)))
+			require.NoError(t, err)
+			proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.Id)
+			require.True(t, ok)
+			require.Equal(t, v1.StatusVotingPeriod, proposal.Status)
+
+			votingParams := app.GovKeeper.GetVotingParams(ctx)
+			require.Equal(t, proposal.VotingStartTime.Add(*votingParams.ExpeditedVotingPeriod), *proposal.VotingEndTime)
+
+			deposits := initialModuleAccCoins.Add(depositParams.ExpeditedMinDeposit...)
+			require.True(t, app.BankKeeper.GetAllBalances(ctx, macc.GetAddress()).IsEqual(deposits))
+
+			if tc.expeditedPasses {
+				err = app.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), "")
+				require.NoError(t, err)
+			}
+
+			newHeader := ctx.BlockHeader()
+			newHeader.Time = ctx.BlockHeader().Time.Add(*votingParams.ExpeditedVotingPeriod)
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			gov.EndBlocker(ctx, app.GovKeeper)
+
+			proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.Id)
+			require.True(t, ok)
+
+			if tc.expeditedPasses {
+				require.Equal(t, v1.StatusPassed, proposal.Status)
+				require.True(t, app.BankKeeper.GetAllBalances(ctx, macc.GetAddress()).IsEqual(initialModuleAccCoins))
+				return
+			}
+
+			// the expedited proposal is converted into a regular one and keeps its deposits
+			require.Equal(t, v1.StatusVotingPeriod, proposal.Status)
+			require.False(t, proposal.Expedited)
+			require.Equal(t, proposal.VotingStartTime.Add(*votingParams.VotingPeriod), *proposal.VotingEndTime)
+			require.True(t, app.BankKeeper.GetAllBalances(ctx, macc.GetAddress()).IsEqual(deposits))
+
+			if tc.regularEventuallyPassing {
+				err = app.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), "")
+				require.NoError(t, err)
+			}
+
+			newHeader = ctx.BlockHeader()
+			newHeader.Time = proposal.VotingEndTime.Add(time.Second)
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			gov.EndBlocker(ctx, app.GovKeeper)
+
+			proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.Id)
+			require.True(t, ok)
+
+			if tc.regularEventuallyPassing {
+				require.Equal(t, v1.StatusPassed, proposal.Status)
+			} else {
+				require.Equal(t, v1.StatusRejected, proposal.Status)
+			}
+			require.True(t, app.BankKeeper.GetAllBalances(ctx, macc.GetAddress()).IsEqual(initialModuleAccCoins))
+		})
+	}
+}
+
+func TestExpeditedProposalVotesKeptOnConversion(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 2, valTokens)
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "", addrs[0], true)
+	require.NoError(t, err)
+	app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+
+	err = app.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionNo), "")
+	require.NoError(t, err)
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(*app.GovKeeper.GetVotingParams(ctx).ExpeditedVotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.Id)
+	require.True(t, ok)
+	require.False(t, proposal.Expedited)
+	require.Equal(t, v1.StatusVotingPeriod, proposal.Status)
+
+	_, found := app.GovKeeper.GetVote(ctx, proposal.Id, addrs[0])
+	require.True(t, found)
+}
+
 func createValidators(t *testing.T, stakingMsgSvr stakingtypes.MsgServer, ctx sdk.Context, addrs []sdk.ValAddress, powerAmt []int64) {
 	require.True(t, len(addrs) <= len(pubkeys), "Not enough pubkeys specified at top of file.")
 
@@ -38,6 +38,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryDeposit(),
 		GetCmdQueryDeposits(),
 		GetCmdQueryTally(),
+		GetCmdQueryProposalsBelowMinDeposit(),
 	)
 
 	return govQueryCmd
@@ -178,6 +179,53 @@ $ %s query gov proposals --page=2 --limit=100
 	return cmd
 }
 
+// GetCmdQueryProposalsBelowMinDeposit implements the command to query for
+// proposals in deposit period whose total deposit is below the minimum deposit.
+func GetCmdQueryProposalsBelowMinDeposit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposals-below-min-deposit",
+		Args:  cobra.NoArgs,
+		Short: "Query proposals in deposit period below the minimum deposit",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for all paginated proposals in deposit period whose total deposit is below the minimum deposit:
+
+Example:
+$ %s query gov proposals-below-min-deposit
+$ %s query gov proposals-below-min-deposit --page=2 --limit=100
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ProposalsBelowMinDeposit(
+				cmd.Context(),
+				&v1.QueryProposalsBelowMinDepositRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "proposals-below-min-deposit")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryVote implements the query proposal vote command. Command to Get a
 // Vote Information.
 func GetCmdQueryVote() *cobra.Command {
@@ -31,6 +31,8 @@ const (
 	flagDepositor    = "depositor"
 	flagStatus       = "status"
 	flagMetadata     = "metadata"
+	FlagExpedited    = "expedited"
+	FlagOptimistic   = "optimistic"
 	// Deprecated: only used for v1beta1 legacy proposals.
 	FlagProposal = "proposal"
 )
@@ -71,6 +73,7 @@ func NewTxCmd(legacyPropCmds []*cobra.Command) *cobra.Command {
 		NewCmdWeightedVote(),
 		NewCmdSubmitProposal(),
 		NewCmdDraftProposal(),
+		NewCmdCancelProposal(),
 
 		// Deprecated
 		cmdSubmitLegacyProp,
@@ -107,6 +110,24 @@ Where proposal.json contains:
   "metadata: "4pIMOgIGx1vZGU=", // base64-encoded metadata
   "deposit": "10stake"
 }
+
+The proposal is expedited with the --expedited flag. Expedited proposals use a
+shorter voting period, a higher threshold and a higher minimum deposit. If an
+expedited proposal does not pass, it is converted into a regular proposal.
+
+The proposal is optimistic with the --optimistic flag. Optimistic proposals
+need no quorum and pass unless the share of the bonded tokens voting No or
+NoWithVeto exceeds the optimistic rejected threshold. Only the optimistic
+authorized addresses can submit them.
+
+A multiple-choice proposal has no messages and defines the names of two to four
+options to vote for, which are voted with "one", "two", "three" and "four":
+
+{
+  "metadata: "4pIMOgIGx1vZGU=",
+  "deposit": "10stake",
+  "vote_options": ["red", "green", "blue"]
+}
 `,
 				version.AppName,
 			),
@@ -117,20 +138,45 @@ Where proposal.json contains:
 				return err
 			}
 
-			msgs, metadata, deposit, err := parseSubmitProposal(clientCtx.Codec, args[0])
+			proposal, msgs, deposit, err := parseSubmitProposal(clientCtx.Codec, args[0])
 			if err != nil {
 				return err
 			}
 
-			msg, err := v1.NewMsgSubmitProposal(msgs, deposit, clientCtx.GetFromAddress().String(), metadata)
+			msg, err := v1.NewMsgSubmitProposal(msgs, deposit, clientCtx.GetFromAddress().String(), proposal.Metadata)
 			if err != nil {
 				return fmt.Errorf("invalid message: %w", err)
 			}
 
+			msg.Expedited, err = cmd.Flags().GetBool(FlagExpedited)
+			if err != nil {
+				return err
+			}
+
+			optimistic, err := cmd.Flags().GetBool(FlagOptimistic)
+			if err != nil {
+				return err
+			}
+
+			switch {
+			case len(proposal.VoteOptions) > 0 && optimistic:
+				return fmt.Errorf("a multiple-choice proposal cannot be optimistic")
+			case len(proposal.VoteOptions) > 0:
+				msg.ProposalType = v1.ProposalTypeMultipleChoice
+				msg.VoteOptions, err = v1.NewProposalVoteOptions(proposal.VoteOptions...)
+				if err != nil {
+					return err
+				}
+			case optimistic:
+				msg.ProposalType = v1.ProposalTypeOptimistic
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().Bool(FlagExpedited, false, "Submit the proposal as an expedited proposal")
+	cmd.Flags().Bool(FlagOptimistic, false, "Submit the proposal as an optimistic proposal")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -205,6 +251,47 @@ $ %s tx gov submit-legacy-proposal --title="Test Proposal" --description="My awe
 	return cmd
 }
 
+// NewCmdCancelProposal implements canceling a proposal by its proposer.
+func NewCmdCancelProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-proposal [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel a governance proposal before the end of its voting period",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a governance proposal in its deposit or voting period. Only the
+proposer of the proposal can cancel it. A share of the deposits, set by the
+proposal_cancel_ratio param, is burned or sent to the proposal_cancel_dest
+address, the rest is refunded to the depositors and the votes are removed.
+
+Example:
+$ %s tx gov cancel-proposal 1 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			msg := v1.NewMsgCancelProposal(proposalID, clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewCmdDeposit implements depositing tokens for an active proposal.
 func NewCmdDeposit() *cobra.Command {
 	cmd := &cobra.Command{
@@ -263,6 +350,8 @@ func NewCmdVote() *cobra.Command {
 			fmt.Sprintf(`Submit a vote for an active proposal. You can
 find the proposal-id by running "%s query gov proposals".
 
+The options of a multiple-choice proposal are voted with one/two/three/four.
+
 Example:
 $ %s tx gov vote 1 yes --from mykey
 `,
@@ -283,13 +372,20 @@ $ %s tx gov vote 1 yes --from mykey
 				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
 			}
 
-			// Find out which vote option user chose
-			byteVoteOption, err := v1.VoteOptionFromString(govutils.NormalizeVoteOption(args[1]))
+			metadata, err := cmd.Flags().GetString(flagMetadata)
 			if err != nil {
 				return err
 			}
 
-			metadata, err := cmd.Flags().GetString(flagMetadata)
+			// Find out which vote option user chose, the options of the
+			// multiple-choice proposals being voted with their own field
+			option := govutils.NormalizeVoteOption(args[1])
+			if mcOption, err := v1.MultipleChoiceOptionFromString(option); err == nil {
+				msg := v1.NewMsgVoteMultipleChoice(from, proposalID, mcOption, metadata)
+				return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			}
+
+			byteVoteOption, err := v1.VoteOptionFromString(option)
 			if err != nil {
 				return err
 			}
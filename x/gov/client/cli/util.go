@@ -80,19 +80,22 @@ type proposal struct {
 	Messages []json.RawMessage `json:"messages,omitempty"`
 	Metadata string            `json:"metadata"`
 	Deposit  string            `json:"deposit"`
+	// VoteOptions defines the names of the options of a multiple-choice
+	// proposal.
+	VoteOptions []string `json:"vote_options,omitempty"`
 }
 
-func parseSubmitProposal(cdc codec.Codec, path string) ([]sdk.Msg, string, sdk.Coins, error) {
+func parseSubmitProposal(cdc codec.Codec, path string) (proposal, []sdk.Msg, sdk.Coins, error) {
 	var proposal proposal
 
 	contents, err := os.ReadFile(path)
 	if err != nil {
-		return nil, "", nil, err
+		return proposal, nil, nil, err
 	}
 
 	err = json.Unmarshal(contents, &proposal)
 	if err != nil {
-		return nil, "", nil, err
+		return proposal, nil, nil, err
 	}
 
 	msgs := make([]sdk.Msg, len(proposal.Messages))
@@ -100,7 +103,7 @@ func parseSubmitProposal(cdc codec.Codec, path string) ([]sdk.Msg, string, sdk.C
 		var msg sdk.Msg
 		err := cdc.UnmarshalInterfaceJSON(anyJSON, &msg)
 		if err != nil {
-			return nil, "", nil, err
+			return proposal, nil, nil, err
 		}
 
 		msgs[i] = msg
@@ -108,8 +111,8 @@ func parseSubmitProposal(cdc codec.Codec, path string) ([]sdk.Msg, string, sdk.C
 
 	deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
 	if err != nil {
-		return nil, "", nil, err
+		return proposal, nil, nil, err
 	}
 
-	return msgs, proposal.Metadata, deposit, nil
+	return proposal, msgs, deposit, nil
 }
@@ -169,10 +169,11 @@ func TestParseSubmitProposal(t *testing.T) {
 	require.Error(t, err)
 
 	// ok json
-	msgs, metadata, deposit, err := parseSubmitProposal(cdc, okJSON.Name())
+	proposal, msgs, deposit, err := parseSubmitProposal(cdc, okJSON.Name())
 	require.NoError(t, err, "unexpected error")
 	require.Equal(t, sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(1000))), deposit)
-	require.Equal(t, base64.StdEncoding.EncodeToString(expectedMetadata), metadata)
+	require.Equal(t, base64.StdEncoding.EncodeToString(expectedMetadata), proposal.Metadata)
+	require.Empty(t, proposal.VoteOptions)
 	require.Len(t, msgs, 3)
 	msg1, ok := msgs[0].(*banktypes.MsgSend)
 	require.True(t, ok)
@@ -192,6 +193,22 @@ func TestParseSubmitProposal(t *testing.T) {
 	require.Equal(t, "My awesome title", textProp.Title)
 	require.Equal(t, "My awesome description", textProp.Description)
 
+	// multiple-choice json
+	multipleChoiceJSON := testutil.WriteToNewTempFile(t, `
+{
+	"metadata": "poll",
+	"deposit": "1000test",
+	"vote_options": ["red", "green", "blue"]
+}
+`)
+	proposal, msgs, deposit, err = parseSubmitProposal(cdc, multipleChoiceJSON.Name())
+	require.NoError(t, err, "unexpected error")
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(1000))), deposit)
+	require.Empty(t, msgs)
+	require.Equal(t, []string{"red", "green", "blue"}, proposal.VoteOptions)
+
+	err = multipleChoiceJSON.Close()
+	require.Nil(t, err, "unexpected error")
 	err = okJSON.Close()
 	require.Nil(t, err, "unexpected error")
 	err = badJSON.Close()
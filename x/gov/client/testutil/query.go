@@ -24,22 +24,30 @@ func (s *IntegrationTestSuite) TestCmdParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"voting_params":{"voting_period":"172800000000000"},"tally_params":{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000"},"deposit_params":{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800000000000"}}`,
+			`{"deposit_params":{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800000000000","expedited_min_deposit":[{"denom":"stake","amount":"50000000"}],"proposal_cancel_ratio":"0.500000000000000000","min_initial_deposit_ratio":"0.000000000000000000"},"voting_params":{"voting_period":"172800000000000","expedited_voting_period":"86400000000000"},"tally_params":{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000","expedited_threshold":"0.667000000000000000","optimistic_rejected_threshold":"0.100000000000000000"}}`,
 		},
 		{
 			"text output",
 			[]string{},
 			`
 deposit_params:
+  expedited_min_deposit:
+  - amount: "50000000"
+    denom: stake
   max_deposit_period: "172800000000000"
   min_deposit:
   - amount: "10000000"
     denom: stake
+  min_initial_deposit_ratio: "0.000000000000000000"
+  proposal_cancel_ratio: "0.500000000000000000"
 tally_params:
+  expedited_threshold: "0.667000000000000000"
+  optimistic_rejected_threshold: "0.100000000000000000"
   quorum: "0.334000000000000000"
   threshold: "0.500000000000000000"
   veto_threshold: "0.334000000000000000"
 voting_params:
+  expedited_voting_period: "86400000000000"
   voting_period: "172800000000000"
 	`,
 		},
@@ -73,7 +81,7 @@ func (s *IntegrationTestSuite) TestCmdParam() {
 				"voting",
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
-			`{"voting_period":"172800000000000"}`,
+			`{"voting_period":"172800000000000","expedited_voting_period":"86400000000000"}`,
 		},
 		{
 			"tally params",
@@ -81,7 +89,7 @@ func (s *IntegrationTestSuite) TestCmdParam() {
 				"tallying",
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
-			`{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000"}`,
+			`{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000","expedited_threshold":"0.667000000000000000","optimistic_rejected_threshold":"0.100000000000000000"}`,
 		},
 		{
 			"deposit params",
@@ -89,7 +97,7 @@ func (s *IntegrationTestSuite) TestCmdParam() {
 				"deposit",
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
-			`{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800000000000"}`,
+			`{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800000000000","expedited_min_deposit":[{"denom":"stake","amount":"50000000"}],"proposal_cancel_ratio":"0.500000000000000000","min_initial_deposit_ratio":"0.000000000000000000"}`,
 		},
 	}
 
@@ -303,6 +311,24 @@ func (s *IntegrationTestSuite) TestCmdGetProposals() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestCmdGetProposalsBelowMinDeposit() {
+	val := s.network.Validators[0]
+
+	cmd := cli.GetCmdQueryProposalsBelowMinDeposit()
+	clientCtx := val.ClientCtx
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, []string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().NoError(err)
+
+	var res v1.QueryProposalsBelowMinDepositResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+
+	// only the proposal submitted without deposit is below the minimum deposit
+	s.Require().Len(res.Proposals, 1)
+	s.Require().Equal(uint64(2), res.Proposals[0].Id)
+	s.Require().Equal(v1.StatusDepositPeriod, res.Proposals[0].Status)
+}
+
 func (s *IntegrationTestSuite) TestCmdQueryDeposits() {
 	val := s.network.Validators[0]
 
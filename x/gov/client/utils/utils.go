@@ -3,6 +3,7 @@ package utils
 import (
 	"strings"
 
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
 
@@ -21,6 +22,18 @@ func NormalizeVoteOption(option string) string {
 	case "NoWithVeto", "no_with_veto":
 		return v1beta1.OptionNoWithVeto.String()
 
+	case "One", "one":
+		return v1.OptionOne.String()
+
+	case "Two", "two":
+		return v1.OptionTwo.String()
+
+	case "Three", "three":
+		return v1.OptionThree.String()
+
+	case "Four", "four":
+		return v1.OptionFour.String()
+
 	default:
 		return option
 	}
@@ -45,6 +45,10 @@ func TestNormalizeWeightedVoteOptions(t *testing.T) {
 			options:    "",
 			normalized: "=1",
 		},
+		"multiple-choice options": {
+			options:    "one=0.5,Two=0.3,three=0.2",
+			normalized: "MULTIPLE_CHOICE_OPTION_ONE=0.5,MULTIPLE_CHOICE_OPTION_TWO=0.3,MULTIPLE_CHOICE_OPTION_THREE=0.2",
+		},
 		"not available option": {
 			options:    "Yessss=1",
 			normalized: "Yessss=1",
@@ -11,6 +11,10 @@ import (
 
 // InitGenesis - store genesis parameters
 func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, data *v1.GenesisState) {
+	if err := k.ValidateProposalCancelDest(data.DepositParams.ProposalCancelDest); err != nil {
+		panic(err)
+	}
+
 	k.SetProposalID(ctx, data.StartingProposalId)
 	k.SetDepositParams(ctx, *data.DepositParams)
 	k.SetVotingParams(ctx, *data.VotingParams)
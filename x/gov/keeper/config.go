@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// CalculateVoteResultsAndVotingPowerFn is the function signature for
+// calculating the results of a vote and the total voting power of its voters.
+// It receives the proposal being tallied and the governance info of the bonded
+// validators, indexed by operator address, and returns the total voting power
+// of the voters along with the voting power cast for each vote option and for
+// each option of a multiple-choice proposal.
+//
+// The quorum is computed against the total bonded tokens, and the votes of the
+// proposal are deleted after the tally, so implementations must not delete
+// them.
+type CalculateVoteResultsAndVotingPowerFn func(
+	ctx sdk.Context,
+	keeper Keeper,
+	proposal v1.Proposal,
+	validators map[string]v1.ValidatorGovInfo,
+) (totalVotingPower sdk.Dec, results map[v1.VoteOption]sdk.Dec, multipleChoiceResults map[v1.MultipleChoiceOption]sdk.Dec)
+
+// Config is a config struct used for intialising the gov module to avoid using globals.
+type Config struct {
+	// MaxMetadataLen defines the maximum proposal metadata length.
+	MaxMetadataLen uint64
+
+	// CalculateVoteResultsAndVotingPowerFn defines the function used to tally
+	// the votes of a proposal. If not set, the stake-weighted tally with
+	// validator vote inheritance, DefaultCalculateVoteResultsAndVotingPower, is
+	// used.
+	CalculateVoteResultsAndVotingPowerFn CalculateVoteResultsAndVotingPowerFn
+}
+
+// DefaultConfig returns the default config for gov.
+func DefaultConfig() Config {
+	return Config{
+		MaxMetadataLen:                       255,
+		CalculateVoteResultsAndVotingPowerFn: DefaultCalculateVoteResultsAndVotingPower,
+	}
+}
@@ -68,6 +68,50 @@ func (keeper Keeper) DeleteAndBurnDeposits(ctx sdk.Context, proposalID uint64) {
 	})
 }
 
+// ChargeDeposit charges the given ratio of the deposits on a specific proposal
+// and refunds the rest to the depositors. The charged deposits are sent to
+// destAddress, or burned if destAddress is empty. The deposits are deleted
+// afterwards.
+func (keeper Keeper) ChargeDeposit(ctx sdk.Context, proposalID uint64, destAddress string, ratio sdk.Dec) error {
+	store := ctx.KVStore(keeper.storeKey)
+	charges := sdk.NewCoins()
+
+	for _, deposit := range keeper.GetDeposits(ctx, proposalID) {
+		depositor := sdk.MustAccAddressFromBech32(deposit.Depositor)
+
+		refund := sdk.NewCoins()
+		for _, coin := range deposit.Amount {
+			charge := sdk.NewDecFromInt(coin.Amount).Mul(ratio).TruncateInt()
+			charges = charges.Add(sdk.NewCoin(coin.Denom, charge))
+			refund = refund.Add(sdk.NewCoin(coin.Denom, coin.Amount.Sub(charge)))
+		}
+
+		if !refund.IsZero() {
+			err := keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, refund)
+			if err != nil {
+				return err
+			}
+		}
+
+		store.Delete(types.DepositKey(proposalID, depositor))
+	}
+
+	if charges.IsZero() {
+		return nil
+	}
+
+	if destAddress == "" {
+		return keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, charges)
+	}
+
+	dest, err := sdk.AccAddressFromBech32(destAddress)
+	if err != nil {
+		return err
+	}
+
+	return keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, dest, charges)
+}
+
 // IterateAllDeposits iterates over all the stored deposits and performs a callback function
 func (keeper Keeper) IterateAllDeposits(ctx sdk.Context, cb func(deposit v1.Deposit) (stop bool)) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -118,6 +162,15 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 		return false, sdkerrors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
 	}
 
+	// Check if the deposit denoms are accepted
+	depositParams := keeper.GetDepositParams(ctx)
+	for _, coin := range depositAmount {
+		if !depositParams.IsAllowedDepositDenom(coin.Denom) {
+			return false, sdkerrors.Wrapf(types.ErrInvalidDepositDenom, "deposited %s, but gov accepts only %v as deposit denoms",
+				coin.Denom, depositParams.AllowedDepositDenoms)
+		}
+	}
+
 	// update the governance module's account coins pool
 	err := keeper.bankKeeper.SendCoinsFromAccountToModule(ctx, depositorAddr, types.ModuleName, depositAmount)
 	if err != nil {
@@ -131,7 +184,7 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	// Check if deposit has provided sufficient total funds to transition the proposal into the voting period
 	activatedVotingPeriod := false
 
-	if proposal.Status == v1.StatusDepositPeriod && sdk.NewCoins(proposal.TotalDeposit...).IsAllGTE(keeper.GetDepositParams(ctx).MinDeposit) {
+	if proposal.Status == v1.StatusDepositPeriod && sdk.NewCoins(proposal.TotalDeposit...).IsAllGTE(depositParams.MinDepositFor(proposal.Expedited)) {
 		keeper.ActivateVotingPeriod(ctx, proposal)
 
 		activatedVotingPeriod = true
@@ -178,3 +231,20 @@ func (keeper Keeper) RefundAndDeleteDeposits(ctx sdk.Context, proposalID uint64)
 		return false
 	})
 }
+
+// validateInitialDeposit validates if the initial deposit is greater than or
+// equal to the minimum required at the time of proposal submission. This
+// minimum amount is determined by the deposit parameters. Returns nil on
+// success, error otherwise.
+func (keeper Keeper) validateInitialDeposit(ctx sdk.Context, initialDeposit sdk.Coins, expedited bool) error {
+	minInitialDeposit := keeper.GetDepositParams(ctx).MinInitialDepositFor(expedited)
+	if minInitialDeposit.IsZero() {
+		return nil
+	}
+
+	if !initialDeposit.IsAllGTE(minInitialDeposit) {
+		return sdkerrors.Wrapf(types.ErrMinDepositTooSmall, "was (%s), need (%s)", initialDeposit, minInitialDeposit)
+	}
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func TestDeposits(t *testing.T) {
@@ -17,7 +18,7 @@ func TestDeposits(t *testing.T) {
 	TestAddrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000000))
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 
@@ -101,7 +102,7 @@ func TestDeposits(t *testing.T) {
 	require.Equal(t, addr1Initial, app.BankKeeper.GetAllBalances(ctx, TestAddrs[1]))
 
 	// Test delete and burn deposits
-	proposal, err = app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err = app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false)
 	require.NoError(t, err)
 	proposalID = proposal.Id
 	_, err = app.GovKeeper.AddDeposit(ctx, proposalID, TestAddrs[0], fourStake)
@@ -111,3 +112,38 @@ func TestDeposits(t *testing.T) {
 	require.Len(t, deposits, 0)
 	require.Equal(t, addr0Initial.Sub(fourStake...), app.BankKeeper.GetAllBalances(ctx, TestAddrs[0]))
 }
+
+func TestDepositDenomAllowList(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	TestAddrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(10000000))
+
+	depositParams := app.GovKeeper.GetDepositParams(ctx)
+	depositParams.AllowedDepositDenoms = []string{sdk.DefaultBondDenom}
+	app.GovKeeper.SetDepositParams(ctx, depositParams)
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, "", addr, false)
+	require.NoError(t, err)
+
+	// deposits in a denom outside of the allow list are rejected
+	otherCoins := sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(100)))
+	_, err = app.GovKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[0], otherCoins)
+	require.ErrorIs(t, err, types.ErrInvalidDepositDenom)
+
+	mixedCoins := otherCoins.Add(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	_, err = app.GovKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[0], mixedCoins)
+	require.ErrorIs(t, err, types.ErrInvalidDepositDenom)
+
+	_, found := app.GovKeeper.GetDeposit(ctx, proposal.Id, TestAddrs[0])
+	require.False(t, found)
+
+	// deposits in an allowed denom are accepted
+	stake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	_, err = app.GovKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[0], stake)
+	require.NoError(t, err)
+
+	deposit, found := app.GovKeeper.GetDeposit(ctx, proposal.Id, TestAddrs[0])
+	require.True(t, found)
+	require.Equal(t, stake, sdk.NewCoins(deposit.Amount...))
+}
@@ -273,6 +273,42 @@ func (q Keeper) TallyResult(c context.Context, req *v1.QueryTallyResultRequest)
 	return &v1.QueryTallyResultResponse{Tally: &tallyResult}, nil
 }
 
+// ProposalsBelowMinDeposit implements the Query/ProposalsBelowMinDeposit gRPC method
+func (q Keeper) ProposalsBelowMinDeposit(c context.Context, req *v1.QueryProposalsBelowMinDepositRequest) (*v1.QueryProposalsBelowMinDepositResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	depositParams := q.GetDepositParams(ctx)
+
+	store := ctx.KVStore(q.storeKey)
+	proposalStore := prefix.NewStore(store, types.ProposalsKeyPrefix)
+
+	proposals, pageRes, err := query.GenericFilteredPaginate(
+		q.cdc,
+		proposalStore,
+		req.Pagination,
+		func(key []byte, p *v1.Proposal) (*v1.Proposal, error) {
+			if p.Status != v1.StatusDepositPeriod {
+				return nil, nil
+			}
+
+			if sdk.NewCoins(p.TotalDeposit...).IsAllGTE(depositParams.MinDepositFor(p.Expedited)) {
+				return nil, nil
+			}
+
+			return p, nil
+		}, func() *v1.Proposal {
+			return &v1.Proposal{}
+		})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryProposalsBelowMinDepositResponse{Proposals: proposals, Pagination: pageRes}, nil
+}
+
 var _ v1beta1.QueryServer = legacyQueryServer{}
 
 type legacyQueryServer struct {
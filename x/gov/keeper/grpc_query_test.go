@@ -53,7 +53,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposal() {
 				testProposal := v1beta1.NewTextProposal("Proposal", "testing proposal")
 				msgContent, err := v1.NewLegacyContent(testProposal, govAcct.String())
 				suite.Require().NoError(err)
-				submittedProposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "")
+				submittedProposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "", addr, false)
 				suite.Require().NoError(err)
 				suite.Require().NotEmpty(submittedProposal)
 
@@ -127,7 +127,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryProposal() {
 				testProposal := v1beta1.NewTextProposal("Proposal", "testing proposal")
 				msgContent, err := v1.NewLegacyContent(testProposal, govAcct.String())
 				suite.Require().NoError(err)
-				submittedProposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "")
+				submittedProposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "", addr, false)
 				suite.Require().NoError(err)
 				suite.Require().NotEmpty(submittedProposal)
 
@@ -192,7 +192,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposals() {
 					testProposal := []sdk.Msg{
 						v1.NewMsgVote(govAddress, uint64(i), v1.OptionYes, ""),
 					}
-					proposal, err := app.GovKeeper.SubmitProposal(ctx, testProposal, "")
+					proposal, err := app.GovKeeper.SubmitProposal(ctx, testProposal, "", addr, false)
 					suite.Require().NotEmpty(proposal)
 					suite.Require().NoError(err)
 					testProposals = append(testProposals, &proposal)
@@ -329,7 +329,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryProposals() {
 				testProposal := v1beta1.NewTextProposal("Proposal", "testing proposal")
 				msgContent, err := v1.NewLegacyContent(testProposal, govAcct.String())
 				suite.Require().NoError(err)
-				submittedProposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "")
+				submittedProposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "", addr, false)
 				suite.Require().NoError(err)
 				suite.Require().NotEmpty(submittedProposal)
 			},
@@ -410,7 +410,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryVote() {
 			"no votes present",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "")
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "", addr, false)
 				suite.Require().NoError(err)
 
 				req = &v1.QueryVoteRequest{
@@ -524,7 +524,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryVote() {
 			"no votes present",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "")
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "", addr, false)
 				suite.Require().NoError(err)
 
 				req = &v1beta1.QueryVoteRequest{
@@ -629,7 +629,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryVotes() {
 			"create a proposal and get votes",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "")
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "", addr, false)
 				suite.Require().NoError(err)
 
 				req = &v1.QueryVotesRequest{
@@ -730,7 +730,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryVotes() {
 			"create a proposal and get votes",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "")
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "", addr, false)
 				suite.Require().NoError(err)
 
 				req = &v1beta1.QueryVotesRequest{
@@ -1012,7 +1012,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposit() {
 			"no deposits proposal",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "")
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "", addr, false)
 				suite.Require().NoError(err)
 				suite.Require().NotNil(proposal)
 
@@ -1113,7 +1113,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryDeposit() {
 			"no deposits proposal",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "")
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "", addr, false)
 				suite.Require().NoError(err)
 				suite.Require().NotNil(proposal)
 
@@ -1203,7 +1203,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposits() {
 			"create a proposal and get deposits",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "")
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "", addr, false)
 				suite.Require().NoError(err)
 
 				req = &v1.QueryDepositsRequest{
@@ -1297,7 +1297,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryDeposits() {
 			"create a proposal and get deposits",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "")
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "", addr, false)
 				suite.Require().NoError(err)
 
 				req = &v1beta1.QueryDepositsRequest{
@@ -1391,7 +1391,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryTally() {
 			"create a proposal and get tally",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "")
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "", addr, false)
 				suite.Require().NoError(err)
 				suite.Require().NotNil(proposal)
 
@@ -1502,7 +1502,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryTally() {
 			"create a proposal and get tally",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "")
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, "", addr, false)
 				suite.Require().NoError(err)
 				suite.Require().NotNil(proposal)
 
@@ -1572,6 +1572,49 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryTally() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCQueryProposalsBelowMinDeposit() {
+	app, ctx, queryClient, addrs := suite.app, suite.ctx, suite.queryClient, suite.addrs
+
+	minDeposit := app.GovKeeper.GetDepositParams(ctx).MinDeposit
+
+	res, err := queryClient.ProposalsBelowMinDeposit(gocontext.Background(), &v1.QueryProposalsBelowMinDepositRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Proposals)
+
+	// a proposal reaching the minimum deposit enters its voting period
+	funded, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, "", addrs[0], false)
+	suite.Require().NoError(err)
+	votingStarted, err := app.GovKeeper.AddDeposit(ctx, funded.Id, addrs[0], minDeposit)
+	suite.Require().NoError(err)
+	suite.Require().True(votingStarted)
+
+	// proposals below the minimum deposit stay in their deposit period
+	var expProposalIDs []uint64
+	for i := 0; i < 2; i++ {
+		proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, "", addrs[0], false)
+		suite.Require().NoError(err)
+		_, err = app.GovKeeper.AddDeposit(ctx, proposal.Id, addrs[1], sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))))
+		suite.Require().NoError(err)
+		expProposalIDs = append(expProposalIDs, proposal.Id)
+	}
+
+	res, err = queryClient.ProposalsBelowMinDeposit(gocontext.Background(), &v1.QueryProposalsBelowMinDepositRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Proposals, 2)
+	for i, proposal := range res.Proposals {
+		suite.Require().Equal(expProposalIDs[i], proposal.Id)
+		suite.Require().Equal(v1.StatusDepositPeriod, proposal.Status)
+	}
+
+	res, err = queryClient.ProposalsBelowMinDeposit(gocontext.Background(), &v1.QueryProposalsBelowMinDepositRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Proposals, 1)
+	suite.Require().Equal(expProposalIDs[0], res.Proposals[0].Id)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+}
+
 func v1TallyToV1Beta1Tally(t v1.TallyResult) v1beta1.TallyResult {
 	yes, _ := sdk.NewIntFromString(t.YesCount)
 	no, _ := sdk.NewIntFromString(t.NoCount)
@@ -18,7 +18,7 @@ import (
 
 // Keeper defines the governance module Keeper
 type Keeper struct {
-	// The reference to the Paramstore to get and set gov specific params
+	// legacy params subspace, only used by the migrations
 	paramSpace types.ParamSubspace
 
 	authKeeper types.AccountKeeper
@@ -42,7 +42,11 @@ type Keeper struct {
 	// Msg server router
 	router *baseapp.MsgServiceRouter
 
-	config types.Config
+	config Config
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
 }
 
 // NewKeeper returns a governance keeper. It handles:
@@ -56,13 +60,17 @@ func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace types.ParamSubspace,
 	authKeeper types.AccountKeeper, bankKeeper types.BankKeeper, sk types.StakingKeeper,
 	legacyRouter v1beta1.Router, router *baseapp.MsgServiceRouter,
-	config types.Config,
+	config Config, authority string,
 ) Keeper {
 	// ensure governance module account is set
 	if addr := authKeeper.GetModuleAddress(types.ModuleName); addr == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
 	}
 
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Sprintf("invalid authority address: %s", authority))
+	}
+
 	// It is vital to seal the governance proposal router here as to not allow
 	// further handlers to be registered after the keeper is created since this
 	// could create invalid or non-deterministic behavior.
@@ -70,7 +78,13 @@ func NewKeeper(
 
 	// If MaxMetadataLen not set by app developer, set to default value.
 	if config.MaxMetadataLen == 0 {
-		config.MaxMetadataLen = types.DefaultConfig().MaxMetadataLen
+		config.MaxMetadataLen = DefaultConfig().MaxMetadataLen
+	}
+
+	// If CalculateVoteResultsAndVotingPowerFn not set by app developer, use the
+	// default stake-weighted tally.
+	if config.CalculateVoteResultsAndVotingPowerFn == nil {
+		config.CalculateVoteResultsAndVotingPowerFn = DefaultConfig().CalculateVoteResultsAndVotingPowerFn
 	}
 
 	return Keeper{
@@ -83,9 +97,15 @@ func NewKeeper(
 		legacyRouter: legacyRouter,
 		router:       router,
 		config:       config,
+		authority:    authority,
 	}
 }
 
+// GetAuthority returns the x/gov module's authority.
+func (keeper Keeper) GetAuthority() string {
+	return keeper.authority
+}
+
 // SetHooks sets the hooks for governance
 func (keeper *Keeper) SetHooks(gh types.GovHooks) *Keeper {
 	if keeper.hooks != nil {
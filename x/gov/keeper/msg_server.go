@@ -35,7 +35,30 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 		return nil, err
 	}
 
-	proposal, err := k.Keeper.SubmitProposal(ctx, proposalMsgs, msg.Metadata)
+	proposer, err := sdk.AccAddressFromBech32(msg.GetProposer())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.validateInitialDeposit(ctx, msg.GetInitialDeposit(), msg.Expedited); err != nil {
+		return nil, err
+	}
+
+	var proposal v1.Proposal
+	switch msg.ProposalType {
+	case v1.ProposalTypeMultipleChoice:
+		if msg.VoteOptions == nil {
+			return nil, sdkerrors.Wrap(types.ErrInvalidProposalType, "multiple-choice proposals must have vote options")
+		}
+		proposal, err = k.Keeper.SubmitMultipleChoiceProposal(ctx, msg.Metadata, proposer, *msg.VoteOptions)
+	case v1.ProposalTypeOptimistic:
+		if !k.GetTallyParams(ctx).IsOptimisticAuthorized(msg.Proposer) {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidProposer, "%s is not allowed to submit optimistic proposals", msg.Proposer)
+		}
+		proposal, err = k.Keeper.SubmitOptimisticProposal(ctx, proposalMsgs, msg.Metadata, proposer)
+	default:
+		proposal, err = k.Keeper.SubmitProposal(ctx, proposalMsgs, msg.Metadata, proposer, msg.Expedited)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -53,7 +76,6 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 
 	defer telemetry.IncrCounter(1, types.ModuleName, "proposal")
 
-	proposer, _ := sdk.AccAddressFromBech32(msg.GetProposer())
 	votingStarted, err := k.Keeper.AddDeposit(ctx, proposal.Id, proposer, msg.GetInitialDeposit())
 	if err != nil {
 		return nil, err
@@ -112,7 +134,12 @@ func (k msgServer) Vote(goCtx context.Context, msg *v1.MsgVote) (*v1.MsgVoteResp
 	if err != nil {
 		return nil, err
 	}
-	err = k.Keeper.AddVote(ctx, msg.ProposalId, accAddr, v1.NewNonSplitVoteOption(msg.Option), msg.Metadata)
+	options := v1.NewNonSplitVoteOption(msg.Option)
+	if msg.MultipleChoiceOption != v1.MultipleChoiceOptionEmpty {
+		options = v1.NewNonSplitMultipleChoiceOption(msg.MultipleChoiceOption)
+	}
+
+	err = k.Keeper.AddVote(ctx, msg.ProposalId, accAddr, options, msg.Metadata)
 	if err != nil {
 		return nil, err
 	}
@@ -205,6 +232,48 @@ func (k msgServer) Deposit(goCtx context.Context, msg *v1.MsgDeposit) (*v1.MsgDe
 	return &v1.MsgDepositResponse{}, nil
 }
 
+func (k msgServer) UpdateParams(goCtx context.Context, msg *v1.MsgUpdateParams) (*v1.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := k.ValidateProposalCancelDest(msg.Params.DepositParams.ProposalCancelDest); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetParams(ctx, msg.Params)
+
+	return &v1.MsgUpdateParamsResponse{}, nil
+}
+
+func (k msgServer) CancelProposal(goCtx context.Context, msg *v1.MsgCancelProposal) (*v1.MsgCancelProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	proposer, err := sdk.AccAddressFromBech32(msg.Proposer)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.CancelProposal(ctx, msg.ProposalId, proposer); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Proposer),
+		),
+	)
+
+	return &v1.MsgCancelProposalResponse{
+		ProposalId:     msg.ProposalId,
+		CanceledTime:   ctx.BlockTime(),
+		CanceledHeight: uint64(ctx.BlockHeight()),
+	}, nil
+}
+
 type legacyMsgServer struct {
 	govAcct string
 	server  v1.MsgServer
@@ -2,10 +2,14 @@ package keeper_test
 
 import (
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
@@ -117,6 +121,101 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestSubmitProposalMinInitialDepositReq() {
+	govAcct := suite.app.GovKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
+	proposer := suite.addrs[0]
+
+	depositParams := suite.app.GovKeeper.GetDepositParams(suite.ctx)
+	depositParams.MinInitialDepositRatio = sdk.NewDecWithPrec(5, 1).String()
+	suite.app.GovKeeper.SetDepositParams(suite.ctx, depositParams)
+
+	minInitialDeposit := depositParams.MinInitialDepositFor(false)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, v1.DefaultMinDepositTokens.QuoRaw(2))), minInitialDeposit)
+
+	bankMsg := &banktypes.MsgSend{
+		FromAddress: govAcct.String(),
+		ToAddress:   proposer.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100))),
+	}
+
+	cases := map[string]struct {
+		initialDeposit sdk.Coins
+		expedited      bool
+		expErr         bool
+	}{
+		"empty initial deposit": {
+			initialDeposit: sdk.NewCoins(),
+			expErr:         true,
+		},
+		"initial deposit below the minimum": {
+			initialDeposit: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, minInitialDeposit.AmountOf(sdk.DefaultBondDenom).SubRaw(1))),
+			expErr:         true,
+		},
+		"initial deposit below the expedited minimum": {
+			initialDeposit: minInitialDeposit,
+			expedited:      true,
+			expErr:         true,
+		},
+		"all good": {
+			initialDeposit: minInitialDeposit,
+			expErr:         false,
+		},
+	}
+
+	for name, tc := range cases {
+		suite.Run(name, func() {
+			msg, err := v1.NewMsgSubmitProposal([]sdk.Msg{bankMsg}, tc.initialDeposit, proposer.String(), "")
+			suite.Require().NoError(err)
+			msg.Expedited = tc.expedited
+
+			res, err := suite.msgSrvr.SubmitProposal(suite.ctx, msg)
+			if tc.expErr {
+				suite.Require().ErrorIs(err, types.ErrMinDepositTooSmall)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res.ProposalId)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSubmitOptimisticProposalReq() {
+	govAcct := suite.app.GovKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
+	authorized, unauthorized := suite.addrs[0], suite.addrs[1]
+
+	bankMsg := &banktypes.MsgSend{
+		FromAddress: govAcct.String(),
+		ToAddress:   authorized.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100))),
+	}
+	minDeposit := suite.app.GovKeeper.GetDepositParams(suite.ctx).MinDeposit
+
+	submit := func(proposer sdk.AccAddress) (*v1.MsgSubmitProposalResponse, error) {
+		msg, err := v1.NewMsgSubmitProposal([]sdk.Msg{bankMsg}, minDeposit, proposer.String(), "")
+		suite.Require().NoError(err)
+		msg.ProposalType = v1.ProposalTypeOptimistic
+
+		return suite.msgSrvr.SubmitProposal(suite.ctx, msg)
+	}
+
+	// optimistic proposals are disabled by default
+	_, err := submit(authorized)
+	suite.Require().ErrorIs(err, types.ErrInvalidProposer)
+
+	tallyParams := suite.app.GovKeeper.GetTallyParams(suite.ctx)
+	tallyParams.OptimisticAuthorizedAddresses = []string{authorized.String()}
+	suite.app.GovKeeper.SetTallyParams(suite.ctx, tallyParams)
+
+	_, err = submit(unauthorized)
+	suite.Require().ErrorIs(err, types.ErrInvalidProposer)
+
+	res, err := submit(authorized)
+	suite.Require().NoError(err)
+	proposal, found := suite.app.GovKeeper.GetProposal(suite.ctx, res.ProposalId)
+	suite.Require().True(found)
+	suite.Require().Equal(v1.ProposalTypeOptimistic, proposal.ProposalType)
+}
+
 func (suite *KeeperTestSuite) TestVoteReq() {
 	govAcct := suite.app.GovKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
 	addrs := suite.addrs
@@ -410,6 +509,121 @@ func (suite *KeeperTestSuite) TestDepositReq() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	authority := suite.app.GovKeeper.GetAuthority()
+
+	params := v1.DefaultParams()
+	params.VotingParams = v1.NewVotingParams(time.Hour, 30*time.Minute)
+
+	blockedDestParams := params
+	blockedDestParams.DepositParams.ProposalCancelDest = authtypes.NewModuleAddress(distrtypes.ModuleName).String()
+
+	cases := map[string]struct {
+		authority string
+		params    v1.Params
+		expErr    bool
+	}{
+		"invalid authority": {
+			authority: suite.addrs[0].String(),
+			params:    params,
+			expErr:    true,
+		},
+		"blocked proposal cancel destination": {
+			authority: authority,
+			params:    blockedDestParams,
+			expErr:    true,
+		},
+		"all good": {
+			authority: authority,
+			params:    params,
+			expErr:    false,
+		},
+	}
+
+	for name, tc := range cases {
+		suite.Run(name, func() {
+			_, err := suite.msgSrvr.UpdateParams(suite.ctx, &v1.MsgUpdateParams{
+				Authority: tc.authority,
+				Params:    tc.params,
+			})
+			if tc.expErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.params, suite.app.GovKeeper.GetParams(suite.ctx))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCancelProposalReq() {
+	govAcct := suite.app.GovKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
+	addrs := suite.addrs
+	proposer := addrs[0]
+
+	coins := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100)))
+	bankMsg := &banktypes.MsgSend{
+		FromAddress: govAcct.String(),
+		ToAddress:   proposer.String(),
+		Amount:      coins,
+	}
+
+	submitProposal := func() uint64 {
+		msg, err := v1.NewMsgSubmitProposal(
+			[]sdk.Msg{bankMsg},
+			coins,
+			proposer.String(),
+			"",
+		)
+		suite.Require().NoError(err)
+
+		res, err := suite.msgSrvr.SubmitProposal(suite.ctx, msg)
+		suite.Require().NoError(err)
+		return res.ProposalId
+	}
+
+	cases := map[string]struct {
+		preRun   func() uint64
+		proposer sdk.AccAddress
+		expErr   bool
+	}{
+		"wrong proposal id": {
+			preRun: func() uint64 {
+				return 0
+			},
+			proposer: proposer,
+			expErr:   true,
+		},
+		"not the proposer": {
+			preRun:   submitProposal,
+			proposer: addrs[1],
+			expErr:   true,
+		},
+		"all good": {
+			preRun:   submitProposal,
+			proposer: proposer,
+			expErr:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		suite.Run(name, func() {
+			proposalID := tc.preRun()
+			res, err := suite.msgSrvr.CancelProposal(suite.ctx, v1.NewMsgCancelProposal(proposalID, tc.proposer))
+			if tc.expErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(proposalID, res.ProposalId)
+				suite.Require().Equal(uint64(suite.ctx.BlockHeight()), res.CanceledHeight)
+
+				_, found := suite.app.GovKeeper.GetProposal(suite.ctx, proposalID)
+				suite.Require().False(found)
+			}
+		})
+	}
+}
+
 // legacy msg server tests
 func (suite *KeeperTestSuite) TestLegacyMsgSubmitProposal() {
 	addrs := suite.addrs
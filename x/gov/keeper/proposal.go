@@ -10,8 +10,40 @@ import (
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
-// SubmitProposal creates a new proposal given an array of messages
-func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadata string) (v1.Proposal, error) {
+// SubmitProposal creates a new proposal given an array of messages. Expedited
+// proposals use the expedited minimum deposit, voting period and threshold.
+func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadata string, proposer sdk.AccAddress, expedited bool) (v1.Proposal, error) {
+	return keeper.submitProposal(ctx, messages, metadata, proposer, expedited, v1.ProposalTypeStandard, nil)
+}
+
+// SubmitOptimisticProposal creates a new optimistic proposal given an array of
+// messages. The proposal passes unless the share of the voting power voting
+// against it exceeds the optimistic rejected threshold.
+func (keeper Keeper) SubmitOptimisticProposal(ctx sdk.Context, messages []sdk.Msg, metadata string, proposer sdk.AccAddress) (v1.Proposal, error) {
+	return keeper.submitProposal(ctx, messages, metadata, proposer, false, v1.ProposalTypeOptimistic, nil)
+}
+
+// SubmitMultipleChoiceProposal creates a new multiple-choice proposal given its
+// vote options. The proposal has no messages, and its result is the option
+// with the most votes.
+func (keeper Keeper) SubmitMultipleChoiceProposal(ctx sdk.Context, metadata string, proposer sdk.AccAddress, voteOptions v1.ProposalVoteOptions) (v1.Proposal, error) {
+	if err := voteOptions.ValidateBasic(); err != nil {
+		return v1.Proposal{}, sdkerrors.Wrap(types.ErrInvalidProposalType, err.Error())
+	}
+
+	for _, name := range voteOptions.Names() {
+		if err := keeper.assertMetadataLength(name); err != nil {
+			return v1.Proposal{}, err
+		}
+	}
+
+	return keeper.submitProposal(ctx, nil, metadata, proposer, false, v1.ProposalTypeMultipleChoice, &voteOptions)
+}
+
+func (keeper Keeper) submitProposal(
+	ctx sdk.Context, messages []sdk.Msg, metadata string, proposer sdk.AccAddress, expedited bool,
+	proposalType v1.ProposalType, voteOptions *v1.ProposalVoteOptions,
+) (v1.Proposal, error) {
 	err := keeper.assertMetadataLength(metadata)
 	if err != nil {
 		return v1.Proposal{}, err
@@ -68,10 +100,12 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 	submitTime := ctx.BlockHeader().Time
 	depositPeriod := keeper.GetDepositParams(ctx).MaxDepositPeriod
 
-	proposal, err := v1.NewProposal(messages, proposalID, metadata, submitTime, submitTime.Add(*depositPeriod))
+	proposal, err := v1.NewProposal(messages, proposalID, metadata, submitTime, submitTime.Add(*depositPeriod), proposer, expedited)
 	if err != nil {
 		return v1.Proposal{}, err
 	}
+	proposal.ProposalType = proposalType
+	proposal.VoteOptions = voteOptions
 
 	keeper.SetProposal(ctx, proposal)
 	keeper.InsertInactiveProposalQueue(ctx, proposalID, *proposal.DepositEndTime)
@@ -85,6 +119,7 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 			types.EventTypeSubmitProposal,
 			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
 			sdk.NewAttribute(types.AttributeKeyProposalMessages, msgsStr),
+			sdk.NewAttribute(types.AttributeKeyProposalType, proposalType.String()),
 		),
 	)
 
@@ -140,6 +175,63 @@ func (keeper Keeper) DeleteProposal(ctx sdk.Context, proposalID uint64) {
 	store.Delete(types.ProposalKey(proposalID))
 }
 
+// CancelProposal cancels a proposal in its deposit or voting period on behalf
+// of its proposer. The ProposalCancelRatio of the deposits is burned or sent to
+// the ProposalCancelDest address and the rest is refunded to the depositors.
+// The votes and the proposal are deleted.
+func (keeper Keeper) CancelProposal(ctx sdk.Context, proposalID uint64, proposer sdk.AccAddress) error {
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+
+	// proposals submitted before the proposer was recorded cannot be canceled
+	if proposal.Proposer == "" {
+		return sdkerrors.Wrapf(types.ErrInvalidProposer, "proposal %d has no proposer and cannot be canceled", proposalID)
+	}
+
+	if proposal.Proposer != proposer.String() {
+		return sdkerrors.Wrapf(types.ErrInvalidProposer, "expected %s, got %s", proposal.Proposer, proposer)
+	}
+
+	if proposal.Status != v1.StatusDepositPeriod && proposal.Status != v1.StatusVotingPeriod {
+		return sdkerrors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
+	}
+
+	if proposal.VotingEndTime != nil && !proposal.VotingEndTime.After(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrVotingPeriodEnded, "proposal %d, voting end time %s", proposalID, proposal.VotingEndTime)
+	}
+
+	depositParams := keeper.GetDepositParams(ctx)
+	ratio, err := sdk.NewDecFromStr(depositParams.ProposalCancelRatio)
+	if err != nil {
+		return err
+	}
+
+	if err := keeper.ChargeDeposit(ctx, proposalID, depositParams.ProposalCancelDest, ratio); err != nil {
+		return err
+	}
+
+	keeper.deleteVotes(ctx, proposalID)
+	keeper.DeleteProposal(ctx, proposalID)
+
+	keeper.Logger(ctx).Info(
+		"proposal canceled by its proposer",
+		"proposal", proposalID,
+		"proposer", proposer.String(),
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyProposer, proposer.String()),
+		),
+	)
+
+	return nil
+}
+
 // IterateProposals iterates over the all the proposals and performs a callback function.
 // Panics when the iterator encounters a proposal which can't be unmarshaled.
 func (keeper Keeper) IterateProposals(ctx sdk.Context, cb func(proposal v1.Proposal) (stop bool)) {
@@ -237,7 +329,7 @@ func (keeper Keeper) SetProposalID(ctx sdk.Context, proposalID uint64) {
 func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal v1.Proposal) {
 	startTime := ctx.BlockHeader().Time
 	proposal.VotingStartTime = &startTime
-	votingPeriod := keeper.GetVotingParams(ctx).VotingPeriod
+	votingPeriod := keeper.GetVotingParams(ctx).VotingPeriodFor(proposal.Expedited)
 	endTime := proposal.VotingStartTime.Add(*votingPeriod)
 	proposal.VotingEndTime = &endTime
 	proposal.Status = v1.StatusVotingPeriod
@@ -18,7 +18,7 @@ import (
 
 func (suite *KeeperTestSuite) TestGetSetProposal() {
 	tp := TestProposal
-	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, "")
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, "", addr, false)
 	suite.Require().NoError(err)
 	proposalID := proposal.Id
 	suite.app.GovKeeper.SetProposal(suite.ctx, proposal)
@@ -30,7 +30,7 @@ func (suite *KeeperTestSuite) TestGetSetProposal() {
 
 func (suite *KeeperTestSuite) TestActivateVotingPeriod() {
 	tp := TestProposal
-	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, "")
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, "", addr, false)
 	suite.Require().NoError(err)
 
 	suite.Require().Nil(proposal.VotingStartTime)
@@ -49,6 +49,98 @@ func (suite *KeeperTestSuite) TestActivateVotingPeriod() {
 	activeIterator.Close()
 }
 
+func (suite *KeeperTestSuite) TestCancelProposal() {
+	depositor := suite.addrs[1]
+	_, _, dest := testdata.KeyTestPubAddr()
+	deposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)))
+
+	testCases := []struct {
+		name        string
+		cancelDest  string
+		activate    bool
+		proposer    sdk.AccAddress
+		expiredVote bool
+		expErr      error
+	}{
+		{
+			name:     "deposit period, charged deposits burned",
+			proposer: addr,
+		},
+		{
+			name:       "voting period, charged deposits sent to the destination",
+			cancelDest: dest.String(),
+			activate:   true,
+			proposer:   addr,
+		},
+		{
+			name:     "not the proposer",
+			proposer: depositor,
+			expErr:   types.ErrInvalidProposer,
+		},
+		{
+			name:        "voting period ended",
+			activate:    true,
+			proposer:    addr,
+			expiredVote: true,
+			expErr:      types.ErrVotingPeriodEnded,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.ctx
+
+			depositParams := suite.app.GovKeeper.GetDepositParams(ctx)
+			depositParams.ProposalCancelDest = tc.cancelDest
+			suite.app.GovKeeper.SetDepositParams(ctx, depositParams)
+
+			proposal, err := suite.app.GovKeeper.SubmitProposal(ctx, TestProposal, "", addr, false)
+			suite.Require().NoError(err)
+			_, err = suite.app.GovKeeper.AddDeposit(ctx, proposal.Id, depositor, deposit)
+			suite.Require().NoError(err)
+
+			if tc.activate {
+				suite.app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+				suite.Require().NoError(suite.app.GovKeeper.AddVote(ctx, proposal.Id, depositor, v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+			}
+
+			if tc.expiredVote {
+				proposal, _ = suite.app.GovKeeper.GetProposal(ctx, proposal.Id)
+				ctx = ctx.WithBlockTime(*proposal.VotingEndTime)
+			}
+
+			balanceBefore := suite.app.BankKeeper.GetAllBalances(ctx, depositor)
+			supplyBefore := suite.app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom)
+
+			err = suite.app.GovKeeper.CancelProposal(ctx, proposal.Id, tc.proposer)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				_, found := suite.app.GovKeeper.GetProposal(ctx, proposal.Id)
+				suite.Require().True(found)
+				return
+			}
+			suite.Require().NoError(err)
+
+			_, found := suite.app.GovKeeper.GetProposal(ctx, proposal.Id)
+			suite.Require().False(found)
+			suite.Require().Empty(suite.app.GovKeeper.GetDeposits(ctx, proposal.Id))
+			suite.Require().Empty(suite.app.GovKeeper.GetVotes(ctx, proposal.Id))
+
+			// half of the deposit is refunded, the other half is charged
+			half := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(500)))
+			suite.Require().Equal(balanceBefore.Add(half...), suite.app.BankKeeper.GetAllBalances(ctx, depositor))
+
+			if tc.cancelDest == "" {
+				supplyAfter := suite.app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom)
+				suite.Require().Equal(supplyBefore.Sub(half[0]), supplyAfter)
+			} else {
+				suite.Require().Equal(half, suite.app.BankKeeper.GetAllBalances(ctx, dest))
+			}
+		})
+	}
+}
+
 type invalidProposalRoute struct{ v1beta1.TextProposal }
 
 func (invalidProposalRoute) ProposalRoute() string { return "nonexistingroute" }
@@ -81,7 +173,7 @@ func (suite *KeeperTestSuite) TestSubmitProposal() {
 	for i, tc := range testCases {
 		prop, err := v1.NewLegacyContent(tc.content, tc.authority)
 		suite.Require().NoError(err)
-		_, err = suite.app.GovKeeper.SubmitProposal(suite.ctx, []sdk.Msg{prop}, tc.metadata)
+		_, err = suite.app.GovKeeper.SubmitProposal(suite.ctx, []sdk.Msg{prop}, tc.metadata, addr, false)
 		suite.Require().True(errors.Is(tc.expectedErr, err), "tc #%d; got: %v, expected: %v", i, err, tc.expectedErr)
 	}
 }
@@ -94,7 +186,7 @@ func (suite *KeeperTestSuite) TestGetProposalsFiltered() {
 
 	for _, s := range status {
 		for i := 0; i < 50; i++ {
-			p, err := v1.NewProposal(TestProposal, proposalID, "", time.Now(), time.Now())
+			p, err := v1.NewProposal(TestProposal, proposalID, "", time.Now(), time.Now(), addr1, false)
 			suite.Require().NoError(err)
 
 			p.Status = s
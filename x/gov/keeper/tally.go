@@ -6,18 +6,9 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
-// TODO: Break into several smaller functions for clarity
-
 // Tally iterates over the votes and updates the tally of a proposal based on the voting power of the
-// voters
+// voters, as computed by the CalculateVoteResultsAndVotingPowerFn of the keeper config
 func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool, burnDeposits bool, tallyResults v1.TallyResult) {
-	results := make(map[v1.VoteOption]sdk.Dec)
-	results[v1.OptionYes] = sdk.ZeroDec()
-	results[v1.OptionAbstain] = sdk.ZeroDec()
-	results[v1.OptionNo] = sdk.ZeroDec()
-	results[v1.OptionNoWithVeto] = sdk.ZeroDec()
-
-	totalVotingPower := sdk.ZeroDec()
 	currValidators := make(map[string]v1.ValidatorGovInfo)
 
 	// fetch all the bonded validators, insert them into currValidators
@@ -33,46 +24,139 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 		return false
 	})
 
+	totalVotingPower, results, multipleChoiceResults := keeper.config.CalculateVoteResultsAndVotingPowerFn(ctx, keeper, proposal, currValidators)
+	if totalVotingPower.IsNil() {
+		totalVotingPower = sdk.ZeroDec()
+	}
+	if results == nil {
+		results = make(map[v1.VoteOption]sdk.Dec)
+	}
+	for _, option := range []v1.VoteOption{v1.OptionYes, v1.OptionAbstain, v1.OptionNo, v1.OptionNoWithVeto} {
+		if _, ok := results[option]; !ok {
+			results[option] = sdk.ZeroDec()
+		}
+	}
+
+	keeper.deleteVotes(ctx, proposal.Id)
+
+	tallyParams := keeper.GetTallyParams(ctx)
+	tallyResults = v1.NewTallyResultFromMap(results)
+	if proposal.ProposalType == v1.ProposalTypeMultipleChoice {
+		tallyResults = tallyResults.WithMultipleChoiceResults(multipleChoiceResults)
+	}
+
+	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
+	// If there is no staked coins, the proposal fails
+	if keeper.sk.TotalBondedTokens(ctx).IsZero() {
+		return false, false, tallyResults
+	}
+
+	totalBonded := sdk.NewDecFromInt(keeper.sk.TotalBondedTokens(ctx))
+
+	// An optimistic proposal passes without quorum, unless the voting power
+	// voting No or NoWithVeto reaches the optimistic rejected threshold of the
+	// total bonded tokens. Its deposits are never burned.
+	if proposal.ProposalType == v1.ProposalTypeOptimistic {
+		rejectedThreshold, _ := sdk.NewDecFromStr(tallyParams.OptimisticRejectedThreshold)
+		against := results[v1.OptionNo].Add(results[v1.OptionNoWithVeto])
+		return against.Quo(totalBonded).LT(rejectedThreshold), false, tallyResults
+	}
+
+	// If there is not enough quorum of votes, the proposal fails
+	percentVoting := totalVotingPower.Quo(totalBonded)
+	quorum, _ := sdk.NewDecFromStr(tallyParams.Quorum)
+	if percentVoting.LT(quorum) {
+		return false, false, tallyResults
+	}
+
+	// A multiple-choice proposal passes when a single option has the most
+	// votes, and fails on a tie. Its deposits are never burned.
+	if proposal.ProposalType == v1.ProposalTypeMultipleChoice {
+		_, hasWinner := tallyResults.PluralityOption()
+		return hasWinner, false, tallyResults
+	}
+
+	// If no one votes (everyone abstains), proposal fails
+	if totalVotingPower.Sub(results[v1.OptionAbstain]).Equal(sdk.ZeroDec()) {
+		return false, false, tallyResults
+	}
+
+	// If more than 1/3 of voters veto, proposal fails
+	vetoThreshold, _ := sdk.NewDecFromStr(tallyParams.VetoThreshold)
+	if results[v1.OptionNoWithVeto].Quo(totalVotingPower).GT(vetoThreshold) {
+		return false, true, tallyResults
+	}
+
+	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
+	threshold, _ := sdk.NewDecFromStr(tallyParams.ThresholdFor(proposal.Expedited))
+	if results[v1.OptionYes].Quo(totalVotingPower.Sub(results[v1.OptionAbstain])).GT(threshold) {
+		return true, false, tallyResults
+	}
+
+	// If more than 1/2 of non-abstaining voters vote No, proposal fails
+	return false, false, tallyResults
+}
+
+// DefaultCalculateVoteResultsAndVotingPower tallies the votes of a proposal
+// weighted by stake. The voting power of a voter is the value of its
+// delegations to bonded validators, and validators vote with the voting power
+// delegated to them minus the delegations of the delegators who voted
+// themselves.
+func DefaultCalculateVoteResultsAndVotingPower(
+	ctx sdk.Context,
+	keeper Keeper,
+	proposal v1.Proposal,
+	validators map[string]v1.ValidatorGovInfo,
+) (totalVotingPower sdk.Dec, results map[v1.VoteOption]sdk.Dec, multipleChoiceResults map[v1.MultipleChoiceOption]sdk.Dec) {
+	results = make(map[v1.VoteOption]sdk.Dec)
+	results[v1.OptionYes] = sdk.ZeroDec()
+	results[v1.OptionAbstain] = sdk.ZeroDec()
+	results[v1.OptionNo] = sdk.ZeroDec()
+	results[v1.OptionNoWithVeto] = sdk.ZeroDec()
+
+	multipleChoiceResults = make(map[v1.MultipleChoiceOption]sdk.Dec)
+	multipleChoiceResults[v1.OptionOne] = sdk.ZeroDec()
+	multipleChoiceResults[v1.OptionTwo] = sdk.ZeroDec()
+	multipleChoiceResults[v1.OptionThree] = sdk.ZeroDec()
+	multipleChoiceResults[v1.OptionFour] = sdk.ZeroDec()
+
+	totalVotingPower = sdk.ZeroDec()
+
 	keeper.IterateVotes(ctx, proposal.Id, func(vote v1.Vote) bool {
 		// if validator, just record it in the map
 		voter := sdk.MustAccAddressFromBech32(vote.Voter)
 
 		valAddrStr := sdk.ValAddress(voter.Bytes()).String()
-		if val, ok := currValidators[valAddrStr]; ok {
+		if val, ok := validators[valAddrStr]; ok {
 			val.Vote = vote.Options
-			currValidators[valAddrStr] = val
+			validators[valAddrStr] = val
 		}
 
 		// iterate over all delegations from voter, deduct from any delegated-to validators
 		keeper.sk.IterateDelegations(ctx, voter, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
 			valAddrStr := delegation.GetValidatorAddr().String()
 
-			if val, ok := currValidators[valAddrStr]; ok {
+			if val, ok := validators[valAddrStr]; ok {
 				// There is no need to handle the special case that validator address equal to voter address.
 				// Because voter's voting power will tally again even if there will be deduction of voter's voting power from validator.
 				val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
-				currValidators[valAddrStr] = val
+				validators[valAddrStr] = val
 
 				// delegation shares * bonded / total shares
 				votingPower := delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares)
 
-				for _, option := range vote.Options {
-					weight, _ := sdk.NewDecFromStr(option.Weight)
-					subPower := votingPower.Mul(weight)
-					results[option.Option] = results[option.Option].Add(subPower)
-				}
+				addVotingPower(results, multipleChoiceResults, vote.Options, votingPower)
 				totalVotingPower = totalVotingPower.Add(votingPower)
 			}
 
 			return false
 		})
 
-		keeper.deleteVote(ctx, vote.ProposalId, voter)
 		return false
 	})
 
 	// iterate over the validators again to tally their voting power
-	for _, val := range currValidators {
+	for _, val := range validators {
 		if len(val.Vote) == 0 {
 			continue
 		}
@@ -80,47 +164,28 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 		sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
 		votingPower := sharesAfterDeductions.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
 
-		for _, option := range val.Vote {
-			weight, _ := sdk.NewDecFromStr(option.Weight)
-			subPower := votingPower.Mul(weight)
-			results[option.Option] = results[option.Option].Add(subPower)
-		}
+		addVotingPower(results, multipleChoiceResults, val.Vote, votingPower)
 		totalVotingPower = totalVotingPower.Add(votingPower)
 	}
 
-	tallyParams := keeper.GetTallyParams(ctx)
-	tallyResults = v1.NewTallyResultFromMap(results)
-
-	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
-	// If there is no staked coins, the proposal fails
-	if keeper.sk.TotalBondedTokens(ctx).IsZero() {
-		return false, false, tallyResults
-	}
-
-	// If there is not enough quorum of votes, the proposal fails
-	percentVoting := totalVotingPower.Quo(sdk.NewDecFromInt(keeper.sk.TotalBondedTokens(ctx)))
-	quorum, _ := sdk.NewDecFromStr(tallyParams.Quorum)
-	if percentVoting.LT(quorum) {
-		return false, false, tallyResults
-	}
-
-	// If no one votes (everyone abstains), proposal fails
-	if totalVotingPower.Sub(results[v1.OptionAbstain]).Equal(sdk.ZeroDec()) {
-		return false, false, tallyResults
-	}
-
-	// If more than 1/3 of voters veto, proposal fails
-	vetoThreshold, _ := sdk.NewDecFromStr(tallyParams.VetoThreshold)
-	if results[v1.OptionNoWithVeto].Quo(totalVotingPower).GT(vetoThreshold) {
-		return false, true, tallyResults
-	}
+	return totalVotingPower, results, multipleChoiceResults
+}
 
-	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
-	threshold, _ := sdk.NewDecFromStr(tallyParams.Threshold)
-	if results[v1.OptionYes].Quo(totalVotingPower.Sub(results[v1.OptionAbstain])).GT(threshold) {
-		return true, false, tallyResults
+// addVotingPower splits the voting power of a voter between the options of its
+// vote according to their weights.
+func addVotingPower(
+	results map[v1.VoteOption]sdk.Dec,
+	multipleChoiceResults map[v1.MultipleChoiceOption]sdk.Dec,
+	options v1.WeightedVoteOptions,
+	votingPower sdk.Dec,
+) {
+	for _, option := range options {
+		weight, _ := sdk.NewDecFromStr(option.Weight)
+		subPower := votingPower.Mul(weight)
+		if option.MultipleChoiceOption != v1.MultipleChoiceOptionEmpty {
+			multipleChoiceResults[option.MultipleChoiceOption] = multipleChoiceResults[option.MultipleChoiceOption].Add(subPower)
+		} else {
+			results[option.Option] = results[option.Option].Add(subPower)
+		}
 	}
-
-	// If more than 1/2 of non-abstaining voters vote No, proposal fails
-	return false, false, tallyResults
 }
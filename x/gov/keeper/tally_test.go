@@ -8,7 +8,11 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -20,7 +24,7 @@ func TestTallyNoOneVotes(t *testing.T) {
 	createValidators(t, ctx, app, []int64{5, 5, 5})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -44,7 +48,7 @@ func TestTallyNoQuorum(t *testing.T) {
 	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(10000000))
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -67,7 +71,7 @@ func TestTallyOnlyValidatorsAllYes(t *testing.T) {
 	addrs, _ := createValidators(t, ctx, app, []int64{5, 5, 5})
 	tp := TestProposal
 
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -93,7 +97,7 @@ func TestTallyOnlyValidators51No(t *testing.T) {
 	valAccAddrs, _ := createValidators(t, ctx, app, []int64{5, 6, 0})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -117,7 +121,7 @@ func TestTallyOnlyValidators51Yes(t *testing.T) {
 	valAccAddrs, _ := createValidators(t, ctx, app, []int64{5, 6, 0})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -142,7 +146,7 @@ func TestTallyOnlyValidatorsVetoed(t *testing.T) {
 	valAccAddrs, _ := createValidators(t, ctx, app, []int64{6, 6, 7})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -168,7 +172,7 @@ func TestTallyOnlyValidatorsAbstainPasses(t *testing.T) {
 	valAccAddrs, _ := createValidators(t, ctx, app, []int64{6, 6, 7})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -194,7 +198,7 @@ func TestTallyOnlyValidatorsAbstainFails(t *testing.T) {
 	valAccAddrs, _ := createValidators(t, ctx, app, []int64{6, 6, 7})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -221,7 +225,7 @@ func TestTallyOnlyValidatorsNonVoter(t *testing.T) {
 	valAccAddr1, valAccAddr2 := valAccAddrs[0], valAccAddrs[1]
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -255,7 +259,7 @@ func TestTallyDelgatorOverride(t *testing.T) {
 	_ = staking.EndBlocker(ctx, app.StakingKeeper)
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -291,7 +295,7 @@ func TestTallyDelgatorInherit(t *testing.T) {
 	_ = staking.EndBlocker(ctx, app.StakingKeeper)
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -330,7 +334,7 @@ func TestTallyDelgatorMultipleOverride(t *testing.T) {
 	_ = staking.EndBlocker(ctx, app.StakingKeeper)
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -372,7 +376,7 @@ func TestTallyDelgatorMultipleInherit(t *testing.T) {
 	_ = staking.EndBlocker(ctx, app.StakingKeeper)
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -415,7 +419,7 @@ func TestTallyJailedValidator(t *testing.T) {
 	app.StakingKeeper.Jail(ctx, sdk.ConsAddress(consAddr.Bytes()))
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -448,7 +452,7 @@ func TestTallyValidatorMultipleDelegations(t *testing.T) {
 	require.NoError(t, err)
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -473,3 +477,181 @@ func TestTallyValidatorMultipleDelegations(t *testing.T) {
 
 	require.True(t, tallyResults.Equals(expectedTallyResult))
 }
+
+func TestTallyOptimisticProposal(t *testing.T) {
+	testCases := []struct {
+		name      string
+		votes     []v1.VoteOption
+		expPasses bool
+	}{
+		{"no votes", nil, true},
+		{"no votes below the rejected threshold", []v1.VoteOption{v1.OptionNo}, true},
+		{"yes votes", []v1.VoteOption{v1.OptionYes, v1.OptionYes}, true},
+		{"no votes above the rejected threshold", []v1.VoteOption{v1.OptionYes, v1.OptionNo}, false},
+		{"no with veto votes above the rejected threshold", []v1.VoteOption{v1.OptionYes, v1.OptionNoWithVeto}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := simapp.Setup(t, false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+			valAccAddrs, _ := createValidators(t, ctx, app, []int64{1, 10, 10})
+
+			proposal, err := app.GovKeeper.SubmitOptimisticProposal(ctx, TestProposal, "", addr)
+			require.NoError(t, err)
+			proposalID := proposal.Id
+			proposal.Status = v1.StatusVotingPeriod
+			app.GovKeeper.SetProposal(ctx, proposal)
+
+			for i, option := range tc.votes {
+				require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[i], v1.NewNonSplitVoteOption(option), ""))
+			}
+
+			proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+			require.True(t, ok)
+			passes, burnDeposits, _ := app.GovKeeper.Tally(ctx, proposal)
+
+			require.Equal(t, tc.expPasses, passes)
+			require.False(t, burnDeposits)
+		})
+	}
+}
+
+func TestTallyOptimisticProposalAtRejectedThreshold(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	valAccAddrs, _ := createValidators(t, ctx, app, []int64{1, 10, 10})
+
+	// the No votes are exactly the rejected threshold share of the bonded tokens
+	noPower := sdk.NewDecFromInt(app.StakingKeeper.TokensFromConsensusPower(ctx, 1))
+	tallyParams := app.GovKeeper.GetTallyParams(ctx)
+	tallyParams.OptimisticRejectedThreshold = noPower.Quo(sdk.NewDecFromInt(app.StakingKeeper.TotalBondedTokens(ctx))).String()
+	app.GovKeeper.SetTallyParams(ctx, tallyParams)
+
+	proposal, err := app.GovKeeper.SubmitOptimisticProposal(ctx, TestProposal, "", addr)
+	require.NoError(t, err)
+	proposal.Status = v1.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.Id, valAccAddrs[0], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+
+	passes, _, _ := app.GovKeeper.Tally(ctx, proposal)
+	require.False(t, passes)
+}
+
+func TestTallyMultipleChoiceProposal(t *testing.T) {
+	testCases := []struct {
+		name      string
+		powers    []int64
+		votes     []v1.MultipleChoiceOption
+		expPasses bool
+		expWinner v1.MultipleChoiceOption
+	}{
+		{"no quorum", []int64{5, 6, 10}, []v1.MultipleChoiceOption{v1.OptionOne}, false, v1.OptionOne},
+		{"plurality", []int64{5, 6, 10}, []v1.MultipleChoiceOption{v1.OptionOne, v1.OptionThree, v1.OptionThree}, true, v1.OptionThree},
+		{"tie", []int64{5, 5, 0}, []v1.MultipleChoiceOption{v1.OptionOne, v1.OptionTwo}, false, v1.MultipleChoiceOptionEmpty},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := simapp.Setup(t, false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+			valAccAddrs, _ := createValidators(t, ctx, app, tc.powers)
+
+			options, err := v1.NewProposalVoteOptions("red", "green", "blue")
+			require.NoError(t, err)
+			proposal, err := app.GovKeeper.SubmitMultipleChoiceProposal(ctx, "poll", addr, *options)
+			require.NoError(t, err)
+			proposalID := proposal.Id
+			proposal.Status = v1.StatusVotingPeriod
+			app.GovKeeper.SetProposal(ctx, proposal)
+
+			for i, option := range tc.votes {
+				require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[i], v1.NewNonSplitMultipleChoiceOption(option), ""))
+			}
+
+			proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+			require.True(t, ok)
+			passes, burnDeposits, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+
+			require.Equal(t, tc.expPasses, passes)
+			require.False(t, burnDeposits)
+
+			// the votes are only counted in the multiple-choice option counts
+			require.Equal(t, "0", tallyResults.YesCount)
+			require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, tc.powers[0]).String(), tallyResults.OptionOneCount)
+			if tc.expPasses {
+				winner, ok := tallyResults.PluralityOption()
+				require.True(t, ok)
+				require.Equal(t, tc.expWinner, winner)
+			}
+		})
+	}
+}
+
+func TestTallyCustomCalculateVoteResultsAndVotingPower(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs, _ := createValidators(t, ctx, app, []int64{1, 2, 10})
+
+	// every voter gets the same voting power, whatever its stake
+	votingPower := sdk.NewDecFromInt(app.StakingKeeper.TokensFromConsensusPower(ctx, 5))
+	config := keeper.DefaultConfig()
+	config.CalculateVoteResultsAndVotingPowerFn = func(
+		ctx sdk.Context, k keeper.Keeper, proposal v1.Proposal, validators map[string]v1.ValidatorGovInfo,
+	) (sdk.Dec, map[v1.VoteOption]sdk.Dec, map[v1.MultipleChoiceOption]sdk.Dec) {
+		// the genesis validator and the three created ones are bonded
+		require.Len(t, validators, 4)
+
+		totalVotingPower := sdk.ZeroDec()
+		results := make(map[v1.VoteOption]sdk.Dec)
+		k.IterateVotes(ctx, proposal.Id, func(vote v1.Vote) bool {
+			for _, option := range vote.Options {
+				weight, _ := sdk.NewDecFromStr(option.Weight)
+				if _, ok := results[option.Option]; !ok {
+					results[option.Option] = sdk.ZeroDec()
+				}
+				results[option.Option] = results[option.Option].Add(votingPower.Mul(weight))
+			}
+			totalVotingPower = totalVotingPower.Add(votingPower)
+			return false
+		})
+
+		return totalVotingPower, results, nil
+	}
+
+	govKeeper := keeper.NewKeeper(
+		app.AppCodec(), app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName), app.AccountKeeper, app.BankKeeper,
+		app.StakingKeeper, v1beta1.NewRouter(), app.MsgServiceRouter(), config, authtypes.NewModuleAddress(types.ModuleName).String(),
+	)
+
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", addr, false)
+	require.NoError(t, err)
+	proposal.Status = v1.StatusVotingPeriod
+	govKeeper.SetProposal(ctx, proposal)
+
+	require.NoError(t, govKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	require.NoError(t, govKeeper.AddVote(ctx, proposal.Id, addrs[1], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	require.NoError(t, govKeeper.AddVote(ctx, proposal.Id, addrs[2], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+
+	// the stake-weighted tally rejects the proposal
+	cacheCtx, _ := ctx.CacheContext()
+	passes, _, _ := app.GovKeeper.Tally(cacheCtx, proposal)
+	require.False(t, passes)
+
+	passes, burnDeposits, tallyResults := govKeeper.Tally(ctx, proposal)
+	require.True(t, passes)
+	require.False(t, burnDeposits)
+
+	expectedYes := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	expectedNo := app.StakingKeeper.TokensFromConsensusPower(ctx, 5)
+	expectedTallyResult := v1.NewTallyResult(expectedYes, sdk.ZeroInt(), expectedNo, sdk.ZeroInt())
+	require.True(t, tallyResults.Equals(expectedTallyResult))
+
+	// the votes are deleted after the tally
+	require.Empty(t, govKeeper.GetVotes(ctx, proposal.Id))
+}
@@ -27,6 +27,16 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 		if !v1.ValidWeightedVoteOption(*option) {
 			return sdkerrors.Wrap(types.ErrInvalidVote, option.String())
 		}
+
+		// multiple-choice proposals can only be voted for one of their options,
+		// and the other proposals cannot be voted with multiple-choice options
+		if proposal.ProposalType == v1.ProposalTypeMultipleChoice {
+			if proposal.VoteOptions == nil || proposal.VoteOptions.Name(option.MultipleChoiceOption) == "" {
+				return sdkerrors.Wrapf(types.ErrInvalidVote, "%s is not an option of multiple-choice proposal %d", option, proposalID)
+			}
+		} else if option.MultipleChoiceOption != v1.MultipleChoiceOptionEmpty {
+			return sdkerrors.Wrapf(types.ErrInvalidVote, "proposal %d is not a multiple-choice proposal", proposalID)
+		}
 	}
 
 	vote := v1.NewVote(proposalID, voterAddr, options, metadata)
@@ -124,3 +134,16 @@ func (keeper Keeper) deleteVote(ctx sdk.Context, proposalID uint64, voterAddr sd
 	store := ctx.KVStore(keeper.storeKey)
 	store.Delete(types.VoteKey(proposalID, voterAddr))
 }
+
+// deleteVotes deletes all the votes of a given proposalID from the store
+func (keeper Keeper) deleteVotes(ctx sdk.Context, proposalID uint64) {
+	var voters []sdk.AccAddress
+	keeper.IterateVotes(ctx, proposalID, func(vote v1.Vote) bool {
+		voters = append(voters, sdk.MustAccAddressFromBech32(vote.Voter))
+		return false
+	})
+
+	for _, voter := range voters {
+		keeper.deleteVote(ctx, proposalID, voter)
+	}
+}
@@ -18,7 +18,7 @@ func TestVotes(t *testing.T) {
 	addrs := simapp.AddTestAddrsIncremental(app, ctx, 5, sdk.NewInt(30000000))
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	metadata := "metadata"
@@ -89,3 +89,39 @@ func TestVotes(t *testing.T) {
 	require.Equal(t, votes[1].Options[2].Weight, sdk.NewDecWithPrec(5, 2).String())
 	require.Equal(t, votes[1].Options[3].Weight, sdk.NewDecWithPrec(5, 2).String())
 }
+
+func TestVotesMultipleChoiceProposal(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(30000000))
+
+	proposal, err := app.GovKeeper.SubmitMultipleChoiceProposal(ctx, "poll", addr, v1.ProposalVoteOptions{OptionOne: "red", OptionTwo: "green"})
+	require.NoError(t, err)
+	require.Equal(t, v1.ProposalTypeMultipleChoice, proposal.ProposalType)
+	proposal.Status = v1.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	require.Error(t, app.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitMultipleChoiceOption(v1.OptionThree), ""), "option not defined by the proposal")
+	require.Error(t, app.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.WeightedVoteOptions{
+		v1.NewWeightedMultipleChoiceOption(v1.OptionOne, sdk.NewDecWithPrec(50, 2)),
+		v1.NewWeightedMultipleChoiceOption(v1.OptionFour, sdk.NewDecWithPrec(50, 2)),
+	}, ""), "option not defined by the proposal")
+	require.Error(t, app.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""), "standard vote option")
+
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitMultipleChoiceOption(v1.OptionTwo), ""))
+	vote, found := app.GovKeeper.GetVote(ctx, proposal.Id, addrs[0])
+	require.True(t, found)
+	require.Equal(t, v1.OptionTwo, vote.Options[0].MultipleChoiceOption)
+	require.Equal(t, v1.OptionEmpty, vote.Options[0].Option)
+
+	// the other proposals cannot be voted with multiple-choice options
+	standardProposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, "", addr, false)
+	require.NoError(t, err)
+	standardProposal.Status = v1.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, standardProposal)
+	require.Error(t, app.GovKeeper.AddVote(ctx, standardProposal.Id, addrs[0], v1.NewNonSplitMultipleChoiceOption(v1.OptionOne), ""))
+
+	_, err = app.GovKeeper.SubmitMultipleChoiceProposal(ctx, "poll", addr, v1.ProposalVoteOptions{OptionOne: "red"})
+	require.Error(t, err)
+}
@@ -76,22 +76,32 @@ func TestMigrateJSON(t *testing.T) {
 	// - Proposals use MsgExecLegacyContent
 	expected := `{
 	"deposit_params": {
+		"allowed_deposit_denoms": [],
+		"expedited_min_deposit": [],
 		"max_deposit_period": "172800s",
 		"min_deposit": [
 			{
 				"amount": "10000000",
 				"denom": "stake"
 			}
-		]
+		],
+		"min_initial_deposit_ratio": "",
+		"proposal_cancel_dest": "",
+		"proposal_cancel_ratio": ""
 	},
 	"deposits": [],
 	"proposals": [
 		{
 			"deposit_end_time": "2001-09-09T01:46:40Z",
+			"expedited": false,
 			"final_tally_result": {
 				"abstain_count": "0",
 				"no_count": "0",
 				"no_with_veto_count": "0",
+				"option_four_count": "",
+				"option_one_count": "",
+				"option_three_count": "",
+				"option_two_count": "",
 				"yes_count": "0"
 			},
 			"id": "1",
@@ -107,6 +117,8 @@ func TestMigrateJSON(t *testing.T) {
 				}
 			],
 			"metadata": "",
+			"proposal_type": "PROPOSAL_TYPE_UNSPECIFIED",
+			"proposer": "",
 			"status": "PROPOSAL_STATUS_DEPOSIT_PERIOD",
 			"submit_time": "2001-09-09T01:46:40Z",
 			"total_deposit": [
@@ -115,12 +127,16 @@ func TestMigrateJSON(t *testing.T) {
 					"denom": "stake"
 				}
 			],
+			"vote_options": null,
 			"voting_end_time": "2001-09-09T01:46:40Z",
 			"voting_start_time": "2001-09-09T01:46:40Z"
 		}
 	],
 	"starting_proposal_id": "1",
 	"tally_params": {
+		"expedited_threshold": "",
+		"optimistic_authorized_addresses": [],
+		"optimistic_rejected_threshold": "",
 		"quorum": "0.334000000000000000",
 		"threshold": "0.500000000000000000",
 		"veto_threshold": "0.334000000000000000"
@@ -130,6 +146,7 @@ func TestMigrateJSON(t *testing.T) {
 			"metadata": "",
 			"options": [
 				{
+					"multiple_choice_option": "MULTIPLE_CHOICE_OPTION_UNSPECIFIED",
 					"option": "VOTE_OPTION_ABSTAIN",
 					"weight": "1.000000000000000000"
 				}
@@ -141,6 +158,7 @@ func TestMigrateJSON(t *testing.T) {
 			"metadata": "",
 			"options": [
 				{
+					"multiple_choice_option": "MULTIPLE_CHOICE_OPTION_UNSPECIFIED",
 					"option": "VOTE_OPTION_NO",
 					"weight": "1.000000000000000000"
 				}
@@ -150,6 +168,7 @@ func TestMigrateJSON(t *testing.T) {
 		}
 	],
 	"voting_params": {
+		"expedited_voting_period": null,
 		"voting_period": "172800s"
 	}
 }`
@@ -0,0 +1,98 @@
+package v047
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// migrateParams moves the gov params from the x/params subspace to the gov
+// store.
+func migrateParams(ctx sdk.Context, store sdk.KVStore, paramstore types.ParamSubspace, cdc codec.BinaryCodec) error {
+	var (
+		depositParams govv1.DepositParams
+		votingParams  govv1.VotingParams
+		tallyParams   govv1.TallyParams
+	)
+
+	paramstore.Get(ctx, govv1.ParamStoreKeyDepositParams, &depositParams)
+	paramstore.Get(ctx, govv1.ParamStoreKeyVotingParams, &votingParams)
+	paramstore.Get(ctx, govv1.ParamStoreKeyTallyParams, &tallyParams)
+
+	migrateExpeditedParams(&depositParams, &votingParams, &tallyParams)
+
+	// the charged deposits of canceled proposals are burned by default
+	if depositParams.ProposalCancelRatio == "" {
+		depositParams.ProposalCancelRatio = govv1.DefaultProposalCancelRatio.String()
+	}
+
+	// proposals can be submitted with any initial deposit by default
+	if depositParams.MinInitialDepositRatio == "" {
+		depositParams.MinInitialDepositRatio = govv1.DefaultMinInitialDepositRatio.String()
+	}
+
+	// optimistic proposals are rejected by the default share of No votes
+	if tallyParams.OptimisticRejectedThreshold == "" {
+		tallyParams.OptimisticRejectedThreshold = govv1.DefaultOptimisticRejectedThreshold.String()
+	}
+
+	params := govv1.NewParams(votingParams, tallyParams, depositParams)
+	if err := params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, bz)
+	return nil
+}
+
+// migrateExpeditedParams sets the expedited proposal params, which did not
+// exist before v0.47. The expedited minimum deposit is five times the minimum
+// deposit, and the expedited voting period and threshold use their defaults
+// unless those would not be stricter than the regular voting period and
+// threshold.
+func migrateExpeditedParams(depositParams *govv1.DepositParams, votingParams *govv1.VotingParams, tallyParams *govv1.TallyParams) {
+	if len(depositParams.ExpeditedMinDeposit) == 0 {
+		expeditedMinDeposit := make(sdk.Coins, len(depositParams.MinDeposit))
+		for i, coin := range depositParams.MinDeposit {
+			expeditedMinDeposit[i] = sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(5))
+		}
+		depositParams.ExpeditedMinDeposit = expeditedMinDeposit
+	}
+
+	if votingParams.ExpeditedVotingPeriod == nil && votingParams.VotingPeriod != nil {
+		expeditedPeriod := govv1.DefaultExpeditedPeriod
+		if expeditedPeriod >= *votingParams.VotingPeriod {
+			expeditedPeriod = *votingParams.VotingPeriod / 2
+		}
+		votingParams.ExpeditedVotingPeriod = &expeditedPeriod
+	}
+
+	if tallyParams.ExpeditedThreshold == "" {
+		expeditedThreshold := govv1.DefaultExpeditedThreshold
+		threshold, err := sdk.NewDecFromStr(tallyParams.Threshold)
+		if err == nil && expeditedThreshold.LTE(threshold) {
+			expeditedThreshold = threshold.Add(sdk.OneDec()).QuoInt64(2)
+		}
+		tallyParams.ExpeditedThreshold = expeditedThreshold.String()
+	}
+}
+
+// MigrateStore performs in-place store migrations from v0.46 to v0.47.
+// The migration includes:
+//
+// - Moving the params from the x/params subspace to the gov store.
+// - Setting the expedited proposal params.
+// - Setting the proposal cancel ratio param.
+// - Setting the minimum initial deposit ratio param.
+// - Setting the optimistic rejected threshold param.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, paramstore types.ParamSubspace, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+	return migrateParams(ctx, store, paramstore, cdc)
+}
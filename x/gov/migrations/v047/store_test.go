@@ -0,0 +1,63 @@
+package v047_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v047gov "github.com/cosmos/cosmos-sdk/x/gov/migrations/v047"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	govKey := sdk.NewKVStoreKey(types.StoreKey)
+	tGovKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(govKey, tGovKey)
+	store := ctx.KVStore(govKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, govKey, tGovKey, types.ModuleName).
+		WithKeyTable(v1.ParamKeyTable())
+
+	depositParams := v1.DepositParams{
+		MinDeposit:       sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		MaxDepositPeriod: durationPtr(time.Hour),
+	}
+	votingParams := v1.VotingParams{VotingPeriod: durationPtr(48 * time.Hour)}
+	tallyParams := v1.TallyParams{
+		Quorum:        v1.DefaultQuorum.String(),
+		Threshold:     sdk.NewDecWithPrec(7, 1).String(),
+		VetoThreshold: v1.DefaultVetoThreshold.String(),
+	}
+	paramstore.Set(ctx, v1.ParamStoreKeyDepositParams, &depositParams)
+	paramstore.Set(ctx, v1.ParamStoreKeyVotingParams, &votingParams)
+	paramstore.Set(ctx, v1.ParamStoreKeyTallyParams, &tallyParams)
+	require.False(t, store.Has(types.ParamsKey))
+
+	require.NoError(t, v047gov.MigrateStore(ctx, govKey, paramstore, encCfg.Codec))
+
+	var params v1.Params
+	encCfg.Codec.MustUnmarshal(store.Get(types.ParamsKey), &params)
+	require.Equal(t, depositParams.MinDeposit, params.DepositParams.MinDeposit)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 500)), sdk.NewCoins(params.DepositParams.ExpeditedMinDeposit...))
+	require.Equal(t, *votingParams.VotingPeriod, *params.VotingParams.VotingPeriod)
+	require.Equal(t, v1.DefaultExpeditedPeriod, *params.VotingParams.ExpeditedVotingPeriod)
+	require.Equal(t, tallyParams.Threshold, params.TallyParams.Threshold)
+	// the default expedited threshold is below the threshold, so the midpoint
+	// between the threshold and 1 is used.
+	require.Equal(t, sdk.NewDecWithPrec(85, 2).String(), params.TallyParams.ExpeditedThreshold)
+	require.Equal(t, v1.DefaultProposalCancelRatio.String(), params.DepositParams.ProposalCancelRatio)
+	require.Empty(t, params.DepositParams.ProposalCancelDest)
+	require.Equal(t, v1.DefaultMinInitialDepositRatio.String(), params.DepositParams.MinInitialDepositRatio)
+	require.Equal(t, v1.DefaultOptimisticRejectedThreshold.String(), params.TallyParams.OptimisticRejectedThreshold)
+	require.Empty(t, params.DepositParams.AllowedDepositDenoms)
+}
+
+func durationPtr(d time.Duration) *time.Duration {
+	return &d
+}
@@ -17,12 +17,17 @@ import (
 
 // Simulation parameter constants
 const (
-	DepositParamsMinDeposit    = "deposit_params_min_deposit"
-	DepositParamsDepositPeriod = "deposit_params_deposit_period"
-	VotingParamsVotingPeriod   = "voting_params_voting_period"
-	TallyParamsQuorum          = "tally_params_quorum"
-	TallyParamsThreshold       = "tally_params_threshold"
-	TallyParamsVeto            = "tally_params_veto"
+	DepositParamsMinDeposit                = "deposit_params_min_deposit"
+	DepositParamsDepositPeriod             = "deposit_params_deposit_period"
+	DepositParamsExpeditedMinDeposit       = "deposit_params_expedited_min_deposit"
+	DepositParamsProposalCancelRatio       = "deposit_params_proposal_cancel_ratio"
+	VotingParamsVotingPeriod               = "voting_params_voting_period"
+	VotingParamsExpeditedVotingPeriod      = "voting_params_expedited_voting_period"
+	TallyParamsQuorum                      = "tally_params_quorum"
+	TallyParamsThreshold                   = "tally_params_threshold"
+	TallyParamsVeto                        = "tally_params_veto"
+	TallyParamsExpeditedThreshold          = "tally_params_expedited_threshold"
+	TallyParamsOptimisticRejectedThreshold = "tally_params_optimistic_rejected_threshold"
 )
 
 // GenDepositParamsDepositPeriod randomized DepositParamsDepositPeriod
@@ -35,11 +40,22 @@ func GenDepositParamsMinDeposit(r *rand.Rand) sdk.Coins {
 	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simulation.RandIntBetween(r, 1, 1e3))))
 }
 
+// GenDepositParamsExpeditedMinDeposit randomized DepositParamsExpeditedMinDeposit
+func GenDepositParamsExpeditedMinDeposit(r *rand.Rand) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simulation.RandIntBetween(r, 1e3+1, 1e4))))
+}
+
 // GenVotingParamsVotingPeriod randomized VotingParamsVotingPeriod
 func GenVotingParamsVotingPeriod(r *rand.Rand) time.Duration {
 	return time.Duration(simulation.RandIntBetween(r, 1, 2*60*60*24*2)) * time.Second
 }
 
+// GenVotingParamsExpeditedVotingPeriod randomized VotingParamsExpeditedVotingPeriod,
+// strictly shorter than the given voting period
+func GenVotingParamsExpeditedVotingPeriod(r *rand.Rand, votingPeriod time.Duration) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 1, int(votingPeriod)))
+}
+
 // GenTallyParamsQuorum randomized TallyParamsQuorum
 func GenTallyParamsQuorum(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 334, 500)), 3)
@@ -55,6 +71,21 @@ func GenTallyParamsVeto(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 250, 334)), 3)
 }
 
+// GenDepositParamsProposalCancelRatio randomized DepositParamsProposalCancelRatio
+func GenDepositParamsProposalCancelRatio(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 0, 1001)), 3)
+}
+
+// GenTallyParamsExpeditedThreshold randomized TallyParamsExpeditedThreshold
+func GenTallyParamsExpeditedThreshold(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 550, 700)), 3)
+}
+
+// GenTallyParamsOptimisticRejectedThreshold randomized TallyParamsOptimisticRejectedThreshold
+func GenTallyParamsOptimisticRejectedThreshold(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 50, 200)), 3)
+}
+
 // RandomizedGenState generates a random GenesisState for gov
 func RandomizedGenState(simState *module.SimulationState) {
 	startingProposalID := uint64(simState.Rand.Intn(100))
@@ -95,11 +126,41 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { veto = GenTallyParamsVeto(r) },
 	)
 
+	var expeditedMinDeposit sdk.Coins
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepositParamsExpeditedMinDeposit, &expeditedMinDeposit, simState.Rand,
+		func(r *rand.Rand) { expeditedMinDeposit = GenDepositParamsExpeditedMinDeposit(r) },
+	)
+
+	var expeditedVotingPeriod time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, VotingParamsExpeditedVotingPeriod, &expeditedVotingPeriod, simState.Rand,
+		func(r *rand.Rand) { expeditedVotingPeriod = GenVotingParamsExpeditedVotingPeriod(r, votingPeriod) },
+	)
+
+	var expeditedThreshold sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, TallyParamsExpeditedThreshold, &expeditedThreshold, simState.Rand,
+		func(r *rand.Rand) { expeditedThreshold = GenTallyParamsExpeditedThreshold(r) },
+	)
+
+	var proposalCancelRatio sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepositParamsProposalCancelRatio, &proposalCancelRatio, simState.Rand,
+		func(r *rand.Rand) { proposalCancelRatio = GenDepositParamsProposalCancelRatio(r) },
+	)
+
+	var optimisticRejectedThreshold sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, TallyParamsOptimisticRejectedThreshold, &optimisticRejectedThreshold, simState.Rand,
+		func(r *rand.Rand) { optimisticRejectedThreshold = GenTallyParamsOptimisticRejectedThreshold(r) },
+	)
+
 	govGenesis := v1.NewGenesisState(
 		startingProposalID,
-		v1.NewDepositParams(minDeposit, depositPeriod),
-		v1.NewVotingParams(votingPeriod),
-		v1.NewTallyParams(quorum, threshold, veto),
+		v1.NewDepositParams(minDeposit, depositPeriod, expeditedMinDeposit, proposalCancelRatio, "", v1.DefaultMinInitialDepositRatio, nil),
+		v1.NewVotingParams(votingPeriod, expeditedVotingPeriod),
+		v1.NewTallyParams(quorum, threshold, veto, expeditedThreshold, optimisticRejectedThreshold, nil),
 	)
 
 	bz, err := json.MarshalIndent(&govGenesis, "", " ")
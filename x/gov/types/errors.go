@@ -21,4 +21,8 @@ var (
 	ErrInvalidSigner           = sdkerrors.Register(ModuleName, 13, "expected gov account as only signer for proposal message")
 	ErrInvalidSignalMsg        = sdkerrors.Register(ModuleName, 14, "signal message is invalid")
 	ErrMetadataTooLong         = sdkerrors.Register(ModuleName, 15, "metadata too long")
+	ErrInvalidProposer         = sdkerrors.Register(ModuleName, 16, "invalid proposer")
+	ErrVotingPeriodEnded       = sdkerrors.Register(ModuleName, 17, "voting period already ended")
+	ErrMinDepositTooSmall      = sdkerrors.Register(ModuleName, 18, "minimum deposit is too small")
+	ErrInvalidDepositDenom     = sdkerrors.Register(ModuleName, 19, "invalid deposit denom")
 )
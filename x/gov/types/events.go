@@ -7,6 +7,7 @@ const (
 	EventTypeProposalVote     = "proposal_vote"
 	EventTypeInactiveProposal = "inactive_proposal"
 	EventTypeActiveProposal   = "active_proposal"
+	EventTypeCancelProposal   = "cancel_proposal"
 	EventTypeSignalProposal   = "signal_proposal"
 
 	AttributeKeyProposalResult     = "proposal_result"
@@ -23,4 +24,8 @@ const (
 	AttributeKeyProposalType       = "proposal_type"
 	AttributeSignalTitle           = "signal_title"
 	AttributeSignalDescription     = "signal_description"
+
+	AttributeValueExpeditedProposalRejected = "expedited_proposal_rejected" // didn't pass as expedited, converted to regular
+	AttributeKeyProposer                    = "proposer"
+	AttributeKeyWinningOption               = "winning_option"
 )
@@ -0,0 +1,532 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/gov/v1/authz.proto
+
+package v1
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// VoteAuthorization allows the grantee to vote on governance proposals on
+// behalf of the granter, restricted to the given proposals and vote options.
+// It applies to MsgVote, or to MsgVoteWeighted if weighted is set, as an
+// authorization is granted for a single Msg type.
+type VoteAuthorization struct {
+	// proposal_ids is the list of proposals the grantee can vote on. If empty,
+	// the grantee can vote on any proposal.
+	ProposalIds []uint64 `protobuf:"varint,1,rep,packed,name=proposal_ids,json=proposalIds,proto3" json:"proposal_ids,omitempty"`
+	// options is the list of vote options the grantee can vote with. If empty,
+	// the grantee can vote with any option.
+	Options []VoteOption `protobuf:"varint,2,rep,packed,name=options,proto3,enum=cosmos.gov.v1.VoteOption" json:"options,omitempty"`
+	// weighted makes the authorization apply to MsgVoteWeighted instead of
+	// MsgVote. Every option of a weighted vote must then be allowed.
+	Weighted bool `protobuf:"varint,3,opt,name=weighted,proto3" json:"weighted,omitempty"`
+}
+
+func (m *VoteAuthorization) Reset()         { *m = VoteAuthorization{} }
+func (m *VoteAuthorization) String() string { return proto.CompactTextString(m) }
+func (*VoteAuthorization) ProtoMessage()    {}
+func (*VoteAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_06ac4a3f9741fe49, []int{0}
+}
+func (m *VoteAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteAuthorization.Merge(m, src)
+}
+func (m *VoteAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteAuthorization proto.InternalMessageInfo
+
+func (m *VoteAuthorization) GetProposalIds() []uint64 {
+	if m != nil {
+		return m.ProposalIds
+	}
+	return nil
+}
+
+func (m *VoteAuthorization) GetOptions() []VoteOption {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *VoteAuthorization) GetWeighted() bool {
+	if m != nil {
+		return m.Weighted
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*VoteAuthorization)(nil), "cosmos.gov.v1.VoteAuthorization")
+}
+
+func init() { proto.RegisterFile("cosmos/gov/v1/authz.proto", fileDescriptor_06ac4a3f9741fe49) }
+
+var fileDescriptor_06ac4a3f9741fe49 = []byte{
+	// 252 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4c, 0xce, 0x2f, 0xce,
+	0xcd, 0x2f, 0xd6, 0x4f, 0xcf, 0x2f, 0xd3, 0x2f, 0x33, 0xd4, 0x4f, 0x2c, 0x2d, 0xc9, 0xa8, 0xd2,
+	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x85, 0x48, 0xe9, 0xa5, 0xe7, 0x97, 0xe9, 0x95, 0x19,
+	0x4a, 0x41, 0x55, 0xc6, 0x83, 0x25, 0xf5, 0xa1, 0x72, 0x60, 0x8e, 0x94, 0x38, 0xaa, 0x21, 0x20,
+	0x0d, 0x60, 0x09, 0xa5, 0x59, 0x8c, 0x5c, 0x82, 0x61, 0xf9, 0x25, 0xa9, 0x8e, 0xa5, 0x25, 0x19,
+	0xf9, 0x45, 0x99, 0x55, 0x89, 0x25, 0x99, 0xf9, 0x79, 0x42, 0x8a, 0x5c, 0x3c, 0x05, 0x45, 0xf9,
+	0x05, 0xf9, 0xc5, 0x89, 0x39, 0xf1, 0x99, 0x29, 0xc5, 0x12, 0x8c, 0x0a, 0xcc, 0x1a, 0x2c, 0x41,
+	0xdc, 0x30, 0x31, 0xcf, 0x94, 0x62, 0x21, 0x63, 0x2e, 0xf6, 0xfc, 0x02, 0x90, 0xe2, 0x62, 0x09,
+	0x26, 0x05, 0x66, 0x0d, 0x3e, 0x23, 0x49, 0x3d, 0x14, 0xd7, 0xe8, 0x81, 0x4c, 0xf5, 0x07, 0xab,
+	0x08, 0x82, 0xa9, 0x14, 0x92, 0xe2, 0xe2, 0x28, 0x4f, 0xcd, 0x4c, 0xcf, 0x28, 0x49, 0x4d, 0x91,
+	0x60, 0x56, 0x60, 0xd4, 0xe0, 0x08, 0x82, 0xf3, 0xad, 0x04, 0x4f, 0x6d, 0xd1, 0xe5, 0x45, 0x71,
+	0x86, 0x93, 0xeb, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38,
+	0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x69, 0xa7, 0x67,
+	0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0x42, 0x3d, 0x0a, 0xa5, 0x74, 0x8b, 0x53, 0xb2,
+	0xf5, 0x2b, 0xc0, 0xfe, 0x2c, 0xa9, 0x2c, 0x48, 0x2d, 0xd6, 0x2f, 0x33, 0x4c, 0x62, 0x03, 0x7b,
+	0xd5, 0x18, 0x30, 0x00, 0x6b, 0x25, 0x41, 0x9b, 0x4a, 0x01, 0x00, 0x00,
+}
+
+func (m *VoteAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weighted {
+		i--
+		if m.Weighted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Options) > 0 {
+		dAtA2 := make([]byte, len(m.Options)*10)
+		var j1 int
+		for _, num := range m.Options {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintAuthz(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProposalIds) > 0 {
+		dAtA4 := make([]byte, len(m.ProposalIds)*10)
+		var j3 int
+		for _, num := range m.ProposalIds {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintAuthz(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *VoteAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProposalIds) > 0 {
+		l = 0
+		for _, e := range m.ProposalIds {
+			l += sovAuthz(uint64(e))
+		}
+		n += 1 + sovAuthz(uint64(l)) + l
+	}
+	if len(m.Options) > 0 {
+		l = 0
+		for _, e := range m.Options {
+			l += sovAuthz(uint64(e))
+		}
+		n += 1 + sovAuthz(uint64(l)) + l
+	}
+	if m.Weighted {
+		n += 2
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *VoteAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthz
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ProposalIds = append(m.ProposalIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthz
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAuthz
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAuthz
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ProposalIds) == 0 {
+					m.ProposalIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuthz
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ProposalIds = append(m.ProposalIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalIds", wireType)
+			}
+		case 2:
+			if wireType == 0 {
+				var v VoteOption
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthz
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= VoteOption(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Options = append(m.Options, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthz
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAuthz
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAuthz
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Options) == 0 {
+					m.Options = make([]VoteOption, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v VoteOption
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuthz
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= VoteOption(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Options = append(m.Options, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weighted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Weighted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
@@ -7,6 +7,7 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	authzcodec "github.com/cosmos/cosmos-sdk/x/authz/codec"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
@@ -19,6 +20,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgVote{}, "cosmos-sdk/v1/MsgVote")
 	legacy.RegisterAminoMsg(cdc, &MsgVoteWeighted{}, "cosmos-sdk/v1/MsgVoteWeighted")
 	legacy.RegisterAminoMsg(cdc, &MsgExecLegacyContent{}, "cosmos-sdk/v1/MsgExecLegacyContent")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/gov/v1/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelProposal{}, "cosmos-sdk/v1/MsgCancelProposal")
+	cdc.RegisterConcrete(&VoteAuthorization{}, "cosmos-sdk/v1/VoteAuthorization", nil)
 }
 
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
@@ -28,6 +32,13 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgVoteWeighted{},
 		&MsgDeposit{},
 		&MsgExecLegacyContent{},
+		&MsgUpdateParams{},
+		&MsgCancelProposal{},
+	)
+
+	registry.RegisterImplementations(
+		(*authz.Authorization)(nil),
+		&VoteAuthorization{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
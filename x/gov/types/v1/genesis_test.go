@@ -2,7 +2,9 @@ package v1_test
 
 import (
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/stretchr/testify/require"
 )
@@ -20,6 +22,36 @@ func TestValidateGenesis(t *testing.T) {
 	votingParams := v1.DefaultVotingParams()
 	tallyParams := v1.DefaultTallyParams()
 
+	lowExpeditedDepositParams := v1.DefaultDepositParams()
+	lowExpeditedDepositParams.ExpeditedMinDeposit = lowExpeditedDepositParams.MinDeposit
+
+	invalidCancelRatioDepositParams := v1.DefaultDepositParams()
+	invalidCancelRatioDepositParams.ProposalCancelRatio = sdk.NewDecWithPrec(11, 1).String()
+
+	invalidMinInitialRatioDepositParams := v1.DefaultDepositParams()
+	invalidMinInitialRatioDepositParams.MinInitialDepositRatio = sdk.NewDecWithPrec(-1, 1).String()
+
+	disallowedMinDepositParams := v1.DefaultDepositParams()
+	disallowedMinDepositParams.AllowedDepositDenoms = []string{"uatom"}
+
+	duplicateDenomDepositParams := v1.DefaultDepositParams()
+	duplicateDenomDepositParams.AllowedDepositDenoms = []string{sdk.DefaultBondDenom, sdk.DefaultBondDenom}
+
+	longExpeditedVotingParams := v1.NewVotingParams(time.Hour, time.Hour)
+
+	lowExpeditedTallyParams := v1.DefaultTallyParams()
+	lowExpeditedTallyParams.ExpeditedThreshold = sdk.NewDecWithPrec(4, 1).String()
+
+	zeroOptimisticTallyParams := v1.DefaultTallyParams()
+	zeroOptimisticTallyParams.OptimisticRejectedThreshold = sdk.ZeroDec().String()
+
+	invalidOptimisticAuthorizedTallyParams := v1.DefaultTallyParams()
+	invalidOptimisticAuthorizedTallyParams.OptimisticAuthorizedAddresses = []string{"invalid"}
+
+	duplicateOptimisticAuthorizedTallyParams := v1.DefaultTallyParams()
+	optimisticAuthorized := sdk.AccAddress("optimistic__________").String()
+	duplicateOptimisticAuthorizedTallyParams.OptimisticAuthorizedAddresses = []string{optimisticAuthorized, optimisticAuthorized}
+
 	testCases := []struct {
 		name         string
 		genesisState *v1.GenesisState
@@ -69,6 +101,106 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErr: true,
 		},
+		{
+			name: "expedited min deposit not greater than min deposit",
+			genesisState: &v1.GenesisState{
+				StartingProposalId: v1.DefaultStartingProposalID,
+				DepositParams:      &lowExpeditedDepositParams,
+				VotingParams:       &votingParams,
+				TallyParams:        &tallyParams,
+			},
+			expErr: true,
+		},
+		{
+			name: "expedited voting period not less than voting period",
+			genesisState: &v1.GenesisState{
+				StartingProposalId: v1.DefaultStartingProposalID,
+				DepositParams:      &depositParams,
+				VotingParams:       &longExpeditedVotingParams,
+				TallyParams:        &tallyParams,
+			},
+			expErr: true,
+		},
+		{
+			name: "expedited threshold not greater than threshold",
+			genesisState: &v1.GenesisState{
+				StartingProposalId: v1.DefaultStartingProposalID,
+				DepositParams:      &depositParams,
+				VotingParams:       &votingParams,
+				TallyParams:        &lowExpeditedTallyParams,
+			},
+			expErr: true,
+		},
+		{
+			name: "zero optimistic rejected threshold",
+			genesisState: &v1.GenesisState{
+				StartingProposalId: v1.DefaultStartingProposalID,
+				DepositParams:      &depositParams,
+				VotingParams:       &votingParams,
+				TallyParams:        &zeroOptimisticTallyParams,
+			},
+			expErr: true,
+		},
+		{
+			name: "invalid optimistic authorized address",
+			genesisState: &v1.GenesisState{
+				StartingProposalId: v1.DefaultStartingProposalID,
+				DepositParams:      &depositParams,
+				VotingParams:       &votingParams,
+				TallyParams:        &invalidOptimisticAuthorizedTallyParams,
+			},
+			expErr: true,
+		},
+		{
+			name: "duplicate optimistic authorized address",
+			genesisState: &v1.GenesisState{
+				StartingProposalId: v1.DefaultStartingProposalID,
+				DepositParams:      &depositParams,
+				VotingParams:       &votingParams,
+				TallyParams:        &duplicateOptimisticAuthorizedTallyParams,
+			},
+			expErr: true,
+		},
+		{
+			name: "proposal cancel ratio greater than 1",
+			genesisState: &v1.GenesisState{
+				StartingProposalId: v1.DefaultStartingProposalID,
+				DepositParams:      &invalidCancelRatioDepositParams,
+				VotingParams:       &votingParams,
+				TallyParams:        &tallyParams,
+			},
+			expErr: true,
+		},
+		{
+			name: "negative minimum initial deposit ratio",
+			genesisState: &v1.GenesisState{
+				StartingProposalId: v1.DefaultStartingProposalID,
+				DepositParams:      &invalidMinInitialRatioDepositParams,
+				VotingParams:       &votingParams,
+				TallyParams:        &tallyParams,
+			},
+			expErr: true,
+		},
+		{
+			name: "minimum deposit denom not allowed",
+			genesisState: &v1.GenesisState{
+				StartingProposalId: v1.DefaultStartingProposalID,
+				DepositParams:      &disallowedMinDepositParams,
+				VotingParams:       &votingParams,
+				TallyParams:        &tallyParams,
+			},
+			expErr: true,
+		},
+		{
+			name: "duplicate allowed deposit denom",
+			genesisState: &v1.GenesisState{
+				StartingProposalId: v1.DefaultStartingProposalID,
+				DepositParams:      &duplicateDenomDepositParams,
+				VotingParams:       &votingParams,
+				TallyParams:        &tallyParams,
+			},
+			expErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
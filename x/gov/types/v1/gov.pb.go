@@ -71,6 +71,90 @@ func (VoteOption) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_e05cb1c0d030febb, []int{0}
 }
 
+// MultipleChoiceOption enumerates the options of a multiple-choice proposal.
+type MultipleChoiceOption int32
+
+const (
+	// MULTIPLE_CHOICE_OPTION_UNSPECIFIED defines no multiple-choice option.
+	MultipleChoiceOption_MULTIPLE_CHOICE_OPTION_UNSPECIFIED MultipleChoiceOption = 0
+	// MULTIPLE_CHOICE_OPTION_ONE defines the first option of a multiple-choice
+	// proposal.
+	MultipleChoiceOption_MULTIPLE_CHOICE_OPTION_ONE MultipleChoiceOption = 1
+	// MULTIPLE_CHOICE_OPTION_TWO defines the second option of a multiple-choice
+	// proposal.
+	MultipleChoiceOption_MULTIPLE_CHOICE_OPTION_TWO MultipleChoiceOption = 2
+	// MULTIPLE_CHOICE_OPTION_THREE defines the third option of a multiple-choice
+	// proposal.
+	MultipleChoiceOption_MULTIPLE_CHOICE_OPTION_THREE MultipleChoiceOption = 3
+	// MULTIPLE_CHOICE_OPTION_FOUR defines the fourth option of a multiple-choice
+	// proposal.
+	MultipleChoiceOption_MULTIPLE_CHOICE_OPTION_FOUR MultipleChoiceOption = 4
+)
+
+var MultipleChoiceOption_name = map[int32]string{
+	0: "MULTIPLE_CHOICE_OPTION_UNSPECIFIED",
+	1: "MULTIPLE_CHOICE_OPTION_ONE",
+	2: "MULTIPLE_CHOICE_OPTION_TWO",
+	3: "MULTIPLE_CHOICE_OPTION_THREE",
+	4: "MULTIPLE_CHOICE_OPTION_FOUR",
+}
+
+var MultipleChoiceOption_value = map[string]int32{
+	"MULTIPLE_CHOICE_OPTION_UNSPECIFIED": 0,
+	"MULTIPLE_CHOICE_OPTION_ONE":         1,
+	"MULTIPLE_CHOICE_OPTION_TWO":         2,
+	"MULTIPLE_CHOICE_OPTION_THREE":       3,
+	"MULTIPLE_CHOICE_OPTION_FOUR":        4,
+}
+
+func (x MultipleChoiceOption) String() string {
+	return proto.EnumName(MultipleChoiceOption_name, int32(x))
+}
+
+func (MultipleChoiceOption) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{1}
+}
+
+// ProposalType enumerates the valid proposal types.
+type ProposalType int32
+
+const (
+	// PROPOSAL_TYPE_UNSPECIFIED defines no proposal type, which is handled as a
+	// standard proposal.
+	ProposalType_PROPOSAL_TYPE_UNSPECIFIED ProposalType = 0
+	// PROPOSAL_TYPE_STANDARD defines a standard proposal, executing its
+	// messages when it passes.
+	ProposalType_PROPOSAL_TYPE_STANDARD ProposalType = 1
+	// PROPOSAL_TYPE_MULTIPLE_CHOICE defines a multiple-choice proposal. It has
+	// no messages, and its voters choose one of up to four named options.
+	ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE ProposalType = 2
+	// PROPOSAL_TYPE_OPTIMISTIC defines an optimistic proposal. It passes unless
+	// enough of the voting power votes against it.
+	ProposalType_PROPOSAL_TYPE_OPTIMISTIC ProposalType = 3
+)
+
+var ProposalType_name = map[int32]string{
+	0: "PROPOSAL_TYPE_UNSPECIFIED",
+	1: "PROPOSAL_TYPE_STANDARD",
+	2: "PROPOSAL_TYPE_MULTIPLE_CHOICE",
+	3: "PROPOSAL_TYPE_OPTIMISTIC",
+}
+
+var ProposalType_value = map[string]int32{
+	"PROPOSAL_TYPE_UNSPECIFIED":     0,
+	"PROPOSAL_TYPE_STANDARD":        1,
+	"PROPOSAL_TYPE_MULTIPLE_CHOICE": 2,
+	"PROPOSAL_TYPE_OPTIMISTIC":      3,
+}
+
+func (x ProposalType) String() string {
+	return proto.EnumName(ProposalType_name, int32(x))
+}
+
+func (ProposalType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{2}
+}
+
 // ProposalStatus enumerates the valid statuses of a proposal.
 type ProposalStatus int32
 
@@ -117,20 +201,94 @@ func (x ProposalStatus) String() string {
 }
 
 func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{1}
+	return fileDescriptor_e05cb1c0d030febb, []int{3}
+}
+
+// ProposalVoteOptions defines the named options of a multiple-choice proposal.
+// The options are voted for with MULTIPLE_CHOICE_OPTION_ONE to
+// MULTIPLE_CHOICE_OPTION_FOUR.
+type ProposalVoteOptions struct {
+	OptionOne   string `protobuf:"bytes,1,opt,name=option_one,json=optionOne,proto3" json:"option_one,omitempty"`
+	OptionTwo   string `protobuf:"bytes,2,opt,name=option_two,json=optionTwo,proto3" json:"option_two,omitempty"`
+	OptionThree string `protobuf:"bytes,3,opt,name=option_three,json=optionThree,proto3" json:"option_three,omitempty"`
+	OptionFour  string `protobuf:"bytes,4,opt,name=option_four,json=optionFour,proto3" json:"option_four,omitempty"`
+}
+
+func (m *ProposalVoteOptions) Reset()         { *m = ProposalVoteOptions{} }
+func (m *ProposalVoteOptions) String() string { return proto.CompactTextString(m) }
+func (*ProposalVoteOptions) ProtoMessage()    {}
+func (*ProposalVoteOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{0}
+}
+func (m *ProposalVoteOptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalVoteOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalVoteOptions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalVoteOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalVoteOptions.Merge(m, src)
+}
+func (m *ProposalVoteOptions) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalVoteOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalVoteOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalVoteOptions proto.InternalMessageInfo
+
+func (m *ProposalVoteOptions) GetOptionOne() string {
+	if m != nil {
+		return m.OptionOne
+	}
+	return ""
+}
+
+func (m *ProposalVoteOptions) GetOptionTwo() string {
+	if m != nil {
+		return m.OptionTwo
+	}
+	return ""
+}
+
+func (m *ProposalVoteOptions) GetOptionThree() string {
+	if m != nil {
+		return m.OptionThree
+	}
+	return ""
+}
+
+func (m *ProposalVoteOptions) GetOptionFour() string {
+	if m != nil {
+		return m.OptionFour
+	}
+	return ""
 }
 
 // WeightedVoteOption defines a unit of vote for vote split.
 type WeightedVoteOption struct {
 	Option VoteOption `protobuf:"varint,1,opt,name=option,proto3,enum=cosmos.gov.v1.VoteOption" json:"option,omitempty"`
 	Weight string     `protobuf:"bytes,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	// multiple_choice_option defines the option voted for on a multiple-choice
+	// proposal, in which case option is left unspecified.
+	MultipleChoiceOption MultipleChoiceOption `protobuf:"varint,3,opt,name=multiple_choice_option,json=multipleChoiceOption,proto3,enum=cosmos.gov.v1.MultipleChoiceOption" json:"multiple_choice_option,omitempty"`
 }
 
 func (m *WeightedVoteOption) Reset()         { *m = WeightedVoteOption{} }
 func (m *WeightedVoteOption) String() string { return proto.CompactTextString(m) }
 func (*WeightedVoteOption) ProtoMessage()    {}
 func (*WeightedVoteOption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{0}
+	return fileDescriptor_e05cb1c0d030febb, []int{1}
 }
 func (m *WeightedVoteOption) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -173,6 +331,13 @@ func (m *WeightedVoteOption) GetWeight() string {
 	return ""
 }
 
+func (m *WeightedVoteOption) GetMultipleChoiceOption() MultipleChoiceOption {
+	if m != nil {
+		return m.MultipleChoiceOption
+	}
+	return MultipleChoiceOption_MULTIPLE_CHOICE_OPTION_UNSPECIFIED
+}
+
 // Deposit defines an amount deposited by an account address to an active
 // proposal.
 type Deposit struct {
@@ -185,7 +350,7 @@ func (m *Deposit) Reset()         { *m = Deposit{} }
 func (m *Deposit) String() string { return proto.CompactTextString(m) }
 func (*Deposit) ProtoMessage()    {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{1}
+	return fileDescriptor_e05cb1c0d030febb, []int{2}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -251,13 +416,23 @@ type Proposal struct {
 	VotingEndTime    *time.Time   `protobuf:"bytes,9,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time,omitempty"`
 	// metadata is any arbitrary metadata attached to the proposal.
 	Metadata string `protobuf:"bytes,10,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// expedited defines if the proposal is expedited. Expedited proposals use
+	// the expedited voting period, threshold and minimum deposit.
+	Expedited bool `protobuf:"varint,11,opt,name=expedited,proto3" json:"expedited,omitempty"`
+	// proposer is the address of the proposal submitter. Only the proposer can
+	// cancel the proposal.
+	Proposer string `protobuf:"bytes,12,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// proposal_type defines the type of the proposal.
+	ProposalType ProposalType `protobuf:"varint,13,opt,name=proposal_type,json=proposalType,proto3,enum=cosmos.gov.v1.ProposalType" json:"proposal_type,omitempty"`
+	// vote_options defines the named options of a multiple-choice proposal.
+	VoteOptions *ProposalVoteOptions `protobuf:"bytes,14,opt,name=vote_options,json=voteOptions,proto3" json:"vote_options,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{2}
+	return fileDescriptor_e05cb1c0d030febb, []int{3}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -356,19 +531,53 @@ func (m *Proposal) GetMetadata() string {
 	return ""
 }
 
+func (m *Proposal) GetExpedited() bool {
+	if m != nil {
+		return m.Expedited
+	}
+	return false
+}
+
+func (m *Proposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *Proposal) GetProposalType() ProposalType {
+	if m != nil {
+		return m.ProposalType
+	}
+	return ProposalType_PROPOSAL_TYPE_UNSPECIFIED
+}
+
+func (m *Proposal) GetVoteOptions() *ProposalVoteOptions {
+	if m != nil {
+		return m.VoteOptions
+	}
+	return nil
+}
+
 // TallyResult defines a standard tally for a governance proposal.
 type TallyResult struct {
 	YesCount        string `protobuf:"bytes,1,opt,name=yes_count,json=yesCount,proto3" json:"yes_count,omitempty"`
 	AbstainCount    string `protobuf:"bytes,2,opt,name=abstain_count,json=abstainCount,proto3" json:"abstain_count,omitempty"`
 	NoCount         string `protobuf:"bytes,3,opt,name=no_count,json=noCount,proto3" json:"no_count,omitempty"`
 	NoWithVetoCount string `protobuf:"bytes,4,opt,name=no_with_veto_count,json=noWithVetoCount,proto3" json:"no_with_veto_count,omitempty"`
+	// option_one_count to option_four_count are the counts of the options of a
+	// multiple-choice proposal. They are only set for multiple-choice proposals.
+	OptionOneCount   string `protobuf:"bytes,5,opt,name=option_one_count,json=optionOneCount,proto3" json:"option_one_count,omitempty"`
+	OptionTwoCount   string `protobuf:"bytes,6,opt,name=option_two_count,json=optionTwoCount,proto3" json:"option_two_count,omitempty"`
+	OptionThreeCount string `protobuf:"bytes,7,opt,name=option_three_count,json=optionThreeCount,proto3" json:"option_three_count,omitempty"`
+	OptionFourCount  string `protobuf:"bytes,8,opt,name=option_four_count,json=optionFourCount,proto3" json:"option_four_count,omitempty"`
 }
 
 func (m *TallyResult) Reset()         { *m = TallyResult{} }
 func (m *TallyResult) String() string { return proto.CompactTextString(m) }
 func (*TallyResult) ProtoMessage()    {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{3}
+	return fileDescriptor_e05cb1c0d030febb, []int{4}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -425,6 +634,34 @@ func (m *TallyResult) GetNoWithVetoCount() string {
 	return ""
 }
 
+func (m *TallyResult) GetOptionOneCount() string {
+	if m != nil {
+		return m.OptionOneCount
+	}
+	return ""
+}
+
+func (m *TallyResult) GetOptionTwoCount() string {
+	if m != nil {
+		return m.OptionTwoCount
+	}
+	return ""
+}
+
+func (m *TallyResult) GetOptionThreeCount() string {
+	if m != nil {
+		return m.OptionThreeCount
+	}
+	return ""
+}
+
+func (m *TallyResult) GetOptionFourCount() string {
+	if m != nil {
+		return m.OptionFourCount
+	}
+	return ""
+}
+
 // Vote defines a vote on a governance proposal.
 // A Vote consists of a proposal ID, the voter, and the vote option.
 type Vote struct {
@@ -439,7 +676,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{4}
+	return fileDescriptor_e05cb1c0d030febb, []int{5}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -496,6 +733,69 @@ func (m *Vote) GetMetadata() string {
 	return ""
 }
 
+// Params defines the parameters of the x/gov module.
+type Params struct {
+	// deposit_params defines the parameters related to deposits.
+	DepositParams DepositParams `protobuf:"bytes,1,opt,name=deposit_params,json=depositParams,proto3" json:"deposit_params"`
+	// voting_params defines the parameters related to voting.
+	VotingParams VotingParams `protobuf:"bytes,2,opt,name=voting_params,json=votingParams,proto3" json:"voting_params"`
+	// tally_params defines the parameters related to tallying.
+	TallyParams TallyParams `protobuf:"bytes,3,opt,name=tally_params,json=tallyParams,proto3" json:"tally_params"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{6}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetDepositParams() DepositParams {
+	if m != nil {
+		return m.DepositParams
+	}
+	return DepositParams{}
+}
+
+func (m *Params) GetVotingParams() VotingParams {
+	if m != nil {
+		return m.VotingParams
+	}
+	return VotingParams{}
+}
+
+func (m *Params) GetTallyParams() TallyParams {
+	if m != nil {
+		return m.TallyParams
+	}
+	return TallyParams{}
+}
+
 // DepositParams defines the params for deposits on governance proposals.
 type DepositParams struct {
 	//  Minimum deposit for a proposal to enter voting period.
@@ -503,13 +803,27 @@ type DepositParams struct {
 	//  Maximum period for Atom holders to deposit on a proposal. Initial value: 2
 	//  months.
 	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
+	//  Minimum deposit for an expedited proposal to enter voting period.
+	ExpeditedMinDeposit []types.Coin `protobuf:"bytes,3,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3" json:"expedited_min_deposit,omitempty"`
+	//  Proportion of the deposits charged when a proposal is canceled by its
+	//  proposer. The rest of the deposits is refunded to the depositors.
+	ProposalCancelRatio string `protobuf:"bytes,4,opt,name=proposal_cancel_ratio,json=proposalCancelRatio,proto3" json:"proposal_cancel_ratio,omitempty"`
+	//  Address receiving the deposits charged when a proposal is canceled. If
+	//  empty, the charged deposits are burned.
+	ProposalCancelDest string `protobuf:"bytes,5,opt,name=proposal_cancel_dest,json=proposalCancelDest,proto3" json:"proposal_cancel_dest,omitempty"`
+	//  Minimum proportion of the minimum deposit a proposal must be submitted
+	//  with. Zero disables the check.
+	MinInitialDepositRatio string `protobuf:"bytes,6,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
+	//  Denoms accepted for deposits. If empty, deposits of any denom are
+	//  accepted.
+	AllowedDepositDenoms []string `protobuf:"bytes,7,rep,name=allowed_deposit_denoms,json=allowedDepositDenoms,proto3" json:"allowed_deposit_denoms,omitempty"`
 }
 
 func (m *DepositParams) Reset()         { *m = DepositParams{} }
 func (m *DepositParams) String() string { return proto.CompactTextString(m) }
 func (*DepositParams) ProtoMessage()    {}
 func (*DepositParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{5}
+	return fileDescriptor_e05cb1c0d030febb, []int{7}
 }
 func (m *DepositParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -552,17 +866,55 @@ func (m *DepositParams) GetMaxDepositPeriod() *time.Duration {
 	return nil
 }
 
+func (m *DepositParams) GetExpeditedMinDeposit() []types.Coin {
+	if m != nil {
+		return m.ExpeditedMinDeposit
+	}
+	return nil
+}
+
+func (m *DepositParams) GetProposalCancelRatio() string {
+	if m != nil {
+		return m.ProposalCancelRatio
+	}
+	return ""
+}
+
+func (m *DepositParams) GetProposalCancelDest() string {
+	if m != nil {
+		return m.ProposalCancelDest
+	}
+	return ""
+}
+
+func (m *DepositParams) GetMinInitialDepositRatio() string {
+	if m != nil {
+		return m.MinInitialDepositRatio
+	}
+	return ""
+}
+
+func (m *DepositParams) GetAllowedDepositDenoms() []string {
+	if m != nil {
+		return m.AllowedDepositDenoms
+	}
+	return nil
+}
+
 // VotingParams defines the params for voting on governance proposals.
 type VotingParams struct {
 	//  Length of the voting period.
 	VotingPeriod *time.Duration `protobuf:"bytes,1,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
+	//  Length of the voting period of expedited proposals. It must be shorter
+	//  than the voting period.
+	ExpeditedVotingPeriod *time.Duration `protobuf:"bytes,2,opt,name=expedited_voting_period,json=expeditedVotingPeriod,proto3,stdduration" json:"expedited_voting_period,omitempty"`
 }
 
 func (m *VotingParams) Reset()         { *m = VotingParams{} }
 func (m *VotingParams) String() string { return proto.CompactTextString(m) }
 func (*VotingParams) ProtoMessage()    {}
 func (*VotingParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{6}
+	return fileDescriptor_e05cb1c0d030febb, []int{8}
 }
 func (m *VotingParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -598,6 +950,13 @@ func (m *VotingParams) GetVotingPeriod() *time.Duration {
 	return nil
 }
 
+func (m *VotingParams) GetExpeditedVotingPeriod() *time.Duration {
+	if m != nil {
+		return m.ExpeditedVotingPeriod
+	}
+	return nil
+}
+
 // TallyParams defines the params for tallying votes on governance proposals.
 type TallyParams struct {
 	//  Minimum percentage of total stake needed to vote for a result to be
@@ -608,13 +967,22 @@ type TallyParams struct {
 	//  Minimum value of Veto votes to Total votes ratio for proposal to be
 	//  vetoed. Default value: 1/3.
 	VetoThreshold string `protobuf:"bytes,3,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+	//  Minimum proportion of Yes votes for an expedited proposal to pass. It
+	//  must be greater than the threshold. Default value: 0.667.
+	ExpeditedThreshold string `protobuf:"bytes,4,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
+	//  Minimum proportion of the total voting power voting No or NoWithVeto for
+	//  an optimistic proposal to be rejected. Default value: 0.1.
+	OptimisticRejectedThreshold string `protobuf:"bytes,5,opt,name=optimistic_rejected_threshold,json=optimisticRejectedThreshold,proto3" json:"optimistic_rejected_threshold,omitempty"`
+	//  Addresses allowed to submit optimistic proposals. If empty, optimistic
+	//  proposals are disabled.
+	OptimisticAuthorizedAddresses []string `protobuf:"bytes,6,rep,name=optimistic_authorized_addresses,json=optimisticAuthorizedAddresses,proto3" json:"optimistic_authorized_addresses,omitempty"`
 }
 
 func (m *TallyParams) Reset()         { *m = TallyParams{} }
 func (m *TallyParams) String() string { return proto.CompactTextString(m) }
 func (*TallyParams) ProtoMessage()    {}
 func (*TallyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{7}
+	return fileDescriptor_e05cb1c0d030febb, []int{9}
 }
 func (m *TallyParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -664,14 +1032,39 @@ func (m *TallyParams) GetVetoThreshold() string {
 	return ""
 }
 
+func (m *TallyParams) GetExpeditedThreshold() string {
+	if m != nil {
+		return m.ExpeditedThreshold
+	}
+	return ""
+}
+
+func (m *TallyParams) GetOptimisticRejectedThreshold() string {
+	if m != nil {
+		return m.OptimisticRejectedThreshold
+	}
+	return ""
+}
+
+func (m *TallyParams) GetOptimisticAuthorizedAddresses() []string {
+	if m != nil {
+		return m.OptimisticAuthorizedAddresses
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
+	proto.RegisterEnum("cosmos.gov.v1.MultipleChoiceOption", MultipleChoiceOption_name, MultipleChoiceOption_value)
+	proto.RegisterEnum("cosmos.gov.v1.ProposalType", ProposalType_name, ProposalType_value)
 	proto.RegisterEnum("cosmos.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
+	proto.RegisterType((*ProposalVoteOptions)(nil), "cosmos.gov.v1.ProposalVoteOptions")
 	proto.RegisterType((*WeightedVoteOption)(nil), "cosmos.gov.v1.WeightedVoteOption")
 	proto.RegisterType((*Deposit)(nil), "cosmos.gov.v1.Deposit")
 	proto.RegisterType((*Proposal)(nil), "cosmos.gov.v1.Proposal")
 	proto.RegisterType((*TallyResult)(nil), "cosmos.gov.v1.TallyResult")
 	proto.RegisterType((*Vote)(nil), "cosmos.gov.v1.Vote")
+	proto.RegisterType((*Params)(nil), "cosmos.gov.v1.Params")
 	proto.RegisterType((*DepositParams)(nil), "cosmos.gov.v1.DepositParams")
 	proto.RegisterType((*VotingParams)(nil), "cosmos.gov.v1.VotingParams")
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1.TallyParams")
@@ -680,76 +1073,170 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
 
 var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 1104 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x4f, 0x53, 0xdb, 0x46,
-	0x14, 0x47, 0xb6, 0x30, 0xe6, 0x19, 0x1b, 0x75, 0xa1, 0x45, 0x90, 0x60, 0x13, 0x4f, 0xdb, 0xa1,
-	0xa4, 0xd8, 0x25, 0x99, 0xb6, 0x33, 0xcd, 0xc9, 0xc6, 0x4a, 0x31, 0xc3, 0x60, 0x57, 0x52, 0xcc,
-	0xa4, 0x17, 0x8d, 0x8c, 0x14, 0x5b, 0x53, 0x4b, 0xeb, 0x6a, 0xd7, 0x0e, 0xfe, 0x08, 0xbd, 0xe5,
-	0xd8, 0x99, 0x7e, 0x8d, 0x4c, 0x3f, 0x43, 0x4e, 0x9d, 0x4c, 0x0e, 0xfd, 0x73, 0x71, 0x5b, 0xb8,
-	0xf1, 0x29, 0x32, 0x5a, 0xad, 0xb0, 0x2d, 0xc8, 0xc0, 0x49, 0xd2, 0x7b, 0xbf, 0xdf, 0xef, 0xbd,
-	0xdd, 0xf7, 0xd3, 0x4a, 0xb0, 0x76, 0x8a, 0x89, 0x8b, 0x49, 0xb9, 0x83, 0x87, 0xe5, 0xe1, 0x5e,
-	0x70, 0x29, 0xf5, 0x7d, 0x4c, 0x31, 0xca, 0x86, 0x89, 0x52, 0x10, 0x19, 0xee, 0x6d, 0xe4, 0x39,
-	0xae, 0x6d, 0x12, 0xbb, 0x3c, 0xdc, 0x6b, 0xdb, 0xd4, 0xdc, 0x2b, 0x9f, 0x62, 0xc7, 0x0b, 0xe1,
-	0x1b, 0xab, 0x1d, 0xdc, 0xc1, 0xec, 0xb6, 0x1c, 0xdc, 0xf1, 0x68, 0xa1, 0x83, 0x71, 0xa7, 0x67,
-	0x97, 0xd9, 0x53, 0x7b, 0xf0, 0xa2, 0x4c, 0x1d, 0xd7, 0x26, 0xd4, 0x74, 0xfb, 0x1c, 0xb0, 0x1e,
-	0x07, 0x98, 0xde, 0x88, 0xa7, 0xf2, 0xf1, 0x94, 0x35, 0xf0, 0x4d, 0xea, 0xe0, 0xa8, 0xe2, 0x7a,
-	0xd8, 0x91, 0x11, 0x16, 0xe5, 0xdd, 0xb2, 0x87, 0x22, 0x06, 0x74, 0x62, 0x3b, 0x9d, 0x2e, 0xb5,
-	0xad, 0x16, 0xa6, 0x76, 0xa3, 0x1f, 0xd0, 0xd0, 0x1e, 0xa4, 0x30, 0xbb, 0x93, 0x85, 0x2d, 0x61,
-	0x3b, 0xf7, 0x68, 0xbd, 0x34, 0xb3, 0xc4, 0xd2, 0x04, 0xaa, 0x72, 0x20, 0xfa, 0x1c, 0x52, 0x2f,
-	0x99, 0x90, 0x9c, 0xd8, 0x12, 0xb6, 0x17, 0xab, 0xb9, 0x77, 0xaf, 0x77, 0x81, 0xb3, 0x6a, 0xf6,
-	0xa9, 0xca, 0xb3, 0xc5, 0xdf, 0x04, 0x58, 0xa8, 0xd9, 0x7d, 0x4c, 0x1c, 0x8a, 0x0a, 0x90, 0xe9,
-	0xfb, 0xb8, 0x8f, 0x89, 0xd9, 0x33, 0x1c, 0x8b, 0xd5, 0x12, 0x55, 0x88, 0x42, 0x75, 0x0b, 0x7d,
-	0x03, 0x8b, 0x56, 0x88, 0xc5, 0x3e, 0xd7, 0x95, 0xdf, 0xbd, 0xde, 0x5d, 0xe5, 0xba, 0x15, 0xcb,
-	0xf2, 0x6d, 0x42, 0x34, 0xea, 0x3b, 0x5e, 0x47, 0x9d, 0x40, 0xd1, 0xb7, 0x90, 0x32, 0x5d, 0x3c,
-	0xf0, 0xa8, 0x9c, 0xdc, 0x4a, 0x6e, 0x67, 0x26, 0xfd, 0x07, 0x33, 0x29, 0xf1, 0x99, 0x94, 0xf6,
-	0xb1, 0xe3, 0x55, 0xc5, 0x37, 0xe3, 0xc2, 0x9c, 0xca, 0xe1, 0xc5, 0x3f, 0x45, 0x48, 0x37, 0x79,
-	0x7d, 0x94, 0x83, 0xc4, 0x55, 0x57, 0x09, 0xc7, 0x42, 0x5f, 0x41, 0xda, 0xb5, 0x09, 0x31, 0x3b,
-	0x36, 0x91, 0x13, 0x4c, 0x77, 0xb5, 0x14, 0xee, 0x7c, 0x29, 0xda, 0xf9, 0x52, 0xc5, 0x1b, 0xa9,
-	0x57, 0x28, 0xf4, 0x35, 0xa4, 0x08, 0x35, 0xe9, 0x80, 0xc8, 0x49, 0xb6, 0x8f, 0x9b, 0xb1, 0x7d,
-	0x8c, 0x4a, 0x69, 0x0c, 0xa4, 0x72, 0x30, 0x3a, 0x00, 0xf4, 0xc2, 0xf1, 0xcc, 0x9e, 0x41, 0xcd,
-	0x5e, 0x6f, 0x64, 0xf8, 0x36, 0x19, 0xf4, 0xa8, 0x2c, 0x6e, 0x09, 0xdb, 0x99, 0x47, 0x1b, 0x31,
-	0x09, 0x3d, 0x80, 0xa8, 0x0c, 0xa1, 0x4a, 0x8c, 0x35, 0x15, 0x41, 0x15, 0xc8, 0x90, 0x41, 0xdb,
-	0x75, 0xa8, 0x11, 0xd8, 0x49, 0x9e, 0xe7, 0x12, 0xf1, 0xae, 0xf5, 0xc8, 0x6b, 0x55, 0xf1, 0xd5,
-	0xbf, 0x05, 0x41, 0x85, 0x90, 0x14, 0x84, 0xd1, 0x21, 0x48, 0x7c, 0x63, 0x0d, 0xdb, 0xb3, 0x42,
-	0x9d, 0xd4, 0x1d, 0x75, 0x72, 0x9c, 0xa9, 0x78, 0x16, 0xd3, 0xaa, 0x41, 0x96, 0x62, 0x6a, 0xf6,
-	0x0c, 0x1e, 0x97, 0x17, 0xee, 0x36, 0x9e, 0x25, 0xc6, 0x8a, 0x6c, 0x73, 0x04, 0x1f, 0x0d, 0x31,
-	0x75, 0xbc, 0x8e, 0x41, 0xa8, 0xe9, 0xf3, 0xa5, 0xa5, 0xef, 0xd8, 0xd2, 0x72, 0x48, 0xd5, 0x02,
-	0x26, 0xeb, 0xe9, 0x00, 0x78, 0x68, 0xb2, 0xbc, 0xc5, 0x3b, 0x6a, 0x65, 0x43, 0x62, 0xb4, 0xba,
-	0x8d, 0xc0, 0x1f, 0xd4, 0xb4, 0x4c, 0x6a, 0xca, 0x10, 0x98, 0x55, 0xbd, 0x7a, 0x2e, 0xfe, 0x25,
-	0x40, 0x66, 0x7a, 0x30, 0x0f, 0x61, 0x71, 0x64, 0x13, 0xe3, 0x94, 0x99, 0x54, 0xb8, 0xf6, 0xc6,
-	0xd4, 0x3d, 0xaa, 0xa6, 0x47, 0x36, 0xd9, 0x0f, 0xf2, 0xe8, 0x31, 0x64, 0xcd, 0x36, 0xa1, 0xa6,
-	0xe3, 0x71, 0x42, 0xe2, 0x46, 0xc2, 0x12, 0x07, 0x85, 0xa4, 0x2f, 0x20, 0xed, 0x61, 0x8e, 0x4f,
-	0xde, 0x88, 0x5f, 0xf0, 0x70, 0x08, 0x7d, 0x02, 0xc8, 0xc3, 0xc6, 0x4b, 0x87, 0x76, 0x8d, 0xa1,
-	0x4d, 0x23, 0x92, 0x78, 0x23, 0x69, 0xd9, 0xc3, 0x27, 0x0e, 0xed, 0xb6, 0x6c, 0x1a, 0x92, 0x8b,
-	0xbf, 0x0b, 0x20, 0x06, 0xe7, 0xc1, 0xed, 0x6f, 0x73, 0x09, 0xe6, 0x87, 0x98, 0xda, 0xb7, 0xbf,
-	0xc9, 0x21, 0x0c, 0x3d, 0x81, 0x85, 0xf0, 0x70, 0x21, 0xb2, 0xc8, 0x7c, 0xf2, 0x20, 0xe6, 0xfd,
-	0xeb, 0x27, 0x97, 0x1a, 0x31, 0x66, 0x86, 0x31, 0x3f, 0x3b, 0x8c, 0x43, 0x31, 0x9d, 0x94, 0xc4,
-	0xe2, 0x3f, 0x02, 0x64, 0xb9, 0xa5, 0x9a, 0xa6, 0x6f, 0xba, 0x04, 0x3d, 0x87, 0x8c, 0xeb, 0x78,
-	0x57, 0xe6, 0x14, 0x6e, 0x33, 0xe7, 0x66, 0x60, 0xce, 0xcb, 0x71, 0xe1, 0xe3, 0x29, 0xd6, 0x97,
-	0xd8, 0x75, 0xa8, 0xed, 0xf6, 0xe9, 0x48, 0x05, 0xd7, 0xf1, 0x22, 0xcf, 0xba, 0x80, 0x5c, 0xf3,
-	0x2c, 0x02, 0x19, 0x7d, 0xdb, 0x77, 0xb0, 0xc5, 0x36, 0x22, 0xa8, 0x10, 0x37, 0x5a, 0x8d, 0x9f,
-	0xdf, 0xd5, 0x4f, 0x2f, 0xc7, 0x85, 0xfb, 0xd7, 0x89, 0x93, 0x22, 0xbf, 0x06, 0x3e, 0x94, 0x5c,
-	0xf3, 0x2c, 0x5a, 0x09, 0xcb, 0x17, 0x75, 0x58, 0x6a, 0x31, 0x6f, 0xf2, 0x95, 0xd5, 0x80, 0x7b,
-	0x35, 0xaa, 0x2c, 0xdc, 0x56, 0x59, 0x64, 0xca, 0x4b, 0x21, 0x8b, 0xab, 0xfe, 0x1f, 0x99, 0x98,
-	0xab, 0x7e, 0x07, 0xa9, 0x9f, 0x07, 0xd8, 0x1f, 0xb8, 0xdc, 0xc1, 0xc5, 0xcb, 0x71, 0x41, 0x0a,
-	0x23, 0x93, 0x0e, 0xe3, 0xdf, 0x81, 0x30, 0x8f, 0xf6, 0x61, 0x91, 0x76, 0x7d, 0x9b, 0x74, 0x71,
-	0xcf, 0xe2, 0x86, 0xf8, 0xec, 0x72, 0x5c, 0x58, 0xb9, 0x0a, 0x7e, 0x50, 0x61, 0xc2, 0x43, 0x3f,
-	0x40, 0x8e, 0x19, 0x76, 0xa2, 0x14, 0x3a, 0x7d, 0xe7, 0x72, 0x5c, 0x90, 0x67, 0x33, 0x1f, 0x94,
-	0xcb, 0x06, 0x38, 0x3d, 0x82, 0xed, 0xfc, 0x22, 0x00, 0x4c, 0x7d, 0x09, 0xef, 0xc1, 0x5a, 0xab,
-	0xa1, 0x2b, 0x46, 0xa3, 0xa9, 0xd7, 0x1b, 0xc7, 0xc6, 0xb3, 0x63, 0xad, 0xa9, 0xec, 0xd7, 0x9f,
-	0xd6, 0x95, 0x9a, 0x34, 0x87, 0x56, 0x60, 0x79, 0x3a, 0xf9, 0x5c, 0xd1, 0x24, 0x01, 0xad, 0xc1,
-	0xca, 0x74, 0xb0, 0x52, 0xd5, 0xf4, 0x4a, 0xfd, 0x58, 0x4a, 0x20, 0x04, 0xb9, 0xe9, 0xc4, 0x71,
-	0x43, 0x4a, 0xa2, 0xfb, 0x20, 0xcf, 0xc6, 0x8c, 0x93, 0xba, 0x7e, 0x60, 0xb4, 0x14, 0xbd, 0x21,
-	0x89, 0x3b, 0x7f, 0x08, 0x90, 0x9b, 0xfd, 0x44, 0xa0, 0x02, 0xdc, 0x6b, 0xaa, 0x8d, 0x66, 0x43,
-	0xab, 0x1c, 0x19, 0x9a, 0x5e, 0xd1, 0x9f, 0x69, 0xb1, 0x9e, 0x8a, 0x90, 0x8f, 0x03, 0x6a, 0x4a,
-	0xb3, 0xa1, 0xd5, 0x75, 0xa3, 0xa9, 0xa8, 0xf5, 0x46, 0x4d, 0x12, 0xd0, 0x03, 0xd8, 0x8c, 0x63,
-	0x5a, 0x0d, 0xbd, 0x7e, 0xfc, 0x7d, 0x04, 0x49, 0xa0, 0x0d, 0xf8, 0x24, 0x0e, 0x69, 0x56, 0x34,
-	0x4d, 0xa9, 0x85, 0x4d, 0xc7, 0x73, 0xaa, 0x72, 0xa8, 0xec, 0xeb, 0x4a, 0x4d, 0x12, 0x6f, 0x62,
-	0x3e, 0xad, 0xd4, 0x8f, 0x94, 0x9a, 0x34, 0x5f, 0x55, 0xde, 0x9c, 0xe7, 0x85, 0xb7, 0xe7, 0x79,
-	0xe1, 0xbf, 0xf3, 0xbc, 0xf0, 0xea, 0x22, 0x3f, 0xf7, 0xf6, 0x22, 0x3f, 0xf7, 0xf7, 0x45, 0x7e,
-	0xee, 0xc7, 0x87, 0x1d, 0x87, 0x76, 0x07, 0xed, 0xd2, 0x29, 0x76, 0xf9, 0x0f, 0x0a, 0xbf, 0xec,
-	0x12, 0xeb, 0xa7, 0xf2, 0x19, 0xfb, 0xe9, 0xa2, 0xa3, 0xbe, 0x4d, 0x82, 0x3f, 0xaa, 0x14, 0xb3,
-	0xeb, 0xe3, 0xf7, 0x01, 0x00, 0x00, 0xff, 0xff, 0x73, 0xa8, 0x09, 0x8c, 0x92, 0x09, 0x00, 0x00,
+	// 1791 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x58, 0x4b, 0x6f, 0xdb, 0xd8,
+	0x15, 0x36, 0x25, 0x59, 0x96, 0x8f, 0x1e, 0xe1, 0x5c, 0x3b, 0x89, 0xfc, 0x12, 0x1d, 0x4d, 0x3b,
+	0x93, 0xf1, 0x34, 0x52, 0x9d, 0xe9, 0xf4, 0x91, 0xe9, 0xa2, 0xb2, 0x44, 0xd7, 0x0a, 0x1c, 0x4b,
+	0xa5, 0x18, 0x1b, 0x99, 0x0d, 0x4b, 0x8b, 0x37, 0x32, 0x5b, 0x91, 0x57, 0x15, 0xaf, 0xe4, 0xb8,
+	0x40, 0x7f, 0x40, 0x0b, 0x14, 0x98, 0x5d, 0x07, 0xe8, 0xa6, 0x7f, 0xa0, 0xab, 0x0e, 0xba, 0xef,
+	0xa2, 0xc0, 0xa0, 0x8b, 0x62, 0x90, 0x55, 0x57, 0x6a, 0x91, 0x00, 0x5d, 0x68, 0xdd, 0x1f, 0x50,
+	0xf0, 0xf2, 0x52, 0x7c, 0x58, 0x8a, 0xbd, 0x92, 0x78, 0xce, 0xf7, 0x7d, 0xe7, 0x9c, 0xab, 0x73,
+	0xce, 0x95, 0x04, 0xf7, 0xbb, 0xc4, 0xb1, 0x88, 0x53, 0xed, 0x91, 0x71, 0x75, 0xbc, 0xef, 0xbe,
+	0x54, 0x06, 0x43, 0x42, 0x09, 0xca, 0x7b, 0x8e, 0x8a, 0x6b, 0x19, 0xef, 0x6f, 0x96, 0x38, 0xee,
+	0x5c, 0x77, 0x70, 0x75, 0xbc, 0x7f, 0x8e, 0xa9, 0xbe, 0x5f, 0xed, 0x12, 0xd3, 0xf6, 0xe0, 0x9b,
+	0xeb, 0x3d, 0xd2, 0x23, 0xec, 0x6d, 0xd5, 0x7d, 0xc7, 0xad, 0x52, 0x8f, 0x90, 0x5e, 0x1f, 0x57,
+	0xd9, 0xd3, 0xf9, 0xe8, 0x65, 0x95, 0x9a, 0x16, 0x76, 0xa8, 0x6e, 0x0d, 0x38, 0x60, 0x23, 0x0e,
+	0xd0, 0xed, 0x2b, 0xee, 0x2a, 0xc5, 0x5d, 0xc6, 0x68, 0xa8, 0x53, 0x93, 0xf8, 0x11, 0x37, 0xbc,
+	0x8c, 0x34, 0x2f, 0x28, 0xcf, 0x96, 0x3d, 0x94, 0xff, 0x20, 0xc0, 0x5a, 0x7b, 0x48, 0x06, 0xc4,
+	0xd1, 0xfb, 0xa7, 0x84, 0xe2, 0xd6, 0xc0, 0xe5, 0x39, 0x68, 0x07, 0x80, 0xb0, 0xb7, 0x1a, 0xb1,
+	0x71, 0x51, 0xd8, 0x15, 0x1e, 0xae, 0x2a, 0xab, 0x9e, 0xa5, 0x65, 0xe3, 0x90, 0x9b, 0x5e, 0x92,
+	0x62, 0x22, 0xec, 0x56, 0x2f, 0x09, 0x7a, 0x00, 0x39, 0xdf, 0x7d, 0x31, 0xc4, 0xb8, 0x98, 0x64,
+	0x80, 0x2c, 0x07, 0xb8, 0x26, 0x24, 0x01, 0x7f, 0xd4, 0x5e, 0x92, 0xd1, 0xb0, 0x98, 0x62, 0x08,
+	0x2e, 0x7a, 0x48, 0x46, 0xc3, 0xf2, 0x3f, 0x04, 0x40, 0x67, 0xd8, 0xec, 0x5d, 0x50, 0x6c, 0x04,
+	0x99, 0xa1, 0x7d, 0x48, 0x7b, 0x20, 0x96, 0x54, 0xe1, 0xf1, 0x46, 0x25, 0x72, 0xfa, 0x95, 0x00,
+	0xaa, 0x70, 0x20, 0xfa, 0x00, 0xd2, 0x97, 0x4c, 0xc8, 0x4b, 0xf4, 0xa0, 0xf0, 0xfa, 0xab, 0x47,
+	0xc0, 0x59, 0x0d, 0xdc, 0x55, 0xb8, 0x17, 0xbd, 0x80, 0x7b, 0xd6, 0xa8, 0x4f, 0xcd, 0x41, 0x1f,
+	0x6b, 0xdd, 0x0b, 0x62, 0x76, 0xb1, 0xc6, 0x43, 0x25, 0x59, 0xa8, 0xf7, 0x63, 0xa1, 0x9e, 0x71,
+	0x70, 0x9d, 0x61, 0x79, 0xd0, 0x75, 0x6b, 0x8e, 0xb5, 0xfc, 0x47, 0x01, 0x56, 0x1a, 0x78, 0x40,
+	0x1c, 0x93, 0xba, 0x95, 0x0f, 0xf8, 0x89, 0x6b, 0xa6, 0xc1, 0xca, 0x48, 0x29, 0xe0, 0x9b, 0x9a,
+	0x06, 0xfa, 0x3e, 0xac, 0x1a, 0x1e, 0x96, 0x0c, 0x79, 0xca, 0xc5, 0xd7, 0x5f, 0x3d, 0x5a, 0xe7,
+	0xd1, 0x6b, 0x86, 0x31, 0xc4, 0x8e, 0xd3, 0xa1, 0x43, 0xd3, 0xee, 0x29, 0x01, 0x14, 0xfd, 0x00,
+	0xd2, 0xba, 0x45, 0x46, 0x36, 0x2d, 0x26, 0x77, 0x93, 0x0f, 0xb3, 0xc1, 0xd1, 0xb8, 0x9d, 0x58,
+	0xe1, 0x9d, 0x58, 0xa9, 0x13, 0xd3, 0x3e, 0x48, 0x7d, 0x3d, 0x91, 0x96, 0x14, 0x0e, 0x2f, 0xff,
+	0x25, 0x0d, 0x19, 0xbf, 0x09, 0x50, 0x01, 0x12, 0xb3, 0xac, 0x12, 0xa6, 0x81, 0xbe, 0x0b, 0x19,
+	0x0b, 0x3b, 0x8e, 0xde, 0xc3, 0x4e, 0x31, 0xc1, 0x74, 0xd7, 0x2b, 0x5e, 0xbf, 0x55, 0xfc, 0x7e,
+	0xab, 0xd4, 0xec, 0x2b, 0x65, 0x86, 0x42, 0x9f, 0x42, 0xda, 0xa1, 0x3a, 0x1d, 0x39, 0xfc, 0xdc,
+	0x76, 0x62, 0xe7, 0xe6, 0x87, 0xea, 0x30, 0x90, 0xc2, 0xc1, 0xe8, 0x08, 0xd0, 0x4b, 0xd3, 0xd6,
+	0xfb, 0x1a, 0xd5, 0xfb, 0xfd, 0x2b, 0x6d, 0x88, 0x9d, 0x51, 0x9f, 0xb2, 0xc6, 0xc8, 0x3e, 0xde,
+	0x8c, 0x49, 0xa8, 0x2e, 0x44, 0x61, 0x08, 0x45, 0x64, 0xac, 0x90, 0x05, 0xd5, 0x20, 0xeb, 0x8c,
+	0xce, 0x2d, 0x93, 0x6a, 0xee, 0x10, 0x15, 0x97, 0xb9, 0x44, 0x3c, 0x6b, 0xd5, 0x9f, 0xb0, 0x83,
+	0xd4, 0x17, 0xff, 0x96, 0x04, 0x05, 0x3c, 0x92, 0x6b, 0x46, 0x4f, 0x41, 0xe4, 0x07, 0xab, 0x61,
+	0xdb, 0xf0, 0x74, 0xd2, 0xb7, 0xd4, 0x29, 0x70, 0xa6, 0x6c, 0x1b, 0x4c, 0xab, 0x01, 0x79, 0x4a,
+	0xa8, 0xde, 0xd7, 0xb8, 0xbd, 0xb8, 0x72, 0xbb, 0x8f, 0x27, 0xc7, 0x58, 0x7e, 0xdb, 0x1c, 0xc3,
+	0x7b, 0x63, 0x42, 0x4d, 0xbb, 0xa7, 0x39, 0x54, 0x1f, 0xf2, 0xd2, 0x32, 0xb7, 0x4c, 0xe9, 0x8e,
+	0x47, 0xed, 0xb8, 0x4c, 0x96, 0xd3, 0x11, 0x70, 0x53, 0x50, 0xde, 0xea, 0x2d, 0xb5, 0xf2, 0x1e,
+	0xd1, 0xaf, 0x6e, 0xd3, 0xed, 0x0f, 0xaa, 0x1b, 0x3a, 0xd5, 0x8b, 0xc0, 0xa6, 0x78, 0xf6, 0x8c,
+	0xb6, 0x61, 0x15, 0xbf, 0x1a, 0x60, 0xc3, 0xa4, 0xd8, 0x28, 0x66, 0x77, 0x85, 0x87, 0x19, 0x25,
+	0x30, 0xa0, 0xef, 0x41, 0xc6, 0xeb, 0x7a, 0x3c, 0x2c, 0xe6, 0x6e, 0x68, 0xf3, 0x19, 0x12, 0xfd,
+	0x04, 0xf2, 0xb3, 0xf1, 0xa1, 0x57, 0x03, 0x5c, 0xcc, 0xb3, 0x26, 0xdb, 0x5a, 0xd0, 0x64, 0xea,
+	0xd5, 0x00, 0x2b, 0xb9, 0x41, 0xe8, 0x09, 0xc9, 0x90, 0x1b, 0x13, 0xea, 0x0f, 0xb7, 0x53, 0x2c,
+	0xb0, 0xc2, 0xcb, 0x0b, 0x04, 0x42, 0x5b, 0x51, 0xc9, 0x8e, 0x83, 0x87, 0xf2, 0xdf, 0x93, 0x90,
+	0x0d, 0x77, 0xdd, 0xc7, 0xb0, 0x7a, 0x85, 0x1d, 0xad, 0xcb, 0x26, 0x50, 0xb8, 0xb6, 0x69, 0x9a,
+	0x36, 0x55, 0x32, 0x57, 0xd8, 0xa9, 0xbb, 0x7e, 0xf4, 0x09, 0xe4, 0xf5, 0x73, 0x87, 0xea, 0xa6,
+	0xcd, 0x09, 0x89, 0xb9, 0x84, 0x1c, 0x07, 0x79, 0xa4, 0x8f, 0x20, 0x63, 0x13, 0x8e, 0x4f, 0xce,
+	0xc5, 0xaf, 0xd8, 0xc4, 0x83, 0x7e, 0x06, 0xc8, 0x26, 0xda, 0xa5, 0x49, 0x2f, 0xb4, 0x31, 0xa6,
+	0x3e, 0x29, 0x35, 0x97, 0x74, 0xc7, 0x26, 0x67, 0x26, 0xbd, 0x38, 0xc5, 0x94, 0x93, 0x7f, 0x08,
+	0x62, 0xb0, 0xfc, 0x39, 0x75, 0x79, 0x2e, 0xb5, 0x30, 0xbb, 0x12, 0xe2, 0x4c, 0x7a, 0xe9, 0x07,
+	0x4d, 0xbf, 0x8b, 0xa9, 0x5e, 0xf2, 0x98, 0x3f, 0x06, 0x14, 0xbe, 0x32, 0x38, 0x77, 0x65, 0x2e,
+	0x57, 0x0c, 0x5d, 0x24, 0x1e, 0xfb, 0x09, 0xbc, 0x17, 0xba, 0x4d, 0x38, 0x39, 0x33, 0xbf, 0xda,
+	0xe0, 0x8e, 0x61, 0xdc, 0xf2, 0x5f, 0x05, 0x48, 0xb9, 0x1f, 0xf2, 0xcd, 0x8b, 0xb9, 0x02, 0xcb,
+	0x6e, 0x03, 0xdc, 0xbc, 0x94, 0x3d, 0x18, 0xfa, 0x0c, 0x56, 0xfc, 0x1e, 0x4b, 0xb1, 0x91, 0x7f,
+	0x10, 0xeb, 0xb1, 0xeb, 0xf7, 0x9b, 0xe2, 0x33, 0x22, 0x73, 0xb5, 0x1c, 0x9d, 0xab, 0xa7, 0xa9,
+	0x4c, 0x52, 0x4c, 0x95, 0xff, 0x2b, 0x40, 0xba, 0xad, 0x0f, 0x75, 0xcb, 0x41, 0x4d, 0xf0, 0x97,
+	0x8e, 0x36, 0x60, 0x16, 0x96, 0x7d, 0xf6, 0xf1, 0x76, 0x2c, 0x20, 0x5f, 0x26, 0x1e, 0x8b, 0xaf,
+	0x99, 0xbc, 0x11, 0x36, 0xa2, 0x43, 0xe0, 0x03, 0xee, 0x2b, 0x25, 0x98, 0xd2, 0xd6, 0xf5, 0x7b,
+	0xd6, 0xb4, 0x7b, 0x11, 0xa1, 0xdc, 0x38, 0x64, 0x43, 0x75, 0xc8, 0x79, 0x8b, 0x9c, 0xcb, 0x24,
+	0x17, 0x2f, 0xf2, 0x88, 0x4a, 0x96, 0x06, 0xa6, 0x27, 0xa9, 0x2f, 0xff, 0x24, 0x2d, 0x95, 0xff,
+	0xb7, 0x0c, 0xf9, 0x48, 0xe6, 0xe8, 0x05, 0x64, 0x2d, 0xd3, 0x9e, 0x2d, 0x54, 0xe1, 0xa6, 0x85,
+	0xba, 0xe3, 0x4a, 0x4f, 0x27, 0xd2, 0xdd, 0x10, 0xeb, 0x3b, 0xc4, 0x32, 0x29, 0xb6, 0x06, 0xf4,
+	0x4a, 0x01, 0xcb, 0xb4, 0xfd, 0x3d, 0x6b, 0x01, 0xb2, 0xf4, 0x57, 0xda, 0xec, 0x38, 0xf1, 0xd0,
+	0x24, 0x06, 0x3f, 0x84, 0x8d, 0x6b, 0xcb, 0xb1, 0xc1, 0xbf, 0x69, 0x1d, 0x7c, 0x6b, 0x3a, 0x91,
+	0xb6, 0xaf, 0x13, 0x83, 0x20, 0x5f, 0xba, 0xbb, 0x53, 0xb4, 0xf4, 0x57, 0x7e, 0x25, 0xcc, 0x8f,
+	0xc6, 0x70, 0x77, 0xb6, 0x11, 0xb5, 0x70, 0x4d, 0x37, 0xde, 0xe1, 0x1f, 0xf2, 0x9a, 0xa4, 0xb9,
+	0xfc, 0x50, 0x75, 0x6b, 0x33, 0xc0, 0xb3, 0xa0, 0x4c, 0x0c, 0x77, 0x67, 0xcd, 0xde, 0xd5, 0xed,
+	0x2e, 0xee, 0x6b, 0xac, 0x12, 0xbe, 0x23, 0xf6, 0x5d, 0xe1, 0xb9, 0x80, 0x40, 0x38, 0xf6, 0x35,
+	0x6a, 0xcd, 0x87, 0xd7, 0x19, 0x5a, 0x71, 0xc1, 0xa8, 0x0f, 0xeb, 0x71, 0x15, 0x03, 0x3b, 0xfe,
+	0x3a, 0x79, 0x32, 0x9d, 0x48, 0xa5, 0x79, 0xfe, 0x48, 0x90, 0xf9, 0x33, 0x86, 0xa2, 0xe1, 0x1a,
+	0xd8, 0xa1, 0x68, 0x00, 0x1b, 0xee, 0x11, 0x98, 0xb6, 0x49, 0xcd, 0xe0, 0xbe, 0xe5, 0x85, 0x79,
+	0x7b, 0xe8, 0xd3, 0xe9, 0x44, 0x7a, 0x7f, 0x21, 0x68, 0x61, 0x71, 0xf7, 0x2c, 0xd3, 0x6e, 0x7a,
+	0x0c, 0x7e, 0x82, 0x5e, 0x7d, 0x9f, 0xc3, 0x3d, 0xbd, 0xdf, 0x27, 0x97, 0xd8, 0x98, 0x09, 0x19,
+	0xd8, 0x26, 0x96, 0xc3, 0x2e, 0xf9, 0x55, 0xd6, 0x16, 0xbb, 0xf3, 0x11, 0xa1, 0x4f, 0x68, 0x9d,
+	0x23, 0xb8, 0x74, 0x83, 0xf9, 0xcb, 0x7f, 0x16, 0x20, 0x17, 0x1e, 0x33, 0xf7, 0x8b, 0x84, 0x3f,
+	0x9a, 0x5e, 0x57, 0x0a, 0x37, 0x75, 0x65, 0x8a, 0x75, 0x9d, 0x3f, 0x98, 0x5e, 0xc7, 0x9d, 0xc1,
+	0xfd, 0xa0, 0x63, 0xa2, 0x7a, 0x89, 0xdb, 0xe9, 0x05, 0x1d, 0x7b, 0x1a, 0x12, 0x2e, 0xbf, 0x4e,
+	0xf1, 0x0b, 0x91, 0xa7, 0xfb, 0x04, 0xd2, 0xbf, 0x1a, 0x91, 0xe1, 0xc8, 0xe2, 0xb7, 0x61, 0x79,
+	0x3a, 0x91, 0x44, 0xcf, 0xb2, 0xf0, 0x9c, 0x39, 0x03, 0xd5, 0x61, 0xd5, 0xbd, 0x07, 0x9c, 0x0b,
+	0xd2, 0x37, 0xf8, 0xba, 0xfd, 0xf6, 0x74, 0x22, 0xad, 0xcd, 0x8c, 0x0b, 0x15, 0x02, 0x1e, 0xfa,
+	0x19, 0x14, 0xd8, 0xe5, 0x17, 0x28, 0x79, 0xb7, 0xe6, 0xde, 0x74, 0x22, 0x15, 0xa3, 0x9e, 0x85,
+	0x72, 0x79, 0x17, 0xa7, 0xce, 0x24, 0x7f, 0x0e, 0xc1, 0x34, 0x85, 0x74, 0xbd, 0xa1, 0xa9, 0x4e,
+	0x27, 0xd2, 0xce, 0x1c, 0xf7, 0x42, 0x71, 0x34, 0x03, 0x07, 0x11, 0x7e, 0x03, 0x3b, 0xee, 0x15,
+	0x60, 0x99, 0x0e, 0x35, 0xbb, 0xda, 0x10, 0xff, 0x02, 0x77, 0xa3, 0xb1, 0xbc, 0xd1, 0xf9, 0xd1,
+	0x74, 0x22, 0x7d, 0xf8, 0x4e, 0xe0, 0xc2, 0xa8, 0x5b, 0x01, 0x4d, 0xe1, 0xac, 0x20, 0xfc, 0xef,
+	0x05, 0x90, 0x42, 0xb2, 0xfa, 0x88, 0x5e, 0x90, 0xa1, 0xf9, 0x6b, 0x6c, 0x68, 0xba, 0x37, 0x7e,
+	0xd8, 0x29, 0xa6, 0x59, 0x6b, 0xcb, 0xd3, 0x89, 0xf4, 0xd1, 0x0d, 0xd0, 0x5b, 0xcc, 0x71, 0xa8,
+	0xda, 0xda, 0x4c, 0xa1, 0xe6, 0x0b, 0xec, 0xfd, 0x56, 0x00, 0x08, 0xfd, 0xfc, 0xdb, 0x82, 0xfb,
+	0xa7, 0x2d, 0x55, 0xd6, 0x5a, 0x6d, 0xb5, 0xd9, 0x3a, 0xd1, 0x9e, 0x9f, 0x74, 0xda, 0x72, 0xbd,
+	0x79, 0xd8, 0x94, 0x1b, 0xe2, 0x12, 0x5a, 0x83, 0x3b, 0x61, 0xe7, 0x0b, 0xb9, 0x23, 0x0a, 0xe8,
+	0x3e, 0xac, 0x85, 0x8d, 0xb5, 0x83, 0x8e, 0x5a, 0x6b, 0x9e, 0x88, 0x09, 0x84, 0xa0, 0x10, 0x76,
+	0x9c, 0xb4, 0xc4, 0x24, 0xda, 0x86, 0x62, 0xd4, 0xa6, 0x9d, 0x35, 0xd5, 0x23, 0xed, 0x54, 0x56,
+	0x5b, 0x62, 0x6a, 0xef, 0x6f, 0x02, 0xac, 0xcf, 0xfb, 0xd1, 0x87, 0x3e, 0x80, 0xf2, 0xb3, 0xe7,
+	0xc7, 0x6a, 0xb3, 0x7d, 0x2c, 0x6b, 0xf5, 0xa3, 0x56, 0xb3, 0xbe, 0x20, 0xc1, 0x12, 0x6c, 0x2e,
+	0xc0, 0xb5, 0x4e, 0x64, 0x51, 0x78, 0x87, 0x5f, 0x3d, 0x6b, 0x89, 0x09, 0xb4, 0x0b, 0xdb, 0x8b,
+	0xfc, 0x47, 0x8a, 0x2c, 0x8b, 0x49, 0x24, 0xc1, 0xd6, 0x02, 0xc4, 0x61, 0xeb, 0xb9, 0x22, 0xa6,
+	0xf6, 0x7e, 0x27, 0x40, 0x2e, 0xfc, 0xdd, 0x18, 0xed, 0xc0, 0x46, 0x5b, 0x69, 0xb5, 0x5b, 0x9d,
+	0xda, 0xb1, 0xa6, 0xbe, 0x68, 0xcb, 0xb1, 0x94, 0x37, 0xe1, 0x5e, 0xd4, 0xdd, 0x51, 0x6b, 0x27,
+	0x8d, 0x9a, 0xd2, 0x10, 0x05, 0xf4, 0x00, 0x76, 0xa2, 0xbe, 0x58, 0x68, 0x31, 0xe1, 0x1e, 0x68,
+	0x14, 0xe2, 0x66, 0xf3, 0xac, 0xd9, 0x51, 0x9b, 0x75, 0x31, 0xb9, 0xf7, 0x4f, 0x01, 0x0a, 0xd1,
+	0x5f, 0x83, 0x6e, 0x01, 0x33, 0x42, 0x47, 0xad, 0xa9, 0xcf, 0x3b, 0xb1, 0x84, 0xca, 0x50, 0x8a,
+	0x03, 0x1a, 0x72, 0xbb, 0xd5, 0x69, 0xaa, 0x5a, 0x5b, 0x56, 0x9a, 0xad, 0x78, 0x62, 0x1c, 0x73,
+	0xda, 0x52, 0x9b, 0x27, 0x3f, 0xf5, 0x21, 0x89, 0x48, 0x5d, 0x1c, 0xd2, 0xae, 0x75, 0x3a, 0x72,
+	0xc3, 0xeb, 0x82, 0xb8, 0x4f, 0x91, 0x9f, 0xca, 0x75, 0x55, 0x6e, 0x88, 0xa9, 0x79, 0xcc, 0xc3,
+	0x5a, 0xf3, 0x58, 0x6e, 0x88, 0xcb, 0x07, 0xf2, 0xd7, 0x6f, 0x4a, 0xc2, 0x37, 0x6f, 0x4a, 0xc2,
+	0x7f, 0xde, 0x94, 0x84, 0x2f, 0xde, 0x96, 0x96, 0xbe, 0x79, 0x5b, 0x5a, 0xfa, 0xd7, 0xdb, 0xd2,
+	0xd2, 0xe7, 0x1f, 0xf7, 0x4c, 0x7a, 0x31, 0x3a, 0xaf, 0x74, 0x89, 0xc5, 0xff, 0x81, 0xe1, 0x2f,
+	0x8f, 0x1c, 0xe3, 0x97, 0xd5, 0x57, 0xec, 0x5f, 0x25, 0xf7, 0xe7, 0x8c, 0xe3, 0xfe, 0x65, 0x94,
+	0x66, 0x9b, 0xf7, 0x93, 0xff, 0x0f, 0x00, 0x64, 0x67, 0x80, 0x9c, 0x73, 0x12, 0x00, 0x00,
+}
+
+func (m *ProposalVoteOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalVoteOptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalVoteOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OptionFour) > 0 {
+		i -= len(m.OptionFour)
+		copy(dAtA[i:], m.OptionFour)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionFour)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OptionThree) > 0 {
+		i -= len(m.OptionThree)
+		copy(dAtA[i:], m.OptionThree)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionThree)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OptionTwo) > 0 {
+		i -= len(m.OptionTwo)
+		copy(dAtA[i:], m.OptionTwo)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionTwo)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OptionOne) > 0 {
+		i -= len(m.OptionOne)
+		copy(dAtA[i:], m.OptionOne)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionOne)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -772,6 +1259,11 @@ func (m *WeightedVoteOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MultipleChoiceOption != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.MultipleChoiceOption))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Weight) > 0 {
 		i -= len(m.Weight)
 		copy(dAtA[i:], m.Weight)
@@ -856,31 +1348,65 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
+	if m.VoteOptions != nil {
+		{
+			size, err := m.VoteOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x52
+		dAtA[i] = 0x72
 	}
-	if m.VotingEndTime != nil {
-		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VotingEndTime):])
-		if err1 != nil {
-			return 0, err1
-		}
-		i -= n1
-		i = encodeVarintGov(dAtA, i, uint64(n1))
+	if m.ProposalType != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalType))
 		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0x68
 	}
-	if m.VotingStartTime != nil {
-		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VotingStartTime):])
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Expedited {
+		i--
+		if m.Expedited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.VotingEndTime != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VotingEndTime):])
 		if err2 != nil {
 			return 0, err2
 		}
 		i -= n2
 		i = encodeVarintGov(dAtA, i, uint64(n2))
 		i--
+		dAtA[i] = 0x4a
+	}
+	if m.VotingStartTime != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VotingStartTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintGov(dAtA, i, uint64(n3))
+		i--
 		dAtA[i] = 0x42
 	}
 	if len(m.TotalDeposit) > 0 {
@@ -898,22 +1424,22 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if m.DepositEndTime != nil {
-		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.DepositEndTime):])
-		if err3 != nil {
-			return 0, err3
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.DepositEndTime):])
+		if err4 != nil {
+			return 0, err4
 		}
-		i -= n3
-		i = encodeVarintGov(dAtA, i, uint64(n3))
+		i -= n4
+		i = encodeVarintGov(dAtA, i, uint64(n4))
 		i--
 		dAtA[i] = 0x32
 	}
 	if m.SubmitTime != nil {
-		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.SubmitTime):])
-		if err4 != nil {
-			return 0, err4
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.SubmitTime):])
+		if err5 != nil {
+			return 0, err5
 		}
-		i -= n4
-		i = encodeVarintGov(dAtA, i, uint64(n4))
+		i -= n5
+		i = encodeVarintGov(dAtA, i, uint64(n5))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -976,6 +1502,34 @@ func (m *TallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OptionFourCount) > 0 {
+		i -= len(m.OptionFourCount)
+		copy(dAtA[i:], m.OptionFourCount)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionFourCount)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.OptionThreeCount) > 0 {
+		i -= len(m.OptionThreeCount)
+		copy(dAtA[i:], m.OptionThreeCount)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionThreeCount)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.OptionTwoCount) > 0 {
+		i -= len(m.OptionTwoCount)
+		copy(dAtA[i:], m.OptionTwoCount)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionTwoCount)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.OptionOneCount) > 0 {
+		i -= len(m.OptionOneCount)
+		copy(dAtA[i:], m.OptionOneCount)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionOneCount)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.NoWithVetoCount) > 0 {
 		i -= len(m.NoWithVetoCount)
 		copy(dAtA[i:], m.NoWithVetoCount)
@@ -1063,6 +1617,59 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TallyParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.VotingParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.DepositParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *DepositParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1083,13 +1690,57 @@ func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AllowedDepositDenoms) > 0 {
+		for iNdEx := len(m.AllowedDepositDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDepositDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDepositDenoms[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.AllowedDepositDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.MinInitialDepositRatio) > 0 {
+		i -= len(m.MinInitialDepositRatio)
+		copy(dAtA[i:], m.MinInitialDepositRatio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MinInitialDepositRatio)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ProposalCancelDest) > 0 {
+		i -= len(m.ProposalCancelDest)
+		copy(dAtA[i:], m.ProposalCancelDest)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ProposalCancelDest)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ProposalCancelRatio) > 0 {
+		i -= len(m.ProposalCancelRatio)
+		copy(dAtA[i:], m.ProposalCancelRatio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ProposalCancelRatio)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ExpeditedMinDeposit) > 0 {
+		for iNdEx := len(m.ExpeditedMinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExpeditedMinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.MaxDepositPeriod != nil {
-		n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err6 != nil {
-			return 0, err6
+		n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err10 != nil {
+			return 0, err10
 		}
-		i -= n6
-		i = encodeVarintGov(dAtA, i, uint64(n6))
+		i -= n10
+		i = encodeVarintGov(dAtA, i, uint64(n10))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1130,13 +1781,23 @@ func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpeditedVotingPeriod != nil {
+		n11, err11 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
+		if err11 != nil {
+			return 0, err11
+		}
+		i -= n11
+		i = encodeVarintGov(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.VotingPeriod != nil {
-		n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err7 != nil {
-			return 0, err7
+		n12, err12 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err12 != nil {
+			return 0, err12
 		}
-		i -= n7
-		i = encodeVarintGov(dAtA, i, uint64(n7))
+		i -= n12
+		i = encodeVarintGov(dAtA, i, uint64(n12))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -1163,6 +1824,29 @@ func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OptimisticAuthorizedAddresses) > 0 {
+		for iNdEx := len(m.OptimisticAuthorizedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OptimisticAuthorizedAddresses[iNdEx])
+			copy(dAtA[i:], m.OptimisticAuthorizedAddresses[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.OptimisticAuthorizedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.OptimisticRejectedThreshold) > 0 {
+		i -= len(m.OptimisticRejectedThreshold)
+		copy(dAtA[i:], m.OptimisticRejectedThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptimisticRejectedThreshold)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ExpeditedThreshold) > 0 {
+		i -= len(m.ExpeditedThreshold)
+		copy(dAtA[i:], m.ExpeditedThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ExpeditedThreshold)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.VetoThreshold) > 0 {
 		i -= len(m.VetoThreshold)
 		copy(dAtA[i:], m.VetoThreshold)
@@ -1198,6 +1882,31 @@ func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *ProposalVoteOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OptionOne)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.OptionTwo)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.OptionThree)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.OptionFour)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
 func (m *WeightedVoteOption) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1211,6 +1920,9 @@ func (m *WeightedVoteOption) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if m.MultipleChoiceOption != 0 {
+		n += 1 + sovGov(uint64(m.MultipleChoiceOption))
+	}
 	return n
 }
 
@@ -1284,6 +1996,20 @@ func (m *Proposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if m.Expedited {
+		n += 2
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.ProposalType != 0 {
+		n += 1 + sovGov(uint64(m.ProposalType))
+	}
+	if m.VoteOptions != nil {
+		l = m.VoteOptions.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1309,6 +2035,22 @@ func (m *TallyResult) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	l = len(m.OptionOneCount)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.OptionTwoCount)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.OptionThreeCount)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.OptionFourCount)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1338,6 +2080,21 @@ func (m *Vote) Size() (n int) {
 	return n
 }
 
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DepositParams.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.VotingParams.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.TallyParams.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
 func (m *DepositParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1354,6 +2111,30 @@ func (m *DepositParams) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxDepositPeriod)
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if len(m.ExpeditedMinDeposit) > 0 {
+		for _, e := range m.ExpeditedMinDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.ProposalCancelRatio)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ProposalCancelDest)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.MinInitialDepositRatio)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.AllowedDepositDenoms) > 0 {
+		for _, s := range m.AllowedDepositDenoms {
+			l = len(s)
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1367,6 +2148,10 @@ func (m *VotingParams) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.VotingPeriod)
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if m.ExpeditedVotingPeriod != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1388,6 +2173,20 @@ func (m *TallyParams) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	l = len(m.ExpeditedThreshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.OptimisticRejectedThreshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.OptimisticAuthorizedAddresses) > 0 {
+		for _, s := range m.OptimisticAuthorizedAddresses {
+			l = len(s)
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1397,7 +2196,7 @@ func sovGov(x uint64) (n int) {
 func sozGov(x uint64) (n int) {
 	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
+func (m *ProposalVoteOptions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1420,17 +2219,17 @@ func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WeightedVoteOption: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProposalVoteOptions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WeightedVoteOption: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProposalVoteOptions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionOne", wireType)
 			}
-			m.Option = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1440,14 +2239,27 @@ func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Option |= VoteOption(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionOne = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionTwo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1475,34 +2287,218 @@ func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Weight = string(dAtA[iNdEx:postIndex])
+			m.OptionTwo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionThree", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Deposit) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+			m.OptionThree = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionFour", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionFour = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedVoteOption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedVoteOption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultipleChoiceOption", wireType)
+			}
+			m.MultipleChoiceOption = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MultipleChoiceOption |= MultipleChoiceOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Deposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -1980,61 +2976,11 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGov
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TallyResult) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field YesCount", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expedited", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2044,27 +2990,15 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.YesCount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.Expedited = bool(v != 0)
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2092,13 +3026,13 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AbstainCount = string(dAtA[iNdEx:postIndex])
+			m.Proposer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoCount", wireType)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalType", wireType)
 			}
-			var stringLen uint64
+			m.ProposalType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2108,14 +3042,183 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProposalType |= ProposalType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGov
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VoteOptions == nil {
+				m.VoteOptions = &ProposalVoteOptions{}
+			}
+			if err := m.VoteOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TallyResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YesCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.YesCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AbstainCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
 			}
 			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
@@ -2128,9 +3231,605 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoWithVetoCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NoWithVetoCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NoWithVetoCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionOneCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionOneCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionTwoCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionTwoCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionThreeCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionThreeCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionFourCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionFourCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Vote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, &WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepositParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VotingParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TallyParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinDeposit = append(m.MinDeposit, types.Coin{})
+			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxDepositPeriod == nil {
+				m.MaxDepositPeriod = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.MaxDepositPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedMinDeposit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2140,79 +3839,31 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NoWithVetoCount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
+			m.ExpeditedMinDeposit = append(m.ExpeditedMinDeposit, types.Coin{})
+			if err := m.ExpeditedMinDeposit[len(m.ExpeditedMinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGov
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Vote) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelRatio", wireType)
 			}
-			m.ProposalId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2222,14 +3873,27 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalCancelRatio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelDest", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2257,13 +3921,13 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			m.ProposalCancelDest = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinInitialDepositRatio", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2273,29 +3937,27 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Options = append(m.Options, &WeightedVoteOption{})
-			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MinInitialDepositRatio = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDepositDenoms", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2323,7 +3985,7 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.AllowedDepositDenoms = append(m.AllowedDepositDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2346,7 +4008,7 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DepositParams) Unmarshal(dAtA []byte) error {
+func (m *VotingParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2369,15 +4031,15 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DepositParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: VotingParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DepositParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VotingParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2404,14 +4066,16 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinDeposit = append(m.MinDeposit, types.Coin{})
-			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.VotingPeriod == nil {
+				m.VotingPeriod = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedVotingPeriod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2438,10 +4102,10 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MaxDepositPeriod == nil {
-				m.MaxDepositPeriod = new(time.Duration)
+			if m.ExpeditedVotingPeriod == nil {
+				m.ExpeditedVotingPeriod = new(time.Duration)
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.MaxDepositPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ExpeditedVotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2466,7 +4130,7 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VotingParams) Unmarshal(dAtA []byte) error {
+func (m *TallyParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2489,17 +4153,17 @@ func (m *VotingParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VotingParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: TallyParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VotingParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TallyParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2509,81 +4173,91 @@ func (m *VotingParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VotingPeriod == nil {
-				m.VotingPeriod = new(time.Duration)
+			m.Quorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TallyParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TallyParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TallyParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedThreshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2611,11 +4285,11 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Quorum = string(dAtA[iNdEx:postIndex])
+			m.ExpeditedThreshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OptimisticRejectedThreshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2643,11 +4317,11 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Threshold = string(dAtA[iNdEx:postIndex])
+			m.OptimisticRejectedThreshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OptimisticAuthorizedAddresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2675,7 +4349,7 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
+			m.OptimisticAuthorizedAddresses = append(m.OptimisticAuthorizedAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
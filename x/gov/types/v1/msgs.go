@@ -12,8 +12,8 @@ import (
 )
 
 var (
-	_, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}
-	_, _          codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
+	_, _, _, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgCancelProposal{}
+	_, _                codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
 )
 
 // NewMsgSubmitProposal creates a new MsgSubmitProposal.
@@ -90,6 +90,28 @@ func (m MsgSubmitProposal) ValidateBasic() error {
 		}
 	}
 
+	if !ValidProposalType(m.ProposalType) {
+		return sdkerrors.Wrapf(types.ErrInvalidProposalType, "%d", m.ProposalType)
+	}
+
+	if m.Expedited && !m.ProposalType.IsStandard() {
+		return sdkerrors.Wrapf(types.ErrInvalidProposalType, "%s proposals cannot be expedited", m.ProposalType)
+	}
+
+	if m.ProposalType == ProposalTypeMultipleChoice {
+		if len(m.Messages) != 0 {
+			return sdkerrors.Wrap(types.ErrInvalidProposalType, "multiple-choice proposals cannot have messages")
+		}
+		if m.VoteOptions == nil {
+			return sdkerrors.Wrap(types.ErrInvalidProposalType, "multiple-choice proposals must have vote options")
+		}
+		if err := m.VoteOptions.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(types.ErrInvalidProposalType, err.Error())
+		}
+	} else if m.VoteOptions != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidProposalType, "%s proposals cannot have vote options", m.ProposalType)
+	}
+
 	return nil
 }
 
@@ -155,7 +177,15 @@ func (msg MsgDeposit) GetSigners() []sdk.AccAddress {
 //
 //nolint:interfacer
 func NewMsgVote(voter sdk.AccAddress, proposalID uint64, option VoteOption, metadata string) *MsgVote {
-	return &MsgVote{proposalID, voter.String(), option, metadata}
+	return &MsgVote{ProposalId: proposalID, Voter: voter.String(), Option: option, Metadata: metadata}
+}
+
+// NewMsgVoteMultipleChoice creates a message to vote for an option of an active
+// multiple-choice proposal
+//
+//nolint:interfacer
+func NewMsgVoteMultipleChoice(voter sdk.AccAddress, proposalID uint64, option MultipleChoiceOption, metadata string) *MsgVote {
+	return &MsgVote{ProposalId: proposalID, Voter: voter.String(), MultipleChoiceOption: option, Metadata: metadata}
 }
 
 // Route implements Msg
@@ -169,7 +199,11 @@ func (msg MsgVote) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Voter); err != nil {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", err)
 	}
-	if !ValidVoteOption(msg.Option) {
+	if msg.MultipleChoiceOption != MultipleChoiceOptionEmpty {
+		if msg.Option != OptionEmpty || !ValidMultipleChoiceOption(msg.MultipleChoiceOption) {
+			return sdkerrors.Wrap(types.ErrInvalidVote, msg.MultipleChoiceOption.String())
+		}
+	} else if !ValidVoteOption(msg.Option) {
 		return sdkerrors.Wrap(types.ErrInvalidVote, msg.Option.String())
 	}
 
@@ -212,6 +246,7 @@ func (msg MsgVoteWeighted) ValidateBasic() error {
 
 	totalWeight := sdk.NewDec(0)
 	usedOptions := make(map[VoteOption]bool)
+	usedMultipleChoiceOptions := make(map[MultipleChoiceOption]bool)
 	for _, option := range msg.Options {
 		if !option.IsValid() {
 			return sdkerrors.Wrap(types.ErrInvalidVote, option.String())
@@ -221,6 +256,13 @@ func (msg MsgVoteWeighted) ValidateBasic() error {
 			return sdkerrors.Wrapf(types.ErrInvalidVote, "Invalid weight: %s", err)
 		}
 		totalWeight = totalWeight.Add(weight)
+		if option.MultipleChoiceOption != MultipleChoiceOptionEmpty {
+			if usedMultipleChoiceOptions[option.MultipleChoiceOption] {
+				return sdkerrors.Wrap(types.ErrInvalidVote, "Duplicated vote option")
+			}
+			usedMultipleChoiceOptions[option.MultipleChoiceOption] = true
+			continue
+		}
 		if usedOptions[option.Option] {
 			return sdkerrors.Wrap(types.ErrInvalidVote, "Duplicated vote option")
 		}
@@ -276,3 +318,64 @@ func (m MsgExecLegacyContent) UnpackInterfaces(unpacker codectypes.AnyUnpacker)
 	var content v1beta1.Content
 	return unpacker.UnpackAny(m.Content, &content)
 }
+
+// Route implements Msg
+func (msg MsgUpdateParams) Route() string { return types.RouterKey }
+
+// Type implements Msg
+func (msg MsgUpdateParams) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements Msg
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return msg.Params.ValidateBasic()
+}
+
+// GetSignBytes implements Msg
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// NewMsgCancelProposal creates a message to cancel a proposal by its proposer
+//
+//nolint:interfacer
+func NewMsgCancelProposal(proposalID uint64, proposer sdk.AccAddress) *MsgCancelProposal {
+	return &MsgCancelProposal{ProposalId: proposalID, Proposer: proposer.String()}
+}
+
+// Route implements Msg
+func (msg MsgCancelProposal) Route() string { return types.RouterKey }
+
+// Type implements Msg
+func (msg MsgCancelProposal) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements Msg
+func (msg MsgCancelProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Proposer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid proposer address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements Msg
+func (msg MsgCancelProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgCancelProposal) GetSigners() []sdk.AccAddress {
+	proposer, _ := sdk.AccAddressFromBech32(msg.Proposer)
+	return []sdk.AccAddress{proposer}
+}
@@ -85,6 +85,19 @@ func TestMsgVote(t *testing.T) {
 	}
 }
 
+func TestMsgVoteMultipleChoice(t *testing.T) {
+	msg := v1.NewMsgVoteMultipleChoice(addrs[0], 1, v1.OptionTwo, "")
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, v1.OptionEmpty, msg.Option)
+	require.Contains(t, msg.String(), "MULTIPLE_CHOICE_OPTION_TWO")
+
+	require.Error(t, v1.NewMsgVoteMultipleChoice(addrs[0], 1, v1.MultipleChoiceOption(5), "").ValidateBasic())
+
+	// a vote sets either a vote option or a multiple-choice option
+	msg.Option = v1.OptionYes
+	require.Error(t, msg.ValidateBasic())
+}
+
 // test ValidateBasic for MsgVoteWeighted
 func TestMsgVoteWeighted(t *testing.T) {
 	metadata := "metadata"
@@ -119,6 +132,18 @@ func TestMsgVoteWeighted(t *testing.T) {
 		{0, addrs[0], v1.WeightedVoteOptions{ // weight sum <1
 			v1.NewWeightedVoteOption(v1.OptionYes, sdk.NewDecWithPrec(5, 1)),
 		}, "", false},
+		{0, addrs[0], v1.NewNonSplitMultipleChoiceOption(v1.OptionFour), "", true},
+		{0, addrs[0], v1.WeightedVoteOptions{ // split multiple-choice vote
+			v1.NewWeightedMultipleChoiceOption(v1.OptionOne, sdk.NewDecWithPrec(5, 1)),
+			v1.NewWeightedMultipleChoiceOption(v1.OptionTwo, sdk.NewDecWithPrec(5, 1)),
+		}, "", true},
+		{0, addrs[0], v1.WeightedVoteOptions{ // duplicate multiple-choice option
+			v1.NewWeightedMultipleChoiceOption(v1.OptionOne, sdk.NewDecWithPrec(5, 1)),
+			v1.NewWeightedMultipleChoiceOption(v1.OptionOne, sdk.NewDecWithPrec(5, 1)),
+		}, "", false},
+		{0, addrs[0], v1.WeightedVoteOptions{ // both a vote and a multiple-choice option
+			{Option: v1.OptionYes, MultipleChoiceOption: v1.OptionOne, Weight: sdk.NewDec(1).String()},
+		}, "", false},
 	}
 
 	for i, tc := range tests {
@@ -167,6 +192,49 @@ func TestMsgSubmitProposal_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgSubmitProposal_ProposalTypes(t *testing.T) {
+	msg1, err := v1.NewLegacyContent(v1beta1.NewTextProposal("Title", "description"), addrs[0].String())
+	require.NoError(t, err)
+
+	options, err := v1.NewProposalVoteOptions("red", "green", "blue")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		messages     []sdk.Msg
+		expedited    bool
+		proposalType v1.ProposalType
+		voteOptions  *v1.ProposalVoteOptions
+		expErr       bool
+	}{
+		{"unknown proposal type", nil, false, v1.ProposalType(42), nil, true},
+		{"expedited optimistic", []sdk.Msg{msg1}, true, v1.ProposalTypeOptimistic, nil, true},
+		{"expedited multiple-choice", nil, true, v1.ProposalTypeMultipleChoice, options, true},
+		{"multiple-choice with msgs", []sdk.Msg{msg1}, false, v1.ProposalTypeMultipleChoice, options, true},
+		{"multiple-choice without options", nil, false, v1.ProposalTypeMultipleChoice, nil, true},
+		{"multiple-choice with one option", nil, false, v1.ProposalTypeMultipleChoice, &v1.ProposalVoteOptions{OptionOne: "red"}, true},
+		{"multiple-choice with empty option", nil, false, v1.ProposalTypeMultipleChoice, &v1.ProposalVoteOptions{OptionOne: "red", OptionThree: "blue"}, true},
+		{"multiple-choice with duplicate options", nil, false, v1.ProposalTypeMultipleChoice, &v1.ProposalVoteOptions{OptionOne: "red", OptionTwo: "red"}, true},
+		{"standard with options", []sdk.Msg{msg1}, false, v1.ProposalTypeStandard, options, true},
+		{"valid multiple-choice", nil, false, v1.ProposalTypeMultipleChoice, options, false},
+		{"valid optimistic", []sdk.Msg{msg1}, false, v1.ProposalTypeOptimistic, nil, false},
+		{"valid standard", []sdk.Msg{msg1}, true, v1.ProposalTypeStandard, nil, false},
+	}
+
+	for _, tc := range tests {
+		msg, err := v1.NewMsgSubmitProposal(tc.messages, coinsPos, addrs[0].String(), "metadata")
+		require.NoError(t, err)
+		msg.Expedited = tc.expedited
+		msg.ProposalType = tc.proposalType
+		msg.VoteOptions = tc.voteOptions
+		if tc.expErr {
+			require.Error(t, msg.ValidateBasic(), "test: %s", tc.name)
+		} else {
+			require.NoError(t, msg.ValidateBasic(), "test: %s", tc.name)
+		}
+	}
+}
+
 // this tests that Amino JSON MsgSubmitProposal.GetSignBytes() still works with Content as Any using the ModuleCdc
 func TestMsgSubmitProposal_GetSignBytes(t *testing.T) {
 	proposal := []sdk.Msg{v1.NewMsgVote(addrs[0], 1, v1.OptionYes, "")}
@@ -179,3 +247,58 @@ func TestMsgSubmitProposal_GetSignBytes(t *testing.T) {
 	require.Equal(t, "{\"type\":\"cosmos-sdk/v1/MsgSubmitProposal\",\"value\":{\"initial_deposit\":[],\"messages\":[{\"type\":\"cosmos-sdk/v1/MsgVote\",\"value\":{\"option\":1,\"proposal_id\":\"1\",\"voter\":\"cosmos1w3jhxap3gempvr\"}}]}}",
 		string(bz))
 }
+
+func TestMsgUpdateParams(t *testing.T) {
+	invalidParams := v1.DefaultParams()
+	invalidParams.TallyParams.Quorum = "-1"
+
+	tests := []struct {
+		name      string
+		authority string
+		params    v1.Params
+		expErr    bool
+	}{
+		{"valid", addrs[0].String(), v1.DefaultParams(), false},
+		{"invalid authority", "", v1.DefaultParams(), true},
+		{"invalid params", addrs[0].String(), invalidParams, true},
+	}
+
+	for _, tc := range tests {
+		msg := v1.MsgUpdateParams{Authority: tc.authority, Params: tc.params}
+		if tc.expErr {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+// test ValidateBasic for MsgCancelProposal
+func TestMsgCancelProposal(t *testing.T) {
+	tests := []struct {
+		proposalID uint64
+		proposer   sdk.AccAddress
+		expectPass bool
+	}{
+		{0, addrs[0], true},
+		{1, sdk.AccAddress{}, false},
+	}
+
+	for i, tc := range tests {
+		msg := v1.NewMsgCancelProposal(tc.proposalID, tc.proposer)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgCancelProposalGetSignBytes(t *testing.T) {
+	addr := sdk.AccAddress("addr1")
+	msg := v1.NewMsgCancelProposal(1, addr)
+	res := msg.GetSignBytes()
+
+	expected := `{"type":"cosmos-sdk/v1/MsgCancelProposal","value":{"proposal_id":"1","proposer":"cosmos1v9jxgu33kfsgr5"}}`
+	require.Equal(t, expected, string(res))
+}
@@ -11,15 +11,21 @@ import (
 
 // Default period for deposits & voting
 const (
-	DefaultPeriod time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultPeriod          time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultExpeditedPeriod time.Duration = time.Hour * 24     // 1 day
 )
 
 // Default governance params
 var (
-	DefaultMinDepositTokens = sdk.NewInt(10000000)
-	DefaultQuorum           = sdk.NewDecWithPrec(334, 3)
-	DefaultThreshold        = sdk.NewDecWithPrec(5, 1)
-	DefaultVetoThreshold    = sdk.NewDecWithPrec(334, 3)
+	DefaultMinDepositTokens            = sdk.NewInt(10000000)
+	DefaultMinExpeditedDepositTokens   = DefaultMinDepositTokens.MulRaw(5)
+	DefaultQuorum                      = sdk.NewDecWithPrec(334, 3)
+	DefaultThreshold                   = sdk.NewDecWithPrec(5, 1)
+	DefaultExpeditedThreshold          = sdk.NewDecWithPrec(667, 3)
+	DefaultVetoThreshold               = sdk.NewDecWithPrec(334, 3)
+	DefaultProposalCancelRatio         = sdk.NewDecWithPrec(5, 1)
+	DefaultMinInitialDepositRatio      = sdk.ZeroDec()
+	DefaultOptimisticRejectedThreshold = sdk.NewDecWithPrec(1, 1)
 )
 
 // Parameter store key
@@ -39,10 +45,19 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewDepositParams creates a new DepositParams object
-func NewDepositParams(minDeposit sdk.Coins, maxDepositPeriod time.Duration) DepositParams {
+func NewDepositParams(
+	minDeposit sdk.Coins, maxDepositPeriod time.Duration, expeditedMinDeposit sdk.Coins,
+	proposalCancelRatio sdk.Dec, proposalCancelDest string,
+	minInitialDepositRatio sdk.Dec, allowedDepositDenoms []string,
+) DepositParams {
 	return DepositParams{
-		MinDeposit:       minDeposit,
-		MaxDepositPeriod: &maxDepositPeriod,
+		MinDeposit:             minDeposit,
+		MaxDepositPeriod:       &maxDepositPeriod,
+		ExpeditedMinDeposit:    expeditedMinDeposit,
+		ProposalCancelRatio:    proposalCancelRatio.String(),
+		ProposalCancelDest:     proposalCancelDest,
+		MinInitialDepositRatio: minInitialDepositRatio.String(),
+		AllowedDepositDenoms:   allowedDepositDenoms,
 	}
 }
 
@@ -51,12 +66,73 @@ func DefaultDepositParams() DepositParams {
 	return NewDepositParams(
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens)),
 		DefaultPeriod,
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinExpeditedDepositTokens)),
+		DefaultProposalCancelRatio,
+		"",
+		DefaultMinInitialDepositRatio,
+		nil,
 	)
 }
 
 // Equal checks equality of DepositParams
 func (dp DepositParams) Equal(dp2 DepositParams) bool {
-	return sdk.Coins(dp.MinDeposit).IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod
+	return sdk.Coins(dp.MinDeposit).IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod &&
+		sdk.Coins(dp.ExpeditedMinDeposit).IsEqual(dp2.ExpeditedMinDeposit) &&
+		dp.ProposalCancelRatio == dp2.ProposalCancelRatio && dp.ProposalCancelDest == dp2.ProposalCancelDest &&
+		dp.MinInitialDepositRatio == dp2.MinInitialDepositRatio && equalStrings(dp.AllowedDepositDenoms, dp2.AllowedDepositDenoms)
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// MinDepositFor returns the minimum deposit required for a proposal to enter
+// its voting period, depending on whether the proposal is expedited.
+func (dp DepositParams) MinDepositFor(expedited bool) sdk.Coins {
+	if expedited {
+		return dp.ExpeditedMinDeposit
+	}
+	return dp.MinDeposit
+}
+
+// MinInitialDepositFor returns the minimum deposit a proposal must be submitted
+// with, depending on whether the proposal is expedited. It is the minimum
+// deposit multiplied by the minimum initial deposit ratio, rounded down.
+func (dp DepositParams) MinInitialDepositFor(expedited bool) sdk.Coins {
+	ratio, err := sdk.NewDecFromStr(dp.MinInitialDepositRatio)
+	if err != nil || !ratio.IsPositive() {
+		return sdk.NewCoins()
+	}
+
+	minInitialDeposit := sdk.NewCoins()
+	for _, coin := range dp.MinDepositFor(expedited) {
+		amount := sdk.NewDecFromInt(coin.Amount).Mul(ratio).TruncateInt()
+		minInitialDeposit = minInitialDeposit.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+
+	return minInitialDeposit
+}
+
+// IsAllowedDepositDenom returns true if deposits of the given denom are
+// accepted.
+func (dp DepositParams) IsAllowedDepositDenom(denom string) bool {
+	if len(dp.AllowedDepositDenoms) == 0 {
+		return true
+	}
+	for _, allowed := range dp.AllowedDepositDenoms {
+		if allowed == denom {
+			return true
+		}
+	}
+	return false
 }
 
 func validateDepositParams(i interface{}) error {
@@ -71,27 +147,105 @@ func validateDepositParams(i interface{}) error {
 	if v.MaxDepositPeriod == nil || v.MaxDepositPeriod.Seconds() <= 0 {
 		return fmt.Errorf("maximum deposit period must be positive: %d", v.MaxDepositPeriod)
 	}
+	if !sdk.Coins(v.ExpeditedMinDeposit).IsValid() {
+		return fmt.Errorf("invalid expedited minimum deposit: %s", v.ExpeditedMinDeposit)
+	}
+	if !sdk.Coins(v.ExpeditedMinDeposit).IsAllGT(v.MinDeposit) {
+		return fmt.Errorf("expedited minimum deposit must be greater than the minimum deposit: %s <= %s",
+			sdk.Coins(v.ExpeditedMinDeposit), sdk.Coins(v.MinDeposit))
+	}
+
+	proposalCancelRatio, err := sdk.NewDecFromStr(v.ProposalCancelRatio)
+	if err != nil {
+		return fmt.Errorf("invalid proposal cancel ratio: %w", err)
+	}
+	if proposalCancelRatio.IsNegative() {
+		return fmt.Errorf("proposal cancel ratio must be positive: %s", proposalCancelRatio)
+	}
+	if proposalCancelRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("proposal cancel ratio too large: %s", proposalCancelRatio)
+	}
+	if v.ProposalCancelDest != "" {
+		if _, err := sdk.AccAddressFromBech32(v.ProposalCancelDest); err != nil {
+			return fmt.Errorf("invalid proposal cancel destination address: %w", err)
+		}
+	}
+
+	minInitialDepositRatio, err := sdk.NewDecFromStr(v.MinInitialDepositRatio)
+	if err != nil {
+		return fmt.Errorf("invalid minimum initial deposit ratio: %w", err)
+	}
+	if minInitialDepositRatio.IsNegative() {
+		return fmt.Errorf("minimum initial deposit ratio must be positive: %s", minInitialDepositRatio)
+	}
+	if minInitialDepositRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("minimum initial deposit ratio too large: %s", minInitialDepositRatio)
+	}
+
+	seenDenoms := make(map[string]bool, len(v.AllowedDepositDenoms))
+	for _, denom := range v.AllowedDepositDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid allowed deposit denom: %w", err)
+		}
+		if seenDenoms[denom] {
+			return fmt.Errorf("duplicate allowed deposit denom: %s", denom)
+		}
+		seenDenoms[denom] = true
+	}
+	for _, coin := range append(sdk.NewCoins(v.MinDeposit...), v.ExpeditedMinDeposit...) {
+		if !v.IsAllowedDepositDenom(coin.Denom) {
+			return fmt.Errorf("minimum deposit denom is not an allowed deposit denom: %s", coin.Denom)
+		}
+	}
 
 	return nil
 }
 
 // NewTallyParams creates a new TallyParams object
-func NewTallyParams(quorum, threshold, vetoThreshold sdk.Dec) TallyParams {
+func NewTallyParams(
+	quorum, threshold, vetoThreshold, expeditedThreshold, optimisticRejectedThreshold sdk.Dec,
+	optimisticAuthorizedAddresses []string,
+) TallyParams {
 	return TallyParams{
-		Quorum:        quorum.String(),
-		Threshold:     threshold.String(),
-		VetoThreshold: vetoThreshold.String(),
+		Quorum:                        quorum.String(),
+		Threshold:                     threshold.String(),
+		VetoThreshold:                 vetoThreshold.String(),
+		ExpeditedThreshold:            expeditedThreshold.String(),
+		OptimisticRejectedThreshold:   optimisticRejectedThreshold.String(),
+		OptimisticAuthorizedAddresses: optimisticAuthorizedAddresses,
 	}
 }
 
 // DefaultTallyParams default parameters for tallying
 func DefaultTallyParams() TallyParams {
-	return NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVetoThreshold)
+	return NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVetoThreshold, DefaultExpeditedThreshold, DefaultOptimisticRejectedThreshold, nil)
 }
 
 // Equal checks equality of TallyParams
 func (tp TallyParams) Equal(other TallyParams) bool {
-	return tp.Quorum == other.Quorum && tp.Threshold == other.Threshold && tp.VetoThreshold == other.VetoThreshold
+	return tp.Quorum == other.Quorum && tp.Threshold == other.Threshold && tp.VetoThreshold == other.VetoThreshold &&
+		tp.ExpeditedThreshold == other.ExpeditedThreshold && tp.OptimisticRejectedThreshold == other.OptimisticRejectedThreshold &&
+		equalStrings(tp.OptimisticAuthorizedAddresses, other.OptimisticAuthorizedAddresses)
+}
+
+// IsOptimisticAuthorized returns true if the given address is allowed to
+// submit optimistic proposals.
+func (tp TallyParams) IsOptimisticAuthorized(addr string) bool {
+	for _, authorized := range tp.OptimisticAuthorizedAddresses {
+		if authorized == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// ThresholdFor returns the minimum proportion of Yes votes for a proposal to
+// pass, depending on whether the proposal is expedited.
+func (tp TallyParams) ThresholdFor(expedited bool) string {
+	if expedited {
+		return tp.ExpeditedThreshold
+	}
+	return tp.Threshold
 }
 
 func validateTallyParams(i interface{}) error {
@@ -122,6 +276,17 @@ func validateTallyParams(i interface{}) error {
 		return fmt.Errorf("vote threshold too large: %s", v)
 	}
 
+	expeditedThreshold, err := sdk.NewDecFromStr(v.ExpeditedThreshold)
+	if err != nil {
+		return fmt.Errorf("invalid expedited threshold string: %w", err)
+	}
+	if expeditedThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("expedited vote threshold too large: %s", v)
+	}
+	if expeditedThreshold.LTE(threshold) {
+		return fmt.Errorf("expedited vote threshold must be greater than the vote threshold: %s <= %s", expeditedThreshold, threshold)
+	}
+
 	vetoThreshold, err := sdk.NewDecFromStr(v.VetoThreshold)
 	if err != nil {
 		return fmt.Errorf("invalid vetoThreshold string: %w", err)
@@ -133,24 +298,56 @@ func validateTallyParams(i interface{}) error {
 		return fmt.Errorf("veto threshold too large: %s", v)
 	}
 
+	optimisticRejectedThreshold, err := sdk.NewDecFromStr(v.OptimisticRejectedThreshold)
+	if err != nil {
+		return fmt.Errorf("invalid optimistic rejected threshold string: %w", err)
+	}
+	if !optimisticRejectedThreshold.IsPositive() {
+		return fmt.Errorf("optimistic rejected threshold must be positive: %s", optimisticRejectedThreshold)
+	}
+	if optimisticRejectedThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("optimistic rejected threshold too large: %s", optimisticRejectedThreshold)
+	}
+
+	seenAddrs := make(map[string]bool, len(v.OptimisticAuthorizedAddresses))
+	for _, addr := range v.OptimisticAuthorizedAddresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid optimistic authorized address: %w", err)
+		}
+		if seenAddrs[addr] {
+			return fmt.Errorf("duplicate optimistic authorized address: %s", addr)
+		}
+		seenAddrs[addr] = true
+	}
+
 	return nil
 }
 
 // NewVotingParams creates a new VotingParams object
-func NewVotingParams(votingPeriod time.Duration) VotingParams {
+func NewVotingParams(votingPeriod, expeditedVotingPeriod time.Duration) VotingParams {
 	return VotingParams{
-		VotingPeriod: &votingPeriod,
+		VotingPeriod:          &votingPeriod,
+		ExpeditedVotingPeriod: &expeditedVotingPeriod,
 	}
 }
 
 // DefaultVotingParams default parameters for voting
 func DefaultVotingParams() VotingParams {
-	return NewVotingParams(DefaultPeriod)
+	return NewVotingParams(DefaultPeriod, DefaultExpeditedPeriod)
 }
 
 // Equal checks equality of TallyParams
 func (vp VotingParams) Equal(other VotingParams) bool {
-	return vp.VotingPeriod == other.VotingPeriod
+	return vp.VotingPeriod == other.VotingPeriod && vp.ExpeditedVotingPeriod == other.ExpeditedVotingPeriod
+}
+
+// VotingPeriodFor returns the length of the voting period of a proposal,
+// depending on whether the proposal is expedited.
+func (vp VotingParams) VotingPeriodFor(expedited bool) *time.Duration {
+	if expedited {
+		return vp.ExpeditedVotingPeriod
+	}
+	return vp.VotingPeriod
 }
 
 func validateVotingParams(i interface{}) error {
@@ -167,14 +364,19 @@ func validateVotingParams(i interface{}) error {
 		return fmt.Errorf("voting period must be positive: %s", v.VotingPeriod)
 	}
 
-	return nil
-}
+	if v.ExpeditedVotingPeriod == nil {
+		return errors.New("expedited voting period must not be nil")
+	}
+
+	if v.ExpeditedVotingPeriod.Seconds() <= 0 {
+		return fmt.Errorf("expedited voting period must be positive: %s", v.ExpeditedVotingPeriod)
+	}
+
+	if *v.ExpeditedVotingPeriod >= *v.VotingPeriod {
+		return fmt.Errorf("expedited voting period must be strictly less than the voting period: %s >= %s", v.ExpeditedVotingPeriod, v.VotingPeriod)
+	}
 
-// Params returns all of the governance params
-type Params struct {
-	VotingParams  VotingParams  `json:"voting_params" yaml:"voting_params"`
-	TallyParams   TallyParams   `json:"tally_params" yaml:"tally_params"`
-	DepositParams DepositParams `json:"deposit_params" yaml:"deposit_params"`
+	return nil
 }
 
 func (gp Params) String() string {
@@ -195,3 +397,14 @@ func NewParams(vp VotingParams, tp TallyParams, dp DepositParams) Params {
 func DefaultParams() Params {
 	return NewParams(DefaultVotingParams(), DefaultTallyParams(), DefaultDepositParams())
 }
+
+// ValidateBasic performs basic validation on governance parameters.
+func (gp Params) ValidateBasic() error {
+	if err := validateDepositParams(gp.DepositParams); err != nil {
+		return err
+	}
+	if err := validateVotingParams(gp.VotingParams); err != nil {
+		return err
+	}
+	return validateTallyParams(gp.TallyParams)
+}
@@ -20,10 +20,15 @@ const (
 	StatusPassed        = ProposalStatus_PROPOSAL_STATUS_PASSED
 	StatusRejected      = ProposalStatus_PROPOSAL_STATUS_REJECTED
 	StatusFailed        = ProposalStatus_PROPOSAL_STATUS_FAILED
+
+	ProposalTypeUnspecified    = ProposalType_PROPOSAL_TYPE_UNSPECIFIED
+	ProposalTypeStandard       = ProposalType_PROPOSAL_TYPE_STANDARD
+	ProposalTypeMultipleChoice = ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE
+	ProposalTypeOptimistic     = ProposalType_PROPOSAL_TYPE_OPTIMISTIC
 )
 
 // NewProposal creates a new Proposal instance
-func NewProposal(messages []sdk.Msg, id uint64, metadata string, submitTime, depositEndTime time.Time) (Proposal, error) {
+func NewProposal(messages []sdk.Msg, id uint64, metadata string, submitTime, depositEndTime time.Time, proposer sdk.AccAddress, expedited bool) (Proposal, error) {
 	msgs, err := sdktx.SetMsgs(messages)
 	if err != nil {
 		return Proposal{}, err
@@ -39,6 +44,8 @@ func NewProposal(messages []sdk.Msg, id uint64, metadata string, submitTime, dep
 		FinalTallyResult: &tally,
 		SubmitTime:       &submitTime,
 		DepositEndTime:   &depositEndTime,
+		Expedited:        expedited,
+		Proposer:         proposer.String(),
 	}
 
 	return p, nil
@@ -117,6 +124,77 @@ func (status ProposalStatus) Format(s fmt.State, verb rune) {
 	}
 }
 
+// ValidProposalType returns true if the proposal type is valid and false
+// otherwise. An unspecified proposal type is handled as a standard proposal.
+func ValidProposalType(proposalType ProposalType) bool {
+	_, ok := ProposalType_name[int32(proposalType)]
+	return ok
+}
+
+// IsStandard returns true if the proposal type is standard or unspecified.
+func (proposalType ProposalType) IsStandard() bool {
+	return proposalType == ProposalTypeUnspecified || proposalType == ProposalTypeStandard
+}
+
+// NewProposalVoteOptions creates the vote options of a multiple-choice
+// proposal from up to four option names.
+func NewProposalVoteOptions(names ...string) (*ProposalVoteOptions, error) {
+	if len(names) > 4 {
+		return nil, fmt.Errorf("a multiple-choice proposal can have at most 4 options, got %d", len(names))
+	}
+
+	names = append(names, make([]string, 4-len(names))...)
+	options := &ProposalVoteOptions{
+		OptionOne:   names[0],
+		OptionTwo:   names[1],
+		OptionThree: names[2],
+		OptionFour:  names[3],
+	}
+
+	return options, options.ValidateBasic()
+}
+
+// Names returns the names of the options, in order.
+func (o ProposalVoteOptions) Names() []string {
+	names := []string{o.OptionOne, o.OptionTwo, o.OptionThree, o.OptionFour}
+	for len(names) > 0 && names[len(names)-1] == "" {
+		names = names[:len(names)-1]
+	}
+	return names
+}
+
+// Name returns the name of the given multiple-choice option, or an empty
+// string if the proposal has no such option.
+func (o ProposalVoteOptions) Name(option MultipleChoiceOption) string {
+	names := o.Names()
+	if option < OptionOne || int(option) > len(names) {
+		return ""
+	}
+	return names[option-OptionOne]
+}
+
+// ValidateBasic checks that the multiple-choice proposal has at least two
+// options and that no option is left empty before the last one.
+func (o ProposalVoteOptions) ValidateBasic() error {
+	names := o.Names()
+	if len(names) < 2 {
+		return fmt.Errorf("a multiple-choice proposal must have at least 2 options")
+	}
+
+	seen := make(map[string]bool, len(names))
+	for i, name := range names {
+		if strings.TrimSpace(name) == "" {
+			return fmt.Errorf("option %d of the multiple-choice proposal is empty", i+1)
+		}
+		if seen[name] {
+			return fmt.Errorf("duplicate multiple-choice proposal option: %s", name)
+		}
+		seen[name] = true
+	}
+
+	return nil
+}
+
 // ValidProposalStatus returns true if the proposal status is valid and false
 // otherwise.
 func ValidProposalStatus(status ProposalStatus) bool {
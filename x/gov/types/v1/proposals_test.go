@@ -37,8 +37,55 @@ func TestNestedAnys(t *testing.T) {
 	testProposal := v1beta1.NewTextProposal("Proposal", "testing proposal")
 	msgContent, err := v1.NewLegacyContent(testProposal, "cosmos1govacct")
 	require.NoError(t, err)
-	proposal, err := v1.NewProposal([]sdk.Msg{msgContent}, 1, "", time.Now(), time.Now())
+	proposal, err := v1.NewProposal([]sdk.Msg{msgContent}, 1, "", time.Now(), time.Now(), sdk.AccAddress("proposer____________"), false)
 	require.NoError(t, err)
 
 	require.Equal(t, "TODO Fix panic here", proposal.String())
 }
+
+func TestProposalVoteOptions(t *testing.T) {
+	options, err := v1.NewProposalVoteOptions("red", "green", "blue")
+	require.NoError(t, err)
+	require.NoError(t, options.ValidateBasic())
+	require.Equal(t, []string{"red", "green", "blue"}, options.Names())
+	require.Equal(t, "green", options.Name(v1.OptionTwo))
+	require.Equal(t, "", options.Name(v1.OptionFour))
+	require.Equal(t, "", options.Name(v1.MultipleChoiceOptionEmpty))
+
+	_, err = v1.NewProposalVoteOptions("a", "b", "c", "d", "e")
+	require.Error(t, err)
+}
+
+func multipleChoiceTallyResult(one, two, three, four int64) v1.TallyResult {
+	return v1.EmptyTallyResult().WithMultipleChoiceResults(map[v1.MultipleChoiceOption]sdk.Dec{
+		v1.OptionOne:   sdk.NewDec(one),
+		v1.OptionTwo:   sdk.NewDec(two),
+		v1.OptionThree: sdk.NewDec(three),
+		v1.OptionFour:  sdk.NewDec(four),
+	})
+}
+
+func TestTallyResultPluralityOption(t *testing.T) {
+	testCases := []struct {
+		name      string
+		tally     v1.TallyResult
+		expOption v1.MultipleChoiceOption
+		expOk     bool
+	}{
+		{"no votes", multipleChoiceTallyResult(0, 0, 0, 0), v1.MultipleChoiceOptionEmpty, false},
+		{"single winner", multipleChoiceTallyResult(1, 5, 3, 0), v1.OptionTwo, true},
+		{"last option wins", multipleChoiceTallyResult(1, 1, 1, 2), v1.OptionFour, true},
+		{"tie", multipleChoiceTallyResult(4, 0, 4, 1), v1.OptionOne, false},
+		{"standard votes", v1.NewTallyResult(sdk.NewInt(1), sdk.NewInt(5), sdk.NewInt(3), sdk.ZeroInt()), v1.MultipleChoiceOptionEmpty, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			option, ok := tc.tally.PluralityOption()
+			require.Equal(t, tc.expOk, ok)
+			if ok {
+				require.Equal(t, tc.expOption, option)
+			}
+		})
+	}
+}
@@ -249,6 +249,108 @@ func (m *QueryProposalsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryProposalsBelowMinDepositRequest is the request type for the
+// Query/ProposalsBelowMinDeposit RPC method.
+type QueryProposalsBelowMinDepositRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProposalsBelowMinDepositRequest) Reset()         { *m = QueryProposalsBelowMinDepositRequest{} }
+func (m *QueryProposalsBelowMinDepositRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalsBelowMinDepositRequest) ProtoMessage()    {}
+func (*QueryProposalsBelowMinDepositRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{4}
+}
+func (m *QueryProposalsBelowMinDepositRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalsBelowMinDepositRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalsBelowMinDepositRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalsBelowMinDepositRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalsBelowMinDepositRequest.Merge(m, src)
+}
+func (m *QueryProposalsBelowMinDepositRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalsBelowMinDepositRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalsBelowMinDepositRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalsBelowMinDepositRequest proto.InternalMessageInfo
+
+func (m *QueryProposalsBelowMinDepositRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryProposalsBelowMinDepositResponse is the response type for the
+// Query/ProposalsBelowMinDeposit RPC method.
+type QueryProposalsBelowMinDepositResponse struct {
+	Proposals []*Proposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProposalsBelowMinDepositResponse) Reset()         { *m = QueryProposalsBelowMinDepositResponse{} }
+func (m *QueryProposalsBelowMinDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalsBelowMinDepositResponse) ProtoMessage()    {}
+func (*QueryProposalsBelowMinDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{5}
+}
+func (m *QueryProposalsBelowMinDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalsBelowMinDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalsBelowMinDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalsBelowMinDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalsBelowMinDepositResponse.Merge(m, src)
+}
+func (m *QueryProposalsBelowMinDepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalsBelowMinDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalsBelowMinDepositResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalsBelowMinDepositResponse proto.InternalMessageInfo
+
+func (m *QueryProposalsBelowMinDepositResponse) GetProposals() []*Proposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+func (m *QueryProposalsBelowMinDepositResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryVoteRequest is the request type for the Query/Vote RPC method.
 type QueryVoteRequest struct {
 	// proposal_id defines the unique id of the proposal.
@@ -261,7 +363,7 @@ func (m *QueryVoteRequest) Reset()         { *m = QueryVoteRequest{} }
 func (m *QueryVoteRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVoteRequest) ProtoMessage()    {}
 func (*QueryVoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_46a436d1109b50d0, []int{4}
+	return fileDescriptor_46a436d1109b50d0, []int{6}
 }
 func (m *QueryVoteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -314,7 +416,7 @@ func (m *QueryVoteResponse) Reset()         { *m = QueryVoteResponse{} }
 func (m *QueryVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVoteResponse) ProtoMessage()    {}
 func (*QueryVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_46a436d1109b50d0, []int{5}
+	return fileDescriptor_46a436d1109b50d0, []int{7}
 }
 func (m *QueryVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -362,7 +464,7 @@ func (m *QueryVotesRequest) Reset()         { *m = QueryVotesRequest{} }
 func (m *QueryVotesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVotesRequest) ProtoMessage()    {}
 func (*QueryVotesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_46a436d1109b50d0, []int{6}
+	return fileDescriptor_46a436d1109b50d0, []int{8}
 }
 func (m *QueryVotesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -417,7 +519,7 @@ func (m *QueryVotesResponse) Reset()         { *m = QueryVotesResponse{} }
 func (m *QueryVotesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVotesResponse) ProtoMessage()    {}
 func (*QueryVotesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_46a436d1109b50d0, []int{7}
+	return fileDescriptor_46a436d1109b50d0, []int{9}
 }
 func (m *QueryVotesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -471,7 +573,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_46a436d1109b50d0, []int{8}
+	return fileDescriptor_46a436d1109b50d0, []int{10}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -521,7 +623,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_46a436d1109b50d0, []int{9}
+	return fileDescriptor_46a436d1109b50d0, []int{11}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -583,7 +685,7 @@ func (m *QueryDepositRequest) Reset()         { *m = QueryDepositRequest{} }
 func (m *QueryDepositRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDepositRequest) ProtoMessage()    {}
 func (*QueryDepositRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_46a436d1109b50d0, []int{10}
+	return fileDescriptor_46a436d1109b50d0, []int{12}
 }
 func (m *QueryDepositRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -636,7 +738,7 @@ func (m *QueryDepositResponse) Reset()         { *m = QueryDepositResponse{} }
 func (m *QueryDepositResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDepositResponse) ProtoMessage()    {}
 func (*QueryDepositResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_46a436d1109b50d0, []int{11}
+	return fileDescriptor_46a436d1109b50d0, []int{13}
 }
 func (m *QueryDepositResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -684,7 +786,7 @@ func (m *QueryDepositsRequest) Reset()         { *m = QueryDepositsRequest{} }
 func (m *QueryDepositsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDepositsRequest) ProtoMessage()    {}
 func (*QueryDepositsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_46a436d1109b50d0, []int{12}
+	return fileDescriptor_46a436d1109b50d0, []int{14}
 }
 func (m *QueryDepositsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -738,7 +840,7 @@ func (m *QueryDepositsResponse) Reset()         { *m = QueryDepositsResponse{} }
 func (m *QueryDepositsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDepositsResponse) ProtoMessage()    {}
 func (*QueryDepositsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_46a436d1109b50d0, []int{13}
+	return fileDescriptor_46a436d1109b50d0, []int{15}
 }
 func (m *QueryDepositsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -791,7 +893,7 @@ func (m *QueryTallyResultRequest) Reset()         { *m = QueryTallyResultRequest
 func (m *QueryTallyResultRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTallyResultRequest) ProtoMessage()    {}
 func (*QueryTallyResultRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_46a436d1109b50d0, []int{14}
+	return fileDescriptor_46a436d1109b50d0, []int{16}
 }
 func (m *QueryTallyResultRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -837,7 +939,7 @@ func (m *QueryTallyResultResponse) Reset()         { *m = QueryTallyResultRespon
 func (m *QueryTallyResultResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTallyResultResponse) ProtoMessage()    {}
 func (*QueryTallyResultResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_46a436d1109b50d0, []int{15}
+	return fileDescriptor_46a436d1109b50d0, []int{17}
 }
 func (m *QueryTallyResultResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -878,6 +980,8 @@ func init() {
 	proto.RegisterType((*QueryProposalResponse)(nil), "cosmos.gov.v1.QueryProposalResponse")
 	proto.RegisterType((*QueryProposalsRequest)(nil), "cosmos.gov.v1.QueryProposalsRequest")
 	proto.RegisterType((*QueryProposalsResponse)(nil), "cosmos.gov.v1.QueryProposalsResponse")
+	proto.RegisterType((*QueryProposalsBelowMinDepositRequest)(nil), "cosmos.gov.v1.QueryProposalsBelowMinDepositRequest")
+	proto.RegisterType((*QueryProposalsBelowMinDepositResponse)(nil), "cosmos.gov.v1.QueryProposalsBelowMinDepositResponse")
 	proto.RegisterType((*QueryVoteRequest)(nil), "cosmos.gov.v1.QueryVoteRequest")
 	proto.RegisterType((*QueryVoteResponse)(nil), "cosmos.gov.v1.QueryVoteResponse")
 	proto.RegisterType((*QueryVotesRequest)(nil), "cosmos.gov.v1.QueryVotesRequest")
@@ -895,67 +999,71 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1/query.proto", fileDescriptor_46a436d1109b50d0) }
 
 var fileDescriptor_46a436d1109b50d0 = []byte{
-	// 945 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x5d, 0x6f, 0xdc, 0x44,
-	0x14, 0xcd, 0x6c, 0x92, 0x76, 0xf7, 0xa6, 0x09, 0x70, 0x5b, 0x9a, 0xc5, 0x94, 0x6d, 0x70, 0x68,
-	0x12, 0x28, 0xf5, 0xb0, 0xe9, 0x97, 0x04, 0xad, 0x04, 0x2d, 0x04, 0x90, 0x78, 0x08, 0xdb, 0x8a,
-	0x07, 0x5e, 0x22, 0x27, 0x6b, 0x19, 0x8b, 0x8d, 0xc7, 0xdd, 0x99, 0x5d, 0x11, 0xd2, 0x08, 0xa9,
-	0x12, 0x82, 0x27, 0x40, 0xa2, 0x12, 0xfc, 0x10, 0x7e, 0x04, 0x8f, 0x15, 0xbc, 0x20, 0x78, 0x41,
-	0x09, 0x3f, 0x04, 0x79, 0xe6, 0xda, 0x6b, 0x3b, 0xde, 0xcd, 0xa6, 0x8a, 0xfa, 0x14, 0x8d, 0x7d,
-	0xee, 0xb9, 0xe7, 0x7e, 0xf8, 0x4c, 0x16, 0x5e, 0xda, 0x12, 0x72, 0x5b, 0x48, 0xee, 0x8b, 0x3e,
-	0xef, 0x37, 0xf9, 0x83, 0x9e, 0xd7, 0xdd, 0x71, 0xa2, 0xae, 0x50, 0x02, 0x67, 0xcd, 0x2b, 0xc7,
-	0x17, 0x7d, 0xa7, 0xdf, 0xb4, 0xde, 0x20, 0xe4, 0xa6, 0x2b, 0x3d, 0x83, 0xe3, 0xfd, 0xe6, 0xa6,
-	0xa7, 0xdc, 0x26, 0x8f, 0x5c, 0x3f, 0x08, 0x5d, 0x15, 0x88, 0xd0, 0x84, 0x5a, 0x17, 0x7c, 0x21,
-	0xfc, 0x8e, 0xc7, 0xdd, 0x28, 0xe0, 0x6e, 0x18, 0x0a, 0xa5, 0x5f, 0x4a, 0x7a, 0x3b, 0x9f, 0xcf,
-	0x19, 0xf3, 0x9b, 0x17, 0x24, 0x66, 0x43, 0x9f, 0x38, 0xa5, 0xd7, 0x07, 0xfb, 0x26, 0x9c, 0xfb,
-	0x34, 0xce, 0xb9, 0xde, 0x15, 0x91, 0x90, 0x6e, 0xa7, 0xe5, 0x3d, 0xe8, 0x79, 0x52, 0xe1, 0x45,
-	0x98, 0x89, 0xe8, 0xd1, 0x46, 0xd0, 0xae, 0xb3, 0x05, 0xb6, 0x32, 0xd5, 0x82, 0xe4, 0xd1, 0xc7,
-	0x6d, 0xfb, 0x13, 0x78, 0xb1, 0x10, 0x28, 0x23, 0x11, 0x4a, 0x0f, 0xaf, 0x42, 0x35, 0x81, 0xe9,
-	0xb0, 0x99, 0xd5, 0x79, 0x27, 0x57, 0xb1, 0x93, 0x86, 0xa4, 0x40, 0xfb, 0xc7, 0x4a, 0x81, 0x4e,
-	0x26, 0x42, 0xd6, 0xe0, 0xb9, 0x54, 0x88, 0x54, 0xae, 0xea, 0x49, 0xcd, 0x3a, 0xb7, 0xfa, 0xca,
-	0x10, 0xd6, 0x7b, 0x1a, 0xd4, 0x9a, 0x8b, 0x72, 0x67, 0x74, 0x60, 0xba, 0x2f, 0x94, 0xd7, 0xad,
-	0x57, 0x16, 0xd8, 0x4a, 0xed, 0x4e, 0xfd, 0x8f, 0xdf, 0xae, 0x9c, 0x23, 0x82, 0xf7, 0xda, 0xed,
-	0xae, 0x27, 0xe5, 0x3d, 0xd5, 0x0d, 0x42, 0xbf, 0x65, 0x60, 0x78, 0x03, 0x6a, 0x6d, 0x2f, 0x12,
-	0x32, 0x50, 0xa2, 0x5b, 0x9f, 0x3c, 0x22, 0x66, 0x00, 0xc5, 0x35, 0x80, 0xc1, 0xd8, 0xea, 0x53,
-	0xba, 0x01, 0x4b, 0x89, 0xd4, 0x78, 0xc6, 0x8e, 0xd9, 0x05, 0x9a, 0xb1, 0xb3, 0xee, 0xfa, 0x1e,
-	0xd5, 0xda, 0xca, 0x44, 0xda, 0xbf, 0x32, 0x38, 0x5f, 0xec, 0x08, 0x75, 0xf8, 0x3a, 0xd4, 0x92,
-	0xe2, 0xe2, 0x66, 0x4c, 0x8e, 0x6a, 0xf1, 0x00, 0x89, 0x1f, 0xe6, 0x94, 0x55, 0xb4, 0xb2, 0xe5,
-	0x23, 0x95, 0x99, 0x9c, 0x39, 0x69, 0x5b, 0xf0, 0xbc, 0x56, 0xf6, 0x99, 0x50, 0xde, 0xb8, 0xfb,
-	0x72, 0xdc, 0xfe, 0xdb, 0xb7, 0xe0, 0x85, 0x4c, 0x12, 0xaa, 0x7c, 0x19, 0xa6, 0xe2, 0xb7, 0xb4,
-	0x57, 0x67, 0x0b, 0x45, 0x6b, 0xa8, 0x06, 0xd8, 0x0f, 0x33, 0xd1, 0x72, 0x6c, 0x8d, 0x6b, 0x25,
-	0x1d, 0x7a, 0x9a, 0xd9, 0x7d, 0xcf, 0x00, 0xb3, 0xe9, 0x49, 0xfd, 0xeb, 0xa6, 0x05, 0xc9, 0xcc,
-	0x4a, 0xe5, 0x1b, 0xc4, 0xc9, 0xcd, 0xea, 0x3a, 0x29, 0x59, 0x77, 0xbb, 0xee, 0x76, 0xae, 0x13,
-	0xfa, 0xc1, 0x86, 0xda, 0x89, 0x4c, 0x3b, 0x6b, 0x71, 0x58, 0xfc, 0xe8, 0xfe, 0x4e, 0xe4, 0xd9,
-	0xff, 0x30, 0x38, 0x9b, 0x8b, 0xa3, 0x12, 0xde, 0x85, 0xd9, 0xbe, 0x50, 0x41, 0xe8, 0x6f, 0x18,
-	0x30, 0x4d, 0xe2, 0xe5, 0xc3, 0xa5, 0x04, 0xa1, 0x4f, 0xb1, 0x67, 0xfa, 0x99, 0x13, 0xde, 0x85,
-	0x39, 0xfa, 0x58, 0x12, 0x0a, 0x53, 0xdd, 0x85, 0x02, 0xc5, 0xfb, 0x06, 0x44, 0x1c, 0xb3, 0xed,
-	0xec, 0x11, 0x6f, 0xc3, 0x19, 0xe5, 0x76, 0x3a, 0x3b, 0x09, 0xc5, 0xa4, 0xa6, 0xb0, 0x0a, 0x14,
-	0xf7, 0x63, 0x08, 0x11, 0xcc, 0xa8, 0xc1, 0xc1, 0x0e, 0xa9, 0x38, 0xca, 0x31, 0xf6, 0x7e, 0xe4,
-	0x3c, 0xa1, 0x32, 0xb6, 0x27, 0xd8, 0x1f, 0x91, 0xc9, 0xa6, 0xf9, 0xa8, 0x9b, 0x6f, 0xc1, 0x69,
-	0x02, 0x51, 0x1f, 0xcf, 0x97, 0x37, 0xa1, 0x95, 0xc0, 0xec, 0x6f, 0xf2, 0x4c, 0xcf, 0x7e, 0xb5,
-	0x1f, 0x33, 0x32, 0xea, 0x81, 0x02, 0x2a, 0x66, 0x15, 0xaa, 0xa4, 0x32, 0x59, 0xf0, 0x61, 0xd5,
-	0xa4, 0xb8, 0x93, 0x5b, 0xf3, 0xb7, 0x61, 0x5e, 0xab, 0xd2, 0x23, 0x6f, 0x79, 0xb2, 0xd7, 0x51,
-	0xc7, 0xb8, 0xc9, 0xea, 0x87, 0x63, 0xd3, 0x09, 0x4d, 0xeb, 0xc5, 0xa1, 0xf9, 0x94, 0x6e, 0x18,
-	0x85, 0x18, 0xe0, 0xea, 0xdf, 0x55, 0x98, 0xd6, 0x74, 0xf8, 0x2d, 0x83, 0x6a, 0xe2, 0xc3, 0xb8,
-	0x58, 0x88, 0x2c, 0xbb, 0x74, 0xad, 0xd7, 0x46, 0x83, 0x8c, 0x26, 0xdb, 0x79, 0xf4, 0xe7, 0x7f,
-	0x3f, 0x57, 0x56, 0x70, 0x89, 0xe7, 0xef, 0xfb, 0xd4, 0xe9, 0xf9, 0x6e, 0xa6, 0xe0, 0x3d, 0xfc,
-	0x1a, 0x6a, 0xe9, 0x1d, 0x82, 0x23, 0x53, 0x24, 0xeb, 0x64, 0x5d, 0x3a, 0x02, 0x45, 0x4a, 0x16,
-	0xb4, 0x12, 0x0b, 0xeb, 0xc3, 0x94, 0xe0, 0x77, 0x0c, 0xa6, 0x62, 0x5f, 0xc3, 0x8b, 0x65, 0x8c,
-	0x99, 0x0b, 0xc4, 0x5a, 0x18, 0x0e, 0xa0, 0x6c, 0xb7, 0x74, 0xb6, 0x1b, 0x78, 0x6d, 0xbc, 0xba,
-	0xb9, 0x76, 0x52, 0xbe, 0xab, 0xaf, 0x93, 0x3d, 0x7c, 0xc4, 0x60, 0x5a, 0xdb, 0x31, 0x0e, 0xcd,
-	0x94, 0x96, 0xff, 0xea, 0x08, 0x04, 0x89, 0xb9, 0xa6, 0xc5, 0x38, 0xf8, 0xe6, 0x71, 0xc4, 0xe0,
-	0x43, 0x38, 0x45, 0x0e, 0x56, 0x9a, 0x22, 0x67, 0xd2, 0x96, 0x3d, 0x0a, 0x42, 0x32, 0x2e, 0x6b,
-	0x19, 0x97, 0x70, 0xb1, 0x28, 0x43, 0xc3, 0xf8, 0x6e, 0xc6, 0xe5, 0xf7, 0xf0, 0x17, 0x06, 0xa7,
-	0xe9, 0x1b, 0xc4, 0x52, 0xf2, 0xbc, 0x1f, 0x5a, 0x8b, 0x23, 0x31, 0xa4, 0xe0, 0xae, 0x56, 0x70,
-	0x1b, 0xdf, 0x19, 0xb3, 0x11, 0xc9, 0xb7, 0xcf, 0x77, 0x53, 0x7f, 0xdc, 0xc3, 0x1f, 0x18, 0x54,
-	0x13, 0x43, 0xc1, 0x51, 0x69, 0xe5, 0xc8, 0x4f, 0xa5, 0xe8, 0x49, 0xf6, 0x4d, 0x2d, 0xae, 0x89,
-	0xfc, 0x98, 0xe2, 0xf0, 0x31, 0x83, 0x99, 0xcc, 0xc7, 0x8d, 0x4b, 0x65, 0xe9, 0x0e, 0x9b, 0x8d,
-	0xb5, 0x7c, 0x24, 0xee, 0x29, 0xf7, 0x47, 0x9b, 0xcb, 0x9d, 0x0f, 0x7e, 0xdf, 0x6f, 0xb0, 0x27,
-	0xfb, 0x0d, 0xf6, 0xef, 0x7e, 0x83, 0xfd, 0x74, 0xd0, 0x98, 0x78, 0x72, 0xd0, 0x98, 0xf8, 0xeb,
-	0xa0, 0x31, 0xf1, 0xf9, 0x65, 0x3f, 0x50, 0x5f, 0xf4, 0x36, 0x9d, 0x2d, 0xb1, 0x9d, 0x30, 0x9a,
-	0x3f, 0x57, 0x64, 0xfb, 0x4b, 0xfe, 0x95, 0xa6, 0x8f, 0xb7, 0x40, 0xc6, 0x3f, 0x2e, 0x4e, 0xe9,
-	0xff, 0xfd, 0xaf, 0xfe, 0x1f, 0x00, 0x00, 0xff, 0xff, 0xce, 0x57, 0x85, 0xcf, 0xa5, 0x0c, 0x00,
-	0x00,
+	// 1014 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xdd, 0x6e, 0xdc, 0x44,
+	0x14, 0xce, 0xe4, 0xa7, 0xcd, 0x9e, 0x34, 0x01, 0x4e, 0xd3, 0xc6, 0x98, 0xb2, 0x0d, 0x4e, 0xf3,
+	0x43, 0x7f, 0x6c, 0x76, 0x93, 0xb6, 0x12, 0xb4, 0x12, 0xb4, 0x10, 0x40, 0x02, 0x29, 0x6c, 0x2b,
+	0x2e, 0xb8, 0x59, 0x39, 0x59, 0xcb, 0x58, 0x6c, 0x3c, 0xae, 0xc7, 0xbb, 0x10, 0xd2, 0x08, 0xa9,
+	0x12, 0x82, 0x2b, 0x40, 0xa2, 0x02, 0xde, 0x80, 0x17, 0xe0, 0x86, 0x37, 0xe0, 0xb2, 0x82, 0x1b,
+	0x24, 0x6e, 0x50, 0xc2, 0x83, 0x20, 0x8f, 0x8f, 0xbd, 0xb6, 0xe3, 0xf5, 0xee, 0x46, 0x15, 0xe2,
+	0x6a, 0x35, 0x33, 0xdf, 0xf9, 0xce, 0x77, 0x7e, 0xe6, 0x78, 0x16, 0x9e, 0xdf, 0xe1, 0x62, 0x97,
+	0x0b, 0xc3, 0xe6, 0x5d, 0xa3, 0x5b, 0x33, 0x1e, 0x74, 0x2c, 0x7f, 0x4f, 0xf7, 0x7c, 0x1e, 0x70,
+	0x9c, 0x8d, 0x8e, 0x74, 0x9b, 0x77, 0xf5, 0x6e, 0x4d, 0xbd, 0x4c, 0xc8, 0x6d, 0x53, 0x58, 0x11,
+	0xce, 0xe8, 0xd6, 0xb6, 0xad, 0xc0, 0xac, 0x19, 0x9e, 0x69, 0x3b, 0xae, 0x19, 0x38, 0xdc, 0x8d,
+	0x4c, 0xd5, 0x0b, 0x36, 0xe7, 0x76, 0xdb, 0x32, 0x4c, 0xcf, 0x31, 0x4c, 0xd7, 0xe5, 0x81, 0x3c,
+	0x14, 0x74, 0xba, 0x90, 0xf5, 0x19, 0xf2, 0x47, 0x07, 0x24, 0xa6, 0x29, 0x57, 0x06, 0xb9, 0x97,
+	0x0b, 0xed, 0x26, 0xcc, 0x7f, 0x10, 0xfa, 0xdc, 0xf2, 0xb9, 0xc7, 0x85, 0xd9, 0x6e, 0x58, 0x0f,
+	0x3a, 0x96, 0x08, 0xf0, 0x22, 0xcc, 0x78, 0xb4, 0xd5, 0x74, 0x5a, 0x0a, 0x5b, 0x64, 0x6b, 0x93,
+	0x0d, 0x88, 0xb7, 0xde, 0x6d, 0x69, 0xef, 0xc1, 0xb9, 0x9c, 0xa1, 0xf0, 0xb8, 0x2b, 0x2c, 0x5c,
+	0x87, 0xe9, 0x18, 0x26, 0xcd, 0x66, 0xea, 0x0b, 0x7a, 0x26, 0x62, 0x3d, 0x31, 0x49, 0x80, 0xda,
+	0xb7, 0xe3, 0x39, 0x3a, 0x11, 0x0b, 0xd9, 0x84, 0x67, 0x12, 0x21, 0x22, 0x30, 0x83, 0x8e, 0x90,
+	0xac, 0x73, 0xf5, 0x17, 0xfb, 0xb0, 0xde, 0x93, 0xa0, 0xc6, 0x9c, 0x97, 0x59, 0xa3, 0x0e, 0x53,
+	0x5d, 0x1e, 0x58, 0xbe, 0x32, 0xbe, 0xc8, 0xd6, 0x2a, 0x77, 0x94, 0xdf, 0x7f, 0xb9, 0x36, 0x4f,
+	0x04, 0x6f, 0xb4, 0x5a, 0xbe, 0x25, 0xc4, 0xbd, 0xc0, 0x77, 0x5c, 0xbb, 0x11, 0xc1, 0xf0, 0x06,
+	0x54, 0x5a, 0x96, 0xc7, 0x85, 0x13, 0x70, 0x5f, 0x99, 0x18, 0x60, 0xd3, 0x83, 0xe2, 0x26, 0x40,
+	0xaf, 0x6c, 0xca, 0xa4, 0x4c, 0xc0, 0x4a, 0x2c, 0x35, 0xac, 0xb1, 0x1e, 0xf5, 0x02, 0xd5, 0x58,
+	0xdf, 0x32, 0x6d, 0x8b, 0x62, 0x6d, 0xa4, 0x2c, 0xb5, 0x9f, 0x18, 0x9c, 0xcf, 0x67, 0x84, 0x32,
+	0x7c, 0x1d, 0x2a, 0x71, 0x70, 0x61, 0x32, 0x26, 0xca, 0x52, 0xdc, 0x43, 0xe2, 0xdb, 0x19, 0x65,
+	0xe3, 0x52, 0xd9, 0xea, 0x40, 0x65, 0x91, 0xcf, 0x8c, 0x34, 0x17, 0x2e, 0x65, 0x95, 0xdd, 0xb1,
+	0xda, 0xfc, 0xd3, 0xf7, 0x1d, 0xf7, 0xcd, 0x28, 0x0b, 0xbd, 0xd2, 0xa5, 0x1d, 0xb2, 0x13, 0xa7,
+	0xe2, 0x67, 0x06, 0xcb, 0x03, 0x1c, 0xfe, 0x4f, 0x32, 0xb3, 0x03, 0xcf, 0x4a, 0xa1, 0x1f, 0xf2,
+	0xc0, 0x1a, 0xf6, 0x26, 0x8d, 0xda, 0x99, 0xda, 0x2d, 0x78, 0x2e, 0xe5, 0x84, 0x22, 0x5f, 0x85,
+	0xc9, 0xf0, 0x94, 0xb2, 0x7c, 0x36, 0x17, 0xb4, 0x84, 0x4a, 0x80, 0xf6, 0x30, 0x65, 0x2d, 0x86,
+	0xd6, 0xb8, 0x59, 0x90, 0xa1, 0x93, 0x94, 0xf2, 0x6b, 0x06, 0x98, 0x76, 0x4f, 0xea, 0x5f, 0x8e,
+	0x52, 0x10, 0xd7, 0xac, 0x50, 0x7e, 0x84, 0x78, 0x7a, 0xb5, 0xba, 0x4e, 0x4a, 0xb6, 0x4c, 0xdf,
+	0xdc, 0xcd, 0x64, 0x42, 0x6e, 0x34, 0x83, 0x3d, 0x2f, 0x4a, 0x67, 0xa5, 0x01, 0xd1, 0xd6, 0xfd,
+	0x3d, 0xcf, 0xd2, 0xfe, 0x62, 0x70, 0x36, 0x63, 0x47, 0x21, 0xbc, 0x0e, 0xb3, 0x5d, 0x1e, 0x38,
+	0xae, 0xdd, 0x8c, 0xc0, 0x54, 0x89, 0x17, 0x8e, 0x87, 0xe2, 0xb8, 0x36, 0xd9, 0x9e, 0xe9, 0xa6,
+	0x56, 0x78, 0x17, 0xe6, 0x68, 0x8c, 0xc4, 0x14, 0x51, 0x74, 0x17, 0x72, 0x14, 0xd4, 0xf4, 0xc4,
+	0x31, 0xdb, 0x4a, 0x2f, 0xf1, 0x36, 0x9c, 0x09, 0xcc, 0x76, 0x7b, 0x2f, 0xa6, 0x98, 0x90, 0x14,
+	0x6a, 0x8e, 0xe2, 0x7e, 0x08, 0x21, 0x82, 0x99, 0xa0, 0xb7, 0xd0, 0x5c, 0x0a, 0x2e, 0x77, 0x93,
+	0x07, 0xf6, 0x47, 0x66, 0x5a, 0x8e, 0x0f, 0x3d, 0x2d, 0xb5, 0x77, 0x60, 0x3e, 0xeb, 0x8f, 0xb2,
+	0xf9, 0x0a, 0x9c, 0x26, 0x10, 0xe5, 0xf1, 0x7c, 0x71, 0x12, 0x1a, 0x31, 0x4c, 0xfb, 0x22, 0xcb,
+	0xf4, 0xdf, 0xb7, 0xf6, 0x63, 0x06, 0xe7, 0x72, 0x0a, 0x28, 0x98, 0x3a, 0x4c, 0x93, 0xca, 0xb8,
+	0xc1, 0xfb, 0x45, 0x93, 0xe0, 0x9e, 0x5e, 0x9b, 0xbf, 0x0a, 0x0b, 0x52, 0x95, 0x2c, 0x79, 0xc3,
+	0x12, 0x9d, 0x76, 0x30, 0xc2, 0x37, 0x5e, 0x39, 0x6e, 0x9b, 0x54, 0x68, 0x4a, 0x36, 0x8e, 0xc2,
+	0xfa, 0x77, 0x18, 0x99, 0x44, 0xc0, 0xfa, 0x0f, 0x00, 0x53, 0x92, 0x0e, 0xbf, 0x64, 0x30, 0x1d,
+	0xcf, 0x61, 0x5c, 0xca, 0x59, 0x16, 0x3d, 0x47, 0xd4, 0x4b, 0xe5, 0xa0, 0x48, 0x93, 0xa6, 0x3f,
+	0xfa, 0xe3, 0x9f, 0xef, 0xc7, 0xd7, 0x70, 0xc5, 0xc8, 0xbe, 0x84, 0xe2, 0x90, 0x84, 0xb1, 0x9f,
+	0x0a, 0xf8, 0x00, 0x3f, 0x87, 0x4a, 0xcc, 0x21, 0xb0, 0xd4, 0x45, 0xdc, 0x4e, 0xea, 0xf2, 0x00,
+	0x14, 0x29, 0x59, 0x94, 0x4a, 0x54, 0x54, 0xfa, 0x29, 0xc1, 0xaf, 0x18, 0x4c, 0x86, 0x73, 0x0d,
+	0x2f, 0x16, 0x31, 0xa6, 0x3e, 0x20, 0xea, 0x62, 0x7f, 0x00, 0x79, 0xbb, 0x25, 0xbd, 0xdd, 0xc0,
+	0x8d, 0xe1, 0xe2, 0x36, 0xe4, 0x24, 0x35, 0xf6, 0xc3, 0x1f, 0xff, 0x00, 0x1f, 0x31, 0x98, 0x0a,
+	0xe9, 0x04, 0xf6, 0xf5, 0x94, 0x84, 0xff, 0x52, 0x09, 0x82, 0xc4, 0x6c, 0x48, 0x31, 0x3a, 0x5e,
+	0x1d, 0x45, 0x0c, 0x3e, 0x84, 0x53, 0x34, 0xc1, 0x0a, 0x5d, 0x64, 0x86, 0xb4, 0xaa, 0x95, 0x41,
+	0x48, 0xc6, 0x15, 0x29, 0x63, 0x19, 0x97, 0xf2, 0x32, 0x24, 0xcc, 0xd8, 0x4f, 0x4d, 0xf9, 0x03,
+	0xfc, 0x91, 0xc1, 0x69, 0xba, 0x83, 0x58, 0x48, 0x9e, 0x9d, 0x87, 0xea, 0x52, 0x29, 0x86, 0x14,
+	0xdc, 0x95, 0x0a, 0x6e, 0xe3, 0x6b, 0x43, 0x26, 0x22, 0xbe, 0xfb, 0xc6, 0x7e, 0x32, 0x1f, 0x0f,
+	0xf0, 0x1b, 0x06, 0xd3, 0x44, 0x2c, 0xb0, 0xcc, 0xad, 0x28, 0xbd, 0x2a, 0xf9, 0x99, 0xa4, 0xdd,
+	0x94, 0xe2, 0x6a, 0x68, 0x8c, 0x28, 0x0e, 0x1f, 0x33, 0x98, 0x49, 0x5d, 0x6e, 0x5c, 0x29, 0x72,
+	0x77, 0x7c, 0xd8, 0xa8, 0xab, 0x03, 0x71, 0x27, 0xec, 0x1f, 0x39, 0x5c, 0xf0, 0x57, 0x06, 0x4a,
+	0xbf, 0xe7, 0x21, 0xae, 0x97, 0x5e, 0xda, 0xe2, 0xd7, 0xab, 0xba, 0x31, 0x9a, 0x11, 0xa9, 0xaf,
+	0x4b, 0xf5, 0x57, 0xf1, 0x72, 0x4e, 0xfd, 0x76, 0x88, 0x6f, 0xee, 0x3a, 0x6e, 0x33, 0xf9, 0xc6,
+	0x27, 0x54, 0x6f, 0xfd, 0x76, 0x58, 0x65, 0x4f, 0x0e, 0xab, 0xec, 0xef, 0xc3, 0x2a, 0xfb, 0xee,
+	0xa8, 0x3a, 0xf6, 0xe4, 0xa8, 0x3a, 0xf6, 0xe7, 0x51, 0x75, 0xec, 0xa3, 0x2b, 0xb6, 0x13, 0x7c,
+	0xdc, 0xd9, 0xd6, 0x77, 0xf8, 0x6e, 0xcc, 0x17, 0xfd, 0x5c, 0x13, 0xad, 0x4f, 0x8c, 0xcf, 0x24,
+	0x79, 0xd8, 0xc1, 0x22, 0xfc, 0xcb, 0x78, 0x4a, 0xfe, 0xa3, 0x5b, 0xff, 0x77, 0x00, 0x34, 0xb8,
+	0x4c, 0x10, 0x7b, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -986,6 +1094,9 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// ProposalsBelowMinDeposit queries the proposals in deposit period whose
+	// total deposit is below the minimum deposit.
+	ProposalsBelowMinDeposit(ctx context.Context, in *QueryProposalsBelowMinDepositRequest, opts ...grpc.CallOption) (*QueryProposalsBelowMinDepositResponse, error)
 }
 
 type queryClient struct {
@@ -1068,6 +1179,15 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) ProposalsBelowMinDeposit(ctx context.Context, in *QueryProposalsBelowMinDepositRequest, opts ...grpc.CallOption) (*QueryProposalsBelowMinDepositResponse, error) {
+	out := new(QueryProposalsBelowMinDepositResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Query/ProposalsBelowMinDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Proposal queries proposal details based on ProposalID.
@@ -1086,6 +1206,9 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// ProposalsBelowMinDeposit queries the proposals in deposit period whose
+	// total deposit is below the minimum deposit.
+	ProposalsBelowMinDeposit(context.Context, *QueryProposalsBelowMinDepositRequest) (*QueryProposalsBelowMinDepositResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1116,6 +1239,9 @@ func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDeposit
 func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (*UnimplementedQueryServer) ProposalsBelowMinDeposit(ctx context.Context, req *QueryProposalsBelowMinDepositRequest) (*QueryProposalsBelowMinDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalsBelowMinDeposit not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1265,6 +1391,24 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProposalsBelowMinDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalsBelowMinDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalsBelowMinDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Query/ProposalsBelowMinDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalsBelowMinDeposit(ctx, req.(*QueryProposalsBelowMinDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1301,6 +1445,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "ProposalsBelowMinDeposit",
+			Handler:    _Query_ProposalsBelowMinDeposit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
@@ -1472,6 +1620,90 @@ func (m *QueryProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryProposalsBelowMinDepositRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsBelowMinDepositRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsBelowMinDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalsBelowMinDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsBelowMinDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsBelowMinDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryVoteRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2021,6 +2253,38 @@ func (m *QueryProposalsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryProposalsBelowMinDepositRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalsBelowMinDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryVoteRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2658,6 +2922,212 @@ func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryProposalsBelowMinDepositRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalsBelowMinDepositRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalsBelowMinDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalsBelowMinDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalsBelowMinDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalsBelowMinDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, &Proposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -47,15 +47,60 @@ func NewTallyResultFromMap(results map[VoteOption]sdk.Dec) TallyResult {
 	)
 }
 
+// WithMultipleChoiceResults returns the tally result with the counts of the
+// options of a multiple-choice proposal set from an Option -> Dec map.
+func (tr TallyResult) WithMultipleChoiceResults(results map[MultipleChoiceOption]sdk.Dec) TallyResult {
+	count := func(option MultipleChoiceOption) string {
+		if result, ok := results[option]; ok {
+			return result.TruncateInt().String()
+		}
+		return sdk.ZeroInt().String()
+	}
+
+	tr.OptionOneCount = count(OptionOne)
+	tr.OptionTwoCount = count(OptionTwo)
+	tr.OptionThreeCount = count(OptionThree)
+	tr.OptionFourCount = count(OptionFour)
+	return tr
+}
+
 // EmptyTallyResult returns an empty TallyResult.
 func EmptyTallyResult() TallyResult {
 	return NewTallyResult(sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt())
 }
 
+// PluralityOption returns the multiple-choice option with the most votes, which
+// is the result of a multiple-choice proposal. It returns false if no vote was
+// cast or if several options are tied for the most votes.
+func (tr TallyResult) PluralityOption() (MultipleChoiceOption, bool) {
+	counts := []string{tr.OptionOneCount, tr.OptionTwoCount, tr.OptionThreeCount, tr.OptionFourCount}
+
+	winner, tied, most := MultipleChoiceOptionEmpty, false, sdk.ZeroInt()
+	for i, count := range counts {
+		amount, ok := sdk.NewIntFromString(count)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case amount.GT(most):
+			winner, tied, most = OptionOne+MultipleChoiceOption(i), false, amount
+		case amount.Equal(most) && amount.IsPositive():
+			tied = true
+		}
+	}
+
+	return winner, winner != MultipleChoiceOptionEmpty && !tied
+}
+
 // Equals returns if two tally results are equal.
 func (tr TallyResult) Equals(comp TallyResult) bool {
 	return tr.YesCount == comp.YesCount &&
 		tr.AbstainCount == comp.AbstainCount &&
 		tr.NoCount == comp.NoCount &&
-		tr.NoWithVetoCount == comp.NoWithVetoCount
+		tr.NoWithVetoCount == comp.NoWithVetoCount &&
+		tr.OptionOneCount == comp.OptionOneCount &&
+		tr.OptionTwoCount == comp.OptionTwoCount &&
+		tr.OptionThreeCount == comp.OptionThreeCount &&
+		tr.OptionFourCount == comp.OptionFourCount
 }
@@ -13,6 +13,13 @@ const (
 	OptionNo         = VoteOption_VOTE_OPTION_NO
 	OptionNoWithVeto = VoteOption_VOTE_OPTION_NO_WITH_VETO
 	OptionAbstain    = VoteOption_VOTE_OPTION_ABSTAIN
+
+	// options of multiple-choice proposals
+	MultipleChoiceOptionEmpty = MultipleChoiceOption_MULTIPLE_CHOICE_OPTION_UNSPECIFIED
+	OptionOne                 = MultipleChoiceOption_MULTIPLE_CHOICE_OPTION_ONE
+	OptionTwo                 = MultipleChoiceOption_MULTIPLE_CHOICE_OPTION_TWO
+	OptionThree               = MultipleChoiceOption_MULTIPLE_CHOICE_OPTION_THREE
+	OptionFour                = MultipleChoiceOption_MULTIPLE_CHOICE_OPTION_FOUR
 )
 
 // NewVote creates a new Vote instance
@@ -60,6 +67,12 @@ func NewWeightedVoteOption(option VoteOption, weight sdk.Dec) *WeightedVoteOptio
 	return &WeightedVoteOption{Option: option, Weight: weight.String()}
 }
 
+// NewWeightedMultipleChoiceOption creates a sub vote for an option of a
+// multiple-choice proposal.
+func NewWeightedMultipleChoiceOption(option MultipleChoiceOption, weight sdk.Dec) *WeightedVoteOption {
+	return &WeightedVoteOption{MultipleChoiceOption: option, Weight: weight.String()}
+}
+
 // IsValid returns true if the sub vote is valid and false otherwise.
 func (w *WeightedVoteOption) IsValid() bool {
 	weight, err := sdk.NewDecFromStr(w.Weight)
@@ -71,12 +84,18 @@ func (w *WeightedVoteOption) IsValid() bool {
 		return false
 	}
 
-	return ValidVoteOption(w.Option)
+	return validSubVoteOption(*w)
 }
 
 // NewNonSplitVoteOption creates a single option vote with weight 1
 func NewNonSplitVoteOption(option VoteOption) WeightedVoteOptions {
-	return WeightedVoteOptions{{option, sdk.NewDec(1).String()}}
+	return WeightedVoteOptions{{Option: option, Weight: sdk.NewDec(1).String()}}
+}
+
+// NewNonSplitMultipleChoiceOption creates a single option vote with weight 1
+// for an option of a multiple-choice proposal.
+func NewNonSplitMultipleChoiceOption(option MultipleChoiceOption) WeightedVoteOptions {
+	return WeightedVoteOptions{NewWeightedMultipleChoiceOption(option, sdk.NewDec(1))}
 }
 
 // ValidWeightedVoteOption returns true if the sub vote is valid and false otherwise.
@@ -85,6 +104,15 @@ func ValidWeightedVoteOption(option WeightedVoteOption) bool {
 	if err != nil || !weight.IsPositive() || weight.GT(sdk.NewDec(1)) {
 		return false
 	}
+	return validSubVoteOption(option)
+}
+
+// validSubVoteOption returns true if the sub vote is for either a valid vote
+// option or a valid multiple-choice option.
+func validSubVoteOption(option WeightedVoteOption) bool {
+	if option.MultipleChoiceOption != MultipleChoiceOptionEmpty {
+		return option.Option == OptionEmpty && ValidMultipleChoiceOption(option.MultipleChoiceOption)
+	}
 	return ValidVoteOption(option.Option)
 }
 
@@ -109,15 +137,29 @@ func VoteOptionFromString(str string) (VoteOption, error) {
 	return VoteOption(option), nil
 }
 
+// MultipleChoiceOptionFromString returns a MultipleChoiceOption from a string.
+// It returns an error if the string is invalid.
+func MultipleChoiceOptionFromString(str string) (MultipleChoiceOption, error) {
+	option, ok := MultipleChoiceOption_value[str]
+	if !ok || !ValidMultipleChoiceOption(MultipleChoiceOption(option)) {
+		return MultipleChoiceOptionEmpty, fmt.Errorf("'%s' is not a valid multiple-choice option, available options: one/two/three/four", str)
+	}
+	return MultipleChoiceOption(option), nil
+}
+
 // WeightedVoteOptionsFromString returns weighted vote options from string. It returns an error
 // if the string is invalid.
 func WeightedVoteOptionsFromString(str string) (WeightedVoteOptions, error) {
 	options := WeightedVoteOptions{}
 	for _, option := range strings.Split(str, ",") {
 		fields := strings.Split(option, "=")
-		option, err := VoteOptionFromString(fields[0])
-		if err != nil {
-			return options, err
+		mcOption, mcErr := MultipleChoiceOptionFromString(fields[0])
+		option := OptionEmpty
+		if mcErr != nil {
+			var err error
+			if option, err = VoteOptionFromString(fields[0]); err != nil {
+				return options, err
+			}
 		}
 		if len(fields) < 2 {
 			return options, fmt.Errorf("weight field does not exist for %s option", fields[0])
@@ -126,7 +168,11 @@ func WeightedVoteOptionsFromString(str string) (WeightedVoteOptions, error) {
 		if err != nil {
 			return options, err
 		}
-		options = append(options, NewWeightedVoteOption(option, weight))
+		if mcErr == nil {
+			options = append(options, NewWeightedMultipleChoiceOption(mcOption, weight))
+		} else {
+			options = append(options, NewWeightedVoteOption(option, weight))
+		}
 	}
 	return options, nil
 }
@@ -142,6 +188,12 @@ func ValidVoteOption(option VoteOption) bool {
 	return false
 }
 
+// ValidMultipleChoiceOption returns true if the multiple-choice option is valid
+// and false otherwise.
+func ValidMultipleChoiceOption(option MultipleChoiceOption) bool {
+	return option >= OptionOne && option <= OptionFour
+}
+
 // Marshal needed for protobuf compatibility.
 func (vo VoteOption) Marshal() ([]byte, error) {
 	return []byte{byte(vo)}, nil
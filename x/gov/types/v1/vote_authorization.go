@@ -0,0 +1,116 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var _ authz.Authorization = &VoteAuthorization{}
+
+// NewVoteAuthorization creates a new VoteAuthorization object.
+func NewVoteAuthorization(proposalIDs []uint64, options []VoteOption) *VoteAuthorization {
+	return &VoteAuthorization{
+		ProposalIds: proposalIDs,
+		Options:     options,
+	}
+}
+
+// NewWeightedVoteAuthorization creates a new VoteAuthorization object for
+// MsgVoteWeighted.
+func NewWeightedVoteAuthorization(proposalIDs []uint64, options []VoteOption) *VoteAuthorization {
+	return &VoteAuthorization{
+		ProposalIds: proposalIDs,
+		Options:     options,
+		Weighted:    true,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a VoteAuthorization) MsgTypeURL() string {
+	if a.Weighted {
+		return sdk.MsgTypeURL(&MsgVoteWeighted{})
+	}
+	return sdk.MsgTypeURL(&MsgVote{})
+}
+
+// Accept implements Authorization.Accept.
+func (a VoteAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	var (
+		proposalID uint64
+		options    []VoteOption
+	)
+	switch msg := msg.(type) {
+	case *MsgVote:
+		if a.Weighted {
+			return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+		}
+		proposalID, options = msg.ProposalId, []VoteOption{msg.Option}
+	case *MsgVoteWeighted:
+		if !a.Weighted {
+			return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+		}
+		proposalID = msg.ProposalId
+		for _, option := range msg.Options {
+			options = append(options, option.Option)
+		}
+	default:
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	if len(a.ProposalIds) > 0 && !containsProposalID(a.ProposalIds, proposalID) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot vote on proposal %d", proposalID)
+	}
+
+	if len(a.Options) > 0 {
+		for _, option := range options {
+			if !containsVoteOption(a.Options, option) {
+				return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot vote with option %s", option)
+			}
+		}
+	}
+
+	return authz.AcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a VoteAuthorization) ValidateBasic() error {
+	proposalIDs := make(map[uint64]bool, len(a.ProposalIds))
+	for _, id := range a.ProposalIds {
+		if proposalIDs[id] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate proposal id %d", id)
+		}
+		proposalIDs[id] = true
+	}
+
+	options := make(map[VoteOption]bool, len(a.Options))
+	for _, option := range a.Options {
+		if !ValidVoteOption(option) {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid vote option %s", option)
+		}
+		if options[option] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate vote option %s", option)
+		}
+		options[option] = true
+	}
+
+	return nil
+}
+
+func containsProposalID(proposalIDs []uint64, proposalID uint64) bool {
+	for _, id := range proposalIDs {
+		if id == proposalID {
+			return true
+		}
+	}
+	return false
+}
+
+func containsVoteOption(options []VoteOption, option VoteOption) bool {
+	for _, o := range options {
+		if o == option {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,83 @@
+package v1_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func TestVoteAuthorization(t *testing.T) {
+	ctx := sdk.Context{}
+	authorization := v1.NewVoteAuthorization([]uint64{1, 2}, []v1.VoteOption{v1.OptionYes, v1.OptionAbstain})
+	require.Equal(t, "/cosmos.gov.v1.MsgVote", authorization.MsgTypeURL())
+	require.NoError(t, authorization.ValidateBasic())
+
+	testCases := []struct {
+		name      string
+		msg       sdk.Msg
+		expAccept bool
+	}{
+		{"allowed proposal and option", v1.NewMsgVote(addrs[0], 1, v1.OptionYes, ""), true},
+		{"proposal not allowed", v1.NewMsgVote(addrs[0], 3, v1.OptionYes, ""), false},
+		{"option not allowed", v1.NewMsgVote(addrs[0], 2, v1.OptionNo, ""), false},
+		{"wrong msg type", v1.NewMsgVoteWeighted(addrs[0], 1, v1.NewNonSplitVoteOption(v1.OptionYes), ""), false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := authorization.Accept(ctx, tc.msg)
+			if tc.expAccept {
+				require.NoError(t, err)
+				require.True(t, resp.Accept)
+				require.False(t, resp.Delete)
+				require.Nil(t, resp.Updated)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	// an empty authorization accepts any vote
+	resp, err := v1.NewVoteAuthorization(nil, nil).Accept(ctx, v1.NewMsgVote(addrs[0], 3, v1.OptionNo, ""))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+
+	// a weighted authorization checks every option of MsgVoteWeighted
+	weighted := v1.NewWeightedVoteAuthorization([]uint64{1}, []v1.VoteOption{v1.OptionYes, v1.OptionAbstain})
+	require.Equal(t, "/cosmos.gov.v1.MsgVoteWeighted", weighted.MsgTypeURL())
+	require.NoError(t, weighted.ValidateBasic())
+
+	weightedCases := []struct {
+		name      string
+		msg       sdk.Msg
+		expAccept bool
+	}{
+		{"allowed options", v1.NewMsgVoteWeighted(addrs[0], 1, v1.WeightedVoteOptions{
+			v1.NewWeightedVoteOption(v1.OptionYes, sdk.NewDecWithPrec(5, 1)),
+			v1.NewWeightedVoteOption(v1.OptionAbstain, sdk.NewDecWithPrec(5, 1)),
+		}, ""), true},
+		{"one option not allowed", v1.NewMsgVoteWeighted(addrs[0], 1, v1.WeightedVoteOptions{
+			v1.NewWeightedVoteOption(v1.OptionYes, sdk.NewDecWithPrec(5, 1)),
+			v1.NewWeightedVoteOption(v1.OptionNo, sdk.NewDecWithPrec(5, 1)),
+		}, ""), false},
+		{"proposal not allowed", v1.NewMsgVoteWeighted(addrs[0], 2, v1.NewNonSplitVoteOption(v1.OptionYes), ""), false},
+		{"wrong msg type", v1.NewMsgVote(addrs[0], 1, v1.OptionYes, ""), false},
+	}
+	for _, tc := range weightedCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := weighted.Accept(ctx, tc.msg)
+			if tc.expAccept {
+				require.NoError(t, err)
+				require.True(t, resp.Accept)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	require.Error(t, v1.NewVoteAuthorization([]uint64{1, 1}, nil).ValidateBasic())
+	require.Error(t, v1.NewVoteAuthorization(nil, []v1.VoteOption{v1.OptionEmpty}).ValidateBasic())
+	require.Error(t, v1.NewVoteAuthorization(nil, []v1.VoteOption{v1.OptionNo, v1.OptionNo}).ValidateBasic())
+}
@@ -34,6 +34,7 @@ func QueryCmd(name string) *cobra.Command {
 		QueryGroupsByMemberCmd(),
 		QueryTallyResultCmd(),
 		QueryGroupsCmd(),
+		QueryVoteDelegationsByGroupCmd(),
 	)
 
 	return queryCmd
@@ -539,3 +540,45 @@ func QueryGroupsCmd() *cobra.Command {
 
 	return cmd
 }
+
+// QueryVoteDelegationsByGroupCmd creates a CLI command for Query/VoteDelegationsByGroup.
+func QueryVoteDelegationsByGroupCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-delegations-by-group [id]",
+		Short: "Query for the vote delegations of the members of a group with pagination flags",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := group.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VoteDelegationsByGroup(cmd.Context(), &group.QueryVoteDelegationsByGroupRequest{
+				GroupId:    groupID,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "vote-delegations-by-group")
+
+	return cmd
+}
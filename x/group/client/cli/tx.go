@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -18,6 +19,7 @@ const (
 	FlagExec               = "exec"
 	ExecTry                = "try"
 	FlagGroupPolicyAsAdmin = "group-policy-as-admin"
+	FlagExpiration         = "expiration"
 )
 
 // TxCmd returns a root CLI command handler for all x/group transaction commands.
@@ -45,6 +47,8 @@ func TxCmd(name string) *cobra.Command {
 		MsgVoteCmd(),
 		MsgExecCmd(),
 		MsgLeaveGroupCmd(),
+		MsgDelegateGroupVoteCmd(),
+		MsgUndelegateGroupVoteCmd(),
 		NewCmdDraftProposal(),
 	)
 
@@ -379,6 +383,18 @@ Here, we can use percentage decision policy when needed, where 0 < percentage <=
         "voting_period": "120h",
         "min_execution_period": "0s"
     }
+}
+
+or a quorum threshold decision policy, where 0 < quorum, threshold <= 1:
+
+{
+    "@type": "/cosmos.group.v1.QuorumThresholdDecisionPolicy",
+    "quorum": "0.4",
+    "threshold": "0.5",
+    "windows": {
+        "voting_period": "120h",
+        "min_execution_period": "0s"
+    }
 }`, version.AppName),
 		Args: cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -815,3 +831,104 @@ Parameters:
 
 	return cmd
 }
+
+// MsgDelegateGroupVoteCmd creates a CLI command for Msg/DelegateGroupVote.
+func MsgDelegateGroupVoteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate-vote [delegator] [group-id] [delegate]",
+		Short: "Delegate the vote of a group member to another member of the group",
+		Long: `Delegate the vote of a group member to another member of the group.
+The weight of the delegator is added to the vote of the delegate on the
+proposals the delegator doesn't vote on, until the delegation is revoked or
+expires.
+
+Parameters:
+		   delegator: account address of the group member delegating its vote
+		   group-id: unique id of the group
+		   delegate: account address of the group member voting on behalf of the delegator
+		   Note, the '--from' flag is ignored as it is implied from [delegator]
+		`,
+		Example: fmt.Sprintf("%s tx group delegate-vote [delegator] 1 [delegate] --expiration 2030-01-01T00:00:00Z", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			var expiration *time.Time
+			if exp, _ := cmd.Flags().GetString(FlagExpiration); exp != "" {
+				expirationTime, err := time.Parse(time.RFC3339, exp)
+				if err != nil {
+					return err
+				}
+				expiration = &expirationTime
+			}
+
+			msg := &group.MsgDelegateGroupVote{
+				Delegator:  clientCtx.GetFromAddress().String(),
+				GroupId:    groupID,
+				Delegate:   args[2],
+				Expiration: expiration,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagExpiration, "", "The RFC 3339 time after which the delegation is no longer taken into account")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// MsgUndelegateGroupVoteCmd creates a CLI command for Msg/UndelegateGroupVote.
+func MsgUndelegateGroupVoteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "undelegate-vote [delegator] [group-id]",
+		Short: "Revoke the vote delegation of a group member",
+		Long: `Revoke the vote delegation of a group member
+
+Parameters:
+		   delegator: account address of the group member revoking its vote delegation
+		   group-id: unique id of the group
+		   Note, the '--from' flag is ignored as it is implied from [delegator]
+		`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &group.MsgUndelegateGroupVote{
+				Delegator: clientCtx.GetFromAddress().String(),
+				GroupId:   groupID,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
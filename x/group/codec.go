@@ -1,6 +1,8 @@
 package group
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/legacy"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -17,6 +19,10 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterInterface((*DecisionPolicy)(nil), nil)
 	cdc.RegisterConcrete(&ThresholdDecisionPolicy{}, "cosmos-sdk/ThresholdDecisionPolicy", nil)
 	cdc.RegisterConcrete(&PercentageDecisionPolicy{}, "cosmos-sdk/PercentageDecisionPolicy", nil)
+	cdc.RegisterConcrete(&QuorumThresholdDecisionPolicy{}, "cosmos-sdk/QuorumThresholdDecisionPolicy", nil)
+	for _, p := range customDecisionPolicies {
+		cdc.RegisterConcrete(p.policy, p.aminoName, nil)
+	}
 
 	legacy.RegisterAminoMsg(cdc, &MsgCreateGroup{}, "cosmos-sdk/MsgCreateGroup")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateGroupMembers{}, "cosmos-sdk/MsgUpdateGroupMembers")
@@ -32,6 +38,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgVote{}, "cosmos-sdk/group/MsgVote")
 	legacy.RegisterAminoMsg(cdc, &MsgExec{}, "cosmos-sdk/group/MsgExec")
 	legacy.RegisterAminoMsg(cdc, &MsgLeaveGroup{}, "cosmos-sdk/group/MsgLeaveGroup")
+	legacy.RegisterAminoMsg(cdc, &MsgDelegateGroupVote{}, "cosmos-sdk/group/MsgDelegateGroupVote")
+	legacy.RegisterAminoMsg(cdc, &MsgUndelegateGroupVote{}, "cosmos-sdk/group/MsgUndelegateGroupVote")
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -50,6 +58,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgVote{},
 		&MsgExec{},
 		&MsgLeaveGroup{},
+		&MsgDelegateGroupVote{},
+		&MsgUndelegateGroupVote{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -59,7 +69,39 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		(*DecisionPolicy)(nil),
 		&ThresholdDecisionPolicy{},
 		&PercentageDecisionPolicy{},
+		&QuorumThresholdDecisionPolicy{},
 	)
+	for _, p := range customDecisionPolicies {
+		registry.RegisterImplementations((*DecisionPolicy)(nil), p.policy)
+	}
+}
+
+// customDecisionPolicy is a DecisionPolicy implementation registered by an
+// app with RegisterDecisionPolicy.
+type customDecisionPolicy struct {
+	policy    DecisionPolicy
+	aminoName string
+}
+
+var customDecisionPolicies []customDecisionPolicy
+
+// RegisterDecisionPolicy registers a custom DecisionPolicy implementation,
+// allowing apps to use their own decision policies in group policies. The
+// policy is registered with the given name in the amino codecs used to sign
+// the group and authz messages, and in the interface registries set up with
+// RegisterInterfaces and RegisterLegacyAminoCodec afterwards. It must
+// therefore be called before the app codecs are built, for example in an init
+// function. It will panic if the amino name is already registered.
+func RegisterDecisionPolicy(policy DecisionPolicy, aminoName string) {
+	for _, p := range customDecisionPolicies {
+		if p.aminoName == aminoName {
+			panic(fmt.Sprintf("already registered decision policy: %s", aminoName))
+		}
+	}
+
+	customDecisionPolicies = append(customDecisionPolicies, customDecisionPolicy{policy, aminoName})
+	amino.RegisterConcrete(policy, aminoName, nil)
+	authzcodec.Amino.RegisterConcrete(policy, aminoName, nil)
 }
 
 var (
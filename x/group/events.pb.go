@@ -528,6 +528,125 @@ func (m *EventProposalPruned) GetTallyResult() *TallyResult {
 	return nil
 }
 
+// EventDelegateGroupVote is an event emitted when a group member delegates its vote.
+type EventDelegateGroupVote struct {
+	// group_id is the unique ID of the group.
+	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// delegator is the account address of the group member delegating its vote.
+	Delegator string `protobuf:"bytes,2,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// delegate is the account address of the group member voting on behalf of the delegator.
+	Delegate string `protobuf:"bytes,3,opt,name=delegate,proto3" json:"delegate,omitempty"`
+}
+
+func (m *EventDelegateGroupVote) Reset()         { *m = EventDelegateGroupVote{} }
+func (m *EventDelegateGroupVote) String() string { return proto.CompactTextString(m) }
+func (*EventDelegateGroupVote) ProtoMessage()    {}
+func (*EventDelegateGroupVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8d753981546f032, []int{10}
+}
+func (m *EventDelegateGroupVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDelegateGroupVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDelegateGroupVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDelegateGroupVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDelegateGroupVote.Merge(m, src)
+}
+func (m *EventDelegateGroupVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDelegateGroupVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDelegateGroupVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDelegateGroupVote proto.InternalMessageInfo
+
+func (m *EventDelegateGroupVote) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+func (m *EventDelegateGroupVote) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *EventDelegateGroupVote) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
+// EventUndelegateGroupVote is an event emitted when a group member revokes its vote delegation.
+type EventUndelegateGroupVote struct {
+	// group_id is the unique ID of the group.
+	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// delegator is the account address of the group member revoking its vote delegation.
+	Delegator string `protobuf:"bytes,2,opt,name=delegator,proto3" json:"delegator,omitempty"`
+}
+
+func (m *EventUndelegateGroupVote) Reset()         { *m = EventUndelegateGroupVote{} }
+func (m *EventUndelegateGroupVote) String() string { return proto.CompactTextString(m) }
+func (*EventUndelegateGroupVote) ProtoMessage()    {}
+func (*EventUndelegateGroupVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8d753981546f032, []int{11}
+}
+func (m *EventUndelegateGroupVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUndelegateGroupVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUndelegateGroupVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUndelegateGroupVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUndelegateGroupVote.Merge(m, src)
+}
+func (m *EventUndelegateGroupVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUndelegateGroupVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUndelegateGroupVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUndelegateGroupVote proto.InternalMessageInfo
+
+func (m *EventUndelegateGroupVote) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+func (m *EventUndelegateGroupVote) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventCreateGroup)(nil), "cosmos.group.v1.EventCreateGroup")
 	proto.RegisterType((*EventUpdateGroup)(nil), "cosmos.group.v1.EventUpdateGroup")
@@ -539,40 +658,45 @@ func init() {
 	proto.RegisterType((*EventExec)(nil), "cosmos.group.v1.EventExec")
 	proto.RegisterType((*EventLeaveGroup)(nil), "cosmos.group.v1.EventLeaveGroup")
 	proto.RegisterType((*EventProposalPruned)(nil), "cosmos.group.v1.EventProposalPruned")
+	proto.RegisterType((*EventDelegateGroupVote)(nil), "cosmos.group.v1.EventDelegateGroupVote")
+	proto.RegisterType((*EventUndelegateGroupVote)(nil), "cosmos.group.v1.EventUndelegateGroupVote")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/events.proto", fileDescriptor_e8d753981546f032) }
 
 var fileDescriptor_e8d753981546f032 = []byte{
-	// 442 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x4f, 0xef, 0xd2, 0x30,
-	0x18, 0xc7, 0xe9, 0x4f, 0x02, 0x52, 0x8c, 0x98, 0xfa, 0x27, 0x03, 0xc9, 0x20, 0xc4, 0x44, 0x0e,
-	0xb2, 0x05, 0x4c, 0xd4, 0x93, 0x44, 0x0c, 0x31, 0x24, 0x1c, 0xc8, 0xf0, 0x4f, 0xe2, 0x05, 0xc7,
-	0xda, 0x8c, 0xc5, 0x41, 0x97, 0xb6, 0x9b, 0x70, 0xf4, 0x1d, 0xf8, 0x52, 0x3c, 0xf8, 0x22, 0x3c,
-	0x12, 0x4f, 0x1e, 0x0d, 0xbc, 0x11, 0xb3, 0xae, 0x03, 0x82, 0x31, 0x23, 0xf9, 0x9d, 0x68, 0xfb,
-	0xfd, 0x7c, 0xbf, 0x3c, 0x4f, 0x9f, 0x15, 0xd6, 0x1d, 0xca, 0x97, 0x94, 0x9b, 0x2e, 0xa3, 0x61,
-	0x60, 0x46, 0x5d, 0x93, 0x44, 0x64, 0x25, 0xb8, 0x11, 0x30, 0x2a, 0x28, 0xaa, 0x24, 0xaa, 0x21,
-	0x55, 0x23, 0xea, 0xd6, 0xaa, 0xc9, 0xc1, 0x4c, 0xca, 0xa6, 0x52, 0xe5, 0xa6, 0xf6, 0xf0, 0x3c,
-	0x49, 0x6c, 0x02, 0xa2, 0xc4, 0x56, 0x07, 0xde, 0x19, 0xc6, 0xc1, 0xaf, 0x19, 0xb1, 0x05, 0x79,
-	0x13, 0x23, 0xa8, 0x0a, 0x6f, 0x4a, 0x76, 0xe6, 0x61, 0x0d, 0x34, 0x41, 0x3b, 0x6f, 0x15, 0xe5,
-	0x7e, 0x84, 0x0f, 0xf8, 0xbb, 0x00, 0x5f, 0x82, 0x8f, 0xe1, 0x83, 0xf3, 0xf4, 0x09, 0xf5, 0x3d,
-	0x67, 0x83, 0x7a, 0xb0, 0x68, 0x63, 0xcc, 0x08, 0xe7, 0xd2, 0x53, 0x1a, 0x68, 0xbf, 0x7e, 0x74,
-	0xee, 0xa9, 0xba, 0x5f, 0x25, 0xca, 0x54, 0x30, 0x6f, 0xe5, 0x5a, 0x29, 0x78, 0x48, 0x3b, 0xf9,
-	0xf3, 0x6b, 0xa4, 0x3d, 0x83, 0x77, 0x65, 0xda, 0x34, 0x9c, 0x2f, 0x3d, 0x31, 0x61, 0x34, 0xa0,
-	0xdc, 0xf6, 0x51, 0x03, 0x96, 0x03, 0xb5, 0x3e, 0x36, 0x04, 0xd3, 0xa3, 0x11, 0x6e, 0xbd, 0x80,
-	0xf7, 0xa5, 0xef, 0x83, 0x27, 0x16, 0x98, 0xd9, 0x5f, 0x2e, 0x77, 0x3e, 0x81, 0x25, 0xe9, 0x7c,
-	0x4f, 0x05, 0xc9, 0xa6, 0xbf, 0x02, 0x85, 0x0f, 0xd7, 0xc4, 0xc9, 0xc4, 0x51, 0x1f, 0x16, 0x18,
-	0xe1, 0xa1, 0x2f, 0xb4, 0xab, 0x26, 0x68, 0xdf, 0xee, 0x3d, 0x36, 0xce, 0x3e, 0x11, 0x23, 0x2d,
-	0x34, 0xce, 0x0b, 0x05, 0x65, 0x96, 0xc4, 0x2d, 0x65, 0x43, 0x08, 0xe6, 0x7d, 0xea, 0x72, 0xed,
-	0x46, 0x7c, 0x81, 0x96, 0x5c, 0xb7, 0x3e, 0xc1, 0x8a, 0x2c, 0x61, 0x4c, 0xec, 0x28, 0x73, 0xda,
-	0xa7, 0x53, 0xb8, 0xba, 0x74, 0x0a, 0xdf, 0x81, 0x1a, 0x43, 0x5a, 0xdd, 0x84, 0x85, 0x2b, 0x82,
-	0xb3, 0xfb, 0x7d, 0x0e, 0x0b, 0x5c, 0xd8, 0x22, 0xe4, 0xaa, 0xdf, 0xc6, 0x7f, 0xfb, 0x9d, 0x4a,
-	0xcc, 0x52, 0x38, 0xea, 0xc3, 0x5b, 0xc2, 0xf6, 0xfd, 0xcd, 0x4c, 0x5d, 0x57, 0xdc, 0x6f, 0xb9,
-	0x57, 0xff, 0xc7, 0xfe, 0x36, 0x86, 0xd4, 0x1d, 0x95, 0xc5, 0x71, 0x33, 0x78, 0xf9, 0x73, 0xa7,
-	0x83, 0xed, 0x4e, 0x07, 0x7f, 0x76, 0x3a, 0xf8, 0xb6, 0xd7, 0x73, 0xdb, 0xbd, 0x9e, 0xfb, 0xbd,
-	0xd7, 0x73, 0x1f, 0x1f, 0xb9, 0x9e, 0x58, 0x84, 0x73, 0xc3, 0xa1, 0x4b, 0xf5, 0x04, 0xd5, 0x4f,
-	0x87, 0xe3, 0xcf, 0xe6, 0x3a, 0x79, 0x81, 0xf3, 0x82, 0x7c, 0x79, 0x4f, 0xff, 0x06, 0x00, 0x00,
-	0xff, 0xff, 0xa5, 0x1a, 0x1c, 0xb9, 0xe2, 0x03, 0x00, 0x00,
+	// 493 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0x86, 0x33, 0x6d, 0x95, 0x36, 0x27, 0x88, 0xa2, 0xe1, 0x22, 0xb7, 0x54, 0x6e, 0x65, 0x21,
+	0xd1, 0x05, 0xb1, 0xd5, 0x80, 0x0a, 0x2b, 0x2a, 0x0a, 0x15, 0xaa, 0xd4, 0x45, 0xe4, 0x70, 0x91,
+	0xd8, 0x04, 0xc7, 0x33, 0x72, 0x2d, 0x6c, 0x8f, 0x35, 0x33, 0x36, 0xcd, 0x92, 0x37, 0xe0, 0x15,
+	0x78, 0x03, 0x16, 0x3c, 0x04, 0xcb, 0x8a, 0x15, 0x4b, 0x94, 0xbc, 0x08, 0xf2, 0x78, 0x9c, 0x44,
+	0x41, 0xe0, 0x48, 0x48, 0x5d, 0xc5, 0xc7, 0xe7, 0xfb, 0xff, 0x9c, 0xcb, 0x78, 0x60, 0xc7, 0x67,
+	0x22, 0x66, 0xc2, 0x09, 0x38, 0xcb, 0x52, 0x27, 0x3f, 0x70, 0x68, 0x4e, 0x13, 0x29, 0xec, 0x94,
+	0x33, 0xc9, 0xf0, 0x66, 0x99, 0xb5, 0x55, 0xd6, 0xce, 0x0f, 0xb6, 0xb7, 0xca, 0x17, 0x03, 0x95,
+	0x76, 0x74, 0x56, 0x05, 0xdb, 0x77, 0x17, 0x9d, 0xe4, 0x28, 0xa5, 0x3a, 0x69, 0x75, 0xe0, 0xc6,
+	0x49, 0x61, 0xfc, 0x9c, 0x53, 0x4f, 0xd2, 0x97, 0x05, 0x82, 0xb7, 0x60, 0x43, 0xb1, 0x83, 0x90,
+	0x18, 0x68, 0x0f, 0xed, 0xaf, 0xb9, 0xeb, 0x2a, 0x3e, 0x25, 0x53, 0xfc, 0x75, 0x4a, 0x96, 0xc1,
+	0xcf, 0xe0, 0xce, 0xa2, 0x7b, 0x8f, 0x45, 0xa1, 0x3f, 0xc2, 0x5d, 0x58, 0xf7, 0x08, 0xe1, 0x54,
+	0x08, 0xa5, 0x69, 0x1d, 0x1b, 0x3f, 0xbe, 0x75, 0x6e, 0xe9, 0xba, 0x9f, 0x95, 0x99, 0xbe, 0xe4,
+	0x61, 0x12, 0xb8, 0x15, 0x38, 0x75, 0x9b, 0xfb, 0xf3, 0xff, 0x70, 0x3b, 0x84, 0x9b, 0xca, 0xad,
+	0x9f, 0x0d, 0xe3, 0x50, 0xf6, 0x38, 0x4b, 0x99, 0xf0, 0x22, 0xbc, 0x0b, 0xed, 0x54, 0x3f, 0xcf,
+	0x1a, 0x82, 0xea, 0xd5, 0x29, 0xb1, 0x9e, 0xc0, 0x6d, 0xa5, 0x7b, 0x1b, 0xca, 0x73, 0xc2, 0xbd,
+	0x8f, 0xcb, 0x2b, 0x1f, 0x40, 0x4b, 0x29, 0xdf, 0x30, 0x49, 0xeb, 0xe9, 0x4f, 0x48, 0xe3, 0x27,
+	0x17, 0xd4, 0xaf, 0xc5, 0xf1, 0x11, 0x34, 0x39, 0x15, 0x59, 0x24, 0x8d, 0x95, 0x3d, 0xb4, 0x7f,
+	0xbd, 0x7b, 0xdf, 0x5e, 0x38, 0x22, 0x76, 0x55, 0x68, 0xe1, 0x97, 0x49, 0xc6, 0x5d, 0x85, 0xbb,
+	0x5a, 0x86, 0x31, 0xac, 0x45, 0x2c, 0x10, 0xc6, 0x6a, 0x31, 0x40, 0x57, 0x3d, 0x5b, 0xef, 0x61,
+	0x53, 0x95, 0x70, 0x46, 0xbd, 0xbc, 0x76, 0xdb, 0xf3, 0x5b, 0x58, 0x59, 0x76, 0x0b, 0x5f, 0x91,
+	0x5e, 0x43, 0x55, 0x5d, 0x8f, 0x67, 0x09, 0x25, 0xf5, 0xfd, 0x3e, 0x86, 0xa6, 0x90, 0x9e, 0xcc,
+	0x84, 0xee, 0x77, 0xf7, 0xaf, 0xfd, 0xf6, 0x15, 0xe6, 0x6a, 0x1c, 0x1f, 0xc1, 0x35, 0xe9, 0x45,
+	0xd1, 0x68, 0xa0, 0xc7, 0x55, 0xf4, 0xdb, 0xee, 0xee, 0xfc, 0x21, 0x7f, 0x55, 0x40, 0x7a, 0x46,
+	0x6d, 0x39, 0x0b, 0xac, 0x2f, 0x48, 0x9f, 0xc3, 0x17, 0x34, 0xa2, 0x41, 0x75, 0x12, 0xd5, 0x52,
+	0xff, 0x31, 0x9c, 0x43, 0x68, 0x91, 0x92, 0x67, 0xbc, 0x76, 0x3c, 0x33, 0x14, 0x3f, 0x82, 0x0d,
+	0x1d, 0x50, 0x63, 0xb5, 0x46, 0x36, 0x25, 0xad, 0x18, 0x8c, 0xf2, 0x53, 0x49, 0xc8, 0x15, 0x14,
+	0x79, 0xfc, 0xf4, 0xfb, 0xd8, 0x44, 0x97, 0x63, 0x13, 0xfd, 0x1a, 0x9b, 0xe8, 0xf3, 0xc4, 0x6c,
+	0x5c, 0x4e, 0xcc, 0xc6, 0xcf, 0x89, 0xd9, 0x78, 0x77, 0x2f, 0x08, 0xe5, 0x79, 0x36, 0xb4, 0x7d,
+	0x16, 0xeb, 0x5b, 0x49, 0xff, 0x74, 0x04, 0xf9, 0xe0, 0x5c, 0x94, 0x97, 0xd2, 0xb0, 0xa9, 0x2e,
+	0xa3, 0x87, 0xbf, 0x07, 0x00, 0x88, 0x34, 0x21, 0xe2, 0xf5, 0x04, 0x00, 0x00,
 }
 
 func (m *EventCreateGroup) Marshal() (dAtA []byte, err error) {
@@ -895,6 +1019,83 @@ func (m *EventProposalPruned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventDelegateGroupVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDelegateGroupVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDelegateGroupVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUndelegateGroupVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUndelegateGroupVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUndelegateGroupVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -1046,6 +1247,42 @@ func (m *EventProposalPruned) Size() (n int) {
 	return n
 }
 
+func (m *EventDelegateGroupVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovEvents(uint64(m.GroupId))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventUndelegateGroupVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovEvents(uint64(m.GroupId))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1906,6 +2143,240 @@ func (m *EventProposalPruned) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventDelegateGroupVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDelegateGroupVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDelegateGroupVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUndelegateGroupVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUndelegateGroupVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUndelegateGroupVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -16,6 +16,7 @@ func (s GenesisState) Validate() error {
 	groups := make(map[uint64]GroupInfo)
 	groupPolicies := make(map[string]GroupPolicyInfo)
 	groupMembers := make(map[uint64]GroupMember)
+	members := make(map[string]bool)
 	proposals := make(map[uint64]Proposal)
 
 	for _, g := range s.Groups {
@@ -49,6 +50,7 @@ func (s GenesisState) Validate() error {
 			return sdkerrors.Wrap(err, "GroupMember validation failed")
 		}
 		groupMembers[g.GroupId] = *g
+		members[fmt.Sprintf("%d/%s", g.GroupId, g.Member.Address)] = true
 	}
 
 	for _, p := range s.Proposals {
@@ -75,6 +77,33 @@ func (s GenesisState) Validate() error {
 			return sdkerrors.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("proposal with ProposalId %d doesn't exist", v.ProposalId))
 		}
 	}
+
+	delegates := make(map[string]string)
+	for _, d := range s.VoteDelegations {
+		if err := d.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "VoteDelegation validation failed")
+		}
+
+		// check that the delegator and the delegate are group members
+		if !members[fmt.Sprintf("%d/%s", d.GroupId, d.Delegator)] {
+			return sdkerrors.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("delegator %s is not a member of group %d", d.Delegator, d.GroupId))
+		}
+		if !members[fmt.Sprintf("%d/%s", d.GroupId, d.Delegate)] {
+			return sdkerrors.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("delegate %s is not a member of group %d", d.Delegate, d.GroupId))
+		}
+		delegates[fmt.Sprintf("%d/%s", d.GroupId, d.Delegator)] = d.Delegate
+	}
+
+	// check that the vote delegations don't loop
+	for _, d := range s.VoteDelegations {
+		visited := make(map[string]bool)
+		for next, ok := d.Delegate, true; ok && !visited[next]; next, ok = delegates[fmt.Sprintf("%d/%s", d.GroupId, next)] {
+			if next == d.Delegator {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("vote delegation from %s to %s in group %d creates a cycle", d.Delegator, d.Delegate, d.GroupId))
+			}
+			visited[next] = true
+		}
+	}
 	return nil
 }
 
@@ -79,6 +79,13 @@ func TestGenesisStateValidate(t *testing.T) {
 	}})
 	require.NoError(t, err)
 
+	memberAddr2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	delegationMembers := []*GroupMember{
+		{GroupId: 1, Member: &Member{Address: memberAddr.String(), Weight: "1"}},
+		{GroupId: 1, Member: &Member{Address: accAddr.String(), Weight: "1"}},
+		{GroupId: 1, Member: &Member{Address: memberAddr2.String(), Weight: "1"}},
+	}
+
 	testCases := []struct {
 		name         string
 		genesisState GenesisState
@@ -732,6 +739,42 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid vote delegations",
+			GenesisState{
+				Groups:       []*GroupInfo{{Id: 1, Admin: accAddr.String(), Metadata: "1", Version: 1, TotalWeight: "3"}},
+				GroupMembers: delegationMembers,
+				VoteDelegations: []*VoteDelegation{
+					{GroupId: 1, Delegator: memberAddr.String(), Delegate: accAddr.String()},
+					{GroupId: 1, Delegator: accAddr.String(), Delegate: memberAddr2.String()},
+				},
+			},
+			false,
+		},
+		{
+			"vote delegate not a group member",
+			GenesisState{
+				Groups:       []*GroupInfo{{Id: 1, Admin: accAddr.String(), Metadata: "1", Version: 1, TotalWeight: "3"}},
+				GroupMembers: delegationMembers,
+				VoteDelegations: []*VoteDelegation{
+					{GroupId: 1, Delegator: memberAddr.String(), Delegate: sdk.AccAddress("non_member__________").String()},
+				},
+			},
+			true,
+		},
+		{
+			"vote delegation cycle",
+			GenesisState{
+				Groups:       []*GroupInfo{{Id: 1, Admin: accAddr.String(), Metadata: "1", Version: 1, TotalWeight: "3"}},
+				GroupMembers: delegationMembers,
+				VoteDelegations: []*VoteDelegation{
+					{GroupId: 1, Delegator: memberAddr.String(), Delegate: accAddr.String()},
+					{GroupId: 1, Delegator: accAddr.String(), Delegate: memberAddr2.String()},
+					{GroupId: 1, Delegator: memberAddr2.String(), Delegate: memberAddr.String()},
+				},
+			},
+			true,
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -39,6 +39,10 @@ func (k Keeper) InitGenesis(ctx types.Context, cdc codec.JSONCodec, data json.Ra
 		panic(errors.Wrap(err, "votes"))
 	}
 
+	if err := k.voteDelegationTable.Import(ctx.KVStore(k.key), genesisState.VoteDelegations, 0); err != nil {
+		panic(errors.Wrap(err, "vote delegations"))
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -85,5 +89,12 @@ func (k Keeper) ExportGenesis(ctx types.Context, _ codec.JSONCodec) *group.Genes
 	}
 	genesisState.Votes = votes
 
+	var voteDelegations []*group.VoteDelegation
+	_, err = k.voteDelegationTable.Export(ctx.KVStore(k.key), &voteDelegations)
+	if err != nil {
+		panic(errors.Wrap(err, "vote delegations"))
+	}
+	genesisState.VoteDelegations = voteDelegations
+
 	return genesisState
 }
@@ -369,3 +369,25 @@ func (k Keeper) Groups(goCtx context.Context, request *group.QueryGroupsRequest)
 		Pagination: pageRes,
 	}, nil
 }
+
+// VoteDelegationsByGroup queries the vote delegations of the members of a group.
+func (k Keeper) VoteDelegationsByGroup(goCtx context.Context, request *group.QueryVoteDelegationsByGroupRequest) (*group.QueryVoteDelegationsByGroupResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	it, err := k.voteDelegationByGroupIndex.GetPaginated(ctx.KVStore(k.key), request.GroupId, request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var delegations []*group.VoteDelegation
+	pageRes, err := orm.Paginate(it, request.Pagination, &delegations)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryVoteDelegationsByGroupResponse{
+		VoteDelegations: delegations,
+		Pagination:      pageRes,
+	}, nil
+}
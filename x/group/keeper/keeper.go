@@ -43,6 +43,10 @@ const (
 	VoteTablePrefix           byte = 0x40
 	VoteByProposalIndexPrefix byte = 0x41
 	VoteByVoterIndexPrefix    byte = 0x42
+
+	// Vote Delegation Table
+	VoteDelegationTablePrefix        byte = 0x50
+	VoteDelegationByGroupIndexPrefix byte = 0x51
 )
 
 type Keeper struct {
@@ -75,6 +79,10 @@ type Keeper struct {
 	voteByProposalIndex orm.Index
 	voteByVoterIndex    orm.Index
 
+	// Vote Delegation Table
+	voteDelegationTable        orm.PrimaryKeyTable
+	voteDelegationByGroupIndex orm.Index
+
 	router *baseapp.MsgServiceRouter
 
 	config group.Config
@@ -202,6 +210,19 @@ func NewKeeper(storeKey storetypes.StoreKey, cdc codec.Codec, router *baseapp.Ms
 	}
 	k.voteTable = *voteTable
 
+	// Vote Delegation Table
+	voteDelegationTable, err := orm.NewPrimaryKeyTable([2]byte{VoteDelegationTablePrefix}, &group.VoteDelegation{}, cdc)
+	if err != nil {
+		panic(err.Error())
+	}
+	k.voteDelegationByGroupIndex, err = orm.NewIndex(voteDelegationTable, VoteDelegationByGroupIndexPrefix, func(value interface{}) ([]interface{}, error) {
+		return []interface{}{value.(*group.VoteDelegation).GroupId}, nil
+	}, group.VoteDelegation{}.GroupId)
+	if err != nil {
+		panic(err.Error())
+	}
+	k.voteDelegationTable = *voteDelegationTable
+
 	if config.MaxMetadataLen == 0 {
 		config.MaxMetadataLen = group.DefaultConfig().MaxMetadataLen
 	}
@@ -153,6 +153,9 @@ func (k Keeper) UpdateGroupMembers(goCtx context.Context, req *group.MsgUpdateGr
 				if err := k.groupMemberTable.Delete(ctx.KVStore(k.key), &groupMember); err != nil {
 					return sdkerrors.Wrap(err, "delete member")
 				}
+				if err := k.deleteVoteDelegations(ctx, req.GroupId, groupMember.Member.Address); err != nil {
+					return sdkerrors.Wrap(err, "delete vote delegations")
+				}
 				continue
 			}
 			// If group member already exists, handle update
@@ -680,7 +683,11 @@ func (k Keeper) doTallyAndUpdate(ctx sdk.Context, p *group.Proposal, electorate
 		return err
 	}
 
-	tallyResult, err := k.Tally(ctx, *p, policyInfo.GroupId)
+	// Until the end of the voting period, the delegated weight is undecided,
+	// as the delegators can still override it with their own vote, so only the
+	// explicit votes can make the result final early.
+	votingPeriodEnded := ctx.BlockTime().After(p.VotingPeriodEnd)
+	tallyResult, err := k.tally(ctx, *p, policyInfo.GroupId, votingPeriodEnded)
 	if err != nil {
 		return err
 	}
@@ -692,7 +699,7 @@ func (k Keeper) doTallyAndUpdate(ctx sdk.Context, p *group.Proposal, electorate
 
 	// If the result was final (i.e. enough votes to pass) or if the voting
 	// period ended, then we consider the proposal as final.
-	if isFinal := result.Final || ctx.BlockTime().After(p.VotingPeriodEnd); isFinal {
+	if isFinal := result.Final || votingPeriodEnded; isFinal {
 		if err := k.pruneVotes(ctx, p.Id); err != nil {
 			return err
 		}
@@ -846,6 +853,10 @@ func (k Keeper) LeaveGroup(goCtx context.Context, req *group.MsgLeaveGroup) (*gr
 		return nil, sdkerrors.Wrap(err, "group member")
 	}
 
+	if err := k.deleteVoteDelegations(ctx, req.GroupId, req.Address); err != nil {
+		return nil, sdkerrors.Wrap(err, "vote delegations")
+	}
+
 	// update group weight
 	groupInfo.TotalWeight = updatedWeight.String()
 	groupInfo.Version++
@@ -866,6 +877,110 @@ func (k Keeper) LeaveGroup(goCtx context.Context, req *group.MsgLeaveGroup) (*gr
 	return &group.MsgLeaveGroupResponse{}, nil
 }
 
+// DelegateGroupVote delegates the vote of a group member to another member of
+// the group, replacing its previous delegation if any.
+func (k Keeper) DelegateGroupVote(goCtx context.Context, req *group.MsgDelegateGroupVote) (*group.MsgDelegateGroupVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.getGroupInfo(ctx, req.GroupId); err != nil {
+		return nil, sdkerrors.Wrap(err, "group")
+	}
+
+	for _, addr := range []string{req.Delegator, req.Delegate} {
+		if _, err := k.getGroupMember(ctx, &group.GroupMember{
+			GroupId: req.GroupId,
+			Member:  &group.Member{Address: addr},
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.Expiration != nil && !req.Expiration.After(ctx.BlockTime()) {
+		return nil, sdkerrors.Wrap(errors.ErrExpired, "vote delegation expiration")
+	}
+
+	// Reject delegations whose chain would loop back to the delegator. Expired
+	// delegations are kept in state until revoked, so they are followed too.
+	delegations, err := k.voteDelegations(ctx, req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	delegates := make(map[string]string, len(delegations))
+	for _, d := range delegations {
+		delegates[d.Delegator] = d.Delegate
+	}
+	visited := make(map[string]bool)
+	for next, ok := req.Delegate, true; ok && !visited[next]; next, ok = delegates[next] {
+		if next == req.Delegator {
+			return nil, sdkerrors.Wrapf(errors.ErrInvalid, "vote delegation from %s to %s creates a cycle", req.Delegator, req.Delegate)
+		}
+		visited[next] = true
+	}
+
+	delegation := group.VoteDelegation{
+		GroupId:    req.GroupId,
+		Delegator:  req.Delegator,
+		Delegate:   req.Delegate,
+		Expiration: req.Expiration,
+	}
+	if err := k.voteDelegationTable.Set(ctx.KVStore(k.key), &delegation); err != nil {
+		return nil, sdkerrors.Wrap(err, "vote delegation")
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&group.EventDelegateGroupVote{
+		GroupId:   req.GroupId,
+		Delegator: req.Delegator,
+		Delegate:  req.Delegate,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &group.MsgDelegateGroupVoteResponse{}, nil
+}
+
+// UndelegateGroupVote revokes the vote delegation of a group member.
+func (k Keeper) UndelegateGroupVote(goCtx context.Context, req *group.MsgUndelegateGroupVote) (*group.MsgUndelegateGroupVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegation := group.VoteDelegation{GroupId: req.GroupId, Delegator: req.Delegator}
+	if !k.voteDelegationTable.Contains(ctx.KVStore(k.key), &delegation) {
+		return nil, sdkerrors.ErrNotFound.Wrapf("no vote delegation of %s in group %d", req.Delegator, req.GroupId)
+	}
+
+	if err := k.voteDelegationTable.Delete(ctx.KVStore(k.key), &delegation); err != nil {
+		return nil, sdkerrors.Wrap(err, "vote delegation")
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&group.EventUndelegateGroupVote{
+		GroupId:   req.GroupId,
+		Delegator: req.Delegator,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &group.MsgUndelegateGroupVoteResponse{}, nil
+}
+
+// deleteVoteDelegations deletes the vote delegations from and to a member
+// leaving a group, if any.
+func (k Keeper) deleteVoteDelegations(ctx sdk.Context, groupID uint64, member string) error {
+	delegations, err := k.voteDelegations(ctx, groupID)
+	if err != nil {
+		return err
+	}
+
+	for _, d := range delegations {
+		if d.Delegator != member && d.Delegate != member {
+			continue
+		}
+		d := d
+		if err := k.voteDelegationTable.Delete(ctx.KVStore(k.key), &d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (k Keeper) getGroupMember(ctx sdk.Context, member *group.GroupMember) (*group.GroupMember, error) {
 	var groupMember group.GroupMember
 	switch err := k.groupMemberTable.GetOne(ctx.KVStore(k.key),
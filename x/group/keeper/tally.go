@@ -10,7 +10,17 @@ import (
 
 // Tally is a function that tallies a proposal by iterating through its votes,
 // and returns the tally result without modifying the proposal or any state.
+//
+// The weight of the members who didn't vote and delegated their vote is added
+// to the vote of the first member of their delegation chain who voted. A
+// member's own vote always overrides its delegation.
 func (k Keeper) Tally(ctx sdk.Context, p group.Proposal, groupID uint64) (group.TallyResult, error) {
+	return k.tally(ctx, p, groupID, true)
+}
+
+// tally tallies a proposal like Tally, only adding the delegated weight to the
+// tally result if withDelegations is true.
+func (k Keeper) tally(ctx sdk.Context, p group.Proposal, groupID uint64, withDelegations bool) (group.TallyResult, error) {
 	// If proposal has already been tallied and updated, then its status is
 	// accepted/rejected, in which case we just return the previously stored result.
 	//
@@ -27,6 +37,7 @@ func (k Keeper) Tally(ctx sdk.Context, p group.Proposal, groupID uint64) (group.
 	defer it.Close()
 
 	tallyResult := group.DefaultTallyResult()
+	votes := make(map[string]group.Vote)
 
 	for {
 		var vote group.Vote
@@ -57,7 +68,118 @@ func (k Keeper) Tally(ctx sdk.Context, p group.Proposal, groupID uint64) (group.
 		if err := tallyResult.Add(vote, member.Member.Weight); err != nil {
 			return group.TallyResult{}, sdkerrors.Wrap(err, "add new vote")
 		}
+		votes[vote.Voter] = vote
+	}
+
+	if !withDelegations {
+		return tallyResult, nil
+	}
+
+	delegations, err := k.activeVoteDelegations(ctx, groupID)
+	if err != nil {
+		return group.TallyResult{}, err
+	}
+
+	delegates := make(map[string]string, len(delegations))
+	for _, d := range delegations {
+		delegates[d.Delegator] = d.Delegate
+	}
+
+	for _, d := range delegations {
+		// An explicit vote overrides the delegation.
+		if _, voted := votes[d.Delegator]; voted {
+			continue
+		}
+
+		member, err := k.getGroupMember(ctx, &group.GroupMember{
+			GroupId: groupID,
+			Member:  &group.Member{Address: d.Delegator},
+		})
+		switch {
+		case sdkerrors.ErrNotFound.Is(err):
+			continue
+		case err != nil:
+			return group.TallyResult{}, err
+		}
+
+		vote, found := k.resolveDelegatedVote(ctx, groupID, d.Delegator, delegates, votes)
+		if !found {
+			continue
+		}
+
+		if err := tallyResult.Add(vote, member.Member.Weight); err != nil {
+			return group.TallyResult{}, sdkerrors.Wrap(err, "add delegated vote")
+		}
 	}
 
 	return tallyResult, nil
 }
+
+// resolveDelegatedVote follows the vote delegation chain starting at the
+// given delegator, and returns the vote of the first member of the chain who
+// voted. It returns false if the chain ends without a vote, goes through an
+// account which is not a group member, or loops back on itself.
+func (k Keeper) resolveDelegatedVote(ctx sdk.Context, groupID uint64, delegator string, delegates map[string]string, votes map[string]group.Vote) (group.Vote, bool) {
+	visited := map[string]bool{delegator: true}
+	current := delegator
+	for {
+		delegate, ok := delegates[current]
+		if !ok || visited[delegate] {
+			return group.Vote{}, false
+		}
+		visited[delegate] = true
+
+		if vote, voted := votes[delegate]; voted {
+			return vote, true
+		}
+
+		if !k.groupMemberTable.Contains(ctx.KVStore(k.key), &group.GroupMember{
+			GroupId: groupID,
+			Member:  &group.Member{Address: delegate},
+		}) {
+			return group.Vote{}, false
+		}
+		current = delegate
+	}
+}
+
+// activeVoteDelegations returns the vote delegations of the members of a
+// group which are not expired.
+func (k Keeper) activeVoteDelegations(ctx sdk.Context, groupID uint64) ([]group.VoteDelegation, error) {
+	delegations, err := k.voteDelegations(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	active := make([]group.VoteDelegation, 0, len(delegations))
+	for _, d := range delegations {
+		if d.IsActive(ctx.BlockTime()) {
+			active = append(active, d)
+		}
+	}
+	return active, nil
+}
+
+// voteDelegations returns all the vote delegations of the members of a group,
+// including the expired ones.
+func (k Keeper) voteDelegations(ctx sdk.Context, groupID uint64) ([]group.VoteDelegation, error) {
+	it, err := k.voteDelegationByGroupIndex.Get(ctx.KVStore(k.key), groupID)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var delegations []group.VoteDelegation
+	for {
+		var d group.VoteDelegation
+		_, err = it.LoadNext(&d)
+		if errors.ErrORMIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		delegations = append(delegations, d)
+	}
+	return delegations, nil
+}
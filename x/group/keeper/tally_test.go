@@ -5,8 +5,10 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/group/errors"
 )
 
 func (s *TestSuite) TestTally() {
@@ -88,3 +90,158 @@ func (s *TestSuite) TestTally() {
 		})
 	}
 }
+
+func (s *TestSuite) TestTallyWithVoteDelegations() {
+	a, b, c, d := s.addrs[1], s.addrs[2], s.addrs[3], s.addrs[5]
+	members := []group.MemberRequest{
+		{Address: a.String(), Weight: "1"},
+		{Address: b.String(), Weight: "2"},
+		{Address: c.String(), Weight: "3"},
+		{Address: d.String(), Weight: "4"},
+	}
+	policy := group.NewThresholdDecisionPolicy("100", time.Hour, 0)
+	policyAddr, groupID := s.createGroupAndGroupPolicy(s.addrs[0], members, policy)
+
+	delegate := func(delegator, delegate sdk.AccAddress, expiration *time.Time) error {
+		_, err := s.keeper.DelegateGroupVote(s.ctx, &group.MsgDelegateGroupVote{
+			Delegator:  delegator.String(),
+			GroupId:    groupID,
+			Delegate:   delegate.String(),
+			Expiration: expiration,
+		})
+		return err
+	}
+	vote := func(proposalID uint64, voter sdk.AccAddress, option group.VoteOption) {
+		_, err := s.keeper.Vote(s.ctx, &group.MsgVote{ProposalId: proposalID, Voter: voter.String(), Option: option})
+		s.Require().NoError(err)
+	}
+	tally := func(proposalID uint64) group.TallyResult {
+		res, err := s.keeper.TallyResult(s.ctx, &group.QueryTallyResultRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		return res.Tally
+	}
+
+	// d -> a -> b -> c
+	s.Require().NoError(delegate(a, b, nil))
+	s.Require().NoError(delegate(b, c, nil))
+	s.Require().NoError(delegate(d, a, nil))
+
+	// the delegations can't loop, and the delegate must be a group member
+	s.Require().ErrorIs(delegate(c, d, nil), errors.ErrInvalid)
+	s.Require().ErrorIs(delegate(c, s.addrs[4], nil), sdkerrors.ErrNotFound)
+	past := s.blockTime.Add(-time.Second)
+	s.Require().ErrorIs(delegate(c, a, &past), errors.ErrExpired)
+
+	res, err := s.keeper.VoteDelegationsByGroup(s.ctx, &group.QueryVoteDelegationsByGroupRequest{GroupId: groupID})
+	s.Require().NoError(err)
+	s.Require().Len(res.VoteDelegations, 3)
+
+	proposalReq := &group.MsgSubmitProposal{GroupPolicyAddress: policyAddr, Proposers: []string{c.String()}}
+	proposalRes, err := s.keeper.SubmitProposal(s.ctx, proposalReq)
+	s.Require().NoError(err)
+	proposalID := proposalRes.ProposalId
+
+	// nobody voted
+	s.Require().Equal(group.DefaultTallyResult(), tally(proposalID))
+
+	// the whole chain follows c
+	vote(proposalID, c, group.VOTE_OPTION_YES)
+	s.Require().Equal("10", tally(proposalID).YesCount)
+
+	// b overrides its delegation, and a and d now follow b
+	vote(proposalID, b, group.VOTE_OPTION_NO)
+	result := tally(proposalID)
+	s.Require().Equal("3", result.YesCount)
+	s.Require().Equal("7", result.NoCount)
+
+	// the weight of a member who leaves the group is no longer delegated, and
+	// the delegations from and to the member are deleted
+	_, err = s.keeper.LeaveGroup(s.ctx, &group.MsgLeaveGroup{Address: a.String(), GroupId: groupID})
+	s.Require().NoError(err)
+	res, err = s.keeper.VoteDelegationsByGroup(s.ctx, &group.QueryVoteDelegationsByGroupRequest{GroupId: groupID})
+	s.Require().NoError(err)
+	s.Require().Len(res.VoteDelegations, 1)
+	result = tally(proposalID)
+	s.Require().Equal("3", result.YesCount)
+	s.Require().Equal("2", result.NoCount)
+
+	// d delegates to c again, until the delegation expires
+	expiration := s.blockTime.Add(time.Minute)
+	s.Require().NoError(delegate(d, c, &expiration))
+	s.Require().Equal("7", tally(proposalID).YesCount)
+
+	proposal, err := s.keeper.Proposal(s.ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	tallyRes, err := s.keeper.Tally(s.sdkCtx.WithBlockTime(expiration), *proposal.Proposal, groupID)
+	s.Require().NoError(err)
+	s.Require().Equal("3", tallyRes.YesCount)
+
+	// the expired delegation still prevents a cycle until it is revoked
+	_, err = s.keeper.DelegateGroupVote(sdk.WrapSDKContext(s.sdkCtx.WithBlockTime(expiration)), &group.MsgDelegateGroupVote{
+		Delegator: c.String(),
+		GroupId:   groupID,
+		Delegate:  d.String(),
+	})
+	s.Require().ErrorIs(err, errors.ErrInvalid)
+
+	_, err = s.keeper.UndelegateGroupVote(s.ctx, &group.MsgUndelegateGroupVote{Delegator: d.String(), GroupId: groupID})
+	s.Require().NoError(err)
+	s.Require().Equal("3", tally(proposalID).YesCount)
+
+	_, err = s.keeper.UndelegateGroupVote(s.ctx, &group.MsgUndelegateGroupVote{Delegator: d.String(), GroupId: groupID})
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+}
+
+func (s *TestSuite) TestVoteDelegationOverrideBeforeVotingPeriodEnd() {
+	a, b, c := s.addrs[1], s.addrs[2], s.addrs[3]
+	members := []group.MemberRequest{
+		{Address: a.String(), Weight: "60"},
+		{Address: b.String(), Weight: "1"},
+		{Address: c.String(), Weight: "39"},
+	}
+	policy := group.NewThresholdDecisionPolicy("60", time.Hour, 0)
+	policyAddr, groupID := s.createGroupAndGroupPolicy(s.addrs[0], members, policy)
+
+	_, err := s.keeper.DelegateGroupVote(s.ctx, &group.MsgDelegateGroupVote{
+		Delegator: a.String(),
+		GroupId:   groupID,
+		Delegate:  b.String(),
+	})
+	s.Require().NoError(err)
+
+	proposalRes, err := s.keeper.SubmitProposal(s.ctx, &group.MsgSubmitProposal{GroupPolicyAddress: policyAddr, Proposers: []string{c.String()}})
+	s.Require().NoError(err)
+	proposalID := proposalRes.ProposalId
+
+	getProposal := func(ctx sdk.Context) *group.Proposal {
+		res, err := s.keeper.Proposal(sdk.WrapSDKContext(ctx), &group.QueryProposalRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		return res.Proposal
+	}
+
+	// with a's delegated weight, b's vote reaches the threshold, but the
+	// proposal is not final as a can still override the delegation
+	_, err = s.keeper.Vote(s.ctx, &group.MsgVote{ProposalId: proposalID, Voter: b.String(), Option: group.VOTE_OPTION_YES, Exec: group.Exec_EXEC_TRY})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_STATUS_SUBMITTED, getProposal(s.sdkCtx).Status)
+
+	res, err := s.keeper.TallyResult(s.ctx, &group.QueryTallyResultRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal("61", res.Tally.YesCount)
+
+	_, err = s.keeper.Exec(s.ctx, &group.MsgExec{ProposalId: proposalID, Executor: b.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_STATUS_SUBMITTED, getProposal(s.sdkCtx).Status)
+
+	// a's own vote overrides the delegation
+	_, err = s.keeper.Vote(s.ctx, &group.MsgVote{ProposalId: proposalID, Voter: a.String(), Option: group.VOTE_OPTION_NO})
+	s.Require().NoError(err)
+
+	ctx := s.sdkCtx.WithBlockTime(getProposal(s.sdkCtx).VotingPeriodEnd.Add(time.Second))
+	s.Require().NoError(s.keeper.TallyProposalsAtVPEnd(ctx))
+
+	proposal := getProposal(ctx)
+	s.Require().Equal(group.PROPOSAL_STATUS_REJECTED, proposal.Status)
+	s.Require().Equal("1", proposal.FinalTallyResult.YesCount)
+	s.Require().Equal("60", proposal.FinalTallyResult.NoCount)
+}
@@ -798,6 +798,77 @@ func (m MsgLeaveGroup) ValidateBasic() error {
 	return nil
 }
 
+var _ sdk.Msg = &MsgDelegateGroupVote{}
+
+// Route Implements Msg
+func (m MsgDelegateGroupVote) Route() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Type Implements Msg
+func (m MsgDelegateGroupVote) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes Implements Msg
+func (m MsgDelegateGroupVote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgDelegateGroupVote
+func (m MsgDelegateGroupVote) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Delegator)
+
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgDelegateGroupVote) ValidateBasic() error {
+	if m.GroupId == 0 {
+		return sdkerrors.Wrap(errors.ErrEmpty, "group-id")
+	}
+
+	return VoteDelegation{
+		GroupId:    m.GroupId,
+		Delegator:  m.Delegator,
+		Delegate:   m.Delegate,
+		Expiration: m.Expiration,
+	}.ValidateBasic()
+}
+
+var _ sdk.Msg = &MsgUndelegateGroupVote{}
+
+// Route Implements Msg
+func (m MsgUndelegateGroupVote) Route() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Type Implements Msg
+func (m MsgUndelegateGroupVote) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes Implements Msg
+func (m MsgUndelegateGroupVote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUndelegateGroupVote
+func (m MsgUndelegateGroupVote) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(m.Delegator)
+
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgUndelegateGroupVote) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Delegator)
+	if err != nil {
+		return sdkerrors.Wrap(err, "delegator")
+	}
+
+	if m.GroupId == 0 {
+		return sdkerrors.Wrap(errors.ErrEmpty, "group-id")
+	}
+	return nil
+}
+
 // strictValidateMembers performs ValidateBasic on Members, but also checks
 // that all members weights are positive (whereas `Members{members}.ValidateBasic()`
 // only checks that they are non-negative.
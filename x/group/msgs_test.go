@@ -1165,3 +1165,75 @@ func TestMsgLeaveGroup(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgDelegateGroupVote(t *testing.T) {
+	testCases := []struct {
+		name   string
+		msg    *group.MsgDelegateGroupVote
+		expErr bool
+		errMsg string
+	}{
+		{
+			"group id is required",
+			&group.MsgDelegateGroupVote{
+				Delegator: member1.String(),
+				Delegate:  member2.String(),
+			},
+			true,
+			"group-id: value is empty",
+		},
+		{
+			"invalid delegator address",
+			&group.MsgDelegateGroupVote{
+				Delegator: "member",
+				GroupId:   1,
+				Delegate:  member2.String(),
+			},
+			true,
+			"delegator: decoding bech32 failed",
+		},
+		{
+			"invalid delegate address",
+			&group.MsgDelegateGroupVote{
+				Delegator: member1.String(),
+				GroupId:   1,
+				Delegate:  "member",
+			},
+			true,
+			"delegate: decoding bech32 failed",
+		},
+		{
+			"self delegation",
+			&group.MsgDelegateGroupVote{
+				Delegator: member1.String(),
+				GroupId:   1,
+				Delegate:  member1.String(),
+			},
+			true,
+			"delegator and delegate must be different",
+		},
+		{
+			"valid testcase",
+			&group.MsgDelegateGroupVote{
+				Delegator: member1.String(),
+				GroupId:   1,
+				Delegate:  member2.String(),
+			},
+			false,
+			"",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := tc.msg
+			err := msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, msg.Type(), sdk.MsgTypeURL(&group.MsgDelegateGroupVote{}))
+			}
+		})
+	}
+}
@@ -1485,6 +1485,116 @@ func (m *QueryGroupsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryVoteDelegationsByGroupRequest is the Query/VoteDelegationsByGroup request type.
+type QueryVoteDelegationsByGroupRequest struct {
+	// group_id is the unique ID of the group.
+	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVoteDelegationsByGroupRequest) Reset()         { *m = QueryVoteDelegationsByGroupRequest{} }
+func (m *QueryVoteDelegationsByGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteDelegationsByGroupRequest) ProtoMessage()    {}
+func (*QueryVoteDelegationsByGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{28}
+}
+func (m *QueryVoteDelegationsByGroupRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteDelegationsByGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteDelegationsByGroupRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVoteDelegationsByGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteDelegationsByGroupRequest.Merge(m, src)
+}
+func (m *QueryVoteDelegationsByGroupRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteDelegationsByGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteDelegationsByGroupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVoteDelegationsByGroupRequest proto.InternalMessageInfo
+
+func (m *QueryVoteDelegationsByGroupRequest) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+func (m *QueryVoteDelegationsByGroupRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryVoteDelegationsByGroupResponse is the Query/VoteDelegationsByGroup response type.
+type QueryVoteDelegationsByGroupResponse struct {
+	// vote_delegations are the vote delegations of the group members.
+	VoteDelegations []*VoteDelegation `protobuf:"bytes,1,rep,name=vote_delegations,json=voteDelegations,proto3" json:"vote_delegations,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVoteDelegationsByGroupResponse) Reset()         { *m = QueryVoteDelegationsByGroupResponse{} }
+func (m *QueryVoteDelegationsByGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteDelegationsByGroupResponse) ProtoMessage()    {}
+func (*QueryVoteDelegationsByGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{29}
+}
+func (m *QueryVoteDelegationsByGroupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteDelegationsByGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteDelegationsByGroupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVoteDelegationsByGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteDelegationsByGroupResponse.Merge(m, src)
+}
+func (m *QueryVoteDelegationsByGroupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteDelegationsByGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteDelegationsByGroupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVoteDelegationsByGroupResponse proto.InternalMessageInfo
+
+func (m *QueryVoteDelegationsByGroupResponse) GetVoteDelegations() []*VoteDelegation {
+	if m != nil {
+		return m.VoteDelegations
+	}
+	return nil
+}
+
+func (m *QueryVoteDelegationsByGroupResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryGroupInfoRequest)(nil), "cosmos.group.v1.QueryGroupInfoRequest")
 	proto.RegisterType((*QueryGroupInfoResponse)(nil), "cosmos.group.v1.QueryGroupInfoResponse")
@@ -1514,93 +1624,100 @@ func init() {
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "cosmos.group.v1.QueryTallyResultResponse")
 	proto.RegisterType((*QueryGroupsRequest)(nil), "cosmos.group.v1.QueryGroupsRequest")
 	proto.RegisterType((*QueryGroupsResponse)(nil), "cosmos.group.v1.QueryGroupsResponse")
+	proto.RegisterType((*QueryVoteDelegationsByGroupRequest)(nil), "cosmos.group.v1.QueryVoteDelegationsByGroupRequest")
+	proto.RegisterType((*QueryVoteDelegationsByGroupResponse)(nil), "cosmos.group.v1.QueryVoteDelegationsByGroupResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/query.proto", fileDescriptor_0fcf9f1d74302290) }
 
 var fileDescriptor_0fcf9f1d74302290 = []byte{
-	// 1292 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x98, 0x4b, 0x6f, 0x1b, 0x55,
-	0x14, 0xc7, 0x73, 0x4b, 0x9e, 0x27, 0x6d, 0x23, 0x6e, 0xd3, 0x36, 0x99, 0x46, 0x4e, 0x98, 0x96,
-	0xbc, 0x33, 0x13, 0x3b, 0x69, 0x5a, 0xf1, 0x54, 0x2d, 0x41, 0xc8, 0xa2, 0x28, 0x35, 0x11, 0x0b,
-	0x84, 0x14, 0x8d, 0xe3, 0x89, 0x19, 0x61, 0xcf, 0xb8, 0x9e, 0x49, 0x84, 0x15, 0x79, 0x83, 0x04,
-	0x0b, 0xc4, 0x02, 0x5a, 0x84, 0x4a, 0xc4, 0xa2, 0x0b, 0x24, 0xf8, 0x00, 0x20, 0x24, 0x76, 0xdd,
-	0x75, 0x59, 0xc1, 0x86, 0x15, 0x42, 0x09, 0xdf, 0x82, 0x0d, 0x9a, 0x7b, 0xcf, 0xb5, 0xe7, 0xed,
-	0x89, 0xb0, 0xc0, 0xab, 0x76, 0x66, 0xce, 0xb9, 0xe7, 0x37, 0xff, 0x73, 0xe6, 0xfa, 0x7f, 0x03,
-	0xd7, 0xf6, 0x2c, 0xbb, 0x6a, 0xd9, 0x6a, 0xb9, 0x6e, 0x1d, 0xd4, 0xd4, 0xc3, 0xac, 0x7a, 0xff,
-	0x40, 0xaf, 0x37, 0x94, 0x5a, 0xdd, 0x72, 0x2c, 0x3a, 0xc6, 0x1f, 0x2a, 0xec, 0xa1, 0x72, 0x98,
-	0x95, 0xc6, 0xcb, 0x56, 0xd9, 0x62, 0xcf, 0x54, 0xf7, 0x7f, 0x3c, 0x4c, 0x9a, 0x2a, 0x5b, 0x56,
-	0xb9, 0xa2, 0xab, 0x5a, 0xcd, 0x50, 0x35, 0xd3, 0xb4, 0x1c, 0xcd, 0x31, 0x2c, 0xd3, 0xc6, 0xa7,
-	0xa1, 0x0a, 0x4e, 0xa3, 0xa6, 0x8b, 0x87, 0x8b, 0xf8, 0xb0, 0xa8, 0xd9, 0x3a, 0x2f, 0xad, 0x1e,
-	0x66, 0x8b, 0xba, 0xa3, 0x65, 0xd5, 0x9a, 0x56, 0x36, 0x4c, 0xb6, 0x12, 0xc6, 0x4e, 0xf2, 0xd8,
-	0x5d, 0x5e, 0x1f, 0xd1, 0xd8, 0x85, 0x9c, 0x83, 0xcb, 0xf7, 0xdc, 0xe4, 0x4d, 0xb7, 0xc6, 0x96,
-	0xb9, 0x6f, 0x15, 0xf4, 0xfb, 0x07, 0xba, 0xed, 0xd0, 0x49, 0x18, 0x66, 0x75, 0x77, 0x8d, 0xd2,
-	0x04, 0x99, 0x21, 0xf3, 0xfd, 0x85, 0x21, 0x76, 0xbd, 0x55, 0x92, 0xdf, 0x82, 0x2b, 0xc1, 0x1c,
-	0xbb, 0x66, 0x99, 0xb6, 0x4e, 0x15, 0xe8, 0x37, 0xcc, 0x7d, 0x8b, 0x25, 0x8c, 0xe6, 0x24, 0x25,
-	0xa0, 0x82, 0xd2, 0xce, 0x60, 0x71, 0xf2, 0x3d, 0xb8, 0xd6, 0x5e, 0x69, 0xdb, 0xaa, 0x18, 0x7b,
-	0x0d, 0x2f, 0x43, 0x0e, 0x86, 0xb4, 0x52, 0xa9, 0xae, 0xdb, 0x36, 0x5b, 0x71, 0x24, 0x3f, 0xf1,
-	0xeb, 0x8f, 0x2b, 0xe3, 0xb8, 0xe8, 0x1d, 0xfe, 0xe4, 0x1d, 0xa7, 0x6e, 0x98, 0xe5, 0x82, 0x08,
-	0x94, 0x77, 0x60, 0x2a, 0x7a, 0x49, 0x44, 0x5c, 0xf7, 0x21, 0xce, 0x44, 0x23, 0x7a, 0xf2, 0x38,
-	0x68, 0x13, 0x26, 0xda, 0xab, 0xde, 0xd5, 0xab, 0x45, 0xbd, 0x6e, 0x77, 0x56, 0x8a, 0xbe, 0x09,
-	0xd0, 0x6e, 0xc6, 0xc4, 0x39, 0x56, 0x72, 0x56, 0x94, 0x74, 0x3b, 0xa7, 0xf0, 0xa1, 0xc1, 0xce,
-	0x29, 0xdb, 0x5a, 0x59, 0xc7, 0x65, 0x0b, 0x9e, 0x4c, 0xf9, 0x5b, 0x02, 0x93, 0x11, 0xf5, 0xf1,
-	0x95, 0x36, 0x60, 0xa8, 0xca, 0x6f, 0x4d, 0x90, 0x99, 0xe7, 0xe6, 0x47, 0x73, 0x53, 0xd1, 0x6f,
-	0xc5, 0xf3, 0x0a, 0x22, 0x98, 0x6e, 0x46, 0xd0, 0xcd, 0x75, 0xa4, 0xe3, 0x45, 0x7d, 0x78, 0x0f,
-	0x7d, 0x78, 0x76, 0xbe, 0x71, 0xa7, 0x54, 0x35, 0x4c, 0xa1, 0x8f, 0x02, 0x03, 0x9a, 0x7b, 0xdd,
-	0xb1, 0x87, 0x3c, 0xac, 0x6b, 0xa2, 0x7d, 0x43, 0x40, 0x8a, 0xa2, 0x42, 0xd5, 0x72, 0x30, 0xc8,
-	0xe4, 0x11, 0xa2, 0x25, 0x4d, 0x2b, 0x46, 0x76, 0x4f, 0xb1, 0x4f, 0x08, 0xcc, 0x04, 0xc6, 0xd4,
-	0xd0, 0xed, 0x3c, 0xbf, 0xfc, 0x0f, 0x07, 0xeb, 0x27, 0x02, 0x2f, 0x24, 0x70, 0xa0, 0x54, 0x9b,
-	0x70, 0x91, 0x83, 0xd4, 0x30, 0x00, 0x25, 0xeb, 0xfc, 0xf5, 0x5c, 0x28, 0x7b, 0xd7, 0xed, 0x9e,
-	0x7e, 0xc7, 0x31, 0xfa, 0xf5, 0xc4, 0xe0, 0xc5, 0x89, 0xea, 0x9f, 0xbf, 0xde, 0x13, 0xf5, 0x16,
-	0x8c, 0x33, 0xec, 0xed, 0xba, 0x55, 0xb3, 0x6c, 0xad, 0x22, 0x74, 0x9c, 0x86, 0xd1, 0x1a, 0xde,
-	0x6a, 0x8f, 0x22, 0x88, 0x5b, 0x5b, 0x25, 0xf9, 0x6d, 0xfc, 0x11, 0x69, 0x27, 0xe2, 0x3b, 0xde,
-	0x84, 0x61, 0x11, 0x86, 0x1b, 0xee, 0x64, 0xe8, 0xed, 0x5a, 0x49, 0xad, 0x50, 0xf9, 0x31, 0x01,
-	0xd9, 0xb7, 0xa0, 0x98, 0x48, 0x2e, 0xc2, 0xbf, 0xf8, 0x79, 0xe8, 0x5a, 0x8f, 0xbf, 0x27, 0x70,
-	0x3d, 0x11, 0x11, 0x15, 0xb8, 0x05, 0x23, 0xe2, 0xb5, 0x44, 0x83, 0x13, 0x24, 0x68, 0xc7, 0x76,
-	0xaf, 0xab, 0x75, 0x98, 0x66, 0xa0, 0xef, 0x5a, 0x8e, 0x9e, 0x6f, 0xe1, 0xba, 0x57, 0xf5, 0xb4,
-	0x0d, 0x76, 0xbf, 0xa4, 0x43, 0x37, 0x81, 0x71, 0x24, 0x7e, 0x49, 0x2c, 0x4c, 0xbe, 0x8b, 0x5f,
-	0x67, 0x64, 0x4d, 0x54, 0x66, 0x01, 0xfa, 0xdd, 0x60, 0x9c, 0x8b, 0xcb, 0x21, 0x51, 0xdc, 0xe8,
-	0x02, 0x0b, 0x91, 0x3f, 0x25, 0xe8, 0x13, 0xdc, 0x7b, 0x76, 0xfe, 0xcc, 0x03, 0xda, 0xb5, 0xae,
-	0x7f, 0x45, 0xd0, 0x5d, 0x84, 0x40, 0xf0, 0xa5, 0x96, 0xb8, 0x50, 0xa2, 0xd5, 0x31, 0x6f, 0xc5,
-	0x63, 0xba, 0xd7, 0xe2, 0x07, 0x04, 0xed, 0x09, 0x62, 0xf9, 0x9a, 0xdb, 0xea, 0x1d, 0x49, 0xd5,
-	0xbb, 0xae, 0x69, 0xf5, 0xa5, 0x30, 0x05, 0x7e, 0xa8, 0xff, 0x55, 0xa8, 0x47, 0x41, 0x4b, 0x80,
-	0x96, 0xa8, 0x07, 0x36, 0x94, 0x63, 0xe2, 0xf5, 0xc2, 0x1e, 0xb4, 0x5e, 0xb0, 0x2b, 0x2f, 0xc1,
-	0x55, 0xc6, 0xb6, 0xa3, 0x55, 0x2a, 0xee, 0xde, 0x76, 0x50, 0x71, 0x52, 0xff, 0x38, 0xec, 0xe0,
-	0x6c, 0xfa, 0x72, 0xf1, 0xa5, 0x6e, 0xc3, 0x80, 0xe3, 0xde, 0xc6, 0x4d, 0x20, 0xec, 0x5b, 0x3d,
-	0x49, 0xf9, 0xfe, 0xa7, 0x7f, 0x4c, 0xf7, 0x15, 0x78, 0x82, 0xfc, 0x3e, 0x50, 0x8f, 0x5a, 0x02,
-	0xa6, 0x5b, 0xcd, 0x78, 0x40, 0xe0, 0x92, 0x6f, 0xf9, 0x1e, 0x68, 0x42, 0xee, 0xef, 0xe7, 0x61,
-	0x80, 0x41, 0xd1, 0xcf, 0x09, 0x8c, 0xb4, 0x0a, 0xd1, 0xd9, 0x10, 0x44, 0xe4, 0x89, 0x4e, 0x9a,
-	0xeb, 0x18, 0xc7, 0x8b, 0xca, 0xca, 0xc7, 0xbf, 0xfd, 0xf5, 0xf0, 0xdc, 0x3c, 0x9d, 0x55, 0x83,
-	0x07, 0x50, 0xb4, 0xa3, 0xe6, 0xbe, 0xa5, 0x1e, 0x09, 0x6b, 0xda, 0xa4, 0xdf, 0x11, 0x18, 0x0b,
-	0x78, 0x14, 0xba, 0x9c, 0x50, 0x2c, 0x74, 0xd0, 0x93, 0x56, 0x52, 0x46, 0x23, 0xe0, 0x3a, 0x03,
-	0x54, 0xe8, 0x72, 0x0c, 0x20, 0x73, 0x54, 0x0d, 0xe4, 0xc4, 0x0f, 0xb5, 0x49, 0x1f, 0x11, 0x38,
-	0xef, 0x3d, 0x3f, 0xd1, 0x85, 0x84, 0xaa, 0xfe, 0x33, 0x9e, 0xb4, 0x98, 0x26, 0x14, 0xe9, 0xb2,
-	0x8c, 0x6e, 0x89, 0x2e, 0xc4, 0xd0, 0xe1, 0xf1, 0xcb, 0xab, 0xe0, 0x31, 0x81, 0x0b, 0xbe, 0x53,
-	0x0a, 0x4d, 0x2a, 0x18, 0xf0, 0xb9, 0xd2, 0x52, 0xaa, 0x58, 0xa4, 0x5b, 0x65, 0x74, 0x8b, 0x74,
-	0x3e, 0x9a, 0xce, 0xde, 0x2d, 0x36, 0x76, 0x99, 0x1d, 0x76, 0x95, 0xab, 0x1a, 0x66, 0x93, 0xfe,
-	0x42, 0x60, 0x3c, 0xea, 0x78, 0x40, 0xb3, 0x9d, 0xba, 0x16, 0x3a, 0xd2, 0x48, 0xb9, 0xb3, 0xa4,
-	0x20, 0xf1, 0xcb, 0x8c, 0xf8, 0x26, 0x5d, 0x4b, 0xea, 0xb6, 0xa1, 0x33, 0x72, 0xfe, 0xc8, 0xa3,
-	0xec, 0xcf, 0x61, 0x78, 0x2e, 0x70, 0x3a, 0x78, 0x9f, 0xce, 0xb9, 0xb3, 0xa4, 0x20, 0xfc, 0x6d,
-	0x06, 0x9f, 0xa3, 0xab, 0x29, 0xe0, 0xfd, 0xb2, 0x7f, 0x46, 0x60, 0x58, 0xf8, 0x0b, 0xfa, 0x62,
-	0x74, 0xe9, 0x80, 0x11, 0x92, 0x66, 0x3b, 0x85, 0x21, 0x95, 0xca, 0xa8, 0x16, 0xe8, 0x5c, 0x88,
-	0x4a, 0x6c, 0xdc, 0xea, 0x91, 0x67, 0x57, 0x6f, 0xd2, 0x27, 0x04, 0xae, 0x44, 0x3b, 0x5d, 0xba,
-	0x96, 0x5c, 0x33, 0xd2, 0xba, 0x4b, 0xeb, 0x67, 0x4b, 0x42, 0xec, 0x57, 0x18, 0xf6, 0x06, 0x5d,
-	0x8f, 0xc5, 0x6e, 0x0f, 0x01, 0x6e, 0x02, 0x9e, 0xef, 0xff, 0x09, 0x81, 0x4b, 0x11, 0x86, 0x94,
-	0xae, 0x46, 0xb3, 0xc4, 0xfb, 0x65, 0x29, 0x7b, 0x86, 0x0c, 0x44, 0x7f, 0x83, 0xa1, 0xbf, 0x4e,
-	0x5f, 0x0d, 0xa1, 0xbb, 0x16, 0xc7, 0xa5, 0x6e, 0xe9, 0xcd, 0x6c, 0x98, 0x5f, 0x7f, 0xf5, 0x88,
-	0xdd, 0x6c, 0xd2, 0x1f, 0x08, 0x8c, 0x05, 0xbc, 0x67, 0xdc, 0x56, 0x1b, 0xed, 0x95, 0xe3, 0xb6,
-	0xda, 0x18, 0x43, 0x9b, 0x30, 0xbf, 0xcc, 0x9a, 0x79, 0xc1, 0x03, 0x23, 0xf3, 0x35, 0x81, 0xf3,
-	0x5e, 0xeb, 0x17, 0xb7, 0xdd, 0x46, 0x78, 0xd6, 0xb8, 0xed, 0x36, 0xca, 0x49, 0x26, 0xcc, 0x72,
-	0x8b, 0x10, 0x15, 0x45, 0x0d, 0x1f, 0x13, 0xb8, 0xe8, 0x37, 0x59, 0xb4, 0xc3, 0x0e, 0xea, 0x73,
-	0x89, 0xd2, 0x72, 0xba, 0x60, 0xc4, 0x5b, 0x63, 0x78, 0x2b, 0x74, 0x29, 0x61, 0xbf, 0xe5, 0xbf,
-	0x08, 0x9e, 0x51, 0x3d, 0x26, 0x30, 0xea, 0xb1, 0x3e, 0x74, 0x3e, 0xba, 0x64, 0xd8, 0x8e, 0x49,
-	0x0b, 0x29, 0x22, 0x91, 0x6c, 0x83, 0x91, 0xad, 0x52, 0x25, 0xfe, 0x6b, 0x0a, 0x4c, 0x21, 0xb3,
-	0x5e, 0xd4, 0x81, 0x41, 0xfe, 0xae, 0xf4, 0x7a, 0x92, 0x12, 0x82, 0xe8, 0x46, 0x72, 0x10, 0xc2,
-	0x4c, 0x33, 0x98, 0x49, 0x7a, 0x35, 0x46, 0xa6, 0xfc, 0x6b, 0x4f, 0x4f, 0x32, 0xe4, 0xd9, 0x49,
-	0x86, 0xfc, 0x79, 0x92, 0x21, 0x5f, 0x9c, 0x66, 0xfa, 0x9e, 0x9d, 0x66, 0xfa, 0x7e, 0x3f, 0xcd,
-	0xf4, 0xbd, 0x77, 0xa3, 0x6c, 0x38, 0x1f, 0x1c, 0x14, 0x95, 0x3d, 0xab, 0x2a, 0x92, 0xf9, 0x3f,
-	0x2b, 0x76, 0xe9, 0x43, 0xf5, 0x23, 0xbe, 0x40, 0x71, 0x90, 0xfd, 0xbd, 0x7b, 0xed, 0x9f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x1c, 0xce, 0x79, 0xa7, 0xb7, 0x17, 0x00, 0x00,
+	// 1369 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x99, 0xdb, 0x6f, 0x1b, 0xc5,
+	0x17, 0xc7, 0x33, 0xfd, 0xa5, 0xb9, 0x9c, 0xb4, 0xcd, 0x4f, 0x93, 0x4b, 0x9d, 0x4d, 0xe4, 0x84,
+	0x6d, 0xc9, 0x3d, 0xbb, 0xb1, 0x73, 0xab, 0x80, 0x82, 0x6a, 0x01, 0x21, 0x48, 0x45, 0xa9, 0x89,
+	0x78, 0x40, 0x48, 0x96, 0x1d, 0x6f, 0xcc, 0x0a, 0xdb, 0xeb, 0x7a, 0x37, 0x11, 0x56, 0xe4, 0x17,
+	0x24, 0x40, 0x42, 0x3c, 0x40, 0x8b, 0x50, 0x89, 0x78, 0xe8, 0x03, 0x12, 0xfc, 0x01, 0x20, 0xa4,
+	0x3e, 0x51, 0x9e, 0xfa, 0x58, 0xc1, 0x0b, 0x4f, 0x08, 0x25, 0xfc, 0x21, 0x68, 0x67, 0xce, 0xda,
+	0x7b, 0x99, 0x5d, 0xdb, 0xc2, 0xa2, 0x79, 0x6a, 0xbd, 0x73, 0xce, 0x9c, 0xcf, 0x7c, 0xcf, 0x99,
+	0x99, 0x33, 0x0a, 0x4c, 0xee, 0x1b, 0x66, 0xc9, 0x30, 0xd5, 0x42, 0xd5, 0x38, 0xac, 0xa8, 0x47,
+	0x09, 0xf5, 0xee, 0xa1, 0x56, 0xad, 0x29, 0x95, 0xaa, 0x61, 0x19, 0x74, 0x98, 0x0f, 0x2a, 0x6c,
+	0x50, 0x39, 0x4a, 0x48, 0xa3, 0x05, 0xa3, 0x60, 0xb0, 0x31, 0xd5, 0xfe, 0x1f, 0x37, 0x93, 0xa6,
+	0x0a, 0x86, 0x51, 0x28, 0x6a, 0x6a, 0xb6, 0xa2, 0xab, 0xd9, 0x72, 0xd9, 0xb0, 0xb2, 0x96, 0x6e,
+	0x94, 0x4d, 0x1c, 0x0d, 0x44, 0xb0, 0x6a, 0x15, 0xcd, 0x19, 0x5c, 0xc4, 0xc1, 0x5c, 0xd6, 0xd4,
+	0x78, 0x68, 0xf5, 0x28, 0x91, 0xd3, 0xac, 0x6c, 0x42, 0xad, 0x64, 0x0b, 0x7a, 0x99, 0xcd, 0x84,
+	0xb6, 0x13, 0xdc, 0x36, 0xc3, 0xe3, 0x23, 0x1a, 0xfb, 0x21, 0x27, 0x61, 0xec, 0x8e, 0xed, 0xbc,
+	0x6d, 0xc7, 0xd8, 0x29, 0x1f, 0x18, 0x69, 0xed, 0xee, 0xa1, 0x66, 0x5a, 0x74, 0x02, 0x06, 0x58,
+	0xdc, 0x8c, 0x9e, 0x8f, 0x91, 0x19, 0x32, 0xdf, 0x9b, 0xee, 0x67, 0xbf, 0x77, 0xf2, 0xf2, 0x1b,
+	0x30, 0xee, 0xf7, 0x31, 0x2b, 0x46, 0xd9, 0xd4, 0xa8, 0x02, 0xbd, 0x7a, 0xf9, 0xc0, 0x60, 0x0e,
+	0x43, 0x49, 0x49, 0xf1, 0xa9, 0xa0, 0x34, 0x3d, 0x98, 0x9d, 0x7c, 0x07, 0x26, 0x9b, 0x33, 0xed,
+	0x1a, 0x45, 0x7d, 0xbf, 0xe6, 0x66, 0x48, 0x42, 0x7f, 0x36, 0x9f, 0xaf, 0x6a, 0xa6, 0xc9, 0x66,
+	0x1c, 0x4c, 0xc5, 0x7e, 0xfb, 0x71, 0x65, 0x14, 0x27, 0xbd, 0xc5, 0x47, 0xde, 0xb6, 0xaa, 0x7a,
+	0xb9, 0x90, 0x76, 0x0c, 0xe5, 0x3d, 0x98, 0x12, 0x4f, 0x89, 0x88, 0xeb, 0x1e, 0xc4, 0x19, 0x31,
+	0xa2, 0xcb, 0x8f, 0x83, 0xd6, 0x21, 0xd6, 0x9c, 0xf5, 0xb6, 0x56, 0xca, 0x69, 0x55, 0xb3, 0xb5,
+	0x52, 0xf4, 0x75, 0x80, 0x66, 0x32, 0x62, 0x17, 0x58, 0xc8, 0x59, 0x27, 0xa4, 0x9d, 0x39, 0x85,
+	0x17, 0x0d, 0x66, 0x4e, 0xd9, 0xcd, 0x16, 0x34, 0x9c, 0x36, 0xed, 0xf2, 0x94, 0xbf, 0x25, 0x30,
+	0x21, 0x88, 0x8f, 0x4b, 0xda, 0x84, 0xfe, 0x12, 0xff, 0x14, 0x23, 0x33, 0xff, 0x9b, 0x1f, 0x4a,
+	0x4e, 0x89, 0x57, 0xc5, 0xfd, 0xd2, 0x8e, 0x31, 0xdd, 0x16, 0xd0, 0xcd, 0xb5, 0xa4, 0xe3, 0x41,
+	0x3d, 0x78, 0xf7, 0x3d, 0x78, 0x66, 0xaa, 0x76, 0x2b, 0x5f, 0xd2, 0xcb, 0x8e, 0x3e, 0x0a, 0x5c,
+	0xcc, 0xda, 0xbf, 0x5b, 0xe6, 0x90, 0x9b, 0x75, 0x4d, 0xb4, 0x6f, 0x08, 0x48, 0x22, 0x2a, 0x54,
+	0x2d, 0x09, 0x7d, 0x4c, 0x1e, 0x47, 0xb4, 0xa8, 0x6a, 0x45, 0xcb, 0xee, 0x29, 0xf6, 0x31, 0x81,
+	0x19, 0x5f, 0x99, 0xea, 0x9a, 0x99, 0xe2, 0x3f, 0xff, 0xc3, 0xc2, 0xfa, 0x89, 0xc0, 0x73, 0x11,
+	0x1c, 0x28, 0xd5, 0x36, 0x5c, 0xe1, 0x20, 0x15, 0x34, 0x40, 0xc9, 0x5a, 0xef, 0x9e, 0xcb, 0x05,
+	0xf7, 0xbc, 0xdd, 0xd3, 0xef, 0x24, 0x44, 0xbf, 0x73, 0x51, 0x78, 0x61, 0xa2, 0x7a, 0xeb, 0xef,
+	0xfc, 0x89, 0xba, 0x05, 0xa3, 0x0c, 0x7b, 0xb7, 0x6a, 0x54, 0x0c, 0x33, 0x5b, 0x74, 0x74, 0x9c,
+	0x86, 0xa1, 0x0a, 0x7e, 0x6a, 0x96, 0x22, 0x38, 0x9f, 0x76, 0xf2, 0xf2, 0x5b, 0x30, 0xe6, 0x73,
+	0xc4, 0x35, 0x6e, 0xc0, 0x80, 0x63, 0x86, 0x07, 0xee, 0x44, 0x60, 0x75, 0x0d, 0xa7, 0x86, 0xa9,
+	0xfc, 0x90, 0x80, 0xec, 0x99, 0xd0, 0xa9, 0x48, 0x2e, 0xc2, 0xbf, 0xb8, 0x1e, 0xba, 0x96, 0xe3,
+	0xef, 0x09, 0x5c, 0x8b, 0x44, 0x44, 0x05, 0xb6, 0x60, 0xd0, 0x59, 0x96, 0x93, 0xe0, 0x08, 0x09,
+	0x9a, 0xb6, 0xdd, 0xcb, 0x6a, 0x15, 0xa6, 0x19, 0xe8, 0x3b, 0x86, 0xa5, 0xa5, 0x1a, 0xb8, 0xf6,
+	0xaf, 0x6a, 0xbb, 0x09, 0xb6, 0x77, 0xd2, 0x91, 0xed, 0x10, 0xbb, 0xd0, 0x42, 0x67, 0x6e, 0x26,
+	0xdf, 0xc6, 0xdd, 0x29, 0x8c, 0x89, 0xca, 0x2c, 0x40, 0xaf, 0x6d, 0x8c, 0x75, 0x31, 0x16, 0x10,
+	0xc5, 0xb6, 0x4e, 0x33, 0x13, 0xf9, 0x13, 0x82, 0x7d, 0x82, 0xfd, 0xcd, 0x4c, 0x75, 0x5c, 0xa0,
+	0x5d, 0xcb, 0xfa, 0x57, 0x04, 0xa6, 0xc4, 0x20, 0xb8, 0xa8, 0x25, 0x2e, 0x94, 0x93, 0xea, 0x90,
+	0x55, 0x71, 0x9b, 0xee, 0xa5, 0xf8, 0x1e, 0xc1, 0xf6, 0x04, 0xb1, 0x3c, 0xc9, 0x6d, 0xe4, 0x8e,
+	0xb4, 0x95, 0xbb, 0xae, 0x69, 0xf5, 0xa5, 0xd3, 0x14, 0x78, 0xa1, 0x9e, 0xa9, 0x50, 0x0f, 0xfc,
+	0x2d, 0x01, 0xb6, 0x44, 0xe7, 0xe0, 0x40, 0x39, 0x21, 0x30, 0x29, 0x44, 0x3b, 0x0f, 0xed, 0xca,
+	0x0b, 0x70, 0x95, 0xb1, 0xed, 0x65, 0x8b, 0x45, 0xfb, 0x6c, 0x3b, 0x2c, 0x5a, 0x6d, 0x5f, 0x0e,
+	0x7b, 0x10, 0x0b, 0xfa, 0xe2, 0xa2, 0x6e, 0xc0, 0x45, 0xcb, 0xfe, 0x8c, 0x87, 0x40, 0xb0, 0x6f,
+	0x75, 0x39, 0xa5, 0x7a, 0x9f, 0xfc, 0x39, 0xdd, 0x93, 0xe6, 0x0e, 0xf2, 0x7b, 0x40, 0x5d, 0x6a,
+	0x39, 0x30, 0xdd, 0x4a, 0xc6, 0x3d, 0x02, 0x23, 0x9e, 0xe9, 0xcf, 0x43, 0x12, 0x3e, 0x75, 0x6e,
+	0x45, 0x7b, 0x6b, 0xbc, 0xaa, 0x15, 0xb5, 0x02, 0xfb, 0xfe, 0x0c, 0xba, 0xc6, 0x47, 0xce, 0xe5,
+	0x17, 0x46, 0x82, 0x72, 0xbd, 0x09, 0xff, 0xb7, 0x37, 0x70, 0x26, 0xdf, 0x34, 0x41, 0xe1, 0xa6,
+	0x85, 0xfb, 0xbd, 0x39, 0x55, 0x7a, 0xf8, 0xc8, 0x3b, 0x75, 0xd7, 0x64, 0x4c, 0xfe, 0x32, 0x02,
+	0x17, 0x19, 0x3c, 0xfd, 0x9c, 0xc0, 0x60, 0x23, 0x5f, 0x74, 0x36, 0x80, 0x24, 0x7c, 0x18, 0x4b,
+	0x73, 0x2d, 0xed, 0x78, 0x50, 0x59, 0xf9, 0xe8, 0xf7, 0xbf, 0xef, 0x5f, 0x98, 0xa7, 0xb3, 0xaa,
+	0xff, 0x1d, 0x8f, 0xf9, 0x29, 0x1f, 0x18, 0xea, 0xb1, 0x93, 0xab, 0x3a, 0xfd, 0x8e, 0xc0, 0xb0,
+	0xaf, 0xd5, 0xa3, 0xcb, 0x11, 0xc1, 0x02, 0xef, 0x65, 0x69, 0xa5, 0x4d, 0x6b, 0x04, 0x5c, 0x67,
+	0x80, 0x0a, 0x5d, 0x0e, 0x01, 0x64, 0x8d, 0x69, 0x0d, 0x39, 0xf1, 0xbc, 0xab, 0xd3, 0x07, 0x04,
+	0x2e, 0xb9, 0x9f, 0xa1, 0x74, 0x21, 0x22, 0xaa, 0xf7, 0xa9, 0x2c, 0x2d, 0xb6, 0x63, 0x8a, 0x74,
+	0x09, 0x46, 0xb7, 0x44, 0x17, 0x42, 0xe8, 0xf0, 0x15, 0xeb, 0x56, 0xf0, 0x84, 0xc0, 0x65, 0xcf,
+	0x63, 0x8f, 0x46, 0x05, 0xf4, 0x3d, 0x17, 0xa4, 0xa5, 0xb6, 0x6c, 0x91, 0x6e, 0x95, 0xd1, 0x2d,
+	0xd2, 0x79, 0x31, 0x9d, 0x99, 0xc9, 0xd5, 0x32, 0xec, 0x55, 0x61, 0x2b, 0x57, 0xd2, 0xcb, 0x75,
+	0xfa, 0x88, 0xc0, 0xa8, 0xe8, 0x95, 0x45, 0x13, 0xad, 0xb2, 0x16, 0x78, 0x19, 0x4a, 0xc9, 0x4e,
+	0x5c, 0x90, 0xf8, 0x45, 0x46, 0xbc, 0x41, 0xd7, 0xa2, 0xb2, 0xad, 0x6b, 0x8c, 0x9c, 0x0f, 0xb9,
+	0x94, 0xfd, 0x39, 0x08, 0xcf, 0x05, 0x6e, 0x0f, 0xde, 0xa3, 0x73, 0xb2, 0x13, 0x17, 0x84, 0xbf,
+	0xc1, 0xe0, 0x93, 0x74, 0xb5, 0x0d, 0x78, 0xaf, 0xec, 0x9f, 0x11, 0x18, 0x70, 0xda, 0x34, 0xfa,
+	0xbc, 0x38, 0xb4, 0xaf, 0x9f, 0x94, 0x66, 0x5b, 0x99, 0x21, 0x95, 0xca, 0xa8, 0x16, 0xe8, 0x5c,
+	0x80, 0xca, 0xb9, 0xff, 0xd4, 0x63, 0xd7, 0xe5, 0x58, 0xa7, 0x8f, 0x09, 0x8c, 0x8b, 0x1f, 0x0c,
+	0x74, 0x2d, 0x3a, 0xa6, 0xf0, 0x05, 0x24, 0xad, 0x77, 0xe6, 0x84, 0xd8, 0x2f, 0x31, 0xec, 0x4d,
+	0xba, 0x1e, 0x8a, 0xdd, 0x2c, 0x02, 0x3c, 0x04, 0x5c, 0xfb, 0xff, 0x31, 0x81, 0x11, 0x41, 0x5f,
+	0x4f, 0x57, 0xc5, 0x2c, 0xe1, 0xcf, 0x0e, 0x29, 0xd1, 0x81, 0x07, 0xa2, 0xbf, 0xc6, 0xd0, 0x5f,
+	0xa1, 0x37, 0x03, 0xe8, 0xec, 0xa2, 0xc9, 0xd5, 0x32, 0x0d, 0xbd, 0xed, 0x0f, 0x55, 0xaf, 0xfe,
+	0xea, 0x31, 0xfb, 0x58, 0xa7, 0x3f, 0x10, 0x18, 0xf6, 0xb5, 0xf0, 0x61, 0x47, 0xad, 0xf8, 0xc9,
+	0x21, 0xad, 0xb4, 0x69, 0xdd, 0xb2, 0x7e, 0x6d, 0x22, 0xd3, 0x0d, 0xee, 0x2b, 0x99, 0xaf, 0x09,
+	0x5c, 0x72, 0x77, 0xd0, 0x61, 0xc7, 0xad, 0xa0, 0xf5, 0x0f, 0x3b, 0x6e, 0x45, 0x0d, 0x79, 0x44,
+	0x2d, 0x37, 0x08, 0x51, 0x51, 0xd4, 0xf0, 0x21, 0x81, 0x2b, 0xde, 0x5e, 0x95, 0xb6, 0x38, 0x41,
+	0x3d, 0xcd, 0xb6, 0xb4, 0xdc, 0x9e, 0x31, 0xe2, 0xad, 0x31, 0xbc, 0x15, 0xba, 0x14, 0x71, 0xde,
+	0xf2, 0x1b, 0xc1, 0x55, 0xaa, 0x27, 0x04, 0x86, 0x5c, 0x1d, 0x24, 0x9d, 0x17, 0x87, 0x0c, 0x76,
+	0xb5, 0xd2, 0x42, 0x1b, 0x96, 0x48, 0xb6, 0xc9, 0xc8, 0x56, 0xa9, 0x12, 0xbe, 0x9b, 0x7c, 0x55,
+	0xc8, 0x3a, 0x58, 0x6a, 0x41, 0x1f, 0x5f, 0x2b, 0xbd, 0x16, 0xa5, 0x84, 0x43, 0x74, 0x3d, 0xda,
+	0x08, 0x61, 0xa6, 0x19, 0xcc, 0x04, 0xbd, 0x1a, 0x22, 0x13, 0xfd, 0x95, 0xc0, 0xb8, 0xb8, 0x6b,
+	0x0b, 0x3b, 0x81, 0x22, 0xbb, 0x4d, 0x69, 0xbd, 0x33, 0x27, 0xc4, 0xbc, 0xc9, 0x30, 0xb7, 0xe8,
+	0x86, 0x78, 0x1b, 0xbb, 0xfa, 0x45, 0xd1, 0x6d, 0x94, 0x7a, 0xf9, 0xc9, 0x69, 0x9c, 0x3c, 0x3d,
+	0x8d, 0x93, 0xbf, 0x4e, 0xe3, 0xe4, 0x8b, 0xb3, 0x78, 0xcf, 0xd3, 0xb3, 0x78, 0xcf, 0x1f, 0x67,
+	0xf1, 0x9e, 0x77, 0xaf, 0x17, 0x74, 0xeb, 0xfd, 0xc3, 0x9c, 0xb2, 0x6f, 0x94, 0x9c, 0xa9, 0xf9,
+	0x3f, 0x2b, 0x66, 0xfe, 0x03, 0xf5, 0x43, 0x1e, 0x27, 0xd7, 0xc7, 0xfe, 0xf6, 0xb1, 0xf6, 0xcf,
+	0x00, 0xb3, 0x05, 0x54, 0xf3, 0xc3, 0x19, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1649,6 +1766,8 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(ctx context.Context, in *QueryGroupsRequest, opts ...grpc.CallOption) (*QueryGroupsResponse, error)
+	// VoteDelegationsByGroup queries the vote delegations of the members of a group.
+	VoteDelegationsByGroup(ctx context.Context, in *QueryVoteDelegationsByGroupRequest, opts ...grpc.CallOption) (*QueryVoteDelegationsByGroupResponse, error)
 }
 
 type queryClient struct {
@@ -1785,6 +1904,15 @@ func (c *queryClient) Groups(ctx context.Context, in *QueryGroupsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) VoteDelegationsByGroup(ctx context.Context, in *QueryVoteDelegationsByGroupRequest, opts ...grpc.CallOption) (*QueryVoteDelegationsByGroupResponse, error) {
+	out := new(QueryVoteDelegationsByGroupResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Query/VoteDelegationsByGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// GroupInfo queries group info based on group id.
@@ -1821,6 +1949,8 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(context.Context, *QueryGroupsRequest) (*QueryGroupsResponse, error)
+	// VoteDelegationsByGroup queries the vote delegations of the members of a group.
+	VoteDelegationsByGroup(context.Context, *QueryVoteDelegationsByGroupRequest) (*QueryVoteDelegationsByGroupResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1869,6 +1999,9 @@ func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTall
 func (*UnimplementedQueryServer) Groups(ctx context.Context, req *QueryGroupsRequest) (*QueryGroupsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Groups not implemented")
 }
+func (*UnimplementedQueryServer) VoteDelegationsByGroup(ctx context.Context, req *QueryVoteDelegationsByGroupRequest) (*QueryVoteDelegationsByGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteDelegationsByGroup not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2126,6 +2259,24 @@ func _Query_Groups_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VoteDelegationsByGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteDelegationsByGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VoteDelegationsByGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Query/VoteDelegationsByGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoteDelegationsByGroup(ctx, req.(*QueryVoteDelegationsByGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.group.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2186,6 +2337,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Groups",
 			Handler:    _Query_Groups_Handler,
 		},
+		{
+			MethodName: "VoteDelegationsByGroup",
+			Handler:    _Query_VoteDelegationsByGroup_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/query.proto",
@@ -3319,6 +3474,95 @@ func (m *QueryGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryVoteDelegationsByGroupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteDelegationsByGroupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteDelegationsByGroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteDelegationsByGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteDelegationsByGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteDelegationsByGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VoteDelegations) > 0 {
+		for iNdEx := len(m.VoteDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3775,6 +4019,41 @@ func (m *QueryGroupsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryVoteDelegationsByGroupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovQuery(uint64(m.GroupId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVoteDelegationsByGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VoteDelegations) > 0 {
+		for _, e := range m.VoteDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6669,6 +6948,231 @@ func (m *QueryGroupsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryVoteDelegationsByGroupRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteDelegationsByGroupRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteDelegationsByGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteDelegationsByGroupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteDelegationsByGroupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteDelegationsByGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteDelegations = append(m.VoteDelegations, &VoteDelegation{})
+			if err := m.VoteDelegations[len(m.VoteDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
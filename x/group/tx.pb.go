@@ -12,18 +12,22 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -1460,6 +1464,211 @@ func (m *MsgLeaveGroupResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgLeaveGroupResponse proto.InternalMessageInfo
 
+// MsgDelegateGroupVote is the Msg/DelegateGroupVote request type.
+type MsgDelegateGroupVote struct {
+	// delegator is the account address of the group member delegating its vote.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// group_id is the unique ID of the group.
+	GroupId uint64 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// delegate is the account address of the group member voting on behalf of
+	// the delegator.
+	Delegate string `protobuf:"bytes,3,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	// expiration is the optional time after which the delegation is no longer
+	// taken into account.
+	Expiration *time.Time `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+}
+
+func (m *MsgDelegateGroupVote) Reset()         { *m = MsgDelegateGroupVote{} }
+func (m *MsgDelegateGroupVote) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateGroupVote) ProtoMessage()    {}
+func (*MsgDelegateGroupVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{28}
+}
+func (m *MsgDelegateGroupVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateGroupVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateGroupVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateGroupVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateGroupVote.Merge(m, src)
+}
+func (m *MsgDelegateGroupVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateGroupVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateGroupVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateGroupVote proto.InternalMessageInfo
+
+func (m *MsgDelegateGroupVote) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *MsgDelegateGroupVote) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+func (m *MsgDelegateGroupVote) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
+func (m *MsgDelegateGroupVote) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+// MsgDelegateGroupVoteResponse is the Msg/DelegateGroupVote response type.
+type MsgDelegateGroupVoteResponse struct {
+}
+
+func (m *MsgDelegateGroupVoteResponse) Reset()         { *m = MsgDelegateGroupVoteResponse{} }
+func (m *MsgDelegateGroupVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateGroupVoteResponse) ProtoMessage()    {}
+func (*MsgDelegateGroupVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{29}
+}
+func (m *MsgDelegateGroupVoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateGroupVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateGroupVoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateGroupVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateGroupVoteResponse.Merge(m, src)
+}
+func (m *MsgDelegateGroupVoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateGroupVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateGroupVoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateGroupVoteResponse proto.InternalMessageInfo
+
+// MsgUndelegateGroupVote is the Msg/UndelegateGroupVote request type.
+type MsgUndelegateGroupVote struct {
+	// delegator is the account address of the group member revoking its vote
+	// delegation.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// group_id is the unique ID of the group.
+	GroupId uint64 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+}
+
+func (m *MsgUndelegateGroupVote) Reset()         { *m = MsgUndelegateGroupVote{} }
+func (m *MsgUndelegateGroupVote) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateGroupVote) ProtoMessage()    {}
+func (*MsgUndelegateGroupVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{30}
+}
+func (m *MsgUndelegateGroupVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateGroupVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateGroupVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUndelegateGroupVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateGroupVote.Merge(m, src)
+}
+func (m *MsgUndelegateGroupVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateGroupVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateGroupVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUndelegateGroupVote proto.InternalMessageInfo
+
+func (m *MsgUndelegateGroupVote) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *MsgUndelegateGroupVote) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+// MsgUndelegateGroupVoteResponse is the Msg/UndelegateGroupVote response type.
+type MsgUndelegateGroupVoteResponse struct {
+}
+
+func (m *MsgUndelegateGroupVoteResponse) Reset()         { *m = MsgUndelegateGroupVoteResponse{} }
+func (m *MsgUndelegateGroupVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateGroupVoteResponse) ProtoMessage()    {}
+func (*MsgUndelegateGroupVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{31}
+}
+func (m *MsgUndelegateGroupVoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateGroupVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateGroupVoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUndelegateGroupVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateGroupVoteResponse.Merge(m, src)
+}
+func (m *MsgUndelegateGroupVoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateGroupVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateGroupVoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUndelegateGroupVoteResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("cosmos.group.v1.Exec", Exec_name, Exec_value)
 	proto.RegisterType((*MsgCreateGroup)(nil), "cosmos.group.v1.MsgCreateGroup")
@@ -1490,92 +1699,104 @@ func init() {
 	proto.RegisterType((*MsgExecResponse)(nil), "cosmos.group.v1.MsgExecResponse")
 	proto.RegisterType((*MsgLeaveGroup)(nil), "cosmos.group.v1.MsgLeaveGroup")
 	proto.RegisterType((*MsgLeaveGroupResponse)(nil), "cosmos.group.v1.MsgLeaveGroupResponse")
+	proto.RegisterType((*MsgDelegateGroupVote)(nil), "cosmos.group.v1.MsgDelegateGroupVote")
+	proto.RegisterType((*MsgDelegateGroupVoteResponse)(nil), "cosmos.group.v1.MsgDelegateGroupVoteResponse")
+	proto.RegisterType((*MsgUndelegateGroupVote)(nil), "cosmos.group.v1.MsgUndelegateGroupVote")
+	proto.RegisterType((*MsgUndelegateGroupVoteResponse)(nil), "cosmos.group.v1.MsgUndelegateGroupVoteResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/tx.proto", fileDescriptor_6b8d3d629f136420) }
 
 var fileDescriptor_6b8d3d629f136420 = []byte{
-	// 1276 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x41, 0x73, 0xdb, 0x44,
-	0x14, 0xb6, 0x6c, 0x37, 0x71, 0x9e, 0x1b, 0x27, 0x51, 0x9c, 0xd6, 0x51, 0x5a, 0xdb, 0x88, 0x34,
-	0x49, 0x3d, 0x8d, 0x4c, 0x1c, 0x7a, 0x09, 0x4c, 0x99, 0x24, 0x35, 0x4c, 0x00, 0x43, 0x46, 0x69,
-	0x29, 0x70, 0x31, 0x8a, 0xb5, 0x55, 0x35, 0xd8, 0x96, 0xd1, 0xca, 0x89, 0x73, 0x84, 0x13, 0xd0,
-	0x0b, 0x33, 0xfd, 0x03, 0xcc, 0x70, 0xe1, 0xc8, 0xa1, 0x37, 0x6e, 0x70, 0xe9, 0x70, 0xea, 0x70,
-	0x62, 0x38, 0x30, 0x4c, 0x72, 0xe0, 0xca, 0x4f, 0x60, 0xb4, 0x2b, 0xad, 0x2d, 0x5b, 0x8e, 0x14,
-	0xe3, 0xc2, 0xa9, 0xb5, 0xde, 0xf7, 0xde, 0xfb, 0xbe, 0xb7, 0x6f, 0xf7, 0xed, 0x06, 0x32, 0x35,
-	0x03, 0x37, 0x0c, 0x5c, 0xd4, 0x4c, 0xa3, 0xdd, 0x2a, 0x1e, 0x6d, 0x14, 0xad, 0x8e, 0xd4, 0x32,
-	0x0d, 0xcb, 0xe0, 0x67, 0xa8, 0x45, 0x22, 0x16, 0xe9, 0x68, 0x43, 0x48, 0x6b, 0x86, 0x66, 0x10,
-	0x5b, 0xd1, 0xfe, 0x1f, 0x85, 0x09, 0x8b, 0x14, 0x56, 0xa5, 0x06, 0xc7, 0xc7, 0x31, 0x69, 0x86,
-	0xa1, 0xd5, 0x51, 0x91, 0xfc, 0x3a, 0x6c, 0x3f, 0x2c, 0x2a, 0xcd, 0x13, 0xc7, 0xb4, 0x34, 0x90,
-	0xf6, 0xa4, 0x85, 0x5c, 0xbf, 0xab, 0x8e, 0xb1, 0x81, 0x35, 0xdb, 0xd4, 0xc0, 0x1a, 0x35, 0x88,
-	0xdf, 0x73, 0x90, 0xaa, 0x60, 0x6d, 0xd7, 0x44, 0x8a, 0x85, 0xde, 0xb2, 0x5d, 0x79, 0x09, 0x2e,
-	0x29, 0x6a, 0x43, 0x6f, 0x66, 0xb8, 0x3c, 0xb7, 0x36, 0xb5, 0x93, 0xf9, 0xf5, 0xe9, 0x7a, 0xda,
-	0x21, 0xb1, 0xad, 0xaa, 0x26, 0xc2, 0xf8, 0xc0, 0x32, 0xf5, 0xa6, 0x26, 0x53, 0x18, 0x7f, 0x07,
-	0x26, 0x1b, 0xa8, 0x71, 0x88, 0x4c, 0x9c, 0x89, 0xe6, 0x63, 0x6b, 0xc9, 0x52, 0x56, 0xea, 0xd3,
-	0x29, 0x55, 0x88, 0x5d, 0x46, 0x9f, 0xb5, 0x11, 0xb6, 0x76, 0xe2, 0xcf, 0xfe, 0xc8, 0x45, 0x64,
-	0xd7, 0x89, 0x17, 0x20, 0xd1, 0x40, 0x96, 0xa2, 0x2a, 0x96, 0x92, 0x89, 0xd9, 0x29, 0x65, 0xf6,
-	0x7b, 0x0b, 0xbe, 0xf8, 0xeb, 0x87, 0x02, 0xcd, 0x23, 0x6e, 0xc2, 0x15, 0x2f, 0x53, 0x19, 0xe1,
-	0x96, 0xd1, 0xc4, 0x88, 0x5f, 0x84, 0x04, 0x49, 0x55, 0xd5, 0x55, 0x42, 0x3a, 0x2e, 0x4f, 0x92,
-	0xdf, 0x7b, 0xaa, 0xf8, 0x23, 0x07, 0x0b, 0x15, 0xac, 0xdd, 0x6f, 0xa9, 0xae, 0x57, 0xc5, 0x49,
-	0x7b, 0x51, 0x99, 0xbd, 0x49, 0xa2, 0x9e, 0x24, 0xfc, 0x3b, 0x90, 0xa2, 0x62, 0xaa, 0x6d, 0x92,
-	0x07, 0x67, 0x62, 0x17, 0x28, 0xc4, 0x34, 0xf5, 0xa5, 0x14, 0xb1, 0x47, 0x72, 0x0e, 0xae, 0xfb,
-	0x92, 0x77, 0x95, 0x8b, 0xdf, 0x71, 0x30, 0xef, 0x45, 0x6c, 0x13, 0xb2, 0x63, 0x14, 0x77, 0x1b,
-	0xa6, 0x9a, 0xe8, 0xb8, 0x4a, 0xc3, 0xc5, 0x02, 0xc2, 0x25, 0x9a, 0xe8, 0x98, 0x30, 0xf0, 0xc8,
-	0xb8, 0x0e, 0x4b, 0x3e, 0x24, 0x99, 0x88, 0xc7, 0x1c, 0x59, 0x59, 0x8f, 0x4c, 0xba, 0xfe, 0xe3,
-	0xd4, 0x11, 0xb6, 0xcd, 0xf2, 0x90, 0xf5, 0x27, 0xc3, 0xf8, 0xfe, 0xcd, 0x41, 0xda, 0xdb, 0x89,
-	0xfb, 0x46, 0x5d, 0xaf, 0x9d, 0xfc, 0x47, 0x6c, 0x79, 0x05, 0x66, 0x54, 0x54, 0xd3, 0xb1, 0x6e,
-	0x34, 0xab, 0x2d, 0x92, 0x39, 0x13, 0xcf, 0x73, 0x6b, 0xc9, 0x52, 0x5a, 0xa2, 0xc7, 0x83, 0xe4,
-	0x1e, 0x0f, 0xd2, 0x76, 0xf3, 0x64, 0x47, 0xfc, 0xe5, 0xe9, 0x7a, 0xb6, 0xbf, 0x11, 0xef, 0x3a,
-	0x01, 0x28, 0x73, 0x39, 0xa5, 0x7a, 0x7e, 0x6f, 0xa5, 0xbe, 0xfc, 0x36, 0x17, 0xe9, 0x29, 0x8a,
-	0x0c, 0xd7, 0xfc, 0x14, 0xb3, 0x1d, 0x58, 0x82, 0x49, 0x85, 0x2a, 0x0c, 0xd4, 0xee, 0x02, 0xc5,
-	0xdf, 0x39, 0x58, 0xf4, 0x56, 0x9a, 0x06, 0x1d, 0xad, 0x83, 0xdf, 0x86, 0x34, 0xad, 0x25, 0xad,
-	0x48, 0xd5, 0xa5, 0x13, 0x0d, 0x70, 0xe7, 0xb5, 0xde, 0xcc, 0xc4, 0x32, 0x8e, 0x96, 0x7f, 0x1c,
-	0x83, 0x8c, 0xb7, 0x62, 0x0f, 0x74, 0xeb, 0xd1, 0x88, 0x7d, 0xf2, 0x6f, 0x4f, 0xd8, 0x1b, 0x90,
-	0xa2, 0xb5, 0xe9, 0x6b, 0xa9, 0x69, 0xcd, 0xb3, 0xd9, 0x4a, 0xb0, 0xe0, 0x29, 0x21, 0x43, 0xc7,
-	0x09, 0x7a, 0xbe, 0xa7, 0x52, 0xcc, 0x67, 0xa3, 0xcf, 0x47, 0xc1, 0x4e, 0xd9, 0x2e, 0xe5, 0xb9,
-	0xb5, 0x84, 0xb7, 0xba, 0x98, 0xae, 0xac, 0x4f, 0xfb, 0x4e, 0xbc, 0xe0, 0xf6, 0xfd, 0x8a, 0x83,
-	0xfc, 0xb0, 0xd5, 0x08, 0x31, 0x45, 0xc6, 0xd9, 0x5c, 0xe2, 0xcb, 0xf0, 0xd2, 0xd0, 0xae, 0x67,
-	0x47, 0xcc, 0x93, 0x28, 0x88, 0x7e, 0x28, 0xaf, 0xee, 0xff, 0x75, 0x93, 0xf8, 0x2c, 0x63, 0xec,
-	0x05, 0x2f, 0xe3, 0x2d, 0x28, 0x04, 0x17, 0x85, 0xd5, 0xf0, 0x27, 0x8e, 0x1c, 0x5a, 0x03, 0xf0,
-	0x91, 0x87, 0xcb, 0x38, 0xab, 0x17, 0x76, 0x1a, 0xad, 0xc0, 0xf2, 0x79, 0x1a, 0x98, 0xd8, 0xaf,
-	0xa3, 0x30, 0x57, 0xc1, 0xda, 0x41, 0xfb, 0xb0, 0xa1, 0x5b, 0xfb, 0xa6, 0xd1, 0x32, 0xb0, 0x52,
-	0x1f, 0xca, 0x98, 0x1b, 0x81, 0xf1, 0x35, 0x98, 0x6a, 0x91, 0xb8, 0xee, 0x31, 0x34, 0x25, 0x77,
-	0x3f, 0x9c, 0x3b, 0xaf, 0x5e, 0xb1, 0x6d, 0x18, 0x2b, 0x1a, 0xc2, 0x99, 0x38, 0x39, 0xbf, 0x7c,
-	0x5b, 0x44, 0x66, 0x28, 0xfe, 0x26, 0xc4, 0x51, 0x07, 0xd5, 0xc8, 0x21, 0x92, 0x2a, 0x2d, 0x0c,
-	0x9c, 0x76, 0xe5, 0x0e, 0xaa, 0xc9, 0x04, 0xb2, 0xc5, 0xbb, 0x3d, 0xd2, 0x25, 0x23, 0xbe, 0x4e,
-	0x06, 0x8b, 0xb7, 0x16, 0x6c, 0x9b, 0xe7, 0x20, 0xd9, 0x72, 0xbe, 0x75, 0x77, 0x3a, 0xb8, 0x9f,
-	0xf6, 0x54, 0xb1, 0x43, 0xae, 0x54, 0xf6, 0x01, 0xa1, 0x9a, 0xca, 0x31, 0xab, 0x65, 0x90, 0x5f,
-	0xef, 0x0c, 0x8c, 0x86, 0x9c, 0x81, 0x5b, 0x97, 0x6d, 0xe6, 0x6c, 0x22, 0xd2, 0x7b, 0x52, 0x7f,
-	0x66, 0xb6, 0xc6, 0xa7, 0x1c, 0x4c, 0x56, 0xb0, 0xf6, 0x81, 0x61, 0x05, 0xab, 0xb0, 0x9b, 0xfb,
-	0xc8, 0xb0, 0x90, 0x19, 0xc8, 0x85, 0xc2, 0xf8, 0x4d, 0x98, 0x30, 0x5a, 0x96, 0x6e, 0xd0, 0x81,
-	0x97, 0x2a, 0x2d, 0x0d, 0x14, 0xdd, 0xce, 0xfb, 0x3e, 0x81, 0xc8, 0x0e, 0xd4, 0xb3, 0xea, 0xf1,
-	0xbe, 0x55, 0xbf, 0xc0, 0x1a, 0xd2, 0x86, 0x27, 0x3c, 0xc4, 0x39, 0x98, 0x71, 0x34, 0x32, 0xdd,
-	0x0d, 0x22, 0xdb, 0xc6, 0x07, 0xcb, 0x7e, 0x15, 0x12, 0x76, 0xc8, 0xb6, 0x65, 0x04, 0x2b, 0x67,
-	0xc8, 0xad, 0xa4, 0x4d, 0x60, 0x02, 0xeb, 0x5a, 0x13, 0x99, 0xa2, 0x4c, 0x18, 0x10, 0x7a, 0x6e,
-	0xcf, 0xbc, 0x01, 0x13, 0x26, 0xc2, 0xed, 0xba, 0x45, 0x62, 0xa6, 0x4a, 0xab, 0x03, 0x6a, 0xdc,
-	0xc5, 0x2a, 0x3b, 0x21, 0x65, 0x02, 0x97, 0x1d, 0x37, 0xb1, 0x0e, 0xd3, 0x15, 0xac, 0xbd, 0x8b,
-	0x94, 0x23, 0xe7, 0x91, 0x35, 0xc2, 0x85, 0xe9, 0x9c, 0xeb, 0x62, 0x5f, 0x1f, 0x5d, 0x25, 0x6f,
-	0x9e, 0x6e, 0x36, 0x57, 0x47, 0xa1, 0x00, 0x71, 0x52, 0xc6, 0x34, 0xcc, 0x96, 0x3f, 0x2c, 0xef,
-	0x56, 0xef, 0xbf, 0x77, 0xb0, 0x5f, 0xde, 0xdd, 0x7b, 0x73, 0xaf, 0x7c, 0x77, 0x36, 0xc2, 0x5f,
-	0x86, 0x04, 0xf9, 0x7a, 0x4f, 0xfe, 0x68, 0x96, 0x2b, 0xfd, 0x9c, 0x84, 0x58, 0x05, 0x6b, 0xfc,
-	0x03, 0x48, 0xf6, 0xbe, 0x0e, 0x73, 0x83, 0x57, 0x0f, 0xcf, 0x60, 0x15, 0x56, 0x03, 0x00, 0xac,
-	0xa8, 0x75, 0xe0, 0x7d, 0x9e, 0x65, 0x2b, 0x7e, 0xee, 0x83, 0x38, 0x41, 0x0a, 0x87, 0x63, 0xd9,
-	0x1e, 0xc2, 0xec, 0xc0, 0x2b, 0x69, 0x39, 0x20, 0x06, 0x41, 0x09, 0xb7, 0xc2, 0xa0, 0x58, 0x1e,
-	0x03, 0xe6, 0xfd, 0x1e, 0x32, 0xab, 0x81, 0x74, 0x29, 0x50, 0x28, 0x86, 0x04, 0xb2, 0x84, 0x3a,
-	0xcc, 0x0d, 0xbe, 0x44, 0x6e, 0x04, 0x2c, 0x02, 0x85, 0x09, 0xeb, 0xa1, 0x60, 0x2c, 0x55, 0x1b,
-	0x16, 0xfc, 0x2f, 0xb4, 0x37, 0x03, 0xe2, 0x74, 0xa1, 0xc2, 0x46, 0x68, 0x28, 0x4b, 0xdb, 0x81,
-	0x2b, 0x43, 0x1e, 0x09, 0x85, 0x80, 0x62, 0xf5, 0x60, 0x85, 0x52, 0x78, 0x2c, 0xcb, 0xfc, 0x84,
-	0x83, 0x5c, 0xd0, 0x1d, 0x6c, 0x33, 0x54, 0x5c, 0xaf, 0x93, 0xf0, 0xda, 0x08, 0x4e, 0x8c, 0xd5,
-	0xe7, 0x1c, 0x2c, 0x0e, 0xbf, 0xd5, 0xac, 0x87, 0x0a, 0xcd, 0xfa, 0xed, 0xf6, 0x85, 0xe0, 0x8c,
-	0xc3, 0x27, 0x90, 0xea, 0xbb, 0x6b, 0x88, 0x7e, 0x81, 0xbc, 0x18, 0xa1, 0x10, 0x8c, 0xe9, 0xdd,
-	0xb0, 0x03, 0x33, 0xd8, 0x77, 0xc3, 0xf6, 0xa3, 0xfc, 0x37, 0xec, 0xb0, 0xa9, 0xca, 0xef, 0x40,
-	0x9c, 0x4c, 0xd4, 0x8c, 0x9f, 0x97, 0x6d, 0x11, 0xf2, 0xc3, 0x2c, 0xbd, 0x31, 0xc8, 0xb9, 0xea,
-	0x1b, 0xc3, 0xb6, 0xf8, 0xc7, 0xf0, 0xcc, 0x98, 0x7b, 0x00, 0x3d, 0xf3, 0x21, 0xeb, 0x87, 0xef,
-	0xda, 0x85, 0x95, 0xf3, 0xed, 0x6e, 0xd4, 0x9d, 0x3b, 0xcf, 0x4e, 0xb3, 0xdc, 0xf3, 0xd3, 0x2c,
-	0xf7, 0xe7, 0x69, 0x96, 0xfb, 0xe6, 0x2c, 0x1b, 0x79, 0x7e, 0x96, 0x8d, 0xfc, 0x76, 0x96, 0x8d,
-	0x7c, 0xbc, 0xac, 0xe9, 0xd6, 0xa3, 0xf6, 0xa1, 0x54, 0x33, 0x1a, 0xce, 0xdf, 0x18, 0x9d, 0x7f,
-	0xd6, 0xb1, 0xfa, 0x69, 0xb1, 0x43, 0xff, 0x8e, 0x78, 0x38, 0x41, 0x6e, 0x68, 0x9b, 0xff, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0xee, 0x01, 0x59, 0xf1, 0xd5, 0x14, 0x00, 0x00,
+	// 1404 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4f, 0x73, 0xdb, 0x44,
+	0x14, 0x8f, 0x62, 0x37, 0x71, 0x5e, 0x1a, 0x27, 0x55, 0x93, 0xd6, 0x51, 0x53, 0xdb, 0x88, 0xfe,
+	0x49, 0x33, 0xb5, 0x4d, 0x9c, 0x96, 0x83, 0x61, 0x0a, 0x75, 0x6b, 0x98, 0x00, 0x86, 0x8e, 0xda,
+	0x52, 0xe0, 0x62, 0x14, 0x6b, 0xab, 0x6a, 0xb0, 0x2c, 0xa1, 0x95, 0x53, 0x67, 0x38, 0xc1, 0x09,
+	0xe8, 0xa5, 0x33, 0xfd, 0x00, 0x30, 0xc3, 0x85, 0x23, 0x87, 0xde, 0xb8, 0x71, 0xea, 0x70, 0xea,
+	0x70, 0x62, 0x38, 0x00, 0x93, 0x1c, 0xb8, 0xc2, 0x37, 0x60, 0xb4, 0x2b, 0xad, 0x25, 0x4b, 0x8e,
+	0x14, 0x93, 0x96, 0x53, 0xa2, 0x7d, 0xbf, 0xf7, 0xde, 0xef, 0xbd, 0x7d, 0xfb, 0xf6, 0xad, 0x21,
+	0xd7, 0x36, 0xb0, 0x6e, 0xe0, 0x8a, 0x6a, 0x19, 0x3d, 0xb3, 0xb2, 0xbd, 0x5e, 0xb1, 0xfb, 0x65,
+	0xd3, 0x32, 0x6c, 0x83, 0x9f, 0xa7, 0x92, 0x32, 0x91, 0x94, 0xb7, 0xd7, 0x85, 0x45, 0xd5, 0x50,
+	0x0d, 0x22, 0xab, 0x38, 0xff, 0x51, 0x98, 0xb0, 0x4c, 0x61, 0x2d, 0x2a, 0x70, 0x75, 0x5c, 0x91,
+	0x6a, 0x18, 0x6a, 0x07, 0x55, 0xc8, 0xd7, 0x56, 0xef, 0x6e, 0x45, 0xee, 0xee, 0xb8, 0xa2, 0xc2,
+	0xb0, 0xc8, 0xd6, 0x74, 0x84, 0x6d, 0x59, 0x37, 0x5d, 0xc0, 0xa9, 0x10, 0xaf, 0x1d, 0x13, 0x79,
+	0x86, 0x4f, 0xba, 0x42, 0x1d, 0xab, 0x8e, 0x48, 0xc7, 0x2a, 0x15, 0x88, 0xdf, 0x73, 0x90, 0x6d,
+	0x62, 0xf5, 0x9a, 0x85, 0x64, 0x1b, 0xbd, 0xe9, 0xa8, 0xf2, 0x65, 0x38, 0x22, 0x2b, 0xba, 0xd6,
+	0xcd, 0x71, 0x45, 0x6e, 0x75, 0xa6, 0x9e, 0xfb, 0xe5, 0x71, 0x69, 0xd1, 0x65, 0x79, 0x55, 0x51,
+	0x2c, 0x84, 0xf1, 0x4d, 0xdb, 0xd2, 0xba, 0xaa, 0x44, 0x61, 0xfc, 0x15, 0x98, 0xd6, 0x91, 0xbe,
+	0x85, 0x2c, 0x9c, 0x9b, 0x2c, 0xa6, 0x56, 0x67, 0xab, 0xf9, 0xf2, 0x50, 0x22, 0xca, 0x4d, 0x22,
+	0x97, 0xd0, 0xa7, 0x3d, 0x84, 0xed, 0x7a, 0xfa, 0xc9, 0xef, 0x85, 0x09, 0xc9, 0x53, 0xe2, 0x05,
+	0xc8, 0xe8, 0xc8, 0x96, 0x15, 0xd9, 0x96, 0x73, 0x29, 0xc7, 0xa5, 0xc4, 0xbe, 0x6b, 0xf0, 0xc5,
+	0x5f, 0x3f, 0xac, 0x51, 0x3f, 0xe2, 0x06, 0x9c, 0x08, 0x32, 0x95, 0x10, 0x36, 0x8d, 0x2e, 0x46,
+	0xfc, 0x32, 0x64, 0x88, 0xab, 0x96, 0xa6, 0x10, 0xd2, 0x69, 0x69, 0x9a, 0x7c, 0x6f, 0x2a, 0xe2,
+	0x8f, 0x1c, 0x2c, 0x35, 0xb1, 0x7a, 0xdb, 0x54, 0x3c, 0xad, 0xa6, 0xeb, 0xf6, 0xa0, 0x61, 0xfa,
+	0x9d, 0x4c, 0x06, 0x9c, 0xf0, 0x6f, 0x43, 0x96, 0x06, 0xd3, 0xea, 0x11, 0x3f, 0x38, 0x97, 0x3a,
+	0x40, 0x22, 0xe6, 0xa8, 0x2e, 0xa5, 0x88, 0x03, 0x21, 0x17, 0xe0, 0x74, 0x24, 0x79, 0x2f, 0x72,
+	0xf1, 0x3b, 0x0e, 0x8e, 0x07, 0x11, 0x57, 0x09, 0xd9, 0x43, 0x0c, 0xee, 0x32, 0xcc, 0x74, 0xd1,
+	0xfd, 0x16, 0x35, 0x97, 0x8a, 0x31, 0x97, 0xe9, 0xa2, 0xfb, 0x84, 0x41, 0x20, 0x8c, 0xd3, 0x70,
+	0x2a, 0x82, 0x24, 0x0b, 0xe2, 0x01, 0x07, 0x27, 0x82, 0xf2, 0xa6, 0xbb, 0xff, 0x87, 0x19, 0x47,
+	0xd2, 0x32, 0x2b, 0x42, 0x3e, 0x9a, 0x0c, 0xe3, 0xfb, 0x37, 0x07, 0x8b, 0xc1, 0x4a, 0xbc, 0x61,
+	0x74, 0xb4, 0xf6, 0xce, 0x73, 0x62, 0xcb, 0xcb, 0x30, 0xaf, 0xa0, 0xb6, 0x86, 0x35, 0xa3, 0xdb,
+	0x32, 0x89, 0xe7, 0x5c, 0xba, 0xc8, 0xad, 0xce, 0x56, 0x17, 0xcb, 0xb4, 0x49, 0x94, 0xbd, 0x26,
+	0x51, 0xbe, 0xda, 0xdd, 0xa9, 0x8b, 0x3f, 0x3f, 0x2e, 0xe5, 0x87, 0x0b, 0xf1, 0xba, 0x6b, 0x80,
+	0x32, 0x97, 0xb2, 0x4a, 0xe0, 0xbb, 0x96, 0xfd, 0xf2, 0xdb, 0xc2, 0x84, 0x2f, 0x29, 0x12, 0xac,
+	0x44, 0x45, 0xcc, 0x4e, 0x60, 0x15, 0xa6, 0x65, 0x1a, 0x61, 0x6c, 0xec, 0x1e, 0x50, 0xfc, 0x8d,
+	0x83, 0xe5, 0x60, 0xa6, 0xa9, 0xd1, 0xf1, 0x2a, 0xf8, 0x2d, 0x58, 0xa4, 0xb9, 0xa4, 0x19, 0x69,
+	0x79, 0x74, 0x26, 0x63, 0xd4, 0x79, 0xd5, 0xef, 0x99, 0x48, 0x0e, 0xa3, 0xe4, 0x1f, 0xa4, 0x20,
+	0x17, 0xcc, 0xd8, 0x1d, 0xcd, 0xbe, 0x37, 0x66, 0x9d, 0xfc, 0xd7, 0x0e, 0x7b, 0x16, 0xb2, 0x34,
+	0x37, 0x43, 0x25, 0x35, 0xa7, 0x06, 0x0e, 0x5b, 0x15, 0x96, 0x02, 0x29, 0x64, 0xe8, 0x34, 0x41,
+	0x1f, 0xf7, 0x65, 0x8a, 0xe9, 0xac, 0x0f, 0xe9, 0xc8, 0xd8, 0x4d, 0xdb, 0x91, 0x22, 0xb7, 0x9a,
+	0x09, 0x66, 0x17, 0xd3, 0x9d, 0x8d, 0x28, 0xdf, 0xa9, 0x67, 0x5c, 0xbe, 0x5f, 0x71, 0x50, 0x1c,
+	0xb5, 0x1b, 0x09, 0x6e, 0x91, 0xc3, 0x2c, 0x2e, 0xf1, 0x45, 0x78, 0x61, 0x64, 0xd5, 0xb3, 0x16,
+	0xf3, 0x68, 0x12, 0xc4, 0x28, 0x54, 0x30, 0xee, 0xff, 0xf5, 0x90, 0x44, 0x6c, 0x63, 0xea, 0x19,
+	0x6f, 0xe3, 0x45, 0x58, 0x8b, 0x4f, 0x0a, 0xcb, 0xe1, 0x4f, 0x1c, 0xac, 0x44, 0xc1, 0xc7, 0xbe,
+	0x5c, 0x0e, 0x33, 0x7b, 0x49, 0x6f, 0xa3, 0x73, 0x70, 0x66, 0xbf, 0x18, 0x58, 0xb0, 0x5f, 0x4f,
+	0xc2, 0xb1, 0x26, 0x56, 0x6f, 0xf6, 0xb6, 0x74, 0xcd, 0xbe, 0x61, 0x19, 0xa6, 0x81, 0xe5, 0xce,
+	0x48, 0xc6, 0xdc, 0x18, 0x8c, 0x57, 0x60, 0xc6, 0x24, 0x76, 0xbd, 0x36, 0x34, 0x23, 0x0d, 0x16,
+	0xf6, 0xbd, 0xaf, 0x5e, 0x72, 0x64, 0x18, 0xcb, 0x2a, 0xc2, 0xb9, 0x74, 0x31, 0x35, 0xaa, 0x44,
+	0x24, 0x86, 0xe2, 0x2f, 0x40, 0x1a, 0xf5, 0x51, 0x9b, 0x34, 0x91, 0x6c, 0x75, 0x29, 0xd4, 0xed,
+	0x1a, 0x7d, 0xd4, 0x96, 0x08, 0xa4, 0xc6, 0x7b, 0x35, 0x32, 0x20, 0x23, 0xbe, 0x0a, 0xcb, 0xa1,
+	0x5c, 0xb0, 0x63, 0x5e, 0x80, 0x59, 0xd3, 0x5d, 0x1b, 0x9c, 0x74, 0xf0, 0x96, 0x36, 0x15, 0xb1,
+	0x4f, 0x46, 0x2a, 0xa7, 0x41, 0x28, 0x96, 0x7c, 0x9f, 0xe5, 0x32, 0x4e, 0xcf, 0x7f, 0x07, 0x4e,
+	0x26, 0xbc, 0x03, 0x6b, 0x47, 0x1d, 0xe6, 0xde, 0x97, 0x3b, 0x27, 0x0d, 0x7b, 0x66, 0x7b, 0xbc,
+	0xcb, 0xc1, 0x74, 0x13, 0xab, 0xef, 0x1b, 0x76, 0x7c, 0x14, 0x4e, 0x71, 0x6f, 0x1b, 0x36, 0xb2,
+	0x62, 0xb9, 0x50, 0x18, 0xbf, 0x01, 0x53, 0x86, 0x69, 0x6b, 0x06, 0xbd, 0xf0, 0xb2, 0xd5, 0x53,
+	0xa1, 0xa4, 0x3b, 0x7e, 0xdf, 0x23, 0x10, 0xc9, 0x85, 0x06, 0x76, 0x3d, 0x3d, 0xb4, 0xeb, 0x07,
+	0xd8, 0x43, 0x5a, 0xf0, 0x84, 0x87, 0x78, 0x0c, 0xe6, 0xdd, 0x18, 0x59, 0xdc, 0x3a, 0x09, 0xdb,
+	0xc1, 0xc7, 0x87, 0x7d, 0x09, 0x32, 0x8e, 0xc9, 0x9e, 0x6d, 0xc4, 0x47, 0xce, 0x90, 0xb5, 0x59,
+	0x87, 0xc0, 0x14, 0xd6, 0xd4, 0x2e, 0xb2, 0x44, 0x09, 0xe6, 0x5d, 0x77, 0xac, 0x66, 0x5e, 0x83,
+	0x29, 0x0b, 0xe1, 0x5e, 0xc7, 0x26, 0x36, 0xb3, 0xd5, 0xf3, 0xa1, 0x68, 0xbc, 0xcd, 0x6a, 0xb8,
+	0x26, 0x25, 0x02, 0x97, 0x5c, 0x35, 0xb1, 0x03, 0x73, 0x4d, 0xac, 0xbe, 0x83, 0xe4, 0x6d, 0xf7,
+	0x91, 0x35, 0xc6, 0xc0, 0xb4, 0xcf, 0xb8, 0x38, 0x54, 0x47, 0x27, 0x61, 0x29, 0xe0, 0x8d, 0x65,
+	0xf2, 0x1f, 0x3a, 0xb9, 0x5e, 0x47, 0x1d, 0xa4, 0x7a, 0x0d, 0x85, 0x94, 0xd3, 0xcb, 0x30, 0xa3,
+	0xd0, 0x45, 0xc3, 0x8a, 0x25, 0x34, 0x80, 0xee, 0x37, 0xc1, 0x5e, 0x82, 0x8c, 0x8b, 0x43, 0xf1,
+	0x33, 0x94, 0x87, 0xe4, 0x5f, 0x07, 0x40, 0x7d, 0x53, 0xb3, 0x64, 0x52, 0x8a, 0x74, 0xac, 0x15,
+	0x42, 0xdd, 0xe2, 0x96, 0xf7, 0xf6, 0xad, 0xa7, 0x1f, 0xfe, 0x51, 0xe0, 0x24, 0x9f, 0x4e, 0x2d,
+	0x4b, 0x9a, 0x01, 0xa3, 0x28, 0xe6, 0x61, 0x25, 0x2a, 0x64, 0x96, 0x93, 0xcf, 0xe8, 0xe3, 0xa3,
+	0xab, 0x3c, 0x87, 0xa4, 0x84, 0xc8, 0xb9, 0x8f, 0x8d, 0xae, 0x32, 0x8a, 0xde, 0xda, 0x1a, 0xa4,
+	0x49, 0xe5, 0x2f, 0xc2, 0x42, 0xe3, 0x83, 0xc6, 0xb5, 0xd6, 0xed, 0x77, 0x6f, 0xde, 0x68, 0x5c,
+	0xdb, 0x7c, 0x63, 0xb3, 0x71, 0x7d, 0x61, 0x82, 0x3f, 0x0a, 0x19, 0xb2, 0x7a, 0x4b, 0xfa, 0x70,
+	0x81, 0xab, 0x7e, 0x33, 0x07, 0xa9, 0x26, 0x56, 0xf9, 0x3b, 0x30, 0xeb, 0x7f, 0xd0, 0x17, 0xc2,
+	0xd3, 0x62, 0x60, 0x16, 0x12, 0xce, 0xc7, 0x00, 0xd8, 0x39, 0xe8, 0x00, 0x1f, 0xf1, 0x92, 0x3e,
+	0x17, 0xa5, 0x1e, 0xc6, 0x09, 0xe5, 0x64, 0x38, 0xe6, 0xed, 0x2e, 0x2c, 0x84, 0x1e, 0xb6, 0x67,
+	0x62, 0x6c, 0x10, 0x94, 0x70, 0x31, 0x09, 0x8a, 0xf9, 0x31, 0xe0, 0x78, 0xd4, 0xdb, 0xf3, 0x7c,
+	0x2c, 0x5d, 0x0a, 0x14, 0x2a, 0x09, 0x81, 0xcc, 0xa1, 0x06, 0xc7, 0xc2, 0x8f, 0xc7, 0xb3, 0x31,
+	0x9b, 0x40, 0x61, 0x42, 0x29, 0x11, 0x8c, 0xb9, 0xea, 0xc1, 0x52, 0xf4, 0x1b, 0xe4, 0x42, 0x8c,
+	0x9d, 0x01, 0x54, 0x58, 0x4f, 0x0c, 0x65, 0x6e, 0xfb, 0x70, 0x62, 0xc4, 0xbb, 0x6e, 0x2d, 0x26,
+	0x59, 0x3e, 0xac, 0x50, 0x4d, 0x8e, 0x65, 0x9e, 0x1f, 0x71, 0x50, 0x88, 0x1b, 0x9b, 0x37, 0x12,
+	0xd9, 0x0d, 0x2a, 0x09, 0xaf, 0x8c, 0xa1, 0xc4, 0x58, 0x7d, 0xce, 0xc1, 0xf2, 0xe8, 0x41, 0xb4,
+	0x94, 0xc8, 0x34, 0xab, 0xb7, 0xcb, 0x07, 0x82, 0x33, 0x0e, 0x1f, 0x43, 0x76, 0x68, 0x3c, 0x14,
+	0xa3, 0x0c, 0x05, 0x31, 0xc2, 0x5a, 0x3c, 0xc6, 0x7f, 0x60, 0x43, 0x63, 0x53, 0xe4, 0x81, 0x1d,
+	0x46, 0x09, 0x17, 0x93, 0xa0, 0x98, 0x9f, 0x3a, 0xa4, 0x49, 0x83, 0xce, 0x45, 0x69, 0x39, 0x12,
+	0xa1, 0x38, 0x4a, 0xe2, 0xb7, 0x41, 0xfa, 0x6a, 0xa4, 0x0d, 0x47, 0x22, 0x14, 0x47, 0x49, 0x98,
+	0x8d, 0x5b, 0x00, 0xbe, 0x2b, 0x3d, 0x1f, 0x85, 0x1f, 0xc8, 0x85, 0x73, 0xfb, 0xcb, 0xfd, 0xdd,
+	0x21, 0x7c, 0x41, 0x47, 0x76, 0x87, 0x10, 0x4c, 0x28, 0x25, 0x82, 0x05, 0x3a, 0x5f, 0xc4, 0xc5,
+	0x17, 0xdd, 0xf9, 0xc2, 0x40, 0xa1, 0x92, 0x10, 0xe8, 0x39, 0xac, 0x5f, 0x79, 0xb2, 0x9b, 0xe7,
+	0x9e, 0xee, 0xe6, 0xb9, 0x3f, 0x77, 0xf3, 0xdc, 0xc3, 0xbd, 0xfc, 0xc4, 0xd3, 0xbd, 0xfc, 0xc4,
+	0xaf, 0x7b, 0xf9, 0x89, 0x8f, 0xce, 0xa8, 0x9a, 0x7d, 0xaf, 0xb7, 0x55, 0x6e, 0x1b, 0xba, 0xfb,
+	0x9b, 0xb8, 0xfb, 0xa7, 0x84, 0x95, 0x4f, 0x2a, 0x7d, 0xfa, 0xb3, 0xf6, 0xd6, 0x14, 0x19, 0x01,
+	0x36, 0xfe, 0x1d, 0x00, 0x4b, 0x90, 0xdd, 0x29, 0x85, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1618,6 +1839,11 @@ type MsgClient interface {
 	Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error)
+	// DelegateGroupVote delegates the vote of a group member to another member
+	// of the group.
+	DelegateGroupVote(ctx context.Context, in *MsgDelegateGroupVote, opts ...grpc.CallOption) (*MsgDelegateGroupVoteResponse, error)
+	// UndelegateGroupVote revokes the vote delegation of a group member.
+	UndelegateGroupVote(ctx context.Context, in *MsgUndelegateGroupVote, opts ...grpc.CallOption) (*MsgUndelegateGroupVoteResponse, error)
 }
 
 type msgClient struct {
@@ -1754,6 +1980,24 @@ func (c *msgClient) LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) DelegateGroupVote(ctx context.Context, in *MsgDelegateGroupVote, opts ...grpc.CallOption) (*MsgDelegateGroupVoteResponse, error) {
+	out := new(MsgDelegateGroupVoteResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/DelegateGroupVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UndelegateGroupVote(ctx context.Context, in *MsgUndelegateGroupVote, opts ...grpc.CallOption) (*MsgUndelegateGroupVoteResponse, error) {
+	out := new(MsgUndelegateGroupVoteResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/UndelegateGroupVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateGroup creates a new group with an admin account address, a list of members and some optional metadata.
@@ -1784,6 +2028,11 @@ type MsgServer interface {
 	Exec(context.Context, *MsgExec) (*MsgExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(context.Context, *MsgLeaveGroup) (*MsgLeaveGroupResponse, error)
+	// DelegateGroupVote delegates the vote of a group member to another member
+	// of the group.
+	DelegateGroupVote(context.Context, *MsgDelegateGroupVote) (*MsgDelegateGroupVoteResponse, error)
+	// UndelegateGroupVote revokes the vote delegation of a group member.
+	UndelegateGroupVote(context.Context, *MsgUndelegateGroupVote) (*MsgUndelegateGroupVoteResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1832,6 +2081,12 @@ func (*UnimplementedMsgServer) Exec(ctx context.Context, req *MsgExec) (*MsgExec
 func (*UnimplementedMsgServer) LeaveGroup(ctx context.Context, req *MsgLeaveGroup) (*MsgLeaveGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaveGroup not implemented")
 }
+func (*UnimplementedMsgServer) DelegateGroupVote(ctx context.Context, req *MsgDelegateGroupVote) (*MsgDelegateGroupVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateGroupVote not implemented")
+}
+func (*UnimplementedMsgServer) UndelegateGroupVote(ctx context.Context, req *MsgUndelegateGroupVote) (*MsgUndelegateGroupVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateGroupVote not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -2089,6 +2344,42 @@ func _Msg_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DelegateGroupVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateGroupVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateGroupVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Msg/DelegateGroupVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateGroupVote(ctx, req.(*MsgDelegateGroupVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UndelegateGroupVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateGroupVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateGroupVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Msg/UndelegateGroupVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateGroupVote(ctx, req.(*MsgUndelegateGroupVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.group.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -2149,6 +2440,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LeaveGroup",
 			Handler:    _Msg_LeaveGroup_Handler,
 		},
+		{
+			MethodName: "DelegateGroupVote",
+			Handler:    _Msg_DelegateGroupVote_Handler,
+		},
+		{
+			MethodName: "UndelegateGroupVote",
+			Handler:    _Msg_UndelegateGroupVote_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/tx.proto",
@@ -3187,60 +3486,193 @@ func (m *MsgLeaveGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgDelegateGroupVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateGroup) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgDelegateGroupVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateGroupVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Members) > 0 {
-		for _, e := range m.Members {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if m.Expiration != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err4 != nil {
+			return 0, err4
 		}
+		i -= n4
+		i = encodeVarintTx(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if m.GroupId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateGroupResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDelegateGroupVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateGroupVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateGroupVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovTx(uint64(m.GroupId))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateGroupMembers) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUndelegateGroupVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateGroupVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateGroupVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Admin)
-	if l > 0 {
+	if m.GroupId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateGroupVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateGroupVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateGroupVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovTx(uint64(m.GroupId))
+	}
+	return n
+}
+
+func (m *MsgUpdateGroupMembers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	if m.GroupId != 0 {
@@ -3656,6 +4088,64 @@ func (m *MsgLeaveGroupResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgDelegateGroupVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovTx(uint64(m.GroupId))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDelegateGroupVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUndelegateGroupVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovTx(uint64(m.GroupId))
+	}
+	return n
+}
+
+func (m *MsgUndelegateGroupVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6571,6 +7061,376 @@ func (m *MsgLeaveGroupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgDelegateGroupVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateGroupVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateGroupVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateGroupVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateGroupVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateGroupVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUndelegateGroupVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUndelegateGroupVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUndelegateGroupVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUndelegateGroupVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUndelegateGroupVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUndelegateGroupVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
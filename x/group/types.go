@@ -231,6 +231,150 @@ func (p PercentageDecisionPolicy) Allow(tally TallyResult, totalPower string) (D
 	return DecisionPolicyResult{Allow: false, Final: false}, nil
 }
 
+// Implements DecisionPolicy Interface
+var _ DecisionPolicy = &QuorumThresholdDecisionPolicy{}
+
+// NewQuorumThresholdDecisionPolicy creates a new quorum threshold DecisionPolicy
+func NewQuorumThresholdDecisionPolicy(quorum string, threshold string, votingPeriod time.Duration, minExecutionPeriod time.Duration) DecisionPolicy {
+	return &QuorumThresholdDecisionPolicy{quorum, threshold, &DecisionPolicyWindows{votingPeriod, minExecutionPeriod}}
+}
+
+func (p QuorumThresholdDecisionPolicy) GetVotingPeriod() time.Duration {
+	return p.Windows.VotingPeriod
+}
+
+func (p QuorumThresholdDecisionPolicy) GetMinExecutionPeriod() time.Duration {
+	return p.Windows.MinExecutionPeriod
+}
+
+func (p QuorumThresholdDecisionPolicy) ValidateBasic() error {
+	if err := validatePercentage(p.Quorum); err != nil {
+		return sdkerrors.Wrap(err, "quorum")
+	}
+	if err := validatePercentage(p.Threshold); err != nil {
+		return sdkerrors.Wrap(err, "threshold")
+	}
+
+	if p.Windows == nil || p.Windows.VotingPeriod == 0 {
+		return sdkerrors.Wrap(errors.ErrInvalid, "voting period cannot be 0")
+	}
+
+	return nil
+}
+
+func (p *QuorumThresholdDecisionPolicy) Validate(g GroupInfo, config Config) error {
+	if p.Windows.MinExecutionPeriod > p.Windows.VotingPeriod+config.MaxExecutionPeriod {
+		return sdkerrors.Wrap(errors.ErrInvalid, "min_execution_period should be smaller than voting_period + max_execution_period")
+	}
+	return nil
+}
+
+// Allow allows a proposal to pass when the votes reach the quorum, and the
+// tally of yes votes equals or exceeds the threshold of the non-abstaining
+// votes. The result is final before the timeout only when the undecided
+// voters can't change it anymore.
+func (p QuorumThresholdDecisionPolicy) Allow(tally TallyResult, totalPower string) (DecisionPolicyResult, error) {
+	quorum, err := math.NewPositiveDecFromString(p.Quorum)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "quorum")
+	}
+	threshold, err := math.NewPositiveDecFromString(p.Threshold)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "threshold")
+	}
+	yesCount, err := tally.GetYesCount()
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "yes count")
+	}
+	abstainCount, err := tally.GetAbstainCount()
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "abstain count")
+	}
+	totalPowerDec, err := math.NewNonNegativeDecFromString(totalPower)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "total power")
+	}
+
+	totalCounts, err := tally.TotalCounts()
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	undecided, err := math.SubNonNegative(totalPowerDec, totalCounts)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	nonAbstainCounts, err := math.SubNonNegative(totalCounts, abstainCount)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+
+	quorumReached, err := reachesPercentage(totalCounts, totalPowerDec, quorum)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	thresholdReached, err := reachesPercentage(yesCount, nonAbstainCounts, threshold)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	allow := quorumReached && thresholdReached
+
+	// The proposal passes whatever the undecided voters vote if the threshold
+	// is still reached when they all vote no.
+	maxNonAbstainCounts, err := nonAbstainCounts.Add(undecided)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	minThresholdReached, err := reachesPercentage(yesCount, maxNonAbstainCounts, threshold)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if quorumReached && minThresholdReached {
+		return DecisionPolicyResult{Allow: true, Final: true}, nil
+	}
+
+	// The proposal is rejected whatever the undecided voters vote if the
+	// threshold isn't reached when they all vote yes.
+	maxYesCount, err := yesCount.Add(undecided)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	maxThresholdReached, err := reachesPercentage(maxYesCount, maxNonAbstainCounts, threshold)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if !maxThresholdReached {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	return DecisionPolicyResult{Allow: allow, Final: false}, nil
+}
+
+// reachesPercentage returns whether `count / total >= percentage`. It returns
+// false when total is zero.
+func reachesPercentage(count, total, percentage math.Dec) (bool, error) {
+	if total.IsZero() {
+		return false, nil
+	}
+	ratio, err := count.Quo(total)
+	if err != nil {
+		return false, err
+	}
+	return ratio.Cmp(percentage) >= 0, nil
+}
+
+// validatePercentage returns an error if the given string isn't a decimal in
+// the (0, 1] range.
+func validatePercentage(s string) error {
+	percentage, err := math.NewPositiveDecFromString(s)
+	if err != nil {
+		return err
+	}
+	if percentage.Cmp(math.NewDecFromInt64(1)) == 1 {
+		return sdkerrors.Wrap(errors.ErrInvalid, "percentage must be > 0 and <= 1")
+	}
+	return nil
+}
+
 var _ orm.Validateable = GroupPolicyInfo{}
 
 // NewGroupPolicyInfo creates a new GroupPolicyInfo instance
@@ -426,6 +570,36 @@ func (v Vote) ValidateBasic() error {
 	return nil
 }
 
+func (d VoteDelegation) PrimaryKeyFields() []interface{} {
+	addr := sdk.MustAccAddressFromBech32(d.Delegator)
+
+	return []interface{}{d.GroupId, addr.Bytes()}
+}
+
+var _ orm.Validateable = VoteDelegation{}
+
+func (d VoteDelegation) ValidateBasic() error {
+	if d.GroupId == 0 {
+		return sdkerrors.Wrap(errors.ErrEmpty, "vote delegation group id")
+	}
+	if _, err := sdk.AccAddressFromBech32(d.Delegator); err != nil {
+		return sdkerrors.Wrap(err, "delegator")
+	}
+	if _, err := sdk.AccAddressFromBech32(d.Delegate); err != nil {
+		return sdkerrors.Wrap(err, "delegate")
+	}
+	if d.Delegator == d.Delegate {
+		return sdkerrors.Wrap(errors.ErrInvalid, "delegator and delegate must be different")
+	}
+	return nil
+}
+
+// IsActive returns whether the vote delegation is taken into account at the
+// given time.
+func (d VoteDelegation) IsActive(blockTime time.Time) bool {
+	return d.Expiration == nil || d.Expiration.After(blockTime)
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (q QueryGroupPoliciesByGroupResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return unpackGroupPolicies(unpacker, q.GroupPolicies)
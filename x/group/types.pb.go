@@ -297,10 +297,10 @@ func (m *MemberRequest) GetMetadata() string {
 
 // ThresholdDecisionPolicy is a decision policy where a proposal passes when it
 // satisfies the two following conditions:
-// 1. The sum of all `YES` voters' weights is greater or equal than the defined
-//    `threshold`.
-// 2. The voting and execution periods of the proposal respect the parameters
-//    given by `windows`.
+//  1. The sum of all `YES` voters' weights is greater or equal than the defined
+//     `threshold`.
+//  2. The voting and execution periods of the proposal respect the parameters
+//     given by `windows`.
 type ThresholdDecisionPolicy struct {
 	// threshold is the minimum weighted sum of `YES` votes that must be met or
 	// exceeded for a proposal to succeed.
@@ -358,10 +358,10 @@ func (m *ThresholdDecisionPolicy) GetWindows() *DecisionPolicyWindows {
 
 // PercentageDecisionPolicy is a decision policy where a proposal passes when
 // it satisfies the two following conditions:
-// 1. The percentage of all `YES` voters' weights out of the total group weight
-//    is greater or equal than the given `percentage`.
-// 2. The voting and execution periods of the proposal respect the parameters
-//    given by `windows`.
+//  1. The percentage of all `YES` voters' weights out of the total group weight
+//     is greater or equal than the given `percentage`.
+//  2. The voting and execution periods of the proposal respect the parameters
+//     given by `windows`.
 type PercentageDecisionPolicy struct {
 	// percentage is the minimum percentage the weighted sum of `YES` votes must
 	// meet for a proposal to succeed.
@@ -417,6 +417,80 @@ func (m *PercentageDecisionPolicy) GetWindows() *DecisionPolicyWindows {
 	return nil
 }
 
+// QuorumThresholdDecisionPolicy is a decision policy where a proposal passes
+// when it satisfies the three following conditions:
+//  1. The percentage of the total group weight which voted (including the
+//     `ABSTAIN` votes) is greater or equal than the given `quorum`.
+//  2. The percentage of the `YES` votes' weights out of the non-abstaining
+//     votes' weights is greater or equal than the given `threshold`.
+//  3. The voting and execution periods of the proposal respect the parameters
+//     given by `windows`.
+type QuorumThresholdDecisionPolicy struct {
+	// quorum is the minimum percentage of the total group weight that must vote
+	// for a proposal to succeed.
+	Quorum string `protobuf:"bytes,1,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	// threshold is the minimum percentage the weighted sum of `YES` votes must
+	// meet out of the weighted sum of the non-abstaining votes for a proposal
+	// to succeed.
+	Threshold string `protobuf:"bytes,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// windows defines the different windows for voting and execution.
+	Windows *DecisionPolicyWindows `protobuf:"bytes,3,opt,name=windows,proto3" json:"windows,omitempty"`
+}
+
+func (m *QuorumThresholdDecisionPolicy) Reset()         { *m = QuorumThresholdDecisionPolicy{} }
+func (m *QuorumThresholdDecisionPolicy) String() string { return proto.CompactTextString(m) }
+func (*QuorumThresholdDecisionPolicy) ProtoMessage()    {}
+func (*QuorumThresholdDecisionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{4}
+}
+func (m *QuorumThresholdDecisionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuorumThresholdDecisionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuorumThresholdDecisionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuorumThresholdDecisionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuorumThresholdDecisionPolicy.Merge(m, src)
+}
+func (m *QuorumThresholdDecisionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuorumThresholdDecisionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuorumThresholdDecisionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuorumThresholdDecisionPolicy proto.InternalMessageInfo
+
+func (m *QuorumThresholdDecisionPolicy) GetQuorum() string {
+	if m != nil {
+		return m.Quorum
+	}
+	return ""
+}
+
+func (m *QuorumThresholdDecisionPolicy) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
+	}
+	return ""
+}
+
+func (m *QuorumThresholdDecisionPolicy) GetWindows() *DecisionPolicyWindows {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
+}
+
 // DecisionPolicyWindows defines the different windows for voting and execution.
 type DecisionPolicyWindows struct {
 	// voting_period is the duration from submission of a proposal to the end of voting period
@@ -440,7 +514,7 @@ func (m *DecisionPolicyWindows) Reset()         { *m = DecisionPolicyWindows{} }
 func (m *DecisionPolicyWindows) String() string { return proto.CompactTextString(m) }
 func (*DecisionPolicyWindows) ProtoMessage()    {}
 func (*DecisionPolicyWindows) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{4}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{5}
 }
 func (m *DecisionPolicyWindows) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -506,7 +580,7 @@ func (m *GroupInfo) Reset()         { *m = GroupInfo{} }
 func (m *GroupInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupInfo) ProtoMessage()    {}
 func (*GroupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{5}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{6}
 }
 func (m *GroupInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -589,7 +663,7 @@ func (m *GroupMember) Reset()         { *m = GroupMember{} }
 func (m *GroupMember) String() string { return proto.CompactTextString(m) }
 func (*GroupMember) ProtoMessage()    {}
 func (*GroupMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{6}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{7}
 }
 func (m *GroupMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -655,7 +729,7 @@ func (m *GroupPolicyInfo) Reset()         { *m = GroupPolicyInfo{} }
 func (m *GroupPolicyInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupPolicyInfo) ProtoMessage()    {}
 func (*GroupPolicyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{7}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{8}
 }
 func (m *GroupPolicyInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -730,7 +804,7 @@ func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{8}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{9}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -775,7 +849,7 @@ func (m *TallyResult) Reset()         { *m = TallyResult{} }
 func (m *TallyResult) String() string { return proto.CompactTextString(m) }
 func (*TallyResult) ProtoMessage()    {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{9}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -822,7 +896,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{11}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -886,6 +960,82 @@ func (m *Vote) GetSubmitTime() time.Time {
 	return time.Time{}
 }
 
+// VoteDelegation represents the delegation of the vote of a group member to
+// another member of the same group.
+type VoteDelegation struct {
+	// group_id is the unique ID of the group.
+	GroupId uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// delegator is the account address of the member delegating its vote.
+	Delegator string `protobuf:"bytes,2,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// delegate is the account address of the member voting on behalf of the delegator.
+	Delegate string `protobuf:"bytes,3,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	// expiration is the time after which the delegation is no longer taken
+	// into account. A nil expiration means the delegation lasts until it is
+	// revoked.
+	Expiration *time.Time `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+}
+
+func (m *VoteDelegation) Reset()         { *m = VoteDelegation{} }
+func (m *VoteDelegation) String() string { return proto.CompactTextString(m) }
+func (*VoteDelegation) ProtoMessage()    {}
+func (*VoteDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{12}
+}
+func (m *VoteDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteDelegation.Merge(m, src)
+}
+func (m *VoteDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteDelegation proto.InternalMessageInfo
+
+func (m *VoteDelegation) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+func (m *VoteDelegation) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *VoteDelegation) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
+func (m *VoteDelegation) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.group.v1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.group.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
@@ -894,6 +1044,7 @@ func init() {
 	proto.RegisterType((*MemberRequest)(nil), "cosmos.group.v1.MemberRequest")
 	proto.RegisterType((*ThresholdDecisionPolicy)(nil), "cosmos.group.v1.ThresholdDecisionPolicy")
 	proto.RegisterType((*PercentageDecisionPolicy)(nil), "cosmos.group.v1.PercentageDecisionPolicy")
+	proto.RegisterType((*QuorumThresholdDecisionPolicy)(nil), "cosmos.group.v1.QuorumThresholdDecisionPolicy")
 	proto.RegisterType((*DecisionPolicyWindows)(nil), "cosmos.group.v1.DecisionPolicyWindows")
 	proto.RegisterType((*GroupInfo)(nil), "cosmos.group.v1.GroupInfo")
 	proto.RegisterType((*GroupMember)(nil), "cosmos.group.v1.GroupMember")
@@ -901,94 +1052,100 @@ func init() {
 	proto.RegisterType((*Proposal)(nil), "cosmos.group.v1.Proposal")
 	proto.RegisterType((*TallyResult)(nil), "cosmos.group.v1.TallyResult")
 	proto.RegisterType((*Vote)(nil), "cosmos.group.v1.Vote")
+	proto.RegisterType((*VoteDelegation)(nil), "cosmos.group.v1.VoteDelegation")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/types.proto", fileDescriptor_f5bddd15d7a54a9d) }
 
 var fileDescriptor_f5bddd15d7a54a9d = []byte{
-	// 1299 bytes of a gzipped FileDescriptorProto
+	// 1381 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xf6, 0xda, 0x8e, 0x3f, 0x5e, 0xa7, 0xb6, 0x99, 0x86, 0x66, 0x93, 0x14, 0x3b, 0x98, 0x0a,
-	0xa2, 0xa2, 0xd8, 0x6d, 0x2a, 0x81, 0xd4, 0x03, 0x60, 0x3b, 0x5b, 0xea, 0xaa, 0xb5, 0xad, 0xdd,
-	0x75, 0x42, 0xb9, 0xac, 0x36, 0xde, 0xe9, 0x66, 0x85, 0xbd, 0x63, 0x76, 0xc7, 0x49, 0xfd, 0x0f,
-	0x7a, 0x41, 0xf4, 0xc8, 0x05, 0xa9, 0x12, 0xbf, 0x00, 0xa9, 0x07, 0xc4, 0x85, 0x6b, 0xd5, 0x03,
-	0xaa, 0x38, 0x71, 0x02, 0xd4, 0x5e, 0xe0, 0xc4, 0x95, 0x23, 0xda, 0x99, 0xd9, 0xc4, 0x1f, 0x89,
-	0x69, 0x2a, 0xe0, 0x94, 0xcc, 0x3c, 0xcf, 0xfb, 0xce, 0xf3, 0x7e, 0xae, 0x0c, 0x6b, 0x5d, 0xe2,
-	0xf7, 0x89, 0x5f, 0xb1, 0x3d, 0x32, 0x1c, 0x54, 0x0e, 0xae, 0x56, 0xe8, 0x68, 0x80, 0xfd, 0xf2,
-	0xc0, 0x23, 0x94, 0xa0, 0x1c, 0x07, 0xcb, 0x0c, 0x2c, 0x1f, 0x5c, 0x5d, 0x5d, 0xb2, 0x89, 0x4d,
-	0x18, 0x56, 0x09, 0xfe, 0xe3, 0xb4, 0xd5, 0x82, 0x4d, 0x88, 0xdd, 0xc3, 0x15, 0x76, 0xda, 0x1b,
-	0xde, 0xab, 0x58, 0x43, 0xcf, 0xa4, 0x0e, 0x71, 0x05, 0x5e, 0x9c, 0xc6, 0xa9, 0xd3, 0xc7, 0x3e,
-	0x35, 0xfb, 0x03, 0x41, 0x58, 0xe1, 0xef, 0x18, 0xdc, 0xb3, 0x78, 0x54, 0x40, 0xd3, 0xb6, 0xa6,
-	0x3b, 0xe2, 0x50, 0xe9, 0x5b, 0x09, 0x12, 0x77, 0x70, 0x7f, 0x0f, 0x7b, 0x68, 0x0b, 0x92, 0xa6,
-	0x65, 0x79, 0xd8, 0xf7, 0x65, 0x69, 0x5d, 0xda, 0x48, 0xd7, 0xe4, 0x9f, 0x1e, 0x6f, 0x2e, 0x09,
-	0x47, 0x55, 0x8e, 0x68, 0xd4, 0x73, 0x5c, 0x5b, 0x0d, 0x89, 0xe8, 0x02, 0x24, 0x0e, 0xb1, 0x63,
-	0xef, 0x53, 0x39, 0x1a, 0x98, 0xa8, 0xe2, 0x84, 0x56, 0x21, 0xd5, 0xc7, 0xd4, 0xb4, 0x4c, 0x6a,
-	0xca, 0x31, 0x86, 0x1c, 0x9d, 0xd1, 0x87, 0x90, 0x32, 0x2d, 0x0b, 0x5b, 0x86, 0x49, 0xe5, 0xf8,
-	0xba, 0xb4, 0x91, 0xd9, 0x5a, 0x2d, 0x73, 0x81, 0xe5, 0x50, 0x60, 0x59, 0x0f, 0x83, 0xab, 0xa5,
-	0x9e, 0xfc, 0x52, 0x8c, 0x3c, 0xfc, 0xb5, 0x28, 0xb1, 0x47, 0xb1, 0x55, 0xa5, 0xa5, 0x43, 0x38,
-	0xc7, 0x25, 0xab, 0xf8, 0xf3, 0x21, 0xf6, 0xe9, 0xff, 0xa5, 0xbc, 0xf4, 0x85, 0x04, 0xcb, 0xfa,
-	0xbe, 0x87, 0xfd, 0x7d, 0xd2, 0xb3, 0xb6, 0x71, 0xd7, 0xf1, 0x1d, 0xe2, 0xb6, 0x49, 0xcf, 0xe9,
-	0x8e, 0xd0, 0x45, 0x48, 0xd3, 0x10, 0xe2, 0x2a, 0xd4, 0xe3, 0x0b, 0xf4, 0x11, 0x24, 0x0f, 0x1d,
-	0xd7, 0x22, 0x87, 0x3e, 0x7b, 0x2e, 0xb3, 0xf5, 0x76, 0x79, 0xaa, 0x2d, 0xca, 0x93, 0xfe, 0x76,
-	0x39, 0x5b, 0x0d, 0xcd, 0xae, 0xa3, 0xa7, 0x8f, 0x37, 0xb3, 0x93, 0x9c, 0xd2, 0x43, 0x09, 0xe4,
-	0x36, 0xf6, 0xba, 0xd8, 0xa5, 0xa6, 0x8d, 0xa7, 0x04, 0x15, 0x00, 0x06, 0x47, 0x98, 0x50, 0x34,
-	0x76, 0xf3, 0x1f, 0x49, 0xfa, 0x4e, 0x82, 0xd7, 0x4f, 0x34, 0x43, 0x37, 0xe1, 0xdc, 0x01, 0xa1,
-	0x8e, 0x6b, 0x1b, 0x03, 0xec, 0x39, 0x84, 0x27, 0x29, 0xb3, 0xb5, 0x32, 0x53, 0xfb, 0x6d, 0xd1,
-	0xf8, 0xbc, 0xf4, 0x5f, 0x05, 0xa5, 0x5f, 0xe4, 0x96, 0x6d, 0x66, 0x88, 0x3a, 0xb0, 0xd4, 0x77,
-	0x5c, 0x03, 0xdf, 0xc7, 0xdd, 0x61, 0x40, 0x0c, 0x1d, 0x46, 0x5f, 0xde, 0x21, 0xea, 0x3b, 0xae,
-	0x12, 0xda, 0x73, 0xb7, 0xa5, 0x3f, 0x24, 0x48, 0x7f, 0x1c, 0x84, 0xde, 0x70, 0xef, 0x11, 0x94,
-	0x85, 0xa8, 0xc3, 0x35, 0xc6, 0xd5, 0xa8, 0x63, 0xa1, 0x32, 0x2c, 0x98, 0x56, 0xdf, 0x71, 0x79,
-	0xbb, 0xcc, 0xe9, 0x30, 0x4e, 0x9b, 0x3b, 0x01, 0x32, 0x24, 0x0f, 0xb0, 0x17, 0xa4, 0x88, 0x0d,
-	0x40, 0x5c, 0x0d, 0x8f, 0xe8, 0x4d, 0x58, 0xa4, 0x84, 0x9a, 0x3d, 0x43, 0xf4, 0xe6, 0x02, 0xb3,
-	0xcc, 0xb0, 0xbb, 0x5d, 0xde, 0xa0, 0x75, 0x80, 0xae, 0x87, 0x4d, 0xca, 0x07, 0x28, 0x71, 0x86,
-	0x01, 0x4a, 0x0b, 0xbb, 0x2a, 0x2d, 0xdd, 0x85, 0x0c, 0x0b, 0x55, 0x8c, 0xfe, 0x0a, 0xa4, 0x58,
-	0xd1, 0x8d, 0xa3, 0x90, 0x93, 0xec, 0xdc, 0xb0, 0x50, 0x05, 0x12, 0x7d, 0x46, 0x12, 0xe9, 0x5d,
-	0x9e, 0xe9, 0x12, 0x31, 0x8b, 0x82, 0x56, 0xfa, 0x2b, 0x0a, 0x39, 0xe6, 0x9b, 0x97, 0x9f, 0x25,
-	0xf3, 0x55, 0x06, 0x74, 0x5c, 0x53, 0x74, 0x52, 0xd3, 0x51, 0x2d, 0x62, 0x67, 0xaf, 0x45, 0xfc,
-	0xf4, 0x5a, 0x2c, 0x4c, 0xd6, 0xc2, 0x84, 0x9c, 0x25, 0x3a, 0xd9, 0x18, 0xb0, 0x58, 0x44, 0xb6,
-	0x97, 0x66, 0xb2, 0x5d, 0x75, 0x47, 0xb5, 0xd2, 0xd3, 0xc7, 0x9b, 0x85, 0xf9, 0x13, 0xa4, 0x66,
-	0xad, 0xc9, 0x19, 0x9d, 0xac, 0x65, 0xf2, 0x95, 0x6a, 0x79, 0x3d, 0xf5, 0xe0, 0x51, 0x31, 0xf2,
-	0xfb, 0xa3, 0xa2, 0x54, 0xfa, 0x61, 0x01, 0x52, 0x6d, 0x8f, 0x0c, 0x88, 0x6f, 0xf6, 0x66, 0x1a,
-	0xf8, 0x16, 0x2c, 0xf1, 0x7c, 0xf2, 0x58, 0x8c, 0xb0, 0x20, 0xff, 0xd4, 0xcf, 0xc8, 0x3e, 0x2e,
-	0xa6, 0x40, 0xe6, 0x36, 0xf7, 0x7b, 0x90, 0x1e, 0x30, 0x0d, 0xd8, 0xf3, 0xe5, 0xf8, 0x7a, 0x6c,
-	0xae, 0xf3, 0x63, 0x2a, 0x52, 0x20, 0xe3, 0x0f, 0xf7, 0xfa, 0x0e, 0x35, 0x82, 0x2f, 0x1b, 0x2b,
-	0xc6, 0xcb, 0x26, 0x03, 0xb8, 0x61, 0x00, 0xa1, 0xb7, 0xe0, 0x1c, 0x0f, 0x33, 0xac, 0x6a, 0x82,
-	0x65, 0x60, 0x91, 0x5d, 0xee, 0x88, 0xd2, 0x5e, 0x99, 0xca, 0x45, 0xc8, 0x4d, 0x32, 0xee, 0x78,
-	0xc4, 0xa1, 0xc5, 0xfb, 0x90, 0xf0, 0xa9, 0x49, 0x87, 0xbe, 0x9c, 0x5a, 0x97, 0x36, 0xb2, 0x5b,
-	0xc5, 0x99, 0x31, 0x08, 0x13, 0xaf, 0x31, 0x9a, 0x2a, 0xe8, 0xa8, 0x0d, 0xe8, 0x9e, 0xe3, 0x9a,
-	0x3d, 0x83, 0x9a, 0xbd, 0xde, 0xc8, 0xf0, 0xb0, 0x3f, 0xec, 0x51, 0x39, 0xcd, 0xa2, 0xbb, 0x38,
-	0xe3, 0x44, 0x0f, 0x48, 0x2a, 0xe3, 0xd4, 0xe2, 0x41, 0x7c, 0x6a, 0x9e, 0x59, 0x8f, 0xdd, 0xa3,
-	0x36, 0xbc, 0x36, 0xb1, 0x48, 0x0d, 0xec, 0x5a, 0x32, 0x9c, 0x21, 0x5d, 0xb9, 0xf1, 0x6d, 0xaa,
-	0xb8, 0x16, 0x6a, 0x43, 0x8e, 0x2f, 0x53, 0xe2, 0x85, 0x02, 0x33, 0x2c, 0xca, 0x77, 0x4e, 0x8d,
-	0x52, 0x11, 0x7c, 0xae, 0x49, 0xcd, 0xe2, 0x89, 0x33, 0xba, 0x12, 0x34, 0x88, 0xef, 0x9b, 0x36,
-	0xf6, 0xe5, 0xc5, 0xf5, 0xd8, 0x69, 0x43, 0xa3, 0x1e, 0xb1, 0xae, 0xc7, 0x83, 0x2e, 0x2e, 0x7d,
-	0x2d, 0x41, 0x66, 0x3c, 0xd6, 0x35, 0x48, 0x8f, 0xb0, 0x6f, 0x74, 0xc9, 0xd0, 0xa5, 0xe2, 0x1b,
-	0x96, 0x1a, 0x61, 0xbf, 0x1e, 0x9c, 0x83, 0x52, 0x9b, 0x7b, 0x3e, 0x35, 0x1d, 0x57, 0x10, 0xf8,
-	0x97, 0x7c, 0x51, 0x5c, 0x72, 0xd2, 0x0a, 0xa4, 0x5c, 0x22, 0x70, 0xde, 0xaa, 0x49, 0x97, 0x70,
-	0xe8, 0x5d, 0x40, 0x2e, 0x31, 0x0e, 0x1d, 0xba, 0x6f, 0x1c, 0x60, 0x1a, 0x92, 0xf8, 0x82, 0xc8,
-	0xb9, 0x64, 0xd7, 0xa1, 0xfb, 0x3b, 0x98, 0x72, 0xb2, 0xd0, 0xf7, 0xa7, 0x04, 0xf1, 0x1d, 0x42,
-	0x31, 0x2a, 0x42, 0x66, 0x20, 0x52, 0x71, 0xbc, 0x34, 0x21, 0xbc, 0xe2, 0x3b, 0xea, 0x80, 0x50,
-	0xb1, 0x36, 0xe7, 0xee, 0x28, 0x46, 0x43, 0xd7, 0x20, 0x41, 0x06, 0xc1, 0xd7, 0x88, 0xa9, 0xcc,
-	0x6e, 0xad, 0xcd, 0xa4, 0x3e, 0x78, 0xb7, 0xc5, 0x28, 0xaa, 0xa0, 0xce, 0x5d, 0x6c, 0xff, 0xce,
-	0x3c, 0x5d, 0xfe, 0x52, 0x02, 0x38, 0x7e, 0x19, 0xad, 0xc1, 0xf2, 0x4e, 0x4b, 0x57, 0x8c, 0x56,
-	0x5b, 0x6f, 0xb4, 0x9a, 0x46, 0xa7, 0xa9, 0xb5, 0x95, 0x7a, 0xe3, 0x46, 0x43, 0xd9, 0xce, 0x47,
-	0xd0, 0x79, 0xc8, 0x8d, 0x83, 0x77, 0x15, 0x2d, 0x2f, 0xa1, 0x65, 0x38, 0x3f, 0x7e, 0x59, 0xad,
-	0x69, 0x7a, 0xb5, 0xd1, 0xcc, 0x47, 0x11, 0x82, 0xec, 0x38, 0xd0, 0x6c, 0xe5, 0x63, 0xe8, 0x22,
-	0xc8, 0x93, 0x77, 0xc6, 0x6e, 0x43, 0xbf, 0x69, 0xec, 0x28, 0x7a, 0x2b, 0x1f, 0x5f, 0x8d, 0x3f,
-	0xf8, 0xa6, 0x10, 0xb9, 0xfc, 0xa3, 0x04, 0xd9, 0xc9, 0x61, 0x43, 0x45, 0x58, 0x6b, 0xab, 0xad,
-	0x76, 0x4b, 0xab, 0xde, 0x36, 0x34, 0xbd, 0xaa, 0x77, 0xb4, 0x29, 0x65, 0x6f, 0xc0, 0xca, 0x34,
-	0x41, 0xeb, 0xd4, 0xee, 0x34, 0x74, 0x5d, 0xd9, 0xce, 0x4b, 0xc1, 0xb3, 0xd3, 0x70, 0xb5, 0x5e,
-	0x57, 0xda, 0x01, 0x1a, 0x3d, 0x09, 0x55, 0x95, 0x5b, 0x4a, 0x3d, 0x40, 0x63, 0x41, 0x46, 0x66,
-	0x6c, 0x6b, 0x2d, 0x35, 0x00, 0xe3, 0x27, 0xbd, 0x1b, 0x04, 0xb4, 0xad, 0x56, 0x77, 0x9b, 0xf9,
-	0x05, 0x11, 0xd0, 0xf7, 0x12, 0x5c, 0x38, 0x79, 0xae, 0xd0, 0x06, 0x5c, 0x3a, 0xb2, 0x57, 0x3e,
-	0x51, 0xea, 0x1d, 0xbd, 0xa5, 0x1a, 0xaa, 0xa2, 0x75, 0x6e, 0xeb, 0x53, 0x11, 0x5e, 0x82, 0xf5,
-	0x53, 0x99, 0xcd, 0x96, 0x6e, 0xa8, 0x9d, 0x66, 0x5e, 0x9a, 0xcb, 0xd2, 0x3a, 0xf5, 0xba, 0xa2,
-	0x69, 0xf9, 0xe8, 0x5c, 0xd6, 0x8d, 0x6a, 0xe3, 0x76, 0x47, 0x55, 0xf2, 0x31, 0x2e, 0xbe, 0xf6,
-	0xc1, 0x93, 0xe7, 0x05, 0xe9, 0xd9, 0xf3, 0x82, 0xf4, 0xdb, 0xf3, 0x82, 0xf4, 0xf0, 0x45, 0x21,
-	0xf2, 0xec, 0x45, 0x21, 0xf2, 0xf3, 0x8b, 0x42, 0xe4, 0xd3, 0x4b, 0xb6, 0x43, 0xf7, 0x87, 0x7b,
-	0xe5, 0x2e, 0xe9, 0x8b, 0x9f, 0x23, 0xe2, 0xcf, 0xa6, 0x6f, 0x7d, 0x56, 0xb9, 0xcf, 0x7f, 0x2d,
-	0xed, 0x25, 0x58, 0x27, 0x5e, 0xfb, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xea, 0x13, 0x0f, 0x14, 0x44,
-	0x0d, 0x00, 0x00,
+	0x18, 0xce, 0xda, 0x8e, 0x3f, 0x5e, 0xa7, 0xb6, 0x99, 0x86, 0x66, 0x93, 0xb4, 0x76, 0x30, 0x15,
+	0x44, 0x45, 0xb1, 0xdb, 0x14, 0x15, 0xa9, 0x07, 0xa8, 0xed, 0x6c, 0xa9, 0xab, 0xd6, 0x36, 0xeb,
+	0x75, 0x42, 0xb9, 0xac, 0x36, 0xde, 0xa9, 0xb3, 0xc2, 0xde, 0x71, 0x77, 0xc7, 0x49, 0xfd, 0x0f,
+	0x7a, 0x41, 0xf4, 0xc8, 0x05, 0xa9, 0x12, 0x47, 0x4e, 0x48, 0x3d, 0x20, 0x2e, 0x5c, 0xab, 0x1e,
+	0x50, 0xc5, 0x09, 0x09, 0x09, 0x50, 0x7b, 0x81, 0x13, 0x57, 0x8e, 0x68, 0x67, 0x66, 0xfd, 0x99,
+	0x38, 0x4d, 0x55, 0x38, 0x25, 0x33, 0xcf, 0xf3, 0xce, 0x3c, 0xef, 0xe7, 0x78, 0x61, 0xb5, 0x49,
+	0xdc, 0x0e, 0x71, 0xf3, 0x2d, 0x87, 0xf4, 0xba, 0xf9, 0xfd, 0x4b, 0x79, 0xda, 0xef, 0x62, 0x37,
+	0xd7, 0x75, 0x08, 0x25, 0x28, 0xc9, 0xc1, 0x1c, 0x03, 0x73, 0xfb, 0x97, 0x56, 0x16, 0x5b, 0xa4,
+	0x45, 0x18, 0x96, 0xf7, 0xfe, 0xe3, 0xb4, 0x95, 0x74, 0x8b, 0x90, 0x56, 0x1b, 0xe7, 0xd9, 0x6a,
+	0xb7, 0x77, 0x37, 0x6f, 0xf6, 0x1c, 0x83, 0x5a, 0xc4, 0x16, 0x78, 0x66, 0x12, 0xa7, 0x56, 0x07,
+	0xbb, 0xd4, 0xe8, 0x74, 0x05, 0x61, 0x99, 0xdf, 0xa3, 0xf3, 0x93, 0xc5, 0xa5, 0x02, 0x9a, 0xb4,
+	0x35, 0xec, 0x3e, 0x87, 0xb2, 0xdf, 0x49, 0x10, 0xbe, 0x8d, 0x3b, 0xbb, 0xd8, 0x41, 0x9b, 0x10,
+	0x31, 0x4c, 0xd3, 0xc1, 0xae, 0x2b, 0x4b, 0x6b, 0xd2, 0x7a, 0xac, 0x28, 0xff, 0xfc, 0x78, 0x63,
+	0x51, 0x1c, 0x54, 0xe0, 0x48, 0x9d, 0x3a, 0x96, 0xdd, 0x52, 0x7d, 0x22, 0x3a, 0x03, 0xe1, 0x03,
+	0x6c, 0xb5, 0xf6, 0xa8, 0x1c, 0xf0, 0x4c, 0x54, 0xb1, 0x42, 0x2b, 0x10, 0xed, 0x60, 0x6a, 0x98,
+	0x06, 0x35, 0xe4, 0x20, 0x43, 0x06, 0x6b, 0xf4, 0x11, 0x44, 0x0d, 0xd3, 0xc4, 0xa6, 0x6e, 0x50,
+	0x39, 0xb4, 0x26, 0xad, 0xc7, 0x37, 0x57, 0x72, 0x5c, 0x60, 0xce, 0x17, 0x98, 0xd3, 0x7c, 0xe7,
+	0x8a, 0xd1, 0x27, 0xbf, 0x65, 0xe6, 0x1e, 0xfe, 0x9e, 0x91, 0xd8, 0xa5, 0xd8, 0x2c, 0xd0, 0xec,
+	0x01, 0x9c, 0xe2, 0x92, 0x55, 0x7c, 0xaf, 0x87, 0x5d, 0xfa, 0x7f, 0x29, 0xcf, 0x7e, 0x21, 0xc1,
+	0x92, 0xb6, 0xe7, 0x60, 0x77, 0x8f, 0xb4, 0xcd, 0x2d, 0xdc, 0xb4, 0x5c, 0x8b, 0xd8, 0x35, 0xd2,
+	0xb6, 0x9a, 0x7d, 0x74, 0x16, 0x62, 0xd4, 0x87, 0xb8, 0x0a, 0x75, 0xb8, 0x81, 0xae, 0x41, 0xe4,
+	0xc0, 0xb2, 0x4d, 0x72, 0xe0, 0xb2, 0xeb, 0xe2, 0x9b, 0xef, 0xe4, 0x26, 0xca, 0x22, 0x37, 0x7e,
+	0xde, 0x0e, 0x67, 0xab, 0xbe, 0xd9, 0x55, 0xf4, 0xf4, 0xf1, 0x46, 0x62, 0x9c, 0x93, 0x7d, 0x28,
+	0x81, 0x5c, 0xc3, 0x4e, 0x13, 0xdb, 0xd4, 0x68, 0xe1, 0x09, 0x41, 0x69, 0x80, 0xee, 0x00, 0x13,
+	0x8a, 0x46, 0x76, 0xfe, 0x23, 0x49, 0xdf, 0x4a, 0x70, 0xee, 0x93, 0x1e, 0x71, 0x7a, 0x9d, 0xa3,
+	0x02, 0x75, 0x06, 0xc2, 0xf7, 0x18, 0x41, 0x68, 0x12, 0xab, 0xf1, 0x00, 0x06, 0x66, 0x04, 0x30,
+	0xf8, 0xfa, 0xd4, 0x7e, 0x2f, 0xc1, 0x9b, 0x87, 0x9a, 0xa1, 0x1b, 0x70, 0x6a, 0x9f, 0x50, 0xcb,
+	0x6e, 0xe9, 0x5d, 0xec, 0x58, 0x84, 0xa7, 0x34, 0xbe, 0xb9, 0x3c, 0x55, 0xa9, 0x5b, 0xa2, 0x4d,
+	0x79, 0xa1, 0x7e, 0xe5, 0x15, 0xea, 0x02, 0xb7, 0xac, 0x31, 0x43, 0xd4, 0x80, 0xc5, 0x8e, 0x65,
+	0xeb, 0xf8, 0x3e, 0x6e, 0xf6, 0x3c, 0xa2, 0x7f, 0x60, 0xe0, 0xe5, 0x0f, 0x44, 0x1d, 0xcb, 0x56,
+	0x7c, 0x7b, 0x7e, 0x6c, 0xf6, 0x2f, 0x09, 0x62, 0x1f, 0x7b, 0xae, 0x97, 0xed, 0xbb, 0x04, 0x25,
+	0x20, 0x60, 0x71, 0x8d, 0x21, 0x35, 0x60, 0x99, 0x28, 0x07, 0xf3, 0x86, 0xd9, 0xb1, 0x6c, 0x39,
+	0x70, 0x4c, 0x3f, 0x70, 0xda, 0xcc, 0x7e, 0x95, 0x21, 0xb2, 0x8f, 0x1d, 0x2f, 0x44, 0xac, 0x5d,
+	0x43, 0xaa, 0xbf, 0x44, 0x6f, 0xc1, 0x02, 0x25, 0xd4, 0x68, 0xeb, 0xa2, 0x93, 0xe6, 0x99, 0x65,
+	0x9c, 0xed, 0xed, 0xf0, 0x76, 0x2a, 0x01, 0x34, 0x1d, 0x6c, 0x50, 0xde, 0xee, 0xe1, 0x13, 0xb4,
+	0x7b, 0x4c, 0xd8, 0x15, 0x68, 0xf6, 0x0e, 0xc4, 0x99, 0xab, 0x62, 0x50, 0x2d, 0x43, 0x94, 0x25,
+	0x5d, 0x1f, 0xb8, 0x1c, 0x61, 0xeb, 0xb2, 0x89, 0xf2, 0x10, 0xee, 0x30, 0x92, 0x08, 0xef, 0xd2,
+	0x54, 0x95, 0x88, 0xc9, 0x21, 0x68, 0xd9, 0x7f, 0x02, 0x90, 0x64, 0x67, 0xf3, 0xf4, 0xb3, 0x60,
+	0xbe, 0xca, 0x38, 0x19, 0xd5, 0x14, 0x18, 0xd7, 0x34, 0xc8, 0x45, 0xf0, 0xe4, 0xb9, 0x08, 0x1d,
+	0x9d, 0x8b, 0xf9, 0xf1, 0x5c, 0x18, 0x90, 0x34, 0x45, 0x25, 0xeb, 0x5d, 0xe6, 0x8b, 0x88, 0xf6,
+	0xe2, 0x54, 0xb4, 0x0b, 0x76, 0xbf, 0x98, 0x7d, 0xfa, 0x78, 0x23, 0x3d, 0xbb, 0x83, 0xd4, 0x84,
+	0x39, 0xde, 0xb9, 0xe3, 0xb9, 0x8c, 0xbc, 0x52, 0x2e, 0xaf, 0x46, 0x1f, 0x3c, 0xca, 0xcc, 0xfd,
+	0xf9, 0x28, 0x23, 0x65, 0x7f, 0x9c, 0x87, 0x68, 0xcd, 0x21, 0x5d, 0xe2, 0x1a, 0xed, 0xa9, 0x02,
+	0xbe, 0x09, 0x8b, 0x3c, 0x9e, 0xdc, 0x17, 0xdd, 0x4f, 0xc8, 0x71, 0xf5, 0x8c, 0x5a, 0xc3, 0x64,
+	0x0a, 0x64, 0x66, 0x71, 0x5f, 0x81, 0x58, 0x97, 0x69, 0xc0, 0x8e, 0x2b, 0x87, 0xd6, 0x82, 0x33,
+	0x0f, 0x1f, 0x52, 0x91, 0x02, 0x71, 0xb7, 0xb7, 0xdb, 0xb1, 0xa8, 0xee, 0xbd, 0xc3, 0xf2, 0xfc,
+	0x09, 0x82, 0x01, 0xdc, 0xd0, 0x83, 0xd0, 0xdb, 0x70, 0x8a, 0xbb, 0xe9, 0x67, 0x35, 0xcc, 0x22,
+	0xb0, 0xc0, 0x36, 0xb7, 0x45, 0x6a, 0x2f, 0x4e, 0xc4, 0xc2, 0xe7, 0x46, 0x18, 0x77, 0xd4, 0x63,
+	0xdf, 0xe2, 0x03, 0x08, 0xbb, 0xd4, 0xa0, 0x3d, 0x57, 0x8e, 0xae, 0x49, 0xeb, 0x89, 0xcd, 0xcc,
+	0x54, 0x1b, 0xf8, 0x81, 0xaf, 0x33, 0x9a, 0x2a, 0xe8, 0xa8, 0x06, 0xe8, 0xae, 0x65, 0x1b, 0x6d,
+	0x9d, 0x1a, 0xed, 0x76, 0x5f, 0x77, 0xb0, 0xdb, 0x6b, 0x53, 0x39, 0xc6, 0xbc, 0x3b, 0x3b, 0x75,
+	0x88, 0xe6, 0x91, 0x54, 0xc6, 0x29, 0x86, 0x3c, 0xff, 0xd4, 0x14, 0xb3, 0x1e, 0xd9, 0x47, 0x35,
+	0x78, 0x63, 0x6c, 0x90, 0xea, 0xd8, 0x36, 0x65, 0x38, 0x41, 0xb8, 0x92, 0xa3, 0xd3, 0x54, 0xb1,
+	0x4d, 0x54, 0x83, 0x24, 0x1f, 0xa6, 0xc4, 0xf1, 0x05, 0xc6, 0x99, 0x97, 0xef, 0x1e, 0xe9, 0xa5,
+	0x22, 0xf8, 0x5c, 0x93, 0x9a, 0xc0, 0x63, 0x6b, 0x74, 0xd1, 0x2b, 0x10, 0xd7, 0x35, 0x5a, 0xd8,
+	0x95, 0x17, 0xd6, 0x82, 0x47, 0x35, 0x8d, 0x3a, 0x60, 0x5d, 0x0d, 0x79, 0x55, 0x9c, 0xfd, 0x5a,
+	0x82, 0xf8, 0xa8, 0xaf, 0xab, 0x10, 0xeb, 0x63, 0x57, 0x6f, 0x92, 0x9e, 0x4d, 0xc5, 0xeb, 0x16,
+	0xed, 0x63, 0xb7, 0xe4, 0xad, 0xbd, 0x54, 0x1b, 0xbb, 0x2e, 0x35, 0x2c, 0x5b, 0x10, 0xf8, 0x1b,
+	0xb7, 0x20, 0x36, 0x39, 0x69, 0x19, 0xa2, 0x36, 0x11, 0x38, 0x2f, 0xd5, 0x88, 0x4d, 0x38, 0xf4,
+	0x1e, 0x20, 0x9b, 0xe8, 0x07, 0x16, 0xdd, 0xd3, 0xf7, 0x31, 0xf5, 0x49, 0x7c, 0x40, 0x24, 0x6d,
+	0xb2, 0x63, 0xd1, 0xbd, 0x6d, 0x4c, 0x39, 0x59, 0xe8, 0xfb, 0x5b, 0x82, 0xd0, 0x36, 0xa1, 0x18,
+	0x65, 0x20, 0xde, 0x15, 0xa1, 0x18, 0x0e, 0x4d, 0xf0, 0xb7, 0xf8, 0x8c, 0xda, 0x27, 0x54, 0x8c,
+	0xcd, 0x99, 0x33, 0x8a, 0xd1, 0xd0, 0x65, 0x08, 0x93, 0xae, 0xf7, 0x1a, 0x31, 0x95, 0x89, 0xcd,
+	0xd5, 0xa9, 0xd0, 0x7b, 0xf7, 0x56, 0x19, 0x45, 0x15, 0xd4, 0x99, 0x83, 0xed, 0xf5, 0xf4, 0x53,
+	0xf6, 0x57, 0x09, 0x12, 0xde, 0xcd, 0x5b, 0xb8, 0x8d, 0x5b, 0xec, 0x19, 0x9d, 0xf5, 0x5a, 0x5c,
+	0x81, 0x98, 0xc9, 0x89, 0xe4, 0x78, 0xcf, 0x87, 0x54, 0xf4, 0x3e, 0x44, 0xc5, 0x02, 0x1f, 0x3b,
+	0xd4, 0x07, 0x4c, 0x74, 0x0d, 0x00, 0xdf, 0xef, 0x5a, 0xfc, 0x75, 0x7f, 0x89, 0x5f, 0xbe, 0x21,
+	0xee, 0xdd, 0xd0, 0xe6, 0xc2, 0x97, 0x12, 0xc0, 0x30, 0xae, 0x68, 0x15, 0x96, 0xb6, 0xab, 0x9a,
+	0xa2, 0x57, 0x6b, 0x5a, 0xb9, 0x5a, 0xd1, 0x1b, 0x95, 0x7a, 0x4d, 0x29, 0x95, 0xaf, 0x97, 0x95,
+	0xad, 0xd4, 0x1c, 0x3a, 0x0d, 0xc9, 0x51, 0xf0, 0x8e, 0x52, 0x4f, 0x49, 0x68, 0x09, 0x4e, 0x8f,
+	0x6e, 0x16, 0x8a, 0x75, 0xad, 0x50, 0xae, 0xa4, 0x02, 0x08, 0x41, 0x62, 0x14, 0xa8, 0x54, 0x53,
+	0x41, 0x74, 0x16, 0xe4, 0xf1, 0x3d, 0x7d, 0xa7, 0xac, 0xdd, 0xd0, 0xb7, 0x15, 0xad, 0x9a, 0x0a,
+	0xad, 0x84, 0x1e, 0x7c, 0x93, 0x9e, 0xbb, 0xf0, 0x93, 0x04, 0x89, 0xf1, 0x51, 0x82, 0x32, 0xb0,
+	0x5a, 0x53, 0xab, 0xb5, 0x6a, 0xbd, 0x70, 0x4b, 0xaf, 0x6b, 0x05, 0xad, 0x51, 0x9f, 0x50, 0x76,
+	0x0e, 0x96, 0x27, 0x09, 0xf5, 0x46, 0xf1, 0x76, 0x59, 0xd3, 0x94, 0xad, 0x94, 0xe4, 0x5d, 0x3b,
+	0x09, 0x17, 0x4a, 0x25, 0xa5, 0xe6, 0xa1, 0x81, 0xc3, 0x50, 0x55, 0xb9, 0xa9, 0x94, 0x3c, 0x34,
+	0xe8, 0x45, 0x64, 0xca, 0xb6, 0x58, 0x55, 0x3d, 0x30, 0x74, 0xd8, 0xbd, 0x9e, 0x43, 0x5b, 0x6a,
+	0x61, 0xa7, 0x92, 0x9a, 0x17, 0x0e, 0xfd, 0x20, 0xc1, 0x99, 0xc3, 0xa7, 0x06, 0x5a, 0x87, 0xf3,
+	0x03, 0x7b, 0xe5, 0x53, 0xa5, 0xd4, 0xd0, 0xaa, 0xaa, 0xae, 0x2a, 0xf5, 0xc6, 0x2d, 0x6d, 0xc2,
+	0xc3, 0xf3, 0xb0, 0x76, 0x24, 0xb3, 0x52, 0xd5, 0x74, 0xb5, 0x51, 0x49, 0x49, 0x33, 0x59, 0xf5,
+	0x46, 0xa9, 0xa4, 0xd4, 0xeb, 0xa9, 0xc0, 0x4c, 0xd6, 0xf5, 0x42, 0xf9, 0x56, 0x43, 0x55, 0x52,
+	0x41, 0x2e, 0xbe, 0xf8, 0xe1, 0x93, 0xe7, 0x69, 0xe9, 0xd9, 0xf3, 0xb4, 0xf4, 0xc7, 0xf3, 0xb4,
+	0xf4, 0xf0, 0x45, 0x7a, 0xee, 0xd9, 0x8b, 0xf4, 0xdc, 0x2f, 0x2f, 0xd2, 0x73, 0x9f, 0x9d, 0x6f,
+	0x59, 0x74, 0xaf, 0xb7, 0x9b, 0x6b, 0x92, 0x8e, 0xf8, 0x34, 0x14, 0x7f, 0x36, 0x5c, 0xf3, 0xf3,
+	0xfc, 0x7d, 0xfe, 0xe5, 0xba, 0x1b, 0x66, 0x55, 0x78, 0xf9, 0xdf, 0x01, 0x00, 0xd0, 0x31, 0x9e,
+	0x01, 0xd0, 0x0e, 0x00, 0x00,
 }
 
 func (this *GroupPolicyInfo) Equal(that interface{}) bool {
@@ -1213,6 +1370,55 @@ func (m *PercentageDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QuorumThresholdDecisionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuorumThresholdDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuorumThresholdDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Windows != nil {
+		{
+			size, err := m.Windows.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Quorum)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DecisionPolicyWindows) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1233,21 +1439,21 @@ func (m *DecisionPolicyWindows) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinExecutionPeriod):])
-	if err4 != nil {
-		return 0, err4
-	}
-	i -= n4
-	i = encodeVarintTypes(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x12
-	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinExecutionPeriod):])
 	if err5 != nil {
 		return 0, err5
 	}
 	i -= n5
 	i = encodeVarintTypes(dAtA, i, uint64(n5))
 	i--
+	dAtA[i] = 0x12
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTypes(dAtA, i, uint64(n6))
+	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
@@ -1272,12 +1478,12 @@ func (m *GroupInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
-	if err6 != nil {
-		return 0, err6
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n6
-	i = encodeVarintTypes(dAtA, i, uint64(n6))
+	i -= n7
+	i = encodeVarintTypes(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x32
 	if len(m.TotalWeight) > 0 {
@@ -1374,12 +1580,12 @@ func (m *GroupPolicyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
-	if err8 != nil {
-		return 0, err8
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err9 != nil {
+		return 0, err9
 	}
-	i -= n8
-	i = encodeVarintTypes(dAtA, i, uint64(n8))
+	i -= n9
+	i = encodeVarintTypes(dAtA, i, uint64(n9))
 	i--
 	dAtA[i] = 0x3a
 	if m.DecisionPolicy != nil {
@@ -1467,12 +1673,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x58
 	}
-	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingPeriodEnd):])
-	if err10 != nil {
-		return 0, err10
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingPeriodEnd):])
+	if err11 != nil {
+		return 0, err11
 	}
-	i -= n10
-	i = encodeVarintTypes(dAtA, i, uint64(n10))
+	i -= n11
+	i = encodeVarintTypes(dAtA, i, uint64(n11))
 	i--
 	dAtA[i] = 0x52
 	{
@@ -1500,12 +1706,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
-	if err12 != nil {
-		return 0, err12
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
+	if err13 != nil {
+		return 0, err13
 	}
-	i -= n12
-	i = encodeVarintTypes(dAtA, i, uint64(n12))
+	i -= n13
+	i = encodeVarintTypes(dAtA, i, uint64(n13))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Proposers) > 0 {
@@ -1610,12 +1816,12 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
-	if err13 != nil {
-		return 0, err13
+	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
+	if err14 != nil {
+		return 0, err14
 	}
-	i -= n13
-	i = encodeVarintTypes(dAtA, i, uint64(n13))
+	i -= n14
+	i = encodeVarintTypes(dAtA, i, uint64(n14))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Metadata) > 0 {
@@ -1645,6 +1851,58 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VoteDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err15 != nil {
+			return 0, err15
+		}
+		i -= n15
+		i = encodeVarintTypes(dAtA, i, uint64(n15))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -1734,6 +1992,27 @@ func (m *PercentageDecisionPolicy) Size() (n int) {
 	return n
 }
 
+func (m *QuorumThresholdDecisionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Quorum)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Windows != nil {
+		l = m.Windows.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func (m *DecisionPolicyWindows) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1925,6 +2204,30 @@ func (m *Vote) Size() (n int) {
 	return n
 }
 
+func (m *VoteDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovTypes(uint64(m.GroupId))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2492,7 +2795,7 @@ func (m *PercentageDecisionPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
+func (m *QuorumThresholdDecisionPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2515,17 +2818,17 @@ func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DecisionPolicyWindows: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuorumThresholdDecisionPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DecisionPolicyWindows: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuorumThresholdDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2535,30 +2838,29 @@ func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Quorum = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinExecutionPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2568,12 +2870,163 @@ func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Windows == nil {
+				m.Windows = &DecisionPolicyWindows{}
+			}
+			if err := m.Windows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecisionPolicyWindows: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecisionPolicyWindows: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinExecutionPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
 			postIndex := iNdEx + msglen
@@ -3920,6 +4373,175 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VoteDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -386,3 +386,119 @@ func TestThresholdDecisionPolicyAllow(t *testing.T) {
 		})
 	}
 }
+
+func TestQuorumThresholdDecisionPolicyValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name   string
+		policy group.DecisionPolicy
+		expErr bool
+	}{
+		{
+			"all good",
+			group.NewQuorumThresholdDecisionPolicy("0.4", "0.5", time.Hour, 0),
+			false,
+		},
+		{
+			"invalid quorum",
+			group.NewQuorumThresholdDecisionPolicy("foo", "0.5", time.Hour, 0),
+			true,
+		},
+		{
+			"quorum greater than 1",
+			group.NewQuorumThresholdDecisionPolicy("1.1", "0.5", time.Hour, 0),
+			true,
+		},
+		{
+			"zero threshold",
+			group.NewQuorumThresholdDecisionPolicy("0.4", "0", time.Hour, 0),
+			true,
+		},
+		{
+			"zero voting period",
+			group.NewQuorumThresholdDecisionPolicy("0.4", "0.5", 0, 0),
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestQuorumThresholdDecisionPolicyAllow(t *testing.T) {
+	policy := group.NewQuorumThresholdDecisionPolicy("0.5", "0.5", time.Hour, 0)
+	testCases := []struct {
+		name       string
+		tally      *group.TallyResult
+		totalPower string
+		result     group.DecisionPolicyResult
+		expErr     bool
+	}{
+		{
+			"quorum and threshold reached whatever the undecided votes",
+			&group.TallyResult{YesCount: "6", NoCount: "0", AbstainCount: "0", NoWithVetoCount: "0"},
+			"10",
+			group.DecisionPolicyResult{Allow: true, Final: true},
+			false,
+		},
+		{
+			"threshold not reached whatever the undecided votes",
+			&group.TallyResult{YesCount: "0", NoCount: "6", AbstainCount: "0", NoWithVetoCount: "0"},
+			"10",
+			group.DecisionPolicyResult{Allow: false, Final: true},
+			false,
+		},
+		{
+			"quorum and threshold reached but undecided votes can change the result",
+			&group.TallyResult{YesCount: "4", NoCount: "1", AbstainCount: "0", NoWithVetoCount: "0"},
+			"10",
+			group.DecisionPolicyResult{Allow: true, Final: false},
+			false,
+		},
+		{
+			"quorum not reached",
+			&group.TallyResult{YesCount: "2", NoCount: "0", AbstainCount: "0", NoWithVetoCount: "0"},
+			"10",
+			group.DecisionPolicyResult{Allow: false, Final: false},
+			false,
+		},
+		{
+			"abstain votes count towards the quorum but not the threshold",
+			&group.TallyResult{YesCount: "1", NoCount: "0", AbstainCount: "5", NoWithVetoCount: "0"},
+			"10",
+			group.DecisionPolicyResult{Allow: true, Final: false},
+			false,
+		},
+		{
+			"all members voted",
+			&group.TallyResult{YesCount: "3", NoCount: "0", AbstainCount: "7", NoWithVetoCount: "0"},
+			"10",
+			group.DecisionPolicyResult{Allow: true, Final: true},
+			false,
+		},
+		{
+			"invalid total power",
+			&group.TallyResult{YesCount: "1", NoCount: "0", AbstainCount: "0", NoWithVetoCount: "0"},
+			"foo",
+			group.DecisionPolicyResult{},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			policyResult, err := policy.Allow(*tc.tally, tc.totalPower)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.result, policyResult)
+			}
+		})
+	}
+}
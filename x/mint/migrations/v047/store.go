@@ -0,0 +1,31 @@
+package v047
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.46 to v0.47.
+// The migration includes:
+//
+// - Moving the params from the x/params subspace to the mint store.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, paramstore paramtypes.Subspace, cdc codec.BinaryCodec) error {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+
+	var params types.Params
+	paramstore.GetParamSet(ctx, &params)
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(storeKey)
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	return nil
+}
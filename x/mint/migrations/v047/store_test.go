@@ -0,0 +1,35 @@
+package v047_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v047 "github.com/cosmos/cosmos-sdk/x/mint/migrations/v047"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	mintKey := sdk.NewKVStoreKey(types.StoreKey)
+	tMintKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(mintKey, tMintKey)
+	store := ctx.KVStore(mintKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, mintKey, tMintKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	legacyParams := types.DefaultParams()
+	legacyParams.BlocksPerYear = 1000
+	paramstore.SetParamSet(ctx, &legacyParams)
+	require.False(t, store.Has(types.ParamsKey))
+
+	require.NoError(t, v047.MigrateStore(ctx, mintKey, paramstore, encCfg.Codec))
+
+	var params types.Params
+	encCfg.Codec.MustUnmarshal(store.Get(types.ParamsKey), &params)
+	require.Equal(t, legacyParams, params)
+}
@@ -0,0 +1,78 @@
+package nft
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate returns an error if the class config is invalid.
+func (c ClassConfig) Validate() error {
+	if err := ValidateClassID(c.ClassId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(c.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid authority address (%s)", c.Authority)
+	}
+
+	if _, ok := MintPolicy_name[int32(c.MintPolicy)]; !ok || c.MintPolicy == MINT_POLICY_UNSPECIFIED {
+		return sdkerrors.Wrapf(ErrInvalidConfig, "invalid mint policy (%s)", c.MintPolicy)
+	}
+
+	if len(c.AllowList) > 0 && c.MintPolicy != MINT_POLICY_ALLOW_LIST {
+		return sdkerrors.Wrapf(ErrInvalidConfig, "allow list set with mint policy %s", c.MintPolicy)
+	}
+
+	seen := make(map[string]bool, len(c.AllowList))
+	for _, addr := range c.AllowList {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid allow list address (%s)", addr)
+		}
+		if seen[addr] {
+			return sdkerrors.Wrapf(ErrInvalidConfig, "duplicate allow list address (%s)", addr)
+		}
+		seen[addr] = true
+	}
+
+	if c.MaxSupply > 0 && c.Minted > c.MaxSupply {
+		return sdkerrors.Wrapf(ErrInvalidConfig, "minted %d exceeds the max supply %d", c.Minted, c.MaxSupply)
+	}
+
+	if c.RoyaltyReceiver != "" {
+		if _, err := sdk.AccAddressFromBech32(c.RoyaltyReceiver); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid royalty receiver address (%s)", c.RoyaltyReceiver)
+		}
+	}
+
+	if !c.RoyaltyRate.IsNil() {
+		if c.RoyaltyRate.IsNegative() || c.RoyaltyRate.GT(sdk.OneDec()) {
+			return sdkerrors.Wrapf(ErrInvalidConfig, "royalty rate must be between 0 and 1 (%s)", c.RoyaltyRate)
+		}
+		if c.RoyaltyRate.IsPositive() && c.RoyaltyReceiver == "" {
+			return sdkerrors.Wrap(ErrInvalidConfig, "royalty rate set without royalty receiver")
+		}
+	}
+
+	return nil
+}
+
+// CanMint returns whether the given account can mint the nfts of the class.
+func (c ClassConfig) CanMint(minter sdk.AccAddress) bool {
+	if minter.String() == c.Authority {
+		return true
+	}
+
+	switch c.MintPolicy {
+	case MINT_POLICY_OPEN:
+		return true
+	case MINT_POLICY_ALLOW_LIST:
+		for _, addr := range c.AllowList {
+			if addr == minter.String() {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
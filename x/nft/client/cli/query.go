@@ -33,6 +33,7 @@ func GetQueryCmd() *cobra.Command {
 
 	nftQueryCmd.AddCommand(
 		GetCmdQueryClass(),
+		GetCmdQueryClassConfig(),
 		GetCmdQueryClasses(),
 		GetCmdQueryNFT(),
 		GetCmdQueryNFTs(),
@@ -69,6 +70,32 @@ func GetCmdQueryClass() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryClassConfig implements the query class config command.
+func GetCmdQueryClassConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "class-config [class-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "query the config of an NFT class based on its id",
+		Example: fmt.Sprintf(`$ %s query %s class-config <class-id>`, version.AppName, nft.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := nft.NewQueryClient(clientCtx)
+			res, err := queryClient.ClassConfig(cmd.Context(), &nft.QueryClassConfigRequest{
+				ClassId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryClasses implements the query classes command.
 func GetCmdQueryClasses() *cobra.Command {
 	cmd := &cobra.Command{
@@ -9,10 +9,26 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
+// Tx flag names and values
+const (
+	FlagName            = "name"
+	FlagSymbol          = "symbol"
+	FlagDescription     = "description"
+	FlagURI             = "uri"
+	FlagURIHash         = "uri-hash"
+	FlagMintPolicy      = "mint-policy"
+	FlagAllowList       = "allow-list"
+	FlagMaxSupply       = "max-supply"
+	FlagRoyaltyReceiver = "royalty-receiver"
+	FlagRoyaltyRate     = "royalty-rate"
+	FlagReceiver        = "receiver"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	nftTxCmd := &cobra.Command{
@@ -26,6 +42,10 @@ func GetTxCmd() *cobra.Command {
 
 	nftTxCmd.AddCommand(
 		NewCmdSend(),
+		NewCmdCreateClass(),
+		NewCmdMint(),
+		NewCmdBurn(),
+		NewCmdUpdate(),
 	)
 
 	return nftTxCmd
@@ -58,3 +78,183 @@ func NewCmdSend() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func NewCmdCreateClass() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-class [class-id] --from [creator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "create a new nft class, the creator being the class authority",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s create-class <class-id> --name <name> --symbol <symbol> --mint-policy allow-list --allow-list <address>,<address> --max-supply 1000 --from <creator> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			name, _ := cmd.Flags().GetString(FlagName)
+			symbol, _ := cmd.Flags().GetString(FlagSymbol)
+			description, _ := cmd.Flags().GetString(FlagDescription)
+			uri, _ := cmd.Flags().GetString(FlagURI)
+			uriHash, _ := cmd.Flags().GetString(FlagURIHash)
+			allowList, _ := cmd.Flags().GetStringSlice(FlagAllowList)
+			royaltyReceiver, _ := cmd.Flags().GetString(FlagRoyaltyReceiver)
+
+			policyStr, _ := cmd.Flags().GetString(FlagMintPolicy)
+			policy, err := parseMintPolicy(policyStr)
+			if err != nil {
+				return err
+			}
+
+			maxSupply, err := cmd.Flags().GetUint64(FlagMaxSupply)
+			if err != nil {
+				return err
+			}
+
+			royaltyRate := sdk.ZeroDec()
+			if rateStr, _ := cmd.Flags().GetString(FlagRoyaltyRate); rateStr != "" {
+				royaltyRate, err = sdk.NewDecFromStr(rateStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := nft.MsgCreateClass{
+				Creator:         clientCtx.GetFromAddress().String(),
+				Id:              args[0],
+				Name:            name,
+				Symbol:          symbol,
+				Description:     description,
+				Uri:             uri,
+				UriHash:         uriHash,
+				MintPolicy:      policy,
+				AllowList:       allowList,
+				MaxSupply:       maxSupply,
+				RoyaltyReceiver: royaltyReceiver,
+				RoyaltyRate:     royaltyRate,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagName, "", "The name of the class")
+	cmd.Flags().String(FlagSymbol, "", "The symbol of the class")
+	cmd.Flags().String(FlagDescription, "", "The description of the class")
+	cmd.Flags().String(FlagURI, "", "The URI of the class metadata")
+	cmd.Flags().String(FlagURIHash, "", "The hash of the class metadata")
+	cmd.Flags().String(FlagMintPolicy, "issuer-only", "Who can mint nfts of the class (open|issuer-only|allow-list)")
+	cmd.Flags().StringSlice(FlagAllowList, nil, "The addresses allowed to mint nfts of the class with the allow-list mint policy")
+	cmd.Flags().Uint64(FlagMaxSupply, 0, "The maximum supply of the class, 0 meaning unlimited")
+	cmd.Flags().String(FlagRoyaltyReceiver, "", "The address receiving the royalties of the class")
+	cmd.Flags().String(FlagRoyaltyRate, "", "The royalty rate of the class, between 0 and 1")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdMint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint [class-id] [nft-id] --from [minter]",
+		Args:  cobra.ExactArgs(2),
+		Short: "mint a new nft of a class",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s mint <class-id> <nft-id> --uri <uri> --receiver <receiver> --from <minter> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			uri, _ := cmd.Flags().GetString(FlagURI)
+			uriHash, _ := cmd.Flags().GetString(FlagURIHash)
+			receiver, _ := cmd.Flags().GetString(FlagReceiver)
+
+			msg := nft.MsgMintNFT{
+				Minter:   clientCtx.GetFromAddress().String(),
+				ClassId:  args[0],
+				Id:       args[1],
+				Uri:      uri,
+				UriHash:  uriHash,
+				Receiver: receiver,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagURI, "", "The URI of the nft metadata")
+	cmd.Flags().String(FlagURIHash, "", "The hash of the nft metadata")
+	cmd.Flags().String(FlagReceiver, "", "The owner of the minted nft, defaults to the minter")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdBurn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn [class-id] [nft-id] --from [owner]",
+		Args:  cobra.ExactArgs(2),
+		Short: "burn an owned nft",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s burn <class-id> <nft-id> --from <owner> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgBurnNFT{
+				Sender:  clientCtx.GetFromAddress().String(),
+				ClassId: args[0],
+				Id:      args[1],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdUpdate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update [class-id] [nft-id] --from [authority]",
+		Args:  cobra.ExactArgs(2),
+		Short: "update the metadata of an nft, only allowed to the class authority",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s update <class-id> <nft-id> --uri <uri> --uri-hash <uri-hash> --from <authority> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			uri, _ := cmd.Flags().GetString(FlagURI)
+			uriHash, _ := cmd.Flags().GetString(FlagURIHash)
+
+			msg := nft.MsgUpdateNFT{
+				Sender:  clientCtx.GetFromAddress().String(),
+				ClassId: args[0],
+				Id:      args[1],
+				Uri:     uri,
+				UriHash: uriHash,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagURI, "", "The URI of the nft metadata")
+	cmd.Flags().String(FlagURIHash, "", "The hash of the nft metadata")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseMintPolicy parses a mint policy given as open, issuer-only or allow-list.
+func parseMintPolicy(policy string) (nft.MintPolicy, error) {
+	value, ok := nft.MintPolicy_value["MINT_POLICY_"+strings.ToUpper(strings.ReplaceAll(policy, "-", "_"))]
+	if !ok || value == int32(nft.MINT_POLICY_UNSPECIFIED) {
+		return nft.MINT_POLICY_UNSPECIFIED, fmt.Errorf("invalid mint policy %s", policy)
+	}
+	return nft.MintPolicy(value), nil
+}
@@ -13,4 +13,7 @@ var (
 	ErrNFTNotExists   = sdkerrors.Register(ModuleName, 6, "nft does not exist")
 	ErrInvalidID      = sdkerrors.Register(ModuleName, 7, "invalid id")
 	ErrInvalidClassID = sdkerrors.Register(ModuleName, 8, "invalid class id")
+	ErrInvalidConfig  = sdkerrors.Register(ModuleName, 9, "invalid nft class config")
+	ErrConfigNotFound = sdkerrors.Register(ModuleName, 10, "nft class config does not exist")
+	ErrSupplyExceeded = sdkerrors.Register(ModuleName, 11, "nft class max supply exceeded")
 )
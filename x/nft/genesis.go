@@ -2,14 +2,30 @@ package nft
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // ValidateGenesis check the given genesis state has no integrity issues
 func ValidateGenesis(data GenesisState) error {
+	classIDs := make(map[string]bool, len(data.Classes))
 	for _, class := range data.Classes {
 		if err := ValidateClassID(class.Id); err != nil {
 			return err
 		}
+		classIDs[class.Id] = true
+	}
+	configs := make(map[string]bool, len(data.ClassConfigs))
+	for _, config := range data.ClassConfigs {
+		if err := config.Validate(); err != nil {
+			return err
+		}
+		if !classIDs[config.ClassId] {
+			return sdkerrors.Wrap(ErrClassNotExists, config.ClassId)
+		}
+		if configs[config.ClassId] {
+			return sdkerrors.Wrapf(ErrInvalidConfig, "duplicate class config (%s)", config.ClassId)
+		}
+		configs[config.ClassId] = true
 	}
 	for _, entry := range data.Entries {
 		for _, nft := range entry.Nfts {
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+// SetClassConfig defines a method for setting the config of a nft class
+func (k Keeper) SetClassConfig(ctx sdk.Context, config nft.ClassConfig) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(classConfigStoreKey(config.ClassId), k.cdc.MustMarshal(&config))
+}
+
+// GetClassConfig defines a method for returning the config of the specified class
+func (k Keeper) GetClassConfig(ctx sdk.Context, classID string) (nft.ClassConfig, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(classConfigStoreKey(classID))
+
+	var config nft.ClassConfig
+	if len(bz) == 0 {
+		return config, false
+	}
+	k.cdc.MustUnmarshal(bz, &config)
+	return config, true
+}
+
+// GetClassConfigs defines a method for returning all class configs
+func (k Keeper) GetClassConfigs(ctx sdk.Context) (configs []*nft.ClassConfig) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, ClassConfigKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var config nft.ClassConfig
+		k.cdc.MustUnmarshal(iterator.Value(), &config)
+		configs = append(configs, &config)
+	}
+	return
+}
@@ -14,6 +14,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *nft.GenesisState) {
 			panic(err)
 		}
 	}
+	for _, config := range data.ClassConfigs {
+		k.SetClassConfig(ctx, *config)
+	}
 	for _, entry := range data.Entries {
 		for _, nft := range entry.Nfts {
 			owner := sdk.MustAccAddressFromBech32(entry.Owner)
@@ -55,7 +58,8 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *nft.GenesisState {
 		})
 	}
 	return &nft.GenesisState{
-		Classes: classes,
-		Entries: entries,
+		Classes:      classes,
+		Entries:      entries,
+		ClassConfigs: k.GetClassConfigs(ctx),
 	}
 }
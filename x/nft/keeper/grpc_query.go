@@ -198,3 +198,21 @@ func (k Keeper) Classes(goCtx context.Context, r *nft.QueryClassesRequest) (*nft
 		Pagination: pageRes,
 	}, nil
 }
+
+// ClassConfig return the config of an NFT class based on its id
+func (k Keeper) ClassConfig(goCtx context.Context, r *nft.QueryClassConfigRequest) (*nft.QueryClassConfigResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if err := nft.ValidateClassID(r.ClassId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	config, has := k.GetClassConfig(ctx, r.ClassId)
+	if !has {
+		return nil, nft.ErrConfigNotFound.Wrapf("not found class config: %s", r.ClassId)
+	}
+	return &nft.QueryClassConfigResponse{Config: &config}, nil
+}
@@ -40,3 +40,120 @@ func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendRespo
 	})
 	return &nft.MsgSendResponse{}, nil
 }
+
+// CreateClass implement CreateClass method of the types.MsgServer.
+func (k Keeper) CreateClass(goCtx context.Context, msg *nft.MsgCreateClass) (*nft.MsgCreateClassResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SaveClass(ctx, msg.Class()); err != nil {
+		return nil, err
+	}
+	k.SetClassConfig(ctx, msg.ClassConfig())
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventCreateClass{
+		ClassId: msg.Id,
+		Creator: msg.Creator,
+	})
+	return &nft.MsgCreateClassResponse{}, nil
+}
+
+// MintNFT implement MintNFT method of the types.MsgServer.
+func (k Keeper) MintNFT(goCtx context.Context, msg *nft.MsgMintNFT) (*nft.MsgMintNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	minter, err := sdk.AccAddressFromBech32(msg.Minter)
+	if err != nil {
+		return nil, err
+	}
+
+	config, has := k.GetClassConfig(ctx, msg.ClassId)
+	if !has {
+		return nil, sdkerrors.Wrap(nft.ErrConfigNotFound, msg.ClassId)
+	}
+
+	if !config.CanMint(minter) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to mint nfts of class %s", minter, msg.ClassId)
+	}
+
+	// the minted NFTs are counted rather than the total supply, so that burning
+	// NFTs doesn't allow minting past the max supply
+	if config.MaxSupply > 0 && config.Minted >= config.MaxSupply {
+		return nil, sdkerrors.Wrapf(nft.ErrSupplyExceeded, "class %s max supply is %d", msg.ClassId, config.MaxSupply)
+	}
+
+	receiver := minter
+	if msg.Receiver != "" {
+		receiver, err = sdk.AccAddressFromBech32(msg.Receiver)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	token := nft.NFT{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+		Uri:     msg.Uri,
+		UriHash: msg.UriHash,
+		Data:    msg.Data,
+	}
+	if err := k.Mint(ctx, token, receiver); err != nil {
+		return nil, err
+	}
+
+	config.Minted++
+	k.SetClassConfig(ctx, config)
+	return &nft.MsgMintNFTResponse{}, nil
+}
+
+// BurnNFT implement BurnNFT method of the types.MsgServer.
+func (k Keeper) BurnNFT(goCtx context.Context, msg *nft.MsgBurnNFT) (*nft.MsgBurnNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := k.GetOwner(ctx, msg.ClassId, msg.Id)
+	if !owner.Equals(sender) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", sender, msg.Id)
+	}
+
+	if err := k.Burn(ctx, msg.ClassId, msg.Id); err != nil {
+		return nil, err
+	}
+	return &nft.MsgBurnNFTResponse{}, nil
+}
+
+// UpdateNFT implement UpdateNFT method of the types.MsgServer.
+func (k Keeper) UpdateNFT(goCtx context.Context, msg *nft.MsgUpdateNFT) (*nft.MsgUpdateNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	config, has := k.GetClassConfig(ctx, msg.ClassId)
+	if !has {
+		return nil, sdkerrors.Wrap(nft.ErrConfigNotFound, msg.ClassId)
+	}
+
+	if config.Authority != msg.Sender {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the authority of class %s", msg.Sender, msg.ClassId)
+	}
+
+	// the authority can't rewrite the metadata of the nfts it transferred
+	if owner := k.GetOwner(ctx, msg.ClassId, msg.Id); owner != nil && owner.String() != msg.Sender {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", msg.Sender, msg.Id)
+	}
+
+	token := nft.NFT{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+		Uri:     msg.Uri,
+		UriHash: msg.UriHash,
+		Data:    msg.Data,
+	}
+	if err := k.Update(ctx, token); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventUpdate{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+		Sender:  msg.Sender,
+	})
+	return &nft.MsgUpdateNFTResponse{}, nil
+}
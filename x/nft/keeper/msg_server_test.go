@@ -0,0 +1,202 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+func (s *TestSuite) TestCreateClass() {
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	msg := &nft.MsgCreateClass{
+		Creator:         s.addrs[0].String(),
+		Id:              testClassID,
+		Name:            testClassName,
+		Symbol:          testClassSymbol,
+		Description:     testClassDescription,
+		Uri:             testClassURI,
+		UriHash:         testClassURIHash,
+		MintPolicy:      nft.MINT_POLICY_ALLOW_LIST,
+		AllowList:       []string{s.addrs[1].String()},
+		MaxSupply:       10,
+		RoyaltyReceiver: s.addrs[0].String(),
+		RoyaltyRate:     sdk.NewDecWithPrec(5, 2),
+	}
+	s.Require().NoError(msg.ValidateBasic())
+
+	_, err := s.app.NFTKeeper.CreateClass(goCtx, msg)
+	s.Require().NoError(err)
+
+	class, has := s.app.NFTKeeper.GetClass(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().Equal(msg.Class(), class)
+
+	res, err := s.queryClient.ClassConfig(goCtx, &nft.QueryClassConfigRequest{ClassId: testClassID})
+	s.Require().NoError(err)
+	s.Require().Equal(msg.ClassConfig(), *res.Config)
+
+	_, err = s.app.NFTKeeper.CreateClass(goCtx, msg)
+	s.Require().ErrorIs(err, nft.ErrClassExists)
+
+	// invalid configs are rejected
+	invalid := *msg
+	invalid.MintPolicy = nft.MINT_POLICY_OPEN
+	s.Require().ErrorIs(invalid.ValidateBasic(), nft.ErrInvalidConfig)
+
+	invalid = *msg
+	invalid.RoyaltyRate = sdk.NewDec(2)
+	s.Require().ErrorIs(invalid.ValidateBasic(), nft.ErrInvalidConfig)
+
+	invalid = *msg
+	invalid.RoyaltyReceiver = ""
+	s.Require().ErrorIs(invalid.ValidateBasic(), nft.ErrInvalidConfig)
+
+	// the class configs are exported and imported with the genesis
+	genesis := s.app.NFTKeeper.ExportGenesis(s.ctx)
+	s.Require().Equal([]*nft.ClassConfig{{
+		ClassId:         testClassID,
+		Authority:       s.addrs[0].String(),
+		MintPolicy:      nft.MINT_POLICY_ALLOW_LIST,
+		AllowList:       []string{s.addrs[1].String()},
+		MaxSupply:       10,
+		RoyaltyReceiver: s.addrs[0].String(),
+		RoyaltyRate:     sdk.NewDecWithPrec(5, 2),
+	}}, genesis.ClassConfigs)
+	s.Require().NoError(nft.ValidateGenesis(*genesis))
+
+	genesis.Classes = nil
+	s.Require().ErrorIs(nft.ValidateGenesis(*genesis), nft.ErrClassNotExists)
+}
+
+func (s *TestSuite) TestMintNFT() {
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	authority, allowed, other := s.addrs[0], s.addrs[1], s.addrs[2]
+
+	// classes created without a config can't be minted with MsgMintNFT
+	err := s.app.NFTKeeper.SaveClass(s.ctx, nft.Class{Id: "nocfg"})
+	s.Require().NoError(err)
+	_, err = s.app.NFTKeeper.MintNFT(goCtx, &nft.MsgMintNFT{Minter: authority.String(), ClassId: "nocfg", Id: testID})
+	s.Require().ErrorIs(err, nft.ErrConfigNotFound)
+
+	_, err = s.app.NFTKeeper.CreateClass(goCtx, &nft.MsgCreateClass{
+		Creator:    authority.String(),
+		Id:         testClassID,
+		MintPolicy: nft.MINT_POLICY_ALLOW_LIST,
+		AllowList:  []string{allowed.String()},
+		MaxSupply:  2,
+	})
+	s.Require().NoError(err)
+
+	_, err = s.app.NFTKeeper.MintNFT(goCtx, &nft.MsgMintNFT{Minter: other.String(), ClassId: testClassID, Id: testID})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// the allow listed minter mints to itself by default
+	_, err = s.app.NFTKeeper.MintNFT(goCtx, &nft.MsgMintNFT{
+		Minter:  allowed.String(),
+		ClassId: testClassID,
+		Id:      testID,
+		Uri:     testURI,
+		UriHash: testURIHash,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(allowed, s.app.NFTKeeper.GetOwner(s.ctx, testClassID, testID))
+
+	token, has := s.app.NFTKeeper.GetNFT(s.ctx, testClassID, testID)
+	s.Require().True(has)
+	s.Require().Equal(testURI, token.Uri)
+
+	// the authority can always mint
+	_, err = s.app.NFTKeeper.MintNFT(goCtx, &nft.MsgMintNFT{
+		Minter:   authority.String(),
+		ClassId:  testClassID,
+		Id:       "kitty2",
+		Receiver: other.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(other, s.app.NFTKeeper.GetOwner(s.ctx, testClassID, "kitty2"))
+
+	_, err = s.app.NFTKeeper.MintNFT(goCtx, &nft.MsgMintNFT{Minter: authority.String(), ClassId: testClassID, Id: "kitty3"})
+	s.Require().ErrorIs(err, nft.ErrSupplyExceeded)
+
+	config, has := s.app.NFTKeeper.GetClassConfig(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().Equal(uint64(2), config.Minted)
+}
+
+func (s *TestSuite) TestBurnNFT() {
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	owner := s.addrs[0]
+
+	_, err := s.app.NFTKeeper.CreateClass(goCtx, &nft.MsgCreateClass{
+		Creator:    owner.String(),
+		Id:         testClassID,
+		MintPolicy: nft.MINT_POLICY_OPEN,
+		MaxSupply:  1,
+	})
+	s.Require().NoError(err)
+
+	_, err = s.app.NFTKeeper.MintNFT(goCtx, &nft.MsgMintNFT{Minter: owner.String(), ClassId: testClassID, Id: testID})
+	s.Require().NoError(err)
+
+	_, err = s.app.NFTKeeper.BurnNFT(goCtx, &nft.MsgBurnNFT{Sender: s.addrs[1].String(), ClassId: testClassID, Id: testID})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = s.app.NFTKeeper.BurnNFT(goCtx, &nft.MsgBurnNFT{Sender: owner.String(), ClassId: testClassID, Id: testID})
+	s.Require().NoError(err)
+	s.Require().False(s.app.NFTKeeper.HasNFT(s.ctx, testClassID, testID))
+	s.Require().Zero(s.app.NFTKeeper.GetTotalSupply(s.ctx, testClassID))
+
+	// burning doesn't free the max supply
+	_, err = s.app.NFTKeeper.MintNFT(goCtx, &nft.MsgMintNFT{Minter: s.addrs[1].String(), ClassId: testClassID, Id: "kitty2"})
+	s.Require().ErrorIs(err, nft.ErrSupplyExceeded)
+}
+
+func (s *TestSuite) TestUpdateNFT() {
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	authority, owner := s.addrs[0], s.addrs[1]
+
+	_, err := s.app.NFTKeeper.CreateClass(goCtx, &nft.MsgCreateClass{
+		Creator:    authority.String(),
+		Id:         testClassID,
+		MintPolicy: nft.MINT_POLICY_ISSUER_ONLY,
+	})
+	s.Require().NoError(err)
+
+	_, err = s.app.NFTKeeper.MintNFT(goCtx, &nft.MsgMintNFT{
+		Minter:   authority.String(),
+		ClassId:  testClassID,
+		Id:       testID,
+		Receiver: owner.String(),
+	})
+	s.Require().NoError(err)
+
+	msg := &nft.MsgUpdateNFT{
+		Sender:  owner.String(),
+		ClassId: testClassID,
+		Id:      testID,
+		Uri:     testURI,
+		UriHash: testURIHash,
+	}
+	_, err = s.app.NFTKeeper.UpdateNFT(goCtx, msg)
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// the authority can't update the nfts owned by other accounts
+	msg.Sender = authority.String()
+	_, err = s.app.NFTKeeper.UpdateNFT(goCtx, msg)
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = s.app.NFTKeeper.Send(goCtx, &nft.MsgSend{ClassId: testClassID, Id: testID, Sender: owner.String(), Receiver: authority.String()})
+	s.Require().NoError(err)
+	_, err = s.app.NFTKeeper.UpdateNFT(goCtx, msg)
+	s.Require().NoError(err)
+
+	token, has := s.app.NFTKeeper.GetNFT(s.ctx, testClassID, testID)
+	s.Require().True(has)
+	s.Require().Equal(testURI, token.Uri)
+	s.Require().Equal(testURIHash, token.UriHash)
+	s.Require().Equal(authority, s.app.NFTKeeper.GetOwner(s.ctx, testClassID, testID))
+
+	msg.Id = "kitty2"
+	_, err = s.app.NFTKeeper.UpdateNFT(goCtx, msg)
+	s.Require().ErrorIs(err, nft.ErrNFTNotExists)
+}
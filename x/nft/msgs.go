@@ -7,10 +7,20 @@ import (
 
 const (
 	// TypeMsgSend nft message types
-	TypeMsgSend = "send"
+	TypeMsgSend        = "send"
+	TypeMsgCreateClass = "create_class"
+	TypeMsgMintNFT     = "mint_nft"
+	TypeMsgBurnNFT     = "burn_nft"
+	TypeMsgUpdateNFT   = "update_nft"
 )
 
-var _ sdk.Msg = &MsgSend{}
+var (
+	_ sdk.Msg = &MsgSend{}
+	_ sdk.Msg = &MsgCreateClass{}
+	_ sdk.Msg = &MsgMintNFT{}
+	_ sdk.Msg = &MsgBurnNFT{}
+	_ sdk.Msg = &MsgUpdateNFT{}
+)
 
 // ValidateBasic implements the Msg.ValidateBasic method.
 func (m MsgSend) ValidateBasic() error {
@@ -39,3 +49,126 @@ func (m MsgSend) GetSigners() []sdk.AccAddress {
 	signer, _ := sdk.AccAddressFromBech32(m.Sender)
 	return []sdk.AccAddress{signer}
 }
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgCreateClass) ValidateBasic() error {
+	if err := ValidateClassID(m.Id); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidClassID, "Invalid class id (%s)", m.Id)
+	}
+
+	_, err := sdk.AccAddressFromBech32(m.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid creator address (%s)", m.Creator)
+	}
+
+	return m.ClassConfig().Validate()
+}
+
+// GetSigners implements Msg
+func (m MsgCreateClass) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Creator)
+	return []sdk.AccAddress{signer}
+}
+
+// Class returns the class created by the message.
+func (m MsgCreateClass) Class() Class {
+	return Class{
+		Id:          m.Id,
+		Name:        m.Name,
+		Symbol:      m.Symbol,
+		Description: m.Description,
+		Uri:         m.Uri,
+		UriHash:     m.UriHash,
+		Data:        m.Data,
+	}
+}
+
+// ClassConfig returns the config of the class created by the message, the
+// creator being the class authority.
+func (m MsgCreateClass) ClassConfig() ClassConfig {
+	return ClassConfig{
+		ClassId:         m.Id,
+		Authority:       m.Creator,
+		MintPolicy:      m.MintPolicy,
+		AllowList:       m.AllowList,
+		MaxSupply:       m.MaxSupply,
+		RoyaltyReceiver: m.RoyaltyReceiver,
+		RoyaltyRate:     m.RoyaltyRate,
+	}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgMintNFT) ValidateBasic() error {
+	if err := ValidateClassID(m.ClassId); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidID, "Invalid class id (%s)", m.ClassId)
+	}
+
+	if err := ValidateNFTID(m.Id); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidID, "Invalid nft id (%s)", m.Id)
+	}
+
+	_, err := sdk.AccAddressFromBech32(m.Minter)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid minter address (%s)", m.Minter)
+	}
+
+	if m.Receiver != "" {
+		_, err = sdk.AccAddressFromBech32(m.Receiver)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid receiver address (%s)", m.Receiver)
+		}
+	}
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgMintNFT) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Minter)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgBurnNFT) ValidateBasic() error {
+	if err := ValidateClassID(m.ClassId); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidID, "Invalid class id (%s)", m.ClassId)
+	}
+
+	if err := ValidateNFTID(m.Id); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidID, "Invalid nft id (%s)", m.Id)
+	}
+
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", m.Sender)
+	}
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgBurnNFT) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgUpdateNFT) ValidateBasic() error {
+	if err := ValidateClassID(m.ClassId); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidID, "Invalid class id (%s)", m.ClassId)
+	}
+
+	if err := ValidateNFTID(m.Id); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidID, "Invalid nft id (%s)", m.Id)
+	}
+
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", m.Sender)
+	}
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgUpdateNFT) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{signer}
+}
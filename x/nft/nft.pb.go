@@ -5,7 +5,10 @@ package nft
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -23,6 +26,46 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// MintPolicy defines which accounts can mint the NFTs of a class with
+// Msg/MintNFT.
+type MintPolicy int32
+
+const (
+	// MINT_POLICY_UNSPECIFIED defines an unspecified mint policy, which is
+	// invalid.
+	MINT_POLICY_UNSPECIFIED MintPolicy = 0
+	// MINT_POLICY_OPEN defines a mint policy allowing any account to mint.
+	MINT_POLICY_OPEN MintPolicy = 1
+	// MINT_POLICY_ISSUER_ONLY defines a mint policy allowing only the class
+	// minting authority to mint.
+	MINT_POLICY_ISSUER_ONLY MintPolicy = 2
+	// MINT_POLICY_ALLOW_LIST defines a mint policy allowing the class minting
+	// authority and the accounts of the class allow list to mint.
+	MINT_POLICY_ALLOW_LIST MintPolicy = 3
+)
+
+var MintPolicy_name = map[int32]string{
+	0: "MINT_POLICY_UNSPECIFIED",
+	1: "MINT_POLICY_OPEN",
+	2: "MINT_POLICY_ISSUER_ONLY",
+	3: "MINT_POLICY_ALLOW_LIST",
+}
+
+var MintPolicy_value = map[string]int32{
+	"MINT_POLICY_UNSPECIFIED": 0,
+	"MINT_POLICY_OPEN":        1,
+	"MINT_POLICY_ISSUER_ONLY": 2,
+	"MINT_POLICY_ALLOW_LIST":  3,
+}
+
+func (x MintPolicy) String() string {
+	return proto.EnumName(MintPolicy_name, int32(x))
+}
+
+func (MintPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{0}
+}
+
 // Class defines the class of the nft type.
 type Class struct {
 	// id defines the unique identifier of the NFT classification, similar to the contract address of ERC721
@@ -205,35 +248,167 @@ func (m *NFT) GetData() *types.Any {
 	return nil
 }
 
+// ClassConfig defines the minting configuration and the royalty metadata of a
+// class created with Msg/CreateClass.
+type ClassConfig struct {
+	// class_id is the id of the class.
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// authority is the minting authority of the class, the account that created
+	// it. It can always mint the NFTs of the class, and update the ones it owns.
+	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+	// mint_policy defines which accounts can mint the NFTs of the class.
+	MintPolicy MintPolicy `protobuf:"varint,3,opt,name=mint_policy,json=mintPolicy,proto3,enum=cosmos.nft.v1beta1.MintPolicy" json:"mint_policy,omitempty"`
+	// allow_list is the list of the accounts allowed to mint with the
+	// MINT_POLICY_ALLOW_LIST mint policy.
+	AllowList []string `protobuf:"bytes,4,rep,name=allow_list,json=allowList,proto3" json:"allow_list,omitempty"`
+	// max_supply is the maximum number of NFTs of the class that can ever be
+	// minted, burned NFTs included, zero means no limit.
+	MaxSupply uint64 `protobuf:"varint,5,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
+	// royalty_receiver is the address receiving the royalties of the sales of
+	// the NFTs of the class. Optional
+	RoyaltyReceiver string `protobuf:"bytes,6,opt,name=royalty_receiver,json=royaltyReceiver,proto3" json:"royalty_receiver,omitempty"`
+	// royalty_rate is the fraction of the sale price of the NFTs of the class
+	// paid to the royalty receiver, between 0 and 1. The royalties are metadata
+	// for the marketplaces and are not enforced by the module.
+	RoyaltyRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=royalty_rate,json=royaltyRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"royalty_rate"`
+	// minted is the number of NFTs of the class minted with Msg/MintNFT, checked
+	// against max_supply. It is maintained by the module.
+	Minted uint64 `protobuf:"varint,8,opt,name=minted,proto3" json:"minted,omitempty"`
+}
+
+func (m *ClassConfig) Reset()         { *m = ClassConfig{} }
+func (m *ClassConfig) String() string { return proto.CompactTextString(m) }
+func (*ClassConfig) ProtoMessage()    {}
+func (*ClassConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{2}
+}
+func (m *ClassConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClassConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClassConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClassConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClassConfig.Merge(m, src)
+}
+func (m *ClassConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClassConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClassConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClassConfig proto.InternalMessageInfo
+
+func (m *ClassConfig) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *ClassConfig) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *ClassConfig) GetMintPolicy() MintPolicy {
+	if m != nil {
+		return m.MintPolicy
+	}
+	return MINT_POLICY_UNSPECIFIED
+}
+
+func (m *ClassConfig) GetAllowList() []string {
+	if m != nil {
+		return m.AllowList
+	}
+	return nil
+}
+
+func (m *ClassConfig) GetMaxSupply() uint64 {
+	if m != nil {
+		return m.MaxSupply
+	}
+	return 0
+}
+
+func (m *ClassConfig) GetRoyaltyReceiver() string {
+	if m != nil {
+		return m.RoyaltyReceiver
+	}
+	return ""
+}
+
+func (m *ClassConfig) GetMinted() uint64 {
+	if m != nil {
+		return m.Minted
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.nft.v1beta1.MintPolicy", MintPolicy_name, MintPolicy_value)
 	proto.RegisterType((*Class)(nil), "cosmos.nft.v1beta1.Class")
 	proto.RegisterType((*NFT)(nil), "cosmos.nft.v1beta1.NFT")
+	proto.RegisterType((*ClassConfig)(nil), "cosmos.nft.v1beta1.ClassConfig")
 }
 
 func init() { proto.RegisterFile("cosmos/nft/v1beta1/nft.proto", fileDescriptor_eb8ebf8e8053172c) }
 
 var fileDescriptor_eb8ebf8e8053172c = []byte{
-	// 317 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xc1, 0x4a, 0xc3, 0x30,
-	0x1c, 0xc6, 0x97, 0xb6, 0xdb, 0x34, 0x03, 0x91, 0x20, 0x92, 0x89, 0x84, 0xb1, 0xd3, 0x2e, 0x26,
-	0x4c, 0xaf, 0x5e, 0x54, 0x10, 0xbd, 0x78, 0x18, 0x9e, 0xbc, 0x8c, 0xb4, 0xe9, 0xd6, 0xe0, 0xda,
-	0x8c, 0x26, 0x15, 0xfb, 0x04, 0x5e, 0x7d, 0x20, 0x1f, 0xc0, 0xe3, 0x8e, 0x1e, 0xa5, 0x7d, 0x11,
-	0x49, 0x5a, 0x87, 0x87, 0x81, 0xa7, 0xfc, 0xf3, 0x7d, 0x1f, 0x7f, 0x7e, 0x1f, 0x7f, 0x78, 0x1a,
-	0x29, 0x9d, 0x2a, 0xcd, 0xb2, 0x85, 0x61, 0x2f, 0xd3, 0x30, 0x36, 0x7c, 0x6a, 0x67, 0xba, 0xce,
-	0x95, 0x51, 0x08, 0x35, 0x2e, 0xb5, 0x4a, 0xeb, 0x9e, 0x0c, 0x97, 0x4a, 0x2d, 0x57, 0x31, 0x73,
-	0x89, 0xb0, 0x58, 0x30, 0x9e, 0x95, 0x4d, 0x7c, 0xfc, 0x01, 0x60, 0xf7, 0x66, 0xc5, 0xb5, 0x46,
-	0x07, 0xd0, 0x93, 0x02, 0x83, 0x11, 0x98, 0xec, 0xcf, 0x3c, 0x29, 0x10, 0x82, 0x41, 0xc6, 0xd3,
-	0x18, 0x7b, 0x4e, 0x71, 0x33, 0x3a, 0x86, 0x3d, 0x5d, 0xa6, 0xa1, 0x5a, 0x61, 0xdf, 0xa9, 0xed,
-	0x0f, 0x8d, 0xe0, 0x40, 0xc4, 0x3a, 0xca, 0xe5, 0xda, 0x48, 0x95, 0xe1, 0xc0, 0x99, 0x7f, 0x25,
-	0x74, 0x08, 0xfd, 0x22, 0x97, 0xb8, 0xeb, 0x1c, 0x3b, 0xa2, 0x21, 0xdc, 0x2b, 0x72, 0x39, 0x4f,
-	0xb8, 0x4e, 0x70, 0xcf, 0xc9, 0xfd, 0x22, 0x97, 0x77, 0x5c, 0x27, 0x68, 0x02, 0x03, 0xc1, 0x0d,
-	0xc7, 0xfd, 0x11, 0x98, 0x0c, 0xce, 0x8f, 0x68, 0x83, 0x4f, 0x7f, 0xf1, 0xe9, 0x55, 0x56, 0xce,
-	0x5c, 0x62, 0xfc, 0x06, 0xa0, 0xff, 0x70, 0xfb, 0x68, 0x97, 0x45, 0xb6, 0xc5, 0x7c, 0x5b, 0xa1,
-	0xef, 0xfe, 0xf7, 0xa2, 0xed, 0xe5, 0x6d, 0x7b, 0xb5, 0x24, 0xfe, 0x6e, 0x92, 0x60, 0x37, 0x09,
-	0xfc, 0x8f, 0xe4, 0xfa, 0xf2, 0xb3, 0x22, 0x60, 0x53, 0x11, 0xf0, 0x5d, 0x11, 0xf0, 0x5e, 0x93,
-	0xce, 0xa6, 0x26, 0x9d, 0xaf, 0x9a, 0x74, 0x9e, 0xc6, 0x4b, 0x69, 0x92, 0x22, 0xa4, 0x91, 0x4a,
-	0x59, 0x7b, 0xba, 0xe6, 0x39, 0xd3, 0xe2, 0x99, 0xbd, 0xda, 0xdb, 0x85, 0x3d, 0xb7, 0xf1, 0xe2,
-	0x27, 0x00, 0x00, 0xff, 0xff, 0x94, 0x4b, 0x2c, 0xc3, 0xdc, 0x01, 0x00, 0x00,
+	// 625 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xcd, 0x4e, 0xdb, 0x40,
+	0x10, 0x8e, 0x63, 0xf3, 0x93, 0x49, 0x45, 0xad, 0x55, 0x44, 0x4d, 0xda, 0x9a, 0x88, 0x43, 0x85,
+	0x2a, 0xe1, 0x08, 0x2a, 0xb5, 0x17, 0xa4, 0x0a, 0x42, 0x50, 0x2d, 0x85, 0x24, 0x72, 0x40, 0x15,
+	0xbd, 0x58, 0x1b, 0xdb, 0x38, 0xab, 0xda, 0xde, 0xc8, 0xbb, 0xa6, 0xf8, 0xd2, 0x6b, 0x7b, 0xec,
+	0x3b, 0xf4, 0x15, 0x90, 0x7a, 0xe9, 0x03, 0x70, 0x44, 0x9c, 0xaa, 0x1e, 0x50, 0x05, 0x2f, 0x52,
+	0x79, 0x6d, 0x02, 0x6a, 0x51, 0x38, 0x79, 0xe6, 0xfb, 0x66, 0x67, 0xbf, 0x6f, 0x67, 0x64, 0x78,
+	0xe6, 0x50, 0x16, 0x52, 0xd6, 0x8c, 0x8e, 0x78, 0xf3, 0x78, 0x7d, 0xe8, 0x71, 0xbc, 0x9e, 0xc5,
+	0xc6, 0x38, 0xa6, 0x9c, 0x22, 0x94, 0xb3, 0x46, 0x86, 0x14, 0x6c, 0x7d, 0xc9, 0xa7, 0xd4, 0x0f,
+	0xbc, 0xa6, 0xa8, 0x18, 0x26, 0x47, 0x4d, 0x1c, 0xa5, 0x79, 0x79, 0xbd, 0xe6, 0x53, 0x9f, 0x8a,
+	0xb0, 0x99, 0x45, 0x05, 0xba, 0x94, 0x37, 0xb1, 0x73, 0xa2, 0xe8, 0x28, 0x92, 0x95, 0x9f, 0x12,
+	0xcc, 0xb4, 0x02, 0xcc, 0x18, 0x5a, 0x80, 0x32, 0x71, 0x35, 0xa9, 0x21, 0xad, 0x56, 0xac, 0x32,
+	0x71, 0x11, 0x02, 0x25, 0xc2, 0xa1, 0xa7, 0x95, 0x05, 0x22, 0x62, 0xb4, 0x08, 0xb3, 0x2c, 0x0d,
+	0x87, 0x34, 0xd0, 0x64, 0x81, 0x16, 0x19, 0x6a, 0x40, 0xd5, 0xf5, 0x98, 0x13, 0x93, 0x31, 0x27,
+	0x34, 0xd2, 0x14, 0x41, 0xde, 0x85, 0x90, 0x0a, 0x72, 0x12, 0x13, 0x6d, 0x46, 0x30, 0x59, 0x88,
+	0x96, 0x60, 0x3e, 0x89, 0x89, 0x3d, 0xc2, 0x6c, 0xa4, 0xcd, 0x0a, 0x78, 0x2e, 0x89, 0xc9, 0x3b,
+	0xcc, 0x46, 0x68, 0x15, 0x14, 0x17, 0x73, 0xac, 0xcd, 0x35, 0xa4, 0xd5, 0xea, 0x46, 0xcd, 0xc8,
+	0xfd, 0x1a, 0x37, 0x7e, 0x8d, 0xad, 0x28, 0xb5, 0x44, 0xc5, 0xca, 0x17, 0x09, 0xe4, 0xee, 0xee,
+	0x7e, 0xd6, 0xcc, 0xc9, 0x5c, 0xd8, 0x13, 0x0b, 0x73, 0x22, 0x37, 0xdd, 0xc2, 0x57, 0x79, 0xe2,
+	0xab, 0x50, 0x22, 0xdf, 0xaf, 0x44, 0xb9, 0x5f, 0x09, 0x3c, 0xa8, 0xe4, 0x87, 0x0c, 0x55, 0xf1,
+	0x90, 0x2d, 0x1a, 0x1d, 0x11, 0x7f, 0x9a, 0xa2, 0xd7, 0x50, 0xc1, 0x09, 0x1f, 0xd1, 0x98, 0xf0,
+	0x34, 0x17, 0xb6, 0xad, 0x5d, 0x9c, 0xae, 0xd5, 0x8a, 0xc1, 0x6c, 0xb9, 0x6e, 0xec, 0x31, 0x36,
+	0xe0, 0x31, 0x89, 0x7c, 0xeb, 0xb6, 0x14, 0xbd, 0x85, 0x6a, 0x48, 0x22, 0x6e, 0x8f, 0x69, 0x40,
+	0x9c, 0x54, 0x38, 0x58, 0xd8, 0xd0, 0x8d, 0xff, 0x37, 0xc4, 0xd8, 0x23, 0x11, 0xef, 0x8b, 0x2a,
+	0x0b, 0xc2, 0x49, 0x8c, 0xde, 0x00, 0xe0, 0x20, 0xa0, 0x9f, 0xec, 0x80, 0x30, 0xae, 0x29, 0x0d,
+	0xf9, 0x81, 0x9b, 0xb3, 0xda, 0x0e, 0x61, 0x1c, 0x3d, 0x07, 0x08, 0xf1, 0x89, 0xcd, 0x92, 0xf1,
+	0x38, 0x48, 0xc5, 0x10, 0x15, 0xab, 0x12, 0xe2, 0x93, 0x81, 0x00, 0x50, 0x0b, 0xd4, 0x98, 0xa6,
+	0x38, 0xe0, 0xa9, 0x1d, 0x7b, 0x8e, 0x47, 0x8e, 0xbd, 0x38, 0x1f, 0xe9, 0x94, 0xee, 0x8f, 0x8b,
+	0x13, 0x56, 0x71, 0x00, 0xd9, 0xf0, 0x68, 0xd2, 0x04, 0x73, 0x4f, 0x0c, 0xbf, 0xb2, 0xbd, 0x79,
+	0x76, 0xb9, 0x5c, 0xfa, 0x7d, 0xb9, 0xfc, 0xc2, 0x27, 0x7c, 0x94, 0x0c, 0x0d, 0x87, 0x86, 0xc5,
+	0x02, 0x17, 0x9f, 0x35, 0xe6, 0x7e, 0x6c, 0xf2, 0x74, 0xec, 0x31, 0x63, 0xc7, 0x73, 0x2e, 0x4e,
+	0xd7, 0xa0, 0xb8, 0x6e, 0xc7, 0x73, 0xac, 0xea, 0xcd, 0x25, 0x98, 0x8b, 0xe5, 0xcd, 0xde, 0xc2,
+	0x73, 0xb5, 0x79, 0x61, 0xa0, 0xc8, 0x5e, 0x7e, 0x06, 0xb8, 0x7d, 0x2f, 0xf4, 0x14, 0x9e, 0xec,
+	0x99, 0xdd, 0x7d, 0xbb, 0xdf, 0xeb, 0x98, 0xad, 0x43, 0xfb, 0xa0, 0x3b, 0xe8, 0xb7, 0x5b, 0xe6,
+	0xae, 0xd9, 0xde, 0x51, 0x4b, 0xa8, 0x06, 0xea, 0x5d, 0xb2, 0xd7, 0x6f, 0x77, 0x55, 0xe9, 0xdf,
+	0x23, 0xe6, 0x60, 0x70, 0xd0, 0xb6, 0xec, 0x5e, 0xb7, 0x73, 0xa8, 0x96, 0x51, 0x1d, 0x16, 0xef,
+	0x92, 0x5b, 0x9d, 0x4e, 0xef, 0xbd, 0xdd, 0x31, 0x07, 0xfb, 0xaa, 0x5c, 0x57, 0xbe, 0x7e, 0xd7,
+	0x4b, 0xdb, 0x9b, 0x67, 0x57, 0xba, 0x74, 0x7e, 0xa5, 0x4b, 0x7f, 0xae, 0x74, 0xe9, 0xdb, 0xb5,
+	0x5e, 0x3a, 0xbf, 0xd6, 0x4b, 0xbf, 0xae, 0xf5, 0xd2, 0x87, 0x95, 0xa9, 0xa6, 0x4f, 0xb2, 0xdf,
+	0xc4, 0x70, 0x56, 0xec, 0xe2, 0xab, 0xbf, 0x03, 0x00, 0x15, 0x7b, 0x9b, 0x05, 0x47, 0x04, 0x00,
+	0x00,
 }
 
 func (m *Class) Marshal() (dAtA []byte, err error) {
@@ -376,6 +551,84 @@ func (m *NFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClassConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClassConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClassConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Minted != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.Minted))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.RoyaltyRate.Size()
+		i -= size
+		if _, err := m.RoyaltyRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintNft(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.RoyaltyReceiver) > 0 {
+		i -= len(m.RoyaltyReceiver)
+		copy(dAtA[i:], m.RoyaltyReceiver)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.RoyaltyReceiver)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MaxSupply != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.MaxSupply))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.AllowList) > 0 {
+		for iNdEx := len(m.AllowList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowList[iNdEx])
+			copy(dAtA[i:], m.AllowList[iNdEx])
+			i = encodeVarintNft(dAtA, i, uint64(len(m.AllowList[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MintPolicy != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.MintPolicy))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintNft(dAtA []byte, offset int, v uint64) int {
 	offset -= sovNft(v)
 	base := offset
@@ -453,6 +706,44 @@ func (m *NFT) Size() (n int) {
 	return n
 }
 
+func (m *ClassConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if m.MintPolicy != 0 {
+		n += 1 + sovNft(uint64(m.MintPolicy))
+	}
+	if len(m.AllowList) > 0 {
+		for _, s := range m.AllowList {
+			l = len(s)
+			n += 1 + l + sovNft(uint64(l))
+		}
+	}
+	if m.MaxSupply != 0 {
+		n += 1 + sovNft(uint64(m.MaxSupply))
+	}
+	l = len(m.RoyaltyReceiver)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = m.RoyaltyRate.Size()
+	n += 1 + l + sovNft(uint64(l))
+	if m.Minted != 0 {
+		n += 1 + sovNft(uint64(m.Minted))
+	}
+	return n
+}
+
 func sovNft(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -951,6 +1242,275 @@ func (m *NFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ClassConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintPolicy", wireType)
+			}
+			m.MintPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintPolicy |= MintPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowList = append(m.AllowList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			m.MaxSupply = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSupply |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoyaltyReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RoyaltyRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			m.Minted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Minted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipNft(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -716,6 +716,96 @@ func (m *QueryClassesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryClassConfigRequest is the request type for the Query/ClassConfig RPC method
+type QueryClassConfigRequest struct {
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+}
+
+func (m *QueryClassConfigRequest) Reset()         { *m = QueryClassConfigRequest{} }
+func (m *QueryClassConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClassConfigRequest) ProtoMessage()    {}
+func (*QueryClassConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d24e0db697b0f9d, []int{14}
+}
+func (m *QueryClassConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClassConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClassConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClassConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClassConfigRequest.Merge(m, src)
+}
+func (m *QueryClassConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClassConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClassConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClassConfigRequest proto.InternalMessageInfo
+
+func (m *QueryClassConfigRequest) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+// QueryClassConfigResponse is the response type for the Query/ClassConfig RPC method
+type QueryClassConfigResponse struct {
+	Config *ClassConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (m *QueryClassConfigResponse) Reset()         { *m = QueryClassConfigResponse{} }
+func (m *QueryClassConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClassConfigResponse) ProtoMessage()    {}
+func (*QueryClassConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d24e0db697b0f9d, []int{15}
+}
+func (m *QueryClassConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClassConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClassConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClassConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClassConfigResponse.Merge(m, src)
+}
+func (m *QueryClassConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClassConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClassConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClassConfigResponse proto.InternalMessageInfo
+
+func (m *QueryClassConfigResponse) GetConfig() *ClassConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryBalanceRequest)(nil), "cosmos.nft.v1beta1.QueryBalanceRequest")
 	proto.RegisterType((*QueryBalanceResponse)(nil), "cosmos.nft.v1beta1.QueryBalanceResponse")
@@ -731,58 +821,64 @@ func init() {
 	proto.RegisterType((*QueryClassResponse)(nil), "cosmos.nft.v1beta1.QueryClassResponse")
 	proto.RegisterType((*QueryClassesRequest)(nil), "cosmos.nft.v1beta1.QueryClassesRequest")
 	proto.RegisterType((*QueryClassesResponse)(nil), "cosmos.nft.v1beta1.QueryClassesResponse")
+	proto.RegisterType((*QueryClassConfigRequest)(nil), "cosmos.nft.v1beta1.QueryClassConfigRequest")
+	proto.RegisterType((*QueryClassConfigResponse)(nil), "cosmos.nft.v1beta1.QueryClassConfigResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/nft/v1beta1/query.proto", fileDescriptor_0d24e0db697b0f9d) }
 
 var fileDescriptor_0d24e0db697b0f9d = []byte{
-	// 728 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x4b, 0x6f, 0xd3, 0x40,
-	0x10, 0xc7, 0xbb, 0x49, 0xd3, 0xc0, 0x54, 0xe2, 0xb1, 0x54, 0x90, 0x1a, 0xb0, 0x22, 0xf7, 0x91,
-	0xb4, 0x55, 0x77, 0xfb, 0x90, 0x38, 0x15, 0x0e, 0x45, 0x04, 0x71, 0x29, 0x10, 0x7a, 0x42, 0x42,
-	0xc8, 0x49, 0x9c, 0x60, 0x91, 0xda, 0x69, 0xd7, 0x06, 0xaa, 0xaa, 0x07, 0x7a, 0x40, 0x54, 0x5c,
-	0x90, 0xe8, 0xe7, 0xe1, 0xcc, 0xb1, 0x12, 0x17, 0x8e, 0xa8, 0xe5, 0x83, 0x20, 0xef, 0x8e, 0x53,
-	0x9b, 0x3a, 0x76, 0x15, 0x71, 0xaa, 0xec, 0xfd, 0xcf, 0xfc, 0x7f, 0xde, 0x79, 0x34, 0xa0, 0x37,
-	0x5d, 0xb1, 0xe5, 0x0a, 0xee, 0xb4, 0x3d, 0xfe, 0x6e, 0xb9, 0x61, 0x79, 0xe6, 0x32, 0xdf, 0xf6,
-	0xad, 0x9d, 0x5d, 0xd6, 0xdb, 0x71, 0x3d, 0x97, 0x52, 0x75, 0xce, 0x9c, 0xb6, 0xc7, 0xf0, 0x5c,
-	0x9b, 0xc7, 0x98, 0x86, 0x29, 0x2c, 0x25, 0xee, 0x87, 0xf6, 0xcc, 0x8e, 0xed, 0x98, 0x9e, 0xed,
-	0x3a, 0x2a, 0x5e, 0xbb, 0xd3, 0x71, 0xdd, 0x4e, 0xd7, 0xe2, 0x66, 0xcf, 0xe6, 0xa6, 0xe3, 0xb8,
-	0x9e, 0x3c, 0x14, 0xe1, 0x69, 0x82, 0x7b, 0xe0, 0x24, 0x4f, 0x8d, 0x1a, 0xdc, 0x78, 0x1e, 0x64,
-	0x5f, 0x37, 0xbb, 0xa6, 0xd3, 0xb4, 0xea, 0xd6, 0xb6, 0x6f, 0x09, 0x8f, 0x4e, 0xc2, 0xa5, 0x66,
-	0xd7, 0x14, 0xe2, 0xb5, 0xdd, 0x2a, 0x91, 0x32, 0xa9, 0x5e, 0xae, 0x17, 0xe5, 0xf3, 0x93, 0x16,
-	0x9d, 0x80, 0x82, 0xfb, 0xde, 0xb1, 0x76, 0x4a, 0x39, 0xf9, 0x5e, 0x3d, 0x18, 0x0c, 0x26, 0xe2,
-	0x79, 0x44, 0xcf, 0x75, 0x84, 0x45, 0x6f, 0xc2, 0x98, 0xb9, 0xe5, 0xfa, 0x8e, 0x27, 0xd3, 0x8c,
-	0xd6, 0xf1, 0xc9, 0x78, 0x00, 0xd7, 0xa5, 0xfe, 0x69, 0x10, 0x7d, 0x01, 0xd7, 0x2b, 0x90, 0xb3,
-	0x5b, 0x68, 0x99, 0xb3, 0x5b, 0xc6, 0x3c, 0xd0, 0x68, 0x3c, 0xba, 0xf5, 0xd9, 0x48, 0x94, 0x8d,
-	0xa3, 0xf6, 0x85, 0xdf, 0xeb, 0x75, 0x77, 0xb3, 0xcd, 0x8c, 0x45, 0xbc, 0x94, 0x30, 0x20, 0xe3,
-	0x5b, 0xbe, 0x10, 0xb8, 0x26, 0xf5, 0x1b, 0xb5, 0x4d, 0x31, 0xec, 0x0d, 0xd2, 0x1a, 0xc0, 0x59,
-	0x65, 0x4b, 0xf9, 0x32, 0xa9, 0x8e, 0xaf, 0xcc, 0x32, 0x6c, 0x8d, 0xa0, 0x0d, 0x98, 0xea, 0x19,
-	0xac, 0x21, 0x7b, 0x66, 0x76, 0xc2, 0x72, 0xd5, 0x23, 0x91, 0xc6, 0x21, 0xc1, 0xab, 0x55, 0x34,
-	0xc8, 0xbe, 0x00, 0xa3, 0x4e, 0xdb, 0x13, 0x25, 0x52, 0xce, 0x57, 0xc7, 0x57, 0x6e, 0xb1, 0xf3,
-	0x2d, 0xc7, 0x36, 0x6a, 0x9b, 0x75, 0x29, 0xa2, 0x8f, 0x63, 0x28, 0x39, 0x89, 0x52, 0xc9, 0x44,
-	0x51, 0x4e, 0x31, 0x96, 0x35, 0xb8, 0x1a, 0xa2, 0x0c, 0x51, 0xe3, 0xfb, 0x67, 0xd7, 0xda, 0xff,
-	0x8e, 0x39, 0xc8, 0x3b, 0x6d, 0x55, 0x80, 0x94, 0xcf, 0x08, 0x34, 0x06, 0xc3, 0x7b, 0x78, 0x18,
-	0xa4, 0xbf, 0x40, 0xd5, 0x1f, 0x61, 0x9b, 0xa0, 0x1e, 0x0d, 0x39, 0x14, 0xa4, 0x00, 0x2d, 0x27,
-	0x93, 0x2c, 0x55, 0x84, 0xd2, 0x19, 0xaf, 0xb0, 0x79, 0xe4, 0x4b, 0xab, 0x6f, 0x1c, 0x2f, 0x2f,
-	0x19, 0xba, 0xbc, 0x47, 0x04, 0x27, 0xad, 0x9f, 0x1f, 0x41, 0x57, 0x41, 0x7d, 0x89, 0x15, 0x16,
-	0x39, 0x05, 0x35, 0x54, 0xfe, 0xb7, 0x4a, 0xaf, 0x7c, 0x2f, 0x42, 0x41, 0x62, 0xd1, 0x23, 0x02,
-	0x45, 0xdc, 0x02, 0xb4, 0x92, 0x84, 0x90, 0xb0, 0x6f, 0xb4, 0x6a, 0xb6, 0x50, 0x99, 0x1a, 0xf7,
-	0x0e, 0x7e, 0xfe, 0xf9, 0x96, 0x5b, 0xa2, 0x8c, 0x27, 0xec, 0xb5, 0x86, 0x12, 0xf3, 0x3d, 0x39,
-	0x52, 0xfb, 0x7c, 0x2f, 0xac, 0xf5, 0x3e, 0x3d, 0x24, 0x50, 0x90, 0xcb, 0x82, 0xce, 0x0c, 0xf4,
-	0x8a, 0x2e, 0x23, 0x6d, 0x36, 0x4b, 0x86, 0x40, 0xcb, 0x12, 0x68, 0x81, 0xce, 0x25, 0x01, 0x49,
-	0x8e, 0x08, 0x06, 0xdf, 0x0b, 0x58, 0x3e, 0x13, 0x18, 0x53, 0xbb, 0x85, 0x0e, 0x76, 0x89, 0x6d,
-	0x2b, 0xad, 0x92, 0xa9, 0x43, 0x9c, 0x45, 0x89, 0x53, 0xa1, 0x33, 0x49, 0x38, 0x42, 0x6a, 0xa3,
-	0xd7, 0xe2, 0xc3, 0x68, 0xb0, 0x27, 0xe8, 0xf4, 0xc0, 0xfc, 0x91, 0xa5, 0xa6, 0xcd, 0x64, 0xa8,
-	0x90, 0xa1, 0x2c, 0x19, 0x34, 0x5a, 0xe2, 0xc9, 0xff, 0x7b, 0x04, 0x3d, 0x20, 0x90, 0xdf, 0xa8,
-	0x6d, 0xd2, 0xa9, 0xb4, 0x84, 0xa1, 0xeb, 0x74, 0xba, 0x08, 0x4d, 0x97, 0xa4, 0xe9, 0x3c, 0xad,
-	0x0e, 0x32, 0x3d, 0x57, 0x86, 0x4f, 0x04, 0x0a, 0x72, 0x1e, 0x52, 0x5a, 0x22, 0xba, 0x3c, 0x52,
-	0x5a, 0x22, 0xb6, 0x33, 0x0c, 0x26, 0x51, 0xaa, 0x74, 0x36, 0x09, 0x05, 0x47, 0x2f, 0x5a, 0x84,
-	0x8f, 0x04, 0x8a, 0x38, 0xce, 0x29, 0x23, 0x13, 0x5f, 0x28, 0x29, 0x23, 0xf3, 0xcf, 0x66, 0x30,
-	0xa6, 0x24, 0xce, 0x5d, 0x7a, 0x3b, 0x05, 0x67, 0x7d, 0xed, 0xc7, 0x89, 0x4e, 0x8e, 0x4f, 0x74,
-	0xf2, 0xfb, 0x44, 0x27, 0x5f, 0x4f, 0xf5, 0x91, 0xe3, 0x53, 0x7d, 0xe4, 0xd7, 0xa9, 0x3e, 0xf2,
-	0xd2, 0xe8, 0xd8, 0xde, 0x1b, 0xbf, 0xc1, 0x9a, 0xee, 0x56, 0x98, 0x40, 0xfd, 0x59, 0x14, 0xad,
-	0xb7, 0xfc, 0x43, 0x90, 0xad, 0x31, 0x26, 0x7f, 0x4d, 0xac, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff,
-	0x01, 0x46, 0x70, 0x1f, 0xeb, 0x08, 0x00, 0x00,
+	// 787 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x4f, 0x4f, 0xdb, 0x4a,
+	0x14, 0xc5, 0x99, 0x84, 0x04, 0xde, 0x45, 0x7a, 0xef, 0x31, 0xa0, 0x47, 0xf0, 0x6b, 0xd3, 0xc8,
+	0x40, 0x62, 0xa0, 0xf1, 0xf0, 0xa7, 0x7f, 0x36, 0xb4, 0x0b, 0x50, 0x53, 0x75, 0x43, 0xdb, 0xc0,
+	0xaa, 0x52, 0x55, 0x39, 0x89, 0x93, 0x5a, 0x0d, 0x76, 0x60, 0x9c, 0xb6, 0x08, 0xb1, 0x28, 0x8b,
+	0xaa, 0xa8, 0x9b, 0x4a, 0x65, 0xdf, 0xaf, 0xd3, 0x25, 0x52, 0x37, 0x5d, 0x22, 0xe8, 0x07, 0xa9,
+	0x3c, 0x73, 0x1d, 0x6c, 0xe1, 0xd8, 0x11, 0xea, 0x0a, 0xd9, 0x73, 0xee, 0x3d, 0xbf, 0x99, 0x3b,
+	0x3e, 0x04, 0xf2, 0x75, 0x87, 0xef, 0x38, 0x9c, 0xd9, 0x4d, 0x97, 0xbd, 0x5d, 0xae, 0x99, 0xae,
+	0xb1, 0xcc, 0x76, 0xbb, 0xe6, 0xde, 0xbe, 0xde, 0xd9, 0x73, 0x5c, 0x87, 0x52, 0xb9, 0xae, 0xdb,
+	0x4d, 0x57, 0xc7, 0x75, 0x65, 0x01, 0x6b, 0x6a, 0x06, 0x37, 0xa5, 0xb8, 0x57, 0xda, 0x31, 0x5a,
+	0x96, 0x6d, 0xb8, 0x96, 0x63, 0xcb, 0x7a, 0xe5, 0x46, 0xcb, 0x71, 0x5a, 0x6d, 0x93, 0x19, 0x1d,
+	0x8b, 0x19, 0xb6, 0xed, 0xb8, 0x62, 0x91, 0xfb, 0xab, 0x11, 0xee, 0x9e, 0x93, 0x58, 0x55, 0x2b,
+	0x30, 0xf1, 0xdc, 0xeb, 0xbe, 0x6e, 0xb4, 0x0d, 0xbb, 0x6e, 0x56, 0xcd, 0xdd, 0xae, 0xc9, 0x5d,
+	0x3a, 0x0d, 0xa3, 0xf5, 0xb6, 0xc1, 0xf9, 0x2b, 0xab, 0x91, 0x23, 0x05, 0xa2, 0xfd, 0x55, 0x1d,
+	0x11, 0xcf, 0x4f, 0x1a, 0x74, 0x12, 0x32, 0xce, 0x3b, 0xdb, 0xdc, 0xcb, 0xa5, 0xc4, 0x7b, 0xf9,
+	0xa0, 0xea, 0x30, 0x19, 0xee, 0xc3, 0x3b, 0x8e, 0xcd, 0x4d, 0xfa, 0x1f, 0x64, 0x8d, 0x1d, 0xa7,
+	0x6b, 0xbb, 0xa2, 0xcd, 0x70, 0x15, 0x9f, 0xd4, 0x87, 0x30, 0x2e, 0xf4, 0x4f, 0xbd, 0xea, 0x01,
+	0x5c, 0xff, 0x86, 0x94, 0xd5, 0x40, 0xcb, 0x94, 0xd5, 0x50, 0x17, 0x80, 0x06, 0xeb, 0xd1, 0xad,
+	0xc7, 0x46, 0x82, 0x6c, 0x0c, 0xb5, 0x5b, 0xdd, 0x4e, 0xa7, 0xbd, 0x9f, 0x6c, 0xa6, 0x96, 0x61,
+	0x22, 0x54, 0x90, 0xb0, 0x97, 0xcf, 0x04, 0xfe, 0x15, 0xfa, 0xcd, 0xca, 0x36, 0xbf, 0xee, 0x09,
+	0xd2, 0x0a, 0xc0, 0xe5, 0x64, 0x73, 0xe9, 0x02, 0xd1, 0xc6, 0x56, 0x8a, 0x3a, 0x5e, 0x0d, 0xef,
+	0x1a, 0xe8, 0xf2, 0xce, 0xe0, 0x0c, 0xf5, 0x67, 0x46, 0xcb, 0x1f, 0x57, 0x35, 0x50, 0xa9, 0x1e,
+	0x13, 0x18, 0x0f, 0xd0, 0x20, 0xfb, 0x22, 0x0c, 0xdb, 0x4d, 0x97, 0xe7, 0x48, 0x21, 0xad, 0x8d,
+	0xad, 0x4c, 0xe9, 0x57, 0xaf, 0x9c, 0xbe, 0x59, 0xd9, 0xae, 0x0a, 0x11, 0x7d, 0x1c, 0x42, 0x49,
+	0x09, 0x94, 0x52, 0x22, 0x8a, 0x74, 0x0a, 0xb1, 0xac, 0xc1, 0x3f, 0x3e, 0xca, 0x35, 0x66, 0xfc,
+	0xe0, 0xf2, 0x58, 0x7b, 0xfb, 0x98, 0x87, 0xb4, 0xdd, 0x94, 0x03, 0x88, 0xd9, 0x86, 0xa7, 0x51,
+	0x75, 0x3c, 0x87, 0x0d, 0xaf, 0xfd, 0x00, 0x53, 0x7f, 0x04, 0x34, 0xa8, 0x47, 0x43, 0x06, 0x19,
+	0x21, 0x40, 0xcb, 0xe9, 0x28, 0x4b, 0x59, 0x21, 0x75, 0xea, 0x4b, 0xbc, 0x3c, 0xe2, 0xa5, 0xd9,
+	0x33, 0x0e, 0x8f, 0x97, 0x5c, 0x7b, 0xbc, 0x27, 0x04, 0x26, 0xc3, 0xfd, 0x11, 0x74, 0x15, 0xe4,
+	0x4e, 0x4c, 0x7f, 0xc8, 0x31, 0xa8, 0xbe, 0xf2, 0xcf, 0x4d, 0xfa, 0x0e, 0x4c, 0x5d, 0x52, 0x6d,
+	0x38, 0x76, 0xd3, 0x6a, 0x0d, 0x70, 0xe4, 0x5b, 0x90, 0xbb, 0x5a, 0x85, 0xfb, 0xb9, 0x0f, 0xd9,
+	0xba, 0x78, 0x83, 0x87, 0x75, 0xab, 0xef, 0x76, 0xb0, 0x10, 0xe5, 0x2b, 0x67, 0xa3, 0x90, 0x11,
+	0x5d, 0xe9, 0x09, 0x81, 0x11, 0x0c, 0x24, 0x5a, 0x8a, 0x2a, 0x8f, 0x88, 0x3e, 0x45, 0x4b, 0x16,
+	0x4a, 0x42, 0xf5, 0xde, 0xd1, 0x8f, 0x5f, 0x5f, 0x53, 0x4b, 0x54, 0x67, 0x11, 0x11, 0x5b, 0x93,
+	0x62, 0x76, 0x20, 0xbe, 0xee, 0x43, 0x76, 0xe0, 0x9f, 0xc1, 0x21, 0x3d, 0x26, 0x90, 0x11, 0xb9,
+	0x45, 0xe7, 0xfa, 0x7a, 0x05, 0x73, 0x51, 0x29, 0x26, 0xc9, 0x10, 0x68, 0x59, 0x00, 0x2d, 0xd2,
+	0xf9, 0x28, 0x20, 0xc1, 0x11, 0xc0, 0x60, 0x07, 0x1e, 0xcb, 0x27, 0x02, 0x59, 0x19, 0x73, 0xb4,
+	0xbf, 0x4b, 0x28, 0x38, 0x95, 0x52, 0xa2, 0x0e, 0x71, 0xca, 0x02, 0xa7, 0x44, 0xe7, 0xa2, 0x70,
+	0xb8, 0xd0, 0x06, 0x8f, 0xa5, 0x0b, 0xc3, 0x5e, 0x64, 0xd1, 0xd9, 0xbe, 0xfd, 0x03, 0xf9, 0xaa,
+	0xcc, 0x25, 0xa8, 0x90, 0xa1, 0x20, 0x18, 0x14, 0x9a, 0x63, 0xd1, 0xff, 0x06, 0x39, 0x3d, 0x22,
+	0x90, 0xde, 0xac, 0x6c, 0xd3, 0x99, 0xb8, 0x86, 0xbe, 0xeb, 0x6c, 0xbc, 0x08, 0x4d, 0x97, 0x84,
+	0xe9, 0x02, 0xd5, 0xfa, 0x99, 0x5e, 0x19, 0xc3, 0x47, 0x02, 0x19, 0x71, 0x97, 0x63, 0xae, 0x44,
+	0x30, 0xc7, 0x94, 0x62, 0x92, 0x0c, 0x51, 0x74, 0x81, 0xa2, 0xd1, 0x62, 0x14, 0x0a, 0xa6, 0x40,
+	0x70, 0x08, 0x1f, 0x08, 0x8c, 0x60, 0xb2, 0xc4, 0x7c, 0x32, 0xe1, 0x6c, 0x53, 0xb4, 0x64, 0x21,
+	0xe2, 0xcc, 0x08, 0x9c, 0x9b, 0xf4, 0xff, 0x18, 0x1c, 0xfa, 0x8d, 0xc0, 0x58, 0xe0, 0xc3, 0xa6,
+	0x8b, 0xf1, 0xed, 0x43, 0x69, 0xa3, 0xdc, 0x1e, 0x4c, 0x8c, 0x3c, 0x77, 0x05, 0x0f, 0xa3, 0xe5,
+	0xc1, 0x8e, 0x87, 0xc9, 0x88, 0x59, 0x5f, 0xfb, 0x7e, 0x9e, 0x27, 0xa7, 0xe7, 0x79, 0x72, 0x76,
+	0x9e, 0x27, 0x5f, 0x2e, 0xf2, 0x43, 0xa7, 0x17, 0xf9, 0xa1, 0x9f, 0x17, 0xf9, 0xa1, 0x17, 0x6a,
+	0xcb, 0x72, 0x5f, 0x77, 0x6b, 0x7a, 0xdd, 0xd9, 0xf1, 0x5b, 0xca, 0x3f, 0x65, 0xde, 0x78, 0xc3,
+	0xde, 0x7b, 0xfd, 0x6b, 0x59, 0xf1, 0xd3, 0x6b, 0xf5, 0xf7, 0x00, 0x9c, 0x30, 0x5d, 0x77, 0x18,
+	0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -812,6 +908,9 @@ type QueryClient interface {
 	Class(ctx context.Context, in *QueryClassRequest, opts ...grpc.CallOption) (*QueryClassResponse, error)
 	// Classes queries all NFT classes
 	Classes(ctx context.Context, in *QueryClassesRequest, opts ...grpc.CallOption) (*QueryClassesResponse, error)
+	// ClassConfig queries the minting configuration and the royalty metadata of
+	// a class created with Msg/CreateClass
+	ClassConfig(ctx context.Context, in *QueryClassConfigRequest, opts ...grpc.CallOption) (*QueryClassConfigResponse, error)
 }
 
 type queryClient struct {
@@ -885,6 +984,15 @@ func (c *queryClient) Classes(ctx context.Context, in *QueryClassesRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) ClassConfig(ctx context.Context, in *QueryClassConfigRequest, opts ...grpc.CallOption) (*QueryClassConfigResponse, error) {
+	out := new(QueryClassConfigResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.nft.v1beta1.Query/ClassConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Balance queries the number of NFTs of a given class owned by the owner, same as balanceOf in ERC721
@@ -902,6 +1010,9 @@ type QueryServer interface {
 	Class(context.Context, *QueryClassRequest) (*QueryClassResponse, error)
 	// Classes queries all NFT classes
 	Classes(context.Context, *QueryClassesRequest) (*QueryClassesResponse, error)
+	// ClassConfig queries the minting configuration and the royalty metadata of
+	// a class created with Msg/CreateClass
+	ClassConfig(context.Context, *QueryClassConfigRequest) (*QueryClassConfigResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -929,6 +1040,9 @@ func (*UnimplementedQueryServer) Class(ctx context.Context, req *QueryClassReque
 func (*UnimplementedQueryServer) Classes(ctx context.Context, req *QueryClassesRequest) (*QueryClassesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Classes not implemented")
 }
+func (*UnimplementedQueryServer) ClassConfig(ctx context.Context, req *QueryClassConfigRequest) (*QueryClassConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassConfig not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1060,6 +1174,24 @@ func _Query_Classes_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ClassConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClassConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.nft.v1beta1.Query/ClassConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassConfig(ctx, req.(*QueryClassConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.nft.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1092,6 +1224,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Classes",
 			Handler:    _Query_Classes_Handler,
 		},
+		{
+			MethodName: "ClassConfig",
+			Handler:    _Query_ClassConfig_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/nft/v1beta1/query.proto",
@@ -1606,6 +1742,71 @@ func (m *QueryClassesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryClassConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClassConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClassConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClassConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClassConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClassConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Config != nil {
+		{
+			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1829,6 +2030,32 @@ func (m *QueryClassesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryClassConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClassConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3209,6 +3436,174 @@ func (m *QueryClassesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryClassConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClassConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClassConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClassConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClassConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClassConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Config == nil {
+				m.Config = &ClassConfig{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
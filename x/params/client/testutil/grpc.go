@@ -29,35 +29,35 @@ func (s *IntegrationTestSuite) TestQueryParamsGRPC() {
 			&proposal.QueryParamsResponse{},
 			&proposal.QueryParamsResponse{
 				Param: proposal.ParamChange{
-					Subspace: "staking",
-					Key:      "MaxValidators",
-					Value:    "100",
+					Subspace: "crisis",
+					Key:      "ConstantFee",
+					Value:    `{"denom":"stake","amount":"1000"}`,
 				},
 			},
 		},
 		{
 			"with wrong subspace",
-			fmt.Sprintf("%s/cosmos/params/v1beta1/params?subspace=%s&key=%s", baseURL, "wrongSubspace", "MaxValidators"),
+			fmt.Sprintf("%s/cosmos/params/v1beta1/params?subspace=%s&key=%s", baseURL, "wrongSubspace", "ConstantFee"),
 			map[string]string{},
 			true,
 			&proposal.QueryParamsResponse{},
 			&proposal.QueryParamsResponse{
 				Param: proposal.ParamChange{
-					Subspace: "staking",
-					Key:      "MaxValidators",
-					Value:    "100",
+					Subspace: "crisis",
+					Key:      "ConstantFee",
+					Value:    `{"denom":"stake","amount":"1000"}`,
 				},
 			},
 		},
 		{
 			"with wrong key",
-			fmt.Sprintf("%s/cosmos/params/v1beta1/params?subspace=%s&key=%s", baseURL, "staking", "wrongKey"),
+			fmt.Sprintf("%s/cosmos/params/v1beta1/params?subspace=%s&key=%s", baseURL, "crisis", "wrongKey"),
 			map[string]string{},
 			false,
 			&proposal.QueryParamsResponse{},
 			&proposal.QueryParamsResponse{
 				Param: proposal.ParamChange{
-					Subspace: "staking",
+					Subspace: "crisis",
 					Key:      "wrongKey",
 					Value:    "",
 				},
@@ -65,15 +65,15 @@ func (s *IntegrationTestSuite) TestQueryParamsGRPC() {
 		},
 		{
 			"params",
-			fmt.Sprintf("%s/cosmos/params/v1beta1/params?subspace=%s&key=%s", baseURL, "staking", "MaxValidators"),
+			fmt.Sprintf("%s/cosmos/params/v1beta1/params?subspace=%s&key=%s", baseURL, "crisis", "ConstantFee"),
 			map[string]string{},
 			false,
 			&proposal.QueryParamsResponse{},
 			&proposal.QueryParamsResponse{
 				Param: proposal.ParamChange{
-					Subspace: "staking",
-					Key:      "MaxValidators",
-					Value:    "100",
+					Subspace: "crisis",
+					Key:      "ConstantFee",
+					Value:    `{"denom":"stake","amount":"1000"}`,
 				},
 			},
 		},
@@ -50,20 +50,20 @@ func (s *IntegrationTestSuite) TestNewQuerySubspaceParamsCmd() {
 		{
 			"json output",
 			[]string{
-				"staking", "MaxValidators",
+				"crisis", "ConstantFee",
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
-			`{"subspace":"staking","key":"MaxValidators","value":"100"}`,
+			`{"subspace":"crisis","key":"ConstantFee","value":"{\"denom\":\"stake\",\"amount\":\"1000\"}"}`,
 		},
 		{
 			"text output",
 			[]string{
-				"staking", "MaxValidators",
+				"crisis", "ConstantFee",
 				fmt.Sprintf("--%s=text", tmcli.OutputFlag),
 			},
-			`key: MaxValidators
-subspace: staking
-value: "100"`,
+			`key: ConstantFee
+subspace: crisis
+value: '{"denom":"stake","amount":"1000"}'`,
 		},
 	}
 
@@ -23,8 +23,25 @@ func NewParamChangeProposalHandler(k keeper.Keeper) govtypes.Handler {
 	}
 }
 
+// migratedSubspaces are the subspaces of the modules which moved their params
+// to their own store. These modules no longer read their subspace, so their
+// params must be updated with their MsgUpdateParams.
+var migratedSubspaces = map[string]bool{
+	"auth":         true,
+	"bank":         true,
+	"distribution": true,
+	"gov":          true,
+	"mint":         true,
+	"slashing":     true,
+	"staking":      true,
+}
+
 func handleParameterChangeProposal(ctx sdk.Context, k keeper.Keeper, p *proposal.ParameterChangeProposal) error {
 	for _, c := range p.Changes {
+		if migratedSubspaces[c.Subspace] {
+			return sdkerrors.Wrapf(proposal.ErrMigratedSubspace, "%s params must be updated with the %s module MsgUpdateParams", c.Subspace, c.Subspace)
+		}
+
 		ss, ok := k.GetSubspace(c.Subspace)
 		if !ok {
 			return sdkerrors.Wrap(proposal.ErrUnknownSubspace, c.Subspace)
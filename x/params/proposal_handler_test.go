@@ -9,11 +9,16 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
-	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -48,33 +53,25 @@ func (suite *HandlerTestSuite) TestProposalHandler() {
 	}{
 		{
 			"all fields",
-			testProposal(proposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators), "1")),
+			testProposal(proposal.NewParamChange(crisistypes.ModuleName, string(crisistypes.ParamStoreKeyConstantFee), `{"denom":"uatom","amount":"64000000"}`)),
 			func() {
-				maxVals := suite.app.StakingKeeper.MaxValidators(suite.ctx)
-				suite.Require().Equal(uint32(1), maxVals)
+				constantFee := suite.app.CrisisKeeper.GetConstantFee(suite.ctx)
+				suite.Require().Equal(sdk.NewCoin("uatom", sdk.NewInt(64000000)), constantFee)
 			},
 			false,
 		},
 		{
 			"invalid type",
-			testProposal(proposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators), "-")),
+			testProposal(proposal.NewParamChange(crisistypes.ModuleName, string(crisistypes.ParamStoreKeyConstantFee), "-")),
 			func() {},
 			true,
 		},
 		{
 			"omit empty fields",
-			testProposal(proposal.ParamChange{
-				Subspace: govtypes.ModuleName,
-				Key:      string(govv1.ParamStoreKeyDepositParams),
-				Value:    `{"min_deposit": [{"denom": "uatom","amount": "64000000"}], "max_deposit_period": "172800000000000"}`,
-			}),
+			testProposal(proposal.NewParamChange(crisistypes.ModuleName, string(crisistypes.ParamStoreKeyConstantFee), `{"amount":"1000"}`)),
 			func() {
-				depositParams := suite.app.GovKeeper.GetDepositParams(suite.ctx)
-				defaultPeriod := govv1.DefaultPeriod
-				suite.Require().Equal(govv1.DepositParams{
-					MinDeposit:       sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(64000000))),
-					MaxDepositPeriod: &defaultPeriod,
-				}, depositParams)
+				constantFee := suite.app.CrisisKeeper.GetConstantFee(suite.ctx)
+				suite.Require().Equal(sdk.NewCoin("uatom", sdk.NewInt(1000)), constantFee)
 			},
 			false,
 		},
@@ -93,3 +90,23 @@ func (suite *HandlerTestSuite) TestProposalHandler() {
 		})
 	}
 }
+
+func (suite *HandlerTestSuite) TestProposalHandlerMigratedSubspaces() {
+	maxValidators := suite.app.StakingKeeper.MaxValidators(suite.ctx)
+
+	for _, subspace := range []string{
+		authtypes.ModuleName, banktypes.ModuleName, distrtypes.ModuleName, govtypes.ModuleName,
+		minttypes.ModuleName, slashingtypes.ModuleName, stakingtypes.ModuleName,
+	} {
+		err := suite.govHandler(suite.ctx, testProposal(proposal.NewParamChange(subspace, "key", "1")))
+		suite.Require().ErrorIs(err, proposal.ErrMigratedSubspace, subspace)
+	}
+
+	// a proposal changing a migrated subspace is rejected as a whole
+	err := suite.govHandler(suite.ctx, testProposal(
+		proposal.NewParamChange(crisistypes.ModuleName, string(crisistypes.ParamStoreKeyConstantFee), `{"amount":"64000000"}`),
+		proposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators), "1"),
+	))
+	suite.Require().ErrorIs(err, proposal.ErrMigratedSubspace)
+	suite.Require().Equal(maxValidators, suite.app.StakingKeeper.MaxValidators(suite.ctx))
+}
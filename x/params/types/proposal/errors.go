@@ -12,4 +12,5 @@ var (
 	ErrEmptySubspace    = sdkerrors.Register(ModuleName, 5, "parameter subspace is empty")
 	ErrEmptyKey         = sdkerrors.Register(ModuleName, 6, "parameter key is empty")
 	ErrEmptyValue       = sdkerrors.Register(ModuleName, 7, "parameter value is empty")
+	ErrMigratedSubspace = sdkerrors.Register(ModuleName, 8, "parameter subspace is migrated to its module store")
 )
@@ -5,6 +5,7 @@ import (
 
 	"github.com/tendermint/tendermint/crypto"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
@@ -47,6 +48,85 @@ func (k Keeper) AfterValidatorRemoved(ctx sdk.Context, address sdk.ConsAddress)
 	return nil
 }
 
+// AfterConsensusPubKeyUpdate adds the address-pubkey relation of the new
+// consensus key and copies the signing info and the missed blocks of the old
+// consensus address to the new one. The old relation and signing info are
+// kept so that the evidence of the old key can still be handled. As the old
+// key keeps signing until the rotation takes effect in the consensus engine,
+// the signing info is merged again when the new key signs its first block.
+func (k Keeper) AfterConsensusPubKeyUpdate(ctx sdk.Context, oldPubKey, newPubKey cryptotypes.PubKey) error {
+	if err := k.AddPubkey(ctx, newPubKey); err != nil {
+		return err
+	}
+
+	oldConsAddr := sdk.ConsAddress(oldPubKey.Address())
+	newConsAddr := sdk.ConsAddress(newPubKey.Address())
+
+	signingInfo, found := k.GetValidatorSigningInfo(ctx, oldConsAddr)
+	if !found {
+		return nil
+	}
+
+	signingInfo.Address = newConsAddr.String()
+	k.SetValidatorSigningInfo(ctx, newConsAddr, signingInfo)
+
+	k.IterateValidatorMissedBlockBitArray(ctx, oldConsAddr, func(index int64, missed bool) (stop bool) {
+		k.SetValidatorMissedBlockBitArray(ctx, newConsAddr, index, missed)
+		return false
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PendingConsAddrRotationKey(newConsAddr), oldConsAddr)
+
+	return nil
+}
+
+// applyConsAddrRotation merges the signing info and the missed blocks of the
+// consensus address a validator rotated from into the given consensus
+// address, when it signs its first block after the rotation. The jailing and
+// the tombstoning of the new address during the transition are kept.
+func (k Keeper) applyConsAddrRotation(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PendingConsAddrRotationKey(consAddr))
+	if bz == nil {
+		return
+	}
+	store.Delete(types.PendingConsAddrRotationKey(consAddr))
+
+	// a key rotated again before taking effect never signed, the signing info
+	// is taken from the last key that did
+	oldConsAddr := sdk.ConsAddress(bz)
+	for {
+		bz := store.Get(types.PendingConsAddrRotationKey(oldConsAddr))
+		if bz == nil {
+			break
+		}
+		store.Delete(types.PendingConsAddrRotationKey(oldConsAddr))
+		oldConsAddr = bz
+	}
+
+	signingInfo, found := k.GetValidatorSigningInfo(ctx, oldConsAddr)
+	if !found {
+		return
+	}
+
+	if newInfo, found := k.GetValidatorSigningInfo(ctx, consAddr); found {
+		if newInfo.JailedUntil.After(signingInfo.JailedUntil) {
+			signingInfo.JailedUntil = newInfo.JailedUntil
+		}
+		signingInfo.Tombstoned = signingInfo.Tombstoned || newInfo.Tombstoned
+	}
+
+	signingInfo.Address = consAddr.String()
+	k.SetValidatorSigningInfo(ctx, consAddr, signingInfo)
+
+	k.clearValidatorMissedBlockBitArray(ctx, consAddr)
+	k.IterateValidatorMissedBlockBitArray(ctx, oldConsAddr, func(index int64, missed bool) (stop bool) {
+		k.SetValidatorMissedBlockBitArray(ctx, consAddr, index, missed)
+		return false
+	})
+}
+
 // Hooks wrapper struct for slashing keeper
 type Hooks struct {
 	k Keeper
@@ -101,3 +181,12 @@ func (h Hooks) AfterDelegationModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.Va
 func (h Hooks) BeforeValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ sdk.Dec) error {
 	return nil
 }
+
+func (h Hooks) BeforeTokenizeShareRecordRedeemed(_ sdk.Context, _ uint64) error {
+	return nil
+}
+
+// Implements sdk.ValidatorHooks
+func (h Hooks) AfterConsensusPubKeyUpdate(ctx sdk.Context, oldPubKey, newPubKey cryptotypes.PubKey, _ sdk.Coin) error {
+	return h.k.AfterConsensusPubKeyUpdate(ctx, oldPubKey, newPubKey)
+}
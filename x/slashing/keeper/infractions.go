@@ -19,6 +19,9 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 		panic(fmt.Sprintf("Validator consensus-address %s not found", consAddr))
 	}
 
+	// merge the signing info of a rotated consensus key taking effect
+	k.applyConsAddrRotation(ctx, consAddr)
+
 	// fetch signing info
 	signInfo, found := k.GetValidatorSigningInfo(ctx, consAddr)
 	if !found {
@@ -17,11 +17,15 @@ type Keeper struct {
 	storeKey   storetypes.StoreKey
 	cdc        codec.BinaryCodec
 	sk         types.StakingKeeper
-	paramspace types.ParamSubspace
+	paramspace types.ParamSubspace // legacy params subspace, only used by the migrations
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
 }
 
 // NewKeeper creates a slashing keeper
-func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, sk types.StakingKeeper, paramspace types.ParamSubspace) Keeper {
+func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, sk types.StakingKeeper, paramspace types.ParamSubspace, authority string) Keeper {
 	// set KeyTable if it has not already been set
 	if !paramspace.HasKeyTable() {
 		paramspace = paramspace.WithKeyTable(types.ParamKeyTable())
@@ -32,9 +36,15 @@ func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, sk types.StakingK
 		cdc:        cdc,
 		sk:         sk,
 		paramspace: paramspace,
+		authority:  authority,
 	}
 }
 
+// GetAuthority returns the x/slashing module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
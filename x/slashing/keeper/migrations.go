@@ -3,6 +3,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v043 "github.com/cosmos/cosmos-sdk/x/slashing/migrations/v043"
+	v047 "github.com/cosmos/cosmos-sdk/x/slashing/migrations/v047"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -19,3 +20,11 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v043.MigrateStore(ctx, m.keeper.storeKey)
 }
+
+// Migrate2to3 migrates the x/slashing module state from the consensus
+// version 2 to version 3. Specifically, it takes the parameters that are
+// currently stored and managed by the x/params modules and stores them directly
+// into the x/slashing module state.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v047.MigrateStore(ctx, m.keeper.storeKey, m.keeper.paramspace, m.keeper.cdc)
+}
@@ -94,3 +94,55 @@ func TestJailUntil(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, time.Unix(253402300799, 0).UTC(), info.JailedUntil)
 }
+
+func TestAfterConsensusPubKeyUpdate(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	pks := simapp.CreateTestPubKeys(2)
+	oldConsAddr, newConsAddr := sdk.ConsAddress(pks[0].Address()), sdk.ConsAddress(pks[1].Address())
+
+	info := types.NewValidatorSigningInfo(oldConsAddr, int64(4), int64(3), time.Unix(2, 0), false, int64(10))
+	app.SlashingKeeper.SetValidatorSigningInfo(ctx, oldConsAddr, info)
+	app.SlashingKeeper.SetValidatorMissedBlockBitArray(ctx, oldConsAddr, 2, true)
+
+	err := app.SlashingKeeper.Hooks().AfterConsensusPubKeyUpdate(ctx, pks[0], pks[1], sdk.Coin{})
+	require.NoError(t, err)
+
+	// the signing info and the missed blocks are copied to the new address
+	newInfo, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, newConsAddr)
+	require.True(t, found)
+	require.Equal(t, newConsAddr.String(), newInfo.Address)
+	require.Equal(t, info.StartHeight, newInfo.StartHeight)
+	require.Equal(t, info.IndexOffset, newInfo.IndexOffset)
+	require.Equal(t, info.MissedBlocksCounter, newInfo.MissedBlocksCounter)
+	require.True(t, app.SlashingKeeper.GetValidatorMissedBlockBitArray(ctx, newConsAddr, 2))
+
+	// the relations of both pubkeys are kept to handle the evidence of the old one
+	pk, err := app.SlashingKeeper.GetPubkey(ctx, newConsAddr.Bytes())
+	require.NoError(t, err)
+	require.True(t, pks[1].Equals(pk))
+	require.True(t, app.SlashingKeeper.HasValidatorSigningInfo(ctx, oldConsAddr))
+
+	// the old key signs until the rotation takes effect, the new signing info
+	// is merged from the old one when the new key signs its first block
+	require.NoError(t, app.SlashingKeeper.AddPubkey(ctx, pks[0]))
+	app.SlashingKeeper.HandleValidatorSignature(ctx, pks[0].Address(), 10, false)
+	app.SlashingKeeper.JailUntil(ctx, newConsAddr, time.Unix(5, 0))
+
+	app.SlashingKeeper.HandleValidatorSignature(ctx, pks[1].Address(), 10, true)
+	newInfo, found = app.SlashingKeeper.GetValidatorSigningInfo(ctx, newConsAddr)
+	require.True(t, found)
+	require.Equal(t, newConsAddr.String(), newInfo.Address)
+	require.Equal(t, info.IndexOffset+2, newInfo.IndexOffset)
+	require.Equal(t, info.MissedBlocksCounter+1, newInfo.MissedBlocksCounter)
+	require.Equal(t, time.Unix(5, 0).UTC(), newInfo.JailedUntil.UTC())
+	require.True(t, app.SlashingKeeper.GetValidatorMissedBlockBitArray(ctx, newConsAddr, info.IndexOffset))
+
+	// the signing info is merged only once
+	app.SlashingKeeper.HandleValidatorSignature(ctx, pks[0].Address(), 10, false)
+	app.SlashingKeeper.HandleValidatorSignature(ctx, pks[1].Address(), 10, true)
+	newInfo, found = app.SlashingKeeper.GetValidatorSigningInfo(ctx, newConsAddr)
+	require.True(t, found)
+	require.Equal(t, info.IndexOffset+3, newInfo.IndexOffset)
+	require.Equal(t, info.MissedBlocksCounter+1, newInfo.MissedBlocksCounter)
+}
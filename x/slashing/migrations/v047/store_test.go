@@ -0,0 +1,35 @@
+package v047_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	v047 "github.com/cosmos/cosmos-sdk/x/slashing/migrations/v047"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	slashingKey := sdk.NewKVStoreKey(types.StoreKey)
+	tSlashingKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(slashingKey, tSlashingKey)
+	store := ctx.KVStore(slashingKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, slashingKey, tSlashingKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	legacyParams := types.DefaultParams()
+	legacyParams.SignedBlocksWindow = 1000
+	paramstore.SetParamSet(ctx, &legacyParams)
+	require.False(t, store.Has(types.ParamsKey))
+
+	require.NoError(t, v047.MigrateStore(ctx, slashingKey, paramstore, encCfg.Codec))
+
+	var params types.Params
+	encCfg.Codec.MustUnmarshal(store.Get(types.ParamsKey), &params)
+	require.Equal(t, legacyParams, params)
+}
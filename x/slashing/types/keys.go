@@ -30,10 +30,14 @@ const (
 // - 0x02<consAddrLen (1 Byte)><consAddress_Bytes><period_Bytes>: bool
 //
 // - 0x03<accAddrLen (1 Byte)><accAddr_Bytes>: cryptotypes.PubKey
+//
+// - 0x04<consAddrLen (1 Byte)><consAddress_Bytes>: sdk.ConsAddress
 var (
+	ParamsKey                             = []byte{0x00} // Prefix for params key
 	ValidatorSigningInfoKeyPrefix         = []byte{0x01} // Prefix for signing info
 	ValidatorMissedBlockBitArrayKeyPrefix = []byte{0x02} // Prefix for missed block bit array
 	AddrPubkeyRelationKeyPrefix           = []byte{0x03} // Prefix for address-pubkey relation
+	PendingConsAddrRotationKeyPrefix      = []byte{0x04} // Prefix for the rotated consensus addresses not signing yet
 )
 
 // ValidatorSigningInfoKey - stored by *Consensus* address (not operator address)
@@ -63,6 +67,12 @@ func ValidatorMissedBlockBitArrayKey(v sdk.ConsAddress, i int64) []byte {
 	return append(ValidatorMissedBlockBitArrayPrefixKey(v), b...)
 }
 
+// PendingConsAddrRotationKey - stored by the *Consensus* address a validator
+// rotated to, mapping it to the consensus address it rotated from
+func PendingConsAddrRotationKey(v sdk.ConsAddress) []byte {
+	return append(PendingConsAddrRotationKeyPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
 // AddrPubkeyRelationKey gets pubkey relation key used to get the pubkey from the address
 func AddrPubkeyRelationKey(addr []byte) []byte {
 	return append(AddrPubkeyRelationKeyPrefix, address.MustLengthPrefix(addr)...)
@@ -7,11 +7,15 @@ import (
 
 // slashing message types
 const (
-	TypeMsgUnjail = "unjail"
+	TypeMsgUnjail       = "unjail"
+	TypeMsgUpdateParams = "update_params"
 )
 
 // verify interface at compile time
-var _ sdk.Msg = &MsgUnjail{}
+var (
+	_ sdk.Msg = &MsgUnjail{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
 
 // NewMsgUnjail creates a new MsgUnjail instance
 //
@@ -42,3 +46,28 @@ func (msg MsgUnjail) ValidateBasic() error {
 	}
 	return nil
 }
+
+// Route implements the sdk.Msg interface.
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	return msg.Params.Validate()
+}
@@ -18,3 +18,14 @@ func TestMsgUnjailGetSignBytes(t *testing.T) {
 		string(bytes),
 	)
 }
+
+func TestMsgUpdateParamsValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("authority").String()
+
+	invalidParams := DefaultParams()
+	invalidParams.SignedBlocksWindow = 0
+
+	require.NoError(t, (&MsgUpdateParams{Authority: authority, Params: DefaultParams()}).ValidateBasic())
+	require.Error(t, (&MsgUpdateParams{Authority: "invalid", Params: DefaultParams()}).ValidateBasic())
+	require.Error(t, (&MsgUpdateParams{Authority: authority, Params: invalidParams}).ValidateBasic())
+}
@@ -67,6 +67,23 @@ func DefaultParams() Params {
 	)
 }
 
+// Validate validates the slashing params.
+func (p Params) Validate() error {
+	if err := validateSignedBlocksWindow(p.SignedBlocksWindow); err != nil {
+		return err
+	}
+	if err := validateMinSignedPerWindow(p.MinSignedPerWindow); err != nil {
+		return err
+	}
+	if err := validateDowntimeJailDuration(p.DowntimeJailDuration); err != nil {
+		return err
+	}
+	if err := validateSlashFractionDoubleSign(p.SlashFractionDoubleSign); err != nil {
+		return err
+	}
+	return validateSlashFractionDowntime(p.SlashFractionDowntime)
+}
+
 func validateSignedBlocksWindow(i interface{}) error {
 	v, ok := i.(int64)
 	if !ok {
@@ -39,6 +39,14 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryHistoricalInfo(),
 		GetCmdQueryParams(),
 		GetCmdQueryPool(),
+		GetCmdQueryTokenizeShareRecordByID(),
+		GetCmdQueryTokenizeShareRecordByDenom(),
+		GetCmdQueryTokenizeShareRecordsOwned(),
+		GetCmdQueryAllTokenizeShareRecords(),
+		GetCmdQueryTotalLiquidStaked(),
+		GetCmdQueryDelegationHistory(),
+		GetCmdQueryUnbondingDelegationHistory(),
+		GetCmdQueryRedelegationHistory(),
 	)
 
 	return stakingQueryCmd
@@ -744,3 +752,379 @@ $ %s query staking params
 
 	return cmd
 }
+
+// GetCmdQueryTokenizeShareRecordByID implements the query for individual tokenize share record information by id
+func GetCmdQueryTokenizeShareRecordByID() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tokenize-share-record-by-id [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query individual tokenize share record information by id",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query individual tokenize share record information by id.
+
+Example:
+$ %s query staking tokenize-share-record-by-id 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TokenizeShareRecordById(cmd.Context(), &types.QueryTokenizeShareRecordByIdRequest{
+				Id: id,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTokenizeShareRecordByDenom implements the query for individual tokenize share record information by share denom
+func GetCmdQueryTokenizeShareRecordByDenom() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "tokenize-share-record-by-denom [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query individual tokenize share record information by share denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query individual tokenize share record information by share denom.
+
+Example:
+$ %s query staking tokenize-share-record-by-denom %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj/1
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TokenizeShareRecordByDenom(cmd.Context(), &types.QueryTokenizeShareRecordByDenomRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTokenizeShareRecordsOwned implements the query tokenize share records by address
+func GetCmdQueryTokenizeShareRecordsOwned() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "tokenize-share-records-owned [owner]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query tokenize share records by address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query tokenize share records by address.
+
+Example:
+$ %s query staking tokenize-share-records-owned %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TokenizeShareRecordsOwned(cmd.Context(), &types.QueryTokenizeShareRecordsOwnedRequest{
+				Owner: owner.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryAllTokenizeShareRecords implements the query for all tokenize share records
+func GetCmdQueryAllTokenizeShareRecords() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-tokenize-share-records",
+		Args:  cobra.NoArgs,
+		Short: "Query for all tokenize share records",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for all tokenize share records.
+
+Example:
+$ %s query staking all-tokenize-share-records
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AllTokenizeShareRecords(cmd.Context(), &types.QueryAllTokenizeShareRecordsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "tokenize share records")
+
+	return cmd
+}
+
+// GetCmdQueryTotalLiquidStaked implements the query for the total liquid staked tokens
+func GetCmdQueryTotalLiquidStaked() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-liquid-staked",
+		Args:  cobra.NoArgs,
+		Short: "Query for total liquid staked tokens",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for the total amount of liquid staked tokens.
+
+Example:
+$ %s query staking total-liquid-staked
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TotalLiquidStaked(cmd.Context(), &types.QueryTotalLiquidStakedRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryDelegationHistory implements the command to query the delegation
+// history of a delegator.
+func GetCmdQueryDelegationHistory() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "delegation-history [delegator-addr]",
+		Short: "Query the delegation history of one delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the shares of the delegations of an individual delegator at each height they were modified.
+The history is recorded by the off-consensus delegation history index, which must be enabled on the queried node.
+
+Example:
+$ %s query staking delegation-history %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryDelegationHistoryRequest{
+				DelegatorAddr: delAddr.String(),
+				Pagination:    pageReq,
+			}
+
+			res, err := queryClient.DelegationHistory(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "delegation history")
+
+	return cmd
+}
+
+// GetCmdQueryUnbondingDelegationHistory implements the command to query the unbonding delegation history
+// of a delegator.
+func GetCmdQueryUnbondingDelegationHistory() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "unbonding-delegation-history [delegator-addr]",
+		Short: "Query the unbonding delegation history of one delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the entries of the unbonding delegations of an individual delegator at each height they were modified.
+The history is recorded by the off-consensus delegation history index, which must be enabled on the queried node.
+
+Example:
+$ %s query staking unbonding-delegation-history %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryUnbondingDelegationHistoryRequest{
+				DelegatorAddr: delAddr.String(),
+				Pagination:    pageReq,
+			}
+
+			res, err := queryClient.UnbondingDelegationHistory(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "unbonding delegation history")
+
+	return cmd
+}
+
+// GetCmdQueryRedelegationHistory implements the command to query the redelegation history
+// of a delegator.
+func GetCmdQueryRedelegationHistory() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "redelegation-history [delegator-addr]",
+		Short: "Query the redelegation history of one delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the entries of the redelegations of an individual delegator at each height they were modified.
+The history is recorded by the off-consensus delegation history index, which must be enabled on the queried node.
+
+Example:
+$ %s query staking redelegation-history %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryRedelegationHistoryRequest{
+				DelegatorAddr: delAddr.String(),
+				Pagination:    pageReq,
+			}
+
+			res, err := queryClient.RedelegationHistory(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "redelegation history")
+
+	return cmd
+}
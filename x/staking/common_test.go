@@ -49,6 +49,7 @@ func getBaseSimappWithCustomKeeper(t *testing.T) (*codec.LegacyAmino, *simapp.Si
 		app.AccountKeeper,
 		app.BankKeeper,
 		app.GetSubspace(types.ModuleName),
+		app.StakingKeeper.GetAuthority(),
 	)
 	app.StakingKeeper.SetParams(ctx, types.DefaultParams())
 
@@ -45,9 +45,49 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateTokenizeShareRecords(data); err != nil {
+		return err
+	}
+
+	for _, history := range data.RotationHistory {
+		if _, err := sdk.ValAddressFromBech32(history.OperatorAddress); err != nil {
+			return fmt.Errorf("invalid consensus pubkey rotation history operator %s: %w", history.OperatorAddress, err)
+		}
+	}
+
 	return data.Params.Validate()
 }
 
+func validateGenesisStateTokenizeShareRecords(data *types.GenesisState) error {
+	ids := make(map[uint64]bool, len(data.TokenizeShareRecords))
+
+	for _, record := range data.TokenizeShareRecords {
+		if ids[record.Id] {
+			return fmt.Errorf("duplicate tokenize share record in genesis state: id %d", record.Id)
+		}
+
+		if record.Id > data.LastTokenizeShareRecordId {
+			return fmt.Errorf("tokenize share record id %d is greater than the last tokenize share record id %d", record.Id, data.LastTokenizeShareRecordId)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(record.Owner); err != nil {
+			return fmt.Errorf("invalid tokenize share record owner %s: %w", record.Owner, err)
+		}
+
+		if _, err := sdk.ValAddressFromBech32(record.Validator); err != nil {
+			return fmt.Errorf("invalid tokenize share record validator %s: %w", record.Validator, err)
+		}
+
+		ids[record.Id] = true
+	}
+
+	if !data.TotalLiquidStakedTokens.IsNil() && data.TotalLiquidStakedTokens.IsNegative() {
+		return fmt.Errorf("total liquid staked tokens cannot be negative: %s", data.TotalLiquidStakedTokens)
+	}
+
+	return nil
+}
+
 func validateGenesisStateValidators(validators []types.Validator) error {
 	addrMap := make(map[string]bool, len(validators))
 
@@ -19,6 +19,13 @@ func TestValidateGenesis(t *testing.T) {
 	genValidators1[0].Tokens = sdk.OneInt()
 	genValidators1[0].DelegatorShares = sdk.OneDec()
 
+	genRecord := types.TokenizeShareRecord{
+		Id:            1,
+		Owner:         sdk.AccAddress(pk.Address()).String(),
+		ModuleAccount: types.GetTokenizeShareRecordModuleAccount(1),
+		Validator:     sdk.ValAddress(pk.Address()).String(),
+	}
+
 	tests := []struct {
 		name    string
 		mutate  func(*types.GenesisState)
@@ -39,6 +46,21 @@ func TestValidateGenesis(t *testing.T) {
 			data.Validators[0].Jailed = true
 			data.Validators[0].Status = types.Bonded
 		}, true},
+		// validate genesis tokenize share records
+		{"tokenize share record", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{genRecord}
+			data.LastTokenizeShareRecordId = 1
+		}, false},
+		{"duplicate tokenize share record", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{genRecord, genRecord}
+			data.LastTokenizeShareRecordId = 1
+		}, true},
+		{"tokenize share record id greater than the last id", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{genRecord}
+		}, true},
+		{"negative total liquid staked tokens", func(data *types.GenesisState) {
+			data.TotalLiquidStakedTokens = sdk.NewInt(-1)
+		}, true},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,230 @@
+package keeper
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// RotateConsPubKey rotates the consensus pubkey of a validator, charging the
+// key rotation fee to the validator operator. The validator is indexed by its
+// new consensus address and the old consensus address is mapped to the new
+// one, so that the infractions committed with the old key can still be
+// handled. The rotation is reported to the consensus engine at the end of the
+// block.
+func (k Keeper) RotateConsPubKey(ctx sdk.Context, validator types.Validator, newPubKey cryptotypes.PubKey) error {
+	fee := k.KeyRotationFee(ctx)
+	if fee.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(
+			ctx, sdk.AccAddress(validator.GetOperator()), authtypes.FeeCollectorName, sdk.NewCoins(fee),
+		); err != nil {
+			return err
+		}
+	}
+
+	oldPubKey, err := validator.ConsPubKey()
+	if err != nil {
+		return err
+	}
+
+	newPkAny, err := codectypes.NewAnyWithValue(newPubKey)
+	if err != nil {
+		return err
+	}
+
+	oldConsAddr := sdk.GetConsAddress(oldPubKey)
+	newConsAddr := sdk.GetConsAddress(newPubKey)
+
+	validator.ConsensusPubkey = newPkAny
+	k.SetValidator(ctx, validator)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorByConsAddrKey(oldConsAddr))
+	if err := k.SetValidatorByConsAddr(ctx, validator); err != nil {
+		return err
+	}
+	k.setOldToNewConsAddr(ctx, oldConsAddr, newConsAddr)
+
+	oldPkAny, err := codectypes.NewAnyWithValue(oldPubKey)
+	if err != nil {
+		return err
+	}
+
+	history := types.ConsPubKeyRotationHistory{
+		OperatorAddress: validator.OperatorAddress,
+		OldConsPubkey:   oldPkAny,
+		NewConsPubkey:   newPkAny,
+		Height:          ctx.BlockHeight(),
+		Fee:             fee,
+		CompletionTime:  ctx.BlockTime().Add(k.UnbondingTime(ctx)),
+	}
+	k.SetConsPubKeyRotationHistory(ctx, history)
+	store.Set(types.GetPendingConsPubKeyRotationKey(validator.GetOperator()), k.cdc.MustMarshal(&history))
+
+	if err := k.AfterConsensusPubKeyUpdate(ctx, oldPubKey, newPubKey, fee); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRotateConsPubKey,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress),
+			sdk.NewAttribute(types.AttributeKeyOldConsAddress, oldConsAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyNewConsAddress, newConsAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
+		),
+	)
+
+	return nil
+}
+
+// SetConsPubKeyRotationHistory sets a consensus pubkey rotation history entry.
+func (k Keeper) SetConsPubKeyRotationHistory(ctx sdk.Context, history types.ConsPubKeyRotationHistory) {
+	valAddr, err := sdk.ValAddressFromBech32(history.OperatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	newPubKey, ok := history.NewConsPubkey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		panic(fmt.Sprintf("expecting cryptotypes.PubKey, got %T", history.NewConsPubkey.GetCachedValue()))
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetConsPubKeyRotationHistoryKey(valAddr, history.Height, sdk.GetConsAddress(newPubKey)), k.cdc.MustMarshal(&history))
+}
+
+// GetValidatorConsPubKeyRotationHistory returns the consensus pubkey rotation
+// history of a validator.
+func (k Keeper) GetValidatorConsPubKeyRotationHistory(ctx sdk.Context, valAddr sdk.ValAddress) (history []types.ConsPubKeyRotationHistory) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetConsPubKeyRotationHistoryPrefix(valAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.ConsPubKeyRotationHistory
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+		history = append(history, entry)
+	}
+
+	return history
+}
+
+// GetAllConsPubKeyRotationHistory returns the consensus pubkey rotation
+// history of all the validators.
+func (k Keeper) GetAllConsPubKeyRotationHistory(ctx sdk.Context) (history []types.ConsPubKeyRotationHistory) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ConsPubKeyRotationHistoryKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.ConsPubKeyRotationHistory
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+		history = append(history, entry)
+	}
+
+	return history
+}
+
+// exceedsMaxConsPubKeyRotations returns true if the validator already rotated
+// its consensus pubkey MaxConsPubKeyRotations param times within the current
+// unbonding period.
+func (k Keeper) exceedsMaxConsPubKeyRotations(ctx sdk.Context, valAddr sdk.ValAddress) bool {
+	count := uint32(0)
+	for _, entry := range k.GetValidatorConsPubKeyRotationHistory(ctx, valAddr) {
+		if entry.CompletionTime.After(ctx.BlockTime()) {
+			count++
+		}
+	}
+
+	return count >= k.MaxConsPubKeyRotations(ctx)
+}
+
+// hasPendingConsPubKeyRotation returns true if the validator rotated its
+// consensus pubkey since the last validator set update. The consensus engine
+// only knows the consensus pubkey the validator had before that rotation.
+func (k Keeper) hasPendingConsPubKeyRotation(ctx sdk.Context, valAddr sdk.ValAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetPendingConsPubKeyRotationKey(valAddr))
+}
+
+// setOldToNewConsAddr maps a rotated consensus address to the consensus
+// address it was rotated to.
+func (k Keeper) setOldToNewConsAddr(ctx sdk.Context, oldConsAddr, newConsAddr sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetOldToNewConsAddrKey(oldConsAddr), newConsAddr)
+}
+
+// getNewConsAddr returns the consensus address a rotated consensus address
+// was rotated to.
+func (k Keeper) getNewConsAddr(ctx sdk.Context, oldConsAddr sdk.ConsAddress) (sdk.ConsAddress, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetOldToNewConsAddrKey(oldConsAddr))
+	if bz == nil {
+		return nil, false
+	}
+
+	return bz, true
+}
+
+// getPendingConsPubKeyRotations returns the old consensus pubkeys of the
+// validators that rotated their consensus pubkey since the last validator set
+// update, by validator operator address.
+func (k Keeper) getPendingConsPubKeyRotations(ctx sdk.Context) (map[string]cryptotypes.PubKey, error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingConsPubKeyRotationKey)
+	defer iterator.Close()
+
+	rotations := make(map[string]cryptotypes.PubKey)
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.ConsPubKeyRotationHistory
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+
+		oldPubKey, ok := entry.OldConsPubkey.GetCachedValue().(cryptotypes.PubKey)
+		if !ok {
+			return nil, fmt.Errorf("expecting cryptotypes.PubKey, got %T", entry.OldConsPubkey.GetCachedValue())
+		}
+
+		rotations[entry.OperatorAddress] = oldPubKey
+	}
+
+	return rotations, nil
+}
+
+// deletePendingConsPubKeyRotations deletes the consensus pubkey rotations
+// reported to the consensus engine.
+func (k Keeper) deletePendingConsPubKeyRotations(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingConsPubKeyRotationKey)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// abciValidatorUpdateZero returns the update removing a consensus pubkey from
+// the consensus engine validator set.
+func abciValidatorUpdateZero(pubKey cryptotypes.PubKey) (abci.ValidatorUpdate, error) {
+	tmProtoPk, err := cryptocodec.ToTmProtoPublicKey(pubKey)
+	if err != nil {
+		return abci.ValidatorUpdate{}, err
+	}
+
+	return abci.ValidatorUpdate{
+		PubKey: tmProtoPk,
+		Power:  0,
+	}, nil
+}
@@ -0,0 +1,176 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestRotateConsPubKey(t *testing.T) {
+	delTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	app, ctx, msgServer, delAddr, valAddr := setupLiquidStakeTest(t, delTokens)
+	ctx = ctx.WithBlockTime(time.Now().UTC())
+	stakingKeeper := app.StakingKeeper
+
+	fee := stakingKeeper.KeyRotationFee(ctx)
+	require.NoError(t, banktestutil.FundAccount(app.BankKeeper, ctx, delAddr, sdk.NewCoins(fee)))
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	feeCollectorBalance := app.BankKeeper.GetBalance(ctx, feeCollector, fee.Denom)
+
+	validator, found := stakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	oldPk, err := validator.ConsPubKey()
+	require.NoError(t, err)
+	newPk := simapp.CreateTestPubKeys(2)[1]
+
+	// the new pubkey can't be the consensus pubkey of a validator
+	msg, err := types.NewMsgRotateConsPubKey(valAddr, oldPk)
+	require.NoError(t, err)
+	_, err = msgServer.RotateConsPubKey(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, types.ErrValidatorPubKeyExists)
+
+	msg, err = types.NewMsgRotateConsPubKey(valAddr, newPk)
+	require.NoError(t, err)
+	_, err = msgServer.RotateConsPubKey(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	// the fee is charged to the validator operator
+	require.True(t, app.BankKeeper.GetBalance(ctx, delAddr, fee.Denom).IsZero())
+	require.Equal(t, feeCollectorBalance.Add(fee), app.BankKeeper.GetBalance(ctx, feeCollector, fee.Denom))
+
+	// the validator is found by its old and new consensus addresses
+	validator, found = stakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	consPk, err := validator.ConsPubKey()
+	require.NoError(t, err)
+	require.True(t, newPk.Equals(consPk))
+
+	for _, pk := range []cryptotypes.PubKey{oldPk, newPk} {
+		byConsAddr, found := stakingKeeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(pk))
+		require.True(t, found)
+		require.Equal(t, valAddr.String(), byConsAddr.OperatorAddress)
+	}
+
+	// the old pubkey is replaced by the new one in the consensus engine
+	// validator set
+	oldTmPk, err := cryptocodec.ToTmProtoPublicKey(oldPk)
+	require.NoError(t, err)
+	updates, err := stakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []abci.ValidatorUpdate{
+		{PubKey: oldTmPk, Power: 0},
+		validator.ABCIValidatorUpdate(stakingKeeper.PowerReduction(ctx)),
+	}, updates)
+
+	updates, err = stakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	require.Empty(t, updates)
+
+	// the rotation is recorded in the history
+	history := stakingKeeper.GetValidatorConsPubKeyRotationHistory(ctx, valAddr)
+	require.Len(t, history, 1)
+	require.Equal(t, fee, history[0].Fee)
+	require.Equal(t, ctx.BlockHeight(), history[0].Height)
+	require.Equal(t, ctx.BlockTime().Add(stakingKeeper.UnbondingTime(ctx)), history[0].CompletionTime)
+	require.Equal(t, history, stakingKeeper.ExportGenesis(ctx).RotationHistory)
+
+	// the validator can't rotate its pubkey again within the unbonding period
+	require.NoError(t, banktestutil.FundAccount(app.BankKeeper, ctx, delAddr, sdk.NewCoins(fee)))
+	msg, err = types.NewMsgRotateConsPubKey(valAddr, simapp.CreateTestPubKeys(3)[2])
+	require.NoError(t, err)
+	_, err = msgServer.RotateConsPubKey(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, types.ErrExceedingMaxConsPubKeyRotations)
+
+	// the limit is the MaxConsPubKeyRotations param
+	params := stakingKeeper.GetParams(ctx)
+	params.MaxConsPubkeyRotations = 2
+	stakingKeeper.SetParams(ctx, params)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	_, err = msgServer.RotateConsPubKey(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	_, err = stakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, banktestutil.FundAccount(app.BankKeeper, ctx, delAddr, sdk.NewCoins(fee)))
+	msg, err = types.NewMsgRotateConsPubKey(valAddr, simapp.CreateTestPubKeys(4)[3])
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	_, err = msgServer.RotateConsPubKey(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, types.ErrExceedingMaxConsPubKeyRotations)
+
+	// the rotations stop counting toward the limit after the unbonding period
+	ctx = ctx.WithBlockTime(history[0].CompletionTime)
+	_, err = msgServer.RotateConsPubKey(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	_, err = stakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+
+	// the first consensus address follows the rotations
+	byConsAddr, found := stakingKeeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(oldPk))
+	require.True(t, found)
+	require.Equal(t, valAddr.String(), byConsAddr.OperatorAddress)
+}
+
+func TestRotateConsPubKeyTwiceBeforeValidatorSetUpdate(t *testing.T) {
+	delTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	app, ctx, msgServer, delAddr, valAddr := setupLiquidStakeTest(t, delTokens)
+	ctx = ctx.WithBlockTime(time.Now().UTC())
+	stakingKeeper := app.StakingKeeper
+
+	params := stakingKeeper.GetParams(ctx)
+	params.MaxConsPubkeyRotations = 3
+	stakingKeeper.SetParams(ctx, params)
+
+	fee := stakingKeeper.KeyRotationFee(ctx)
+	require.NoError(t, banktestutil.FundAccount(app.BankKeeper, ctx, delAddr, sdk.NewCoins(fee.Add(fee))))
+
+	validator, found := stakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	oldPk, err := validator.ConsPubKey()
+	require.NoError(t, err)
+	pks := simapp.CreateTestPubKeys(4)
+
+	msg, err := types.NewMsgRotateConsPubKey(valAddr, pks[1])
+	require.NoError(t, err)
+	_, err = msgServer.RotateConsPubKey(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	// the second rotation is rejected until the consensus engine is told
+	// about the first one
+	msg, err = types.NewMsgRotateConsPubKey(valAddr, pks[2])
+	require.NoError(t, err)
+	_, err = msgServer.RotateConsPubKey(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, types.ErrConsPubKeyRotationPending)
+
+	// the update removes the pubkey known to the consensus engine
+	validator, found = stakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	oldTmPk, err := cryptocodec.ToTmProtoPublicKey(oldPk)
+	require.NoError(t, err)
+	updates, err := stakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []abci.ValidatorUpdate{
+		{PubKey: oldTmPk, Power: 0},
+		validator.ABCIValidatorUpdate(stakingKeeper.PowerReduction(ctx)),
+	}, updates)
+
+	// the validator can rotate again in the same block once the update is
+	// applied, both rotations are kept in the history
+	_, err = msgServer.RotateConsPubKey(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	history := stakingKeeper.GetValidatorConsPubKeyRotationHistory(ctx, valAddr)
+	require.Len(t, history, 2)
+	require.Equal(t, history[0].Height, history[1].Height)
+}
@@ -282,6 +282,10 @@ func (k Keeper) SetUnbondingDelegation(ctx sdk.Context, ubd types.UnbondingDeleg
 	key := types.GetUBDKey(delegatorAddress, addr)
 	store.Set(key, bz)
 	store.Set(types.GetUBDByValIndexKey(delegatorAddress, addr), []byte{}) // index, store empty bytes
+
+	if k.delegationHistory != nil {
+		k.delegationHistory.recordUnbondingDelegation(ctx, ubd, false)
+	}
 }
 
 // RemoveUnbondingDelegation removes the unbonding delegation object and associated index.
@@ -296,6 +300,10 @@ func (k Keeper) RemoveUnbondingDelegation(ctx sdk.Context, ubd types.UnbondingDe
 	key := types.GetUBDKey(delegatorAddress, addr)
 	store.Delete(key)
 	store.Delete(types.GetUBDByValIndexKey(delegatorAddress, addr))
+
+	if k.delegationHistory != nil {
+		k.delegationHistory.recordUnbondingDelegation(ctx, ubd, true)
+	}
 }
 
 // SetUnbondingDelegationEntry adds an entry to the unbonding delegation at
@@ -477,6 +485,10 @@ func (k Keeper) SetRedelegation(ctx sdk.Context, red types.Redelegation) {
 	store.Set(key, bz)
 	store.Set(types.GetREDByValSrcIndexKey(delegatorAddress, valSrcAddr, valDestAddr), []byte{})
 	store.Set(types.GetREDByValDstIndexKey(delegatorAddress, valSrcAddr, valDestAddr), []byte{})
+
+	if k.delegationHistory != nil {
+		k.delegationHistory.recordRedelegation(ctx, red, false)
+	}
 }
 
 // SetRedelegationEntry adds an entry to the unbonding delegation at the given
@@ -533,6 +545,10 @@ func (k Keeper) RemoveRedelegation(ctx sdk.Context, red types.Redelegation) {
 	store.Delete(redKey)
 	store.Delete(types.GetREDByValSrcIndexKey(delegatorAddress, valSrcAddr, valDestAddr))
 	store.Delete(types.GetREDByValDstIndexKey(delegatorAddress, valSrcAddr, valDestAddr))
+
+	if k.delegationHistory != nil {
+		k.delegationHistory.recordRedelegation(ctx, red, true)
+	}
 }
 
 // redelegation queue timeslice operations
@@ -688,6 +704,14 @@ func (k Keeper) Delegate(
 	delegation.Shares = delegation.Shares.Add(newShares)
 	k.SetDelegation(ctx, delegation)
 
+	// the shares added to a validator bond delegation count towards the
+	// validator bond of the validator
+	if delegation.ValidatorBond {
+		if err := k.IncreaseValidatorBondShares(ctx, validator.GetOperator(), newShares); err != nil {
+			return newShares, err
+		}
+	}
+
 	// Call the after-modification hook
 	if err := k.AfterDelegationModified(ctx, delegatorAddress, delegation.GetValidatorAddr()); err != nil {
 		return newShares, err
@@ -716,6 +740,14 @@ func (k Keeper) Unbond(
 		return amount, sdkerrors.Wrap(types.ErrNotEnoughDelegationShares, delegation.Shares.String())
 	}
 
+	// the validator bond cap is checked by the msg handlers, the unbonding
+	// performed by slashing must not fail
+	if delegation.ValidatorBond {
+		if err := k.DecreaseValidatorBondShares(ctx, valAddr, shares); err != nil {
+			return amount, err
+		}
+	}
+
 	// get validator
 	validator, found := k.GetValidator(ctx, valAddr)
 	if !found {
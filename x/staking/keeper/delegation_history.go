@@ -0,0 +1,305 @@
+package keeper
+
+import (
+	"context"
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var (
+	_ types.StakingHooks       = &DelegationHistoryIndex{}
+	_ baseapp.StreamingService = &DelegationHistoryIndex{}
+)
+
+// DelegationHistoryIndex is an optional off-consensus index recording the
+// shares of the delegations, and the entries of the unbonding delegations and
+// redelegations, at each height they are modified, so that they can be queried
+// at heights the node has pruned.
+//
+// The delegation changes are recorded by the staking hooks, and the unbonding
+// delegation and redelegation changes by the keeper when they are stored or
+// removed. The changes of the current block are buffered in a transient store
+// so that the changes of failed txs are discarded. The index is also a
+// streaming service of the BaseApp, writing the buffered changes to its own
+// database at the end of each block. The index database is not part of the
+// application state.
+type DelegationHistoryIndex struct {
+	db     dbm.DB
+	tkey   storetypes.StoreKey
+	keeper Keeper
+}
+
+// NewDelegationHistoryIndex creates a new delegation history index writing to
+// the given database and buffering the changes of a block in the given
+// transient store.
+func NewDelegationHistoryIndex(db dbm.DB, tkey storetypes.StoreKey, k Keeper) *DelegationHistoryIndex {
+	return &DelegationHistoryIndex{
+		db:     db,
+		tkey:   tkey,
+		keeper: k,
+	}
+}
+
+// DelegationHistory returns the paginated delegation history entries of a
+// delegator, in ascending height order.
+func (idx *DelegationHistoryIndex) DelegationHistory(delAddr sdk.AccAddress, pageReq *query.PageRequest) ([]types.DelegationHistoryEntry, *query.PageResponse, error) {
+	store := prefix.NewStore(dbadapter.Store{DB: idx.db}, types.GetDelegationHistoryPrefix(delAddr))
+
+	var entries []types.DelegationHistoryEntry
+	pageRes, err := query.Paginate(store, pageReq, func(key []byte, value []byte) error {
+		var entry types.DelegationHistoryEntry
+		if err := idx.keeper.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return entries, pageRes, nil
+}
+
+// UnbondingDelegationHistory returns the paginated unbonding delegation
+// history entries of a delegator, in ascending height order.
+func (idx *DelegationHistoryIndex) UnbondingDelegationHistory(delAddr sdk.AccAddress, pageReq *query.PageRequest) ([]types.UnbondingDelegationHistoryEntry, *query.PageResponse, error) {
+	store := prefix.NewStore(dbadapter.Store{DB: idx.db}, types.GetUnbondingDelegationHistoryPrefix(delAddr))
+
+	var entries []types.UnbondingDelegationHistoryEntry
+	pageRes, err := query.Paginate(store, pageReq, func(key []byte, value []byte) error {
+		var entry types.UnbondingDelegationHistoryEntry
+		if err := idx.keeper.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return entries, pageRes, nil
+}
+
+// RedelegationHistory returns the paginated redelegation history entries of a
+// delegator, in ascending height order.
+func (idx *DelegationHistoryIndex) RedelegationHistory(delAddr sdk.AccAddress, pageReq *query.PageRequest) ([]types.RedelegationHistoryEntry, *query.PageResponse, error) {
+	store := prefix.NewStore(dbadapter.Store{DB: idx.db}, types.GetRedelegationHistoryPrefix(delAddr))
+
+	var entries []types.RedelegationHistoryEntry
+	pageRes, err := query.Paginate(store, pageReq, func(key []byte, value []byte) error {
+		var entry types.RedelegationHistoryEntry
+		if err := idx.keeper.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return entries, pageRes, nil
+}
+
+// record buffers the shares of a delegation at the current height in the
+// transient store, overwriting a previous change of the same block.
+func (idx *DelegationHistoryIndex) record(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec) {
+	entry := types.DelegationHistoryEntry{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Height:           ctx.BlockHeight(),
+		Shares:           shares,
+	}
+
+	store := unmeteredContext(ctx).TransientStore(idx.tkey)
+	store.Set(types.GetDelegationHistoryKey(delAddr, entry.Height, valAddr), idx.keeper.cdc.MustMarshal(&entry))
+}
+
+// recordUnbondingDelegation buffers the entries of an unbonding delegation at
+// the current height in the transient store, overwriting a previous change of
+// the same block. A removed unbonding delegation is recorded without entries.
+func (idx *DelegationHistoryIndex) recordUnbondingDelegation(ctx sdk.Context, ubd types.UnbondingDelegation, removed bool) {
+	delAddr := sdk.MustAccAddressFromBech32(ubd.DelegatorAddress)
+	valAddr, err := sdk.ValAddressFromBech32(ubd.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	entry := types.UnbondingDelegationHistoryEntry{
+		DelegatorAddress: ubd.DelegatorAddress,
+		ValidatorAddress: ubd.ValidatorAddress,
+		Height:           ctx.BlockHeight(),
+	}
+	if !removed {
+		entry.Entries = ubd.Entries
+	}
+
+	store := unmeteredContext(ctx).TransientStore(idx.tkey)
+	store.Set(types.GetUnbondingDelegationHistoryKey(delAddr, entry.Height, valAddr), idx.keeper.cdc.MustMarshal(&entry))
+}
+
+// recordRedelegation buffers the entries of a redelegation at the current
+// height in the transient store, overwriting a previous change of the same
+// block. A removed redelegation is recorded without entries.
+func (idx *DelegationHistoryIndex) recordRedelegation(ctx sdk.Context, red types.Redelegation, removed bool) {
+	delAddr := sdk.MustAccAddressFromBech32(red.DelegatorAddress)
+	valSrcAddr, err := sdk.ValAddressFromBech32(red.ValidatorSrcAddress)
+	if err != nil {
+		panic(err)
+	}
+	valDstAddr, err := sdk.ValAddressFromBech32(red.ValidatorDstAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	entry := types.RedelegationHistoryEntry{
+		DelegatorAddress:    red.DelegatorAddress,
+		ValidatorSrcAddress: red.ValidatorSrcAddress,
+		ValidatorDstAddress: red.ValidatorDstAddress,
+		Height:              ctx.BlockHeight(),
+	}
+	if !removed {
+		entry.Entries = red.Entries
+	}
+
+	store := unmeteredContext(ctx).TransientStore(idx.tkey)
+	store.Set(types.GetRedelegationHistoryKey(delAddr, entry.Height, valSrcAddr, valDstAddr), idx.keeper.cdc.MustMarshal(&entry))
+}
+
+// unmeteredContext returns the context with an infinite gas meter. The index
+// only runs on some nodes, its reads and writes must not consume the gas of
+// the txs or the gas used would differ between the nodes.
+func unmeteredContext(ctx sdk.Context) sdk.Context {
+	return ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+}
+
+// flush writes the changes buffered in the transient store to the index
+// database.
+func (idx *DelegationHistoryIndex) flush(ctx sdk.Context) error {
+	iterator := ctx.TransientStore(idx.tkey).Iterator(nil, nil)
+	defer iterator.Close()
+
+	batch := idx.db.NewBatch()
+	defer batch.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if err := batch.Set(iterator.Key(), iterator.Value()); err != nil {
+			return err
+		}
+	}
+
+	return batch.Write()
+}
+
+// AfterDelegationModified records the shares of the modified delegation.
+func (idx *DelegationHistoryIndex) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	delegation, found := idx.keeper.GetDelegation(unmeteredContext(ctx), delAddr, valAddr)
+	if !found {
+		return nil
+	}
+
+	idx.record(ctx, delAddr, valAddr, delegation.Shares)
+	return nil
+}
+
+// BeforeDelegationRemoved records the removed delegation with zero shares.
+func (idx *DelegationHistoryIndex) BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	idx.record(ctx, delAddr, valAddr, sdk.ZeroDec())
+	return nil
+}
+
+func (idx *DelegationHistoryIndex) AfterValidatorCreated(_ sdk.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (idx *DelegationHistoryIndex) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (idx *DelegationHistoryIndex) AfterValidatorRemoved(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (idx *DelegationHistoryIndex) AfterValidatorBonded(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (idx *DelegationHistoryIndex) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (idx *DelegationHistoryIndex) BeforeDelegationCreated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (idx *DelegationHistoryIndex) BeforeDelegationSharesModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (idx *DelegationHistoryIndex) BeforeValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ sdk.Dec) error {
+	return nil
+}
+
+func (idx *DelegationHistoryIndex) AfterConsensusPubKeyUpdate(_ sdk.Context, _, _ cryptotypes.PubKey, _ sdk.Coin) error {
+	return nil
+}
+
+func (idx *DelegationHistoryIndex) BeforeTokenizeShareRecordRedeemed(_ sdk.Context, _ uint64) error {
+	return nil
+}
+
+// ListenEndBlock writes the changes of the block to the index database. The
+// index is off-consensus, a failure is logged instead of halting the node.
+func (idx *DelegationHistoryIndex) ListenEndBlock(ctx context.Context, _ abci.RequestEndBlock, _ abci.ResponseEndBlock) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := idx.flush(sdkCtx); err != nil {
+		idx.keeper.Logger(sdkCtx).Error("failed to write the delegation history", "height", sdkCtx.BlockHeight(), "err", err)
+	}
+
+	return nil
+}
+
+func (idx *DelegationHistoryIndex) ListenBeginBlock(_ context.Context, _ abci.RequestBeginBlock, _ abci.ResponseBeginBlock) error {
+	return nil
+}
+
+func (idx *DelegationHistoryIndex) ListenDeliverTx(_ context.Context, _ abci.RequestDeliverTx, _ abci.ResponseDeliverTx) error {
+	return nil
+}
+
+func (idx *DelegationHistoryIndex) ListenCommit(_ context.Context, _ abci.ResponseCommit) error {
+	return nil
+}
+
+// Stream implements the StreamingService interface, the index has no
+// streaming loop.
+func (idx *DelegationHistoryIndex) Stream(_ *sync.WaitGroup) error {
+	return nil
+}
+
+// Listeners implements the StreamingService interface, the index doesn't
+// listen to the store writes.
+func (idx *DelegationHistoryIndex) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return nil
+}
+
+// Close closes the index database.
+func (idx *DelegationHistoryIndex) Close() error {
+	return idx.db.Close()
+}
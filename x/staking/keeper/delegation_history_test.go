@@ -0,0 +1,239 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestDelegationHistory(t *testing.T) {
+	delTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	app, ctx, _, delAddr, valAddr := setupLiquidStakeTest(t, delTokens)
+	idx := keeper.NewDelegationHistoryIndex(dbm.NewMemDB(), app.GetTKey(types.TStoreKey), app.StakingKeeper)
+
+	stakingKeeper := app.StakingKeeper
+	stakingKeeper.SetDelegationHistoryIndex(idx)
+	querier := keeper.Querier{Keeper: stakingKeeper}
+
+	endBlock := func(ctx sdk.Context) {
+		require.NoError(t, idx.ListenEndBlock(sdk.WrapSDKContext(ctx), abci.RequestEndBlock{}, abci.ResponseEndBlock{}))
+	}
+
+	// the delegation created by the validator is recorded at the end of the block
+	ctx = ctx.WithBlockHeight(10)
+	require.NoError(t, idx.AfterDelegationModified(ctx, delAddr, valAddr))
+	res, err := querier.DelegationHistory(sdk.WrapSDKContext(ctx), &types.QueryDelegationHistoryRequest{DelegatorAddr: delAddr.String()})
+	require.NoError(t, err)
+	require.Empty(t, res.Entries)
+	endBlock(ctx)
+
+	// the changes of a discarded tx are not recorded
+	ctx = ctx.WithBlockHeight(11)
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = app.StakingKeeper.Unbond(cacheCtx, delAddr, valAddr, sdk.NewDecFromInt(delTokens.QuoRaw(2)))
+	require.NoError(t, err)
+	require.NoError(t, idx.AfterDelegationModified(cacheCtx, delAddr, valAddr))
+	endBlock(ctx)
+
+	// the shares are recorded after a partial unbonding and with zero shares
+	// once the delegation is removed
+	ctx = ctx.WithBlockHeight(12)
+	_, err = app.StakingKeeper.Unbond(ctx, delAddr, valAddr, sdk.NewDecFromInt(delTokens.QuoRaw(2)))
+	require.NoError(t, err)
+	require.NoError(t, idx.AfterDelegationModified(ctx, delAddr, valAddr))
+	endBlock(ctx)
+
+	ctx = ctx.WithBlockHeight(13)
+	require.NoError(t, idx.BeforeDelegationRemoved(ctx, delAddr, valAddr))
+	_, err = app.StakingKeeper.Unbond(ctx, delAddr, valAddr, sdk.NewDecFromInt(delTokens.QuoRaw(2)))
+	require.NoError(t, err)
+	endBlock(ctx)
+
+	expected := []types.DelegationHistoryEntry{
+		{DelegatorAddress: delAddr.String(), ValidatorAddress: valAddr.String(), Height: 10, Shares: sdk.NewDecFromInt(delTokens)},
+		{DelegatorAddress: delAddr.String(), ValidatorAddress: valAddr.String(), Height: 12, Shares: sdk.NewDecFromInt(delTokens.QuoRaw(2))},
+		{DelegatorAddress: delAddr.String(), ValidatorAddress: valAddr.String(), Height: 13, Shares: sdk.ZeroDec()},
+	}
+
+	res, err = querier.DelegationHistory(sdk.WrapSDKContext(ctx), &types.QueryDelegationHistoryRequest{DelegatorAddr: delAddr.String()})
+	require.NoError(t, err)
+	require.Equal(t, expected, res.Entries)
+
+	// the entries are paginated
+	res, err = querier.DelegationHistory(sdk.WrapSDKContext(ctx), &types.QueryDelegationHistoryRequest{
+		DelegatorAddr: delAddr.String(),
+		Pagination:    &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, expected[:2], res.Entries)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+
+	res, err = querier.DelegationHistory(sdk.WrapSDKContext(ctx), &types.QueryDelegationHistoryRequest{
+		DelegatorAddr: delAddr.String(),
+		Pagination:    &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Equal(t, expected[2:], res.Entries)
+
+	// the history of another delegator is empty
+	res, err = querier.DelegationHistory(sdk.WrapSDKContext(ctx), &types.QueryDelegationHistoryRequest{
+		DelegatorAddr: sdk.AccAddress("other_delegator_____").String(),
+	})
+	require.NoError(t, err)
+	require.Empty(t, res.Entries)
+
+	// the query fails on the nodes not running the index
+	_, err = keeper.Querier{Keeper: app.StakingKeeper}.DelegationHistory(sdk.WrapSDKContext(ctx), &types.QueryDelegationHistoryRequest{DelegatorAddr: delAddr.String()})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+
+	_, err = querier.DelegationHistory(sdk.WrapSDKContext(ctx), &types.QueryDelegationHistoryRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestUnbondingDelegationAndRedelegationHistory(t *testing.T) {
+	delTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	app, ctx, _, delAddr, valAddr := setupLiquidStakeTest(t, delTokens)
+	idx := keeper.NewDelegationHistoryIndex(dbm.NewMemDB(), app.GetTKey(types.TStoreKey), app.StakingKeeper)
+
+	stakingKeeper := app.StakingKeeper
+	stakingKeeper.SetDelegationHistoryIndex(idx)
+	querier := keeper.Querier{Keeper: stakingKeeper}
+
+	endBlock := func(ctx sdk.Context) {
+		require.NoError(t, idx.ListenEndBlock(sdk.WrapSDKContext(ctx), abci.RequestEndBlock{}, abci.ResponseEndBlock{}))
+	}
+
+	var dstAddr sdk.ValAddress
+	for _, validator := range stakingKeeper.GetAllValidators(ctx) {
+		if !validator.GetOperator().Equals(valAddr) {
+			dstAddr = validator.GetOperator()
+		}
+	}
+	require.NotNil(t, dstAddr)
+
+	// the unbonding delegation and the redelegation are recorded with their
+	// entries at the height they are created
+	ctx = ctx.WithBlockHeight(10)
+	ubdCompletion, err := stakingKeeper.Undelegate(ctx, delAddr, valAddr, sdk.NewDecFromInt(delTokens.QuoRaw(4)))
+	require.NoError(t, err)
+	endBlock(ctx)
+
+	ctx = ctx.WithBlockHeight(11)
+	redCompletion, err := stakingKeeper.BeginRedelegation(ctx, delAddr, valAddr, dstAddr, sdk.NewDecFromInt(delTokens.QuoRaw(4)))
+	require.NoError(t, err)
+	endBlock(ctx)
+
+	ubd, found := stakingKeeper.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	red, found := stakingKeeper.GetRedelegation(ctx, delAddr, valAddr, dstAddr)
+	require.True(t, found)
+
+	// the matured entries are recorded as removed once completed
+	ctx = ctx.WithBlockHeight(12).WithBlockTime(ubdCompletion)
+	_, err = stakingKeeper.CompleteUnbonding(ctx, delAddr, valAddr)
+	require.NoError(t, err)
+	endBlock(ctx)
+
+	ctx = ctx.WithBlockHeight(13).WithBlockTime(redCompletion)
+	_, err = stakingKeeper.CompleteRedelegation(ctx, delAddr, valAddr, dstAddr)
+	require.NoError(t, err)
+	endBlock(ctx)
+
+	ubdRes, err := querier.UnbondingDelegationHistory(sdk.WrapSDKContext(ctx), &types.QueryUnbondingDelegationHistoryRequest{DelegatorAddr: delAddr.String()})
+	require.NoError(t, err)
+	require.Equal(t, []types.UnbondingDelegationHistoryEntry{
+		{DelegatorAddress: delAddr.String(), ValidatorAddress: valAddr.String(), Height: 10, Entries: ubd.Entries},
+		{DelegatorAddress: delAddr.String(), ValidatorAddress: valAddr.String(), Height: 12},
+	}, ubdRes.Entries)
+
+	redRes, err := querier.RedelegationHistory(sdk.WrapSDKContext(ctx), &types.QueryRedelegationHistoryRequest{DelegatorAddr: delAddr.String()})
+	require.NoError(t, err)
+	require.Equal(t, []types.RedelegationHistoryEntry{
+		{DelegatorAddress: delAddr.String(), ValidatorSrcAddress: valAddr.String(), ValidatorDstAddress: dstAddr.String(), Height: 11, Entries: red.Entries},
+		{DelegatorAddress: delAddr.String(), ValidatorSrcAddress: valAddr.String(), ValidatorDstAddress: dstAddr.String(), Height: 13},
+	}, redRes.Entries)
+
+	// the entries are paginated
+	ubdRes, err = querier.UnbondingDelegationHistory(sdk.WrapSDKContext(ctx), &types.QueryUnbondingDelegationHistoryRequest{
+		DelegatorAddr: delAddr.String(),
+		Pagination:    &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, ubdRes.Entries, 1)
+	require.Equal(t, uint64(2), ubdRes.Pagination.Total)
+
+	// the queries fail on the nodes not running the index
+	_, err = keeper.Querier{Keeper: app.StakingKeeper}.UnbondingDelegationHistory(sdk.WrapSDKContext(ctx), &types.QueryUnbondingDelegationHistoryRequest{DelegatorAddr: delAddr.String()})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+	_, err = keeper.Querier{Keeper: app.StakingKeeper}.RedelegationHistory(sdk.WrapSDKContext(ctx), &types.QueryRedelegationHistoryRequest{DelegatorAddr: delAddr.String()})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+
+	_, err = querier.UnbondingDelegationHistory(sdk.WrapSDKContext(ctx), &types.QueryUnbondingDelegationHistoryRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = querier.RedelegationHistory(sdk.WrapSDKContext(ctx), &types.QueryRedelegationHistoryRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestDelegationHistoryGasUsed(t *testing.T) {
+	delTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	app, ctx, _, delAddr, valAddr := setupLiquidStakeTest(t, delTokens)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	require.NoError(t, banktestutil.FundAccount(app.BankKeeper, ctx, delAddr, sdk.NewCoins(sdk.NewCoin(bondDenom, delTokens))))
+
+	var dstAddr sdk.ValAddress
+	for _, validator := range app.StakingKeeper.GetAllValidators(ctx) {
+		if !validator.GetOperator().Equals(valAddr) {
+			dstAddr = validator.GetOperator()
+		}
+	}
+	require.NotNil(t, dstAddr)
+
+	// gasUsed runs the same delegate, undelegate and redelegate on a branch of
+	// the state, calling the index hook after each when the index is given
+	gasUsed := func(k keeper.Keeper, idx *keeper.DelegationHistoryIndex) uint64 {
+		cacheCtx, _ := ctx.CacheContext()
+		cacheCtx = cacheCtx.WithGasMeter(sdk.NewGasMeter(100_000_000))
+		goCtx := sdk.WrapSDKContext(cacheCtx)
+		msgServer := keeper.NewMsgServerImpl(k)
+		amount := sdk.NewCoin(bondDenom, delTokens.QuoRaw(4))
+
+		afterModified := func() {
+			if idx != nil {
+				require.NoError(t, idx.AfterDelegationModified(cacheCtx, delAddr, valAddr))
+			}
+		}
+
+		_, err := msgServer.Delegate(goCtx, types.NewMsgDelegate(delAddr, valAddr, amount))
+		require.NoError(t, err)
+		afterModified()
+		_, err = msgServer.Undelegate(goCtx, types.NewMsgUndelegate(delAddr, valAddr, amount))
+		require.NoError(t, err)
+		afterModified()
+		_, err = msgServer.BeginRedelegate(goCtx, types.NewMsgBeginRedelegate(delAddr, valAddr, dstAddr, amount))
+		require.NoError(t, err)
+		afterModified()
+
+		return cacheCtx.GasMeter().GasConsumed()
+	}
+
+	withoutIndex := gasUsed(app.StakingKeeper, nil)
+
+	stakingKeeper := app.StakingKeeper
+	idx := keeper.NewDelegationHistoryIndex(dbm.NewMemDB(), app.GetTKey(types.TStoreKey), stakingKeeper)
+	stakingKeeper.SetDelegationHistoryIndex(idx)
+	withIndex := gasUsed(stakingKeeper, idx)
+
+	// the index consumes no gas, the nodes running it use the same gas
+	require.NotZero(t, withoutIndex)
+	require.Equal(t, withoutIndex, withIndex)
+}
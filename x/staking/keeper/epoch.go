@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetEpochDelegationPool returns the epoch delegation pool's module account
+func (k Keeper) GetEpochDelegationPool(ctx sdk.Context) (epochDelegationPool authtypes.ModuleAccountI) {
+	return k.authKeeper.GetModuleAccount(ctx, types.EpochDelegationPoolName)
+}
+
+// shouldQueueForEpoch returns true if the staking messages must be buffered
+// until the end of the current epoch instead of being executed right away.
+func (k Keeper) shouldQueueForEpoch(ctx sdk.Context) bool {
+	return k.epochKeeper != nil && k.EpochMode(ctx) && !k.epochKeeper.IsExecutingEpochAction(ctx)
+}
+
+// isExecutingEpochMsg returns true if the staking message being executed was
+// buffered until the end of the epoch, so that its tokens are escrowed in the
+// epoch delegation pool. It doesn't depend on the EpochMode param so that the
+// messages queued before the param is disabled are still executed correctly.
+func (k Keeper) isExecutingEpochMsg(ctx sdk.Context) bool {
+	return k.epochKeeper != nil && k.epochKeeper.IsExecutingEpochAction(ctx)
+}
+
+// queueEpochMsg buffers a staking message until the end of the current epoch,
+// escrowing the given tokens, if any, from the sender in the epoch delegation
+// pool.
+func (k Keeper) queueEpochMsg(ctx sdk.Context, msg sdk.Msg, sender sdk.AccAddress, escrow sdk.Coins) error {
+	if !escrow.IsZero() {
+		if err := k.bankKeeper.DelegateCoinsFromAccountToModule(ctx, sender, types.EpochDelegationPoolName, escrow); err != nil {
+			return err
+		}
+	}
+
+	epochNumber := k.epochKeeper.GetEpochNumber(ctx)
+	k.epochKeeper.QueueMsgForEpoch(ctx, epochNumber, msg)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeQueueEpochMsg,
+			sdk.NewAttribute(types.AttributeKeyEpochNumber, fmt.Sprint(epochNumber)),
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, sdk.MsgTypeURL(msg)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		),
+	})
+
+	return nil
+}
+
+// delegateFromEpochPool performs a delegation whose tokens were escrowed in the
+// epoch delegation pool when the message was queued.
+func (k Keeper) delegateFromEpochPool(
+	ctx sdk.Context, delAddr sdk.AccAddress, bondAmt math.Int, validator types.Validator,
+) (newShares sdk.Dec, err error) {
+	coins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), bondAmt))
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.EpochDelegationPoolName, types.NotBondedPoolName, coins); err != nil {
+		return sdk.Dec{}, err
+	}
+
+	// the tokens are now in the not bonded pool and are moved to the bonded
+	// pool by Delegate if the validator is bonded
+	return k.Delegate(ctx, delAddr, bondAmt, types.Unbonded, validator, false)
+}
+
+// refundEpochEscrow returns the tokens escrowed for a queued message to the
+// delegator.
+func (k Keeper) refundEpochEscrow(ctx sdk.Context, delegator string, amount sdk.Coin) error {
+	delAddr, err := sdk.AccAddressFromBech32(delegator)
+	if err != nil {
+		return err
+	}
+
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	return k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.EpochDelegationPoolName, delAddr, sdk.NewCoins(amount))
+}
+
+// GetEpochEscrowedTokens returns the tokens that the queued staking messages
+// escrow in the epoch delegation pool.
+func (k Keeper) GetEpochEscrowedTokens(ctx sdk.Context) sdk.Coins {
+	escrowed := sdk.NewCoins()
+	if k.epochKeeper == nil {
+		return escrowed
+	}
+
+	for _, msg := range k.epochKeeper.GetEpochActions(ctx) {
+		switch msg := msg.(type) {
+		case *types.MsgDelegate:
+			escrowed = escrowed.Add(msg.Amount)
+		case *types.MsgCreateValidator:
+			escrowed = escrowed.Add(msg.Value)
+		}
+	}
+
+	return escrowed
+}
+
+// EpochingHooks wrapper struct for staking keeper
+type EpochingHooks struct {
+	k Keeper
+}
+
+// EpochingHooks returns the epoching hooks refunding the tokens escrowed for
+// the staking messages that fail at the end of an epoch
+func (k Keeper) EpochingHooks() EpochingHooks {
+	return EpochingHooks{k}
+}
+
+// AfterEpochActionFailure returns the tokens escrowed for a failed MsgDelegate
+// or MsgCreateValidator to the delegator.
+func (h EpochingHooks) AfterEpochActionFailure(ctx sdk.Context, msg sdk.Msg) error {
+	switch msg := msg.(type) {
+	case *types.MsgDelegate:
+		return h.k.refundEpochEscrow(ctx, msg.DelegatorAddress, msg.Amount)
+	case *types.MsgCreateValidator:
+		return h.k.refundEpochEscrow(ctx, msg.DelegatorAddress, msg.Value)
+	default:
+		return nil
+	}
+}
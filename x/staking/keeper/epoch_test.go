@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func setupEpochMode(t *testing.T) (*simapp.SimApp, sdk.Context, types.MsgServer, sdk.AccAddress, sdk.ValAddress) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.EpochMode = true
+	app.StakingKeeper.SetParams(ctx, params)
+
+	delAddrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(10000))
+	validators := app.StakingKeeper.GetValidators(ctx, 10)
+	require.Len(t, validators, 1)
+
+	return app, ctx, keeper.NewMsgServerImpl(app.StakingKeeper), delAddrs[0], validators[0].GetOperator()
+}
+
+func endEpoch(app *simapp.SimApp, ctx sdk.Context) sdk.Context {
+	ctx = ctx.WithBlockHeight(app.EpochingKeeper.EpochLength(ctx)).WithEventManager(sdk.NewEventManager())
+	epoching.EndBlocker(ctx, app.EpochingKeeper)
+	return ctx
+}
+
+func requireEpochPoolInvariant(t *testing.T, app *simapp.SimApp, ctx sdk.Context) {
+	msg, broken := keeper.EpochDelegationPoolInvariant(app.StakingKeeper)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestEpochModeDelegate(t *testing.T) {
+	app, ctx, msgServer, delAddr, valAddr := setupEpochMode(t)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	poolAddr := app.StakingKeeper.GetEpochDelegationPool(ctx).GetAddress()
+	amount := sdk.NewInt64Coin(bondDenom, 1000)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	tokensBefore := validator.Tokens
+
+	_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(delAddr, valAddr, amount))
+	require.NoError(t, err)
+
+	// the tokens are escrowed and the delegation is buffered
+	require.Equal(t, sdk.NewInt(9000), app.BankKeeper.GetBalance(ctx, delAddr, bondDenom).Amount)
+	require.Equal(t, amount, app.BankKeeper.GetBalance(ctx, poolAddr, bondDenom))
+	_, found = app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.False(t, found)
+	requireEpochPoolInvariant(t, app, ctx)
+
+	ctx = endEpoch(app, ctx)
+
+	// the delegation is applied at the end of the epoch
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.True(t, delegation.Shares.IsPositive())
+	validator, _ = app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.Equal(t, tokensBefore.Add(amount.Amount), validator.Tokens)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, poolAddr).IsZero())
+	requireEpochPoolInvariant(t, app, ctx)
+
+	// undelegations are buffered as well
+	_, err = msgServer.Undelegate(sdk.WrapSDKContext(ctx), types.NewMsgUndelegate(delAddr, valAddr, amount))
+	require.NoError(t, err)
+	_, found = app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+
+	ctx = endEpoch(app, ctx)
+
+	_, found = app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.False(t, found)
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+}
+
+func TestEpochModeFailedDelegationRefund(t *testing.T) {
+	app, ctx, msgServer, delAddr, valAddr := setupEpochMode(t)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	poolAddr := app.StakingKeeper.GetEpochDelegationPool(ctx).GetAddress()
+
+	_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(delAddr, valAddr, sdk.NewInt64Coin(bondDenom, 1000)))
+	require.NoError(t, err)
+
+	// changing the bond denom makes the buffered delegation fail
+	params := app.StakingKeeper.GetParams(ctx)
+	params.BondDenom = "otherdenom"
+	app.StakingKeeper.SetParams(ctx, params)
+
+	ctx = endEpoch(app, ctx)
+
+	_, found := app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.False(t, found)
+	require.Equal(t, sdk.NewInt(10000), app.BankKeeper.GetBalance(ctx, delAddr, bondDenom).Amount)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, poolAddr).IsZero())
+	requireEpochPoolInvariant(t, app, ctx)
+}
+
+func TestEpochModeDisabled(t *testing.T) {
+	app, ctx, msgServer, delAddr, valAddr := setupEpochMode(t)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(delAddr, valAddr, sdk.NewInt64Coin(bondDenom, 1000)))
+	require.NoError(t, err)
+
+	// the messages queued before epoch mode is disabled are still executed
+	// from the escrow, while the new ones are executed right away
+	params := app.StakingKeeper.GetParams(ctx)
+	params.EpochMode = false
+	app.StakingKeeper.SetParams(ctx, params)
+
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(delAddr, valAddr, sdk.NewInt64Coin(bondDenom, 500)))
+	require.NoError(t, err)
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	sharesBefore := delegation.Shares
+
+	ctx = endEpoch(app, ctx)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.True(t, delegation.Shares.GT(sharesBefore))
+	require.Equal(t, sdk.NewInt(8500), app.BankKeeper.GetBalance(ctx, delAddr, bondDenom).Amount)
+	requireEpochPoolInvariant(t, app, ctx)
+}
@@ -5,6 +5,7 @@ import (
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -29,6 +30,15 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 	k.SetLastTotalPower(ctx, data.LastTotalPower)
 
 	for _, validator := range data.Validators {
+		// the liquid staking fields are missing from the validators exported
+		// before their introduction
+		if validator.ValidatorBondShares.IsNil() {
+			validator.ValidatorBondShares = sdk.ZeroDec()
+		}
+		if validator.LiquidShares.IsNil() {
+			validator.LiquidShares = sdk.ZeroDec()
+		}
+
 		k.SetValidator(ctx, validator)
 
 		// Manually set indices for the first time
@@ -96,6 +106,34 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 		}
 	}
 
+	for _, tokenizeShareRecord := range data.TokenizeShareRecords {
+		if err := k.AddTokenizeShareRecord(ctx, tokenizeShareRecord); err != nil {
+			panic(err)
+		}
+	}
+
+	k.SetLastTokenizeShareRecordID(ctx, data.LastTokenizeShareRecordId)
+
+	for _, history := range data.RotationHistory {
+		k.SetConsPubKeyRotationHistory(ctx, history)
+
+		oldPubKey, ok := history.OldConsPubkey.GetCachedValue().(cryptotypes.PubKey)
+		if !ok {
+			panic(fmt.Sprintf("expecting cryptotypes.PubKey, got %T", history.OldConsPubkey.GetCachedValue()))
+		}
+
+		newPubKey, ok := history.NewConsPubkey.GetCachedValue().(cryptotypes.PubKey)
+		if !ok {
+			panic(fmt.Sprintf("expecting cryptotypes.PubKey, got %T", history.NewConsPubkey.GetCachedValue()))
+		}
+
+		k.setOldToNewConsAddr(ctx, sdk.GetConsAddress(oldPubKey), sdk.GetConsAddress(newPubKey))
+	}
+
+	if !data.TotalLiquidStakedTokens.IsNil() {
+		k.SetTotalLiquidStakedTokens(ctx, data.TotalLiquidStakedTokens)
+	}
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -189,13 +227,17 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	})
 
 	return &types.GenesisState{
-		Params:               k.GetParams(ctx),
-		LastTotalPower:       k.GetLastTotalPower(ctx),
-		LastValidatorPowers:  lastValidatorPowers,
-		Validators:           k.GetAllValidators(ctx),
-		Delegations:          k.GetAllDelegations(ctx),
-		UnbondingDelegations: unbondingDelegations,
-		Redelegations:        redelegations,
-		Exported:             true,
+		Params:                    k.GetParams(ctx),
+		LastTotalPower:            k.GetLastTotalPower(ctx),
+		LastValidatorPowers:       lastValidatorPowers,
+		Validators:                k.GetAllValidators(ctx),
+		Delegations:               k.GetAllDelegations(ctx),
+		UnbondingDelegations:      unbondingDelegations,
+		Redelegations:             redelegations,
+		Exported:                  true,
+		TokenizeShareRecords:      k.GetAllTokenizeShareRecords(ctx),
+		LastTokenizeShareRecordId: k.GetLastTokenizeShareRecordID(ctx),
+		TotalLiquidStakedTokens:   k.GetTotalLiquidStakedTokens(ctx),
+		RotationHistory:           k.GetAllConsPubKeyRotationHistory(ctx),
 	}
 }
@@ -78,11 +78,19 @@ func TestInitGenesis(t *testing.T) {
 	delegations = append(delegations, genesisDelegations...)
 
 	genesisState := types.NewGenesisState(params, validators, delegations)
+	genesisState.TokenizeShareRecords = []types.TokenizeShareRecord{
+		{Id: 2, Owner: addrs[2].String(), ModuleAccount: types.GetTokenizeShareRecordModuleAccount(2), Validator: bondedVal1.OperatorAddress},
+	}
+	genesisState.LastTokenizeShareRecordId = 2
+	genesisState.TotalLiquidStakedTokens = valTokens
 	vals := app.StakingKeeper.InitGenesis(ctx, genesisState)
 
 	actualGenesis := app.StakingKeeper.ExportGenesis(ctx)
 	require.Equal(t, genesisState.Params, actualGenesis.Params)
 	require.Equal(t, genesisState.Delegations, actualGenesis.Delegations)
+	require.Equal(t, genesisState.TokenizeShareRecords, actualGenesis.TokenizeShareRecords)
+	require.Equal(t, genesisState.LastTokenizeShareRecordId, actualGenesis.LastTokenizeShareRecordId)
+	require.Equal(t, genesisState.TotalLiquidStakedTokens, actualGenesis.TotalLiquidStakedTokens)
 	require.EqualValues(t, app.StakingKeeper.GetAllValidators(ctx), actualGenesis.Validators)
 
 	// Ensure validators have addresses.
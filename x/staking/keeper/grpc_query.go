@@ -464,6 +464,181 @@ func (k Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
+// TokenizeShareRecordById queries the tokenize share record with the given id
+func (k Querier) TokenizeShareRecordById(c context.Context, req *types.QueryTokenizeShareRecordByIdRequest) (*types.QueryTokenizeShareRecordByIdResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	record, err := k.GetTokenizeShareRecord(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryTokenizeShareRecordByIdResponse{Record: record}, nil
+}
+
+// TokenizeShareRecordByDenom queries the tokenize share record backing the
+// share tokens of the given denom
+func (k Querier) TokenizeShareRecordByDenom(c context.Context, req *types.QueryTokenizeShareRecordByDenomRequest) (*types.QueryTokenizeShareRecordByDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "denom cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	record, err := k.GetTokenizeShareRecordByDenom(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryTokenizeShareRecordByDenomResponse{Record: record}, nil
+}
+
+// TokenizeShareRecordsOwned queries the tokenize share records owned by the
+// given address
+func (k Querier) TokenizeShareRecordsOwned(c context.Context, req *types.QueryTokenizeShareRecordsOwnedRequest) (*types.QueryTokenizeShareRecordsOwnedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	records := k.GetTokenizeShareRecordsByOwner(ctx, owner)
+
+	return &types.QueryTokenizeShareRecordsOwnedResponse{Records: records}, nil
+}
+
+// AllTokenizeShareRecords queries all the tokenize share records
+func (k Querier) AllTokenizeShareRecords(c context.Context, req *types.QueryAllTokenizeShareRecordsRequest) (*types.QueryAllTokenizeShareRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var records []types.TokenizeShareRecord
+	recordStore := k.GetTokenizeShareRecordsStore(ctx)
+	pageRes, err := query.Paginate(recordStore, req.Pagination, func(key []byte, value []byte) error {
+		var record types.TokenizeShareRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllTokenizeShareRecordsResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// TotalLiquidStaked queries the total amount of liquid staked tokens
+func (k Querier) TotalLiquidStaked(c context.Context, _ *types.QueryTotalLiquidStakedRequest) (*types.QueryTotalLiquidStakedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	totalLiquidStaked := k.GetTotalLiquidStakedTokens(ctx)
+
+	return &types.QueryTotalLiquidStakedResponse{Tokens: totalLiquidStaked.String()}, nil
+}
+
+// DelegationHistory queries the changes of the delegations of a delegator
+// recorded by the off-consensus delegation history index. The entries are read
+// from the latest state of the index regardless of the query height.
+func (k Querier) DelegationHistory(c context.Context, req *types.QueryDelegationHistoryRequest) (*types.QueryDelegationHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.DelegatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "delegator address cannot be empty")
+	}
+
+	if k.delegationHistory == nil {
+		return nil, status.Error(codes.Unimplemented, "delegation history index is not enabled on this node")
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, pageRes, err := k.delegationHistory.DelegationHistory(delAddr, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDelegationHistoryResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
+// UnbondingDelegationHistory queries the changes of the unbonding delegations
+// of a delegator recorded by the off-consensus delegation history index. The
+// entries are read from the latest state of the index regardless of the query
+// height.
+func (k Querier) UnbondingDelegationHistory(c context.Context, req *types.QueryUnbondingDelegationHistoryRequest) (*types.QueryUnbondingDelegationHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.DelegatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "delegator address cannot be empty")
+	}
+
+	if k.delegationHistory == nil {
+		return nil, status.Error(codes.Unimplemented, "delegation history index is not enabled on this node")
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, pageRes, err := k.delegationHistory.UnbondingDelegationHistory(delAddr, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryUnbondingDelegationHistoryResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
+// RedelegationHistory queries the changes of the redelegations of a delegator
+// recorded by the off-consensus delegation history index. The entries are read
+// from the latest state of the index regardless of the query height.
+func (k Querier) RedelegationHistory(c context.Context, req *types.QueryRedelegationHistoryRequest) (*types.QueryRedelegationHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.DelegatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "delegator address cannot be empty")
+	}
+
+	if k.delegationHistory == nil {
+		return nil, status.Error(codes.Unimplemented, "delegation history index is not enabled on this node")
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, pageRes, err := k.delegationHistory.RedelegationHistory(delAddr, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRedelegationHistoryResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
 func queryRedelegation(ctx sdk.Context, k Querier, req *types.QueryRedelegationsRequest) (redels types.Redelegations, err error) {
 	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
 	if err != nil {
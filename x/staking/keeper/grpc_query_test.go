@@ -796,6 +796,7 @@ func createValidators(t *testing.T, ctx sdk.Context, app *simapp.SimApp, powers
 		app.AccountKeeper,
 		app.BankKeeper,
 		app.GetSubspace(types.ModuleName),
+		app.StakingKeeper.GetAuthority(),
 	)
 
 	val1 := teststaking.NewValidator(t, valAddrs[0], pks[0])
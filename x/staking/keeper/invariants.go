@@ -18,6 +18,8 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		PositiveDelegationInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "delegator-shares",
 		DelegatorSharesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "epoch-delegation-pool",
+		EpochDelegationPoolInvariant(k))
 }
 
 // AllInvariants runs all invariants of the staking module.
@@ -38,7 +40,12 @@ func AllInvariants(k Keeper) sdk.Invariant {
 			return res, stop
 		}
 
-		return DelegatorSharesInvariant(k)(ctx)
+		res, stop = DelegatorSharesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return EpochDelegationPoolInvariant(k)(ctx)
 	}
 }
 
@@ -194,3 +201,24 @@ func DelegatorSharesInvariant(k Keeper) sdk.Invariant {
 		return sdk.FormatInvariant(types.ModuleName, "delegator shares", msg), broken
 	}
 }
+
+// EpochDelegationPoolInvariant checks that the epoch delegation pool holds
+// exactly the tokens escrowed by the staking messages queued until the end of
+// the epoch. In particular, the pool is empty when no message is queued.
+func EpochDelegationPoolInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		poolAddr := k.authKeeper.GetModuleAddress(types.EpochDelegationPoolName)
+		if poolAddr == nil {
+			return sdk.FormatInvariant(types.ModuleName, "epoch delegation pool", "epoch delegation pool module account is not set"), false
+		}
+
+		poolBalance := k.bankKeeper.GetAllBalances(ctx, poolAddr)
+		escrowed := k.GetEpochEscrowedTokens(ctx)
+		broken := !poolBalance.IsAllGTE(escrowed) || !escrowed.IsAllGTE(poolBalance)
+
+		return sdk.FormatInvariant(types.ModuleName, "epoch delegation pool", fmt.Sprintf(
+			"\tPool's tokens: %v\n"+
+				"\tsum of queued delegation tokens: %v\n",
+			poolBalance, escrowed)), broken
+	}
+}
@@ -26,13 +26,17 @@ type Keeper struct {
 	authKeeper types.AccountKeeper
 	bankKeeper types.BankKeeper
 	hooks      types.StakingHooks
-	paramstore paramtypes.Subspace
+	paramstore paramtypes.Subspace // legacy params subspace, only used by the migrations
+	authority  string
+
+	epochKeeper       types.EpochKeeper
+	delegationHistory *DelegationHistoryIndex
 }
 
 // NewKeeper creates a new staking Keeper instance
 func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, ak types.AccountKeeper, bk types.BankKeeper,
-	ps paramtypes.Subspace,
+	ps paramtypes.Subspace, authority string,
 ) Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -48,6 +52,11 @@ func NewKeeper(
 		panic(fmt.Sprintf("%s module account has not been set", types.NotBondedPoolName))
 	}
 
+	// ensure that authority is a valid AccAddress
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Errorf("invalid staking authority address: %w", err))
+	}
+
 	return Keeper{
 		storeKey:   key,
 		cdc:        cdc,
@@ -55,6 +64,7 @@ func NewKeeper(
 		bankKeeper: bk,
 		paramstore: ps,
 		hooks:      nil,
+		authority:  authority,
 	}
 }
 
@@ -63,6 +73,11 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
 }
 
+// GetAuthority returns the x/staking module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Set the validator hooks
 func (k *Keeper) SetHooks(sh types.StakingHooks) *Keeper {
 	if k.hooks != nil {
@@ -74,6 +89,36 @@ func (k *Keeper) SetHooks(sh types.StakingHooks) *Keeper {
 	return k
 }
 
+// SetEpochKeeper sets the epoching keeper used to buffer the staking messages
+// until the end of the current epoch when the EpochMode param is enabled.
+func (k *Keeper) SetEpochKeeper(ek types.EpochKeeper) *Keeper {
+	if k.epochKeeper != nil {
+		panic("cannot set epoch keeper twice")
+	}
+
+	// the epoch delegation pool escrows the buffered delegations
+	if addr := k.authKeeper.GetModuleAddress(types.EpochDelegationPoolName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.EpochDelegationPoolName))
+	}
+
+	k.epochKeeper = ek
+
+	return k
+}
+
+// SetDelegationHistoryIndex sets the off-consensus delegation history index
+// serving the DelegationHistory query. The index must also be registered as
+// staking hooks and as a streaming service of the BaseApp.
+func (k *Keeper) SetDelegationHistoryIndex(index *DelegationHistoryIndex) *Keeper {
+	if k.delegationHistory != nil {
+		panic("cannot set delegation history index twice")
+	}
+
+	k.delegationHistory = index
+
+	return k
+}
+
 // Load the last total validator power.
 func (k Keeper) GetLastTotalPower(ctx sdk.Context) math.Int {
 	store := ctx.KVStore(k.storeKey)
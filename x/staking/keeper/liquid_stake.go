@@ -0,0 +1,262 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetTokenizeSharePool returns the tokenize share pool's module account
+func (k Keeper) GetTokenizeSharePool(ctx sdk.Context) (tokenizeSharePool authtypes.ModuleAccountI) {
+	return k.authKeeper.GetModuleAccount(ctx, types.TokenizeSharePoolName)
+}
+
+// GetTotalLiquidStakedTokens returns the amount of tokens backing the share
+// tokens of the tokenize share records delegated to bonded validators.
+func (k Keeper) GetTotalLiquidStakedTokens(ctx sdk.Context) math.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TotalLiquidStakedTokensKey)
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	ip := sdk.IntProto{}
+	k.cdc.MustUnmarshal(bz, &ip)
+
+	return ip.Int
+}
+
+// SetTotalLiquidStakedTokens sets the amount of tokens backing the share tokens
+// of the tokenize share records delegated to bonded validators.
+func (k Keeper) SetTotalLiquidStakedTokens(ctx sdk.Context, tokens math.Int) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: tokens})
+	store.Set(types.TotalLiquidStakedTokensKey, bz)
+}
+
+// CheckExceedsGlobalLiquidStakingCap returns true if liquid staking the given
+// tokens would exceed the GlobalLiquidStakingCap param. The tokens are added to
+// the total bonded tokens if they are not already bonded.
+func (k Keeper) CheckExceedsGlobalLiquidStakingCap(ctx sdk.Context, tokens math.Int, tokensAlreadyBonded bool) bool {
+	liquidStakingCap := k.GlobalLiquidStakingCap(ctx)
+	liquidStakedAmount := k.GetTotalLiquidStakedTokens(ctx).Add(tokens)
+
+	totalStakedAmount := k.TotalBondedTokens(ctx)
+	if !tokensAlreadyBonded {
+		totalStakedAmount = totalStakedAmount.Add(tokens)
+	}
+	if !totalStakedAmount.IsPositive() {
+		return liquidStakedAmount.IsPositive()
+	}
+
+	liquidStakePercent := sdk.NewDecFromInt(liquidStakedAmount).QuoInt(totalStakedAmount)
+	return liquidStakePercent.GT(liquidStakingCap)
+}
+
+// CheckExceedsValidatorLiquidStakingCap returns true if liquid staking the
+// given shares would exceed the ValidatorLiquidStakingCap param of the
+// validator.
+func (k Keeper) CheckExceedsValidatorLiquidStakingCap(ctx sdk.Context, validator types.Validator, shares sdk.Dec) bool {
+	if !validator.DelegatorShares.IsPositive() {
+		return shares.IsPositive()
+	}
+
+	liquidStakingCap := k.ValidatorLiquidStakingCap(ctx)
+	liquidSharesPercent := validator.LiquidShares.Add(shares).Quo(validator.DelegatorShares)
+
+	return liquidSharesPercent.GT(liquidStakingCap)
+}
+
+// CheckExceedsValidatorBondCap returns true if liquid staking the given shares
+// would exceed the ValidatorBondFactor param times the validator bond shares
+// of the validator.
+func (k Keeper) CheckExceedsValidatorBondCap(ctx sdk.Context, validator types.Validator, shares sdk.Dec) bool {
+	validatorBondFactor := k.ValidatorBondFactor(ctx)
+	if validatorBondFactor.Equal(types.DefaultValidatorBondFactor) {
+		return false
+	}
+
+	maxValLiquidShares := validator.ValidatorBondShares.Mul(validatorBondFactor)
+	return validator.LiquidShares.Add(shares).GT(maxValLiquidShares)
+}
+
+// SafelyIncreaseTotalLiquidStakedTokens increases the total liquid staked
+// tokens if the global liquid staking cap is not exceeded.
+func (k Keeper) SafelyIncreaseTotalLiquidStakedTokens(ctx sdk.Context, tokens math.Int, tokensAlreadyBonded bool) error {
+	if k.CheckExceedsGlobalLiquidStakingCap(ctx, tokens, tokensAlreadyBonded) {
+		return types.ErrGlobalLiquidStakingCapExceeded
+	}
+
+	k.SetTotalLiquidStakedTokens(ctx, k.GetTotalLiquidStakedTokens(ctx).Add(tokens))
+	return nil
+}
+
+// DecreaseTotalLiquidStakedTokens decreases the total liquid staked tokens.
+func (k Keeper) DecreaseTotalLiquidStakedTokens(ctx sdk.Context, tokens math.Int) error {
+	totalLiquidStaked := k.GetTotalLiquidStakedTokens(ctx)
+	if tokens.GT(totalLiquidStaked) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "total liquid staked tokens %s is less than %s", totalLiquidStaked, tokens)
+	}
+
+	k.SetTotalLiquidStakedTokens(ctx, totalLiquidStaked.Sub(tokens))
+	return nil
+}
+
+// SafelyIncreaseValidatorLiquidShares increases the liquid shares of a
+// validator if neither the validator bond cap nor the validator liquid staking
+// cap are exceeded.
+func (k Keeper) SafelyIncreaseValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec) error {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound
+	}
+
+	if k.CheckExceedsValidatorBondCap(ctx, validator, shares) {
+		return types.ErrInsufficientValidatorBondShares
+	}
+	if k.CheckExceedsValidatorLiquidStakingCap(ctx, validator, shares) {
+		return types.ErrValLiquidStakingCapExceeded
+	}
+
+	validator.LiquidShares = validator.LiquidShares.Add(shares)
+	k.SetValidator(ctx, validator)
+
+	return nil
+}
+
+// DecreaseValidatorLiquidShares decreases the liquid shares of a validator.
+func (k Keeper) DecreaseValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec) error {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound
+	}
+
+	if shares.GT(validator.LiquidShares) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "validator liquid shares %s is less than %s", validator.LiquidShares, shares)
+	}
+
+	validator.LiquidShares = validator.LiquidShares.Sub(shares)
+	k.SetValidator(ctx, validator)
+
+	return nil
+}
+
+// IncreaseValidatorBondShares increases the validator bond shares of a
+// validator.
+func (k Keeper) IncreaseValidatorBondShares(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec) error {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound
+	}
+
+	validator.ValidatorBondShares = validator.ValidatorBondShares.Add(shares)
+	k.SetValidator(ctx, validator)
+
+	return nil
+}
+
+// DecreaseValidatorBondShares decreases the validator bond shares of a
+// validator. It doesn't check the validator bond cap so that the unbonding
+// performed by slashing never fails.
+func (k Keeper) DecreaseValidatorBondShares(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec) error {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound
+	}
+
+	if shares.GT(validator.ValidatorBondShares) {
+		shares = validator.ValidatorBondShares
+	}
+
+	validator.ValidatorBondShares = validator.ValidatorBondShares.Sub(shares)
+	k.SetValidator(ctx, validator)
+
+	return nil
+}
+
+// CheckExceedsValidatorBondCapAfterUnbond returns true if removing the given
+// shares from the validator bond shares of the validator would leave its
+// liquid shares above the ValidatorBondFactor param times the validator bond
+// shares.
+func (k Keeper) CheckExceedsValidatorBondCapAfterUnbond(ctx sdk.Context, validator types.Validator, shares sdk.Dec) bool {
+	validatorBondFactor := k.ValidatorBondFactor(ctx)
+	if validatorBondFactor.Equal(types.DefaultValidatorBondFactor) {
+		return false
+	}
+
+	if shares.GT(validator.ValidatorBondShares) {
+		shares = validator.ValidatorBondShares
+	}
+
+	maxValLiquidShares := validator.ValidatorBondShares.Sub(shares).Mul(validatorBondFactor)
+	return validator.LiquidShares.GT(maxValLiquidShares)
+}
+
+// validateValidatorBondUnbond returns an error if the delegation is a validator
+// bond and unbonding the given shares from it would exceed the validator bond
+// cap of the validator.
+func (k Keeper) validateValidatorBondUnbond(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec) error {
+	delegation, found := k.GetDelegation(ctx, delAddr, valAddr)
+	if !found || !delegation.ValidatorBond {
+		return nil
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound
+	}
+
+	if k.CheckExceedsValidatorBondCapAfterUnbond(ctx, validator, shares) {
+		return types.ErrInsufficientValidatorBondShares
+	}
+
+	return nil
+}
+
+// getValidatorLiquidTokens returns the tokens backing the liquid shares of a
+// validator.
+func (k Keeper) getValidatorLiquidTokens(validator types.Validator) math.Int {
+	if !validator.DelegatorShares.IsPositive() || validator.LiquidShares.IsNil() {
+		return sdk.ZeroInt()
+	}
+
+	return validator.TokensFromShares(validator.LiquidShares).TruncateInt()
+}
+
+// slashTotalLiquidStakedTokens decreases the total liquid staked tokens by the
+// liquid tokens slashed from a validator. The liquid tokens of a validator
+// that is not bonded are not part of the total.
+func (k Keeper) slashTotalLiquidStakedTokens(ctx sdk.Context, before, after types.Validator) {
+	if !before.IsBonded() {
+		return
+	}
+
+	k.removeTotalLiquidStakedTokens(ctx, k.getValidatorLiquidTokens(before).Sub(k.getValidatorLiquidTokens(after)))
+}
+
+// addTotalLiquidStakedTokens increases the total liquid staked tokens by the
+// liquid tokens of a validator becoming bonded, without checking the global
+// liquid staking cap.
+func (k Keeper) addTotalLiquidStakedTokens(ctx sdk.Context, tokens math.Int) {
+	if !tokens.IsPositive() {
+		return
+	}
+
+	k.SetTotalLiquidStakedTokens(ctx, k.GetTotalLiquidStakedTokens(ctx).Add(tokens))
+}
+
+// removeTotalLiquidStakedTokens decreases the total liquid staked tokens by
+// the liquid tokens of a bonded validator that are slashed or no longer
+// bonded.
+func (k Keeper) removeTotalLiquidStakedTokens(ctx sdk.Context, tokens math.Int) {
+	if !tokens.IsPositive() {
+		return
+	}
+
+	// the total may be lower than the sum of the liquid tokens of the
+	// validators because of rounding
+	totalLiquidStaked := k.GetTotalLiquidStakedTokens(ctx)
+	k.SetTotalLiquidStakedTokens(ctx, totalLiquidStaked.Sub(sdk.MinInt(tokens, totalLiquidStaked)))
+}
@@ -0,0 +1,447 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// setupLiquidStakeTest creates a bonded validator self-delegating the given
+// tokens.
+func setupLiquidStakeTest(t *testing.T, delTokens math.Int) (*simapp.SimApp, sdk.Context, types.MsgServer, sdk.AccAddress, sdk.ValAddress) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+	delAddr := simapp.AddTestAddrsIncremental(app, ctx, 1, delTokens)[0]
+	valAddr := sdk.ValAddress(delAddr)
+
+	msg, err := types.NewMsgCreateValidator(
+		valAddr, simapp.CreateTestPubKeys(1)[0], sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), delTokens),
+		types.Description{Moniker: "liquid"}, types.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+	)
+	require.NoError(t, err)
+	_, err = msgServer.CreateValidator(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	_, err = app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.True(t, validator.IsBonded())
+
+	return app, ctx, msgServer, delAddr, valAddr
+}
+
+// setupRedelegationSource creates a second bonded validator, the source of a
+// redelegation, and a delegator funded with the given tokens.
+func setupRedelegationSource(t *testing.T, app *simapp.SimApp, ctx sdk.Context, msgServer types.MsgServer, delTokens math.Int) (sdk.ValAddress, sdk.AccAddress) {
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, delTokens)
+	srcValAddr := sdk.ValAddress(addrs[1])
+
+	msg, err := types.NewMsgCreateValidator(
+		srcValAddr, simapp.CreateTestPubKeys(2)[1], sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), delTokens),
+		types.Description{Moniker: "source"}, types.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+	)
+	require.NoError(t, err)
+	_, err = msgServer.CreateValidator(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	_, err = app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+
+	return srcValAddr, addrs[2]
+}
+
+func TestTokenizeSharesAndRedeemTokens(t *testing.T) {
+	delTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	app, ctx, msgServer, delAddr, valAddr := setupLiquidStakeTest(t, delTokens)
+	goCtx := sdk.WrapSDKContext(ctx)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	owner := sdk.AccAddress("owner_______________")
+
+	tokenizeTokens := delTokens.QuoRaw(2)
+	res, err := msgServer.TokenizeShares(goCtx, types.NewMsgTokenizeShares(delAddr, valAddr, sdk.NewCoin(bondDenom, tokenizeTokens), owner))
+	require.NoError(t, err)
+
+	// the record backs the share tokens minted to the delegator
+	record, err := app.StakingKeeper.GetTokenizeShareRecordByDenom(ctx, res.Amount.Denom)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), record.Id)
+	require.Equal(t, owner.String(), record.Owner)
+	require.Equal(t, valAddr.String(), record.Validator)
+	require.Equal(t, res.Amount, app.BankKeeper.GetBalance(ctx, delAddr, record.GetShareTokenDenom()))
+	require.Equal(t, tokenizeTokens, res.Amount.Amount)
+
+	// the tokenized shares are moved to the record module account
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(delTokens.Sub(tokenizeTokens)), delegation.Shares)
+
+	recordDelegation, found := app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(tokenizeTokens), recordDelegation.Shares)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(tokenizeTokens), validator.LiquidShares)
+	require.Equal(t, tokenizeTokens, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	// the record is queryable by id, denom and owner
+	querier := keeper.Querier{Keeper: app.StakingKeeper}
+	byID, err := querier.TokenizeShareRecordById(goCtx, &types.QueryTokenizeShareRecordByIdRequest{Id: record.Id})
+	require.NoError(t, err)
+	require.Equal(t, record, byID.Record)
+	byDenom, err := querier.TokenizeShareRecordByDenom(goCtx, &types.QueryTokenizeShareRecordByDenomRequest{Denom: res.Amount.Denom})
+	require.NoError(t, err)
+	require.Equal(t, record, byDenom.Record)
+	owned, err := querier.TokenizeShareRecordsOwned(goCtx, &types.QueryTokenizeShareRecordsOwnedRequest{Owner: owner.String()})
+	require.NoError(t, err)
+	require.Equal(t, []types.TokenizeShareRecord{record}, owned.Records)
+	all, err := querier.AllTokenizeShareRecords(goCtx, &types.QueryAllTokenizeShareRecordsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.TokenizeShareRecord{record}, all.Records)
+	totalLiquidStaked, err := querier.TotalLiquidStaked(goCtx, &types.QueryTotalLiquidStakedRequest{})
+	require.NoError(t, err)
+	require.Equal(t, tokenizeTokens.String(), totalLiquidStaked.Tokens)
+
+	// the share tokens are transferable and redeemable by their holder
+	redeemer := sdk.AccAddress("redeemer____________")
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, delAddr, redeemer, sdk.NewCoins(res.Amount)))
+
+	redeemed := sdk.NewCoin(res.Amount.Denom, res.Amount.Amount.QuoRaw(2))
+	_, err = msgServer.RedeemTokensForShares(goCtx, types.NewMsgRedeemTokensForShares(redeemer, redeemed))
+	require.NoError(t, err)
+
+	redeemerDelegation, found := app.StakingKeeper.GetDelegation(ctx, redeemer, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(redeemed.Amount), redeemerDelegation.Shares)
+	require.Equal(t, tokenizeTokens.Sub(redeemed.Amount), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	// the record is removed once all its share tokens are redeemed
+	_, err = msgServer.RedeemTokensForShares(goCtx, types.NewMsgRedeemTokensForShares(redeemer, res.Amount.Sub(redeemed)))
+	require.NoError(t, err)
+
+	_, err = app.StakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+	require.ErrorIs(t, err, types.ErrTokenizeShareRecordNotExists)
+	_, found = app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	require.False(t, found)
+	require.True(t, app.BankKeeper.GetSupply(ctx, record.GetShareTokenDenom()).IsZero())
+
+	validator, found = app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.True(t, validator.LiquidShares.IsZero())
+	require.True(t, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx).IsZero())
+
+	// redeeming share tokens without a record fails
+	_, err = msgServer.RedeemTokensForShares(goCtx, types.NewMsgRedeemTokensForShares(redeemer, sdk.NewInt64Coin(record.GetShareTokenDenom(), 1)))
+	require.Error(t, err)
+}
+
+func TestTokenizeSharesFailures(t *testing.T) {
+	delTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	app, ctx, msgServer, delAddr, valAddr := setupLiquidStakeTest(t, delTokens)
+	goCtx := sdk.WrapSDKContext(ctx)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	testCases := []struct {
+		name        string
+		malleate    func(params *types.Params)
+		amount      sdk.Coin
+		expectedErr error
+	}{
+		{
+			name:        "not bond denom",
+			amount:      sdk.NewCoin("foo", delTokens),
+			expectedErr: types.ErrOnlyBondDenomAllowedForTokenize,
+		},
+		{
+			name:        "more than the delegation",
+			amount:      sdk.NewCoin(bondDenom, delTokens.MulRaw(2)),
+			expectedErr: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "global liquid staking cap exceeded",
+			malleate: func(params *types.Params) {
+				params.GlobalLiquidStakingCap = sdk.NewDecWithPrec(1, 2)
+			},
+			amount:      sdk.NewCoin(bondDenom, delTokens),
+			expectedErr: types.ErrGlobalLiquidStakingCapExceeded,
+		},
+		{
+			name: "validator liquid staking cap exceeded",
+			malleate: func(params *types.Params) {
+				params.ValidatorLiquidStakingCap = sdk.NewDecWithPrec(1, 2)
+			},
+			amount:      sdk.NewCoin(bondDenom, delTokens),
+			expectedErr: types.ErrValLiquidStakingCapExceeded,
+		},
+		{
+			name: "validator bond cap exceeded",
+			malleate: func(params *types.Params) {
+				params.ValidatorBondFactor = sdk.NewDec(250)
+			},
+			amount:      sdk.NewCoin(bondDenom, delTokens),
+			expectedErr: types.ErrInsufficientValidatorBondShares,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cacheCtx, _ := ctx.CacheContext()
+			params := app.StakingKeeper.GetParams(cacheCtx)
+			if tc.malleate != nil {
+				tc.malleate(&params)
+			}
+			app.StakingKeeper.SetParams(cacheCtx, params)
+
+			_, err := msgServer.TokenizeShares(sdk.WrapSDKContext(cacheCtx), types.NewMsgTokenizeShares(delAddr, valAddr, tc.amount, delAddr))
+			require.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+
+	_, err := msgServer.TokenizeShares(goCtx, types.NewMsgTokenizeShares(delAddr, valAddr, sdk.NewCoin(bondDenom, delTokens), delAddr))
+	require.NoError(t, err)
+}
+
+func TestTokenizeSharesRedelegationInProgress(t *testing.T) {
+	delTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	app, ctx, msgServer, _, valAddr := setupLiquidStakeTest(t, delTokens)
+	goCtx := sdk.WrapSDKContext(ctx)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	srcValAddr, redelAddr := setupRedelegationSource(t, app, ctx, msgServer, delTokens)
+	_, err := msgServer.Delegate(goCtx, types.NewMsgDelegate(redelAddr, srcValAddr, sdk.NewCoin(bondDenom, delTokens)))
+	require.NoError(t, err)
+	_, err = msgServer.BeginRedelegate(goCtx, types.NewMsgBeginRedelegate(redelAddr, srcValAddr, valAddr, sdk.NewCoin(bondDenom, delTokens)))
+	require.NoError(t, err)
+
+	// the redelegated shares cannot be tokenized until the redelegation
+	// completes
+	_, err = msgServer.TokenizeShares(goCtx, types.NewMsgTokenizeShares(redelAddr, valAddr, sdk.NewCoin(bondDenom, delTokens), redelAddr))
+	require.ErrorIs(t, err, types.ErrRedelegationInProgress)
+
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(app.StakingKeeper.UnbondingTime(ctx)))
+	app.StakingKeeper.BlockValidatorUpdates(ctx)
+
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(redelAddr, valAddr, sdk.NewCoin(bondDenom, delTokens), redelAddr))
+	require.NoError(t, err)
+}
+
+func TestTokenizeSharesUnbondedValidator(t *testing.T) {
+	delTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	app, ctx, msgServer, delAddr, valAddr := setupLiquidStakeTest(t, delTokens)
+	goCtx := sdk.WrapSDKContext(ctx)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	// jail the validator so it is no longer bonded
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+	app.StakingKeeper.Jail(ctx, consAddr)
+	_, err = app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	validator, found = app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.False(t, validator.IsBonded())
+
+	// the global liquid staking cap only accounts for the bonded tokens
+	params := app.StakingKeeper.GetParams(ctx)
+	params.GlobalLiquidStakingCap = sdk.NewDecWithPrec(1, 2)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	res, err := msgServer.TokenizeShares(goCtx, types.NewMsgTokenizeShares(delAddr, valAddr, sdk.NewCoin(bondDenom, delTokens), delAddr))
+	require.NoError(t, err)
+	require.True(t, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx).IsZero())
+
+	_, err = msgServer.RedeemTokensForShares(goCtx, types.NewMsgRedeemTokensForShares(delAddr, res.Amount))
+	require.NoError(t, err)
+	require.True(t, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx).IsZero())
+}
+
+func TestTotalLiquidStakedTokensValidatorStatusChange(t *testing.T) {
+	delTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	app, ctx, msgServer, delAddr, valAddr := setupLiquidStakeTest(t, delTokens)
+	goCtx := sdk.WrapSDKContext(ctx)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+
+	setBonded := func(bonded bool) {
+		if bonded {
+			app.StakingKeeper.Unjail(ctx, consAddr)
+		} else {
+			app.StakingKeeper.Jail(ctx, consAddr)
+		}
+		_, err := app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+		require.NoError(t, err)
+		validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+		require.True(t, found)
+		require.Equal(t, bonded, validator.IsBonded())
+	}
+
+	// the tokens tokenized while bonded leave the total once the validator is
+	// jailed, and the redemption doesn't decrease it again
+	tokenizeTokens := delTokens.QuoRaw(4)
+	res, err := msgServer.TokenizeShares(goCtx, types.NewMsgTokenizeShares(delAddr, valAddr, sdk.NewCoin(bondDenom, tokenizeTokens), delAddr))
+	require.NoError(t, err)
+	require.Equal(t, tokenizeTokens, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	setBonded(false)
+	require.True(t, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx).IsZero())
+
+	_, err = msgServer.RedeemTokensForShares(goCtx, types.NewMsgRedeemTokensForShares(delAddr, res.Amount))
+	require.NoError(t, err)
+	require.True(t, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx).IsZero())
+
+	// the tokens tokenized while unbonded enter the total once the validator
+	// is bonded again, and leave it when redeemed
+	res, err = msgServer.TokenizeShares(goCtx, types.NewMsgTokenizeShares(delAddr, valAddr, sdk.NewCoin(bondDenom, tokenizeTokens), delAddr))
+	require.NoError(t, err)
+	require.True(t, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx).IsZero())
+
+	setBonded(true)
+	require.Equal(t, tokenizeTokens, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	_, err = msgServer.RedeemTokensForShares(goCtx, types.NewMsgRedeemTokensForShares(delAddr, res.Amount))
+	require.NoError(t, err)
+	require.True(t, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx).IsZero())
+
+	// slashing a validator that is not bonded leaves the total unchanged
+	res, err = msgServer.TokenizeShares(goCtx, types.NewMsgTokenizeShares(delAddr, valAddr, sdk.NewCoin(bondDenom, tokenizeTokens), delAddr))
+	require.NoError(t, err)
+	setBonded(false)
+	app.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), 1, sdk.NewDecWithPrec(5, 1))
+	require.True(t, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx).IsZero())
+
+	_, err = msgServer.RedeemTokensForShares(goCtx, types.NewMsgRedeemTokensForShares(delAddr, res.Amount))
+	require.NoError(t, err)
+	require.True(t, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx).IsZero())
+}
+
+func TestValidatorBond(t *testing.T) {
+	delTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	app, ctx, msgServer, delAddr, valAddr := setupLiquidStakeTest(t, delTokens)
+	goCtx := sdk.WrapSDKContext(ctx)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ValidatorBondFactor = sdk.NewDec(2)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// the validator has no validator bond backing liquid shares
+	liquidAddr := simapp.AddTestAddrsIncremental(app, ctx, 2, delTokens)[1]
+	_, err := msgServer.Delegate(goCtx, types.NewMsgDelegate(liquidAddr, valAddr, sdk.NewCoin(bondDenom, delTokens)))
+	require.NoError(t, err)
+
+	_, err = msgServer.TokenizeShares(goCtx, types.NewMsgTokenizeShares(liquidAddr, valAddr, sdk.NewCoin(bondDenom, delTokens), liquidAddr))
+	require.ErrorIs(t, err, types.ErrInsufficientValidatorBondShares)
+
+	// flag the delegation as a validator bond
+	_, err = msgServer.ValidatorBond(goCtx, types.NewMsgValidatorBond(delAddr, valAddr))
+	require.NoError(t, err)
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.True(t, delegation.ValidatorBond)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.Equal(t, delegation.Shares, validator.ValidatorBondShares)
+
+	_, err = msgServer.ValidatorBond(goCtx, types.NewMsgValidatorBond(delAddr, valAddr))
+	require.ErrorIs(t, err, types.ErrValidatorBondAlreadySet)
+
+	// a validator bond delegation cannot be tokenized
+	_, err = msgServer.TokenizeShares(goCtx, types.NewMsgTokenizeShares(delAddr, valAddr, sdk.NewCoin(bondDenom, delTokens), delAddr))
+	require.ErrorIs(t, err, types.ErrValidatorBondNotTokenizable)
+
+	// the validator bond now backs twice its shares of liquid shares
+	_, err = msgServer.TokenizeShares(goCtx, types.NewMsgTokenizeShares(liquidAddr, valAddr, sdk.NewCoin(bondDenom, delTokens), liquidAddr))
+	require.NoError(t, err)
+
+	// the validator bond delegation cannot be undelegated below the liquid
+	// shares it backs
+	_, err = msgServer.Undelegate(goCtx, types.NewMsgUndelegate(delAddr, valAddr, sdk.NewCoin(bondDenom, delTokens)))
+	require.ErrorIs(t, err, types.ErrInsufficientValidatorBondShares)
+
+	_, err = msgServer.Undelegate(goCtx, types.NewMsgUndelegate(delAddr, valAddr, sdk.NewCoin(bondDenom, delTokens.QuoRaw(2))))
+	require.NoError(t, err)
+
+	validator, found = app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(delTokens.QuoRaw(2)), validator.ValidatorBondShares)
+
+	// delegating to a validator bond delegation increases the validator bond
+	_, err = msgServer.Delegate(goCtx, types.NewMsgDelegate(delAddr, valAddr, sdk.NewCoin(bondDenom, delTokens.QuoRaw(2))))
+	require.NoError(t, err)
+
+	validator, found = app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(delTokens), validator.ValidatorBondShares)
+}
+
+func TestSlashRedelegationValidatorBondAtCap(t *testing.T) {
+	delTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	app, ctx, msgServer, delAddr, valAddr := setupLiquidStakeTest(t, delTokens)
+	goCtx := sdk.WrapSDKContext(ctx)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ValidatorBondFactor = sdk.OneDec()
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// the validator bond delegation is received through a redelegation
+	srcValAddr, bondAddr := setupRedelegationSource(t, app, ctx, msgServer, delTokens)
+	bondTokens := delTokens.QuoRaw(2)
+	_, err := msgServer.Delegate(goCtx, types.NewMsgDelegate(bondAddr, srcValAddr, sdk.NewCoin(bondDenom, bondTokens)))
+	require.NoError(t, err)
+	_, err = msgServer.BeginRedelegate(goCtx, types.NewMsgBeginRedelegate(bondAddr, srcValAddr, valAddr, sdk.NewCoin(bondDenom, bondTokens)))
+	require.NoError(t, err)
+	_, err = msgServer.ValidatorBond(goCtx, types.NewMsgValidatorBond(bondAddr, valAddr))
+	require.NoError(t, err)
+
+	// the liquid shares of the validator reach its validator bond shares
+	_, err = msgServer.TokenizeShares(goCtx, types.NewMsgTokenizeShares(delAddr, valAddr, sdk.NewCoin(bondDenom, bondTokens), delAddr))
+	require.NoError(t, err)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.Equal(t, validator.ValidatorBondShares, validator.LiquidShares)
+
+	// the validator bond delegation cannot be undelegated
+	_, err = msgServer.Undelegate(goCtx, types.NewMsgUndelegate(bondAddr, valAddr, sdk.NewCoin(bondDenom, sdk.OneInt())))
+	require.ErrorIs(t, err, types.ErrInsufficientValidatorBondShares)
+
+	// but slashing the source validator unbonds it from the validator bond
+	srcValidator, found := app.StakingKeeper.GetValidator(ctx, srcValAddr)
+	require.True(t, found)
+	consAddr, err := srcValidator.GetConsAddr()
+	require.NoError(t, err)
+	power := app.StakingKeeper.TokensToConsensusPower(ctx, srcValidator.Tokens)
+	infractionHeight := ctx.BlockHeight()
+	ctx = ctx.WithBlockHeight(infractionHeight + 1)
+	require.NotPanics(t, func() {
+		app.StakingKeeper.Slash(ctx, consAddr, infractionHeight, power, sdk.NewDecWithPrec(5, 1))
+	})
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, bondAddr, valAddr)
+	require.True(t, found)
+	require.True(t, delegation.Shares.LT(sdk.NewDecFromInt(bondTokens)))
+
+	validator, found = app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.Equal(t, delegation.Shares, validator.ValidatorBondShares)
+}
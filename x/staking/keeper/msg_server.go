@@ -14,6 +14,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -38,8 +40,8 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 		return nil, err
 	}
 
-	if msg.Commission.Rate.LT(k.MinCommissionRate(ctx)) {
-		return nil, sdkerrors.Wrapf(types.ErrCommissionLTMinRate, "cannot set validator commission to less than minimum rate of %s", k.MinCommissionRate(ctx))
+	if err := msg.Commission.ValidateWithMinRate(k.MinCommissionRate(ctx)); err != nil {
+		return nil, err
 	}
 
 	// check to see if the pubkey or sender has been registered before
@@ -85,6 +87,21 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 		}
 	}
 
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// in epoch mode, the self-delegation is escrowed and the validator is
+	// created at the end of the current epoch
+	if k.shouldQueueForEpoch(ctx) {
+		if err := k.queueEpochMsg(ctx, msg, delegatorAddress, sdk.NewCoins(msg.Value)); err != nil {
+			return nil, err
+		}
+
+		return &types.MsgCreateValidatorResponse{}, nil
+	}
+
 	validator, err := types.NewValidator(valAddr, pk, msg.Description)
 	if err != nil {
 		return nil, err
@@ -100,11 +117,6 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 		return nil, err
 	}
 
-	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
-	if err != nil {
-		return nil, err
-	}
-
 	validator.MinSelfDelegation = msg.MinSelfDelegation
 
 	k.SetValidator(ctx, validator)
@@ -118,8 +130,13 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 
 	// move coins from the msg.Address account to a (self-delegation) delegator account
 	// the validator account and global shares are updated within here
-	// NOTE source will always be from a wallet which are unbonded
-	_, err = k.Keeper.Delegate(ctx, delegatorAddress, msg.Value.Amount, types.Unbonded, validator, true)
+	// NOTE source will always be from a wallet which are unbonded, or from the
+	// epoch delegation pool for a buffered message
+	if k.isExecutingEpochMsg(ctx) {
+		_, err = k.delegateFromEpochPool(ctx, delegatorAddress, msg.Value.Amount, validator)
+	} else {
+		_, err = k.Keeper.Delegate(ctx, delegatorAddress, msg.Value.Amount, types.Unbonded, validator, true)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -153,6 +170,14 @@ func (k msgServer) EditValidator(goCtx context.Context, msg *types.MsgEditValida
 		return nil, types.ErrNoValidatorFound
 	}
 
+	if k.shouldQueueForEpoch(ctx) {
+		if err := k.queueEpochMsg(ctx, msg, sdk.AccAddress(valAddr), nil); err != nil {
+			return nil, err
+		}
+
+		return &types.MsgEditValidatorResponse{}, nil
+	}
+
 	// replace all editable fields (clients should autofill existing values)
 	description, err := validator.Description.UpdateDescription(msg.Description)
 	if err != nil {
@@ -230,8 +255,24 @@ func (k msgServer) Delegate(goCtx context.Context, msg *types.MsgDelegate) (*typ
 		)
 	}
 
-	// NOTE: source funds are always unbonded
-	newShares, err := k.Keeper.Delegate(ctx, delegatorAddress, msg.Amount.Amount, types.Unbonded, validator, true)
+	// in epoch mode, the tokens are escrowed and delegated at the end of the
+	// current epoch
+	if k.shouldQueueForEpoch(ctx) {
+		if err := k.queueEpochMsg(ctx, msg, delegatorAddress, sdk.NewCoins(msg.Amount)); err != nil {
+			return nil, err
+		}
+
+		return &types.MsgDelegateResponse{}, nil
+	}
+
+	// NOTE: source funds are always unbonded, or escrowed in the epoch
+	// delegation pool for a buffered message
+	var newShares sdk.Dec
+	if k.isExecutingEpochMsg(ctx) {
+		newShares, err = k.delegateFromEpochPool(ctx, delegatorAddress, msg.Amount.Amount, validator)
+	} else {
+		newShares, err = k.Keeper.Delegate(ctx, delegatorAddress, msg.Amount.Amount, types.Unbonded, validator, true)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -282,6 +323,11 @@ func (k msgServer) BeginRedelegate(goCtx context.Context, msg *types.MsgBeginRed
 		return nil, err
 	}
 
+	// the validator bond must keep covering the liquid shares of the validator
+	if err := k.validateValidatorBondUnbond(ctx, delegatorAddress, valSrcAddr, shares); err != nil {
+		return nil, err
+	}
+
 	bondDenom := k.BondDenom(ctx)
 	if msg.Amount.Denom != bondDenom {
 		return nil, sdkerrors.Wrapf(
@@ -294,6 +340,16 @@ func (k msgServer) BeginRedelegate(goCtx context.Context, msg *types.MsgBeginRed
 		return nil, err
 	}
 
+	// in epoch mode, the completion time is only known at the end of the
+	// current epoch
+	if k.shouldQueueForEpoch(ctx) {
+		if err := k.queueEpochMsg(ctx, msg, delegatorAddress, nil); err != nil {
+			return nil, err
+		}
+
+		return &types.MsgBeginRedelegateResponse{}, nil
+	}
+
 	completionTime, err := k.BeginRedelegation(
 		ctx, delegatorAddress, valSrcAddr, valDstAddr, shares,
 	)
@@ -351,6 +407,11 @@ func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgUndelegate) (
 		return nil, err
 	}
 
+	// the validator bond must keep covering the liquid shares of the validator
+	if err := k.validateValidatorBondUnbond(ctx, delegatorAddress, addr, shares); err != nil {
+		return nil, err
+	}
+
 	bondDenom := k.BondDenom(ctx)
 	if msg.Amount.Denom != bondDenom {
 		return nil, sdkerrors.Wrapf(
@@ -358,6 +419,16 @@ func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgUndelegate) (
 		)
 	}
 
+	// in epoch mode, the completion time is only known at the end of the
+	// current epoch
+	if k.shouldQueueForEpoch(ctx) {
+		if err := k.queueEpochMsg(ctx, msg, delegatorAddress, nil); err != nil {
+			return nil, err
+		}
+
+		return &types.MsgUndelegateResponse{}, nil
+	}
+
 	completionTime, err := k.Keeper.Undelegate(ctx, delegatorAddress, addr, shares)
 	if err != nil {
 		return nil, err
@@ -464,6 +535,14 @@ func (k msgServer) CancelUnbondingDelegation(goCtx context.Context, msg *types.M
 		return nil, sdkerrors.ErrInvalidRequest.Wrap("unbonding delegation is already processed")
 	}
 
+	if k.shouldQueueForEpoch(ctx) {
+		if err := k.queueEpochMsg(ctx, msg, delegatorAddress, nil); err != nil {
+			return nil, err
+		}
+
+		return &types.MsgCancelUnbondingDelegationResponse{}, nil
+	}
+
 	// delegate back the unbonding delegation amount to the validator
 	_, err = k.Keeper.Delegate(ctx, delegatorAddress, msg.Amount.Amount, types.Unbonding, validator, false)
 	if err != nil {
@@ -499,3 +578,419 @@ func (k msgServer) CancelUnbondingDelegation(goCtx context.Context, msg *types.M
 
 	return &types.MsgCancelUnbondingDelegationResponse{}, nil
 }
+
+// TokenizeShares defines a method for converting a delegation into share
+// tokens backed by a tokenize share record
+func (k msgServer) TokenizeShares(goCtx context.Context, msg *types.MsgTokenizeShares) (*types.MsgTokenizeSharesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.TokenizedShareOwner); err != nil {
+		return nil, err
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	delegation, found := k.GetDelegation(ctx, delegatorAddress, valAddr)
+	if !found {
+		return nil, types.ErrNoDelegation
+	}
+
+	// the validator bond delegations back the liquid shares of the validator
+	if delegation.ValidatorBond {
+		return nil, types.ErrValidatorBondNotTokenizable
+	}
+
+	// the shares received through a redelegation must stay slashable for an
+	// infraction of the source validator until the redelegation completes
+	if k.HasReceivingRedelegation(ctx, delegatorAddress, valAddr) {
+		return nil, types.ErrRedelegationInProgress
+	}
+
+	bondDenom := k.BondDenom(ctx)
+	if msg.Amount.Denom != bondDenom {
+		return nil, types.ErrOnlyBondDenomAllowedForTokenize
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delegatorAddress, valAddr, msg.Amount.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	// only the delegated free tokens of a vesting account can be tokenized, as
+	// the share tokens are not subject to the vesting schedule
+	if acc, ok := k.authKeeper.GetAccount(ctx, delegatorAddress).(vesting.VestingAccount); ok {
+		delFree := acc.GetDelegatedFree().AmountOf(bondDenom)
+		if delFree.LT(msg.Amount.Amount) {
+			return nil, types.ErrExceedingFreeVestingDelegations
+		}
+	}
+
+	// the global liquid staking cap only accounts for the bonded tokens
+	if validator.IsBonded() && k.CheckExceedsGlobalLiquidStakingCap(ctx, msg.Amount.Amount, true) {
+		return nil, types.ErrGlobalLiquidStakingCapExceeded
+	}
+	if k.CheckExceedsValidatorBondCap(ctx, validator, shares) {
+		return nil, types.ErrInsufficientValidatorBondShares
+	}
+	if k.CheckExceedsValidatorLiquidStakingCap(ctx, validator, shares) {
+		return nil, types.ErrValLiquidStakingCapExceeded
+	}
+
+	recordID := k.GetLastTokenizeShareRecordID(ctx) + 1
+	k.SetLastTokenizeShareRecordID(ctx, recordID)
+
+	record := types.TokenizeShareRecord{
+		Id:            recordID,
+		Owner:         msg.TokenizedShareOwner,
+		ModuleAccount: types.GetTokenizeShareRecordModuleAccount(recordID),
+		Validator:     msg.ValidatorAddress,
+	}
+	if err := k.AddTokenizeShareRecord(ctx, record); err != nil {
+		return nil, err
+	}
+
+	// unbond the delegation and delegate the tokens from the record module
+	// account, skipping the unbonding period
+	returnAmount, err := k.Unbond(ctx, delegatorAddress, valAddr, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	if validator.IsBonded() {
+		k.bondedTokensToNotBonded(ctx, returnAmount)
+	}
+
+	// NOTE: UndelegateCoinsFromModuleToAccount tracks the undelegation of a
+	// vesting account
+	returnCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, returnAmount))
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delegatorAddress, returnCoins); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.SendCoins(ctx, delegatorAddress, record.GetModuleAddress(), returnCoins); err != nil {
+		return nil, err
+	}
+
+	// the validator must be reloaded as Unbond modified its tokens and shares
+	validator, found = k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	newShares, err := k.Keeper.Delegate(ctx, record.GetModuleAddress(), returnAmount, types.Unbonded, validator, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if validator.IsBonded() {
+		if err := k.SafelyIncreaseTotalLiquidStakedTokens(ctx, returnAmount, true); err != nil {
+			return nil, err
+		}
+	}
+	if err := k.SafelyIncreaseValidatorLiquidShares(ctx, valAddr, newShares); err != nil {
+		return nil, err
+	}
+
+	// one share token is minted for each share of the record delegation
+	shareToken := sdk.NewCoin(record.GetShareTokenDenom(), newShares.TruncateInt())
+	if err := k.bankKeeper.MintCoins(ctx, types.TokenizeSharePoolName, sdk.NewCoins(shareToken)); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.TokenizeSharePoolName, delegatorAddress, sdk.NewCoins(shareToken)); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTokenizeShares,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyShareOwner, msg.TokenizedShareOwner),
+			sdk.NewAttribute(types.AttributeKeyShareRecordID, strconv.FormatUint(record.Id, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+		),
+	})
+
+	return &types.MsgTokenizeSharesResponse{
+		Amount: shareToken,
+	}, nil
+}
+
+// RedeemTokensForShares defines a method for converting share tokens back into
+// a delegation
+func (k msgServer) RedeemTokensForShares(goCtx context.Context, msg *types.MsgRedeemTokensForShares) (*types.MsgRedeemTokensForSharesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	shareToken := msg.Amount
+	balance := k.bankKeeper.GetBalance(ctx, delegatorAddress, shareToken.Denom)
+	if balance.Amount.LT(shareToken.Amount) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is smaller than %s", balance, shareToken)
+	}
+
+	record, err := k.GetTokenizeShareRecordByDenom(ctx, shareToken.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	delegation, found := k.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	if !found {
+		return nil, types.ErrNoDelegation
+	}
+
+	// redeeming all the share tokens of the record redeems the full record
+	// delegation, including the decimal shares lost by the truncation of the
+	// share tokens
+	shares := sdk.NewDecFromInt(shareToken.Amount)
+	if shareToken.Amount.Equal(delegation.Shares.TruncateInt()) {
+		shares = delegation.Shares
+	}
+	if shares.GT(delegation.Shares) {
+		return nil, types.ErrNotEnoughDelegationShares
+	}
+
+	// pay the pending rewards of the record to its owner before the record
+	// delegation is modified
+	if err := k.BeforeTokenizeShareRecordRedeemed(ctx, record.Id); err != nil {
+		return nil, err
+	}
+
+	returnAmount, err := k.Unbond(ctx, record.GetModuleAddress(), valAddr, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	if validator.IsBonded() {
+		k.bondedTokensToNotBonded(ctx, returnAmount)
+	}
+
+	// the record is removed once its whole delegation is redeemed
+	if _, found := k.GetDelegation(ctx, record.GetModuleAddress(), valAddr); !found {
+		if err := k.DeleteTokenizeShareRecord(ctx, record.Id); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.DecreaseValidatorLiquidShares(ctx, valAddr, shares); err != nil {
+		return nil, err
+	}
+	if validator.IsBonded() {
+		k.removeTotalLiquidStakedTokens(ctx, returnAmount)
+	}
+
+	// burn the share tokens and send the unbonded tokens to the delegator
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delegatorAddress, types.TokenizeSharePoolName, sdk.NewCoins(shareToken)); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.TokenizeSharePoolName, sdk.NewCoins(shareToken)); err != nil {
+		return nil, err
+	}
+
+	returnCoin := sdk.NewCoin(k.BondDenom(ctx), returnAmount)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delegatorAddress, sdk.NewCoins(returnCoin)); err != nil {
+		return nil, err
+	}
+
+	// the validator must be reloaded as Unbond modified its tokens and shares
+	validator, found = k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	// NOTE: DelegateCoinsFromAccountToModule tracks the delegation of a
+	// vesting account
+	if _, err := k.Keeper.Delegate(ctx, delegatorAddress, returnAmount, types.Unbonded, validator, true); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRedeemShares,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, record.Validator),
+			sdk.NewAttribute(types.AttributeKeyShareRecordID, strconv.FormatUint(record.Id, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, shareToken.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+		),
+	})
+
+	return &types.MsgRedeemTokensForSharesResponse{
+		Amount: returnCoin,
+	}, nil
+}
+
+// ValidatorBond defines a method for flagging a delegation as a validator bond,
+// allowing the validator to back liquid shares
+func (k msgServer) ValidatorBond(goCtx context.Context, msg *types.MsgValidatorBond) (*types.MsgValidatorBondResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, found := k.GetValidator(ctx, valAddr); !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	delegation, found := k.GetDelegation(ctx, delegatorAddress, valAddr)
+	if !found {
+		return nil, types.ErrNoDelegation
+	}
+
+	if delegation.ValidatorBond {
+		return nil, types.ErrValidatorBondAlreadySet
+	}
+
+	delegation.ValidatorBond = true
+	k.SetDelegation(ctx, delegation)
+
+	if err := k.IncreaseValidatorBondShares(ctx, valAddr, delegation.Shares); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeValidatorBondDelegation,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+		),
+	})
+
+	return &types.MsgValidatorBondResponse{}, nil
+}
+
+// UpdateParams defines a method to perform updation of params exist in x/staking module.
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetParams(ctx, msg.Params)
+
+	// raise the commission of the validators below the new minimum commission rate
+	if err := k.ApplyMinCommissionRate(ctx); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// RotateConsPubKey defines a method for rotating the consensus pubkey of a validator
+func (k msgServer) RotateConsPubKey(goCtx context.Context, msg *types.MsgRotateConsPubKey) (*types.MsgRotateConsPubKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	pk, ok := msg.NewPubkey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "Expecting cryptotypes.PubKey, got %T", pk)
+	}
+
+	// the new pubkey must not be the current or a previous consensus pubkey
+	// of any validator
+	if _, found := k.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(pk)); found {
+		return nil, types.ErrValidatorPubKeyExists
+	}
+
+	cp := ctx.ConsensusParams()
+	if cp != nil && cp.Validator != nil {
+		pkType := pk.Type()
+		hasKeyType := false
+		for _, keyType := range cp.Validator.PubKeyTypes {
+			if pkType == keyType {
+				hasKeyType = true
+				break
+			}
+		}
+		if !hasKeyType {
+			return nil, sdkerrors.Wrapf(
+				types.ErrValidatorPubKeyTypeNotSupported,
+				"got: %s, expected: %s", pk.Type(), cp.Validator.PubKeyTypes,
+			)
+		}
+	}
+
+	if k.exceedsMaxConsPubKeyRotations(ctx, valAddr) {
+		return nil, types.ErrExceedingMaxConsPubKeyRotations
+	}
+
+	// a second rotation before the validator set update would replace the
+	// old consensus pubkey known to the consensus engine
+	if k.hasPendingConsPubKeyRotation(ctx, valAddr) {
+		return nil, types.ErrConsPubKeyRotationPending
+	}
+
+	if k.shouldQueueForEpoch(ctx) {
+		if err := k.queueEpochMsg(ctx, msg, sdk.AccAddress(valAddr), nil); err != nil {
+			return nil, err
+		}
+
+		return &types.MsgRotateConsPubKeyResponse{}, nil
+	}
+
+	if err := k.Keeper.RotateConsPubKey(ctx, validator, pk); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.ValidatorAddress),
+		),
+	)
+
+	return &types.MsgRotateConsPubKeyResponse{}, nil
+}
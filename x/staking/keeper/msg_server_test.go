@@ -144,3 +144,72 @@ func TestCancelUnbondingDelegation(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgUpdateParams(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MaxValidators = 50
+
+	testCases := []struct {
+		Name      string
+		ExceptErr bool
+		authority string
+	}{
+		{
+			Name:      "invalid authority",
+			ExceptErr: true,
+			authority: sdk.AccAddress("invalid_authority___").String(),
+		},
+		{
+			Name:      "success",
+			ExceptErr: false,
+			authority: app.StakingKeeper.GetAuthority(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{
+				Authority: testCase.authority,
+				Params:    params,
+			})
+			if testCase.ExceptErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, params, app.StakingKeeper.GetParams(ctx))
+			}
+		})
+	}
+}
+
+func TestMsgUpdateParamsMinCommissionRate(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+	validators := app.StakingKeeper.GetAllValidators(ctx)
+	require.NotEmpty(t, validators)
+	for _, validator := range validators {
+		require.True(t, validator.Commission.Rate.LT(sdk.NewDecWithPrec(5, 2)))
+	}
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MinCommissionRate = sdk.NewDecWithPrec(5, 2)
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{
+		Authority: app.StakingKeeper.GetAuthority(),
+		Params:    params,
+	})
+	require.NoError(t, err)
+
+	// the validators below the new min commission rate are raised to it
+	for _, validator := range app.StakingKeeper.GetAllValidators(ctx) {
+		require.Equal(t, sdk.NewDecWithPrec(5, 2), validator.Commission.Rate)
+		require.True(t, validator.Commission.MaxRate.GTE(sdk.NewDecWithPrec(5, 2)))
+		require.Equal(t, ctx.BlockHeader().Time, validator.Commission.UpdateTime)
+		require.NoError(t, validator.Commission.Validate())
+	}
+}
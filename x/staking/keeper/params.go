@@ -9,35 +9,30 @@ import (
 )
 
 // UnbondingTime
-func (k Keeper) UnbondingTime(ctx sdk.Context) (res time.Duration) {
-	k.paramstore.Get(ctx, types.KeyUnbondingTime, &res)
-	return
+func (k Keeper) UnbondingTime(ctx sdk.Context) time.Duration {
+	return k.GetParams(ctx).UnbondingTime
 }
 
 // MaxValidators - Maximum number of validators
-func (k Keeper) MaxValidators(ctx sdk.Context) (res uint32) {
-	k.paramstore.Get(ctx, types.KeyMaxValidators, &res)
-	return
+func (k Keeper) MaxValidators(ctx sdk.Context) uint32 {
+	return k.GetParams(ctx).MaxValidators
 }
 
 // MaxEntries - Maximum number of simultaneous unbonding
 // delegations or redelegations (per pair/trio)
-func (k Keeper) MaxEntries(ctx sdk.Context) (res uint32) {
-	k.paramstore.Get(ctx, types.KeyMaxEntries, &res)
-	return
+func (k Keeper) MaxEntries(ctx sdk.Context) uint32 {
+	return k.GetParams(ctx).MaxEntries
 }
 
 // HistoricalEntries = number of historical info entries
 // to persist in store
-func (k Keeper) HistoricalEntries(ctx sdk.Context) (res uint32) {
-	k.paramstore.Get(ctx, types.KeyHistoricalEntries, &res)
-	return
+func (k Keeper) HistoricalEntries(ctx sdk.Context) uint32 {
+	return k.GetParams(ctx).HistoricalEntries
 }
 
 // BondDenom - Bondable coin denomination
-func (k Keeper) BondDenom(ctx sdk.Context) (res string) {
-	k.paramstore.Get(ctx, types.KeyBondDenom, &res)
-	return
+func (k Keeper) BondDenom(ctx sdk.Context) string {
+	return k.GetParams(ctx).BondDenom
 }
 
 // PowerReduction - is the amount of staking tokens required for 1 unit of consensus-engine power.
@@ -49,24 +44,60 @@ func (k Keeper) PowerReduction(ctx sdk.Context) math.Int {
 }
 
 // MinCommissionRate - Minimum validator commission rate
-func (k Keeper) MinCommissionRate(ctx sdk.Context) (res sdk.Dec) {
-	k.paramstore.Get(ctx, types.KeyMinCommissionRate, &res)
-	return
+func (k Keeper) MinCommissionRate(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).MinCommissionRate
 }
 
-// Get all parameters as types.Params
-func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams(
-		k.UnbondingTime(ctx),
-		k.MaxValidators(ctx),
-		k.MaxEntries(ctx),
-		k.HistoricalEntries(ctx),
-		k.BondDenom(ctx),
-		k.MinCommissionRate(ctx),
-	)
+// EpochMode - Whether the staking messages that change the validator set are
+// buffered until the end of the current epoch
+func (k Keeper) EpochMode(ctx sdk.Context) bool {
+	return k.GetParams(ctx).EpochMode
 }
 
-// set the params
+// ValidatorBondFactor - Maximum ratio between the liquid shares and the
+// validator bond shares of a validator, -1 disables the requirement
+func (k Keeper) ValidatorBondFactor(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).ValidatorBondFactor
+}
+
+// GlobalLiquidStakingCap - Maximum fraction of the total bonded tokens that
+// can be liquid staked
+func (k Keeper) GlobalLiquidStakingCap(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).GlobalLiquidStakingCap
+}
+
+// ValidatorLiquidStakingCap - Maximum fraction of the delegator shares of a
+// validator that can be liquid staked
+func (k Keeper) ValidatorLiquidStakingCap(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).ValidatorLiquidStakingCap
+}
+
+// KeyRotationFee - Fee charged to the validator operator for a consensus
+// pubkey rotation
+func (k Keeper) KeyRotationFee(ctx sdk.Context) sdk.Coin {
+	return k.GetParams(ctx).KeyRotationFee
+}
+
+// MaxConsPubKeyRotations - Maximum number of consensus pubkey rotations of a
+// validator within an unbonding period
+func (k Keeper) MaxConsPubKeyRotations(ctx sdk.Context) uint32 {
+	return k.GetParams(ctx).MaxConsPubkeyRotations
+}
+
+// GetParams returns the total set of staking parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the staking parameters.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramstore.SetParamSet(ctx, &params)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ParamsKey, k.cdc.MustMarshal(&params))
 }
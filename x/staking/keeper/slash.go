@@ -127,8 +127,12 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 
 	// Deduct from validator's bonded tokens and update the validator.
 	// Burn the slashed tokens from the pool account and decrease the total supply.
+	validatorBeforeSlash := validator
 	validator = k.RemoveValidatorTokens(ctx, validator, tokensToBurn)
 
+	// the tokens backing the tokenized shares of the validator are slashed too
+	k.slashTotalLiquidStakedTokens(ctx, validatorBeforeSlash, validator)
+
 	switch validator.GetStatus() {
 	case types.Bonded:
 		if err := k.burnBondedTokens(ctx, tokensToBurn); err != nil {
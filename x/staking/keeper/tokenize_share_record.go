@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetLastTokenizeShareRecordID returns the id of the last tokenize share
+// record created.
+func (k Keeper) GetLastTokenizeShareRecordID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastTokenizeShareRecordIDKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastTokenizeShareRecordID sets the id of the last tokenize share record
+// created.
+func (k Keeper) SetLastTokenizeShareRecordID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastTokenizeShareRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetTokenizeShareRecord returns the tokenize share record with the given id.
+func (k Keeper) GetTokenizeShareRecord(ctx sdk.Context, id uint64) (tokenizeShareRecord types.TokenizeShareRecord, err error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetTokenizeShareRecordByIndexKey(id))
+	if bz == nil {
+		return tokenizeShareRecord, sdkerrors.Wrap(types.ErrTokenizeShareRecordNotExists, fmt.Sprintf("tokenizeShareRecord %d does not exist", id))
+	}
+
+	k.cdc.MustUnmarshal(bz, &tokenizeShareRecord)
+	return tokenizeShareRecord, nil
+}
+
+// GetTokenizeShareRecordsByOwner returns the tokenize share records owned by
+// the given address.
+func (k Keeper) GetTokenizeShareRecordsByOwner(ctx sdk.Context, owner sdk.AccAddress) (tokenizeShareRecords []types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	it := sdk.KVStorePrefixIterator(store, types.GetTokenizeShareRecordIDsByOwnerPrefix(owner))
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		tokenizeShareRecord, err := k.GetTokenizeShareRecord(ctx, sdk.BigEndianToUint64(it.Value()))
+		if err != nil {
+			continue
+		}
+		tokenizeShareRecords = append(tokenizeShareRecords, tokenizeShareRecord)
+	}
+	return
+}
+
+// GetTokenizeShareRecordByDenom returns the tokenize share record backing the
+// share tokens of the given denom.
+func (k Keeper) GetTokenizeShareRecordByDenom(ctx sdk.Context, denom string) (types.TokenizeShareRecord, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTokenizeShareRecordIDByDenomKey(denom))
+	if bz == nil {
+		return types.TokenizeShareRecord{}, sdkerrors.Wrap(types.ErrTokenizeShareRecordNotExists, fmt.Sprintf("tokenizeShareRecord %s does not exist", denom))
+	}
+
+	return k.GetTokenizeShareRecord(ctx, sdk.BigEndianToUint64(bz))
+}
+
+// IterateTokenizeShareRecords iterates over all the tokenize share records.
+func (k Keeper) IterateTokenizeShareRecords(ctx sdk.Context, cb func(tokenizeShareRecord types.TokenizeShareRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	it := sdk.KVStorePrefixIterator(store, types.TokenizeShareRecordPrefix)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var tokenizeShareRecord types.TokenizeShareRecord
+		k.cdc.MustUnmarshal(it.Value(), &tokenizeShareRecord)
+
+		if cb(tokenizeShareRecord) {
+			break
+		}
+	}
+}
+
+// GetAllTokenizeShareRecords returns all the tokenize share records.
+func (k Keeper) GetAllTokenizeShareRecords(ctx sdk.Context) (tokenizeShareRecords []types.TokenizeShareRecord) {
+	k.IterateTokenizeShareRecords(ctx, func(tokenizeShareRecord types.TokenizeShareRecord) (stop bool) {
+		tokenizeShareRecords = append(tokenizeShareRecords, tokenizeShareRecord)
+		return false
+	})
+
+	return tokenizeShareRecords
+}
+
+// GetTokenizeShareRecordsStore returns the prefix store of the tokenize share
+// records, used to paginate them.
+func (k Keeper) GetTokenizeShareRecordsStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.TokenizeShareRecordPrefix)
+}
+
+// AddTokenizeShareRecord stores a new tokenize share record along with its
+// owner and denom indexes.
+func (k Keeper) AddTokenizeShareRecord(ctx sdk.Context, tokenizeShareRecord types.TokenizeShareRecord) error {
+	if k.hasTokenizeShareRecord(ctx, tokenizeShareRecord.Id) {
+		return sdkerrors.Wrapf(types.ErrTokenizeShareRecordExists, "tokenizeShareRecord %d already exists", tokenizeShareRecord.Id)
+	}
+
+	k.setTokenizeShareRecord(ctx, tokenizeShareRecord)
+
+	owner, err := sdk.AccAddressFromBech32(tokenizeShareRecord.Owner)
+	if err != nil {
+		return err
+	}
+
+	k.setTokenizeShareRecordWithOwner(ctx, owner, tokenizeShareRecord.Id)
+	k.setTokenizeShareRecordWithDenom(ctx, tokenizeShareRecord.GetShareTokenDenom(), tokenizeShareRecord.Id)
+
+	return nil
+}
+
+// DeleteTokenizeShareRecord removes a tokenize share record along with its
+// owner and denom indexes.
+func (k Keeper) DeleteTokenizeShareRecord(ctx sdk.Context, recordID uint64) error {
+	record, err := k.GetTokenizeShareRecord(ctx, recordID)
+	if err != nil {
+		return err
+	}
+	owner, err := sdk.AccAddressFromBech32(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTokenizeShareRecordByIndexKey(recordID))
+	store.Delete(types.GetTokenizeShareRecordIDByOwnerAndIDKey(owner, recordID))
+	store.Delete(types.GetTokenizeShareRecordIDByDenomKey(record.GetShareTokenDenom()))
+	return nil
+}
+
+func (k Keeper) hasTokenizeShareRecord(ctx sdk.Context, id uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetTokenizeShareRecordByIndexKey(id))
+}
+
+func (k Keeper) setTokenizeShareRecord(ctx sdk.Context, tokenizeShareRecord types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&tokenizeShareRecord)
+
+	store.Set(types.GetTokenizeShareRecordByIndexKey(tokenizeShareRecord.Id), bz)
+}
+
+func (k Keeper) setTokenizeShareRecordWithOwner(ctx sdk.Context, owner sdk.AccAddress, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTokenizeShareRecordIDByOwnerAndIDKey(owner, id), sdk.Uint64ToBigEndian(id))
+}
+
+func (k Keeper) setTokenizeShareRecordWithDenom(ctx sdk.Context, denom string, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTokenizeShareRecordIDByDenomKey(denom), sdk.Uint64ToBigEndian(id))
+}
@@ -120,6 +120,14 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		return nil, err
 	}
 
+	// Retrieve the old consensus pubkeys of the validators that rotated their
+	// consensus pubkey since the last update, these keys are removed from
+	// the consensus engine validator set.
+	rotations, err := k.getPendingConsPubKeyRotations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Iterate over validators, highest power to lowest.
 	iterator := k.ValidatorsPowerStoreIterator(ctx)
 	defer iterator.Close()
@@ -169,8 +177,20 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		newPower := validator.ConsensusPower(powerReduction)
 		newPowerBytes := k.cdc.MustMarshal(&gogotypes.Int64Value{Value: newPower})
 
-		// update the validator set if power has changed
-		if !found || !bytes.Equal(oldPowerBytes, newPowerBytes) {
+		// replace the old consensus pubkey of a bonded validator that rotated
+		// its consensus pubkey
+		oldPubKey, rotated := rotations[valAddrStr]
+		if found && rotated {
+			update, err := abciValidatorUpdateZero(oldPubKey)
+			if err != nil {
+				return nil, err
+			}
+
+			updates = append(updates, update)
+		}
+
+		// update the validator set if power or the consensus pubkey has changed
+		if !found || rotated || !bytes.Equal(oldPowerBytes, newPowerBytes) {
 			updates = append(updates, validator.ABCIValidatorUpdate(powerReduction))
 
 			k.SetLastValidatorPower(ctx, valAddr, newPower)
@@ -195,9 +215,24 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		}
 		amtFromBondedToNotBonded = amtFromBondedToNotBonded.Add(validator.GetTokens())
 		k.DeleteLastValidatorPower(ctx, validator.GetOperator())
+
+		// the consensus engine only knows the old consensus pubkey of a
+		// validator that rotated its consensus pubkey
+		if oldPubKey, rotated := rotations[validator.OperatorAddress]; rotated {
+			update, err := abciValidatorUpdateZero(oldPubKey)
+			if err != nil {
+				return nil, err
+			}
+
+			updates = append(updates, update)
+			continue
+		}
+
 		updates = append(updates, validator.ABCIValidatorUpdateZero())
 	}
 
+	k.deletePendingConsPubKeyRotations(ctx)
+
 	// Update the pools based on the recent updates in the validator set:
 	// - The tokens from the non-bonded candidates that enter the new validator set need to be transferred
 	// to the Bonded pool.
@@ -285,6 +320,9 @@ func (k Keeper) bondValidator(ctx sdk.Context, validator types.Validator) (types
 
 	validator = validator.UpdateStatus(types.Bonded)
 
+	// the liquid tokens of the validator are now bonded
+	k.addTotalLiquidStakedTokens(ctx, k.getValidatorLiquidTokens(validator))
+
 	// save the now bonded validator record to the two referenced stores
 	k.SetValidator(ctx, validator)
 	k.SetValidatorByPowerIndex(ctx, validator)
@@ -316,6 +354,9 @@ func (k Keeper) beginUnbondingValidator(ctx sdk.Context, validator types.Validat
 
 	validator = validator.UpdateStatus(types.Unbonding)
 
+	// the liquid tokens of the validator are no longer bonded
+	k.removeTotalLiquidStakedTokens(ctx, k.getValidatorLiquidTokens(validator))
+
 	// set the unbonding completion time and completion height appropriately
 	validator.UnbondingTime = ctx.BlockHeader().Time.Add(params.UnbondingTime)
 	validator.UnbondingHeight = ctx.BlockHeader().Height
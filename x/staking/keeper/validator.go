@@ -38,8 +38,16 @@ func (k Keeper) GetValidatorByConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress
 	store := ctx.KVStore(k.storeKey)
 
 	opAddr := store.Get(types.GetValidatorByConsAddrKey(consAddr))
-	if opAddr == nil {
-		return validator, false
+	for opAddr == nil {
+		// the consensus address may have been rotated, follow the rotations
+		// up to the current consensus address of the validator
+		newConsAddr, found := k.getNewConsAddr(ctx, consAddr)
+		if !found {
+			return validator, false
+		}
+
+		consAddr = newConsAddr
+		opAddr = store.Get(types.GetValidatorByConsAddrKey(consAddr))
 	}
 
 	return k.GetValidator(ctx, opAddr)
@@ -139,20 +147,40 @@ func (k Keeper) UpdateValidatorCommission(ctx sdk.Context,
 	commission := validator.Commission
 	blockTime := ctx.BlockHeader().Time
 
-	if err := commission.ValidateNewRate(newRate, blockTime); err != nil {
+	if err := commission.ValidateNewRate(newRate, k.MinCommissionRate(ctx), blockTime); err != nil {
 		return commission, err
 	}
 
-	if newRate.LT(k.MinCommissionRate(ctx)) {
-		return commission, fmt.Errorf("cannot set validator commission to less than minimum rate of %s", k.MinCommissionRate(ctx))
-	}
-
 	commission.Rate = newRate
 	commission.UpdateTime = blockTime
 
 	return commission, nil
 }
 
+// ApplyMinCommissionRate raises the commission rate of all the validators below
+// the MinCommissionRate param to that rate, along with their max commission
+// rate if it is also below.
+func (k Keeper) ApplyMinCommissionRate(ctx sdk.Context) error {
+	minRate := k.MinCommissionRate(ctx)
+
+	for _, validator := range k.GetAllValidators(ctx) {
+		commission, updated := validator.Commission.ApplyMinRate(minRate, ctx.BlockHeader().Time)
+		if !updated {
+			continue
+		}
+
+		// call the before-modification hook since we're about to update the commission
+		if err := k.BeforeValidatorModified(ctx, validator.GetOperator()); err != nil {
+			return err
+		}
+
+		validator.Commission = commission
+		k.SetValidator(ctx, validator)
+	}
+
+	return nil
+}
+
 // remove the validator record and associated indexes
 // except for the bonded validator index which is only handled in ApplyAndReturnTendermintUpdates
 // TODO, this function panics, and it's not good.
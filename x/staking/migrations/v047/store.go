@@ -0,0 +1,68 @@
+package v047
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.46 to v0.47.
+// The migration includes:
+//
+// - Moving the params from the x/params subspace to the staking store, setting
+// the EpochMode and liquid staking params to their defaults and the
+// KeyRotationFee param to its default amount in the bond denom and the
+// MaxConsPubKeyRotations param to its default.
+//
+// - Setting the validator bond shares and the liquid shares of all the
+// validators to zero.
+//
+// - Raising the commission rate of the validators below the MinCommissionRate
+// param to that rate.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, paramstore paramtypes.Subspace, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	params := migrateParamsStore(ctx, store, paramstore, cdc)
+	migrateValidators(ctx, store, cdc, params.MinCommissionRate)
+
+	return nil
+}
+
+func migrateParamsStore(ctx sdk.Context, store sdk.KVStore, paramstore paramtypes.Subspace, cdc codec.BinaryCodec) types.Params {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+
+	params := types.DefaultParams()
+	paramstore.GetParamSetIfExists(ctx, &params)
+	params.EpochMode = types.DefaultEpochMode
+	params.ValidatorBondFactor = types.DefaultValidatorBondFactor
+	params.GlobalLiquidStakingCap = types.DefaultGlobalLiquidStakingCap
+	params.ValidatorLiquidStakingCap = types.DefaultValidatorLiquidStakingCap
+	params.KeyRotationFee = sdk.NewCoin(params.BondDenom, types.DefaultKeyRotationFee.Amount)
+	params.MaxConsPubkeyRotations = types.DefaultMaxConsPubKeyRotations
+
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	return params
+}
+
+func migrateValidators(ctx sdk.Context, store sdk.KVStore, cdc codec.BinaryCodec, minCommissionRate sdk.Dec) {
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorsKey)
+
+	var validators []types.Validator
+	for ; iterator.Valid(); iterator.Next() {
+		validators = append(validators, types.MustUnmarshalValidator(cdc, iterator.Value()))
+	}
+	iterator.Close()
+
+	for _, validator := range validators {
+		validator.ValidatorBondShares = sdk.ZeroDec()
+		validator.LiquidShares = sdk.ZeroDec()
+		validator.Commission, _ = validator.Commission.ApplyMinRate(minCommissionRate, ctx.BlockHeader().Time)
+
+		store.Set(types.GetValidatorKey(validator.GetOperator()), types.MustMarshalValidator(cdc, &validator))
+	}
+}
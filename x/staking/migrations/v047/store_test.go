@@ -0,0 +1,79 @@
+package v047_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	v047staking "github.com/cosmos/cosmos-sdk/x/staking/migrations/v047"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	stakingKey := sdk.NewKVStoreKey("staking")
+	tStakingKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(stakingKey, tStakingKey)
+	store := ctx.KVStore(stakingKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, stakingKey, tStakingKey, "staking").
+		WithKeyTable(types.ParamKeyTable())
+
+	// set the v0.46 params, which don't include EpochMode
+	paramstore.Set(ctx, types.KeyUnbondingTime, time.Hour)
+	paramstore.Set(ctx, types.KeyMaxValidators, uint32(50))
+	paramstore.Set(ctx, types.KeyMaxEntries, uint32(7))
+	paramstore.Set(ctx, types.KeyHistoricalEntries, uint32(100))
+	paramstore.Set(ctx, types.KeyBondDenom, "foo")
+	paramstore.Set(ctx, types.KeyMinCommissionRate, sdk.NewDecWithPrec(5, 2))
+	require.False(t, paramstore.Has(ctx, types.KeyEpochMode))
+
+	// set a v0.46 validator, which doesn't have the liquid staking fields
+	valAddr := sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address())
+	validator, err := types.NewValidator(valAddr, ed25519.GenPrivKey().PubKey(), types.Description{})
+	require.NoError(t, err)
+	validator.ValidatorBondShares = sdk.Dec{}
+	validator.LiquidShares = sdk.Dec{}
+	store.Set(types.GetValidatorKey(valAddr), types.MustMarshalValidator(encCfg.Codec, &validator))
+
+	// set a validator with a commission above the min commission rate
+	valAddr2 := sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address())
+	validator2, err := types.NewValidator(valAddr2, ed25519.GenPrivKey().PubKey(), types.Description{})
+	require.NoError(t, err)
+	commission2 := types.NewCommission(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2))
+	validator2.Commission = commission2
+	store.Set(types.GetValidatorKey(valAddr2), types.MustMarshalValidator(encCfg.Codec, &validator2))
+
+	// Run migrations.
+	err = v047staking.MigrateStore(ctx, stakingKey, paramstore, encCfg.Codec)
+	require.NoError(t, err)
+
+	// Make sure the params are moved to the staking store.
+	var params types.Params
+	encCfg.Codec.MustUnmarshal(store.Get(types.ParamsKey), &params)
+	require.Equal(t, types.NewParams(
+		time.Hour, 50, 7, 100, "foo", sdk.NewDecWithPrec(5, 2), types.DefaultEpochMode,
+		types.DefaultValidatorBondFactor, types.DefaultGlobalLiquidStakingCap, types.DefaultValidatorLiquidStakingCap,
+		sdk.NewCoin("foo", types.DefaultKeyRotationFee.Amount), types.DefaultMaxConsPubKeyRotations,
+	), params)
+
+	// Make sure the liquid staking fields of the validators are initialized.
+	validator = types.MustUnmarshalValidator(encCfg.Codec, store.Get(types.GetValidatorKey(valAddr)))
+	require.Equal(t, sdk.ZeroDec(), validator.ValidatorBondShares)
+	require.Equal(t, sdk.ZeroDec(), validator.LiquidShares)
+
+	// Make sure the commission below the min commission rate is raised to it.
+	require.Equal(t, sdk.NewDecWithPrec(5, 2), validator.Commission.Rate)
+	require.Equal(t, sdk.NewDecWithPrec(5, 2), validator.Commission.MaxRate)
+	require.Equal(t, sdk.ZeroDec(), validator.Commission.MaxChangeRate)
+	require.Equal(t, ctx.BlockHeader().Time, validator.Commission.UpdateTime)
+
+	validator2 = types.MustUnmarshalValidator(encCfg.Codec, store.Get(types.GetValidatorKey(valAddr2)))
+	require.Equal(t, commission2, validator2.Commission)
+}
@@ -23,7 +23,12 @@ import (
 )
 
 const (
-	consensusVersion uint64 = 3
+	consensusVersion uint64 = 4
+)
+
+// Module init related flags
+const (
+	FlagDelegationHistoryIndex = "x-staking-delegation-history-index"
 )
 
 var (
@@ -106,6 +111,11 @@ func NewAppModule(cdc codec.Codec, keeper keeper.Keeper, ak types.AccountKeeper,
 	}
 }
 
+// AddModuleInitFlags implements servertypes.ModuleInitFlags interface.
+func AddModuleInitFlags(startCmd *cobra.Command) {
+	startCmd.Flags().Bool(FlagDelegationHistoryIndex, false, "Run the off-consensus x/staking delegation history index serving the DelegationHistory query")
+}
+
 // Name returns the staking module's name.
 func (AppModule) Name() string {
 	return types.ModuleName
@@ -140,6 +150,7 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	m := keeper.NewMigrator(am.keeper)
 	cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2)
 	cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3)
+	cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4)
 }
 
 // InitGenesis performs genesis initialization for the staking module. It returns
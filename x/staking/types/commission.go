@@ -6,6 +6,7 @@ import (
 	"sigs.k8s.io/yaml"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // NewCommissionRates returns an initialized validator commission rates.
@@ -78,9 +79,24 @@ func (cr CommissionRates) Validate() error {
 	return nil
 }
 
+// ValidateWithMinRate performs the Validate checks of initial commission
+// parameters and checks that the rate is not lower than the minimum commission
+// rate. If validation fails, an SDK error is returned.
+func (cr CommissionRates) ValidateWithMinRate(minRate sdk.Dec) error {
+	if err := cr.Validate(); err != nil {
+		return err
+	}
+
+	if cr.Rate.LT(minRate) {
+		return sdkerrors.Wrapf(ErrCommissionLTMinRate, "cannot set validator commission to less than minimum rate of %s", minRate)
+	}
+
+	return nil
+}
+
 // ValidateNewRate performs basic sanity validation checks of a new commission
 // rate. If validation fails, an SDK error is returned.
-func (c Commission) ValidateNewRate(newRate sdk.Dec, blockTime time.Time) error {
+func (c Commission) ValidateNewRate(newRate, minRate sdk.Dec, blockTime time.Time) error {
 	switch {
 	case blockTime.Sub(c.UpdateTime).Hours() < 24:
 		// new rate cannot be changed more than once within 24 hours
@@ -90,6 +106,10 @@ func (c Commission) ValidateNewRate(newRate sdk.Dec, blockTime time.Time) error
 		// new rate cannot be negative
 		return ErrCommissionNegative
 
+	case newRate.LT(minRate):
+		// new rate cannot be lower than the minimum commission rate
+		return sdkerrors.Wrapf(ErrCommissionLTMinRate, "cannot set validator commission to less than minimum rate of %s", minRate)
+
 	case newRate.GT(c.MaxRate):
 		// new rate cannot be greater than the max rate
 		return ErrCommissionGTMaxRate
@@ -101,3 +121,20 @@ func (c Commission) ValidateNewRate(newRate sdk.Dec, blockTime time.Time) error
 
 	return nil
 }
+
+// ApplyMinRate raises the rate of the commission, and its max rate if needed, to
+// the minimum commission rate. It returns false and leaves the commission
+// unchanged if its rate is not lower than the minimum rate.
+func (c Commission) ApplyMinRate(minRate sdk.Dec, blockTime time.Time) (Commission, bool) {
+	if !c.Rate.LT(minRate) {
+		return c, false
+	}
+
+	c.Rate = minRate
+	if c.MaxRate.LT(minRate) {
+		c.MaxRate = minRate
+	}
+	c.UpdateTime = blockTime
+
+	return c, true
+}
@@ -41,6 +41,7 @@ func TestCommissionValidateNewRate(t *testing.T) {
 	now := time.Now().UTC()
 	c1 := types.NewCommission(sdk.MustNewDecFromStr("0.40"), sdk.MustNewDecFromStr("0.80"), sdk.MustNewDecFromStr("0.10"))
 	c1.UpdateTime = now
+	minRate := sdk.MustNewDecFromStr("0.05")
 
 	testCases := []struct {
 		input     types.Commission
@@ -56,6 +57,8 @@ func TestCommissionValidateNewRate(t *testing.T) {
 		{c1, sdk.MustNewDecFromStr("0.90"), now.Add(48 * time.Hour), true},
 		// invalid new commission rate; new rate > max change rate
 		{c1, sdk.MustNewDecFromStr("0.60"), now.Add(48 * time.Hour), true},
+		// invalid new commission rate; new rate < min rate
+		{c1, sdk.MustNewDecFromStr("0.01"), now.Add(48 * time.Hour), true},
 		// valid commission
 		{c1, sdk.MustNewDecFromStr("0.50"), now.Add(48 * time.Hour), false},
 		// valid commission
@@ -63,7 +66,7 @@ func TestCommissionValidateNewRate(t *testing.T) {
 	}
 
 	for i, tc := range testCases {
-		err := tc.input.ValidateNewRate(tc.newRate, tc.blockTime)
+		err := tc.input.ValidateNewRate(tc.newRate, minRate, tc.blockTime)
 		require.Equal(
 			t, tc.expectErr, err != nil,
 			"unexpected result; tc #%d, input: %v, newRate: %s, blockTime: %s",
@@ -71,3 +74,49 @@ func TestCommissionValidateNewRate(t *testing.T) {
 		)
 	}
 }
+
+func TestCommissionValidateWithMinRate(t *testing.T) {
+	minRate := sdk.MustNewDecFromStr("0.05")
+
+	testCases := []struct {
+		input     types.CommissionRates
+		expectErr bool
+	}{
+		// invalid commission; rate > max rate
+		{types.NewCommissionRates(sdk.MustNewDecFromStr("0.75"), sdk.MustNewDecFromStr("0.50"), sdk.ZeroDec()), true},
+		// invalid commission; rate < min rate
+		{types.NewCommissionRates(sdk.MustNewDecFromStr("0.01"), sdk.OneDec(), sdk.MustNewDecFromStr("0.10")), true},
+		// valid commission; rate = min rate
+		{types.NewCommissionRates(minRate, sdk.OneDec(), sdk.MustNewDecFromStr("0.10")), false},
+		// valid commission
+		{types.NewCommissionRates(sdk.MustNewDecFromStr("0.20"), sdk.OneDec(), sdk.MustNewDecFromStr("0.10")), false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.input.ValidateWithMinRate(minRate)
+		require.Equal(t, tc.expectErr, err != nil, "unexpected result; tc #%d, input: %v", i, tc.input)
+	}
+}
+
+func TestCommissionApplyMinRate(t *testing.T) {
+	now := time.Now().UTC()
+	minRate := sdk.MustNewDecFromStr("0.10")
+
+	// rate and max rate below the min rate
+	c := types.NewCommission(sdk.MustNewDecFromStr("0.01"), sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.01"))
+	c, updated := c.ApplyMinRate(minRate, now)
+	require.True(t, updated)
+	require.Equal(t, types.NewCommissionWithTime(minRate, minRate, sdk.MustNewDecFromStr("0.01"), now), c)
+
+	// rate below the min rate
+	c = types.NewCommission(sdk.MustNewDecFromStr("0.01"), sdk.MustNewDecFromStr("0.50"), sdk.MustNewDecFromStr("0.01"))
+	c, updated = c.ApplyMinRate(minRate, now)
+	require.True(t, updated)
+	require.Equal(t, types.NewCommissionWithTime(minRate, sdk.MustNewDecFromStr("0.50"), sdk.MustNewDecFromStr("0.01"), now), c)
+
+	// rate at the min rate
+	c = types.NewCommission(minRate, sdk.MustNewDecFromStr("0.50"), sdk.MustNewDecFromStr("0.01"))
+	c2, updated := c.ApplyMinRate(minRate, now)
+	require.False(t, updated)
+	require.Equal(t, c, c2)
+}
@@ -0,0 +1,18 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = ConsPubKeyRotationHistory{}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (h ConsPubKeyRotationHistory) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var oldPubKey, newPubKey cryptotypes.PubKey
+	if err := unpacker.UnpackAny(h.OldConsPubkey, &oldPubKey); err != nil {
+		return err
+	}
+
+	return unpacker.UnpackAny(h.NewConsPubkey, &newPubKey)
+}
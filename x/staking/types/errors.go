@@ -50,4 +50,17 @@ var (
 	ErrNoHistoricalInfo                = sdkerrors.Register(ModuleName, 38, "no historical info found")
 	ErrEmptyValidatorPubKey            = sdkerrors.Register(ModuleName, 39, "empty validator public key")
 	ErrCommissionLTMinRate             = sdkerrors.Register(ModuleName, 40, "commission cannot be less than min rate")
+	ErrTokenizeShareRecordNotExists    = sdkerrors.Register(ModuleName, 41, "tokenize share record not exists")
+	ErrNotTokenizeShareRecordOwner     = sdkerrors.Register(ModuleName, 42, "not tokenize share record owner")
+	ErrExceedingFreeVestingDelegations = sdkerrors.Register(ModuleName, 43, "trying to exceed vested free delegation for vesting account")
+	ErrOnlyBondDenomAllowedForTokenize = sdkerrors.Register(ModuleName, 44, "only bond denom is allowed for tokenize")
+	ErrInsufficientValidatorBondShares = sdkerrors.Register(ModuleName, 45, "insufficient validator bond shares")
+	ErrValidatorBondNotTokenizable     = sdkerrors.Register(ModuleName, 46, "validator bond delegation is not allowed to tokenize share")
+	ErrGlobalLiquidStakingCapExceeded  = sdkerrors.Register(ModuleName, 47, "delegation or tokenization exceeds the global cap")
+	ErrValLiquidStakingCapExceeded     = sdkerrors.Register(ModuleName, 48, "delegation or tokenization exceeds the validator cap")
+	ErrValidatorBondAlreadySet         = sdkerrors.Register(ModuleName, 49, "delegation is already a validator bond")
+	ErrTokenizeShareRecordExists       = sdkerrors.Register(ModuleName, 50, "tokenize share record already exists")
+	ErrExceedingMaxConsPubKeyRotations = sdkerrors.Register(ModuleName, 51, "exceeding maximum consensus pubkey rotations within unbonding period")
+	ErrConsPubKeyRotationPending       = sdkerrors.Register(ModuleName, 52, "validator already rotated its consensus pubkey in this block")
+	ErrRedelegationInProgress          = sdkerrors.Register(ModuleName, 53, "delegator is not allowed to tokenize shares from validator with a redelegation in progress")
 )
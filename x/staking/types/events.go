@@ -10,6 +10,11 @@ const (
 	EventTypeUnbond                    = "unbond"
 	EventTypeCancelUnbondingDelegation = "cancel_unbonding_delegation"
 	EventTypeRedelegate                = "redelegate"
+	EventTypeQueueEpochMsg             = "queue_epoch_msg"
+	EventTypeTokenizeShares            = "tokenize_shares"
+	EventTypeRedeemShares              = "redeem_shares"
+	EventTypeValidatorBondDelegation   = "validator_bond_delegation"
+	EventTypeRotateConsPubKey          = "rotate_cons_pubkey"
 
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
@@ -20,5 +25,11 @@ const (
 	AttributeKeyCreationHeight    = "creation_height"
 	AttributeKeyCompletionTime    = "completion_time"
 	AttributeKeyNewShares         = "new_shares"
+	AttributeKeyEpochNumber       = "epoch_number"
+	AttributeKeyMsgTypeURL        = "msg_type_url"
+	AttributeKeyShareOwner        = "share_owner"
+	AttributeKeyShareRecordID     = "share_record_id"
+	AttributeKeyOldConsAddress    = "old_cons_address"
+	AttributeKeyNewConsAddress    = "new_cons_address"
 	AttributeValueCategory        = ModuleName
 )
@@ -5,21 +5,24 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState creates a new GenesisState instanc e
 func NewGenesisState(params Params, validators []Validator, delegations []Delegation) *GenesisState {
 	return &GenesisState{
-		Params:      params,
-		Validators:  validators,
-		Delegations: delegations,
+		Params:                  params,
+		Validators:              validators,
+		Delegations:             delegations,
+		TotalLiquidStakedTokens: sdk.ZeroInt(),
 	}
 }
 
 // DefaultGenesisState gets the raw genesis raw message for testing
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params: DefaultParams(),
+		Params:                  DefaultParams(),
+		TotalLiquidStakedTokens: sdk.ZeroInt(),
 	}
 }
 
@@ -42,5 +45,10 @@ func (g GenesisState) UnpackInterfaces(c codectypes.AnyUnpacker) error {
 			return err
 		}
 	}
+	for i := range g.RotationHistory {
+		if err := g.RotationHistory[i].UnpackInterfaces(c); err != nil {
+			return err
+		}
+	}
 	return nil
 }
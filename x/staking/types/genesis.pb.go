@@ -44,6 +44,15 @@ type GenesisState struct {
 	// redelegations defines the redelegations active at genesis.
 	Redelegations []Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
 	Exported      bool           `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// tokenize_share_records defines the tokenize share records active at genesis.
+	TokenizeShareRecords []TokenizeShareRecord `protobuf:"bytes,9,rep,name=tokenize_share_records,json=tokenizeShareRecords,proto3" json:"tokenize_share_records"`
+	// last_tokenize_share_record_id is the id of the last created tokenize share record.
+	LastTokenizeShareRecordId uint64 `protobuf:"varint,10,opt,name=last_tokenize_share_record_id,json=lastTokenizeShareRecordId,proto3" json:"last_tokenize_share_record_id,omitempty"`
+	// total_liquid_staked_tokens is the total amount of tokens liquid staked.
+	TotalLiquidStakedTokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=total_liquid_staked_tokens,json=totalLiquidStakedTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_liquid_staked_tokens"`
+	// rotation_history defines the consensus public key rotations of the
+	// validators.
+	RotationHistory []ConsPubKeyRotationHistory `protobuf:"bytes,12,rep,name=rotation_history,json=rotationHistory,proto3" json:"rotation_history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -128,6 +137,27 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetTokenizeShareRecords() []TokenizeShareRecord {
+	if m != nil {
+		return m.TokenizeShareRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastTokenizeShareRecordId() uint64 {
+	if m != nil {
+		return m.LastTokenizeShareRecordId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetRotationHistory() []ConsPubKeyRotationHistory {
+	if m != nil {
+		return m.RotationHistory
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -179,38 +209,46 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 483 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x41, 0x6e, 0xd3, 0x40,
-	0x14, 0x86, 0x6d, 0x92, 0xa6, 0x61, 0x52, 0x10, 0x1a, 0x52, 0x64, 0xb2, 0x70, 0x42, 0x54, 0xa1,
-	0x08, 0xa8, 0xad, 0x86, 0x1d, 0x62, 0x43, 0x84, 0xa8, 0x40, 0x2c, 0x22, 0x17, 0x10, 0x62, 0x13,
-	0x4d, 0xea, 0xc1, 0xb5, 0xea, 0x78, 0xac, 0x79, 0x2f, 0xa5, 0xdc, 0x80, 0x25, 0x47, 0xe8, 0x21,
-	0x38, 0x44, 0x97, 0x15, 0x2b, 0xc4, 0xa2, 0x42, 0xc9, 0x86, 0x1b, 0xb0, 0x45, 0x9e, 0x99, 0x18,
-	0xa3, 0x60, 0x56, 0xc9, 0xe8, 0x7d, 0xff, 0x37, 0xbf, 0xe4, 0x37, 0x64, 0xe7, 0x50, 0xc0, 0x4c,
-	0x80, 0x0f, 0xc8, 0x8e, 0xe3, 0x34, 0xf2, 0x4f, 0xf6, 0xa6, 0x1c, 0xd9, 0x9e, 0x1f, 0xf1, 0x94,
-	0x43, 0x0c, 0x5e, 0x26, 0x05, 0x0a, 0x7a, 0x4b, 0x53, 0x9e, 0xa1, 0x3c, 0x43, 0x75, 0xda, 0x91,
-	0x88, 0x84, 0x42, 0xfc, 0xfc, 0x9f, 0xa6, 0x3b, 0x55, 0xce, 0x55, 0x5a, 0x53, 0xb7, 0x35, 0x35,
-	0xd1, 0x71, 0x73, 0x81, 0x3a, 0xf4, 0x7f, 0xd5, 0xc9, 0xd6, 0xbe, 0x2e, 0x70, 0x80, 0x0c, 0x39,
-	0x7d, 0x4c, 0x1a, 0x19, 0x93, 0x6c, 0x06, 0x8e, 0xdd, 0xb3, 0x07, 0xad, 0xa1, 0xeb, 0xfd, 0xbb,
-	0x90, 0x37, 0x56, 0xd4, 0xa8, 0x7e, 0x7e, 0xd9, 0xb5, 0x02, 0x93, 0xa1, 0x6f, 0xc9, 0x8d, 0x84,
-	0x01, 0x4e, 0x50, 0x20, 0x4b, 0x26, 0x99, 0xf8, 0xc0, 0xa5, 0x73, 0xa5, 0x67, 0x0f, 0xb6, 0x46,
-	0x5e, 0xce, 0x7d, 0xbf, 0xec, 0xde, 0x8d, 0x62, 0x3c, 0x9a, 0x4f, 0xbd, 0x43, 0x31, 0x33, 0x4d,
-	0xcc, 0xcf, 0x2e, 0x84, 0xc7, 0x3e, 0x7e, 0xcc, 0x38, 0x78, 0xcf, 0x53, 0x0c, 0xae, 0xe7, 0x9e,
-	0x57, 0xb9, 0x66, 0x9c, 0x5b, 0x68, 0x48, 0xb6, 0x95, 0xf9, 0x84, 0x25, 0x71, 0xc8, 0x50, 0x48,
-	0x6d, 0x07, 0xa7, 0xd6, 0xab, 0x0d, 0x5a, 0xc3, 0x7b, 0x55, 0x35, 0x5f, 0x32, 0xc0, 0x37, 0xab,
-	0x8c, 0x52, 0x99, 0xca, 0x37, 0x93, 0xb5, 0x09, 0xd0, 0x7d, 0x42, 0x8a, 0x0b, 0xc0, 0xa9, 0x2b,
-	0xf5, 0x9d, 0x2a, 0x75, 0x11, 0x36, 0xc6, 0x52, 0x94, 0xbe, 0x20, 0xad, 0x90, 0x27, 0x3c, 0x62,
-	0x18, 0x8b, 0x14, 0x9c, 0x0d, 0x65, 0xea, 0x57, 0x99, 0x9e, 0x16, 0xa8, 0x51, 0x95, 0xc3, 0xf4,
-	0x3d, 0xd9, 0x9e, 0xa7, 0x53, 0x91, 0x86, 0x71, 0x1a, 0x4d, 0xca, 0xd6, 0x86, 0xb2, 0xde, 0xaf,
-	0xb2, 0xbe, 0x5e, 0x85, 0xd6, 0xf4, 0xed, 0xf9, 0xfa, 0x08, 0xe8, 0x98, 0x5c, 0x93, 0xbc, 0xec,
-	0xdf, 0x54, 0xfe, 0x9d, 0x2a, 0x7f, 0x50, 0x82, 0x8d, 0xf8, 0x6f, 0x01, 0xed, 0x90, 0x26, 0x3f,
-	0xcd, 0x84, 0x44, 0x1e, 0x3a, 0xcd, 0x9e, 0x3d, 0x68, 0x06, 0xc5, 0xb9, 0x7f, 0x44, 0xe8, 0xfa,
-	0xb7, 0xa1, 0x43, 0xb2, 0xc9, 0xc2, 0x50, 0x72, 0xd0, 0xfb, 0x77, 0x75, 0xe4, 0x7c, 0xfd, 0xb2,
-	0xdb, 0x36, 0x05, 0x9e, 0xe8, 0xc9, 0x01, 0xca, 0x38, 0x8d, 0x82, 0x15, 0x48, 0xdb, 0x64, 0xe3,
-	0xcf, 0xa6, 0xd5, 0x02, 0x7d, 0x78, 0xd4, 0xfc, 0x74, 0xd6, 0xb5, 0x7e, 0x9e, 0x75, 0xad, 0xd1,
-	0xb3, 0xf3, 0x85, 0x6b, 0x5f, 0x2c, 0x5c, 0xfb, 0xc7, 0xc2, 0xb5, 0x3f, 0x2f, 0x5d, 0xeb, 0x62,
-	0xe9, 0x5a, 0xdf, 0x96, 0xae, 0xf5, 0xee, 0xc1, 0x7f, 0x97, 0xf1, 0xb4, 0x78, 0x56, 0x6a, 0x2d,
-	0xa7, 0x0d, 0xf5, 0x64, 0x1e, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x73, 0xbd, 0x93, 0xb3, 0xc9,
-	0x03, 0x00, 0x00,
+	// 614 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcf, 0x6e, 0x13, 0x31,
+	0x10, 0xc6, 0x77, 0xe9, 0xbf, 0xd4, 0x29, 0x50, 0x99, 0xb4, 0x6c, 0x23, 0xb1, 0x09, 0x55, 0x85,
+	0x22, 0xa0, 0x1b, 0xb5, 0xdc, 0x10, 0x07, 0x08, 0x88, 0x52, 0xe8, 0x21, 0xda, 0x14, 0x84, 0xb8,
+	0xac, 0xbc, 0xb5, 0xd9, 0x58, 0xd9, 0xac, 0x83, 0xed, 0x94, 0x86, 0x27, 0xe0, 0xc8, 0x23, 0xf4,
+	0x21, 0x78, 0x88, 0x1e, 0x2b, 0x4e, 0x88, 0x43, 0x85, 0x92, 0x0b, 0x77, 0x5e, 0x00, 0xad, 0xed,
+	0x84, 0x94, 0xed, 0xf6, 0xc0, 0x29, 0xf1, 0xce, 0xf7, 0xfd, 0x66, 0x3c, 0x9a, 0x31, 0xd8, 0x38,
+	0x60, 0xa2, 0xcb, 0x44, 0x5d, 0x48, 0xd4, 0xa1, 0x49, 0x54, 0x3f, 0xdc, 0x0a, 0x89, 0x44, 0x5b,
+	0xf5, 0x88, 0x24, 0x44, 0x50, 0xe1, 0xf5, 0x38, 0x93, 0x0c, 0xae, 0x6a, 0x95, 0x67, 0x54, 0x9e,
+	0x51, 0x95, 0x4b, 0x11, 0x8b, 0x98, 0x92, 0xd4, 0xd3, 0x7f, 0x5a, 0x5d, 0xce, 0x63, 0x8e, 0xdd,
+	0x5a, 0xb5, 0xa6, 0x55, 0x81, 0xb6, 0x9b, 0x04, 0xea, 0xb0, 0xfe, 0x7b, 0x01, 0x2c, 0xed, 0xe8,
+	0x02, 0x5a, 0x12, 0x49, 0x02, 0x1f, 0x81, 0xf9, 0x1e, 0xe2, 0xa8, 0x2b, 0x1c, 0xbb, 0x6a, 0xd7,
+	0x8a, 0xdb, 0xae, 0x77, 0x71, 0x41, 0x5e, 0x53, 0xa9, 0x1a, 0xb3, 0x27, 0x67, 0x15, 0xcb, 0x37,
+	0x1e, 0xf8, 0x16, 0x2c, 0xc7, 0x48, 0xc8, 0x40, 0x32, 0x89, 0xe2, 0xa0, 0xc7, 0x3e, 0x12, 0xee,
+	0x5c, 0xa9, 0xda, 0xb5, 0xa5, 0x86, 0x97, 0xea, 0x7e, 0x9c, 0x55, 0xee, 0x44, 0x54, 0xb6, 0xfb,
+	0xa1, 0x77, 0xc0, 0xba, 0xa6, 0x12, 0xf3, 0xb3, 0x29, 0x70, 0xa7, 0x2e, 0x07, 0x3d, 0x22, 0xbc,
+	0xdd, 0x44, 0xfa, 0xd7, 0x52, 0xce, 0x7e, 0x8a, 0x69, 0xa6, 0x14, 0x88, 0xc1, 0x8a, 0x22, 0x1f,
+	0xa2, 0x98, 0x62, 0x24, 0x19, 0xd7, 0x74, 0xe1, 0xcc, 0x54, 0x67, 0x6a, 0xc5, 0xed, 0xbb, 0x79,
+	0x65, 0xee, 0x21, 0x21, 0xdf, 0x8c, 0x3d, 0x0a, 0x65, 0x4a, 0xbe, 0x11, 0x67, 0x22, 0x02, 0xee,
+	0x00, 0x30, 0x49, 0x20, 0x9c, 0x59, 0x85, 0xbe, 0x9d, 0x87, 0x9e, 0x98, 0x0d, 0x71, 0xca, 0x0a,
+	0x5f, 0x82, 0x22, 0x26, 0x31, 0x89, 0x90, 0xa4, 0x2c, 0x11, 0xce, 0x9c, 0x22, 0xad, 0xe7, 0x91,
+	0x9e, 0x4d, 0xa4, 0x06, 0x35, 0x6d, 0x86, 0xef, 0xc1, 0x4a, 0x3f, 0x09, 0x59, 0x82, 0x69, 0x12,
+	0x05, 0xd3, 0xd4, 0x79, 0x45, 0xbd, 0x97, 0x47, 0x7d, 0x3d, 0x36, 0x65, 0xf0, 0xa5, 0x7e, 0x36,
+	0x24, 0x60, 0x13, 0x5c, 0xe5, 0x64, 0x9a, 0xbf, 0xa0, 0xf8, 0x1b, 0x79, 0x7c, 0x9f, 0xe0, 0x7f,
+	0xc1, 0xe7, 0x01, 0xb0, 0x0c, 0x0a, 0xe4, 0xa8, 0xc7, 0xb8, 0x24, 0xd8, 0x29, 0x54, 0xed, 0x5a,
+	0xc1, 0x9f, 0x9c, 0x61, 0x04, 0x56, 0x25, 0xeb, 0x90, 0x84, 0x7e, 0x22, 0x81, 0x68, 0x23, 0x4e,
+	0x02, 0x4e, 0x0e, 0x18, 0xc7, 0xc2, 0x59, 0xbc, 0xfc, 0x5a, 0xfb, 0xc6, 0xd5, 0x4a, 0x4d, 0xbe,
+	0xf2, 0x8c, 0xaf, 0x25, 0xb3, 0x21, 0x01, 0x1f, 0x83, 0x5b, 0x66, 0x26, 0x2f, 0xc8, 0x16, 0x50,
+	0xec, 0x80, 0xaa, 0x5d, 0x9b, 0xf5, 0xd7, 0xf4, 0xc0, 0x65, 0x00, 0xbb, 0x18, 0x76, 0x40, 0x59,
+	0x0f, 0x74, 0x4c, 0x3f, 0xf4, 0x29, 0x0e, 0xd2, 0x8a, 0x08, 0xd6, 0x40, 0xe1, 0x14, 0xff, 0x6b,
+	0xbe, 0x6f, 0x2a, 0xe2, 0x9e, 0x02, 0xb6, 0x14, 0x4f, 0xe5, 0x16, 0x30, 0x04, 0xcb, 0x9c, 0x49,
+	0xd5, 0xc0, 0xa0, 0x4d, 0x85, 0x64, 0x7c, 0xe0, 0x2c, 0xa9, 0x8e, 0x6c, 0xe5, 0x75, 0xe4, 0x29,
+	0x4b, 0x44, 0xb3, 0x1f, 0xbe, 0x22, 0x03, 0xdf, 0x38, 0x5f, 0x68, 0xa3, 0xe9, 0xcb, 0x75, 0x7e,
+	0xfe, 0xf3, 0x7a, 0x1b, 0xc0, 0xec, 0x5e, 0xc0, 0x6d, 0xb0, 0x80, 0x30, 0xe6, 0x44, 0xe8, 0xdd,
+	0x5f, 0x6c, 0x38, 0xdf, 0xbe, 0x6e, 0x96, 0x4c, 0xce, 0x27, 0x3a, 0xd2, 0x92, 0x9c, 0x26, 0x91,
+	0x3f, 0x16, 0xc2, 0x12, 0x98, 0xfb, 0xbb, 0xe5, 0x33, 0xbe, 0x3e, 0x3c, 0x2c, 0x7c, 0x3e, 0xae,
+	0x58, 0xbf, 0x8e, 0x2b, 0x56, 0xe3, 0xf9, 0xc9, 0xd0, 0xb5, 0x4f, 0x87, 0xae, 0xfd, 0x73, 0xe8,
+	0xda, 0x5f, 0x46, 0xae, 0x75, 0x3a, 0x72, 0xad, 0xef, 0x23, 0xd7, 0x7a, 0x77, 0xff, 0xd2, 0x46,
+	0x1d, 0x4d, 0x9e, 0x34, 0xd5, 0xb2, 0x70, 0x5e, 0x3d, 0x57, 0x0f, 0xfe, 0x0c, 0x00, 0x11, 0xa3,
+	0x87, 0x21, 0x45, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -233,6 +271,49 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RotationHistory) > 0 {
+		for iNdEx := len(m.RotationHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RotationHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	{
+		size := m.TotalLiquidStakedTokens.Size()
+		i -= size
+		if _, err := m.TotalLiquidStakedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.LastTokenizeShareRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTokenizeShareRecordId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for iNdEx := len(m.TokenizeShareRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenizeShareRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -425,6 +506,23 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for _, e := range m.TokenizeShareRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastTokenizeShareRecordId))
+	}
+	l = m.TotalLiquidStakedTokens.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RotationHistory) > 0 {
+		for _, e := range m.RotationHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -735,6 +833,126 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizeShareRecords = append(m.TokenizeShareRecords, TokenizeShareRecord{})
+			if err := m.TokenizeShareRecords[len(m.TokenizeShareRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTokenizeShareRecordId", wireType)
+			}
+			m.LastTokenizeShareRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTokenizeShareRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidStakedTokens", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalLiquidStakedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RotationHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RotationHistory = append(m.RotationHistory, ConsPubKeyRotationHistory{})
+			if err := m.RotationHistory[len(m.RotationHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
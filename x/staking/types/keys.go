@@ -20,6 +20,10 @@ const (
 	// StoreKey is the string store representation
 	StoreKey = ModuleName
 
+	// TStoreKey is the string transient store representation, used by the
+	// delegation history index to buffer the changes of a block
+	TStoreKey = "transient_" + ModuleName
+
 	// QuerierRoute is the querier route for the staking module
 	QuerierRoute = ModuleName
 
@@ -49,6 +53,22 @@ var (
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
 
 	HistoricalInfoKey = []byte{0x50} // prefix for the historical info
+
+	ParamsKey = []byte{0x51} // prefix for parameters for module x/staking
+
+	TokenizeShareRecordPrefix          = []byte{0x61} // key for tokenize share record with its id
+	TokenizeShareRecordIDByOwnerPrefix = []byte{0x62} // key for tokenize share record id by owner
+	TokenizeShareRecordIDByDenomPrefix = []byte{0x63} // key for tokenize share record id by denom
+	LastTokenizeShareRecordIDKey       = []byte{0x64} // key for the last tokenize share record id
+	TotalLiquidStakedTokensKey         = []byte{0x65} // key for the total liquid staked tokens
+
+	DelegationHistoryKey          = []byte{0x71} // prefix for the delegation history index entries, stored off-consensus
+	UnbondingDelegationHistoryKey = []byte{0x72} // prefix for the unbonding delegation history index entries, stored off-consensus
+	RedelegationHistoryKey        = []byte{0x73} // prefix for the redelegation history index entries, stored off-consensus
+
+	ConsPubKeyRotationHistoryKey = []byte{0x81} // prefix for the consensus pubkey rotation history, by validator operator
+	PendingConsPubKeyRotationKey = []byte{0x82} // prefix for the consensus pubkey rotations not yet reported to the consensus engine
+	OldToNewConsAddrKey          = []byte{0x83} // prefix for the consensus address a rotated consensus address was rotated to
 )
 
 // GetValidatorKey creates the key for the validator with address
@@ -348,3 +368,99 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 func GetHistoricalInfoKey(height int64) []byte {
 	return append(HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
 }
+
+// GetTokenizeShareRecordByIndexKey returns the key of a tokenize share record
+// indexed by its id.
+func GetTokenizeShareRecordByIndexKey(id uint64) []byte {
+	return append(TokenizeShareRecordPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDsByOwnerPrefix returns the key prefix of the tokenize
+// share record ids owned by an address.
+func GetTokenizeShareRecordIDsByOwnerPrefix(owner sdk.AccAddress) []byte {
+	return append(TokenizeShareRecordIDByOwnerPrefix, address.MustLengthPrefix(owner)...)
+}
+
+// GetTokenizeShareRecordIDByOwnerAndIDKey returns the key indexing a tokenize
+// share record id by its owner.
+func GetTokenizeShareRecordIDByOwnerAndIDKey(owner sdk.AccAddress, id uint64) []byte {
+	return append(GetTokenizeShareRecordIDsByOwnerPrefix(owner), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDByDenomKey returns the key indexing a tokenize share
+// record id by the denom of its share tokens.
+func GetTokenizeShareRecordIDByDenomKey(denom string) []byte {
+	return append(TokenizeShareRecordIDByDenomPrefix, []byte(denom)...)
+}
+
+// GetConsPubKeyRotationHistoryPrefix returns the key prefix of the consensus
+// pubkey rotation history of a validator.
+func GetConsPubKeyRotationHistoryPrefix(valAddr sdk.ValAddress) []byte {
+	return append(ConsPubKeyRotationHistoryKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetConsPubKeyRotationHistoryKey returns the key of the consensus pubkey
+// rotation of a validator at a given height to a given consensus address.
+// VALUE: staking/ConsPubKeyRotationHistory
+func GetConsPubKeyRotationHistoryKey(valAddr sdk.ValAddress, height int64, newConsAddr sdk.ConsAddress) []byte {
+	key := append(GetConsPubKeyRotationHistoryPrefix(valAddr), sdk.Uint64ToBigEndian(uint64(height))...)
+	return append(key, address.MustLengthPrefix(newConsAddr)...)
+}
+
+// GetPendingConsPubKeyRotationKey returns the key of the consensus pubkey
+// rotation of a validator not yet reported to the consensus engine.
+// VALUE: staking/ConsPubKeyRotationHistory
+func GetPendingConsPubKeyRotationKey(valAddr sdk.ValAddress) []byte {
+	return append(PendingConsPubKeyRotationKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetOldToNewConsAddrKey returns the key of the consensus address a rotated
+// consensus address was rotated to.
+// VALUE: consensus address ([]byte)
+func GetOldToNewConsAddrKey(oldConsAddr sdk.ConsAddress) []byte {
+	return append(OldToNewConsAddrKey, address.MustLengthPrefix(oldConsAddr)...)
+}
+
+// GetDelegationHistoryPrefix returns the key prefix of the delegation history
+// entries of a delegator.
+func GetDelegationHistoryPrefix(delAddr sdk.AccAddress) []byte {
+	return append(DelegationHistoryKey, address.MustLengthPrefix(delAddr)...)
+}
+
+// GetDelegationHistoryKey returns the key of the delegation history entry of a
+// delegator and validator pair at a given height.
+// VALUE: staking/DelegationHistoryEntry
+func GetDelegationHistoryKey(delAddr sdk.AccAddress, height int64, valAddr sdk.ValAddress) []byte {
+	key := append(GetDelegationHistoryPrefix(delAddr), sdk.Uint64ToBigEndian(uint64(height))...)
+	return append(key, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetUnbondingDelegationHistoryPrefix returns the key prefix of the unbonding
+// delegation history entries of a delegator.
+func GetUnbondingDelegationHistoryPrefix(delAddr sdk.AccAddress) []byte {
+	return append(UnbondingDelegationHistoryKey, address.MustLengthPrefix(delAddr)...)
+}
+
+// GetUnbondingDelegationHistoryKey returns the key of the unbonding delegation
+// history entry of a delegator and validator pair at a given height.
+// VALUE: staking/UnbondingDelegationHistoryEntry
+func GetUnbondingDelegationHistoryKey(delAddr sdk.AccAddress, height int64, valAddr sdk.ValAddress) []byte {
+	key := append(GetUnbondingDelegationHistoryPrefix(delAddr), sdk.Uint64ToBigEndian(uint64(height))...)
+	return append(key, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetRedelegationHistoryPrefix returns the key prefix of the redelegation
+// history entries of a delegator.
+func GetRedelegationHistoryPrefix(delAddr sdk.AccAddress) []byte {
+	return append(RedelegationHistoryKey, address.MustLengthPrefix(delAddr)...)
+}
+
+// GetRedelegationHistoryKey returns the key of the redelegation history entry
+// of a delegator, source validator and destination validator triplet at a
+// given height.
+// VALUE: staking/RedelegationHistoryEntry
+func GetRedelegationHistoryKey(delAddr sdk.AccAddress, height int64, valSrcAddr, valDstAddr sdk.ValAddress) []byte {
+	key := append(GetRedelegationHistoryPrefix(delAddr), sdk.Uint64ToBigEndian(uint64(height))...)
+	key = append(key, address.MustLengthPrefix(valSrcAddr)...)
+	return append(key, address.MustLengthPrefix(valDstAddr)...)
+}
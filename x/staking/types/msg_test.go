@@ -197,3 +197,131 @@ func TestMsgUndelegate(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgTokenizeShares
+func TestMsgTokenizeShares(t *testing.T) {
+	tests := []struct {
+		name          string
+		delegatorAddr sdk.AccAddress
+		validatorAddr sdk.ValAddress
+		amount        sdk.Coin
+		owner         sdk.AccAddress
+		expectPass    bool
+	}{
+		{"regular", sdk.AccAddress(valAddr1), valAddr2, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), sdk.AccAddress(valAddr3), true},
+		{"zero amount", sdk.AccAddress(valAddr1), valAddr2, sdk.NewInt64Coin(sdk.DefaultBondDenom, 0), sdk.AccAddress(valAddr3), false},
+		{"nil amount", sdk.AccAddress(valAddr1), valAddr2, sdk.Coin{}, sdk.AccAddress(valAddr3), false},
+		{"empty delegator", sdk.AccAddress(emptyAddr), valAddr1, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), sdk.AccAddress(valAddr3), false},
+		{"empty validator", sdk.AccAddress(valAddr1), emptyAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), sdk.AccAddress(valAddr3), false},
+		{"empty owner", sdk.AccAddress(valAddr1), valAddr2, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), sdk.AccAddress(emptyAddr), false},
+	}
+
+	for _, tc := range tests {
+		msg := types.NewMsgTokenizeShares(tc.delegatorAddr, tc.validatorAddr, tc.amount, tc.owner)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+// test ValidateBasic for MsgRedeemTokensForShares
+func TestMsgRedeemTokensForShares(t *testing.T) {
+	shareDenom := valAddr2.String() + "/1"
+
+	tests := []struct {
+		name          string
+		delegatorAddr sdk.AccAddress
+		amount        sdk.Coin
+		expectPass    bool
+	}{
+		{"regular", sdk.AccAddress(valAddr1), sdk.NewInt64Coin(shareDenom, 1), true},
+		{"zero amount", sdk.AccAddress(valAddr1), sdk.NewInt64Coin(shareDenom, 0), false},
+		{"nil amount", sdk.AccAddress(valAddr1), sdk.Coin{}, false},
+		{"empty delegator", sdk.AccAddress(emptyAddr), sdk.NewInt64Coin(shareDenom, 1), false},
+	}
+
+	for _, tc := range tests {
+		msg := types.NewMsgRedeemTokensForShares(tc.delegatorAddr, tc.amount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+// test ValidateBasic for MsgValidatorBond
+func TestMsgValidatorBond(t *testing.T) {
+	tests := []struct {
+		name          string
+		delegatorAddr sdk.AccAddress
+		validatorAddr sdk.ValAddress
+		expectPass    bool
+	}{
+		{"regular", sdk.AccAddress(valAddr1), valAddr2, true},
+		{"empty delegator", sdk.AccAddress(emptyAddr), valAddr1, false},
+		{"empty validator", sdk.AccAddress(valAddr1), emptyAddr, false},
+	}
+
+	for _, tc := range tests {
+		msg := types.NewMsgValidatorBond(tc.delegatorAddr, tc.validatorAddr)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+// test ValidateBasic for MsgRotateConsPubKey
+func TestMsgRotateConsPubKey(t *testing.T) {
+	tests := []struct {
+		name          string
+		validatorAddr sdk.ValAddress
+		pubKey        cryptotypes.PubKey
+		expectPass    bool
+	}{
+		{"regular", valAddr1, pk2, true},
+		{"empty validator", emptyAddr, pk2, false},
+		{"empty pubkey", valAddr1, nil, false},
+	}
+
+	for _, tc := range tests {
+		msg, err := types.NewMsgRotateConsPubKey(tc.validatorAddr, tc.pubKey)
+		require.NoError(t, err)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+			require.Equal(t, []sdk.AccAddress{sdk.AccAddress(tc.validatorAddr)}, msg.GetSigners())
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+// test ValidateBasic for MsgUpdateParams
+func TestMsgUpdateParams(t *testing.T) {
+	invalidParams := types.DefaultParams()
+	invalidParams.MaxValidators = 0
+
+	tests := []struct {
+		name       string
+		authority  string
+		params     types.Params
+		expectPass bool
+	}{
+		{"regular", sdk.AccAddress(valAddr1).String(), types.DefaultParams(), true},
+		{"invalid authority", "invalid", types.DefaultParams(), false},
+		{"invalid params", sdk.AccAddress(valAddr1).String(), invalidParams, false},
+	}
+
+	for _, tc := range tests {
+		msg := types.MsgUpdateParams{Authority: tc.authority, Params: tc.params}
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
@@ -36,6 +36,29 @@ const (
 // DefaultMinCommissionRate is set to 0%
 var DefaultMinCommissionRate = sdk.ZeroDec()
 
+// DefaultEpochMode disables the buffering of staking messages until the end of
+// the current epoch.
+const DefaultEpochMode = false
+
+var (
+	// DefaultValidatorBondFactor of -1 disables the validator bond requirement
+	// on tokenized shares.
+	DefaultValidatorBondFactor = sdk.NewDec(-1)
+	// DefaultGlobalLiquidStakingCap of 100% allows all the stake to be liquid.
+	DefaultGlobalLiquidStakingCap = sdk.OneDec()
+	// DefaultValidatorLiquidStakingCap of 100% allows all the stake of a
+	// validator to be liquid.
+	DefaultValidatorLiquidStakingCap = sdk.OneDec()
+)
+
+// DefaultKeyRotationFee is the default fee paid by a validator to rotate its
+// consensus public key.
+var DefaultKeyRotationFee = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000)
+
+// DefaultMaxConsPubKeyRotations is the default maximum number of consensus
+// public key rotations of a validator within an unbonding period.
+const DefaultMaxConsPubKeyRotations uint32 = 1
+
 var (
 	KeyUnbondingTime     = []byte("UnbondingTime")
 	KeyMaxValidators     = []byte("MaxValidators")
@@ -43,6 +66,14 @@ var (
 	KeyBondDenom         = []byte("BondDenom")
 	KeyHistoricalEntries = []byte("HistoricalEntries")
 	KeyMinCommissionRate = []byte("MinCommissionRate")
+	KeyEpochMode         = []byte("EpochMode")
+
+	KeyValidatorBondFactor       = []byte("ValidatorBondFactor")
+	KeyGlobalLiquidStakingCap    = []byte("GlobalLiquidStakingCap")
+	KeyValidatorLiquidStakingCap = []byte("ValidatorLiquidStakingCap")
+
+	KeyKeyRotationFee         = []byte("KeyRotationFee")
+	KeyMaxConsPubKeyRotations = []byte("MaxConsPubKeyRotations")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -53,14 +84,24 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string, minCommissionRate sdk.Dec) Params {
+func NewParams(
+	unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string, minCommissionRate sdk.Dec, epochMode bool,
+	validatorBondFactor, globalLiquidStakingCap, validatorLiquidStakingCap sdk.Dec, keyRotationFee sdk.Coin,
+	maxConsPubKeyRotations uint32,
+) Params {
 	return Params{
-		UnbondingTime:     unbondingTime,
-		MaxValidators:     maxValidators,
-		MaxEntries:        maxEntries,
-		HistoricalEntries: historicalEntries,
-		BondDenom:         bondDenom,
-		MinCommissionRate: minCommissionRate,
+		UnbondingTime:             unbondingTime,
+		MaxValidators:             maxValidators,
+		MaxEntries:                maxEntries,
+		HistoricalEntries:         historicalEntries,
+		BondDenom:                 bondDenom,
+		MinCommissionRate:         minCommissionRate,
+		EpochMode:                 epochMode,
+		ValidatorBondFactor:       validatorBondFactor,
+		GlobalLiquidStakingCap:    globalLiquidStakingCap,
+		ValidatorLiquidStakingCap: validatorLiquidStakingCap,
+		KeyRotationFee:            keyRotationFee,
+		MaxConsPubkeyRotations:    maxConsPubKeyRotations,
 	}
 }
 
@@ -73,6 +114,12 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyHistoricalEntries, &p.HistoricalEntries, validateHistoricalEntries),
 		paramtypes.NewParamSetPair(KeyBondDenom, &p.BondDenom, validateBondDenom),
 		paramtypes.NewParamSetPair(KeyMinCommissionRate, &p.MinCommissionRate, validateMinCommissionRate),
+		paramtypes.NewParamSetPair(KeyEpochMode, &p.EpochMode, validateEpochMode),
+		paramtypes.NewParamSetPair(KeyValidatorBondFactor, &p.ValidatorBondFactor, validateValidatorBondFactor),
+		paramtypes.NewParamSetPair(KeyGlobalLiquidStakingCap, &p.GlobalLiquidStakingCap, validateLiquidStakingCap),
+		paramtypes.NewParamSetPair(KeyValidatorLiquidStakingCap, &p.ValidatorLiquidStakingCap, validateLiquidStakingCap),
+		paramtypes.NewParamSetPair(KeyKeyRotationFee, &p.KeyRotationFee, validateKeyRotationFee),
+		paramtypes.NewParamSetPair(KeyMaxConsPubKeyRotations, &p.MaxConsPubkeyRotations, validateMaxConsPubKeyRotations),
 	}
 }
 
@@ -85,6 +132,12 @@ func DefaultParams() Params {
 		DefaultHistoricalEntries,
 		sdk.DefaultBondDenom,
 		DefaultMinCommissionRate,
+		DefaultEpochMode,
+		DefaultValidatorBondFactor,
+		DefaultGlobalLiquidStakingCap,
+		DefaultValidatorLiquidStakingCap,
+		DefaultKeyRotationFee,
+		DefaultMaxConsPubKeyRotations,
 	)
 }
 
@@ -136,6 +189,26 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateValidatorBondFactor(p.ValidatorBondFactor); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap(p.GlobalLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap(p.ValidatorLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateKeyRotationFee(p.KeyRotationFee); err != nil {
+		return err
+	}
+
+	if err := validateMaxConsPubKeyRotations(p.MaxConsPubkeyRotations); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -232,3 +305,77 @@ func validateMinCommissionRate(i interface{}) error {
 
 	return nil
 }
+
+func validateEpochMode(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateValidatorBondFactor(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("validator bond factor cannot be nil")
+	}
+	if v.IsNegative() && !v.Equal(sdk.NewDec(-1)) {
+		return fmt.Errorf("invalid validator bond factor: %s, must be positive or -1", v)
+	}
+
+	return nil
+}
+
+func validateLiquidStakingCap(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("liquid staking cap cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("liquid staking cap cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("liquid staking cap cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
+
+func validateKeyRotationFee(i interface{}) error {
+	v, ok := i.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.Amount.IsNil() {
+		return fmt.Errorf("key rotation fee cannot be nil")
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid key rotation fee: %w", err)
+	}
+
+	return nil
+}
+
+func validateMaxConsPubKeyRotations(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max consensus pubkey rotations must be positive: %d", v)
+	}
+
+	return nil
+}
@@ -35,4 +35,19 @@ func Test_validateParams(t *testing.T) {
 
 	params.MinCommissionRate = sdk.NewDec(2)
 	require.Error(t, params.Validate())
+
+	// validate liquid staking params
+	params = types.DefaultParams()
+	params.ValidatorBondFactor = sdk.NewDec(-2)
+	require.Error(t, params.Validate())
+
+	params.ValidatorBondFactor = sdk.NewDec(250)
+	require.NoError(t, params.Validate())
+
+	params.GlobalLiquidStakingCap = sdk.NewDecWithPrec(-1, 1)
+	require.Error(t, params.Validate())
+
+	params.GlobalLiquidStakingCap = sdk.NewDecWithPrec(25, 2)
+	params.ValidatorLiquidStakingCap = sdk.NewDecWithPrec(11, 1)
+	require.Error(t, params.Validate())
 }
@@ -7,9 +7,17 @@ import "cosmossdk.io/math"
 // - NotBondedPool -> "not_bonded_tokens_pool"
 //
 // - BondedPool -> "bonded_tokens_pool"
+//
+// - EpochDelegationPool -> "epoch_delegation_pool", escrowing the tokens of the
+// delegations buffered until the end of the current epoch
+//
+// - TokenizeSharePool -> "tokenize_share_pool", minting and burning the share
+// tokens of tokenized delegations
 const (
-	NotBondedPoolName = "not_bonded_tokens_pool"
-	BondedPoolName    = "bonded_tokens_pool"
+	NotBondedPoolName       = "not_bonded_tokens_pool"
+	BondedPoolName          = "bonded_tokens_pool"
+	EpochDelegationPoolName = "epoch_delegation_pool"
+	TokenizeSharePoolName   = "tokenize_share_pool"
 )
 
 // NewPool creates a new Pool instance used for queries
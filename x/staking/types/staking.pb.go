@@ -336,6 +336,10 @@ type Validator struct {
 	//
 	// Since: cosmos-sdk 0.46
 	MinSelfDelegation github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=min_self_delegation,json=minSelfDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_self_delegation"`
+	// validator_bond_shares is the number of shares self bonded from the validator.
+	ValidatorBondShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=validator_bond_shares,json=validatorBondShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_bond_shares"`
+	// liquid_shares is the number of shares either tokenized or owned by a liquid staking provider.
+	LiquidShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=liquid_shares,json=liquidShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquid_shares"`
 }
 
 func (m *Validator) Reset()      { *m = Validator{} }
@@ -596,6 +600,8 @@ type Delegation struct {
 	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 	// shares define the delegation shares received.
 	Shares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=shares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"shares"`
+	// validator_bond indicates whether the delegation is a validator bond.
+	ValidatorBond bool `protobuf:"varint,4,opt,name=validator_bond,json=validatorBond,proto3" json:"validator_bond,omitempty"`
 }
 
 func (m *Delegation) Reset()      { *m = Delegation{} }
@@ -848,6 +854,25 @@ type Params struct {
 	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
 	// min_commission_rate is the chain-wide minimum commission rate that a validator can charge their delegators
 	MinCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_commission_rate" yaml:"min_commission_rate"`
+	// epoch_mode defines whether the staking messages that change the validator
+	// set are buffered and only executed at the end of the current epoch.
+	EpochMode bool `protobuf:"varint,7,opt,name=epoch_mode,json=epochMode,proto3" json:"epoch_mode,omitempty" yaml:"epoch_mode"`
+	// validator_bond_factor is required as a safety check for tokenizing shares
+	// and delegations from liquid staking providers. A value of -1 disables the
+	// check.
+	ValidatorBondFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=validator_bond_factor,json=validatorBondFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_bond_factor" yaml:"validator_bond_factor"`
+	// global_liquid_staking_cap represents a cap on the portion of stake that
+	// comes from liquid staking providers.
+	GlobalLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"global_liquid_staking_cap" yaml:"global_liquid_staking_cap"`
+	// validator_liquid_staking_cap represents a cap on the portion of stake that
+	// comes from liquid staking providers for a specific validator.
+	ValidatorLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=validator_liquid_staking_cap,json=validatorLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_liquid_staking_cap" yaml:"validator_liquid_staking_cap"`
+	// key_rotation_fee is the fee paid by a validator to rotate its consensus
+	// public key.
+	KeyRotationFee types2.Coin `protobuf:"bytes,11,opt,name=key_rotation_fee,json=keyRotationFee,proto3" json:"key_rotation_fee" yaml:"key_rotation_fee"`
+	// max_cons_pubkey_rotations is the maximum number of consensus public key
+	// rotations of a validator within an unbonding period.
+	MaxConsPubkeyRotations uint32 `protobuf:"varint,12,opt,name=max_cons_pubkey_rotations,json=maxConsPubkeyRotations,proto3" json:"max_cons_pubkey_rotations,omitempty" yaml:"max_cons_pubkey_rotations"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -917,6 +942,27 @@ func (m *Params) GetBondDenom() string {
 	return ""
 }
 
+func (m *Params) GetEpochMode() bool {
+	if m != nil {
+		return m.EpochMode
+	}
+	return false
+}
+
+func (m *Params) GetKeyRotationFee() types2.Coin {
+	if m != nil {
+		return m.KeyRotationFee
+	}
+	return types2.Coin{}
+}
+
+func (m *Params) GetMaxConsPubkeyRotations() uint32 {
+	if m != nil {
+		return m.MaxConsPubkeyRotations
+	}
+	return 0
+}
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -1113,6 +1159,362 @@ func (m *Pool) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Pool proto.InternalMessageInfo
 
+// TokenizeShareRecord represents a tokenized delegation. The delegation is
+// held by the record module account and represented by share tokens.
+type TokenizeShareRecord struct {
+	// id is the unique identifier of the record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address of the owner of the record.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// module_account is the name of the module account holding the delegation.
+	ModuleAccount string `protobuf:"bytes,3,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty"`
+	// validator is the operator address of the validator of the delegation.
+	Validator string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *TokenizeShareRecord) Reset()         { *m = TokenizeShareRecord{} }
+func (m *TokenizeShareRecord) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecord) ProtoMessage()    {}
+func (*TokenizeShareRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{20}
+}
+func (m *TokenizeShareRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecord.Merge(m, src)
+}
+func (m *TokenizeShareRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecord proto.InternalMessageInfo
+
+func (m *TokenizeShareRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TokenizeShareRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetModuleAccount() string {
+	if m != nil {
+		return m.ModuleAccount
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+// DelegationHistoryEntry records the shares of a delegation at the end of a
+// block in which it was modified. A removed delegation is recorded with zero
+// shares.
+type DelegationHistoryEntry struct {
+	// delegator_address is the bech32-encoded address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the bech32-encoded address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// height is the height of the block in which the delegation was modified.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// shares define the delegation shares at the end of the block.
+	Shares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=shares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"shares"`
+}
+
+func (m *DelegationHistoryEntry) Reset()         { *m = DelegationHistoryEntry{} }
+func (m *DelegationHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*DelegationHistoryEntry) ProtoMessage()    {}
+func (*DelegationHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{21}
+}
+func (m *DelegationHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegationHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegationHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationHistoryEntry.Merge(m, src)
+}
+func (m *DelegationHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationHistoryEntry proto.InternalMessageInfo
+
+func (m *DelegationHistoryEntry) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *DelegationHistoryEntry) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *DelegationHistoryEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// UnbondingDelegationHistoryEntry records the entries of an unbonding
+// delegation at the end of a block in which it was modified. A removed
+// unbonding delegation is recorded without entries.
+type UnbondingDelegationHistoryEntry struct {
+	// delegator_address is the bech32-encoded address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the bech32-encoded address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// height is the height of the block in which the unbonding delegation was
+	// modified.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// entries are the unbonding delegation entries at the end of the block.
+	Entries []UnbondingDelegationEntry `protobuf:"bytes,4,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *UnbondingDelegationHistoryEntry) Reset()         { *m = UnbondingDelegationHistoryEntry{} }
+func (m *UnbondingDelegationHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*UnbondingDelegationHistoryEntry) ProtoMessage()    {}
+func (*UnbondingDelegationHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{22}
+}
+func (m *UnbondingDelegationHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnbondingDelegationHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbondingDelegationHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnbondingDelegationHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingDelegationHistoryEntry.Merge(m, src)
+}
+func (m *UnbondingDelegationHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbondingDelegationHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingDelegationHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbondingDelegationHistoryEntry proto.InternalMessageInfo
+
+func (m *UnbondingDelegationHistoryEntry) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *UnbondingDelegationHistoryEntry) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *UnbondingDelegationHistoryEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *UnbondingDelegationHistoryEntry) GetEntries() []UnbondingDelegationEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+// RedelegationHistoryEntry records the entries of a redelegation at the end of
+// a block in which it was modified. A removed redelegation is recorded without
+// entries.
+type RedelegationHistoryEntry struct {
+	// delegator_address is the bech32-encoded address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_src_address is the validator redelegation source operator address.
+	ValidatorSrcAddress string `protobuf:"bytes,2,opt,name=validator_src_address,json=validatorSrcAddress,proto3" json:"validator_src_address,omitempty"`
+	// validator_dst_address is the validator redelegation destination operator address.
+	ValidatorDstAddress string `protobuf:"bytes,3,opt,name=validator_dst_address,json=validatorDstAddress,proto3" json:"validator_dst_address,omitempty"`
+	// height is the height of the block in which the redelegation was modified.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// entries are the redelegation entries at the end of the block.
+	Entries []RedelegationEntry `protobuf:"bytes,5,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *RedelegationHistoryEntry) Reset()         { *m = RedelegationHistoryEntry{} }
+func (m *RedelegationHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*RedelegationHistoryEntry) ProtoMessage()    {}
+func (*RedelegationHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{23}
+}
+func (m *RedelegationHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedelegationHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedelegationHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RedelegationHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedelegationHistoryEntry.Merge(m, src)
+}
+func (m *RedelegationHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedelegationHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedelegationHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedelegationHistoryEntry proto.InternalMessageInfo
+
+func (m *RedelegationHistoryEntry) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *RedelegationHistoryEntry) GetValidatorSrcAddress() string {
+	if m != nil {
+		return m.ValidatorSrcAddress
+	}
+	return ""
+}
+
+func (m *RedelegationHistoryEntry) GetValidatorDstAddress() string {
+	if m != nil {
+		return m.ValidatorDstAddress
+	}
+	return ""
+}
+
+func (m *RedelegationHistoryEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *RedelegationHistoryEntry) GetEntries() []RedelegationEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+// ConsPubKeyRotationHistory records the rotation of the consensus public key
+// of a validator.
+type ConsPubKeyRotationHistory struct {
+	// operator_address defines the address of the validator's operator; bech
+	// encoded in JSON.
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// old_cons_pubkey is the consensus public key of the validator before the
+	// rotation.
+	OldConsPubkey *types1.Any `protobuf:"bytes,2,opt,name=old_cons_pubkey,json=oldConsPubkey,proto3" json:"old_cons_pubkey,omitempty"`
+	// new_cons_pubkey is the consensus public key of the validator after the
+	// rotation.
+	NewConsPubkey *types1.Any `protobuf:"bytes,3,opt,name=new_cons_pubkey,json=newConsPubkey,proto3" json:"new_cons_pubkey,omitempty"`
+	// height is the height of the block in which the key was rotated.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// fee is the key rotation fee paid by the validator.
+	Fee types2.Coin `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee"`
+	// completion_time is the time at which the rotation stops counting toward
+	// the rotation limit, one unbonding period after the rotation.
+	CompletionTime time.Time `protobuf:"bytes,6,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+}
+
+func (m *ConsPubKeyRotationHistory) Reset()         { *m = ConsPubKeyRotationHistory{} }
+func (m *ConsPubKeyRotationHistory) String() string { return proto.CompactTextString(m) }
+func (*ConsPubKeyRotationHistory) ProtoMessage()    {}
+func (*ConsPubKeyRotationHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{24}
+}
+func (m *ConsPubKeyRotationHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsPubKeyRotationHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsPubKeyRotationHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsPubKeyRotationHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsPubKeyRotationHistory.Merge(m, src)
+}
+func (m *ConsPubKeyRotationHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsPubKeyRotationHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsPubKeyRotationHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsPubKeyRotationHistory proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("cosmos.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
 	proto.RegisterType((*HistoricalInfo)(nil), "cosmos.staking.v1beta1.HistoricalInfo")
@@ -1135,6 +1537,11 @@ func init() {
 	proto.RegisterType((*RedelegationEntryResponse)(nil), "cosmos.staking.v1beta1.RedelegationEntryResponse")
 	proto.RegisterType((*RedelegationResponse)(nil), "cosmos.staking.v1beta1.RedelegationResponse")
 	proto.RegisterType((*Pool)(nil), "cosmos.staking.v1beta1.Pool")
+	proto.RegisterType((*TokenizeShareRecord)(nil), "cosmos.staking.v1beta1.TokenizeShareRecord")
+	proto.RegisterType((*DelegationHistoryEntry)(nil), "cosmos.staking.v1beta1.DelegationHistoryEntry")
+	proto.RegisterType((*UnbondingDelegationHistoryEntry)(nil), "cosmos.staking.v1beta1.UnbondingDelegationHistoryEntry")
+	proto.RegisterType((*RedelegationHistoryEntry)(nil), "cosmos.staking.v1beta1.RedelegationHistoryEntry")
+	proto.RegisterType((*ConsPubKeyRotationHistory)(nil), "cosmos.staking.v1beta1.ConsPubKeyRotationHistory")
 }
 
 func init() {
@@ -1142,112 +1549,142 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 1669 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4d, 0x6c, 0x1b, 0xc7,
-	0x15, 0xe6, 0x52, 0x0c, 0x45, 0x3d, 0x4a, 0xa2, 0x34, 0x56, 0x52, 0x9a, 0x68, 0x49, 0x96, 0x4d,
-	0x13, 0xa7, 0x88, 0xa9, 0x5a, 0x05, 0x02, 0x54, 0x28, 0x50, 0x98, 0x22, 0x53, 0xab, 0x4e, 0x5c,
-	0x86, 0x94, 0x55, 0xf4, 0x07, 0x5d, 0x0c, 0x77, 0x47, 0xd4, 0x54, 0xbb, 0xb3, 0xc4, 0xce, 0xd0,
-	0x15, 0x81, 0x16, 0x28, 0xd0, 0x4b, 0xea, 0x53, 0x8e, 0xb9, 0x18, 0x30, 0x90, 0x1e, 0x73, 0x0c,
-	0x7a, 0xe9, 0xa1, 0xd7, 0x34, 0x27, 0x23, 0xa7, 0xa6, 0x2d, 0xd4, 0xc2, 0xbe, 0x14, 0x3d, 0x15,
-	0xb9, 0xb7, 0x28, 0xe6, 0x67, 0x7f, 0x4c, 0x8a, 0x8a, 0x14, 0xa8, 0x40, 0x00, 0x5f, 0xec, 0x9d,
-	0x99, 0xf7, 0xbe, 0x79, 0xef, 0x7b, 0x3f, 0x7a, 0x43, 0x78, 0xd1, 0x09, 0xb8, 0x1f, 0xf0, 0x4d,
-	0x2e, 0xf0, 0x11, 0x65, 0xc3, 0xcd, 0x7b, 0x37, 0x06, 0x44, 0xe0, 0x1b, 0xd1, 0xba, 0x39, 0x0a,
-	0x03, 0x11, 0xa0, 0x17, 0xb4, 0x54, 0x33, 0xda, 0x35, 0x52, 0x95, 0x8d, 0x61, 0x30, 0x0c, 0x94,
-	0xc8, 0xa6, 0xfc, 0xd2, 0xd2, 0x95, 0xab, 0xc3, 0x20, 0x18, 0x7a, 0x64, 0x53, 0xad, 0x06, 0xe3,
-	0x83, 0x4d, 0xcc, 0x26, 0xe6, 0xa8, 0x3a, 0x7d, 0xe4, 0x8e, 0x43, 0x2c, 0x68, 0xc0, 0xcc, 0x79,
-	0x6d, 0xfa, 0x5c, 0x50, 0x9f, 0x70, 0x81, 0xfd, 0x51, 0x84, 0xad, 0x2d, 0xb1, 0xf5, 0xa5, 0xc6,
-	0x2c, 0x83, 0x6d, 0x5c, 0x19, 0x60, 0x4e, 0x62, 0x3f, 0x9c, 0x80, 0x46, 0xd8, 0x5f, 0x16, 0x84,
-	0xb9, 0x24, 0xf4, 0x29, 0x13, 0x9b, 0x62, 0x32, 0x22, 0x5c, 0xff, 0xab, 0x4f, 0x1b, 0xbf, 0xb5,
-	0x60, 0xf5, 0x16, 0xe5, 0x22, 0x08, 0xa9, 0x83, 0xbd, 0x5d, 0x76, 0x10, 0xa0, 0xd7, 0x20, 0x7f,
-	0x48, 0xb0, 0x4b, 0xc2, 0xb2, 0x55, 0xb7, 0xae, 0x15, 0xb7, 0xca, 0xcd, 0x04, 0xa1, 0xa9, 0x75,
-	0x6f, 0xa9, 0xf3, 0x56, 0xee, 0xc3, 0x93, 0x5a, 0xa6, 0x67, 0xa4, 0xd1, 0x77, 0x21, 0x7f, 0x0f,
-	0x7b, 0x9c, 0x88, 0x72, 0xb6, 0xbe, 0x70, 0xad, 0xb8, 0xf5, 0xd5, 0xe6, 0xe9, 0xf4, 0x35, 0xf7,
-	0xb1, 0x47, 0x5d, 0x2c, 0x82, 0x18, 0x40, 0xab, 0x35, 0xde, 0xcf, 0x42, 0x69, 0x27, 0xf0, 0x7d,
-	0xca, 0x39, 0x0d, 0x58, 0x0f, 0x0b, 0xc2, 0x51, 0x17, 0x72, 0x21, 0x16, 0x44, 0x99, 0xb2, 0xd4,
-	0xfa, 0x8e, 0x94, 0xff, 0xcb, 0x49, 0xed, 0xa5, 0x21, 0x15, 0x87, 0xe3, 0x41, 0xd3, 0x09, 0x7c,
-	0x43, 0x86, 0xf9, 0xef, 0x3a, 0x77, 0x8f, 0x8c, 0x7f, 0x6d, 0xe2, 0x7c, 0xfc, 0xc1, 0x75, 0x30,
-	0x36, 0xb4, 0x89, 0xd3, 0x53, 0x48, 0xe8, 0x87, 0x50, 0xf0, 0xf1, 0xb1, 0xad, 0x50, 0xb3, 0x97,
-	0x80, 0xba, 0xe8, 0xe3, 0x63, 0x69, 0x2b, 0x72, 0xa1, 0x24, 0x81, 0x9d, 0x43, 0xcc, 0x86, 0x44,
-	0xe3, 0x2f, 0x5c, 0x02, 0xfe, 0x8a, 0x8f, 0x8f, 0x77, 0x14, 0xa6, 0xbc, 0x65, 0xbb, 0xf0, 0xee,
-	0xc3, 0x5a, 0xe6, 0x9f, 0x0f, 0x6b, 0x56, 0xe3, 0x0f, 0x16, 0x40, 0x42, 0x17, 0xfa, 0x29, 0xac,
-	0x39, 0xf1, 0x4a, 0x5d, 0xcf, 0x4d, 0x00, 0x5f, 0x9e, 0x17, 0x88, 0x29, 0xb2, 0x5b, 0x05, 0x69,
-	0xe8, 0xa3, 0x93, 0x9a, 0xd5, 0x2b, 0x39, 0x53, 0x71, 0xe8, 0x40, 0x71, 0x3c, 0x72, 0xb1, 0x20,
-	0xb6, 0x4c, 0x4d, 0x45, 0x5c, 0x71, 0xab, 0xd2, 0xd4, 0x79, 0xdb, 0x8c, 0xf2, 0xb6, 0xb9, 0x17,
-	0xe5, 0xad, 0xc6, 0x7a, 0xe7, 0xef, 0x35, 0xab, 0x07, 0x5a, 0x51, 0x1e, 0xa5, 0xac, 0x7f, 0xdf,
-	0x82, 0x62, 0x9b, 0x70, 0x27, 0xa4, 0x23, 0x59, 0x08, 0xa8, 0x0c, 0x8b, 0x7e, 0xc0, 0xe8, 0x91,
-	0x49, 0xbb, 0xa5, 0x5e, 0xb4, 0x44, 0x15, 0x28, 0x50, 0x97, 0x30, 0x41, 0xc5, 0x44, 0x07, 0xac,
-	0x17, 0xaf, 0xa5, 0xd6, 0x2f, 0xc8, 0x80, 0xd3, 0x88, 0xeb, 0x5e, 0xb4, 0x44, 0xaf, 0xc0, 0x1a,
-	0x27, 0xce, 0x38, 0xa4, 0x62, 0x62, 0x3b, 0x01, 0x13, 0xd8, 0x11, 0xe5, 0x9c, 0x12, 0x29, 0x45,
-	0xfb, 0x3b, 0x7a, 0x5b, 0x82, 0xb8, 0x44, 0x60, 0xea, 0xf1, 0xf2, 0x73, 0x1a, 0xc4, 0x2c, 0x53,
-	0xe6, 0xfe, 0x29, 0x0f, 0x4b, 0x71, 0xde, 0xa2, 0x1d, 0x58, 0x0b, 0x46, 0x24, 0x94, 0xdf, 0x36,
-	0x76, 0xdd, 0x90, 0x70, 0x6e, 0x32, 0xb4, 0xfc, 0xf1, 0x07, 0xd7, 0x37, 0x0c, 0xdd, 0x37, 0xf5,
-	0x49, 0x5f, 0x84, 0x94, 0x0d, 0x7b, 0xa5, 0x48, 0xc3, 0x6c, 0xa3, 0x1f, 0xc9, 0x80, 0x31, 0x4e,
-	0x18, 0x1f, 0x73, 0x7b, 0x34, 0x1e, 0x1c, 0x91, 0x89, 0xe1, 0x75, 0x63, 0x86, 0xd7, 0x9b, 0x6c,
-	0xd2, 0x2a, 0x7f, 0x94, 0x40, 0x3b, 0xe1, 0x64, 0x24, 0x82, 0x66, 0x77, 0x3c, 0xb8, 0x4d, 0x26,
-	0x32, 0x5a, 0x06, 0xa7, 0xab, 0x60, 0xd0, 0x0b, 0x90, 0xff, 0x39, 0xa6, 0x1e, 0x71, 0x15, 0x2b,
-	0x85, 0x9e, 0x59, 0xa1, 0x6d, 0xc8, 0x73, 0x81, 0xc5, 0x98, 0x2b, 0x2a, 0x56, 0xb7, 0x1a, 0xf3,
-	0x32, 0xa3, 0x15, 0x30, 0xb7, 0xaf, 0x24, 0x7b, 0x46, 0x03, 0xed, 0x41, 0x5e, 0x04, 0x47, 0x84,
-	0x19, 0x92, 0x2e, 0x94, 0xd5, 0xbb, 0x4c, 0xa4, 0xb2, 0x7a, 0x97, 0x89, 0x9e, 0xc1, 0x42, 0x43,
-	0x58, 0x73, 0x89, 0x47, 0x86, 0x8a, 0x4a, 0x7e, 0x88, 0x43, 0xc2, 0xcb, 0xf9, 0x4b, 0xa8, 0x9a,
-	0x52, 0x8c, 0xda, 0x57, 0xa0, 0xe8, 0x36, 0x14, 0xdd, 0x24, 0xdd, 0xca, 0x8b, 0x8a, 0xe8, 0xaf,
-	0xcd, 0xf3, 0x3f, 0x95, 0x99, 0xa6, 0x49, 0xa5, 0xb5, 0x65, 0x72, 0x8d, 0xd9, 0x20, 0x60, 0x2e,
-	0x65, 0x43, 0xfb, 0x90, 0xd0, 0xe1, 0xa1, 0x28, 0x17, 0xea, 0xd6, 0xb5, 0x85, 0x5e, 0x29, 0xde,
-	0xbf, 0xa5, 0xb6, 0xd1, 0x6d, 0x58, 0x4d, 0x44, 0x55, 0xed, 0x2c, 0x5d, 0xa0, 0x76, 0x56, 0x62,
-	0x5d, 0x79, 0x8a, 0x6e, 0x01, 0x24, 0x85, 0x59, 0x06, 0x05, 0xd4, 0xf8, 0xec, 0xea, 0x36, 0x2e,
-	0xa4, 0x74, 0x91, 0x07, 0x57, 0x7c, 0xca, 0x6c, 0x4e, 0xbc, 0x03, 0xdb, 0x50, 0x25, 0x21, 0x8b,
-	0x97, 0x10, 0xda, 0x75, 0x9f, 0xb2, 0x3e, 0xf1, 0x0e, 0xda, 0x31, 0xec, 0xf6, 0xf2, 0xdb, 0x0f,
-	0x6b, 0x19, 0x53, 0x4b, 0x99, 0x46, 0x17, 0x96, 0xf7, 0xb1, 0x67, 0xca, 0x80, 0x70, 0xf4, 0x1a,
-	0x2c, 0xe1, 0x68, 0x51, 0xb6, 0xea, 0x0b, 0x67, 0x96, 0x51, 0x22, 0xaa, 0xab, 0xf3, 0xd7, 0x7f,
-	0xab, 0x5b, 0x8d, 0xdf, 0x59, 0x90, 0x6f, 0xef, 0x77, 0x31, 0x0d, 0x51, 0x07, 0xd6, 0x93, 0x84,
-	0x3a, 0x6f, 0x6d, 0x26, 0x39, 0x18, 0x15, 0x67, 0x07, 0xd6, 0xef, 0x45, 0xe5, 0x1e, 0xc3, 0x64,
-	0x3f, 0x0b, 0x26, 0x56, 0x31, 0xfb, 0x53, 0x8e, 0x77, 0x60, 0x51, 0x5b, 0xc9, 0xd1, 0x36, 0x3c,
-	0x37, 0x92, 0x1f, 0xca, 0xdf, 0xe2, 0x56, 0x75, 0x6e, 0x22, 0x2a, 0x79, 0x13, 0x40, 0xad, 0xd2,
-	0xf8, 0x8f, 0x05, 0xd0, 0xde, 0xdf, 0xdf, 0x0b, 0xe9, 0xc8, 0x23, 0xe2, 0xb2, 0x3c, 0x7e, 0x03,
-	0x9e, 0x4f, 0x3c, 0xe6, 0xa1, 0x73, 0x6e, 0xaf, 0xaf, 0xc4, 0x6a, 0xfd, 0xd0, 0x39, 0x15, 0xcd,
-	0xe5, 0x22, 0x46, 0x5b, 0x38, 0x37, 0x5a, 0x9b, 0x8b, 0xd3, 0x69, 0xec, 0x43, 0x31, 0x71, 0x9f,
-	0xa3, 0x36, 0x14, 0x84, 0xf9, 0x36, 0x6c, 0x36, 0xe6, 0xb3, 0x19, 0xa9, 0x19, 0x46, 0x63, 0xcd,
-	0xc6, 0x7f, 0x25, 0xa9, 0x71, 0xc6, 0x7e, 0xb1, 0xd2, 0x48, 0xf6, 0x5e, 0xd3, 0x1b, 0x2f, 0x63,
-	0xa2, 0x30, 0x58, 0x53, 0xac, 0xfe, 0x26, 0x0b, 0x57, 0xee, 0x46, 0xdd, 0xe6, 0x0b, 0xcb, 0x44,
-	0x17, 0x16, 0x09, 0x13, 0x21, 0x55, 0x54, 0xc8, 0x58, 0x7f, 0x73, 0x5e, 0xac, 0x4f, 0xf1, 0xa5,
-	0xc3, 0x44, 0x38, 0x31, 0x91, 0x8f, 0x60, 0xa6, 0x58, 0xf8, 0x6b, 0x16, 0xca, 0xf3, 0x34, 0xd1,
-	0xcb, 0x50, 0x72, 0x42, 0xa2, 0x36, 0xa2, 0xae, 0x6f, 0xa9, 0xae, 0xbf, 0x1a, 0x6d, 0x9b, 0xa6,
-	0xff, 0x26, 0xc8, 0x01, 0x4a, 0x26, 0x96, 0x14, 0xbd, 0xf0, 0xc4, 0xb4, 0x9a, 0x28, 0xab, 0xb6,
-	0x4f, 0xa0, 0x44, 0x19, 0x15, 0x14, 0x7b, 0xf6, 0x00, 0x7b, 0x98, 0x39, 0x9f, 0x67, 0xb2, 0x9c,
-	0x6d, 0xd4, 0xab, 0x06, 0xb4, 0xa5, 0x31, 0xd1, 0x3e, 0x2c, 0x46, 0xf0, 0xb9, 0x4b, 0x80, 0x8f,
-	0xc0, 0x52, 0x53, 0xd4, 0x27, 0x59, 0x58, 0xef, 0x11, 0xf7, 0xd9, 0xa2, 0xf5, 0x27, 0x00, 0xba,
-	0xe0, 0x64, 0x1f, 0xfc, 0x1c, 0xcc, 0xce, 0x16, 0xf0, 0x92, 0xc6, 0x6b, 0x73, 0x91, 0xe2, 0xf6,
-	0xa3, 0x2c, 0x2c, 0xa7, 0xb9, 0x7d, 0x06, 0xfe, 0x2e, 0xa0, 0xdd, 0xa4, 0x1b, 0xe4, 0x54, 0x37,
-	0x78, 0x65, 0x5e, 0x37, 0x98, 0xc9, 0xba, 0xb3, 0xdb, 0xc0, 0xa7, 0x59, 0xc8, 0x77, 0x71, 0x88,
-	0x7d, 0x8e, 0xbe, 0x3f, 0x33, 0xc0, 0xe9, 0x57, 0xd5, 0xd5, 0x99, 0x9c, 0x6b, 0x9b, 0x47, 0xbd,
-	0x4e, 0xb9, 0x77, 0x4f, 0x99, 0xdf, 0xbe, 0x0e, 0xab, 0xf2, 0x89, 0x18, 0xbb, 0xa2, 0x49, 0x5c,
-	0x51, 0x6f, 0xbc, 0xf8, 0x75, 0xc1, 0x51, 0x0d, 0x8a, 0x52, 0x2c, 0x69, 0x74, 0x52, 0x06, 0x7c,
-	0x7c, 0xdc, 0xd1, 0x3b, 0xe8, 0x3a, 0xa0, 0xc3, 0xf8, 0xd1, 0x6e, 0x27, 0x14, 0x48, 0xb9, 0xf5,
-	0xe4, 0x24, 0x12, 0xff, 0x0a, 0x80, 0xb4, 0xc2, 0x76, 0x09, 0x0b, 0x7c, 0xf3, 0xc6, 0x59, 0x92,
-	0x3b, 0x6d, 0xb9, 0x81, 0x7e, 0xa9, 0x67, 0xc1, 0xa9, 0xd7, 0xa3, 0x19, 0xc3, 0xdf, 0xb8, 0x58,
-	0xa6, 0x7e, 0x7a, 0x52, 0xab, 0x4c, 0xb0, 0xef, 0x6d, 0x37, 0x4e, 0x81, 0x6c, 0xa8, 0xd9, 0xf0,
-	0xe9, 0x57, 0x67, 0x2a, 0x83, 0xdf, 0xb3, 0x00, 0x25, 0x2d, 0xb7, 0x47, 0xf8, 0x48, 0x3e, 0x6b,
-	0xe4, 0xd0, 0x9b, 0x9a, 0x50, 0xad, 0xb3, 0x87, 0xde, 0x44, 0x3f, 0x1a, 0x7a, 0x53, 0x15, 0xf1,
-	0xed, 0xa4, 0xc1, 0x65, 0x4d, 0x0c, 0x0d, 0xcc, 0x00, 0x73, 0x92, 0x1a, 0x9c, 0x69, 0xa4, 0x3d,
-	0xd3, 0xc3, 0x32, 0x8d, 0x4f, 0x2c, 0xb8, 0x3a, 0x93, 0x4d, 0xb1, 0xb1, 0x3f, 0x03, 0x14, 0xa6,
-	0x0e, 0x55, 0x6c, 0x26, 0xc6, 0xe8, 0x0b, 0x27, 0xe7, 0x7a, 0x38, 0xd3, 0x2b, 0xff, 0x5f, 0x3d,
-	0x3a, 0xa7, 0x22, 0xf0, 0x47, 0x0b, 0x36, 0xd2, 0xc6, 0xc4, 0x6e, 0xdd, 0x81, 0xe5, 0xb4, 0x2d,
-	0xc6, 0xa1, 0x17, 0xcf, 0xe3, 0x90, 0xf1, 0xe5, 0x29, 0x7d, 0xf4, 0x56, 0x52, 0xb8, 0xfa, 0xc7,
-	0xa2, 0x1b, 0xe7, 0xe6, 0x26, 0xb2, 0x69, 0xba, 0x80, 0x73, 0xd1, 0x14, 0x93, 0xeb, 0x06, 0x81,
-	0x87, 0x7e, 0x05, 0xeb, 0x2c, 0x10, 0xb6, 0xcc, 0x72, 0xe2, 0xda, 0xe6, 0xe5, 0xaa, 0xbb, 0xdf,
-	0x5b, 0x17, 0xa3, 0xec, 0x5f, 0x27, 0xb5, 0x59, 0xa8, 0x29, 0x1e, 0x4b, 0x2c, 0x10, 0x2d, 0x75,
-	0xbe, 0xa7, 0xdf, 0xb5, 0x21, 0xac, 0x3c, 0x7d, 0xb5, 0xee, 0x96, 0x6f, 0x5e, 0xf8, 0xea, 0x95,
-	0xb3, 0xae, 0x5d, 0x1e, 0xa4, 0xee, 0xdc, 0x2e, 0xc8, 0x18, 0xfe, 0xfb, 0x61, 0xcd, 0xfa, 0xc6,
-	0xef, 0x2d, 0x80, 0xe4, 0x09, 0x8f, 0x5e, 0x85, 0x2f, 0xb5, 0x7e, 0x70, 0xa7, 0x6d, 0xf7, 0xf7,
-	0x6e, 0xee, 0xdd, 0xed, 0xdb, 0x77, 0xef, 0xf4, 0xbb, 0x9d, 0x9d, 0xdd, 0xd7, 0x77, 0x3b, 0xed,
-	0xb5, 0x4c, 0xa5, 0x74, 0xff, 0x41, 0xbd, 0x78, 0x97, 0xf1, 0x11, 0x71, 0xe8, 0x01, 0x25, 0x2e,
-	0x7a, 0x09, 0x36, 0x9e, 0x96, 0x96, 0xab, 0x4e, 0x7b, 0xcd, 0xaa, 0x2c, 0xdf, 0x7f, 0x50, 0x2f,
-	0xe8, 0xe9, 0x88, 0xb8, 0xe8, 0x1a, 0x3c, 0x3f, 0x2b, 0xb7, 0x7b, 0xe7, 0x7b, 0x6b, 0xd9, 0xca,
-	0xca, 0xfd, 0x07, 0xf5, 0xa5, 0x78, 0x8c, 0x42, 0x0d, 0x40, 0x69, 0x49, 0x83, 0xb7, 0x50, 0x81,
-	0xfb, 0x0f, 0xea, 0x79, 0x4d, 0x5b, 0x25, 0xf7, 0xf6, 0x7b, 0xd5, 0x4c, 0xeb, 0xf5, 0x0f, 0x1f,
-	0x57, 0xad, 0x47, 0x8f, 0xab, 0xd6, 0x3f, 0x1e, 0x57, 0xad, 0x77, 0x9e, 0x54, 0x33, 0x8f, 0x9e,
-	0x54, 0x33, 0x7f, 0x7e, 0x52, 0xcd, 0xfc, 0xf8, 0xd5, 0x33, 0x19, 0x3b, 0x8e, 0x7f, 0xc9, 0x55,
-	0xdc, 0x0d, 0xf2, 0xaa, 0x29, 0x7f, 0xeb, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x49, 0x0e, 0x8f,
-	0x94, 0xe8, 0x15, 0x00, 0x00,
+	// 2148 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x5d, 0x6c, 0x5b, 0x49,
+	0x15, 0xce, 0x75, 0x5c, 0xc7, 0x3e, 0x8e, 0xe3, 0x64, 0xda, 0x66, 0x9d, 0xa8, 0xc4, 0xc1, 0xdb,
+	0xdd, 0xed, 0xa2, 0xad, 0x43, 0x0b, 0x5a, 0x89, 0x08, 0x09, 0xd5, 0x71, 0x4a, 0x43, 0x7f, 0xc8,
+	0xde, 0xa4, 0x41, 0xfc, 0x88, 0xab, 0xf1, 0xbd, 0x13, 0xe7, 0x92, 0xeb, 0x19, 0x73, 0xef, 0xb8,
+	0xad, 0x11, 0x48, 0x08, 0x5e, 0x4a, 0x25, 0xa4, 0x7d, 0x42, 0x2b, 0xa4, 0x42, 0xa5, 0x85, 0xb7,
+	0x7d, 0xac, 0x78, 0x80, 0x07, 0x5e, 0x57, 0xfb, 0x54, 0xed, 0x13, 0x0b, 0x28, 0xa0, 0x56, 0x42,
+	0x88, 0x27, 0xd4, 0x77, 0x24, 0x34, 0x3f, 0xf7, 0x27, 0xfe, 0x69, 0xe2, 0xae, 0x57, 0xea, 0x6a,
+	0x5f, 0x12, 0xcf, 0xcc, 0x99, 0x6f, 0xce, 0x7c, 0x73, 0xce, 0x99, 0x73, 0xe6, 0xc2, 0x59, 0x9b,
+	0x05, 0x2d, 0x16, 0xac, 0x04, 0x1c, 0xef, 0xbb, 0xb4, 0xb9, 0x72, 0xeb, 0x42, 0x83, 0x70, 0x7c,
+	0x21, 0x6c, 0x57, 0xdb, 0x3e, 0xe3, 0x0c, 0xcd, 0x2b, 0xa9, 0x6a, 0xd8, 0xab, 0xa5, 0x16, 0x4f,
+	0x35, 0x59, 0x93, 0x49, 0x91, 0x15, 0xf1, 0x4b, 0x49, 0x2f, 0x2e, 0x34, 0x19, 0x6b, 0x7a, 0x64,
+	0x45, 0xb6, 0x1a, 0x9d, 0xdd, 0x15, 0x4c, 0xbb, 0x7a, 0x68, 0xa9, 0x77, 0xc8, 0xe9, 0xf8, 0x98,
+	0xbb, 0x8c, 0xea, 0xf1, 0x72, 0xef, 0x38, 0x77, 0x5b, 0x24, 0xe0, 0xb8, 0xd5, 0x0e, 0xb1, 0x95,
+	0x26, 0x96, 0x5a, 0x54, 0xab, 0xa5, 0xb1, 0xf5, 0x56, 0x1a, 0x38, 0x20, 0xd1, 0x3e, 0x6c, 0xe6,
+	0x86, 0xd8, 0x67, 0x38, 0xa1, 0x0e, 0xf1, 0x5b, 0x2e, 0xe5, 0x2b, 0xbc, 0xdb, 0x26, 0x81, 0xfa,
+	0xab, 0x46, 0x2b, 0xbf, 0x30, 0x60, 0xe6, 0x8a, 0x1b, 0x70, 0xe6, 0xbb, 0x36, 0xf6, 0x36, 0xe8,
+	0x2e, 0x43, 0x6f, 0x42, 0x66, 0x8f, 0x60, 0x87, 0xf8, 0x25, 0x63, 0xd9, 0x38, 0x97, 0xbf, 0x58,
+	0xaa, 0xc6, 0x08, 0x55, 0x35, 0xf7, 0x8a, 0x1c, 0xaf, 0xa5, 0xdf, 0x3f, 0x28, 0x4f, 0x98, 0x5a,
+	0x1a, 0x7d, 0x0d, 0x32, 0xb7, 0xb0, 0x17, 0x10, 0x5e, 0x4a, 0x2d, 0x4f, 0x9e, 0xcb, 0x5f, 0xfc,
+	0x7c, 0x75, 0x30, 0x7d, 0xd5, 0x1d, 0xec, 0xb9, 0x0e, 0xe6, 0x2c, 0x02, 0x50, 0xd3, 0x2a, 0xef,
+	0xa5, 0xa0, 0xb8, 0xc6, 0x5a, 0x2d, 0x37, 0x08, 0x5c, 0x46, 0x4d, 0xcc, 0x49, 0x80, 0x36, 0x21,
+	0xed, 0x63, 0x4e, 0xa4, 0x2a, 0xb9, 0xda, 0x57, 0x85, 0xfc, 0x5f, 0x0f, 0xca, 0xaf, 0x36, 0x5d,
+	0xbe, 0xd7, 0x69, 0x54, 0x6d, 0xd6, 0xd2, 0x64, 0xe8, 0x7f, 0xe7, 0x03, 0x67, 0x5f, 0xef, 0xaf,
+	0x4e, 0xec, 0x0f, 0x1f, 0x9e, 0x07, 0xad, 0x43, 0x9d, 0xd8, 0xa6, 0x44, 0x42, 0xdf, 0x82, 0x6c,
+	0x0b, 0xdf, 0xb1, 0x24, 0x6a, 0x6a, 0x0c, 0xa8, 0x53, 0x2d, 0x7c, 0x47, 0xe8, 0x8a, 0x1c, 0x28,
+	0x0a, 0x60, 0x7b, 0x0f, 0xd3, 0x26, 0x51, 0xf8, 0x93, 0x63, 0xc0, 0x2f, 0xb4, 0xf0, 0x9d, 0x35,
+	0x89, 0x29, 0x56, 0x59, 0xcd, 0xbe, 0xf3, 0xa0, 0x3c, 0xf1, 0xef, 0x07, 0x65, 0xa3, 0xf2, 0x27,
+	0x03, 0x20, 0xa6, 0x0b, 0x7d, 0x0f, 0x66, 0xed, 0xa8, 0x25, 0x97, 0x0f, 0xf4, 0x01, 0xbe, 0x36,
+	0xec, 0x20, 0x7a, 0xc8, 0xae, 0x65, 0x85, 0xa2, 0x8f, 0x0e, 0xca, 0x86, 0x59, 0xb4, 0x7b, 0xce,
+	0x61, 0x1d, 0xf2, 0x9d, 0xb6, 0x83, 0x39, 0xb1, 0x84, 0x69, 0x4a, 0xe2, 0xf2, 0x17, 0x17, 0xab,
+	0xca, 0x6e, 0xab, 0xa1, 0xdd, 0x56, 0xb7, 0x43, 0xbb, 0x55, 0x58, 0x6f, 0xff, 0xa3, 0x6c, 0x98,
+	0xa0, 0x26, 0x8a, 0xa1, 0x84, 0xf6, 0xef, 0x19, 0x90, 0xaf, 0x93, 0xc0, 0xf6, 0xdd, 0xb6, 0x70,
+	0x04, 0x54, 0x82, 0xa9, 0x16, 0xa3, 0xee, 0xbe, 0x36, 0xbb, 0x9c, 0x19, 0x36, 0xd1, 0x22, 0x64,
+	0x5d, 0x87, 0x50, 0xee, 0xf2, 0xae, 0x3a, 0x30, 0x33, 0x6a, 0x8b, 0x59, 0xb7, 0x49, 0x23, 0x70,
+	0x43, 0xae, 0xcd, 0xb0, 0x89, 0x5e, 0x87, 0xd9, 0x80, 0xd8, 0x1d, 0xdf, 0xe5, 0x5d, 0xcb, 0x66,
+	0x94, 0x63, 0x9b, 0x97, 0xd2, 0x52, 0xa4, 0x18, 0xf6, 0xaf, 0xa9, 0x6e, 0x01, 0xe2, 0x10, 0x8e,
+	0x5d, 0x2f, 0x28, 0x9d, 0x50, 0x20, 0xba, 0x99, 0x50, 0xf7, 0x37, 0x59, 0xc8, 0x45, 0x76, 0x8b,
+	0xd6, 0x60, 0x96, 0xb5, 0x89, 0x2f, 0x7e, 0x5b, 0xd8, 0x71, 0x7c, 0x12, 0x04, 0xda, 0x42, 0x4b,
+	0x1f, 0x3e, 0x3c, 0x7f, 0x4a, 0xd3, 0x7d, 0x49, 0x8d, 0x6c, 0x71, 0xdf, 0xa5, 0x4d, 0xb3, 0x18,
+	0xce, 0xd0, 0xdd, 0xe8, 0xdb, 0xe2, 0xc0, 0x68, 0x40, 0x68, 0xd0, 0x09, 0xac, 0x76, 0xa7, 0xb1,
+	0x4f, 0xba, 0x9a, 0xd7, 0x53, 0x7d, 0xbc, 0x5e, 0xa2, 0xdd, 0x5a, 0xe9, 0x83, 0x18, 0xda, 0xf6,
+	0xbb, 0x6d, 0xce, 0xaa, 0x9b, 0x9d, 0xc6, 0x55, 0xd2, 0x35, 0x8b, 0x11, 0xce, 0xa6, 0x84, 0x41,
+	0xf3, 0x90, 0xf9, 0x01, 0x76, 0x3d, 0xe2, 0x48, 0x56, 0xb2, 0xa6, 0x6e, 0xa1, 0x55, 0xc8, 0x04,
+	0x1c, 0xf3, 0x4e, 0x20, 0xa9, 0x98, 0xb9, 0x58, 0x19, 0x66, 0x19, 0x35, 0x46, 0x9d, 0x2d, 0x29,
+	0x69, 0xea, 0x19, 0x68, 0x1b, 0x32, 0x9c, 0xed, 0x13, 0xaa, 0x49, 0x1a, 0xc9, 0xaa, 0x37, 0x28,
+	0x4f, 0x58, 0xf5, 0x06, 0xe5, 0xa6, 0xc6, 0x42, 0x4d, 0x98, 0x75, 0x88, 0x47, 0x9a, 0x92, 0xca,
+	0x60, 0x0f, 0xfb, 0x24, 0x28, 0x65, 0xc6, 0xe0, 0x35, 0xc5, 0x08, 0x75, 0x4b, 0x82, 0xa2, 0xab,
+	0x90, 0x77, 0x62, 0x73, 0x2b, 0x4d, 0x49, 0xa2, 0x5f, 0x1e, 0xb6, 0xff, 0x84, 0x65, 0xea, 0x20,
+	0x95, 0x9c, 0x2d, 0x8c, 0xab, 0x43, 0x1b, 0x8c, 0x3a, 0x2e, 0x6d, 0x5a, 0x7b, 0xc4, 0x6d, 0xee,
+	0xf1, 0x52, 0x76, 0xd9, 0x38, 0x37, 0x69, 0x16, 0xa3, 0xfe, 0x2b, 0xb2, 0x1b, 0x5d, 0x85, 0x99,
+	0x58, 0x54, 0xfa, 0x4e, 0x6e, 0x04, 0xdf, 0x29, 0x44, 0x73, 0xc5, 0x28, 0xba, 0x02, 0x10, 0x3b,
+	0x66, 0x09, 0x24, 0x50, 0xe5, 0x68, 0xef, 0xd6, 0x5b, 0x48, 0xcc, 0x45, 0x1e, 0x9c, 0x6c, 0xb9,
+	0xd4, 0x0a, 0x88, 0xb7, 0x6b, 0x69, 0xaa, 0x04, 0x64, 0x7e, 0x0c, 0x47, 0x3b, 0xd7, 0x72, 0xe9,
+	0x16, 0xf1, 0x76, 0xeb, 0x11, 0x2c, 0x6a, 0xc3, 0xe9, 0x5b, 0xa1, 0xf3, 0x58, 0x62, 0x43, 0xe1,
+	0x51, 0x4f, 0x8f, 0xe1, 0xa8, 0x4f, 0x46, 0xd0, 0xd2, 0x6a, 0xd5, 0x71, 0x63, 0x28, 0x78, 0xee,
+	0x0f, 0x3b, 0x6e, 0xb4, 0x52, 0x61, 0x0c, 0x2b, 0x4d, 0x2b, 0x48, 0xb5, 0xc4, 0xea, 0xf4, 0xdd,
+	0x07, 0xe5, 0x09, 0x1d, 0x20, 0x26, 0x2a, 0x9b, 0x30, 0xbd, 0x83, 0x3d, 0xed, 0xdb, 0x24, 0x40,
+	0x6f, 0x42, 0x0e, 0x87, 0x8d, 0x92, 0xb1, 0x3c, 0xf9, 0xcc, 0xd8, 0x10, 0x8b, 0xaa, 0x90, 0xf3,
+	0xd3, 0xbf, 0x2f, 0x1b, 0x95, 0xdf, 0x19, 0x90, 0xa9, 0xef, 0x6c, 0x62, 0xd7, 0x47, 0xeb, 0x30,
+	0x17, 0x7b, 0xc9, 0x71, 0x03, 0x4e, 0xec, 0x58, 0xba, 0x5f, 0xc0, 0xc4, 0xc7, 0x10, 0xc2, 0xa4,
+	0x8e, 0x82, 0x89, 0xa6, 0xe8, 0xfe, 0x9e, 0x8d, 0xaf, 0xc3, 0x94, 0xd2, 0x32, 0x40, 0xab, 0x70,
+	0xa2, 0x2d, 0x7e, 0xc8, 0xfd, 0xe6, 0x2f, 0x2e, 0x0d, 0xf5, 0x2e, 0x29, 0xaf, 0xad, 0x52, 0x4d,
+	0xa9, 0xfc, 0xcf, 0x00, 0xa8, 0xef, 0xec, 0x6c, 0xfb, 0x6e, 0xdb, 0x23, 0x7c, 0x5c, 0x3b, 0xbe,
+	0x96, 0x34, 0xbc, 0xc0, 0xb7, 0x8f, 0xbd, 0xeb, 0xd8, 0xa8, 0xb6, 0x7c, 0x7b, 0x20, 0x9a, 0x13,
+	0xf0, 0x08, 0x6d, 0xf2, 0xd8, 0x68, 0xf5, 0x80, 0x0f, 0xa6, 0x71, 0x0b, 0xf2, 0xf1, 0xf6, 0x03,
+	0x54, 0x87, 0x2c, 0xd7, 0xbf, 0x35, 0x9b, 0x95, 0xe1, 0x6c, 0x86, 0xd3, 0x34, 0xa3, 0xd1, 0xcc,
+	0xca, 0xef, 0x53, 0x00, 0x09, 0x37, 0x7c, 0xa1, 0xcc, 0x48, 0x5c, 0x28, 0xda, 0x37, 0xc7, 0x91,
+	0x26, 0x69, 0x2c, 0xf4, 0x0a, 0xcc, 0x1c, 0x0e, 0x35, 0xf2, 0xaa, 0xcb, 0x9a, 0x85, 0x43, 0x51,
+	0xa2, 0x87, 0xfc, 0x9f, 0xa7, 0xe0, 0xe4, 0xcd, 0x30, 0xd2, 0xbe, 0xb0, 0x84, 0x6d, 0xc2, 0x14,
+	0xa1, 0xdc, 0x77, 0x25, 0x63, 0xc2, 0x24, 0xbe, 0x38, 0xcc, 0x24, 0x06, 0xec, 0x65, 0x9d, 0x72,
+	0xbf, 0xab, 0x0d, 0x24, 0x84, 0xe9, 0x61, 0xe1, 0x6f, 0x29, 0x28, 0x0d, 0x9b, 0x89, 0x5e, 0x83,
+	0xa2, 0xed, 0x13, 0xd9, 0x11, 0xde, 0x78, 0x86, 0xbc, 0xf1, 0x66, 0xc2, 0x6e, 0x7d, 0xe1, 0x5d,
+	0x07, 0x91, 0x3c, 0x0a, 0xfb, 0x13, 0xa2, 0x23, 0x67, 0x8b, 0x33, 0xf1, 0x64, 0x31, 0x8c, 0x08,
+	0x14, 0x5d, 0xea, 0x72, 0x17, 0x7b, 0x56, 0x03, 0x7b, 0x98, 0xda, 0xcf, 0x93, 0x55, 0xf7, 0x5f,
+	0x52, 0x33, 0x1a, 0xb4, 0xa6, 0x30, 0xd1, 0x0e, 0x4c, 0x85, 0xf0, 0xe9, 0x31, 0xc0, 0x87, 0x60,
+	0x89, 0x0c, 0xf2, 0xa3, 0x14, 0xcc, 0x99, 0xc4, 0xf9, 0x6c, 0xd1, 0xfa, 0x5d, 0x00, 0xe5, 0x97,
+	0x22, 0x5c, 0x96, 0xd2, 0x63, 0xf0, 0xf3, 0x9c, 0xc2, 0xab, 0x07, 0x3c, 0xc1, 0xed, 0x07, 0x29,
+	0x98, 0x4e, 0x72, 0xfb, 0x19, 0xb8, 0x3e, 0xd0, 0x46, 0x1c, 0x0d, 0xd2, 0x32, 0x1a, 0xbc, 0x3e,
+	0x2c, 0x1a, 0xf4, 0x59, 0xdd, 0xb3, 0xc3, 0xc0, 0xfd, 0x2c, 0x64, 0x36, 0xb1, 0x8f, 0x5b, 0x01,
+	0xfa, 0x46, 0x5f, 0xf2, 0xaa, 0x2a, 0xca, 0x85, 0x3e, 0x9b, 0xab, 0xeb, 0x07, 0x0d, 0x65, 0x72,
+	0xef, 0x0c, 0xc8, 0x5d, 0x5f, 0x81, 0x19, 0x51, 0x1e, 0x47, 0x5b, 0x51, 0x24, 0x16, 0x64, 0x7d,
+	0x1b, 0x55, 0x56, 0x01, 0x2a, 0x43, 0x5e, 0x88, 0xc5, 0x81, 0x4e, 0xc8, 0x40, 0x0b, 0xdf, 0x59,
+	0x57, 0x3d, 0xe8, 0x3c, 0xa0, 0xbd, 0xe8, 0xc1, 0xc2, 0x8a, 0x29, 0x10, 0x72, 0x73, 0xf1, 0x48,
+	0x28, 0xfe, 0x39, 0x00, 0x99, 0x70, 0x3a, 0x84, 0xb2, 0x96, 0xae, 0xef, 0x72, 0xa2, 0xa7, 0x2e,
+	0x3a, 0xd0, 0x8f, 0x55, 0x1e, 0xdc, 0x53, 0x39, 0xeb, 0x12, 0xe4, 0xda, 0x68, 0x96, 0xfa, 0xf4,
+	0xa0, 0xbc, 0xd8, 0xc5, 0x2d, 0x6f, 0xb5, 0x32, 0x00, 0xb2, 0x22, 0xf3, 0xe2, 0xc3, 0x15, 0x37,
+	0xfa, 0x32, 0x00, 0x69, 0x33, 0x7b, 0xcf, 0x6a, 0x31, 0x87, 0xc8, 0x9a, 0x24, 0x5b, 0x3b, 0xfd,
+	0xf4, 0xa0, 0x3c, 0xa7, 0x60, 0xe2, 0xb1, 0x8a, 0x99, 0x93, 0x8d, 0xeb, 0xcc, 0x21, 0xe8, 0x67,
+	0x46, 0x5f, 0x3a, 0xbd, 0x8b, 0x6d, 0xce, 0x7c, 0x59, 0x83, 0xe4, 0x6a, 0x37, 0x46, 0x56, 0xfb,
+	0x8c, 0x5a, 0x6f, 0x20, 0x68, 0xa5, 0x27, 0xc1, 0xbe, 0x2c, 0x7b, 0xd1, 0x2f, 0x0d, 0x58, 0x68,
+	0x7a, 0xac, 0x81, 0x3d, 0x2b, 0x4c, 0xb4, 0x95, 0xd9, 0x59, 0x36, 0x6e, 0xcb, 0x1a, 0x27, 0x57,
+	0x33, 0x47, 0x56, 0x64, 0x59, 0x29, 0x32, 0x14, 0xb8, 0x62, 0xce, 0xab, 0xb1, 0x6b, 0x72, 0x68,
+	0x4b, 0x8d, 0xac, 0xe1, 0x36, 0xfa, 0x95, 0x01, 0x67, 0x62, 0xfd, 0x07, 0xa8, 0x04, 0x52, 0xa5,
+	0x9b, 0x23, 0xab, 0xf4, 0x72, 0x2f, 0x37, 0x83, 0xb4, 0x5a, 0x88, 0x86, 0xfb, 0x14, 0x73, 0x60,
+	0x76, 0x9f, 0x74, 0x2d, 0x9f, 0x71, 0x15, 0xe5, 0x77, 0x09, 0x29, 0xe5, 0xb5, 0x17, 0x69, 0x87,
+	0x15, 0x4f, 0x77, 0x89, 0xb2, 0xcd, 0xa5, 0xb5, 0xb2, 0x50, 0xf3, 0xe9, 0x41, 0xf9, 0x25, 0xb5,
+	0x78, 0x2f, 0x40, 0xc5, 0x9c, 0xd9, 0x27, 0x5d, 0x53, 0xf7, 0x5c, 0x26, 0x04, 0x59, 0xb0, 0x20,
+	0x1f, 0x9f, 0x18, 0x0d, 0xdf, 0x12, 0xa2, 0x09, 0xaa, 0xca, 0x2a, 0xd4, 0xce, 0xc6, 0xfc, 0x0e,
+	0x15, 0xad, 0x98, 0xf3, 0xe2, 0xb9, 0x89, 0x51, 0xfd, 0x92, 0x10, 0x2e, 0x91, 0x7c, 0x0a, 0x79,
+	0xd7, 0x00, 0x14, 0x67, 0x07, 0x26, 0x09, 0xda, 0x8c, 0x06, 0xb2, 0x36, 0x4d, 0x14, 0x92, 0xc6,
+	0xb3, 0x6b, 0xd3, 0x78, 0x7e, 0x58, 0x9b, 0xc6, 0x73, 0xd1, 0x57, 0xe2, 0xbb, 0x38, 0x75, 0x14,
+	0x51, 0x3a, 0x92, 0xf5, 0x5e, 0xb7, 0x13, 0x95, 0x8f, 0x0c, 0x58, 0xe8, 0x0b, 0x7c, 0x91, 0xb2,
+	0xdf, 0x07, 0xe4, 0x27, 0x06, 0x65, 0x18, 0xe9, 0x6a, 0xa5, 0x47, 0x8e, 0xa3, 0x73, 0x7e, 0xef,
+	0xc0, 0x27, 0x96, 0x4e, 0xa4, 0xe5, 0x09, 0xfc, 0xd9, 0x80, 0x53, 0x49, 0x65, 0xa2, 0x6d, 0xdd,
+	0x80, 0xe9, 0xa4, 0x2e, 0x7a, 0x43, 0x67, 0x8f, 0xb3, 0x21, 0xbd, 0x97, 0x43, 0xf3, 0xd1, 0x5b,
+	0xf1, 0x1d, 0xa3, 0xde, 0x74, 0x2f, 0x1c, 0x9b, 0x9b, 0x50, 0xa7, 0xde, 0xbb, 0x26, 0x1d, 0x26,
+	0xdc, 0xe9, 0x4d, 0xc6, 0x3c, 0xf4, 0x13, 0x98, 0xa3, 0x8c, 0xcb, 0x78, 0x43, 0x1c, 0x4b, 0x3f,
+	0x30, 0xa9, 0x8b, 0xfa, 0xad, 0xd1, 0x28, 0xfb, 0xcf, 0x41, 0xb9, 0x1f, 0xaa, 0x87, 0xc7, 0x22,
+	0x65, 0xbc, 0x26, 0xc7, 0xb7, 0xe5, 0x30, 0xf2, 0xa1, 0x70, 0x78, 0x69, 0x75, 0xb1, 0x5f, 0x1f,
+	0x79, 0xe9, 0xc2, 0xb3, 0x96, 0x9d, 0x6e, 0x24, 0xd6, 0x5c, 0xcd, 0x8a, 0x33, 0xfc, 0xaf, 0x38,
+	0xc7, 0x3f, 0x1a, 0x70, 0x52, 0x76, 0xba, 0x3f, 0x22, 0xf2, 0x51, 0xc1, 0x24, 0x36, 0xf3, 0x1d,
+	0x34, 0x03, 0x29, 0xd7, 0x91, 0x2c, 0xa4, 0xcd, 0x94, 0xeb, 0xa0, 0x2a, 0x9c, 0x60, 0xb7, 0x29,
+	0xf1, 0x8f, 0x4c, 0x3b, 0x94, 0x98, 0xbc, 0x6a, 0x99, 0xd3, 0xf1, 0x88, 0x85, 0x6d, 0x9b, 0x75,
+	0x28, 0xd7, 0x8f, 0xa3, 0x05, 0xd5, 0x7b, 0x49, 0x75, 0x8a, 0x27, 0x8a, 0x28, 0x6c, 0x95, 0xd2,
+	0x47, 0x40, 0xc7, 0xa2, 0xda, 0x08, 0x7f, 0x9d, 0x82, 0xf9, 0xd8, 0x8d, 0xd5, 0x37, 0x84, 0xae,
+	0xb2, 0xfe, 0x17, 0xab, 0x6c, 0x9a, 0x17, 0xdf, 0x33, 0x64, 0x66, 0x3d, 0x29, 0x33, 0x6b, 0xdd,
+	0x4a, 0xd4, 0x9f, 0xe9, 0xf1, 0xd5, 0x9f, 0x9a, 0x9c, 0xdf, 0xa6, 0xa0, 0x3c, 0xa0, 0x94, 0xfa,
+	0x14, 0xb2, 0xb4, 0xd9, 0x9b, 0x66, 0x7e, 0xec, 0xa2, 0x53, 0x31, 0xf4, 0xaf, 0x14, 0x94, 0x92,
+	0x41, 0xe3, 0x93, 0xa0, 0xe6, 0x45, 0x4e, 0xdf, 0x63, 0xbe, 0xd3, 0x87, 0xf8, 0x4e, 0xa4, 0xf5,
+	0x27, 0x3e, 0x66, 0x5a, 0xaf, 0x88, 0x7e, 0x38, 0x09, 0x0b, 0xfa, 0x42, 0xbf, 0x1a, 0x5f, 0xe8,
+	0x9a, 0xee, 0xf1, 0x7c, 0xc9, 0xd8, 0x81, 0x22, 0xf3, 0x9c, 0x64, 0x46, 0xf1, 0x9c, 0x1f, 0x32,
+	0x0a, 0xcc, 0x73, 0xe2, 0xe4, 0x43, 0xe0, 0x52, 0x72, 0xfb, 0x10, 0xee, 0xe4, 0xf3, 0xe1, 0x52,
+	0x72, 0x3b, 0x81, 0x3b, 0x8c, 0xfb, 0x0b, 0x30, 0x29, 0xb2, 0xb3, 0x13, 0xc7, 0x4b, 0x3a, 0x84,
+	0xec, 0xa0, 0xb2, 0x3c, 0xf3, 0xfc, 0x65, 0xf9, 0x6a, 0xf6, 0xae, 0xce, 0x5f, 0xbe, 0xf0, 0x07,
+	0x03, 0x20, 0xfe, 0x0a, 0x83, 0xde, 0x80, 0x97, 0x6a, 0xdf, 0xbc, 0x51, 0xb7, 0xb6, 0xb6, 0x2f,
+	0x6d, 0xdf, 0xdc, 0xb2, 0x6e, 0xde, 0xd8, 0xda, 0x5c, 0x5f, 0xdb, 0xb8, 0xbc, 0xb1, 0x5e, 0x9f,
+	0x9d, 0x58, 0x2c, 0xde, 0xbb, 0xbf, 0x9c, 0xbf, 0x49, 0x83, 0x36, 0xb1, 0xdd, 0x5d, 0x97, 0x38,
+	0xe8, 0x55, 0x38, 0x75, 0x58, 0x5a, 0xb4, 0xd6, 0xeb, 0xb3, 0xc6, 0xe2, 0xf4, 0xbd, 0xfb, 0xcb,
+	0x59, 0xe5, 0xa9, 0xc4, 0x41, 0xe7, 0xe0, 0x74, 0xbf, 0xdc, 0xc6, 0x8d, 0xaf, 0xcf, 0xa6, 0x16,
+	0x0b, 0xf7, 0xee, 0x2f, 0xe7, 0x22, 0x97, 0x46, 0x15, 0x40, 0x49, 0x49, 0x8d, 0x37, 0xb9, 0x08,
+	0xf7, 0xee, 0x2f, 0x67, 0xd4, 0x95, 0xba, 0x98, 0xbe, 0xfb, 0xee, 0xd2, 0x44, 0xed, 0xf2, 0xfb,
+	0x8f, 0x97, 0x8c, 0x47, 0x8f, 0x97, 0x8c, 0x7f, 0x3e, 0x5e, 0x32, 0xde, 0x7e, 0xb2, 0x34, 0xf1,
+	0xe8, 0xc9, 0xd2, 0xc4, 0x5f, 0x9e, 0x2c, 0x4d, 0x7c, 0xe7, 0x8d, 0x67, 0x06, 0xd6, 0x3b, 0xd1,
+	0xc7, 0x78, 0x19, 0x62, 0x1b, 0x19, 0x49, 0xdc, 0x97, 0xfe, 0x3f, 0x00, 0xec, 0x40, 0x72, 0xc6,
+	0xab, 0x1f, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1256,478 +1693,537 @@ func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_
 func StakingDescription() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 7521 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7c, 0x6b, 0x90, 0x24, 0xd9,
-		0x75, 0x56, 0xd7, 0xa3, 0xab, 0xab, 0x4e, 0x55, 0x57, 0x67, 0xdf, 0xee, 0x99, 0xa9, 0xe9, 0xdd,
-		0xed, 0xee, 0xad, 0x7d, 0xcc, 0xec, 0xab, 0x67, 0x77, 0x76, 0x67, 0x66, 0xa7, 0xc6, 0xd2, 0x52,
-		0xaf, 0x99, 0xe9, 0x99, 0x7e, 0xd4, 0x66, 0x75, 0xcf, 0x3e, 0x8c, 0xc9, 0xc8, 0xce, 0xba, 0x5d,
-		0x9d, 0xdb, 0x59, 0x99, 0xe9, 0xcc, 0xac, 0x99, 0xe9, 0x0d, 0x43, 0xac, 0x42, 0x3c, 0xa4, 0x21,
-		0x00, 0x19, 0x13, 0x58, 0x96, 0x35, 0x62, 0xd7, 0x32, 0xc8, 0x08, 0x01, 0xb6, 0x25, 0x04, 0xc6,
-		0x01, 0x08, 0x08, 0x40, 0xd6, 0x0f, 0x42, 0xf6, 0x0f, 0x6c, 0x83, 0x59, 0xcc, 0x4a, 0x01, 0x8b,
-		0x10, 0x58, 0x88, 0x25, 0x02, 0x42, 0x61, 0x82, 0xb8, 0xaf, 0x7c, 0xd4, 0xa3, 0xab, 0x7a, 0x98,
-		0x95, 0x1d, 0xe1, 0x5f, 0x5d, 0x79, 0xee, 0xf9, 0xbe, 0x3c, 0xf7, 0xdc, 0x73, 0xcf, 0x3d, 0xf7,
-		0x66, 0x66, 0xc3, 0x3f, 0xbf, 0x04, 0xcb, 0x6d, 0xcb, 0x6a, 0x1b, 0xf8, 0x8c, 0xed, 0x58, 0x9e,
-		0xb5, 0xd3, 0xdd, 0x3d, 0xd3, 0xc2, 0xae, 0xe6, 0xe8, 0xb6, 0x67, 0x39, 0x2b, 0x54, 0x86, 0x66,
-		0x98, 0xc6, 0x8a, 0xd0, 0x28, 0xae, 0xc3, 0xec, 0x65, 0xdd, 0xc0, 0x35, 0x5f, 0xb1, 0x89, 0x3d,
-		0xf4, 0x22, 0x24, 0x77, 0x75, 0x03, 0x17, 0x62, 0xcb, 0x89, 0xd3, 0xd9, 0xb3, 0x8f, 0xae, 0xf4,
-		0x80, 0x56, 0xa2, 0x88, 0x06, 0x11, 0xcb, 0x14, 0x51, 0xfc, 0x76, 0x12, 0xe6, 0x06, 0xb4, 0x22,
-		0x04, 0x49, 0x53, 0xed, 0x10, 0xc6, 0xd8, 0xe9, 0x8c, 0x4c, 0x7f, 0xa3, 0x02, 0x4c, 0xd9, 0xaa,
-		0xb6, 0xaf, 0xb6, 0x71, 0x21, 0x4e, 0xc5, 0xe2, 0x12, 0x2d, 0x02, 0xb4, 0xb0, 0x8d, 0xcd, 0x16,
-		0x36, 0xb5, 0x83, 0x42, 0x62, 0x39, 0x71, 0x3a, 0x23, 0x87, 0x24, 0xe8, 0x29, 0x98, 0xb5, 0xbb,
-		0x3b, 0x86, 0xae, 0x29, 0x21, 0x35, 0x58, 0x4e, 0x9c, 0x9e, 0x94, 0x25, 0xd6, 0x50, 0x0b, 0x94,
-		0x4f, 0xc1, 0xcc, 0x2d, 0xac, 0xee, 0x87, 0x55, 0xb3, 0x54, 0x35, 0x4f, 0xc4, 0x21, 0xc5, 0x2a,
-		0xe4, 0x3a, 0xd8, 0x75, 0xd5, 0x36, 0x56, 0xbc, 0x03, 0x1b, 0x17, 0x92, 0xb4, 0xf7, 0xcb, 0x7d,
-		0xbd, 0xef, 0xed, 0x79, 0x96, 0xa3, 0xb6, 0x0e, 0x6c, 0x8c, 0xca, 0x90, 0xc1, 0x66, 0xb7, 0xc3,
-		0x18, 0x26, 0x87, 0xf8, 0xaf, 0x6e, 0x76, 0x3b, 0xbd, 0x2c, 0x69, 0x02, 0xe3, 0x14, 0x53, 0x2e,
-		0x76, 0x6e, 0xea, 0x1a, 0x2e, 0xa4, 0x28, 0xc1, 0xa9, 0x3e, 0x82, 0x26, 0x6b, 0xef, 0xe5, 0x10,
-		0x38, 0x54, 0x85, 0x0c, 0xbe, 0xed, 0x61, 0xd3, 0xd5, 0x2d, 0xb3, 0x30, 0x45, 0x49, 0x1e, 0x1b,
-		0x30, 0x8a, 0xd8, 0x68, 0xf5, 0x52, 0x04, 0x38, 0x74, 0x1e, 0xa6, 0x2c, 0xdb, 0xd3, 0x2d, 0xd3,
-		0x2d, 0xa4, 0x97, 0x63, 0xa7, 0xb3, 0x67, 0x1f, 0x1c, 0x18, 0x08, 0x9b, 0x4c, 0x47, 0x16, 0xca,
-		0x68, 0x15, 0x24, 0xd7, 0xea, 0x3a, 0x1a, 0x56, 0x34, 0xab, 0x85, 0x15, 0xdd, 0xdc, 0xb5, 0x0a,
-		0x19, 0x4a, 0xb0, 0xd4, 0xdf, 0x11, 0xaa, 0x58, 0xb5, 0x5a, 0x78, 0xd5, 0xdc, 0xb5, 0xe4, 0xbc,
-		0x1b, 0xb9, 0x46, 0xc7, 0x21, 0xe5, 0x1e, 0x98, 0x9e, 0x7a, 0xbb, 0x90, 0xa3, 0x11, 0xc2, 0xaf,
-		0x8a, 0xbf, 0x92, 0x82, 0x99, 0x71, 0x42, 0xec, 0x12, 0x4c, 0xee, 0x92, 0x5e, 0x16, 0xe2, 0x47,
-		0xf1, 0x01, 0xc3, 0x44, 0x9d, 0x98, 0xba, 0x47, 0x27, 0x96, 0x21, 0x6b, 0x62, 0xd7, 0xc3, 0x2d,
-		0x16, 0x11, 0x89, 0x31, 0x63, 0x0a, 0x18, 0xa8, 0x3f, 0xa4, 0x92, 0xf7, 0x14, 0x52, 0xaf, 0xc2,
-		0x8c, 0x6f, 0x92, 0xe2, 0xa8, 0x66, 0x5b, 0xc4, 0xe6, 0x99, 0x51, 0x96, 0xac, 0xd4, 0x05, 0x4e,
-		0x26, 0x30, 0x39, 0x8f, 0x23, 0xd7, 0xa8, 0x06, 0x60, 0x99, 0xd8, 0xda, 0x55, 0x5a, 0x58, 0x33,
-		0x0a, 0xe9, 0x21, 0x5e, 0xda, 0x24, 0x2a, 0x7d, 0x5e, 0xb2, 0x98, 0x54, 0x33, 0xd0, 0xc5, 0x20,
-		0xd4, 0xa6, 0x86, 0x44, 0xca, 0x3a, 0x9b, 0x64, 0x7d, 0xd1, 0xb6, 0x0d, 0x79, 0x07, 0x93, 0xb8,
-		0xc7, 0x2d, 0xde, 0xb3, 0x0c, 0x35, 0x62, 0x65, 0x64, 0xcf, 0x64, 0x0e, 0x63, 0x1d, 0x9b, 0x76,
-		0xc2, 0x97, 0xe8, 0x11, 0xf0, 0x05, 0x0a, 0x0d, 0x2b, 0xa0, 0x59, 0x28, 0x27, 0x84, 0x1b, 0x6a,
-		0x07, 0x2f, 0xbc, 0x09, 0xf9, 0xa8, 0x7b, 0xd0, 0x3c, 0x4c, 0xba, 0x9e, 0xea, 0x78, 0x34, 0x0a,
-		0x27, 0x65, 0x76, 0x81, 0x24, 0x48, 0x60, 0xb3, 0x45, 0xb3, 0xdc, 0xa4, 0x4c, 0x7e, 0xa2, 0x3f,
-		0x16, 0x74, 0x38, 0x41, 0x3b, 0xfc, 0x78, 0xff, 0x88, 0x46, 0x98, 0x7b, 0xfb, 0xbd, 0x70, 0x01,
-		0xa6, 0x23, 0x1d, 0x18, 0xf7, 0xd6, 0xc5, 0x9f, 0x80, 0x63, 0x03, 0xa9, 0xd1, 0xab, 0x30, 0xdf,
-		0x35, 0x75, 0xd3, 0xc3, 0x8e, 0xed, 0x60, 0x12, 0xb1, 0xec, 0x56, 0x85, 0xff, 0x3c, 0x35, 0x24,
-		0xe6, 0xb6, 0xc3, 0xda, 0x8c, 0x45, 0x9e, 0xeb, 0xf6, 0x0b, 0x9f, 0xcc, 0xa4, 0xdf, 0x9f, 0x92,
-		0xde, 0x7a, 0xeb, 0xad, 0xb7, 0xe2, 0xc5, 0x7f, 0x9a, 0x82, 0xf9, 0x41, 0x73, 0x66, 0xe0, 0xf4,
-		0x3d, 0x0e, 0x29, 0xb3, 0xdb, 0xd9, 0xc1, 0x0e, 0x75, 0xd2, 0xa4, 0xcc, 0xaf, 0x50, 0x19, 0x26,
-		0x0d, 0x75, 0x07, 0x1b, 0x85, 0xe4, 0x72, 0xec, 0x74, 0xfe, 0xec, 0x53, 0x63, 0xcd, 0xca, 0x95,
-		0x35, 0x02, 0x91, 0x19, 0x12, 0x7d, 0x14, 0x92, 0x3c, 0x45, 0x13, 0x86, 0x27, 0xc7, 0x63, 0x20,
-		0x73, 0x49, 0xa6, 0x38, 0xf4, 0x00, 0x64, 0xc8, 0x5f, 0x16, 0x1b, 0x29, 0x6a, 0x73, 0x9a, 0x08,
-		0x48, 0x5c, 0xa0, 0x05, 0x48, 0xd3, 0x69, 0xd2, 0xc2, 0x62, 0x69, 0xf3, 0xaf, 0x49, 0x60, 0xb5,
-		0xf0, 0xae, 0xda, 0x35, 0x3c, 0xe5, 0xa6, 0x6a, 0x74, 0x31, 0x0d, 0xf8, 0x8c, 0x9c, 0xe3, 0xc2,
-		0x1b, 0x44, 0x86, 0x96, 0x20, 0xcb, 0x66, 0x95, 0x6e, 0xb6, 0xf0, 0x6d, 0x9a, 0x3d, 0x27, 0x65,
-		0x36, 0xd1, 0x56, 0x89, 0x84, 0xdc, 0xfe, 0x0d, 0xd7, 0x32, 0x45, 0x68, 0xd2, 0x5b, 0x10, 0x01,
-		0xbd, 0xfd, 0x85, 0xde, 0xc4, 0xfd, 0xd0, 0xe0, 0xee, 0xf5, 0xcd, 0xa5, 0x53, 0x30, 0x43, 0x35,
-		0x9e, 0xe7, 0x43, 0xaf, 0x1a, 0x85, 0xd9, 0xe5, 0xd8, 0xe9, 0xb4, 0x9c, 0x67, 0xe2, 0x4d, 0x2e,
-		0x2d, 0x7e, 0x35, 0x0e, 0x49, 0x9a, 0x58, 0x66, 0x20, 0xbb, 0xf5, 0x5a, 0xa3, 0xae, 0xd4, 0x36,
-		0xb7, 0x2b, 0x6b, 0x75, 0x29, 0x86, 0xf2, 0x00, 0x54, 0x70, 0x79, 0x6d, 0xb3, 0xbc, 0x25, 0xc5,
-		0xfd, 0xeb, 0xd5, 0x8d, 0xad, 0xf3, 0x2f, 0x48, 0x09, 0x1f, 0xb0, 0xcd, 0x04, 0xc9, 0xb0, 0xc2,
-		0xf3, 0x67, 0xa5, 0x49, 0x24, 0x41, 0x8e, 0x11, 0xac, 0xbe, 0x5a, 0xaf, 0x9d, 0x7f, 0x41, 0x4a,
-		0x45, 0x25, 0xcf, 0x9f, 0x95, 0xa6, 0xd0, 0x34, 0x64, 0xa8, 0xa4, 0xb2, 0xb9, 0xb9, 0x26, 0xa5,
-		0x7d, 0xce, 0xe6, 0x96, 0xbc, 0xba, 0x71, 0x45, 0xca, 0xf8, 0x9c, 0x57, 0xe4, 0xcd, 0xed, 0x86,
-		0x04, 0x3e, 0xc3, 0x7a, 0xbd, 0xd9, 0x2c, 0x5f, 0xa9, 0x4b, 0x59, 0x5f, 0xa3, 0xf2, 0xda, 0x56,
-		0xbd, 0x29, 0xe5, 0x22, 0x66, 0x3d, 0x7f, 0x56, 0x9a, 0xf6, 0x6f, 0x51, 0xdf, 0xd8, 0x5e, 0x97,
-		0xf2, 0x68, 0x16, 0xa6, 0xd9, 0x2d, 0x84, 0x11, 0x33, 0x3d, 0xa2, 0xf3, 0x2f, 0x48, 0x52, 0x60,
-		0x08, 0x63, 0x99, 0x8d, 0x08, 0xce, 0xbf, 0x20, 0xa1, 0x62, 0x15, 0x26, 0x69, 0x18, 0x22, 0x04,
-		0xf9, 0xb5, 0x72, 0xa5, 0xbe, 0xa6, 0x6c, 0x36, 0xb6, 0x56, 0x37, 0x37, 0xca, 0x6b, 0x52, 0x2c,
-		0x90, 0xc9, 0xf5, 0x97, 0xb7, 0x57, 0xe5, 0x7a, 0x4d, 0x8a, 0x87, 0x65, 0x8d, 0x7a, 0x79, 0xab,
-		0x5e, 0x93, 0x12, 0x45, 0x0d, 0xe6, 0x07, 0x25, 0xd4, 0x81, 0x53, 0x28, 0x14, 0x0b, 0xf1, 0x21,
-		0xb1, 0x40, 0xb9, 0x7a, 0x63, 0xa1, 0xf8, 0xad, 0x38, 0xcc, 0x0d, 0x58, 0x54, 0x06, 0xde, 0xe4,
-		0x25, 0x98, 0x64, 0xb1, 0xcc, 0x96, 0xd9, 0x27, 0x06, 0xae, 0x4e, 0x34, 0xb2, 0xfb, 0x96, 0x5a,
-		0x8a, 0x0b, 0x97, 0x1a, 0x89, 0x21, 0xa5, 0x06, 0xa1, 0xe8, 0x0b, 0xd8, 0x1f, 0xeb, 0x4b, 0xfe,
-		0x6c, 0x7d, 0x3c, 0x3f, 0xce, 0xfa, 0x48, 0x65, 0x47, 0x5b, 0x04, 0x26, 0x07, 0x2c, 0x02, 0x97,
-		0x60, 0xb6, 0x8f, 0x68, 0xec, 0x64, 0xfc, 0xf1, 0x18, 0x14, 0x86, 0x39, 0x67, 0x44, 0x4a, 0x8c,
-		0x47, 0x52, 0xe2, 0xa5, 0x5e, 0x0f, 0x3e, 0x3c, 0x7c, 0x10, 0xfa, 0xc6, 0xfa, 0x0b, 0x31, 0x38,
-		0x3e, 0xb8, 0xa4, 0x1c, 0x68, 0xc3, 0x47, 0x21, 0xd5, 0xc1, 0xde, 0x9e, 0x25, 0xca, 0xaa, 0xc7,
-		0x07, 0x2c, 0xd6, 0xa4, 0xb9, 0x77, 0xb0, 0x39, 0x2a, 0xbc, 0xda, 0x27, 0x86, 0xd5, 0x85, 0xcc,
-		0x9a, 0x3e, 0x4b, 0x3f, 0x19, 0x87, 0x63, 0x03, 0xc9, 0x07, 0x1a, 0xfa, 0x10, 0x80, 0x6e, 0xda,
-		0x5d, 0x8f, 0x95, 0x4e, 0x2c, 0x13, 0x67, 0xa8, 0x84, 0x26, 0x2f, 0x92, 0x65, 0xbb, 0x9e, 0xdf,
-		0x9e, 0xa0, 0xed, 0xc0, 0x44, 0x54, 0xe1, 0xc5, 0xc0, 0xd0, 0x24, 0x35, 0x74, 0x71, 0x48, 0x4f,
-		0xfb, 0x02, 0xf3, 0x59, 0x90, 0x34, 0x43, 0xc7, 0xa6, 0xa7, 0xb8, 0x9e, 0x83, 0xd5, 0x8e, 0x6e,
-		0xb6, 0xe9, 0x52, 0x93, 0x2e, 0x4d, 0xee, 0xaa, 0x86, 0x8b, 0xe5, 0x19, 0xd6, 0xdc, 0x14, 0xad,
-		0x04, 0x41, 0x03, 0xc8, 0x09, 0x21, 0x52, 0x11, 0x04, 0x6b, 0xf6, 0x11, 0xc5, 0x9f, 0xcc, 0x40,
-		0x36, 0x54, 0x80, 0xa3, 0x87, 0x21, 0xf7, 0x86, 0x7a, 0x53, 0x55, 0xc4, 0xa6, 0x8a, 0x79, 0x22,
-		0x4b, 0x64, 0x0d, 0xbe, 0xb1, 0x7a, 0x16, 0xe6, 0xa9, 0x8a, 0xd5, 0xf5, 0xb0, 0xa3, 0x68, 0x86,
-		0xea, 0xba, 0xd4, 0x69, 0x69, 0xaa, 0x8a, 0x48, 0xdb, 0x26, 0x69, 0xaa, 0x8a, 0x16, 0x74, 0x0e,
-		0xe6, 0x28, 0xa2, 0xd3, 0x35, 0x3c, 0xdd, 0x36, 0xb0, 0x42, 0xb6, 0x79, 0x2e, 0x5d, 0x72, 0x7c,
-		0xcb, 0x66, 0x89, 0xc6, 0x3a, 0x57, 0x20, 0x16, 0xb9, 0xa8, 0x06, 0x0f, 0x51, 0x58, 0x1b, 0x9b,
-		0xd8, 0x51, 0x3d, 0xac, 0xe0, 0x1f, 0xef, 0xaa, 0x86, 0xab, 0xa8, 0x66, 0x4b, 0xd9, 0x53, 0xdd,
-		0xbd, 0xc2, 0x3c, 0x21, 0xa8, 0xc4, 0x0b, 0x31, 0xf9, 0x24, 0x51, 0xbc, 0xc2, 0xf5, 0xea, 0x54,
-		0xad, 0x6c, 0xb6, 0xae, 0xaa, 0xee, 0x1e, 0x2a, 0xc1, 0x71, 0xca, 0xe2, 0x7a, 0x8e, 0x6e, 0xb6,
-		0x15, 0x6d, 0x0f, 0x6b, 0xfb, 0x4a, 0xd7, 0xdb, 0x7d, 0xb1, 0xf0, 0x40, 0xf8, 0xfe, 0xd4, 0xc2,
-		0x26, 0xd5, 0xa9, 0x12, 0x95, 0x6d, 0x6f, 0xf7, 0x45, 0xd4, 0x84, 0x1c, 0x19, 0x8c, 0x8e, 0xfe,
-		0x26, 0x56, 0x76, 0x2d, 0x87, 0xae, 0xa1, 0xf9, 0x01, 0xa9, 0x29, 0xe4, 0xc1, 0x95, 0x4d, 0x0e,
-		0x58, 0xb7, 0x5a, 0xb8, 0x34, 0xd9, 0x6c, 0xd4, 0xeb, 0x35, 0x39, 0x2b, 0x58, 0x2e, 0x5b, 0x0e,
-		0x09, 0xa8, 0xb6, 0xe5, 0x3b, 0x38, 0xcb, 0x02, 0xaa, 0x6d, 0x09, 0xf7, 0x9e, 0x83, 0x39, 0x4d,
-		0x63, 0x7d, 0xd6, 0x35, 0x85, 0x6f, 0xc6, 0xdc, 0x82, 0x14, 0x71, 0x96, 0xa6, 0x5d, 0x61, 0x0a,
-		0x3c, 0xc6, 0x5d, 0x74, 0x11, 0x8e, 0x05, 0xce, 0x0a, 0x03, 0x67, 0xfb, 0x7a, 0xd9, 0x0b, 0x3d,
-		0x07, 0x73, 0xf6, 0x41, 0x3f, 0x10, 0x45, 0xee, 0x68, 0x1f, 0xf4, 0xc2, 0x2e, 0xc0, 0xbc, 0xbd,
-		0x67, 0xf7, 0xe3, 0x9e, 0x0c, 0xe3, 0x90, 0xbd, 0x67, 0xf7, 0x02, 0x1f, 0xa3, 0x3b, 0x73, 0x07,
-		0x6b, 0xaa, 0x87, 0x5b, 0x85, 0x13, 0x61, 0xf5, 0x50, 0x03, 0x5a, 0x01, 0x49, 0xd3, 0x14, 0x6c,
-		0xaa, 0x3b, 0x06, 0x56, 0x54, 0x07, 0x9b, 0xaa, 0x5b, 0x58, 0xa2, 0xca, 0x49, 0xcf, 0xe9, 0x62,
-		0x39, 0xaf, 0x69, 0x75, 0xda, 0x58, 0xa6, 0x6d, 0xe8, 0x49, 0x98, 0xb5, 0x76, 0xde, 0xd0, 0x58,
-		0x44, 0x2a, 0xb6, 0x83, 0x77, 0xf5, 0xdb, 0x85, 0x47, 0xa9, 0x7b, 0x67, 0x48, 0x03, 0x8d, 0xc7,
-		0x06, 0x15, 0xa3, 0x27, 0x40, 0xd2, 0xdc, 0x3d, 0xd5, 0xb1, 0x69, 0x4a, 0x76, 0x6d, 0x55, 0xc3,
-		0x85, 0xc7, 0x98, 0x2a, 0x93, 0x6f, 0x08, 0x31, 0x99, 0x11, 0xee, 0x2d, 0x7d, 0xd7, 0x13, 0x8c,
-		0xa7, 0xd8, 0x8c, 0xa0, 0x32, 0xce, 0x76, 0x1a, 0x24, 0xe2, 0x89, 0xc8, 0x8d, 0x4f, 0x53, 0xb5,
-		0xbc, 0xbd, 0x67, 0x87, 0xef, 0xfb, 0x08, 0x4c, 0x13, 0xcd, 0xe0, 0xa6, 0x4f, 0xb0, 0xc2, 0xcd,
-		0xde, 0x0b, 0xdd, 0xf1, 0x05, 0x38, 0x4e, 0x94, 0x3a, 0xd8, 0x53, 0x5b, 0xaa, 0xa7, 0x86, 0xb4,
-		0x9f, 0xa6, 0xda, 0xc4, 0xed, 0xeb, 0xbc, 0x31, 0x62, 0xa7, 0xd3, 0xdd, 0x39, 0xf0, 0x03, 0xeb,
-		0x19, 0x66, 0x27, 0x91, 0x89, 0xd0, 0xfa, 0xd0, 0x8a, 0xf3, 0x62, 0x09, 0x72, 0xe1, 0xb8, 0x47,
-		0x19, 0x60, 0x91, 0x2f, 0xc5, 0x48, 0x11, 0x54, 0xdd, 0xac, 0x91, 0xf2, 0xe5, 0xf5, 0xba, 0x14,
-		0x27, 0x65, 0xd4, 0xda, 0xea, 0x56, 0x5d, 0x91, 0xb7, 0x37, 0xb6, 0x56, 0xd7, 0xeb, 0x52, 0x22,
-		0x54, 0xd8, 0x5f, 0x4b, 0xa6, 0x1f, 0x97, 0x4e, 0x91, 0xaa, 0x21, 0x1f, 0xdd, 0xa9, 0xa1, 0x1f,
-		0x81, 0x13, 0xe2, 0x58, 0xc5, 0xc5, 0x9e, 0x72, 0x4b, 0x77, 0xe8, 0x84, 0xec, 0xa8, 0x6c, 0x71,
-		0xf4, 0xe3, 0x67, 0x9e, 0x6b, 0x35, 0xb1, 0xf7, 0x8a, 0xee, 0x90, 0xe9, 0xd6, 0x51, 0x3d, 0xb4,
-		0x06, 0x4b, 0xa6, 0xa5, 0xb8, 0x9e, 0x6a, 0xb6, 0x54, 0xa7, 0xa5, 0x04, 0x07, 0x5a, 0x8a, 0xaa,
-		0x69, 0xd8, 0x75, 0x2d, 0xb6, 0x10, 0xfa, 0x2c, 0x0f, 0x9a, 0x56, 0x93, 0x2b, 0x07, 0x2b, 0x44,
-		0x99, 0xab, 0xf6, 0x84, 0x6f, 0x62, 0x58, 0xf8, 0x3e, 0x00, 0x99, 0x8e, 0x6a, 0x2b, 0xd8, 0xf4,
-		0x9c, 0x03, 0x5a, 0x9f, 0xa7, 0xe5, 0x74, 0x47, 0xb5, 0xeb, 0xe4, 0xfa, 0x87, 0xb2, 0x4d, 0xba,
-		0x96, 0x4c, 0x27, 0xa5, 0xc9, 0x6b, 0xc9, 0xf4, 0xa4, 0x94, 0xba, 0x96, 0x4c, 0xa7, 0xa4, 0xa9,
-		0x6b, 0xc9, 0x74, 0x5a, 0xca, 0x5c, 0x4b, 0xa6, 0x33, 0x12, 0x14, 0xdf, 0x4b, 0x40, 0x2e, 0x5c,
-		0xc1, 0x93, 0x0d, 0x91, 0x46, 0xd7, 0xb0, 0x18, 0xcd, 0x72, 0x8f, 0x1c, 0x5a, 0xef, 0xaf, 0x54,
-		0xc9, 0xe2, 0x56, 0x4a, 0xb1, 0x72, 0x59, 0x66, 0x48, 0x52, 0x58, 0x90, 0xf0, 0xc3, 0xac, 0x3c,
-		0x49, 0xcb, 0xfc, 0x0a, 0x5d, 0x81, 0xd4, 0x1b, 0x2e, 0xe5, 0x4e, 0x51, 0xee, 0x47, 0x0f, 0xe7,
-		0xbe, 0xd6, 0xa4, 0xe4, 0x99, 0x6b, 0x4d, 0x65, 0x63, 0x53, 0x5e, 0x2f, 0xaf, 0xc9, 0x1c, 0x8e,
-		0x4e, 0x42, 0xd2, 0x50, 0xdf, 0x3c, 0x88, 0x2e, 0x83, 0x54, 0x34, 0xee, 0xb0, 0x9c, 0x84, 0xe4,
-		0x2d, 0xac, 0xee, 0x47, 0x17, 0x1f, 0x2a, 0xfa, 0x10, 0xa7, 0xc7, 0x19, 0x98, 0xa4, 0xfe, 0x42,
-		0x00, 0xdc, 0x63, 0xd2, 0x04, 0x4a, 0x43, 0xb2, 0xba, 0x29, 0x93, 0x29, 0x22, 0x41, 0x8e, 0x49,
-		0x95, 0xc6, 0x6a, 0xbd, 0x5a, 0x97, 0xe2, 0xc5, 0x73, 0x90, 0x62, 0x4e, 0x20, 0xd3, 0xc7, 0x77,
-		0x83, 0x34, 0xc1, 0x2f, 0x39, 0x47, 0x4c, 0xb4, 0x6e, 0xaf, 0x57, 0xea, 0xb2, 0x14, 0xef, 0x1b,
-		0xfc, 0xa2, 0x0b, 0xb9, 0x70, 0x65, 0xfe, 0xc3, 0xd9, 0x9e, 0x7f, 0x2d, 0x06, 0xd9, 0x50, 0xa5,
-		0x4d, 0x4a, 0x24, 0xd5, 0x30, 0xac, 0x5b, 0x8a, 0x6a, 0xe8, 0xaa, 0xcb, 0x43, 0x03, 0xa8, 0xa8,
-		0x4c, 0x24, 0xe3, 0x0e, 0xdd, 0x0f, 0x69, 0xd2, 0x4c, 0x4a, 0xa9, 0xe2, 0xe7, 0x62, 0x20, 0xf5,
-		0x96, 0xba, 0x3d, 0x66, 0xc6, 0xfe, 0x20, 0xcd, 0x2c, 0x7e, 0x36, 0x06, 0xf9, 0x68, 0x7d, 0xdb,
-		0x63, 0xde, 0xc3, 0x7f, 0xa0, 0xe6, 0xfd, 0x6e, 0x1c, 0xa6, 0x23, 0x55, 0xed, 0xb8, 0xd6, 0xfd,
-		0x38, 0xcc, 0xea, 0x2d, 0xdc, 0xb1, 0x2d, 0x0f, 0x9b, 0xda, 0x81, 0x62, 0xe0, 0x9b, 0xd8, 0x28,
-		0x14, 0x69, 0xd2, 0x38, 0x73, 0x78, 0xdd, 0xbc, 0xb2, 0x1a, 0xe0, 0xd6, 0x08, 0xac, 0x34, 0xb7,
-		0x5a, 0xab, 0xaf, 0x37, 0x36, 0xb7, 0xea, 0x1b, 0xd5, 0xd7, 0x94, 0xed, 0x8d, 0xeb, 0x1b, 0x9b,
-		0xaf, 0x6c, 0xc8, 0x92, 0xde, 0xa3, 0xf6, 0x21, 0x4e, 0xfb, 0x06, 0x48, 0xbd, 0x46, 0xa1, 0x13,
-		0x30, 0xc8, 0x2c, 0x69, 0x02, 0xcd, 0xc1, 0xcc, 0xc6, 0xa6, 0xd2, 0x5c, 0xad, 0xd5, 0x95, 0xfa,
-		0xe5, 0xcb, 0xf5, 0xea, 0x56, 0x93, 0x9d, 0x84, 0xf8, 0xda, 0x5b, 0x91, 0x09, 0x5e, 0xfc, 0x4c,
-		0x02, 0xe6, 0x06, 0x58, 0x82, 0xca, 0x7c, 0x0f, 0xc3, 0xb6, 0x55, 0xcf, 0x8c, 0x63, 0xfd, 0x0a,
-		0xa9, 0x22, 0x1a, 0xaa, 0xe3, 0xf1, 0x2d, 0xcf, 0x13, 0x40, 0xbc, 0x64, 0x7a, 0xfa, 0xae, 0x8e,
-		0x1d, 0x7e, 0xc2, 0xc4, 0x36, 0x36, 0x33, 0x81, 0x9c, 0x1d, 0x32, 0x3d, 0x0d, 0xc8, 0xb6, 0x5c,
-		0xdd, 0xd3, 0x6f, 0x62, 0x45, 0x37, 0xc5, 0x71, 0x14, 0xd9, 0xe8, 0x24, 0x65, 0x49, 0xb4, 0xac,
-		0x9a, 0x9e, 0xaf, 0x6d, 0xe2, 0xb6, 0xda, 0xa3, 0x4d, 0x92, 0x79, 0x42, 0x96, 0x44, 0x8b, 0xaf,
-		0xfd, 0x30, 0xe4, 0x5a, 0x56, 0x97, 0x54, 0x7f, 0x4c, 0x8f, 0xac, 0x1d, 0x31, 0x39, 0xcb, 0x64,
-		0xbe, 0x0a, 0xaf, 0xeb, 0x83, 0x73, 0xb0, 0x9c, 0x9c, 0x65, 0x32, 0xa6, 0x72, 0x0a, 0x66, 0xd4,
-		0x76, 0xdb, 0x21, 0xe4, 0x82, 0x88, 0xed, 0x54, 0xf2, 0xbe, 0x98, 0x2a, 0x2e, 0x5c, 0x83, 0xb4,
-		0xf0, 0x03, 0x59, 0xbc, 0x89, 0x27, 0x14, 0x9b, 0x6d, 0xbf, 0xe3, 0xa7, 0x33, 0x72, 0xda, 0x14,
-		0x8d, 0x0f, 0x43, 0x4e, 0x77, 0x95, 0xe0, 0x58, 0x3f, 0xbe, 0x1c, 0x3f, 0x9d, 0x96, 0xb3, 0xba,
-		0xeb, 0x1f, 0x89, 0x16, 0xbf, 0x10, 0x87, 0x7c, 0xf4, 0xb1, 0x04, 0xaa, 0x41, 0xda, 0xb0, 0x34,
-		0x95, 0x86, 0x16, 0x7b, 0x26, 0x76, 0x7a, 0xc4, 0x93, 0x8c, 0x95, 0x35, 0xae, 0x2f, 0xfb, 0xc8,
-		0x85, 0x7f, 0x1d, 0x83, 0xb4, 0x10, 0xa3, 0xe3, 0x90, 0xb4, 0x55, 0x6f, 0x8f, 0xd2, 0x4d, 0x56,
-		0xe2, 0x52, 0x4c, 0xa6, 0xd7, 0x44, 0xee, 0xda, 0xaa, 0x49, 0x43, 0x80, 0xcb, 0xc9, 0x35, 0x19,
-		0x57, 0x03, 0xab, 0x2d, 0xba, 0x0d, 0xb2, 0x3a, 0x1d, 0x6c, 0x7a, 0xae, 0x18, 0x57, 0x2e, 0xaf,
-		0x72, 0x31, 0x7a, 0x0a, 0x66, 0x3d, 0x47, 0xd5, 0x8d, 0x88, 0x6e, 0x92, 0xea, 0x4a, 0xa2, 0xc1,
-		0x57, 0x2e, 0xc1, 0x49, 0xc1, 0xdb, 0xc2, 0x9e, 0xaa, 0xed, 0xe1, 0x56, 0x00, 0x4a, 0xd1, 0xe3,
-		0x8e, 0x13, 0x5c, 0xa1, 0xc6, 0xdb, 0x05, 0xb6, 0xf8, 0xeb, 0x31, 0x98, 0x15, 0x1b, 0xb7, 0x96,
-		0xef, 0xac, 0x75, 0x00, 0xd5, 0x34, 0x2d, 0x2f, 0xec, 0xae, 0xfe, 0x50, 0xee, 0xc3, 0xad, 0x94,
-		0x7d, 0x90, 0x1c, 0x22, 0x58, 0xe8, 0x00, 0x04, 0x2d, 0x43, 0xdd, 0xb6, 0x04, 0x59, 0xfe, 0xcc,
-		0x89, 0x3e, 0xb8, 0x64, 0x5b, 0x7d, 0x60, 0x22, 0xb2, 0xc3, 0x43, 0xf3, 0x30, 0xb9, 0x83, 0xdb,
-		0xba, 0xc9, 0x4f, 0x92, 0xd9, 0x85, 0x38, 0x90, 0x49, 0xfa, 0x07, 0x32, 0x95, 0x3f, 0x05, 0x73,
-		0x9a, 0xd5, 0xe9, 0x35, 0xb7, 0x22, 0xf5, 0x1c, 0x37, 0xb8, 0x57, 0x63, 0xaf, 0x3f, 0xc3, 0x95,
-		0xda, 0x96, 0xa1, 0x9a, 0xed, 0x15, 0xcb, 0x69, 0x07, 0x0f, 0x5e, 0x49, 0xc5, 0xe3, 0x86, 0x1e,
-		0xbf, 0xda, 0x3b, 0xff, 0x3b, 0x16, 0xfb, 0xb9, 0x78, 0xe2, 0x4a, 0xa3, 0xf2, 0xc5, 0xf8, 0xc2,
-		0x15, 0x06, 0x6c, 0x08, 0x67, 0xc8, 0x78, 0xd7, 0xc0, 0x1a, 0xe9, 0x20, 0x7c, 0xe7, 0x29, 0x98,
-		0x6f, 0x5b, 0x6d, 0x8b, 0x32, 0x9d, 0x21, 0xbf, 0xf8, 0x93, 0xdb, 0x8c, 0x2f, 0x5d, 0x18, 0xf9,
-		0x98, 0xb7, 0xb4, 0x01, 0x73, 0x5c, 0x59, 0xa1, 0x8f, 0x8e, 0xd8, 0xc6, 0x06, 0x1d, 0x7a, 0xaa,
-		0x56, 0xf8, 0xa5, 0x6f, 0xd3, 0xe5, 0x5b, 0x9e, 0xe5, 0x50, 0xd2, 0xc6, 0xf6, 0x3e, 0x25, 0x19,
-		0x8e, 0x45, 0xf8, 0xd8, 0x24, 0xc5, 0xce, 0x08, 0xc6, 0x7f, 0xc1, 0x19, 0xe7, 0x42, 0x8c, 0x4d,
-		0x0e, 0x2d, 0x55, 0x61, 0xfa, 0x28, 0x5c, 0xff, 0x92, 0x73, 0xe5, 0x70, 0x98, 0xe4, 0x0a, 0xcc,
-		0x50, 0x12, 0xad, 0xeb, 0x7a, 0x56, 0x87, 0x66, 0xc0, 0xc3, 0x69, 0xfe, 0xd5, 0xb7, 0xd9, 0xac,
-		0xc9, 0x13, 0x58, 0xd5, 0x47, 0x95, 0x4a, 0x40, 0x9f, 0x96, 0xb5, 0xb0, 0x66, 0x8c, 0x60, 0xf8,
-		0x3a, 0x37, 0xc4, 0xd7, 0x2f, 0xdd, 0x80, 0x79, 0xf2, 0x9b, 0x26, 0xa8, 0xb0, 0x25, 0xa3, 0x8f,
-		0xe0, 0x0a, 0xbf, 0xfe, 0x71, 0x36, 0x31, 0xe7, 0x7c, 0x82, 0x90, 0x4d, 0xa1, 0x51, 0x6c, 0x63,
-		0xcf, 0xc3, 0x8e, 0xab, 0xa8, 0xc6, 0x20, 0xf3, 0x42, 0x67, 0x18, 0x85, 0x9f, 0xf9, 0x6e, 0x74,
-		0x14, 0xaf, 0x30, 0x64, 0xd9, 0x30, 0x4a, 0xdb, 0x70, 0x62, 0x40, 0x54, 0x8c, 0xc1, 0xf9, 0x19,
-		0xce, 0x39, 0xdf, 0x17, 0x19, 0x84, 0xb6, 0x01, 0x42, 0xee, 0x8f, 0xe5, 0x18, 0x9c, 0x3f, 0xcb,
-		0x39, 0x11, 0xc7, 0x8a, 0x21, 0x25, 0x8c, 0xd7, 0x60, 0xf6, 0x26, 0x76, 0x76, 0x2c, 0x97, 0x9f,
-		0x1b, 0x8d, 0x41, 0xf7, 0x59, 0x4e, 0x37, 0xc3, 0x81, 0xf4, 0x20, 0x89, 0x70, 0x5d, 0x84, 0xf4,
-		0xae, 0xaa, 0xe1, 0x31, 0x28, 0xee, 0x72, 0x8a, 0x29, 0xa2, 0x4f, 0xa0, 0x65, 0xc8, 0xb5, 0x2d,
-		0xbe, 0x46, 0x8d, 0x86, 0x7f, 0x8e, 0xc3, 0xb3, 0x02, 0xc3, 0x29, 0x6c, 0xcb, 0xee, 0x1a, 0x64,
-		0x01, 0x1b, 0x4d, 0xf1, 0xd7, 0x04, 0x85, 0xc0, 0x70, 0x8a, 0x23, 0xb8, 0xf5, 0x6d, 0x41, 0xe1,
-		0x86, 0xfc, 0xf9, 0x12, 0x64, 0x2d, 0xd3, 0x38, 0xb0, 0xcc, 0x71, 0x8c, 0x78, 0x87, 0x33, 0x00,
-		0x87, 0x10, 0x82, 0x4b, 0x90, 0x19, 0x77, 0x20, 0xfe, 0xfa, 0x77, 0xc5, 0xf4, 0x10, 0x23, 0x70,
-		0x05, 0x66, 0x44, 0x82, 0xd2, 0x2d, 0x73, 0x0c, 0x8a, 0xbf, 0xc1, 0x29, 0xf2, 0x21, 0x18, 0xef,
-		0x86, 0x87, 0x5d, 0xaf, 0x8d, 0xc7, 0x21, 0xf9, 0x82, 0xe8, 0x06, 0x87, 0x70, 0x57, 0xee, 0x60,
-		0x53, 0xdb, 0x1b, 0x8f, 0xe1, 0x17, 0x84, 0x2b, 0x05, 0x86, 0x50, 0x54, 0x61, 0xba, 0xa3, 0x3a,
-		0xee, 0x9e, 0x6a, 0x8c, 0x35, 0x1c, 0x7f, 0x93, 0x73, 0xe4, 0x7c, 0x10, 0xf7, 0x48, 0xd7, 0x3c,
-		0x0a, 0xcd, 0x17, 0x85, 0x47, 0x42, 0x30, 0x3e, 0xf5, 0x5c, 0x8f, 0x1e, 0xb2, 0x1d, 0x85, 0xed,
-		0x6f, 0x89, 0xa9, 0xc7, 0xb0, 0xeb, 0x61, 0xc6, 0x4b, 0x90, 0x71, 0xf5, 0x37, 0xc7, 0xa2, 0xf9,
-		0x92, 0x18, 0x69, 0x0a, 0x20, 0xe0, 0xd7, 0xe0, 0xe4, 0xc0, 0x65, 0x62, 0x0c, 0xb2, 0xbf, 0xcd,
-		0xc9, 0x8e, 0x0f, 0x58, 0x2a, 0x78, 0x4a, 0x38, 0x2a, 0xe5, 0xdf, 0x11, 0x29, 0x01, 0xf7, 0x70,
-		0x35, 0xc8, 0xae, 0xc1, 0x55, 0x77, 0x8f, 0xe6, 0xb5, 0xbf, 0x2b, 0xbc, 0xc6, 0xb0, 0x11, 0xaf,
-		0x6d, 0xc1, 0x71, 0xce, 0x78, 0xb4, 0x71, 0xfd, 0x45, 0x91, 0x58, 0x19, 0x7a, 0x3b, 0x3a, 0xba,
-		0x3f, 0x0a, 0x0b, 0xbe, 0x3b, 0x45, 0x79, 0xea, 0x2a, 0x1d, 0xd5, 0x1e, 0x83, 0xf9, 0x97, 0x38,
-		0xb3, 0xc8, 0xf8, 0x7e, 0x7d, 0xeb, 0xae, 0xab, 0x36, 0x21, 0x7f, 0x15, 0x0a, 0x82, 0xbc, 0x6b,
-		0x3a, 0x58, 0xb3, 0xda, 0xa6, 0xfe, 0x26, 0x6e, 0x8d, 0x41, 0xfd, 0xcb, 0x3d, 0x43, 0xb5, 0x1d,
-		0x82, 0x13, 0xe6, 0x55, 0x90, 0xfc, 0x5a, 0x45, 0xd1, 0x3b, 0xb6, 0xe5, 0x78, 0x23, 0x18, 0xbf,
-		0x2c, 0x46, 0xca, 0xc7, 0xad, 0x52, 0x58, 0xa9, 0x0e, 0xec, 0xc9, 0xf3, 0xb8, 0x21, 0xf9, 0x15,
-		0x4e, 0x34, 0x1d, 0xa0, 0x78, 0xe2, 0xd0, 0xac, 0x8e, 0xad, 0x3a, 0xe3, 0xe4, 0xbf, 0xbf, 0x27,
-		0x12, 0x07, 0x87, 0xf0, 0xc4, 0x41, 0x2a, 0x3a, 0xb2, 0xda, 0x8f, 0xc1, 0xf0, 0x55, 0x91, 0x38,
-		0x04, 0x86, 0x53, 0x88, 0x82, 0x61, 0x0c, 0x8a, 0xbf, 0x2f, 0x28, 0x04, 0x86, 0x50, 0xbc, 0x1c,
-		0x2c, 0xb4, 0x0e, 0x6e, 0xeb, 0xae, 0xe7, 0xb0, 0xa2, 0xf8, 0x70, 0xaa, 0x7f, 0xf0, 0xdd, 0x68,
-		0x11, 0x26, 0x87, 0xa0, 0x24, 0x13, 0xf1, 0x63, 0x57, 0xba, 0x67, 0x1a, 0x6d, 0xd8, 0xaf, 0x88,
-		0x4c, 0x14, 0x82, 0x11, 0xdb, 0x42, 0x15, 0x22, 0x71, 0xbb, 0x46, 0x76, 0x0a, 0x63, 0xd0, 0xfd,
-		0xc3, 0x1e, 0xe3, 0x9a, 0x02, 0x4b, 0x38, 0x43, 0xf5, 0x4f, 0xd7, 0xdc, 0xc7, 0x07, 0x63, 0x45,
-		0xe7, 0xaf, 0xf6, 0xd4, 0x3f, 0xdb, 0x0c, 0xc9, 0x72, 0xc8, 0x4c, 0x4f, 0x3d, 0x85, 0x46, 0xbd,
-		0x67, 0x54, 0xf8, 0xd8, 0x07, 0xbc, 0xbf, 0xd1, 0x72, 0xaa, 0xb4, 0x46, 0x82, 0x3c, 0x5a, 0xf4,
-		0x8c, 0x26, 0xfb, 0xf8, 0x07, 0x7e, 0x9c, 0x47, 0x6a, 0x9e, 0xd2, 0x65, 0x98, 0x8e, 0x14, 0x3c,
-		0xa3, 0xa9, 0xfe, 0x34, 0xa7, 0xca, 0x85, 0xeb, 0x9d, 0xd2, 0x39, 0x48, 0x92, 0xe2, 0x65, 0x34,
-		0xfc, 0xcf, 0x70, 0x38, 0x55, 0x2f, 0x7d, 0x04, 0xd2, 0xa2, 0x68, 0x19, 0x0d, 0xfd, 0xb3, 0x1c,
-		0xea, 0x43, 0x08, 0x5c, 0x14, 0x2c, 0xa3, 0xe1, 0x7f, 0x4e, 0xc0, 0x05, 0x84, 0xc0, 0xc7, 0x77,
-		0xe1, 0xd7, 0xfe, 0x7c, 0x92, 0x2f, 0x3a, 0xc2, 0x77, 0x97, 0x60, 0x8a, 0x57, 0x2a, 0xa3, 0xd1,
-		0x9f, 0xe4, 0x37, 0x17, 0x88, 0xd2, 0x05, 0x98, 0x1c, 0xd3, 0xe1, 0x7f, 0x81, 0x43, 0x99, 0x7e,
-		0xa9, 0x0a, 0xd9, 0x50, 0x75, 0x32, 0x1a, 0xfe, 0x17, 0x39, 0x3c, 0x8c, 0x22, 0xa6, 0xf3, 0xea,
-		0x64, 0x34, 0xc1, 0x5f, 0x12, 0xa6, 0x73, 0x04, 0x71, 0x9b, 0x28, 0x4c, 0x46, 0xa3, 0x3f, 0x25,
-		0xbc, 0x2e, 0x20, 0xa5, 0x97, 0x20, 0xe3, 0x2f, 0x36, 0xa3, 0xf1, 0x3f, 0xc9, 0xf1, 0x01, 0x86,
-		0x78, 0x20, 0xb4, 0xd8, 0x8d, 0xa6, 0xf8, 0xcb, 0xc2, 0x03, 0x21, 0x14, 0x99, 0x46, 0xbd, 0x05,
-		0xcc, 0x68, 0xa6, 0x9f, 0x12, 0xd3, 0xa8, 0xa7, 0x7e, 0x21, 0xa3, 0x49, 0x73, 0xfe, 0x68, 0x8a,
-		0xbf, 0x22, 0x46, 0x93, 0xea, 0x13, 0x33, 0x7a, 0x2b, 0x82, 0xd1, 0x1c, 0x3f, 0x2d, 0xcc, 0xe8,
-		0x29, 0x08, 0x4a, 0x0d, 0x40, 0xfd, 0xd5, 0xc0, 0x68, 0xbe, 0x4f, 0x73, 0xbe, 0xd9, 0xbe, 0x62,
-		0xa0, 0xf4, 0x0a, 0x1c, 0x1f, 0x5c, 0x09, 0x8c, 0x66, 0xfd, 0x99, 0x0f, 0x7a, 0xf6, 0x6e, 0xe1,
-		0x42, 0xa0, 0xb4, 0x15, 0x2c, 0x29, 0xe1, 0x2a, 0x60, 0x34, 0xed, 0x67, 0x3e, 0x88, 0x26, 0xee,
-		0x70, 0x11, 0x50, 0x2a, 0x03, 0x04, 0x0b, 0xf0, 0x68, 0xae, 0xcf, 0x72, 0xae, 0x10, 0x88, 0x4c,
-		0x0d, 0xbe, 0xfe, 0x8e, 0xc6, 0xdf, 0x15, 0x53, 0x83, 0x23, 0xc8, 0xd4, 0x10, 0x4b, 0xef, 0x68,
-		0xf4, 0xe7, 0xc4, 0xd4, 0x10, 0x10, 0x12, 0xd9, 0xa1, 0xd5, 0x6d, 0x34, 0xc3, 0x3b, 0x22, 0xb2,
-		0x43, 0xa8, 0xd2, 0x06, 0xcc, 0xf6, 0x2d, 0x88, 0xa3, 0xa9, 0x7e, 0x8e, 0x53, 0x49, 0xbd, 0xeb,
-		0x61, 0x78, 0xf1, 0xe2, 0x8b, 0xe1, 0x68, 0xb6, 0xcf, 0xf7, 0x2c, 0x5e, 0x7c, 0x2d, 0x2c, 0x5d,
-		0x82, 0xb4, 0xd9, 0x35, 0x0c, 0x32, 0x79, 0xd0, 0xe1, 0xef, 0x06, 0x16, 0xfe, 0xcb, 0x0f, 0xb8,
-		0x77, 0x04, 0xa0, 0x74, 0x0e, 0x26, 0x71, 0x67, 0x07, 0xb7, 0x46, 0x21, 0xbf, 0xf3, 0x03, 0x91,
-		0x30, 0x89, 0x76, 0xe9, 0x25, 0x00, 0x76, 0x34, 0x42, 0x1f, 0x06, 0x8e, 0xc0, 0xfe, 0xd7, 0x1f,
-		0xf0, 0x97, 0x71, 0x02, 0x48, 0x40, 0xc0, 0x5e, 0xed, 0x39, 0x9c, 0xe0, 0xbb, 0x51, 0x02, 0x3a,
-		0x22, 0x17, 0x61, 0xea, 0x0d, 0xd7, 0x32, 0x3d, 0xb5, 0x3d, 0x0a, 0xfd, 0xdf, 0x38, 0x5a, 0xe8,
-		0x13, 0x87, 0x75, 0x2c, 0x07, 0x7b, 0x6a, 0xdb, 0x1d, 0x85, 0xfd, 0xef, 0x1c, 0xeb, 0x03, 0x08,
-		0x58, 0x53, 0x5d, 0x6f, 0x9c, 0x7e, 0xff, 0x9e, 0x00, 0x0b, 0x00, 0x31, 0x9a, 0xfc, 0xde, 0xc7,
-		0x07, 0xa3, 0xb0, 0xdf, 0x13, 0x46, 0x73, 0xfd, 0xd2, 0x47, 0x20, 0x43, 0x7e, 0xb2, 0x37, 0xec,
-		0x46, 0x80, 0xff, 0x07, 0x07, 0x07, 0x08, 0x72, 0x67, 0xd7, 0x6b, 0x79, 0xfa, 0x68, 0x67, 0x7f,
-		0x9f, 0x8f, 0xb4, 0xd0, 0x2f, 0x95, 0x21, 0xeb, 0x7a, 0xad, 0x56, 0x97, 0xd7, 0xa7, 0x23, 0xe0,
-		0xff, 0xf3, 0x07, 0xfe, 0x91, 0x85, 0x8f, 0x21, 0xa3, 0x7d, 0x6b, 0xdf, 0xb3, 0x2d, 0xfa, 0xc0,
-		0x63, 0x14, 0xc3, 0x07, 0x9c, 0x21, 0x04, 0x29, 0x55, 0x21, 0x47, 0xfa, 0xe2, 0x60, 0x1b, 0xd3,
-		0xa7, 0x53, 0x23, 0x28, 0xfe, 0x17, 0x77, 0x40, 0x04, 0x54, 0xf9, 0xb1, 0xaf, 0xbf, 0xb7, 0x18,
-		0xfb, 0xe6, 0x7b, 0x8b, 0xb1, 0xdf, 0x7d, 0x6f, 0x31, 0xf6, 0xa9, 0x6f, 0x2d, 0x4e, 0x7c, 0xf3,
-		0x5b, 0x8b, 0x13, 0xbf, 0xf5, 0xad, 0xc5, 0x89, 0xc1, 0xa7, 0xc4, 0x70, 0xc5, 0xba, 0x62, 0xb1,
-		0xf3, 0xe1, 0xd7, 0x8b, 0x6d, 0xdd, 0xdb, 0xeb, 0xee, 0xac, 0x68, 0x56, 0x87, 0x1e, 0xe3, 0x06,
-		0xa7, 0xb5, 0xfe, 0x26, 0x07, 0x3e, 0x16, 0x27, 0x1b, 0xe6, 0xe8, 0x59, 0xae, 0x6a, 0x1e, 0x0c,
-		0xf9, 0x56, 0x67, 0x61, 0xe0, 0xc1, 0x70, 0xf1, 0x2a, 0x24, 0xca, 0xe6, 0x01, 0x3a, 0xc9, 0x72,
-		0x9e, 0xd2, 0x75, 0x0c, 0xfe, 0xe6, 0xd7, 0x14, 0xb9, 0xde, 0x76, 0x0c, 0x34, 0x1f, 0xbc, 0x9e,
-		0x19, 0x3b, 0x9d, 0xe3, 0xef, 0x5c, 0x96, 0xa4, 0x4f, 0xbf, 0xbd, 0x34, 0xf1, 0x8b, 0x6f, 0x2f,
-		0x4d, 0x7c, 0xef, 0x9d, 0xa5, 0x89, 0xb7, 0x7e, 0x67, 0x79, 0xa2, 0xb2, 0xdf, 0xdb, 0xdb, 0xaf,
-		0x8d, 0xec, 0x71, 0xba, 0x6c, 0x1e, 0xd0, 0x0e, 0x37, 0x62, 0xaf, 0x4f, 0xd2, 0x43, 0x6f, 0x71,
-		0xc8, 0xbd, 0xd8, 0x7b, 0xc8, 0xfd, 0x0a, 0x36, 0x8c, 0xeb, 0xa6, 0x75, 0xcb, 0xdc, 0x22, 0x6a,
-		0x3b, 0x29, 0xf6, 0x4a, 0x31, 0xfc, 0x54, 0x1c, 0x16, 0xfb, 0xce, 0xb3, 0x79, 0x14, 0x0c, 0xfb,
-		0x68, 0xa9, 0x04, 0xe9, 0x9a, 0x08, 0xae, 0x02, 0x4c, 0xb9, 0x58, 0xb3, 0xcc, 0x96, 0x4b, 0xbb,
-		0x9d, 0x90, 0xc5, 0x25, 0xe9, 0xb6, 0xa9, 0x9a, 0x96, 0xcb, 0xdf, 0x94, 0x64, 0x17, 0x95, 0x9f,
-		0x8d, 0x1d, 0x6d, 0x4c, 0xa7, 0xc5, 0x9d, 0x44, 0x37, 0x9f, 0x1b, 0x79, 0xec, 0xbf, 0x4f, 0x7a,
-		0xe9, 0x77, 0x22, 0x72, 0xf4, 0x3f, 0xae, 0x57, 0x7e, 0x3a, 0x0e, 0x4b, 0xbd, 0x5e, 0x21, 0x53,
-		0xcb, 0xf5, 0xd4, 0x8e, 0x3d, 0xcc, 0x2d, 0x97, 0x20, 0xb3, 0x25, 0x74, 0x8e, 0xec, 0x97, 0xbb,
-		0x47, 0xf4, 0x4b, 0xde, 0xbf, 0x95, 0x70, 0xcc, 0xd9, 0x31, 0x1d, 0xe3, 0xf7, 0xe3, 0x9e, 0x3c,
-		0xf3, 0x7f, 0x52, 0x70, 0x52, 0xb3, 0xdc, 0x8e, 0xe5, 0x2a, 0x6c, 0x2a, 0xb0, 0x0b, 0xee, 0x93,
-		0x5c, 0xb8, 0x69, 0xf4, 0x83, 0x92, 0xe2, 0x75, 0x98, 0x5b, 0x25, 0xe9, 0x82, 0x6c, 0x83, 0x82,
-		0x47, 0x3c, 0x03, 0x5f, 0x26, 0x5d, 0x8e, 0x54, 0xfc, 0xfc, 0x11, 0x53, 0x58, 0x54, 0xfc, 0x58,
-		0x0c, 0xa4, 0xa6, 0xa6, 0x1a, 0xaa, 0xf3, 0xff, 0x4b, 0x85, 0x2e, 0x00, 0xd0, 0x8f, 0x90, 0x82,
-		0xaf, 0x86, 0xf2, 0x67, 0x0b, 0x2b, 0xe1, 0xce, 0xad, 0xb0, 0x3b, 0xd1, 0x4f, 0x12, 0x32, 0x54,
-		0x97, 0xfc, 0x7c, 0xf2, 0x55, 0x80, 0xa0, 0x01, 0x3d, 0x00, 0x27, 0x9a, 0xd5, 0xf2, 0x5a, 0x59,
-		0x56, 0xd8, 0xdb, 0xed, 0x1b, 0xcd, 0x46, 0xbd, 0xba, 0x7a, 0x79, 0xb5, 0x5e, 0x93, 0x26, 0xd0,
-		0x71, 0x40, 0xe1, 0x46, 0xff, 0xc5, 0x94, 0x63, 0x30, 0x1b, 0x96, 0xb3, 0x57, 0xe4, 0xe3, 0xa4,
-		0x54, 0xd4, 0x3b, 0xb6, 0x81, 0xe9, 0xb3, 0x3f, 0x45, 0x17, 0x5e, 0x1b, 0x5d, 0x85, 0xfc, 0xda,
-		0xbf, 0x61, 0xaf, 0x4d, 0xcf, 0x05, 0x70, 0xdf, 0xe7, 0xa5, 0x35, 0x98, 0x55, 0x35, 0x0d, 0xdb,
-		0x11, 0xca, 0x11, 0xb9, 0x9a, 0x10, 0xd2, 0xa7, 0x99, 0x1c, 0x19, 0xb0, 0x5d, 0x80, 0x94, 0x4b,
-		0x7b, 0x3f, 0x8a, 0xe2, 0x1b, 0x9c, 0x82, 0xab, 0x97, 0x4c, 0x98, 0x25, 0xa5, 0x9f, 0xea, 0xe0,
-		0x90, 0x19, 0x87, 0x1f, 0x34, 0xfc, 0xa3, 0x2f, 0x3f, 0x4b, 0x9f, 0x6d, 0x3e, 0x1c, 0x1d, 0x96,
-		0x01, 0xe1, 0x24, 0x4b, 0x9c, 0x3b, 0x30, 0x14, 0x43, 0x5e, 0xdc, 0x8f, 0x1b, 0x7c, 0xf8, 0xcd,
-		0xfe, 0x31, 0xbf, 0xd9, 0xe2, 0xa0, 0x18, 0x08, 0xdd, 0x69, 0x9a, 0xb3, 0xb2, 0x86, 0x4a, 0x7d,
-		0xd8, 0x9c, 0x7e, 0xfd, 0xa9, 0xd0, 0xf2, 0xc4, 0x28, 0xf9, 0x9f, 0x67, 0x28, 0xf3, 0xa5, 0xf0,
-		0x6d, 0xfc, 0xb9, 0xf7, 0x9b, 0x09, 0x58, 0xe4, 0xca, 0x3b, 0xaa, 0x8b, 0xcf, 0xdc, 0x7c, 0x6e,
-		0x07, 0x7b, 0xea, 0x73, 0x67, 0x34, 0x4b, 0x17, 0xb9, 0x7a, 0x8e, 0x4f, 0x47, 0xd2, 0xbe, 0xc2,
-		0xdb, 0x07, 0x2f, 0x5c, 0x0b, 0xc3, 0xa7, 0x71, 0x71, 0x1b, 0x92, 0x55, 0x4b, 0x37, 0x49, 0xaa,
-		0x6a, 0x61, 0xd3, 0xea, 0xf0, 0xd9, 0xc3, 0x2e, 0xd0, 0x73, 0x90, 0x52, 0x3b, 0x56, 0xd7, 0xf4,
-		0xd8, 0xcc, 0xa9, 0x9c, 0xfc, 0xfa, 0xbb, 0x4b, 0x13, 0xff, 0xf6, 0xdd, 0xa5, 0xc4, 0xaa, 0xe9,
-		0xfd, 0xc6, 0x57, 0x9e, 0x01, 0x4e, 0xb5, 0x6a, 0x7a, 0x32, 0x57, 0x2c, 0x25, 0xdf, 0x7f, 0x7b,
-		0x29, 0x56, 0x7c, 0x15, 0xa6, 0x6a, 0x58, 0xbb, 0x17, 0xe6, 0x1a, 0xd6, 0x42, 0xcc, 0x35, 0xac,
-		0xf5, 0x30, 0x5f, 0x80, 0xf4, 0xaa, 0xe9, 0xb1, 0x37, 0xd1, 0x9f, 0x82, 0x84, 0x6e, 0xb2, 0x97,
-		0x1b, 0x0f, 0xb5, 0x8d, 0x68, 0x11, 0x60, 0x0d, 0x6b, 0x3e, 0xb0, 0x85, 0xb5, 0x5e, 0x60, 0xff,
-		0xad, 0x89, 0x56, 0xa5, 0xf6, 0x5b, 0xff, 0x71, 0x71, 0xe2, 0xad, 0xf7, 0x16, 0x27, 0x86, 0x0e,
-		0x71, 0x71, 0xe8, 0x10, 0xbb, 0xad, 0x7d, 0x96, 0x91, 0xfd, 0x91, 0xfd, 0x62, 0x12, 0x1e, 0xa2,
-		0x1f, 0x28, 0x39, 0x1d, 0xdd, 0xf4, 0xce, 0x68, 0xce, 0x81, 0xed, 0xd1, 0x92, 0xc5, 0xda, 0xe5,
-		0x03, 0x3b, 0x1b, 0x34, 0xaf, 0xb0, 0xe6, 0x21, 0xf5, 0xc8, 0x2e, 0x4c, 0x36, 0x08, 0x8e, 0xb8,
-		0xd8, 0xb3, 0x3c, 0xd5, 0xe0, 0xeb, 0x0f, 0xbb, 0x20, 0x52, 0xf6, 0x51, 0x53, 0x9c, 0x49, 0x75,
-		0xf1, 0x3d, 0x93, 0x81, 0xd5, 0x5d, 0xf6, 0x6e, 0x78, 0x82, 0x96, 0x29, 0x69, 0x22, 0xa0, 0xaf,
-		0x81, 0xcf, 0xc3, 0xa4, 0xda, 0x65, 0x2f, 0x31, 0x24, 0x48, 0xfd, 0x42, 0x2f, 0x8a, 0xd7, 0x61,
-		0x8a, 0x3f, 0x4a, 0x45, 0x12, 0x24, 0xf6, 0xf1, 0x01, 0xbd, 0x4f, 0x4e, 0x26, 0x3f, 0xd1, 0x0a,
-		0x4c, 0x52, 0xe3, 0xf9, 0x47, 0x2f, 0x85, 0x95, 0x3e, 0xeb, 0x57, 0xa8, 0x91, 0x32, 0x53, 0x2b,
-		0x5e, 0x83, 0x74, 0xcd, 0xea, 0xe8, 0xa6, 0x15, 0x65, 0xcb, 0x30, 0x36, 0x6a, 0xb3, 0xdd, 0xe5,
-		0x51, 0x21, 0xb3, 0x0b, 0x74, 0x1c, 0x52, 0xec, 0x5b, 0x01, 0xfe, 0x22, 0x06, 0xbf, 0x2a, 0x56,
-		0x61, 0x8a, 0x72, 0x6f, 0xda, 0x24, 0xf9, 0xfb, 0xaf, 0x65, 0x66, 0xf8, 0x97, 0x63, 0x9c, 0x3e,
-		0x1e, 0x18, 0x8b, 0x20, 0xd9, 0x52, 0x3d, 0x95, 0xf7, 0x9b, 0xfe, 0x2e, 0x7e, 0x14, 0xd2, 0x9c,
-		0xc4, 0x45, 0x67, 0x21, 0x61, 0xd9, 0x2e, 0x7f, 0x95, 0x62, 0x61, 0x58, 0x57, 0x36, 0xed, 0x4a,
-		0x92, 0xc4, 0x8c, 0x4c, 0x94, 0x2b, 0xf2, 0xd0, 0xb0, 0x78, 0x31, 0x14, 0x16, 0xa1, 0x21, 0x0f,
-		0xfd, 0x64, 0x43, 0xda, 0x17, 0x0e, 0x7e, 0xb0, 0xbc, 0x13, 0x87, 0xc5, 0x50, 0xeb, 0x4d, 0xec,
-		0xb8, 0xba, 0x65, 0xb2, 0x88, 0xe2, 0xd1, 0x82, 0x42, 0x46, 0xf2, 0xf6, 0x21, 0xe1, 0xf2, 0x11,
-		0x48, 0x94, 0x6d, 0x1b, 0x2d, 0x40, 0x9a, 0x5e, 0x6b, 0x16, 0x8b, 0x97, 0xa4, 0xec, 0x5f, 0x93,
-		0x36, 0xd7, 0xda, 0xf5, 0x6e, 0xa9, 0x8e, 0xff, 0x39, 0x9d, 0xb8, 0x2e, 0x5e, 0x84, 0x4c, 0xd5,
-		0x32, 0x5d, 0x6c, 0xba, 0x5d, 0x5a, 0xd9, 0xec, 0x18, 0x96, 0xb6, 0xcf, 0x19, 0xd8, 0x05, 0x71,
-		0xb8, 0x6a, 0xdb, 0x14, 0x99, 0x94, 0xc9, 0x4f, 0x36, 0x67, 0x2b, 0xcd, 0xa1, 0x2e, 0xba, 0x78,
-		0x74, 0x17, 0xf1, 0x4e, 0xfa, 0x3e, 0xfa, 0xfd, 0x18, 0x3c, 0xd8, 0x3f, 0xa1, 0xf6, 0xf1, 0x81,
-		0x7b, 0xd4, 0xf9, 0xf4, 0x2a, 0x64, 0x1a, 0xf4, 0x9b, 0xf6, 0xeb, 0xf8, 0x00, 0x2d, 0xc0, 0x14,
-		0x6e, 0x9d, 0x3d, 0x77, 0xee, 0xb9, 0x8b, 0x2c, 0xda, 0xaf, 0x4e, 0xc8, 0x42, 0x80, 0x16, 0x21,
-		0xe3, 0x62, 0xcd, 0x3e, 0x7b, 0xee, 0xfc, 0xfe, 0x73, 0x2c, 0xbc, 0xae, 0x4e, 0xc8, 0x81, 0xa8,
-		0x94, 0x26, 0xbd, 0x7e, 0xff, 0x9d, 0xa5, 0x58, 0x65, 0x12, 0x12, 0x6e, 0xb7, 0xf3, 0xa1, 0xc6,
-		0xc8, 0x67, 0x26, 0x61, 0x39, 0x8c, 0xa4, 0xf5, 0xdf, 0x4d, 0xd5, 0xd0, 0x5b, 0x6a, 0xf0, 0xdf,
-		0x08, 0xa4, 0x90, 0x0f, 0xa8, 0xc6, 0x90, 0x95, 0xe2, 0x50, 0x4f, 0x16, 0x7f, 0x39, 0x06, 0xb9,
-		0x1b, 0x82, 0xb9, 0x89, 0x3d, 0x74, 0x09, 0xc0, 0xbf, 0x93, 0x98, 0x36, 0x0f, 0xac, 0xf4, 0xde,
-		0x6b, 0xc5, 0xc7, 0xc8, 0x21, 0x75, 0x74, 0x81, 0x06, 0xa2, 0x6d, 0xb9, 0xfc, 0x13, 0xab, 0x11,
-		0x50, 0x5f, 0x19, 0x3d, 0x0d, 0x88, 0x66, 0x38, 0xe5, 0xa6, 0xe5, 0xe9, 0x66, 0x5b, 0xb1, 0xad,
-		0x5b, 0xfc, 0xc3, 0xd5, 0x84, 0x2c, 0xd1, 0x96, 0x1b, 0xb4, 0xa1, 0x41, 0xe4, 0xc4, 0xe8, 0x8c,
-		0xcf, 0x42, 0x8a, 0x75, 0xb5, 0xd5, 0x72, 0xb0, 0xeb, 0xf2, 0x24, 0x26, 0x2e, 0xd1, 0x25, 0x98,
-		0xb2, 0xbb, 0x3b, 0x8a, 0xc8, 0x18, 0xd9, 0xb3, 0x0f, 0x0e, 0x9a, 0xff, 0x22, 0x3e, 0x78, 0x06,
-		0x48, 0xd9, 0xdd, 0x1d, 0x12, 0x2d, 0x0f, 0x43, 0x6e, 0x80, 0x31, 0xd9, 0x9b, 0x81, 0x1d, 0xf4,
-		0x5f, 0x29, 0xf0, 0x1e, 0x28, 0xb6, 0xa3, 0x5b, 0x8e, 0xee, 0x1d, 0xd0, 0xf7, 0xa1, 0x12, 0xb2,
-		0x24, 0x1a, 0x1a, 0x5c, 0x5e, 0xdc, 0x87, 0x99, 0x26, 0x2d, 0xe2, 0x02, 0xcb, 0xcf, 0x05, 0xf6,
-		0xc5, 0x46, 0xdb, 0x37, 0xd4, 0xb2, 0x78, 0x9f, 0x65, 0x95, 0x97, 0x87, 0x46, 0xe7, 0x85, 0xa3,
-		0x47, 0x67, 0x74, 0xb5, 0xfb, 0xbd, 0x93, 0x91, 0xc9, 0xc9, 0x82, 0x33, 0x9c, 0xbe, 0xc6, 0x0d,
-		0xcc, 0x51, 0x7b, 0xb4, 0x85, 0xc3, 0x17, 0xd5, 0x85, 0x11, 0x69, 0x74, 0x61, 0xe4, 0x14, 0x2a,
-		0x5e, 0x84, 0xe9, 0x86, 0xea, 0x78, 0x4d, 0xec, 0x5d, 0xc5, 0x6a, 0x0b, 0x3b, 0xd1, 0x55, 0x77,
-		0x5a, 0xac, 0xba, 0x08, 0x92, 0x74, 0x69, 0x65, 0xab, 0x0e, 0xfd, 0x5d, 0xdc, 0x83, 0x24, 0x7d,
-		0x27, 0xd2, 0x5f, 0x91, 0x39, 0x82, 0xad, 0xc8, 0x24, 0x97, 0x1e, 0x78, 0xd8, 0x15, 0x87, 0x06,
-		0xf4, 0x02, 0xbd, 0x20, 0xd6, 0xd5, 0xc4, 0xe1, 0xeb, 0x2a, 0x0f, 0x44, 0xbe, 0xba, 0x1a, 0x30,
-		0x55, 0x21, 0xa9, 0x78, 0xb5, 0xe6, 0x1b, 0x12, 0x0b, 0x0c, 0x41, 0xeb, 0x30, 0x63, 0xab, 0x8e,
-		0x47, 0x3f, 0x0f, 0xd9, 0xa3, 0xbd, 0xe0, 0xb1, 0xbe, 0xd4, 0x3f, 0xf3, 0x22, 0x9d, 0xe5, 0x77,
-		0x99, 0xb6, 0xc3, 0xc2, 0xe2, 0x7f, 0x4a, 0x42, 0x8a, 0x3b, 0xe3, 0x23, 0x30, 0xc5, 0xdd, 0xca,
-		0xa3, 0xf3, 0xa1, 0x95, 0xfe, 0x85, 0x69, 0xc5, 0x5f, 0x40, 0x38, 0x9f, 0xc0, 0xa0, 0xc7, 0x21,
-		0xad, 0xed, 0xa9, 0xba, 0xa9, 0xe8, 0x2d, 0x5e, 0x10, 0x66, 0xdf, 0x7b, 0x77, 0x69, 0xaa, 0x4a,
-		0x64, 0xab, 0x35, 0x79, 0x8a, 0x36, 0xae, 0xb6, 0x48, 0x25, 0xb0, 0x87, 0xf5, 0xf6, 0x9e, 0xc7,
-		0x67, 0x18, 0xbf, 0x42, 0x2f, 0x42, 0x92, 0x04, 0x04, 0xff, 0x78, 0x70, 0xa1, 0xaf, 0xc2, 0xf7,
-		0xb7, 0xd0, 0x95, 0x34, 0xb9, 0xf1, 0xa7, 0xfe, 0xc3, 0x52, 0x4c, 0xa6, 0x08, 0x54, 0x85, 0x69,
-		0x43, 0x75, 0x3d, 0x85, 0xae, 0x60, 0xe4, 0xf6, 0x93, 0x94, 0xe2, 0x64, 0xbf, 0x43, 0xb8, 0x63,
-		0xb9, 0xe9, 0x59, 0x82, 0x62, 0xa2, 0x16, 0x3a, 0x0d, 0x12, 0x25, 0xd1, 0xac, 0x4e, 0x47, 0xf7,
-		0x58, 0x6d, 0x95, 0xa2, 0x7e, 0xcf, 0x13, 0x79, 0x95, 0x8a, 0x69, 0x85, 0xf5, 0x00, 0x64, 0xe8,
-		0xe7, 0x4a, 0x54, 0x85, 0xbd, 0x88, 0x9b, 0x26, 0x02, 0xda, 0x78, 0x0a, 0x66, 0x82, 0xfc, 0xc8,
-		0x54, 0xd2, 0x8c, 0x25, 0x10, 0x53, 0xc5, 0x67, 0x61, 0xde, 0xc4, 0xb7, 0xe9, 0xab, 0xc1, 0x11,
-		0xed, 0x0c, 0xd5, 0x46, 0xa4, 0xed, 0x46, 0x14, 0xf1, 0x18, 0xe4, 0x35, 0xe1, 0x7c, 0xa6, 0x0b,
-		0x54, 0x77, 0xda, 0x97, 0x52, 0xb5, 0x93, 0x90, 0x56, 0x6d, 0x9b, 0x29, 0x64, 0x79, 0x7e, 0xb4,
-		0x6d, 0xda, 0xf4, 0x24, 0xcc, 0xd2, 0x3e, 0x3a, 0xd8, 0xed, 0x1a, 0x1e, 0x27, 0xc9, 0x51, 0x9d,
-		0x19, 0xd2, 0x20, 0x33, 0x39, 0xd5, 0x7d, 0x04, 0xa6, 0xf1, 0x4d, 0xbd, 0x85, 0x4d, 0x0d, 0x33,
-		0xbd, 0x69, 0xaa, 0x97, 0x13, 0x42, 0xaa, 0xf4, 0x04, 0xf8, 0x79, 0x4f, 0x11, 0x39, 0x39, 0xcf,
-		0xf8, 0x84, 0xbc, 0xcc, 0xc4, 0xc5, 0x02, 0x24, 0x6b, 0xaa, 0xa7, 0x92, 0x02, 0xc3, 0xbb, 0xcd,
-		0x16, 0x9a, 0x9c, 0x4c, 0x7e, 0x16, 0xdf, 0x8f, 0x43, 0xf2, 0x86, 0xe5, 0x61, 0xf4, 0x7c, 0xa8,
-		0x00, 0xcc, 0x0f, 0x8a, 0xe7, 0xa6, 0xde, 0x36, 0x71, 0x6b, 0xdd, 0x6d, 0x87, 0xfe, 0xb7, 0x40,
-		0x10, 0x4e, 0xf1, 0x48, 0x38, 0xcd, 0xc3, 0xa4, 0x63, 0x75, 0xcd, 0x96, 0x78, 0x87, 0x95, 0x5e,
-		0xa0, 0x3a, 0xa4, 0xfd, 0x28, 0x49, 0x8e, 0x8a, 0x92, 0x19, 0x12, 0x25, 0x24, 0x86, 0xb9, 0x40,
-		0x9e, 0xda, 0xe1, 0xc1, 0x52, 0x81, 0x8c, 0x9f, 0xbc, 0x78, 0xb4, 0x8d, 0x17, 0xb0, 0x01, 0x8c,
-		0x2c, 0x26, 0xfe, 0xd8, 0xfb, 0xce, 0x63, 0x11, 0x27, 0xf9, 0x0d, 0xdc, 0x7b, 0x91, 0xb0, 0xe2,
-		0xff, 0xe7, 0x60, 0x8a, 0xf6, 0x2b, 0x08, 0x2b, 0xf6, 0xbf, 0x0e, 0x1e, 0x84, 0x8c, 0xab, 0xb7,
-		0x4d, 0xd5, 0xeb, 0x3a, 0x98, 0x47, 0x5e, 0x20, 0x28, 0x7e, 0x2d, 0x06, 0x29, 0x16, 0xc9, 0x21,
-		0xbf, 0xc5, 0x06, 0xfb, 0x2d, 0x3e, 0xcc, 0x6f, 0x89, 0x7b, 0xf7, 0x5b, 0x19, 0xc0, 0x37, 0xc6,
-		0xe5, 0x9f, 0x9f, 0x0f, 0xa8, 0x18, 0x98, 0x89, 0x4d, 0xbd, 0xcd, 0x27, 0x6a, 0x08, 0x54, 0xfc,
-		0xf7, 0x31, 0x52, 0xc4, 0xf2, 0x76, 0x54, 0x86, 0x69, 0x61, 0x97, 0xb2, 0x6b, 0xa8, 0x6d, 0x1e,
-		0x3b, 0x0f, 0x0d, 0x35, 0xee, 0xb2, 0xa1, 0xb6, 0xe5, 0x2c, 0xb7, 0x87, 0x5c, 0x0c, 0x1e, 0x87,
-		0xf8, 0x90, 0x71, 0x88, 0x0c, 0x7c, 0xe2, 0xde, 0x06, 0x3e, 0x32, 0x44, 0xc9, 0xde, 0x21, 0xfa,
-		0x72, 0x9c, 0x6e, 0x66, 0x6c, 0xcb, 0x55, 0x8d, 0x1f, 0xc6, 0x8c, 0x78, 0x00, 0x32, 0xb6, 0x65,
-		0x28, 0xac, 0x85, 0xbd, 0xdb, 0x9d, 0xb6, 0x2d, 0x43, 0xee, 0x1b, 0xf6, 0xc9, 0xfb, 0x34, 0x5d,
-		0x52, 0xf7, 0xc1, 0x6b, 0x53, 0xbd, 0x5e, 0x73, 0x20, 0xc7, 0x5c, 0xc1, 0xd7, 0xb2, 0x67, 0x89,
-		0x0f, 0xe8, 0xe2, 0x18, 0xeb, 0x5f, 0x7b, 0x99, 0xd9, 0x4c, 0x53, 0xe6, 0x7a, 0x04, 0xc1, 0x52,
-		0xff, 0xa0, 0x5d, 0x70, 0x38, 0x2c, 0x65, 0xae, 0x57, 0xfc, 0xab, 0x31, 0x80, 0x35, 0xe2, 0x59,
-		0xda, 0x5f, 0xb2, 0x0a, 0xb9, 0xd4, 0x04, 0x25, 0x72, 0xe7, 0xc5, 0x61, 0x83, 0xc6, 0xef, 0x9f,
-		0x73, 0xc3, 0x76, 0x57, 0x61, 0x3a, 0x08, 0x46, 0x17, 0x0b, 0x63, 0x16, 0x0f, 0xa9, 0xaa, 0x9b,
-		0xd8, 0x93, 0x73, 0x37, 0x43, 0x57, 0xc5, 0x7f, 0x16, 0x83, 0x0c, 0xb5, 0x69, 0x1d, 0x7b, 0x6a,
-		0x64, 0x0c, 0x63, 0xf7, 0x3e, 0x86, 0x0f, 0x01, 0x30, 0x1a, 0x57, 0x7f, 0x13, 0xf3, 0xc8, 0xca,
-		0x50, 0x49, 0x53, 0x7f, 0x13, 0xa3, 0xf3, 0xbe, 0xc3, 0x13, 0x87, 0x3b, 0x5c, 0x54, 0xdd, 0xdc,
-		0xed, 0x27, 0x60, 0x8a, 0xfe, 0xbb, 0xa6, 0xdb, 0x2e, 0x2f, 0xa4, 0x53, 0x66, 0xb7, 0xb3, 0x75,
-		0xdb, 0x2d, 0xbe, 0x01, 0x53, 0x5b, 0xb7, 0xd9, 0xd9, 0xc8, 0x03, 0x90, 0x71, 0x2c, 0x8b, 0xaf,
-		0xc9, 0xac, 0x16, 0x4a, 0x13, 0x01, 0x5d, 0x82, 0xc4, 0x79, 0x40, 0x3c, 0x38, 0x0f, 0x08, 0x0e,
-		0x34, 0x12, 0x63, 0x1d, 0x68, 0x3c, 0xf9, 0x9b, 0x31, 0xc8, 0x86, 0xf2, 0x03, 0x7a, 0x0e, 0x8e,
-		0x55, 0xd6, 0x36, 0xab, 0xd7, 0x95, 0xd5, 0x9a, 0x72, 0x79, 0xad, 0x7c, 0x25, 0xf8, 0x7a, 0x69,
-		0xe1, 0xf8, 0x9d, 0xbb, 0xcb, 0x28, 0xa4, 0xbb, 0x6d, 0xd2, 0x73, 0x7a, 0x74, 0x06, 0xe6, 0xa3,
-		0x90, 0x72, 0xa5, 0x59, 0xdf, 0xd8, 0x92, 0x62, 0x0b, 0xc7, 0xee, 0xdc, 0x5d, 0x9e, 0x0d, 0x21,
-		0xca, 0x3b, 0x2e, 0x36, 0xbd, 0x7e, 0x40, 0x75, 0x73, 0x7d, 0x7d, 0x75, 0x4b, 0x8a, 0xf7, 0x01,
-		0x78, 0xc2, 0x7e, 0x02, 0x66, 0xa3, 0x80, 0x8d, 0xd5, 0x35, 0x29, 0xb1, 0x80, 0xee, 0xdc, 0x5d,
-		0xce, 0x87, 0xb4, 0x37, 0x74, 0x63, 0x21, 0xfd, 0x89, 0xcf, 0x2f, 0x4e, 0xfc, 0xc2, 0xcf, 0x2f,
-		0xc6, 0x48, 0xcf, 0xa6, 0x23, 0x39, 0x02, 0x3d, 0x0d, 0x27, 0x9a, 0xab, 0x57, 0x36, 0xea, 0x35,
-		0x65, 0xbd, 0x79, 0x45, 0x9c, 0x74, 0x8b, 0xde, 0xcd, 0xdc, 0xb9, 0xbb, 0x9c, 0xe5, 0x5d, 0x1a,
-		0xa6, 0xdd, 0x90, 0xeb, 0x37, 0x36, 0xb7, 0xea, 0x52, 0x8c, 0x69, 0x37, 0x1c, 0x7c, 0xd3, 0xf2,
-		0xd8, 0xff, 0x73, 0x7b, 0x16, 0x4e, 0x0e, 0xd0, 0xf6, 0x3b, 0x36, 0x7b, 0xe7, 0xee, 0xf2, 0x74,
-		0xc3, 0xc1, 0x6c, 0xfe, 0x50, 0xc4, 0x0a, 0x14, 0xfa, 0x11, 0x9b, 0x8d, 0xcd, 0x66, 0x79, 0x4d,
-		0x5a, 0x5e, 0x90, 0xee, 0xdc, 0x5d, 0xce, 0x89, 0x64, 0x48, 0xf4, 0x83, 0x9e, 0x7d, 0x98, 0x3b,
-		0x9e, 0xf7, 0x9f, 0x82, 0x47, 0xf9, 0x19, 0xa0, 0xeb, 0xa9, 0xfb, 0xba, 0xd9, 0xf6, 0x0f, 0x6f,
-		0xf9, 0x35, 0xdf, 0xf9, 0x1c, 0xe7, 0xe7, 0x8c, 0x42, 0x3a, 0xe2, 0x08, 0x77, 0xe8, 0xd3, 0xcb,
-		0x85, 0x11, 0x0f, 0xf5, 0x46, 0x6f, 0x9d, 0x86, 0x1f, 0x0f, 0x2f, 0x8c, 0x38, 0x84, 0x5e, 0x38,
-		0x74, 0x73, 0x57, 0xfc, 0x64, 0x0c, 0xf2, 0x57, 0x75, 0xd7, 0xb3, 0x1c, 0x5d, 0x53, 0x0d, 0xfa,
-		0xcd, 0xd2, 0xf9, 0x71, 0x73, 0x6b, 0xcf, 0x54, 0x7f, 0x09, 0x52, 0x37, 0x55, 0x83, 0x25, 0xb5,
-		0xf0, 0xb3, 0x80, 0x5e, 0xf7, 0x05, 0xa9, 0x4d, 0x10, 0x30, 0x58, 0xf1, 0x4b, 0x71, 0x98, 0xa1,
-		0x93, 0xc1, 0x65, 0xff, 0x8e, 0x8b, 0xec, 0xb1, 0x1a, 0x90, 0x74, 0x54, 0x8f, 0x1f, 0x1a, 0x56,
-		0x7e, 0x84, 0x9f, 0x03, 0x3f, 0x3e, 0xfa, 0x34, 0x77, 0xa5, 0xff, 0xa8, 0x98, 0x32, 0xa1, 0x57,
-		0x20, 0xdd, 0x51, 0x6f, 0x2b, 0x94, 0x35, 0x7e, 0x1f, 0x58, 0xa7, 0x3a, 0xea, 0x6d, 0x62, 0x2b,
-		0x6a, 0xc1, 0x0c, 0x21, 0xd6, 0xf6, 0x54, 0xb3, 0x8d, 0x19, 0x7f, 0xe2, 0x3e, 0xf0, 0x4f, 0x77,
-		0xd4, 0xdb, 0x55, 0xca, 0x49, 0xee, 0x52, 0x4a, 0x7f, 0xfa, 0xed, 0xa5, 0x09, 0x7a, 0xcc, 0xfe,
-		0xab, 0x31, 0x80, 0xc0, 0x5d, 0xe8, 0x8f, 0x83, 0xa4, 0xf9, 0x57, 0xf4, 0xf6, 0x2e, 0x1f, 0xc0,
-		0x53, 0xc3, 0x06, 0xa2, 0xc7, 0xd9, 0x6c, 0x61, 0xfe, 0xe6, 0xbb, 0x4b, 0x31, 0x79, 0x46, 0xeb,
-		0x19, 0x87, 0x3a, 0x64, 0xbb, 0x76, 0x4b, 0xf5, 0xb0, 0x42, 0x37, 0x71, 0xf1, 0x23, 0x2c, 0xf2,
-		0xc0, 0x80, 0xa4, 0x29, 0x64, 0xfd, 0x97, 0x62, 0x90, 0xad, 0x85, 0x1e, 0xf2, 0x15, 0x60, 0xaa,
-		0x63, 0x99, 0xfa, 0x3e, 0x0f, 0xbb, 0x8c, 0x2c, 0x2e, 0xd1, 0x02, 0xa4, 0xd9, 0xd7, 0x9a, 0xde,
-		0x81, 0x38, 0xf1, 0x14, 0xd7, 0x04, 0x75, 0x0b, 0xef, 0xb8, 0xba, 0xf0, 0xb5, 0x2c, 0x2e, 0xc9,
-		0xd6, 0xc5, 0xc5, 0x5a, 0xd7, 0xd1, 0xbd, 0x03, 0x45, 0xb3, 0x4c, 0x4f, 0xd5, 0x3c, 0xfe, 0xdd,
-		0xdf, 0x8c, 0x90, 0x57, 0x99, 0x98, 0x90, 0xb4, 0xb0, 0xa7, 0xea, 0x86, 0x5b, 0x60, 0x0f, 0xc2,
-		0xc4, 0x65, 0xc8, 0xdc, 0x5f, 0x4b, 0x85, 0x8f, 0xa8, 0xaa, 0x20, 0x59, 0x36, 0x76, 0x22, 0x25,
-		0x25, 0x8b, 0xd0, 0xc2, 0x6f, 0x7c, 0xe5, 0x99, 0x79, 0xee, 0x6e, 0x5e, 0x54, 0xb2, 0x17, 0x5b,
-		0xe5, 0x19, 0x81, 0x10, 0xb5, 0xe6, 0x6b, 0x64, 0xc0, 0xc4, 0x7e, 0xcf, 0xee, 0xee, 0x04, 0xc7,
-		0x5a, 0xf3, 0x7d, 0x7e, 0x2d, 0x9b, 0x07, 0x95, 0xc2, 0x37, 0x02, 0xea, 0xe0, 0x2c, 0xe9, 0x3a,
-		0x3e, 0x20, 0xa3, 0xc5, 0x79, 0x1a, 0x94, 0x86, 0x94, 0x88, 0x6f, 0xa8, 0xba, 0x21, 0x3e, 0x42,
-		0x97, 0xf9, 0x15, 0x2a, 0x41, 0xca, 0xf5, 0x54, 0xaf, 0xeb, 0xf2, 0x7f, 0x16, 0x57, 0x1c, 0x16,
-		0x19, 0x15, 0xcb, 0x6c, 0x35, 0xa9, 0xa6, 0xcc, 0x11, 0x68, 0x0b, 0x52, 0x9e, 0xb5, 0x8f, 0x4d,
-		0xee, 0xa4, 0x23, 0x45, 0xf5, 0x80, 0x67, 0x51, 0x8c, 0x0b, 0xb5, 0x41, 0x6a, 0x61, 0x03, 0xb7,
-		0x59, 0x41, 0xb4, 0xa7, 0x92, 0x7d, 0x43, 0xea, 0x3e, 0xcc, 0x9a, 0x19, 0x9f, 0xb5, 0x49, 0x49,
-		0xd1, 0xf5, 0xe8, 0x63, 0x66, 0xf6, 0x9f, 0x15, 0x1f, 0x19, 0xd6, 0xff, 0x50, 0x64, 0x8a, 0xc3,
-		0x84, 0xf0, 0x13, 0xe9, 0x27, 0x40, 0xea, 0x9a, 0x3b, 0x96, 0x49, 0x3f, 0x15, 0xe5, 0xc5, 0x78,
-		0x9a, 0x96, 0x37, 0x33, 0xbe, 0xfc, 0x2a, 0xab, 0xca, 0xaf, 0x43, 0x3e, 0x50, 0xa5, 0x73, 0x27,
-		0x73, 0x84, 0xb9, 0x33, 0xed, 0x63, 0x49, 0x2b, 0xba, 0x0a, 0x10, 0x4c, 0x4c, 0x7a, 0x3c, 0x90,
-		0x1d, 0x3e, 0x86, 0xc1, 0xec, 0x16, 0xdb, 0xac, 0x00, 0x8b, 0x0c, 0x98, 0xeb, 0xe8, 0xa6, 0xe2,
-		0x62, 0x63, 0x57, 0xe1, 0xae, 0x22, 0x94, 0xd9, 0xfb, 0x30, 0xb4, 0xb3, 0x1d, 0xdd, 0x6c, 0x62,
-		0x63, 0xb7, 0xe6, 0xd3, 0x96, 0x72, 0x9f, 0x78, 0x7b, 0x69, 0x82, 0xcf, 0xa5, 0x89, 0x62, 0x83,
-		0x1e, 0x51, 0xf3, 0x69, 0x80, 0x5d, 0x74, 0x1e, 0x32, 0xaa, 0xb8, 0xa0, 0x07, 0x07, 0x87, 0x4d,
-		0xa3, 0x40, 0x95, 0xcd, 0xce, 0xb7, 0x7e, 0x67, 0x39, 0x56, 0xfc, 0xf9, 0x18, 0xa4, 0x6a, 0x37,
-		0x1a, 0xaa, 0xee, 0xa0, 0x3a, 0xcc, 0x06, 0x01, 0x35, 0xee, 0xdc, 0x0c, 0x62, 0x50, 0x4c, 0xce,
-		0xfa, 0xb0, 0x5d, 0xe3, 0xa1, 0x34, 0xbd, 0xfb, 0xc9, 0x9e, 0x8e, 0xd7, 0x61, 0x8a, 0x59, 0xe9,
-		0xa2, 0x12, 0x4c, 0xda, 0xe4, 0x07, 0x3f, 0x91, 0x5f, 0x1c, 0x1a, 0x88, 0x54, 0xdf, 0x3f, 0x41,
-		0x24, 0x90, 0xe2, 0xef, 0xc7, 0x00, 0x6a, 0x37, 0x6e, 0x6c, 0x39, 0xba, 0x6d, 0x60, 0xef, 0x7e,
-		0xf5, 0x78, 0x0d, 0x8e, 0x85, 0xb6, 0x26, 0x8e, 0x36, 0x76, 0xaf, 0xe7, 0x82, 0xcd, 0x89, 0xa3,
-		0x0d, 0x64, 0x6b, 0xb9, 0x9e, 0xcf, 0x96, 0x18, 0x9b, 0xad, 0xe6, 0x7a, 0x83, 0xdd, 0xd8, 0x84,
-		0x6c, 0xd0, 0x7d, 0x17, 0xd5, 0x20, 0xed, 0xf1, 0xdf, 0xdc, 0x9b, 0xc5, 0xe1, 0xde, 0x14, 0x30,
-		0xee, 0x51, 0x1f, 0x59, 0xfc, 0xbf, 0xc4, 0xa9, 0x7e, 0xc4, 0xfe, 0xe1, 0x0a, 0x23, 0x92, 0x7b,
-		0x79, 0x6e, 0xbc, 0x1f, 0x15, 0x05, 0xe7, 0xea, 0xf1, 0xea, 0xc7, 0xe3, 0x30, 0xb7, 0x2d, 0xb2,
-		0xcd, 0x1f, 0x5a, 0x4f, 0x34, 0x60, 0x0a, 0x9b, 0x9e, 0xa3, 0x53, 0x57, 0x90, 0xb1, 0x7e, 0x76,
-		0xd8, 0x58, 0x0f, 0xe8, 0x0b, 0xfd, 0x9f, 0x45, 0xe2, 0x5c, 0x9b, 0xd3, 0xf4, 0x78, 0xe1, 0xdf,
-		0xc5, 0xa1, 0x30, 0x0c, 0x89, 0x4e, 0xc1, 0x8c, 0xe6, 0x60, 0x2a, 0x50, 0x22, 0x87, 0x6b, 0x79,
-		0x21, 0xe6, 0x49, 0x7f, 0x1d, 0x48, 0x01, 0x45, 0x02, 0x8b, 0xa8, 0x1e, 0xb9, 0x62, 0xca, 0x07,
-		0x60, 0x9a, 0xf6, 0x31, 0xcc, 0xe8, 0xa6, 0xee, 0xe9, 0xaa, 0xa1, 0xec, 0xa8, 0x86, 0x6a, 0x6a,
-		0xf7, 0x52, 0x59, 0xf6, 0x27, 0xea, 0x3c, 0x27, 0xad, 0x30, 0x4e, 0x74, 0x03, 0xa6, 0x04, 0x7d,
-		0xf2, 0x3e, 0xd0, 0x0b, 0xb2, 0x50, 0x15, 0xf5, 0xdb, 0x71, 0x98, 0x95, 0x71, 0xeb, 0x8f, 0x96,
-		0x5b, 0x7f, 0x14, 0x80, 0x4d, 0x38, 0x92, 0x07, 0xef, 0xc1, 0xb3, 0xfd, 0x13, 0x38, 0xc3, 0xf8,
-		0x6a, 0xae, 0x17, 0xf2, 0xed, 0x37, 0xe2, 0x90, 0x0b, 0xfb, 0xf6, 0x8f, 0xc0, 0xba, 0x80, 0x56,
-		0x83, 0x6c, 0x90, 0xe4, 0xff, 0x6d, 0x75, 0x48, 0x36, 0xe8, 0x8b, 0xba, 0xc3, 0xd3, 0xc0, 0xf7,
-		0xe3, 0x90, 0x6a, 0xa8, 0x8e, 0xda, 0x71, 0xd1, 0xb5, 0xbe, 0x02, 0x4e, 0x9c, 0xb2, 0xf5, 0xfd,
-		0x4f, 0x6d, 0xbe, 0xa9, 0x67, 0x21, 0xf7, 0xe9, 0x01, 0xf5, 0xdb, 0x63, 0x90, 0x27, 0x5b, 0xc4,
-		0xd0, 0x03, 0xf9, 0x38, 0x7d, 0xcc, 0x48, 0xf6, 0x78, 0xc1, 0xd3, 0x20, 0xb4, 0x04, 0x59, 0xa2,
-		0x16, 0x24, 0x3a, 0xa2, 0x03, 0x1d, 0xf5, 0x76, 0x9d, 0x49, 0xd0, 0x33, 0x80, 0xf6, 0xfc, 0x4d,
-		0xbb, 0x12, 0xb8, 0x80, 0xe8, 0xcd, 0x06, 0x2d, 0x42, 0xfd, 0x21, 0x00, 0x62, 0x85, 0xc2, 0x5e,
-		0xf2, 0x62, 0x7b, 0x9c, 0x0c, 0x91, 0xd4, 0xe8, 0x8b, 0x5e, 0x3f, 0xc1, 0x6a, 0xc1, 0x9e, 0xdd,
-		0x23, 0x2f, 0xc3, 0xd7, 0x8e, 0x16, 0xa9, 0xdf, 0x7f, 0x77, 0x69, 0xe1, 0x40, 0xed, 0x18, 0xa5,
-		0xe2, 0x00, 0xca, 0x22, 0xad, 0x0d, 0xa3, 0xbb, 0xce, 0x50, 0x04, 0x7f, 0x3e, 0x06, 0x28, 0x48,
-		0xb9, 0x32, 0x76, 0x6d, 0xb2, 0xad, 0x21, 0x45, 0x6f, 0xa8, 0x42, 0x8d, 0x1d, 0x5e, 0xf4, 0x06,
-		0x78, 0x51, 0xf4, 0x86, 0x66, 0xc4, 0xc5, 0x20, 0xc1, 0xc5, 0xf9, 0x18, 0x0e, 0x78, 0x43, 0x6f,
-		0xa5, 0x6a, 0xe9, 0x02, 0xdd, 0x97, 0xc3, 0x26, 0x8a, 0xbf, 0x1d, 0x83, 0x93, 0x7d, 0xd1, 0xe4,
-		0x1b, 0xfb, 0x27, 0x00, 0x39, 0xa1, 0x46, 0xfe, 0x6f, 0xf3, 0x98, 0xd1, 0x47, 0x0e, 0xce, 0x59,
-		0xa7, 0x2f, 0x57, 0x7e, 0x58, 0x39, 0x9a, 0xbd, 0xb9, 0xf7, 0x4f, 0x62, 0x30, 0x1f, 0x36, 0xc6,
-		0xef, 0xd6, 0x06, 0xe4, 0xc2, 0xb6, 0xf0, 0x0e, 0x3d, 0x3a, 0x4e, 0x87, 0x78, 0x5f, 0x22, 0x78,
-		0xf4, 0x72, 0x30, 0x71, 0xd9, 0x61, 0xd1, 0x73, 0x63, 0xfb, 0x46, 0xd8, 0xd4, 0x3b, 0x81, 0x93,
-		0xa2, 0x8a, 0x49, 0x36, 0x2c, 0xcb, 0x40, 0x7f, 0x12, 0x66, 0x4d, 0xcb, 0x53, 0x48, 0x94, 0xe3,
-		0x96, 0xc2, 0x77, 0xae, 0x2c, 0xfb, 0xbd, 0x7c, 0x34, 0x97, 0x7d, 0xe7, 0xdd, 0xa5, 0x7e, 0xaa,
-		0x1e, 0x3f, 0xce, 0x98, 0x96, 0x57, 0xa1, 0xed, 0x5b, 0x6c, 0x5f, 0xeb, 0xc0, 0x74, 0xf4, 0xd6,
-		0x2c, 0x5b, 0xae, 0x1f, 0xf9, 0xd6, 0xd3, 0x87, 0xdd, 0x36, 0xb7, 0x13, 0xba, 0x27, 0x7b, 0xa7,
-		0xe9, 0x7b, 0x6f, 0x2f, 0xc5, 0x9e, 0xfc, 0x6a, 0x0c, 0x20, 0xd8, 0xc2, 0xa3, 0xa7, 0xe1, 0x44,
-		0x65, 0x73, 0xa3, 0xa6, 0x34, 0xb7, 0xca, 0x5b, 0xdb, 0xcd, 0xe8, 0x9b, 0xcf, 0xe2, 0x4c, 0xd8,
-		0xb5, 0xb1, 0xa6, 0xef, 0xea, 0xb8, 0x85, 0x1e, 0x87, 0xf9, 0xa8, 0x36, 0xb9, 0xaa, 0xd7, 0xa4,
-		0xd8, 0x42, 0xee, 0xce, 0xdd, 0xe5, 0x34, 0xab, 0x8e, 0x70, 0x0b, 0x9d, 0x86, 0x63, 0xfd, 0x7a,
-		0xab, 0x1b, 0x57, 0xa4, 0xf8, 0xc2, 0xf4, 0x9d, 0xbb, 0xcb, 0x19, 0xbf, 0x8c, 0x42, 0x45, 0x40,
-		0x61, 0x4d, 0xce, 0x97, 0x58, 0x80, 0x3b, 0x77, 0x97, 0x53, 0xcc, 0x6d, 0x0b, 0xc9, 0x4f, 0x7c,
-		0x7e, 0x71, 0xa2, 0x72, 0x79, 0xe8, 0xa9, 0xef, 0xd3, 0x87, 0x7a, 0xec, 0xb6, 0x7f, 0x92, 0x1b,
-		0x39, 0xea, 0xfd, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x8c, 0xb0, 0x45, 0xd1, 0x08, 0x66, 0x00,
-		0x00,
+		// 8471 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7d, 0x7d, 0x70, 0x24, 0xc7,
+		0x75, 0x1f, 0xf6, 0x13, 0xbb, 0x0f, 0x8b, 0xdd, 0xc1, 0x00, 0x77, 0xdc, 0xc3, 0x91, 0x00, 0xb8,
+		0xa4, 0xc8, 0x23, 0x25, 0xe2, 0xc8, 0x23, 0xef, 0x48, 0x2e, 0x2d, 0x31, 0xbb, 0xd8, 0xbd, 0x3b,
+		0xdc, 0xe1, 0x8b, 0xb3, 0xc0, 0xf1, 0xc3, 0x71, 0xa6, 0x06, 0xb3, 0x8d, 0xc5, 0x10, 0xb3, 0x33,
+		0xa3, 0x99, 0xd9, 0xbb, 0x03, 0x2b, 0x49, 0x51, 0x51, 0x9c, 0x48, 0x4c, 0x9c, 0xc8, 0x71, 0xca,
+		0x96, 0x69, 0x9d, 0x4c, 0x5a, 0x76, 0xe4, 0xc8, 0x72, 0x62, 0x5b, 0x8a, 0x12, 0x5b, 0x95, 0x44,
+		0x49, 0x55, 0x12, 0x45, 0x7f, 0xa4, 0x14, 0xff, 0x11, 0xdb, 0x89, 0xc3, 0xd8, 0x94, 0xcb, 0x51,
+		0x64, 0x25, 0x56, 0x14, 0xa6, 0x2a, 0x29, 0x95, 0x53, 0xa9, 0xd7, 0x1f, 0x33, 0xb3, 0x5f, 0xd8,
+		0xc5, 0xe9, 0xa8, 0x30, 0xe5, 0xbf, 0xb0, 0xf3, 0xfa, 0xbd, 0x5f, 0x77, 0xbf, 0x7e, 0xfd, 0xfa,
+		0xf5, 0xeb, 0x9e, 0x01, 0xfc, 0xfe, 0x45, 0x58, 0x6a, 0xd9, 0x76, 0xcb, 0x24, 0x67, 0x1d, 0xd7,
+		0xf6, 0xed, 0xdd, 0xce, 0xde, 0xd9, 0x26, 0xf1, 0x74, 0xd7, 0x70, 0x7c, 0xdb, 0x5d, 0xa6, 0x34,
+		0xb9, 0xc0, 0x38, 0x96, 0x05, 0x47, 0x69, 0x1d, 0x66, 0x2e, 0x1a, 0x26, 0xa9, 0x05, 0x8c, 0x0d,
+		0xe2, 0xcb, 0x4f, 0x41, 0x72, 0xcf, 0x30, 0x49, 0x31, 0xb6, 0x94, 0x38, 0x33, 0x75, 0xee, 0xfe,
+		0xe5, 0x1e, 0xa1, 0xe5, 0x6e, 0x89, 0x2d, 0x24, 0x2b, 0x54, 0xa2, 0xf4, 0x7f, 0x92, 0x30, 0x3b,
+		0xa0, 0x54, 0x96, 0x21, 0x69, 0x69, 0x6d, 0x44, 0x8c, 0x9d, 0xc9, 0x2a, 0xf4, 0xb7, 0x5c, 0x84,
+		0x49, 0x47, 0xd3, 0x0f, 0xb4, 0x16, 0x29, 0xc6, 0x29, 0x59, 0x3c, 0xca, 0x0b, 0x00, 0x4d, 0xe2,
+		0x10, 0xab, 0x49, 0x2c, 0xfd, 0xb0, 0x98, 0x58, 0x4a, 0x9c, 0xc9, 0x2a, 0x11, 0x8a, 0xfc, 0x7e,
+		0x98, 0x71, 0x3a, 0xbb, 0xa6, 0xa1, 0xab, 0x11, 0x36, 0x58, 0x4a, 0x9c, 0x49, 0x29, 0x12, 0x2b,
+		0xa8, 0x85, 0xcc, 0x0f, 0x42, 0xe1, 0x06, 0xd1, 0x0e, 0xa2, 0xac, 0x53, 0x94, 0x35, 0x8f, 0xe4,
+		0x08, 0xe3, 0x0a, 0xe4, 0xda, 0xc4, 0xf3, 0xb4, 0x16, 0x51, 0xfd, 0x43, 0x87, 0x14, 0x93, 0xb4,
+		0xf7, 0x4b, 0x7d, 0xbd, 0xef, 0xed, 0xf9, 0x14, 0x97, 0xda, 0x3e, 0x74, 0x88, 0x5c, 0x81, 0x2c,
+		0xb1, 0x3a, 0x6d, 0x86, 0x90, 0x1a, 0xa2, 0xbf, 0xba, 0xd5, 0x69, 0xf7, 0xa2, 0x64, 0x50, 0x8c,
+		0x43, 0x4c, 0x7a, 0xc4, 0xbd, 0x6e, 0xe8, 0xa4, 0x98, 0xa6, 0x00, 0x0f, 0xf6, 0x01, 0x34, 0x58,
+		0x79, 0x2f, 0x86, 0x90, 0x93, 0x57, 0x20, 0x4b, 0x6e, 0xfa, 0xc4, 0xf2, 0x0c, 0xdb, 0x2a, 0x4e,
+		0x52, 0x90, 0xf7, 0x0d, 0x18, 0x45, 0x62, 0x36, 0x7b, 0x21, 0x42, 0x39, 0xf9, 0x02, 0x4c, 0xda,
+		0x8e, 0x6f, 0xd8, 0x96, 0x57, 0xcc, 0x2c, 0xc5, 0xce, 0x4c, 0x9d, 0xbb, 0x7b, 0xa0, 0x21, 0x6c,
+		0x32, 0x1e, 0x45, 0x30, 0xcb, 0xab, 0x20, 0x79, 0x76, 0xc7, 0xd5, 0x89, 0xaa, 0xdb, 0x4d, 0xa2,
+		0x1a, 0xd6, 0x9e, 0x5d, 0xcc, 0x52, 0x80, 0xc5, 0xfe, 0x8e, 0x50, 0xc6, 0x15, 0xbb, 0x49, 0x56,
+		0xad, 0x3d, 0x5b, 0xc9, 0x7b, 0x5d, 0xcf, 0xf2, 0x49, 0x48, 0x7b, 0x87, 0x96, 0xaf, 0xdd, 0x2c,
+		0xe6, 0xa8, 0x85, 0xf0, 0x27, 0x34, 0x1d, 0xd2, 0x34, 0xb0, 0xba, 0xe2, 0x34, 0x33, 0x1d, 0xfe,
+		0x58, 0xfa, 0xf5, 0x34, 0x14, 0xc6, 0x31, 0xbe, 0x67, 0x20, 0xb5, 0x87, 0xfd, 0x2f, 0xc6, 0x8f,
+		0xa3, 0x1d, 0x26, 0xd3, 0xad, 0xde, 0xf4, 0x6d, 0xaa, 0xb7, 0x02, 0x53, 0x16, 0xf1, 0x7c, 0xd2,
+		0x64, 0xb6, 0x92, 0x18, 0xd3, 0xda, 0x80, 0x09, 0xf5, 0x1b, 0x5b, 0xf2, 0xb6, 0x8c, 0xed, 0x05,
+		0x28, 0x04, 0x4d, 0x52, 0x5d, 0xcd, 0x6a, 0x09, 0xab, 0x3d, 0x3b, 0xaa, 0x25, 0xcb, 0x75, 0x21,
+		0xa7, 0xa0, 0x98, 0x92, 0x27, 0x5d, 0xcf, 0x72, 0x0d, 0xc0, 0xb6, 0x88, 0xbd, 0xa7, 0x36, 0x89,
+		0x6e, 0x16, 0x33, 0x43, 0xb4, 0xb4, 0x89, 0x2c, 0x7d, 0x5a, 0xb2, 0x19, 0x55, 0x37, 0xe5, 0xa7,
+		0x43, 0x23, 0x9c, 0x1c, 0x62, 0x43, 0xeb, 0x6c, 0xfa, 0xf5, 0xd9, 0xe1, 0x0e, 0xe4, 0x5d, 0x82,
+		0x33, 0x82, 0x34, 0x79, 0xcf, 0xb2, 0xb4, 0x11, 0xcb, 0x23, 0x7b, 0xa6, 0x70, 0x31, 0xd6, 0xb1,
+		0x69, 0x37, 0xfa, 0x28, 0xdf, 0x07, 0x01, 0x41, 0xa5, 0x66, 0x05, 0xd4, 0x3f, 0xe5, 0x04, 0x71,
+		0x43, 0x6b, 0x93, 0xf9, 0x57, 0x20, 0xdf, 0xad, 0x1e, 0x79, 0x0e, 0x52, 0x9e, 0xaf, 0xb9, 0x3e,
+		0xb5, 0xc2, 0x94, 0xc2, 0x1e, 0x64, 0x09, 0x12, 0xc4, 0x6a, 0x52, 0xff, 0x97, 0x52, 0xf0, 0xa7,
+		0xfc, 0x67, 0xc2, 0x0e, 0x27, 0x68, 0x87, 0x1f, 0xe8, 0x1f, 0xd1, 0x2e, 0xe4, 0xde, 0x7e, 0xcf,
+		0x3f, 0x09, 0xd3, 0x5d, 0x1d, 0x18, 0xb7, 0xea, 0xd2, 0x2f, 0x27, 0xe1, 0xc4, 0x40, 0x6c, 0xf9,
+		0x05, 0x98, 0xeb, 0x58, 0x86, 0xe5, 0x13, 0xd7, 0x71, 0x09, 0x9a, 0x2c, 0xab, 0xab, 0xf8, 0x9f,
+		0x27, 0x87, 0x18, 0xdd, 0x4e, 0x94, 0x9b, 0xa1, 0x28, 0xb3, 0x9d, 0x7e, 0xa2, 0xfc, 0x22, 0x4c,
+		0xa1, 0x7d, 0x68, 0xae, 0x46, 0x01, 0xd9, 0x6c, 0x3c, 0x37, 0x5e, 0x97, 0x97, 0x6b, 0xa1, 0x64,
+		0x35, 0xf1, 0xb1, 0x58, 0x5c, 0x89, 0x62, 0xc9, 0xfb, 0x90, 0xbb, 0x4e, 0x5c, 0x63, 0xcf, 0xd0,
+		0x19, 0x36, 0xaa, 0x33, 0x7f, 0xee, 0xa9, 0x31, 0xb1, 0xaf, 0x45, 0x44, 0x1b, 0xbe, 0xe6, 0x93,
+		0x32, 0xec, 0x6c, 0x5c, 0xab, 0x2b, 0xab, 0x17, 0x57, 0xeb, 0x35, 0xa5, 0x0b, 0x79, 0xfe, 0x0b,
+		0x31, 0x98, 0x8a, 0xb4, 0x05, 0xdd, 0x96, 0xd5, 0x69, 0xef, 0x12, 0x97, 0x6b, 0x9c, 0x3f, 0xc9,
+		0xa7, 0x21, 0xbb, 0xd7, 0x31, 0x4d, 0x66, 0x36, 0x6c, 0xcd, 0xcb, 0x20, 0x01, 0x4d, 0x06, 0xbd,
+		0x14, 0x77, 0x04, 0xd4, 0x4b, 0xe1, 0x6f, 0xf9, 0x3e, 0x98, 0x32, 0x3c, 0xd5, 0x25, 0x0e, 0xd1,
+		0x7c, 0xd2, 0x2c, 0x26, 0x97, 0x62, 0x67, 0x32, 0xd5, 0x78, 0x31, 0xa6, 0x80, 0xe1, 0x29, 0x9c,
+		0x2a, 0xcf, 0x43, 0x46, 0xd8, 0x5e, 0x31, 0x85, 0x1c, 0x4a, 0xf0, 0xcc, 0xca, 0xb8, 0x74, 0x5a,
+		0x94, 0xb1, 0xe7, 0xd2, 0x13, 0x30, 0xd3, 0xd7, 0x49, 0xb9, 0x00, 0x53, 0xb5, 0xfa, 0xca, 0x5a,
+		0x45, 0xa9, 0x6c, 0xaf, 0x6e, 0x6e, 0x48, 0x13, 0x72, 0x1e, 0x22, 0xfd, 0x96, 0x62, 0x0f, 0x67,
+		0x33, 0xdf, 0x9c, 0x94, 0x5e, 0x7d, 0xf5, 0xd5, 0x57, 0xe3, 0xa5, 0x7f, 0x96, 0x86, 0xb9, 0x41,
+		0x5e, 0x6e, 0xa0, 0xc3, 0x0d, 0x75, 0x92, 0xe8, 0xd2, 0x49, 0x05, 0x52, 0xa6, 0xb6, 0x4b, 0x4c,
+		0xda, 0xb9, 0xfc, 0xb9, 0xf7, 0x8f, 0xe5, 0x47, 0x97, 0xd7, 0x50, 0x44, 0x61, 0x92, 0xf2, 0x87,
+		0xb8, 0xe6, 0x52, 0x14, 0xe1, 0xe1, 0xf1, 0x10, 0xd0, 0xfb, 0x71, 0x2d, 0x9f, 0x86, 0x2c, 0xfe,
+		0x65, 0xc3, 0x92, 0x66, 0xc3, 0x82, 0x04, 0x3a, 0x2c, 0xf3, 0x90, 0xa1, 0x8e, 0xad, 0x49, 0x82,
+		0x21, 0x13, 0xcf, 0xe8, 0x0a, 0x9a, 0x64, 0x4f, 0xeb, 0x98, 0xbe, 0x7a, 0x5d, 0x33, 0x3b, 0x84,
+		0xba, 0xa8, 0xac, 0x92, 0xe3, 0xc4, 0x6b, 0x48, 0x93, 0x17, 0x61, 0x8a, 0xf9, 0x41, 0xc3, 0x6a,
+		0x92, 0x9b, 0x74, 0x25, 0x4c, 0x29, 0xcc, 0x35, 0xae, 0x22, 0x05, 0xab, 0x7f, 0xd9, 0xb3, 0x2d,
+		0xe1, 0x4c, 0x68, 0x15, 0x48, 0xa0, 0xd5, 0x3f, 0xd9, 0xbb, 0x08, 0xdf, 0x33, 0xb8, 0x7b, 0x7d,
+		0xde, 0xef, 0x41, 0x28, 0x50, 0x8e, 0xc7, 0xf9, 0x5c, 0xd5, 0xcc, 0xe2, 0x0c, 0x35, 0x80, 0x3c,
+		0x23, 0x6f, 0x72, 0x6a, 0xe9, 0x4b, 0x71, 0x48, 0xd2, 0xa5, 0xa0, 0x00, 0x53, 0xdb, 0x2f, 0x6e,
+		0xd5, 0xd5, 0xda, 0xe6, 0x4e, 0x75, 0xad, 0x2e, 0xc5, 0x70, 0xe8, 0x29, 0xe1, 0xe2, 0xda, 0x66,
+		0x65, 0x5b, 0x8a, 0x07, 0xcf, 0xab, 0x1b, 0xdb, 0x17, 0x9e, 0x90, 0x12, 0x81, 0xc0, 0x0e, 0x23,
+		0x24, 0xa3, 0x0c, 0x8f, 0x9f, 0x93, 0x52, 0xb2, 0x04, 0x39, 0x06, 0xb0, 0xfa, 0x42, 0xbd, 0x76,
+		0xe1, 0x09, 0x29, 0xdd, 0x4d, 0x79, 0xfc, 0x9c, 0x34, 0x29, 0x4f, 0x43, 0x96, 0x52, 0xaa, 0x9b,
+		0x9b, 0x6b, 0x52, 0x26, 0xc0, 0x6c, 0x6c, 0x2b, 0xab, 0x1b, 0x97, 0xa4, 0x6c, 0x80, 0x79, 0x49,
+		0xd9, 0xdc, 0xd9, 0x92, 0x20, 0x40, 0x58, 0xaf, 0x37, 0x1a, 0x95, 0x4b, 0x75, 0x69, 0x2a, 0xe0,
+		0xa8, 0xbe, 0xb8, 0x5d, 0x6f, 0x48, 0xb9, 0xae, 0x66, 0x3d, 0x7e, 0x4e, 0x9a, 0x0e, 0xaa, 0xa8,
+		0x6f, 0xec, 0xac, 0x4b, 0x79, 0x79, 0x06, 0xa6, 0x59, 0x15, 0xa2, 0x11, 0x85, 0x1e, 0xd2, 0x85,
+		0x27, 0x24, 0x29, 0x6c, 0x08, 0x43, 0x99, 0xe9, 0x22, 0x5c, 0x78, 0x42, 0x92, 0x4b, 0x2b, 0x90,
+		0xa2, 0x66, 0x28, 0xcb, 0x90, 0x5f, 0xab, 0x54, 0xeb, 0x6b, 0xea, 0xe6, 0x16, 0x4e, 0x9a, 0xca,
+		0x9a, 0x14, 0x0b, 0x69, 0x4a, 0xfd, 0xb9, 0x9d, 0x55, 0xa5, 0x5e, 0x93, 0xe2, 0x51, 0xda, 0x56,
+		0xbd, 0xb2, 0x5d, 0xaf, 0x49, 0x89, 0x92, 0x0e, 0x73, 0x83, 0x96, 0xc0, 0x81, 0x53, 0x28, 0x62,
+		0x0b, 0xf1, 0x21, 0xb6, 0x40, 0xb1, 0x7a, 0x6d, 0xa1, 0xf4, 0x8d, 0x38, 0xcc, 0x0e, 0x08, 0x03,
+		0x06, 0x56, 0xf2, 0x2c, 0xa4, 0x98, 0x2d, 0x33, 0x57, 0xfc, 0xd0, 0xc0, 0x78, 0x82, 0x5a, 0x76,
+		0x5f, 0x70, 0x44, 0xe5, 0xa2, 0x61, 0x63, 0x62, 0x48, 0xd8, 0x88, 0x10, 0x7d, 0x06, 0xfb, 0x23,
+		0x7d, 0xcb, 0x35, 0x8b, 0x68, 0x2e, 0x8c, 0x13, 0xd1, 0x50, 0xda, 0xf1, 0x96, 0xed, 0xd4, 0x80,
+		0x65, 0xfb, 0x19, 0x98, 0xe9, 0x03, 0x1a, 0x7b, 0xf9, 0xfc, 0x68, 0x0c, 0x8a, 0xc3, 0x94, 0x33,
+		0xc2, 0x25, 0xc6, 0xbb, 0x5c, 0xe2, 0x33, 0xbd, 0x1a, 0xbc, 0x77, 0xf8, 0x20, 0xf4, 0x8d, 0xf5,
+		0x67, 0x63, 0x70, 0x72, 0xf0, 0xf6, 0x60, 0x60, 0x1b, 0x3e, 0x04, 0xe9, 0x36, 0xf1, 0xf7, 0x6d,
+		0x11, 0x08, 0x3f, 0x30, 0x20, 0xbc, 0xc2, 0xe2, 0xde, 0xc1, 0xe6, 0x52, 0xf2, 0xd3, 0xbd, 0x6d,
+		0x5d, 0x1c, 0xb6, 0x59, 0xe9, 0x6b, 0xe9, 0xc7, 0xe3, 0x70, 0x62, 0x20, 0xf8, 0xc0, 0x86, 0xde,
+		0x03, 0x60, 0x58, 0x4e, 0xc7, 0x67, 0xc1, 0x2e, 0xf3, 0xc4, 0x59, 0x4a, 0xa1, 0xce, 0x0b, 0xbd,
+		0x6c, 0xc7, 0x0f, 0xca, 0xd9, 0x22, 0x0a, 0x8c, 0x44, 0x19, 0x9e, 0x0a, 0x1b, 0x9a, 0xa4, 0x0d,
+		0x5d, 0x18, 0xd2, 0xd3, 0x3e, 0xc3, 0x7c, 0x14, 0x24, 0xdd, 0x34, 0x88, 0xe5, 0xab, 0x9e, 0xef,
+		0x12, 0xad, 0x6d, 0x58, 0x2d, 0xb6, 0xce, 0x96, 0x53, 0x7b, 0x9a, 0xe9, 0x11, 0xa5, 0xc0, 0x8a,
+		0x1b, 0xa2, 0x14, 0x25, 0xa8, 0x01, 0xb9, 0x11, 0x89, 0x74, 0x97, 0x04, 0x2b, 0x0e, 0x24, 0x4a,
+		0x3f, 0x9e, 0x85, 0xa9, 0xc8, 0x66, 0x4a, 0xbe, 0x17, 0x72, 0x2f, 0x6b, 0xd7, 0x35, 0x55, 0x6c,
+		0x90, 0x99, 0x26, 0xa6, 0x90, 0xb6, 0xc5, 0x48, 0xf2, 0xa3, 0x30, 0x47, 0x59, 0xec, 0x8e, 0x4f,
+		0x5c, 0x55, 0x37, 0x35, 0xcf, 0xa3, 0x4a, 0xcb, 0x50, 0x56, 0x19, 0xcb, 0x36, 0xb1, 0x68, 0x45,
+		0x94, 0xc8, 0xe7, 0x61, 0x96, 0x4a, 0xb4, 0x3b, 0xa6, 0x6f, 0x38, 0x26, 0x51, 0x71, 0xcb, 0xee,
+		0x15, 0x21, 0xda, 0xb2, 0x19, 0xe4, 0x58, 0xe7, 0x0c, 0xd8, 0x22, 0x4f, 0xae, 0xc1, 0x3d, 0x54,
+		0xac, 0x45, 0x2c, 0xe2, 0x6a, 0x3e, 0x51, 0xc9, 0x87, 0x3b, 0x9a, 0xe9, 0xa9, 0x9a, 0xd5, 0x54,
+		0xf7, 0x35, 0x6f, 0xbf, 0x38, 0x17, 0x84, 0x25, 0xa7, 0x90, 0xf1, 0x12, 0xe7, 0xab, 0x53, 0xb6,
+		0x8a, 0xd5, 0xbc, 0xac, 0x79, 0xfb, 0x72, 0x19, 0x4e, 0x52, 0x14, 0xcf, 0x77, 0x0d, 0xab, 0xa5,
+		0xea, 0xfb, 0x44, 0x3f, 0x50, 0x3b, 0xfe, 0xde, 0x53, 0xc5, 0xd3, 0xd1, 0xfa, 0x69, 0x0b, 0x1b,
+		0x94, 0x67, 0x05, 0x59, 0x76, 0xfc, 0xbd, 0xa7, 0xe4, 0x06, 0xe4, 0x70, 0x30, 0xda, 0xc6, 0x2b,
+		0x44, 0xdd, 0xb3, 0x5d, 0xba, 0x86, 0xe6, 0x07, 0xb8, 0xa6, 0x88, 0x06, 0x97, 0x37, 0xb9, 0xc0,
+		0xba, 0xdd, 0x24, 0xe5, 0x54, 0x63, 0xab, 0x5e, 0xaf, 0x29, 0x53, 0x02, 0xe5, 0xa2, 0xed, 0xa2,
+		0x41, 0xb5, 0xec, 0x40, 0xc1, 0x53, 0xcc, 0xa0, 0x5a, 0xb6, 0x50, 0xef, 0x79, 0x98, 0xd5, 0x75,
+		0xd6, 0x67, 0x43, 0x57, 0xf9, 0xc6, 0xda, 0x2b, 0x4a, 0x5d, 0xca, 0xd2, 0xf5, 0x4b, 0x8c, 0x81,
+		0xdb, 0xb8, 0x27, 0x3f, 0x0d, 0x27, 0x42, 0x65, 0x45, 0x05, 0x67, 0xfa, 0x7a, 0xd9, 0x2b, 0x7a,
+		0x1e, 0x66, 0x9d, 0xc3, 0x7e, 0x41, 0xb9, 0xab, 0x46, 0xe7, 0xb0, 0x57, 0xec, 0x49, 0x98, 0x73,
+		0xf6, 0x9d, 0x7e, 0xb9, 0x87, 0xa3, 0x72, 0xb2, 0xb3, 0xef, 0xf4, 0x0a, 0xbe, 0x8f, 0x66, 0x59,
+		0x5c, 0xa2, 0xd3, 0xe8, 0xf0, 0xae, 0x28, 0x7b, 0xa4, 0x40, 0x5e, 0x06, 0x49, 0xd7, 0x55, 0x62,
+		0x69, 0xbb, 0x26, 0x51, 0x35, 0x97, 0x58, 0x9a, 0x57, 0x5c, 0xa4, 0xcc, 0x49, 0xdf, 0xed, 0x10,
+		0x25, 0xaf, 0xeb, 0x75, 0x5a, 0x58, 0xa1, 0x65, 0xf2, 0xc3, 0x30, 0x63, 0xef, 0xbe, 0xac, 0x33,
+		0x8b, 0x54, 0x1d, 0x97, 0xec, 0x19, 0x37, 0x8b, 0xf7, 0x53, 0xf5, 0x16, 0xb0, 0x80, 0xda, 0xe3,
+		0x16, 0x25, 0xcb, 0x0f, 0x81, 0xa4, 0x7b, 0xfb, 0x9a, 0xeb, 0x50, 0x97, 0xec, 0x39, 0x9a, 0x4e,
+		0x8a, 0xef, 0x63, 0xac, 0x8c, 0xbe, 0x21, 0xc8, 0x38, 0x23, 0xbc, 0x1b, 0xc6, 0x9e, 0x2f, 0x10,
+		0x1f, 0x64, 0x33, 0x82, 0xd2, 0x38, 0xda, 0x19, 0x90, 0x50, 0x13, 0x5d, 0x15, 0x9f, 0xa1, 0x6c,
+		0x79, 0x67, 0xdf, 0x89, 0xd6, 0x7b, 0x1f, 0x4c, 0x3b, 0xfb, 0xd1, 0x4a, 0x1f, 0x62, 0x81, 0x9b,
+		0xb3, 0x1f, 0xa9, 0xf1, 0x09, 0x38, 0x89, 0x4c, 0x6d, 0xe2, 0x6b, 0x4d, 0xcd, 0xd7, 0x22, 0xdc,
+		0x1f, 0xa0, 0xdc, 0xa8, 0xf6, 0x75, 0x5e, 0xd8, 0xd5, 0x4e, 0xb7, 0xb3, 0x7b, 0x18, 0x18, 0xd6,
+		0x23, 0xac, 0x9d, 0x48, 0x13, 0xa6, 0xf5, 0xae, 0xed, 0xa6, 0x4a, 0x65, 0xc8, 0x45, 0xed, 0x5e,
+		0xce, 0x02, 0xb3, 0x7c, 0x29, 0x86, 0x41, 0xd0, 0xca, 0x66, 0x0d, 0xc3, 0x97, 0x97, 0xea, 0x52,
+		0x1c, 0xc3, 0xa8, 0xb5, 0xd5, 0xed, 0xba, 0xaa, 0xec, 0x6c, 0x6c, 0xaf, 0xae, 0xd7, 0xa5, 0x44,
+		0x24, 0xb0, 0xbf, 0x92, 0xcc, 0x3c, 0x20, 0x3d, 0x58, 0xfa, 0x72, 0x02, 0xf2, 0xdd, 0x7b, 0x6b,
+		0xf9, 0x87, 0xe0, 0x2e, 0x91, 0x22, 0xf3, 0x88, 0xaf, 0xde, 0x30, 0x5c, 0x3a, 0x21, 0xdb, 0x1a,
+		0x5b, 0x1c, 0x03, 0xfb, 0x99, 0xe3, 0x5c, 0x0d, 0xe2, 0x3f, 0x6f, 0xb8, 0x38, 0xdd, 0xda, 0x9a,
+		0x2f, 0xaf, 0xc1, 0xa2, 0x65, 0xab, 0x9e, 0xaf, 0x59, 0x4d, 0xcd, 0x6d, 0xaa, 0x61, 0x72, 0x52,
+		0xd5, 0x74, 0x9d, 0x78, 0x9e, 0xcd, 0x16, 0xc2, 0x00, 0xe5, 0x6e, 0xcb, 0x6e, 0x70, 0xe6, 0x70,
+		0x85, 0xa8, 0x70, 0xd6, 0x1e, 0xf3, 0x4d, 0x0c, 0x33, 0xdf, 0xd3, 0x90, 0x6d, 0x6b, 0x8e, 0x4a,
+		0x2c, 0xdf, 0x3d, 0xa4, 0xf1, 0x79, 0x46, 0xc9, 0xb4, 0x35, 0xa7, 0x8e, 0xcf, 0xf2, 0x35, 0x78,
+		0x20, 0x64, 0x55, 0x4d, 0xd2, 0xd2, 0xf4, 0x43, 0x95, 0x06, 0xe3, 0x34, 0xd1, 0xa3, 0xea, 0xb6,
+		0xb5, 0x67, 0x1a, 0xba, 0xef, 0x15, 0xa7, 0x02, 0x1f, 0x57, 0x0a, 0x25, 0xd6, 0xa8, 0xc0, 0x15,
+		0xcf, 0xb6, 0x68, 0x0c, 0xbe, 0x22, 0xb8, 0xdf, 0xbd, 0x11, 0xee, 0x1e, 0xa5, 0xa4, 0x94, 0xba,
+		0x92, 0xcc, 0xa4, 0xa4, 0xf4, 0x95, 0x64, 0x26, 0x2d, 0x4d, 0x5e, 0x49, 0x66, 0x32, 0x52, 0xf6,
+		0x4a, 0x32, 0x93, 0x95, 0xa0, 0xf4, 0xa3, 0x59, 0xc8, 0x45, 0x77, 0x06, 0xb8, 0xd1, 0xd2, 0xe9,
+		0xda, 0x18, 0xa3, 0xde, 0xf3, 0xbe, 0x23, 0xf7, 0x11, 0xcb, 0x2b, 0xb8, 0x68, 0x96, 0xd3, 0x2c,
+		0x0c, 0x57, 0x98, 0x24, 0x06, 0x2c, 0x68, 0xd6, 0x84, 0x85, 0x3d, 0x19, 0x85, 0x3f, 0xc9, 0x97,
+		0x20, 0xfd, 0xb2, 0x47, 0xb1, 0xd3, 0x14, 0xfb, 0xfe, 0xa3, 0xb1, 0xaf, 0x34, 0x28, 0x78, 0xf6,
+		0x4a, 0x43, 0xdd, 0xd8, 0x54, 0xd6, 0x2b, 0x6b, 0x0a, 0x17, 0x97, 0x4f, 0x41, 0xd2, 0xd4, 0x5e,
+		0x39, 0xec, 0x5e, 0x5e, 0x29, 0x49, 0x5e, 0x86, 0x42, 0xc7, 0x62, 0xbb, 0x6e, 0x1c, 0x2a, 0xe4,
+		0x2a, 0x44, 0xb9, 0xf2, 0x61, 0xe9, 0x1a, 0xf2, 0x8f, 0x69, 0x1e, 0xa7, 0x20, 0x89, 0x69, 0xe0,
+		0xee, 0x45, 0x90, 0x92, 0xe4, 0x33, 0x90, 0x6b, 0x92, 0xdd, 0x4e, 0x4b, 0x75, 0x49, 0x53, 0xd3,
+		0xfd, 0x6e, 0xd7, 0x3f, 0x45, 0x8b, 0x14, 0x5a, 0x22, 0x5f, 0x85, 0x2c, 0x8e, 0x91, 0x45, 0xc7,
+		0x78, 0x86, 0xaa, 0xe0, 0x91, 0xa3, 0x55, 0xc0, 0x87, 0x58, 0x08, 0x29, 0xa1, 0xbc, 0x7c, 0x05,
+		0xd2, 0xbe, 0xe6, 0xb6, 0x88, 0x4f, 0x3d, 0x7f, 0xfe, 0xdc, 0xf2, 0x38, 0x48, 0xdb, 0x54, 0x02,
+		0xd5, 0x4a, 0x6d, 0x94, 0x23, 0xc8, 0x97, 0x61, 0x92, 0xfd, 0xf2, 0x8a, 0xb3, 0x4b, 0x89, 0xe3,
+		0x83, 0x29, 0x42, 0xfc, 0x5d, 0xf4, 0x59, 0x67, 0x21, 0x45, 0x8d, 0x4d, 0x06, 0xe0, 0xe6, 0x26,
+		0x4d, 0xc8, 0x19, 0x48, 0xae, 0x6c, 0x2a, 0xe8, 0xb7, 0x24, 0xc8, 0x31, 0xaa, 0xba, 0xb5, 0x5a,
+		0x5f, 0xa9, 0x4b, 0xf1, 0xd2, 0x79, 0x48, 0x33, 0x0b, 0x42, 0x9f, 0x16, 0xd8, 0x90, 0x34, 0xc1,
+		0x1f, 0x39, 0x46, 0x4c, 0x94, 0xee, 0xac, 0x57, 0xeb, 0x8a, 0x14, 0x2f, 0xed, 0x40, 0xa1, 0x47,
+		0xeb, 0xf2, 0x09, 0x98, 0x51, 0xea, 0xdb, 0xf5, 0x0d, 0xdc, 0xb5, 0xa9, 0x3b, 0x1b, 0x57, 0x37,
+		0x36, 0x9f, 0xc7, 0x94, 0x47, 0x17, 0x59, 0x38, 0xc8, 0x98, 0x3c, 0x07, 0x52, 0x48, 0x6e, 0x6c,
+		0xee, 0x28, 0xb4, 0x35, 0x7f, 0x3d, 0x0e, 0x52, 0xaf, 0xda, 0xe4, 0xbb, 0x60, 0x76, 0xbb, 0xa2,
+		0x5c, 0xaa, 0x6f, 0xab, 0x6c, 0x27, 0x1a, 0x40, 0xcf, 0x81, 0x14, 0x2d, 0xb8, 0xb8, 0x4a, 0x37,
+		0xda, 0x8b, 0x70, 0x3a, 0x4a, 0xad, 0xbf, 0xb0, 0x5d, 0xdf, 0x68, 0xd0, 0xca, 0x2b, 0x1b, 0x97,
+		0xd0, 0x5b, 0xf7, 0xe0, 0x89, 0xbd, 0x6f, 0x02, 0x9b, 0xda, 0x8d, 0x57, 0x5f, 0xab, 0x49, 0xc9,
+		0x5e, 0xf2, 0xe6, 0x46, 0x7d, 0xf3, 0xa2, 0x94, 0xea, 0xad, 0x9d, 0xee, 0x87, 0xd3, 0xf2, 0x3c,
+		0x9c, 0xec, 0xa5, 0xaa, 0xf5, 0x8d, 0x6d, 0xe5, 0x45, 0x69, 0xb2, 0xb7, 0xe2, 0x46, 0x5d, 0xb9,
+		0xb6, 0xba, 0x52, 0x97, 0x32, 0xf2, 0x49, 0x90, 0xbb, 0x5b, 0xb4, 0x7d, 0x79, 0xb3, 0x26, 0x65,
+		0xfb, 0xfc, 0x53, 0xc9, 0x83, 0x5c, 0x74, 0x53, 0xfa, 0x03, 0x71, 0x8d, 0xa5, 0x4f, 0xc6, 0x61,
+		0x2a, 0xb2, 0xc9, 0xc4, 0xdd, 0x81, 0x66, 0x9a, 0xf6, 0x0d, 0x55, 0x33, 0x0d, 0xcd, 0xe3, 0xde,
+		0x0b, 0x28, 0xa9, 0x82, 0x94, 0x71, 0xbd, 0xc5, 0xf8, 0xeb, 0x45, 0xfa, 0xbd, 0xb8, 0x5e, 0xa4,
+		0xa4, 0x74, 0xe9, 0xd3, 0x31, 0x90, 0x7a, 0x77, 0x8f, 0x3d, 0xdd, 0x8f, 0x0d, 0xeb, 0xfe, 0x0f,
+		0x64, 0xec, 0x3e, 0x15, 0x83, 0x7c, 0xf7, 0x96, 0xb1, 0xa7, 0x79, 0xf7, 0xfe, 0x3f, 0x6d, 0xde,
+		0xef, 0xc5, 0x61, 0xba, 0x6b, 0xa3, 0x38, 0x6e, 0xeb, 0x3e, 0x0c, 0x33, 0x46, 0x93, 0xb4, 0x1d,
+		0xdb, 0xc7, 0xd3, 0x46, 0xd5, 0x24, 0xd7, 0x89, 0x59, 0x2c, 0x51, 0x17, 0x7f, 0xf6, 0xe8, 0xad,
+		0xe8, 0xf2, 0x6a, 0x28, 0xb7, 0x86, 0x62, 0xe5, 0xd9, 0xd5, 0x5a, 0x7d, 0x7d, 0x6b, 0x73, 0xbb,
+		0xbe, 0xb1, 0xf2, 0xa2, 0xf0, 0x2e, 0x8a, 0x64, 0xf4, 0xb0, 0xbd, 0x8b, 0x4e, 0x7b, 0x0b, 0xa4,
+		0xde, 0x46, 0xa1, 0xaf, 0x18, 0xd0, 0x2c, 0x69, 0x42, 0x9e, 0x85, 0xc2, 0xc6, 0xa6, 0xda, 0x58,
+		0xad, 0xd5, 0xd5, 0xfa, 0xc5, 0x8b, 0xf5, 0x95, 0xed, 0x06, 0x4b, 0x2e, 0x06, 0xdc, 0xdb, 0x52,
+		0x3c, 0xaa, 0xe2, 0xd7, 0x13, 0x30, 0x3b, 0xa0, 0x25, 0x72, 0x85, 0xa7, 0x05, 0x58, 0xa6, 0xe2,
+		0x91, 0x71, 0x5a, 0xbf, 0x8c, 0x81, 0xf9, 0x96, 0xe6, 0xfa, 0x3c, 0x8b, 0xf0, 0x10, 0xa0, 0x96,
+		0x2c, 0x1f, 0xe3, 0x04, 0x97, 0x27, 0x6d, 0x59, 0xae, 0xa0, 0x10, 0xd2, 0x59, 0xde, 0xf6, 0x03,
+		0x20, 0x3b, 0xb6, 0x67, 0xf8, 0xc6, 0x75, 0x3c, 0xc3, 0x14, 0x19, 0x5e, 0xcc, 0x1d, 0x24, 0x15,
+		0x49, 0x94, 0xac, 0x5a, 0x7e, 0xc0, 0x6d, 0x91, 0x96, 0xd6, 0xc3, 0x8d, 0x71, 0x4c, 0x42, 0x91,
+		0x44, 0x49, 0xc0, 0x7d, 0x2f, 0xe4, 0x9a, 0x76, 0x07, 0x37, 0x54, 0x8c, 0x0f, 0xbd, 0x45, 0x4c,
+		0x99, 0x62, 0xb4, 0x80, 0x85, 0x6f, 0x95, 0xc3, 0xd4, 0x72, 0x4e, 0x99, 0x62, 0x34, 0xc6, 0xf2,
+		0x20, 0x14, 0xb4, 0x56, 0xcb, 0x45, 0x70, 0x01, 0xc4, 0x36, 0xff, 0xf9, 0x80, 0x4c, 0x19, 0xe7,
+		0xaf, 0x40, 0x46, 0xe8, 0x01, 0xe3, 0x61, 0xd4, 0x84, 0xea, 0xb0, 0x8c, 0x56, 0x1c, 0xb3, 0xcd,
+		0x96, 0x28, 0xbc, 0x17, 0x72, 0x86, 0xa7, 0x86, 0x67, 0x9b, 0xf1, 0xa5, 0xf8, 0x99, 0x8c, 0x32,
+		0x65, 0x78, 0xc1, 0x19, 0x49, 0xe9, 0xb3, 0x71, 0xc8, 0x77, 0x9f, 0xda, 0xca, 0x35, 0xc8, 0x98,
+		0x36, 0x3f, 0x64, 0x61, 0x57, 0x06, 0xce, 0x8c, 0x38, 0xe8, 0x5d, 0x5e, 0xe3, 0xfc, 0x4a, 0x20,
+		0x39, 0xff, 0x6f, 0x62, 0x90, 0x11, 0x64, 0xf9, 0x24, 0x24, 0x1d, 0xcd, 0xdf, 0xa7, 0x70, 0xa9,
+		0x6a, 0x5c, 0x8a, 0x29, 0xf4, 0x19, 0xe9, 0x9e, 0xa3, 0xb1, 0x73, 0x22, 0x4e, 0xc7, 0x67, 0x1c,
+		0x57, 0x93, 0x68, 0x4d, 0x9a, 0x59, 0xb0, 0xdb, 0x6d, 0x62, 0xf9, 0x9e, 0x18, 0x57, 0x4e, 0x5f,
+		0xe1, 0x64, 0xbc, 0x3c, 0xe0, 0xbb, 0x9a, 0x61, 0x76, 0xf1, 0x26, 0x29, 0xaf, 0x24, 0x0a, 0x02,
+		0xe6, 0x32, 0x9c, 0x12, 0xb8, 0x4d, 0xe2, 0x6b, 0xfa, 0x3e, 0x69, 0x86, 0x42, 0x69, 0x9a, 0x41,
+		0xbc, 0x8b, 0x33, 0xd4, 0x78, 0xb9, 0x90, 0x2d, 0x7d, 0x3d, 0x0e, 0x33, 0x22, 0x17, 0xd2, 0x0c,
+		0x94, 0xb5, 0x0e, 0xa0, 0x59, 0x96, 0xed, 0x47, 0xd5, 0xd5, 0x6f, 0xca, 0x7d, 0x72, 0xcb, 0x95,
+		0x40, 0x48, 0x89, 0x00, 0xcc, 0xff, 0x51, 0x0c, 0x20, 0x2c, 0x1a, 0xaa, 0xb7, 0x45, 0x98, 0xe2,
+		0x67, 0xf2, 0xf4, 0x62, 0x07, 0x4b, 0x9f, 0x01, 0x23, 0x61, 0xd6, 0x04, 0x93, 0x9c, 0xbb, 0xa4,
+		0x65, 0x58, 0xfc, 0x74, 0x86, 0x3d, 0x88, 0x24, 0x67, 0x32, 0x3c, 0x9e, 0x54, 0x20, 0xe3, 0x91,
+		0xb6, 0x66, 0xf9, 0x86, 0xce, 0xcf, 0x5b, 0x2e, 0x1c, 0xab, 0xf1, 0xcb, 0x0d, 0x2e, 0xad, 0x04,
+		0x38, 0xa5, 0x33, 0x90, 0x11, 0x54, 0x0c, 0xfc, 0x36, 0x36, 0x37, 0xea, 0xd2, 0x84, 0x3c, 0x09,
+		0x89, 0x46, 0x7d, 0x5b, 0x8a, 0xe1, 0x26, 0xb6, 0xb2, 0xb6, 0x5a, 0x69, 0x48, 0xf1, 0xea, 0x5f,
+		0x84, 0x59, 0xdd, 0x6e, 0xf7, 0x56, 0x58, 0x95, 0x7a, 0x12, 0x88, 0xde, 0xe5, 0xd8, 0x4b, 0x8f,
+		0x70, 0xa6, 0x96, 0x6d, 0x6a, 0x56, 0x6b, 0xd9, 0x76, 0x5b, 0xe1, 0xb5, 0x18, 0xdc, 0x6b, 0x78,
+		0x91, 0xcb, 0x31, 0xce, 0xee, 0xff, 0x8a, 0xc5, 0x7e, 0x2e, 0x9e, 0xb8, 0xb4, 0x55, 0xfd, 0x5c,
+		0x7c, 0xfe, 0x12, 0x13, 0xdc, 0x12, 0xdd, 0x51, 0xc8, 0x9e, 0x49, 0x74, 0x6c, 0x3c, 0x7c, 0xeb,
+		0xfd, 0x30, 0xd7, 0xb2, 0x5b, 0x36, 0x45, 0x3a, 0x8b, 0xbf, 0x58, 0x23, 0xe4, 0x6c, 0x40, 0x9d,
+		0x1f, 0x79, 0x09, 0xa7, 0xbc, 0x01, 0xb3, 0x9c, 0x59, 0xa5, 0xc7, 0xf7, 0x2c, 0x55, 0x21, 0x1f,
+		0x99, 0x27, 0x2f, 0xfe, 0xea, 0x1f, 0xd0, 0xa8, 0x44, 0x99, 0xe1, 0xa2, 0x58, 0xc6, 0xb2, 0x19,
+		0x65, 0x05, 0x4e, 0x74, 0xe1, 0x31, 0x1f, 0x41, 0xdc, 0x11, 0x88, 0xff, 0x82, 0x23, 0xce, 0x46,
+		0x10, 0x1b, 0x5c, 0xb4, 0xbc, 0x02, 0xd3, 0xc7, 0xc1, 0xfa, 0x97, 0x1c, 0x2b, 0x47, 0xa2, 0x20,
+		0x97, 0xa0, 0x40, 0x41, 0xf4, 0x8e, 0xe7, 0xdb, 0x6d, 0xea, 0x80, 0x8f, 0x86, 0xf9, 0x57, 0x7f,
+		0xc0, 0x26, 0x6d, 0x1e, 0xc5, 0x56, 0x02, 0xa9, 0x72, 0x19, 0xe8, 0x8d, 0x05, 0x3c, 0xdd, 0x1d,
+		0x81, 0xf0, 0x55, 0xde, 0x90, 0x80, 0xbf, 0x7c, 0x0d, 0xe6, 0xf0, 0x37, 0xf5, 0x8f, 0xd1, 0x96,
+		0x8c, 0x4e, 0xaa, 0x17, 0xff, 0xed, 0x47, 0x99, 0x5f, 0x98, 0x0d, 0x00, 0x22, 0x6d, 0x8a, 0x8c,
+		0x62, 0x8b, 0xf8, 0x3e, 0x71, 0x3d, 0x55, 0x33, 0x07, 0x35, 0x2f, 0x92, 0x95, 0x2c, 0xfe, 0xf4,
+		0xb7, 0xbb, 0x47, 0xf1, 0x12, 0x93, 0xac, 0x98, 0x66, 0x79, 0x07, 0xee, 0x1a, 0x60, 0x15, 0x63,
+		0x60, 0xbe, 0xce, 0x31, 0xe7, 0xfa, 0x2c, 0x03, 0x61, 0xb7, 0x40, 0xd0, 0x83, 0xb1, 0x1c, 0x03,
+		0xf3, 0x67, 0x38, 0xa6, 0xcc, 0x65, 0xc5, 0x90, 0x22, 0xe2, 0x15, 0x98, 0xb9, 0x4e, 0xdc, 0x5d,
+		0xdb, 0xe3, 0x99, 0xe0, 0x31, 0xe0, 0x3e, 0xc5, 0xe1, 0x0a, 0x5c, 0x90, 0xa6, 0x86, 0x11, 0xeb,
+		0x69, 0xc8, 0xec, 0x69, 0x3a, 0x19, 0x03, 0xe2, 0x16, 0x87, 0x98, 0x44, 0x7e, 0x14, 0xad, 0x40,
+		0xae, 0x65, 0xf3, 0x25, 0x72, 0xb4, 0xf8, 0xa7, 0xb9, 0xf8, 0x94, 0x90, 0xe1, 0x10, 0x8e, 0xed,
+		0x74, 0x4c, 0x5c, 0x3f, 0x47, 0x43, 0xfc, 0xac, 0x80, 0x10, 0x32, 0x1c, 0xe2, 0x18, 0x6a, 0x7d,
+		0x43, 0x40, 0x78, 0x11, 0x7d, 0x3e, 0x8b, 0x07, 0xc4, 0xe6, 0xa1, 0x6d, 0x8d, 0xd3, 0x88, 0x37,
+		0x39, 0x02, 0x70, 0x11, 0x04, 0x78, 0x06, 0xb2, 0xe3, 0x0e, 0xc4, 0x2f, 0x7c, 0x5b, 0x4c, 0x0f,
+		0x31, 0x02, 0x97, 0xa0, 0x20, 0x1c, 0x14, 0x5e, 0x01, 0x1a, 0x0d, 0xf1, 0x77, 0x38, 0x44, 0x3e,
+		0x22, 0xc6, 0xbb, 0xe1, 0x13, 0xcf, 0x6f, 0x91, 0x71, 0x40, 0x3e, 0x2b, 0xba, 0xc1, 0x45, 0xb8,
+		0x2a, 0x77, 0x89, 0xa5, 0xef, 0x8f, 0x87, 0xf0, 0x8b, 0x42, 0x95, 0x42, 0x06, 0x21, 0x56, 0x60,
+		0xba, 0xad, 0xb9, 0xde, 0xbe, 0x66, 0x8e, 0x35, 0x1c, 0x7f, 0x97, 0x63, 0xe4, 0x02, 0x21, 0xae,
+		0x91, 0x8e, 0x75, 0x1c, 0x98, 0xcf, 0x09, 0x8d, 0x74, 0xac, 0x2e, 0xa0, 0x2d, 0x98, 0xf3, 0x7c,
+		0x9a, 0x36, 0x3f, 0x0e, 0xda, 0x2f, 0x89, 0xa9, 0xc7, 0x64, 0xd7, 0xa3, 0x88, 0xcf, 0x40, 0xd6,
+		0x33, 0x5e, 0x19, 0x0b, 0xe6, 0xf3, 0x62, 0xa4, 0xa9, 0x00, 0x0a, 0xbf, 0x08, 0xa7, 0x06, 0x2e,
+		0x13, 0x63, 0x80, 0xfd, 0x32, 0x07, 0x3b, 0x39, 0x60, 0xa9, 0xe0, 0x2e, 0xe1, 0xb8, 0x90, 0x7f,
+		0x4f, 0xb8, 0x04, 0xd2, 0x83, 0xb5, 0x85, 0x9b, 0x16, 0x4f, 0xdb, 0x3b, 0x9e, 0xd6, 0xfe, 0xbe,
+		0xd0, 0x1a, 0x93, 0xed, 0xd2, 0xda, 0x36, 0x9c, 0xe4, 0x88, 0xc7, 0x1b, 0xd7, 0x5f, 0x11, 0x8e,
+		0x95, 0x49, 0xef, 0x74, 0x8f, 0xee, 0x0f, 0xc3, 0x7c, 0xa0, 0x4e, 0x11, 0x1d, 0x7b, 0x2a, 0xe6,
+		0x9a, 0x47, 0x23, 0xff, 0x2a, 0x47, 0x16, 0x1e, 0x3f, 0x08, 0xaf, 0xbd, 0x75, 0xcd, 0x41, 0xf0,
+		0x17, 0xa0, 0x28, 0xc0, 0x3b, 0x96, 0x4b, 0x74, 0xbb, 0x65, 0x19, 0xaf, 0x90, 0xe6, 0x18, 0xd0,
+		0xbf, 0xd6, 0x33, 0x54, 0x3b, 0x11, 0x71, 0x44, 0x5e, 0x05, 0x29, 0x88, 0x55, 0x54, 0xa3, 0xed,
+		0xd8, 0xae, 0x3f, 0x02, 0xf1, 0x0b, 0x62, 0xa4, 0x02, 0xb9, 0x55, 0x2a, 0x56, 0xae, 0x03, 0xbb,
+		0x4b, 0x32, 0xae, 0x49, 0x7e, 0x91, 0x03, 0x4d, 0x87, 0x52, 0xdc, 0x71, 0xe8, 0x76, 0xdb, 0xd1,
+		0xdc, 0x71, 0xfc, 0xdf, 0x3f, 0x10, 0x8e, 0x83, 0x8b, 0x70, 0xc7, 0x81, 0x11, 0x1d, 0xae, 0xf6,
+		0x63, 0x20, 0x7c, 0x49, 0x38, 0x0e, 0x21, 0xc3, 0x21, 0x44, 0xc0, 0x30, 0x06, 0xc4, 0x3f, 0x14,
+		0x10, 0x42, 0x06, 0x21, 0x9e, 0x0b, 0x17, 0x5a, 0x97, 0xb4, 0x0c, 0xcf, 0xe7, 0x97, 0xc1, 0x8e,
+		0x86, 0xfa, 0x47, 0xdf, 0xee, 0x0e, 0xc2, 0x94, 0x88, 0x28, 0x7a, 0x22, 0x7e, 0x90, 0x42, 0xb7,
+		0x6c, 0xa3, 0x1b, 0xf6, 0xeb, 0xc2, 0x13, 0x45, 0xc4, 0xb0, 0x6d, 0x91, 0x08, 0x11, 0xd5, 0xae,
+		0xe3, 0x46, 0x65, 0x0c, 0xb8, 0xdf, 0xe8, 0x69, 0x5c, 0x43, 0xc8, 0x22, 0x66, 0x24, 0xfe, 0xe9,
+		0x58, 0x07, 0xe4, 0x70, 0x2c, 0xeb, 0xfc, 0x72, 0x4f, 0xfc, 0xb3, 0xc3, 0x24, 0x99, 0x0f, 0x29,
+		0xf4, 0xc4, 0x53, 0xf2, 0xa8, 0xbb, 0x9e, 0xc5, 0x8f, 0xbc, 0xc3, 0xfb, 0xdb, 0x1d, 0x4e, 0x95,
+		0xd7, 0x40, 0xe2, 0x94, 0x30, 0x80, 0x1d, 0x09, 0xf6, 0xd1, 0x77, 0x02, 0x3b, 0xef, 0x8a, 0x79,
+		0xca, 0x17, 0x61, 0xba, 0x2b, 0xe0, 0x19, 0x0d, 0xf5, 0x97, 0x39, 0x54, 0x2e, 0x1a, 0xef, 0x94,
+		0xcf, 0x43, 0x12, 0x83, 0x97, 0xd1, 0xe2, 0x3f, 0xca, 0xc5, 0x29, 0x7b, 0xf9, 0x83, 0x90, 0x11,
+		0x41, 0xcb, 0x68, 0xd1, 0xbf, 0xc2, 0x45, 0x03, 0x11, 0x14, 0x17, 0x01, 0xcb, 0x68, 0xf1, 0xbf,
+		0x2a, 0xc4, 0x85, 0x08, 0x8a, 0x8f, 0xaf, 0xc2, 0xaf, 0xfc, 0xb5, 0x24, 0x13, 0x17, 0x22, 0x65,
+		0xbc, 0xcb, 0xc2, 0x22, 0x95, 0xd1, 0xd2, 0x1f, 0xe7, 0x95, 0x0b, 0x89, 0xf2, 0x93, 0x90, 0x1a,
+		0x53, 0xe1, 0x3f, 0xc6, 0x45, 0x19, 0x7f, 0x79, 0x05, 0xa6, 0x22, 0xd1, 0xc9, 0x68, 0xf1, 0xbf,
+		0xc1, 0xc5, 0xa3, 0x52, 0xd8, 0x74, 0x1e, 0x9d, 0x8c, 0x06, 0xf8, 0x9b, 0xa2, 0xe9, 0x5c, 0x02,
+		0xd5, 0x26, 0x02, 0x93, 0xd1, 0xd2, 0x9f, 0x10, 0x5a, 0x17, 0x22, 0xe5, 0x67, 0x21, 0x1b, 0x2c,
+		0x36, 0xa3, 0xe5, 0x7f, 0x9c, 0xcb, 0x87, 0x32, 0xa8, 0x81, 0x8e, 0x75, 0x0c, 0x88, 0xbf, 0x25,
+		0x34, 0x10, 0x91, 0xc2, 0x69, 0xd4, 0x1b, 0xc0, 0x8c, 0x46, 0xfa, 0x09, 0x31, 0x8d, 0x7a, 0xe2,
+		0x17, 0x1c, 0x4d, 0xea, 0xf3, 0x47, 0x43, 0xfc, 0x6d, 0x31, 0x9a, 0x94, 0x1f, 0x9b, 0xd1, 0x1b,
+		0x11, 0x8c, 0xc6, 0xf8, 0x29, 0xd1, 0x8c, 0x9e, 0x80, 0xa0, 0xbc, 0x05, 0x72, 0x7f, 0x34, 0x30,
+		0x1a, 0xef, 0x93, 0x1c, 0x6f, 0xa6, 0x2f, 0x18, 0x28, 0x3f, 0x0f, 0x27, 0x07, 0x47, 0x02, 0xa3,
+		0x51, 0x7f, 0xfa, 0x9d, 0x9e, 0xbd, 0x5b, 0x34, 0x10, 0x28, 0x6f, 0xc3, 0xdc, 0xa0, 0x28, 0x60,
+		0x34, 0xec, 0xeb, 0xef, 0x74, 0x3b, 0xee, 0x68, 0x10, 0x50, 0xae, 0x00, 0x84, 0x0b, 0xf0, 0x68,
+		0xac, 0x4f, 0x71, 0xac, 0x88, 0x10, 0x4e, 0x0d, 0xbe, 0xfe, 0x8e, 0x96, 0xbf, 0x25, 0xa6, 0x06,
+		0x97, 0xc0, 0xa9, 0x21, 0x96, 0xde, 0xd1, 0xd2, 0x9f, 0x16, 0x53, 0x43, 0x88, 0xa0, 0x65, 0x47,
+		0x56, 0xb7, 0xd1, 0x08, 0x6f, 0x0a, 0xcb, 0x8e, 0x48, 0x95, 0x37, 0x60, 0xa6, 0x6f, 0x41, 0x1c,
+		0x0d, 0xf5, 0x73, 0x1c, 0x4a, 0xea, 0x5d, 0x0f, 0xa3, 0x8b, 0x17, 0x5f, 0x0c, 0x47, 0xa3, 0x7d,
+		0xa6, 0x67, 0xf1, 0xe2, 0x6b, 0x61, 0xf9, 0x19, 0xc8, 0x58, 0x1d, 0xd3, 0xc4, 0xc9, 0x23, 0x1f,
+		0x7d, 0xdb, 0xb7, 0xf8, 0x5f, 0xbe, 0xc7, 0xb5, 0x23, 0x04, 0xca, 0xe7, 0x21, 0x45, 0xda, 0xbb,
+		0xa4, 0x39, 0x4a, 0xf2, 0x5b, 0xdf, 0x13, 0x0e, 0x13, 0xb9, 0xcb, 0xcf, 0x02, 0xb0, 0xd4, 0x08,
+		0x3d, 0x86, 0x1f, 0x21, 0xfb, 0x47, 0xdf, 0xe3, 0xd7, 0xeb, 0x42, 0x91, 0x10, 0x80, 0x5d, 0xd6,
+		0x3b, 0x1a, 0xe0, 0xdb, 0xdd, 0x00, 0x74, 0x44, 0x9e, 0x86, 0x49, 0x3c, 0x48, 0xf3, 0xb5, 0xd6,
+		0x28, 0xe9, 0xff, 0xca, 0xa5, 0x05, 0x3f, 0x2a, 0xac, 0x6d, 0xbb, 0xc4, 0xd7, 0x5a, 0xde, 0x28,
+		0xd9, 0xff, 0xc6, 0x65, 0x03, 0x01, 0x14, 0xd6, 0x35, 0xcf, 0x1f, 0xa7, 0xdf, 0x7f, 0x2c, 0x84,
+		0x85, 0x00, 0x36, 0x1a, 0x7f, 0x1f, 0x90, 0xc3, 0x51, 0xb2, 0xdf, 0x11, 0x8d, 0xe6, 0xfc, 0xe5,
+		0x0f, 0x42, 0x16, 0x7f, 0xb2, 0x3b, 0xb3, 0x23, 0x84, 0xff, 0x3b, 0x17, 0x0e, 0x25, 0xb0, 0x66,
+		0xcf, 0x6f, 0xfa, 0xc6, 0x68, 0x65, 0x7f, 0x97, 0x8f, 0xb4, 0xe0, 0x2f, 0x57, 0x60, 0xca, 0xf3,
+		0x9b, 0xcd, 0x0e, 0x8f, 0x4f, 0x47, 0x88, 0xff, 0x8f, 0xef, 0x05, 0x29, 0x8b, 0x40, 0x06, 0x47,
+		0xfb, 0xc6, 0x81, 0xef, 0xd8, 0xf4, 0xbc, 0x65, 0x14, 0xc2, 0x3b, 0x1c, 0x21, 0x22, 0x52, 0x5e,
+		0x81, 0x1c, 0xf6, 0x45, 0xbc, 0x8b, 0x30, 0x0a, 0xe2, 0x7f, 0x72, 0x05, 0x74, 0x09, 0x55, 0x7f,
+		0xe4, 0xab, 0x6f, 0x2f, 0xc4, 0xbe, 0xfe, 0xf6, 0x42, 0xec, 0xf7, 0xde, 0x5e, 0x88, 0x7d, 0xe2,
+		0x1b, 0x0b, 0x13, 0x5f, 0xff, 0xc6, 0xc2, 0xc4, 0x6f, 0x7f, 0x63, 0x61, 0x62, 0x70, 0x96, 0x18,
+		0x2e, 0xd9, 0x97, 0x6c, 0x96, 0x1f, 0x7e, 0xa9, 0xd4, 0x32, 0xfc, 0xfd, 0xce, 0xee, 0xb2, 0x6e,
+		0xb7, 0x69, 0x1a, 0x37, 0xcc, 0xd6, 0x06, 0x9b, 0x1c, 0xf8, 0x48, 0x1c, 0x4e, 0x31, 0x8c, 0xb0,
+		0x54, 0xb3, 0x0e, 0x87, 0xbc, 0x49, 0x39, 0x3f, 0x30, 0x31, 0x5c, 0xba, 0x0c, 0x89, 0x8a, 0x75,
+		0x28, 0x9f, 0x62, 0x3e, 0x4f, 0xed, 0xb8, 0x26, 0xbf, 0xcb, 0x39, 0x89, 0xcf, 0x3b, 0xae, 0x89,
+		0x99, 0x77, 0x71, 0xe1, 0x1a, 0x4f, 0x78, 0xd8, 0x43, 0x59, 0xfa, 0xe4, 0x1b, 0x8b, 0x13, 0xbf,
+		0xf2, 0xc6, 0xe2, 0xc4, 0x77, 0xde, 0x5c, 0x9c, 0x78, 0xf5, 0x77, 0x97, 0x26, 0xaa, 0x07, 0xbd,
+		0xbd, 0xfd, 0xca, 0xc8, 0x1e, 0x67, 0x2a, 0xd6, 0x21, 0xed, 0xf0, 0x56, 0xec, 0xa5, 0x14, 0xd6,
+		0xe7, 0x89, 0x24, 0xf7, 0x42, 0x6f, 0x92, 0xfb, 0x79, 0x62, 0x9a, 0x57, 0x2d, 0xfb, 0x86, 0x85,
+		0xf7, 0x17, 0xbc, 0xdd, 0x34, 0x7b, 0x49, 0x00, 0x7e, 0x22, 0x0e, 0x0b, 0x7d, 0xf9, 0x6c, 0x6e,
+		0x05, 0xc3, 0x5e, 0x29, 0x2d, 0x43, 0xa6, 0x26, 0x8c, 0xab, 0x88, 0xef, 0x32, 0xea, 0xb6, 0xd5,
+		0xf4, 0x68, 0xb7, 0x13, 0x8a, 0x78, 0xc4, 0x6e, 0x5b, 0x9a, 0x65, 0x7b, 0xfc, 0xee, 0x33, 0x7b,
+		0xa8, 0xfe, 0x4c, 0xec, 0x78, 0x63, 0x3a, 0x2d, 0x6a, 0x12, 0xdd, 0x7c, 0x6c, 0x64, 0xda, 0xff,
+		0x00, 0x7b, 0x19, 0x74, 0xa2, 0x2b, 0xf5, 0x3f, 0xae, 0x56, 0x7e, 0x2a, 0x0e, 0x8b, 0xbd, 0x5a,
+		0xc1, 0xa9, 0xe5, 0xf9, 0x5a, 0xdb, 0x19, 0xa6, 0x96, 0x67, 0x20, 0xbb, 0x2d, 0x78, 0x8e, 0xad,
+		0x97, 0x5b, 0xc7, 0xd4, 0x4b, 0x3e, 0xa8, 0x4a, 0x28, 0xe6, 0xdc, 0x98, 0x8a, 0x09, 0xfa, 0x71,
+		0x5b, 0x9a, 0xf9, 0xdf, 0x69, 0x38, 0xa5, 0xdb, 0x5e, 0xdb, 0xf6, 0x54, 0x36, 0x15, 0xd8, 0x03,
+		0xd7, 0x49, 0x2e, 0x5a, 0x34, 0xfa, 0xa0, 0xa4, 0x74, 0x15, 0x66, 0x57, 0xd1, 0x5d, 0xe0, 0x36,
+		0x28, 0x3c, 0xe2, 0x19, 0x78, 0x3d, 0x7c, 0xa9, 0x2b, 0xe2, 0xe7, 0x07, 0x5c, 0x51, 0x52, 0xe9,
+		0x23, 0x31, 0x90, 0x1a, 0xba, 0x66, 0x6a, 0xee, 0xf7, 0x0b, 0x25, 0x3f, 0x09, 0xc0, 0xee, 0x7b,
+		0x04, 0x6f, 0x6e, 0xe6, 0xcf, 0x15, 0x97, 0xa3, 0x9d, 0x5b, 0x66, 0x35, 0xd1, 0x3b, 0x54, 0x59,
+		0xca, 0x8b, 0x3f, 0x1f, 0x7e, 0x01, 0x20, 0x2c, 0x90, 0x4f, 0xc3, 0x5d, 0x8d, 0x95, 0xca, 0x5a,
+		0x45, 0x11, 0xb7, 0x84, 0x1a, 0x5b, 0xf5, 0x15, 0xf6, 0x9e, 0xd5, 0x04, 0x5e, 0xb0, 0x89, 0x16,
+		0x06, 0xb7, 0x9a, 0x4e, 0xc0, 0x4c, 0x94, 0xce, 0x5e, 0x7a, 0x89, 0x63, 0xa8, 0x68, 0xb4, 0x1d,
+		0x93, 0xd0, 0xa3, 0x47, 0xd5, 0x10, 0x5a, 0x1b, 0x1d, 0x85, 0xfc, 0xeb, 0x7f, 0xc7, 0x5e, 0x84,
+		0x98, 0x0d, 0xc5, 0x03, 0x9d, 0x97, 0xd7, 0x60, 0x06, 0xaf, 0x66, 0x3a, 0x5d, 0x90, 0x23, 0x7c,
+		0x35, 0x02, 0xd2, 0xc3, 0x54, 0x2e, 0x19, 0xa2, 0x3d, 0x09, 0x69, 0x8f, 0xf6, 0x7e, 0x14, 0xc4,
+		0xd7, 0x38, 0x04, 0x67, 0x2f, 0x5b, 0x30, 0xc3, 0x5e, 0xec, 0x23, 0x91, 0x66, 0x1c, 0x9d, 0x68,
+		0xf8, 0xc7, 0x5f, 0x78, 0x94, 0x1e, 0xad, 0xde, 0xdb, 0x3d, 0x2c, 0x03, 0xcc, 0x49, 0x91, 0x38,
+		0x76, 0xd8, 0x50, 0x02, 0x79, 0x51, 0x1f, 0x6f, 0xf0, 0xd1, 0x95, 0xfd, 0x13, 0x5e, 0xd9, 0xc2,
+		0x20, 0x1b, 0x88, 0xd4, 0x34, 0xcd, 0x51, 0x59, 0x41, 0xb5, 0x3e, 0x6c, 0x4e, 0xbf, 0xf4, 0xfe,
+		0xc8, 0xf2, 0xc4, 0x20, 0xf9, 0x9f, 0x47, 0x28, 0xf2, 0x33, 0xd1, 0x6a, 0x82, 0xb9, 0xf7, 0x5b,
+		0x09, 0x58, 0xe0, 0xcc, 0xbb, 0x9a, 0x47, 0xce, 0x5e, 0x7f, 0x6c, 0x97, 0xf8, 0xda, 0x63, 0x67,
+		0x75, 0xdb, 0x10, 0xbe, 0x7a, 0x96, 0x4f, 0x47, 0x2c, 0x5f, 0xe6, 0xe5, 0x83, 0x17, 0xae, 0xf9,
+		0xe1, 0xd3, 0xb8, 0xb4, 0x03, 0xc9, 0x15, 0xdb, 0xb0, 0xd0, 0x55, 0x35, 0x89, 0x65, 0xb7, 0xf9,
+		0xec, 0x61, 0x0f, 0xf2, 0x63, 0x90, 0xd6, 0xda, 0x76, 0xc7, 0xf2, 0xd9, 0xcc, 0xa9, 0x9e, 0xfa,
+		0xea, 0x5b, 0x8b, 0x13, 0xff, 0xfe, 0xad, 0xc5, 0xc4, 0xaa, 0xe5, 0xff, 0xe6, 0x17, 0x1f, 0x01,
+		0x0e, 0xb5, 0x6a, 0xf9, 0x0a, 0x67, 0x2c, 0x27, 0xbf, 0xf9, 0xc6, 0x62, 0xac, 0xf4, 0x02, 0x4c,
+		0xd6, 0x88, 0x7e, 0x3b, 0xc8, 0x35, 0xa2, 0x47, 0x90, 0x6b, 0x44, 0xef, 0x41, 0x7e, 0x12, 0x32,
+		0xab, 0x96, 0xcf, 0xde, 0x2d, 0x79, 0x3f, 0x24, 0x0c, 0x8b, 0x5d, 0x57, 0x3e, 0xb2, 0x6d, 0xc8,
+		0x85, 0x82, 0x35, 0xa2, 0x07, 0x82, 0x4d, 0xa2, 0x17, 0x63, 0xa3, 0xaa, 0x46, 0xae, 0x6a, 0xed,
+		0xb7, 0x7f, 0x7f, 0x61, 0xe2, 0xd5, 0xb7, 0x17, 0x26, 0x86, 0x0e, 0x71, 0x69, 0xe8, 0x10, 0x7b,
+		0xcd, 0x03, 0xe6, 0x91, 0x83, 0x91, 0xfd, 0x5c, 0x12, 0xee, 0xa1, 0xaf, 0x1c, 0xba, 0x6d, 0xc3,
+		0xf2, 0xcf, 0xea, 0xee, 0xa1, 0xe3, 0xd3, 0x90, 0xc5, 0xde, 0xe3, 0x03, 0x3b, 0x13, 0x16, 0x2f,
+		0xb3, 0xe2, 0x21, 0xf1, 0xc8, 0x1e, 0xa4, 0xb6, 0x50, 0x0e, 0x55, 0xec, 0xdb, 0xbe, 0x66, 0xf2,
+		0xf5, 0x87, 0x3d, 0x20, 0x95, 0xbd, 0xa6, 0x18, 0x67, 0x54, 0x43, 0xbc, 0xa1, 0x68, 0x12, 0x6d,
+		0x8f, 0xbd, 0xed, 0x91, 0xa0, 0x61, 0x4a, 0x06, 0x09, 0xf4, 0xc5, 0x8e, 0x39, 0x48, 0x69, 0x1d,
+		0x76, 0x87, 0x22, 0x81, 0xf1, 0x0b, 0x7d, 0x28, 0x5d, 0x85, 0x49, 0x7e, 0x94, 0x8a, 0x97, 0x08,
+		0x0e, 0xc8, 0x21, 0xad, 0x27, 0xa7, 0xe0, 0x4f, 0x79, 0x19, 0x52, 0xb4, 0xf1, 0xfc, 0x35, 0xb6,
+		0xe2, 0x72, 0x5f, 0xeb, 0x97, 0x69, 0x23, 0x15, 0xc6, 0x56, 0xba, 0x02, 0x99, 0x9a, 0xdd, 0x36,
+		0x2c, 0xbb, 0x1b, 0x2d, 0xcb, 0xd0, 0x68, 0x9b, 0x9d, 0x0e, 0xb7, 0x0a, 0x85, 0x3d, 0xe0, 0x5d,
+		0x65, 0xf6, 0xf6, 0x0f, 0xbf, 0x07, 0xc2, 0x9f, 0x4a, 0x2b, 0x30, 0x49, 0xb1, 0x37, 0x9d, 0xe0,
+		0x8d, 0xdb, 0x58, 0xe4, 0x8d, 0x5b, 0x0e, 0x1f, 0x0f, 0x1b, 0x2b, 0x43, 0xb2, 0xa9, 0xf9, 0x1a,
+		0xef, 0x37, 0xfd, 0x5d, 0xfa, 0x10, 0x64, 0x38, 0x88, 0x27, 0x9f, 0x83, 0x84, 0xed, 0x78, 0xfc,
+		0x26, 0xc7, 0xfc, 0xb0, 0xae, 0x6c, 0x3a, 0xd5, 0x24, 0xda, 0x8c, 0x82, 0xcc, 0x55, 0x65, 0xa8,
+		0x59, 0x3c, 0x15, 0x31, 0x8b, 0xc8, 0x90, 0x47, 0x7e, 0xb2, 0x21, 0xed, 0x33, 0x87, 0xc0, 0x58,
+		0xde, 0x8c, 0xc3, 0x42, 0xa4, 0xf4, 0x3a, 0x71, 0x3d, 0xc3, 0xb6, 0x98, 0x45, 0x71, 0x6b, 0x91,
+		0x23, 0x8d, 0xe4, 0xe5, 0x43, 0xcc, 0xe5, 0x83, 0x90, 0xa8, 0x38, 0x0e, 0xbe, 0x04, 0x4b, 0x9f,
+		0x75, 0x9b, 0xd9, 0x4b, 0x52, 0x09, 0x9e, 0xb1, 0xcc, 0xb3, 0xf7, 0xfc, 0x1b, 0x9a, 0x1b, 0xbc,
+		0x20, 0x2b, 0x9e, 0x4b, 0x4f, 0x43, 0x76, 0xc5, 0xb6, 0x3c, 0x62, 0x79, 0x1d, 0x1a, 0xd9, 0xec,
+		0x9a, 0xb6, 0x7e, 0xc0, 0x11, 0xd8, 0x03, 0x2a, 0x5c, 0x73, 0x1c, 0x2a, 0x99, 0x54, 0xf0, 0x27,
+		0x9b, 0xb3, 0xd5, 0xc6, 0x50, 0x15, 0x3d, 0x7d, 0x7c, 0x15, 0xf1, 0x4e, 0x06, 0x3a, 0xfa, 0x93,
+		0x18, 0xdc, 0xdd, 0x3f, 0xa1, 0x0e, 0xc8, 0xa1, 0x77, 0xdc, 0xf9, 0xf4, 0x02, 0x64, 0xb7, 0xe8,
+		0x17, 0x47, 0xae, 0x92, 0x43, 0x79, 0x1e, 0x3f, 0x4b, 0x71, 0xee, 0xfc, 0xf9, 0xc7, 0x9e, 0x66,
+		0xd6, 0x7e, 0x79, 0x42, 0x11, 0x04, 0x79, 0x01, 0xb2, 0x1e, 0xd1, 0x9d, 0x73, 0xe7, 0x2f, 0x1c,
+		0x3c, 0xc6, 0xcc, 0xeb, 0xf2, 0x84, 0x12, 0x92, 0xca, 0x19, 0xec, 0xf5, 0x37, 0xdf, 0x5c, 0x8c,
+		0x55, 0x53, 0x90, 0xf0, 0x3a, 0xed, 0x77, 0xd5, 0x46, 0x5e, 0x4f, 0xc1, 0x52, 0x54, 0x92, 0xc6,
+		0x7f, 0xd7, 0x35, 0xd3, 0x68, 0x6a, 0xe1, 0xb7, 0x62, 0xa4, 0x88, 0x0e, 0x28, 0xc7, 0x90, 0x95,
+		0xe2, 0x48, 0x4d, 0x96, 0x7e, 0x2d, 0x06, 0xb9, 0x6b, 0x02, 0x19, 0x3f, 0x2e, 0xf3, 0x0c, 0x40,
+		0x50, 0x93, 0x98, 0x36, 0xa7, 0x97, 0x7b, 0xeb, 0x5a, 0x0e, 0x64, 0x94, 0x08, 0xbb, 0xfc, 0x24,
+		0x35, 0x44, 0xc7, 0xf6, 0xf8, 0x4b, 0x93, 0x23, 0x44, 0x03, 0x66, 0xbc, 0x9f, 0x47, 0x3d, 0x9c,
+		0x7a, 0xdd, 0xf6, 0xf1, 0xc6, 0x80, 0x63, 0xdf, 0xe0, 0xaf, 0xa2, 0x27, 0x14, 0x89, 0x96, 0x5c,
+		0xa3, 0x05, 0x5b, 0x48, 0xc7, 0x46, 0x67, 0x03, 0x14, 0x0c, 0xd6, 0xb5, 0x66, 0xd3, 0x25, 0x9e,
+		0xc7, 0x9d, 0x98, 0x78, 0xc4, 0x37, 0x35, 0x9d, 0xce, 0xae, 0x2a, 0x3c, 0x06, 0xbe, 0xeb, 0x3a,
+		0x60, 0xfe, 0x0b, 0xfb, 0xe0, 0x1e, 0x20, 0xed, 0x74, 0x76, 0xd1, 0x5a, 0xee, 0x85, 0xdc, 0x80,
+		0xc6, 0x4c, 0x5d, 0x0f, 0xdb, 0x41, 0x3f, 0x74, 0xc3, 0x7b, 0xa0, 0x3a, 0xae, 0x61, 0xbb, 0x86,
+		0x7f, 0x48, 0x6f, 0x63, 0x25, 0x14, 0x49, 0x14, 0x6c, 0x71, 0x7a, 0xe9, 0x00, 0x0a, 0x0d, 0x1a,
+		0xc4, 0x85, 0x2d, 0x3f, 0x1f, 0xb6, 0x2f, 0x36, 0xba, 0x7d, 0x43, 0x5b, 0x16, 0xef, 0x6b, 0x59,
+		0xf5, 0xb9, 0xa1, 0xd6, 0xf9, 0xe4, 0xf1, 0xad, 0xb3, 0x7b, 0xb5, 0xfb, 0xe3, 0x53, 0x70, 0x77,
+		0x6f, 0x61, 0x97, 0xfb, 0x1a, 0xd7, 0x30, 0x47, 0xed, 0xd1, 0xe6, 0x8f, 0x5e, 0x54, 0xe7, 0x47,
+		0xb8, 0xd1, 0xf9, 0x91, 0x53, 0xa8, 0xf4, 0x34, 0x4c, 0xe3, 0xbd, 0xca, 0x06, 0xf1, 0x2f, 0x13,
+		0xad, 0x49, 0xdc, 0xee, 0x55, 0x77, 0x5a, 0xac, 0xba, 0x32, 0x24, 0xe9, 0xd2, 0xca, 0x56, 0x1d,
+		0xfa, 0xbb, 0xb4, 0x0f, 0x49, 0x14, 0x0d, 0x57, 0x64, 0x2e, 0x41, 0x1f, 0x90, 0xba, 0x7b, 0xe8,
+		0x13, 0x4f, 0x24, 0x0d, 0xe8, 0x83, 0xfc, 0x84, 0x58, 0x57, 0x13, 0x47, 0xaf, 0xab, 0xdc, 0x10,
+		0xf9, 0xea, 0x6a, 0xc2, 0x64, 0x15, 0x5d, 0xf1, 0x6a, 0x2d, 0x68, 0x48, 0x2c, 0x6c, 0x88, 0xbc,
+		0x0e, 0x05, 0x47, 0x73, 0x7d, 0xfa, 0xc2, 0xd7, 0x3e, 0xed, 0x05, 0xb7, 0xf5, 0xc5, 0xfe, 0x99,
+		0xd7, 0xd5, 0x59, 0x5e, 0xcb, 0xb4, 0x13, 0x25, 0x96, 0xfe, 0x30, 0x09, 0x69, 0xae, 0x8c, 0x0f,
+		0xc2, 0x24, 0x57, 0x2b, 0xb7, 0xce, 0x7b, 0x96, 0xfb, 0x17, 0xa6, 0xe5, 0x60, 0x01, 0xe1, 0x78,
+		0x42, 0x46, 0x7e, 0x00, 0x32, 0xfa, 0xbe, 0x66, 0x58, 0xaa, 0xd1, 0xe4, 0x01, 0xe1, 0xd4, 0xdb,
+		0x6f, 0x2d, 0x4e, 0xae, 0x20, 0x6d, 0xb5, 0xa6, 0x4c, 0xd2, 0xc2, 0xd5, 0x26, 0x46, 0x02, 0xfb,
+		0xc4, 0x68, 0xed, 0xfb, 0x7c, 0x86, 0xf1, 0x27, 0xfc, 0xca, 0x15, 0x1a, 0x04, 0x7f, 0x1d, 0x78,
+		0xbe, 0x2f, 0xc2, 0x0f, 0xb6, 0xd0, 0xd5, 0x0c, 0x56, 0xfc, 0x89, 0xff, 0xb4, 0x18, 0x53, 0xa8,
+		0x84, 0xbc, 0x02, 0xd3, 0xa6, 0xe6, 0xf9, 0x2a, 0x5d, 0xc1, 0xb0, 0xfa, 0x14, 0x85, 0x38, 0xd5,
+		0xaf, 0x10, 0xae, 0x58, 0xde, 0xf4, 0x29, 0x94, 0x62, 0xa4, 0x26, 0xbe, 0xad, 0x48, 0x41, 0xf0,
+		0x3a, 0xa9, 0xe1, 0xb3, 0xd8, 0x2a, 0x4d, 0xf5, 0x9e, 0x47, 0xfa, 0x0a, 0x25, 0xd3, 0x08, 0xeb,
+		0x34, 0x64, 0xe9, 0x0b, 0x88, 0x94, 0x85, 0xdd, 0x03, 0xce, 0x20, 0x81, 0x16, 0x3e, 0x08, 0x85,
+		0xd0, 0x3f, 0x32, 0x96, 0x0c, 0x43, 0x09, 0xc9, 0x94, 0xf1, 0x51, 0x98, 0xb3, 0xc8, 0x4d, 0x5f,
+		0x0d, 0xc9, 0x8c, 0x3b, 0x4b, 0xb9, 0x65, 0x2c, 0xbb, 0xd6, 0x2d, 0xf1, 0x3e, 0xc8, 0xeb, 0x42,
+		0xf9, 0x8c, 0x17, 0x28, 0xef, 0x74, 0x40, 0xa5, 0x6c, 0xa7, 0x20, 0xa3, 0x39, 0x0e, 0x63, 0x98,
+		0xe2, 0xfe, 0xd1, 0x71, 0x68, 0xd1, 0xc3, 0x30, 0x43, 0xfb, 0xe8, 0x12, 0xaf, 0x63, 0xfa, 0x1c,
+		0x24, 0x47, 0x79, 0x0a, 0x58, 0xa0, 0x30, 0x3a, 0xe5, 0xbd, 0x0f, 0xa6, 0xc9, 0x75, 0xa3, 0x49,
+		0x2c, 0x9d, 0x30, 0xbe, 0x69, 0xca, 0x97, 0x13, 0x44, 0xca, 0xf4, 0x10, 0x04, 0x7e, 0x4f, 0x15,
+		0x3e, 0x39, 0xcf, 0xf0, 0x04, 0xbd, 0xc2, 0xc8, 0xa5, 0x22, 0x24, 0x6b, 0x9a, 0xaf, 0x61, 0x80,
+		0xe1, 0xdf, 0x64, 0x0b, 0x4d, 0x4e, 0xc1, 0x9f, 0xa5, 0x6f, 0xc6, 0x21, 0x79, 0xcd, 0xf6, 0x89,
+		0xfc, 0x78, 0x24, 0x00, 0xcc, 0x0f, 0xb2, 0xe7, 0x86, 0xd1, 0xb2, 0x48, 0x73, 0xdd, 0x6b, 0x45,
+		0xbe, 0x16, 0x12, 0x9a, 0x53, 0xbc, 0xcb, 0x9c, 0xe6, 0x20, 0xe5, 0xda, 0x1d, 0xab, 0x29, 0x6e,
+		0xd0, 0xd2, 0x07, 0xb9, 0x0e, 0x99, 0xc0, 0x4a, 0x92, 0xa3, 0xac, 0xa4, 0x80, 0x56, 0x82, 0x36,
+		0xcc, 0x09, 0xca, 0xe4, 0x2e, 0x37, 0x96, 0x2a, 0x64, 0x03, 0xe7, 0x55, 0x4c, 0x1d, 0xc3, 0x60,
+		0x43, 0x31, 0x5c, 0x4c, 0x82, 0xb1, 0x0f, 0x94, 0xc7, 0x2c, 0x4e, 0x0a, 0x0a, 0xb8, 0xf6, 0xba,
+		0xcc, 0x8a, 0x7f, 0xb9, 0x64, 0x92, 0xf6, 0x2b, 0x34, 0x2b, 0xf6, 0xf5, 0x92, 0xbb, 0xf1, 0x4a,
+		0x52, 0xcb, 0xd2, 0xfc, 0x8e, 0x4b, 0xb8, 0xe5, 0x85, 0x84, 0xd2, 0x57, 0x62, 0x90, 0x66, 0x96,
+		0x1c, 0xd1, 0x5b, 0x6c, 0xb0, 0xde, 0xe2, 0xc3, 0xf4, 0x96, 0xb8, 0x7d, 0xbd, 0x55, 0x00, 0x82,
+		0xc6, 0x78, 0xfc, 0x83, 0x12, 0x03, 0x22, 0x06, 0xd6, 0xc4, 0x86, 0xd1, 0xe2, 0x13, 0x35, 0x22,
+		0x54, 0xfa, 0x8f, 0x31, 0xc8, 0x06, 0xe5, 0x72, 0x05, 0xa6, 0x45, 0xbb, 0xd4, 0x3d, 0x53, 0x6b,
+		0x71, 0xdb, 0xb9, 0x67, 0x68, 0xe3, 0x2e, 0x9a, 0x5a, 0x4b, 0x99, 0xe2, 0xed, 0xc1, 0x87, 0xc1,
+		0xe3, 0x10, 0x1f, 0x32, 0x0e, 0x5d, 0x03, 0x9f, 0xb8, 0xbd, 0x81, 0xef, 0x1a, 0xa2, 0x64, 0xef,
+		0x10, 0x7d, 0x21, 0x4e, 0x37, 0x33, 0x8e, 0xed, 0x69, 0xe6, 0x0f, 0x62, 0x46, 0x9c, 0x86, 0xac,
+		0x63, 0x9b, 0x2a, 0x2b, 0x61, 0x37, 0xcb, 0x33, 0x8e, 0x6d, 0x2a, 0x7d, 0xc3, 0x9e, 0xba, 0x43,
+		0xd3, 0x25, 0x7d, 0x07, 0xb4, 0x36, 0xd9, 0xab, 0x35, 0x17, 0x72, 0x4c, 0x15, 0x7c, 0x2d, 0x7b,
+		0x14, 0x75, 0x80, 0xbf, 0x8a, 0xb1, 0xfe, 0xb5, 0x97, 0x35, 0x9b, 0x71, 0x2a, 0xe9, 0xfd, 0x40,
+		0x82, 0xb9, 0xfe, 0x62, 0x7c, 0x98, 0x04, 0x33, 0x3b, 0x85, 0xf3, 0x95, 0x7e, 0x32, 0x06, 0xb0,
+		0x86, 0x9a, 0xa5, 0xfd, 0xc5, 0x55, 0xc8, 0xa3, 0x4d, 0x50, 0xbb, 0x6a, 0x5e, 0x18, 0x36, 0x68,
+		0xbc, 0xfe, 0x9c, 0x17, 0x6d, 0xf7, 0x0a, 0x4c, 0x87, 0xc6, 0xe8, 0x11, 0xd1, 0x98, 0x85, 0x23,
+		0xa2, 0xea, 0x06, 0xf1, 0x95, 0xdc, 0xf5, 0xc8, 0x53, 0xe9, 0x9f, 0xc7, 0x20, 0x4b, 0xdb, 0x84,
+		0xaf, 0xc3, 0x77, 0x8d, 0x61, 0xec, 0xf6, 0xc7, 0xf0, 0x1e, 0x00, 0x06, 0x83, 0x07, 0xb4, 0xdc,
+		0xb2, 0xb2, 0x94, 0x82, 0xc7, 0xae, 0xf2, 0x85, 0x40, 0xe1, 0x89, 0xa3, 0x15, 0x2e, 0xa2, 0x6e,
+		0xae, 0xf6, 0xbb, 0x60, 0x92, 0x7e, 0x32, 0xef, 0xa6, 0xc7, 0x03, 0x69, 0xfc, 0xea, 0xca, 0xf6,
+		0x4d, 0xaf, 0xf4, 0x32, 0x4c, 0x6e, 0xdf, 0x64, 0xb9, 0x91, 0xd3, 0x90, 0x75, 0x6d, 0x9b, 0xaf,
+		0xc9, 0x2c, 0x16, 0xca, 0x20, 0x81, 0x2e, 0x41, 0x22, 0x1f, 0x10, 0x0f, 0xf3, 0x01, 0x61, 0x42,
+		0x23, 0x31, 0x56, 0x42, 0xe3, 0xe1, 0xdf, 0x8a, 0xc1, 0x54, 0xc4, 0x3f, 0xc8, 0x8f, 0xc1, 0x89,
+		0xea, 0xda, 0xe6, 0xca, 0x55, 0x75, 0xb5, 0xa6, 0x5e, 0x5c, 0xab, 0x5c, 0x0a, 0x5f, 0x9e, 0x9a,
+		0x3f, 0xf9, 0xda, 0xad, 0x25, 0x39, 0xc2, 0xbb, 0x63, 0xd1, 0x3c, 0xbd, 0x7c, 0x16, 0xe6, 0xba,
+		0x45, 0x2a, 0xd5, 0x06, 0xbe, 0x49, 0x15, 0x9b, 0x3f, 0xf1, 0xda, 0xad, 0xa5, 0x99, 0x88, 0x44,
+		0x65, 0xd7, 0x23, 0x96, 0xdf, 0x2f, 0xb0, 0xb2, 0xb9, 0xbe, 0xbe, 0xba, 0x2d, 0xc5, 0xfb, 0x04,
+		0xb8, 0xc3, 0x7e, 0x08, 0x66, 0xba, 0x05, 0x36, 0x56, 0xd7, 0xa4, 0xc4, 0xbc, 0xfc, 0xda, 0xad,
+		0xa5, 0x7c, 0x84, 0x7b, 0xc3, 0x30, 0xe7, 0x33, 0x1f, 0xfb, 0xcc, 0xc2, 0xc4, 0x2f, 0xfe, 0xfc,
+		0x42, 0x0c, 0x7b, 0x36, 0xdd, 0xe5, 0x23, 0xe4, 0x0f, 0xc0, 0x5d, 0x8d, 0xd5, 0x4b, 0x1b, 0xf5,
+		0x9a, 0xba, 0xde, 0xb8, 0xd4, 0xf3, 0x3e, 0xec, 0x7c, 0xe1, 0xb5, 0x5b, 0x4b, 0x53, 0xbc, 0x4b,
+		0xc3, 0xb8, 0xb7, 0x94, 0xfa, 0xb5, 0xcd, 0xed, 0xba, 0x14, 0x63, 0xdc, 0x5b, 0x2e, 0xb9, 0x6e,
+		0xfb, 0xec, 0x6b, 0x9b, 0x8f, 0xc2, 0xa9, 0x01, 0xdc, 0x41, 0xc7, 0x66, 0x5e, 0xbb, 0xb5, 0x34,
+		0xbd, 0xe5, 0x12, 0x36, 0x7f, 0xa8, 0xc4, 0x32, 0x14, 0xfb, 0x25, 0x36, 0xb7, 0x36, 0x1b, 0x95,
+		0x35, 0x69, 0x69, 0x5e, 0x7a, 0xed, 0xd6, 0x52, 0x4e, 0x38, 0x43, 0xe4, 0x0f, 0x7b, 0xf6, 0x6e,
+		0xee, 0x78, 0x7e, 0xe9, 0x59, 0xb8, 0x9f, 0xe7, 0x00, 0x3d, 0x5f, 0x3b, 0x30, 0xac, 0x56, 0x90,
+		0xbc, 0xe5, 0xcf, 0x7c, 0xe7, 0x73, 0x92, 0x71, 0x2d, 0x0b, 0xea, 0x88, 0x14, 0xee, 0xd0, 0xd3,
+		0xcb, 0xf9, 0x11, 0x87, 0x7a, 0xa3, 0xb7, 0x4e, 0xc3, 0xd3, 0xc3, 0xf3, 0x23, 0x92, 0xd0, 0xf3,
+		0x47, 0x6e, 0xee, 0x4a, 0x1f, 0x8f, 0x41, 0xfe, 0xb2, 0xe1, 0xf9, 0xb6, 0x6b, 0xe8, 0x9a, 0x49,
+		0x5f, 0x99, 0xba, 0x30, 0xae, 0x6f, 0xed, 0x99, 0xea, 0xcf, 0x42, 0xfa, 0xba, 0x66, 0x32, 0xa7,
+		0x16, 0x3d, 0x0b, 0xe8, 0x55, 0x5f, 0xe8, 0xda, 0x04, 0x00, 0x13, 0x2b, 0x7d, 0x3e, 0x0e, 0x05,
+		0x3a, 0x19, 0x3c, 0xf6, 0x79, 0x40, 0xdc, 0x63, 0x6d, 0x41, 0xd2, 0xd5, 0x7c, 0x9e, 0x34, 0xac,
+		0xfe, 0x10, 0xcf, 0x03, 0x3f, 0x30, 0x3a, 0x9b, 0xbb, 0xdc, 0x9f, 0x2a, 0xa6, 0x48, 0xf2, 0xf3,
+		0x90, 0x69, 0x6b, 0x37, 0x55, 0x8a, 0x1a, 0xbf, 0x03, 0xa8, 0x93, 0x6d, 0xed, 0x26, 0xb6, 0x55,
+		0x6e, 0x42, 0x01, 0x81, 0xf5, 0x7d, 0xcd, 0x6a, 0x11, 0x86, 0x9f, 0xb8, 0x03, 0xf8, 0xd3, 0x6d,
+		0xed, 0xe6, 0x0a, 0xc5, 0xc4, 0x5a, 0xca, 0x19, 0x3c, 0xa9, 0xa6, 0x69, 0xf6, 0x2f, 0xc7, 0x00,
+		0x42, 0x75, 0xc9, 0x7f, 0x16, 0x24, 0x3d, 0x78, 0xa2, 0xd5, 0x7b, 0x7c, 0x00, 0x1f, 0x1c, 0x36,
+		0x10, 0x3d, 0xca, 0x66, 0x0b, 0xf3, 0xd7, 0xdf, 0x5a, 0x8c, 0x29, 0x05, 0xbd, 0x67, 0x1c, 0xea,
+		0x30, 0xd5, 0x71, 0x9a, 0x9a, 0x4f, 0x54, 0xba, 0x89, 0x8b, 0x1f, 0x63, 0x91, 0x07, 0x26, 0x88,
+		0x45, 0x91, 0xd6, 0x7f, 0x9e, 0x7e, 0xc4, 0x31, 0x3c, 0xe4, 0x2b, 0xc2, 0x64, 0xdb, 0xb6, 0x8c,
+		0x03, 0x6e, 0x76, 0x59, 0x45, 0x3c, 0x62, 0xc6, 0x93, 0xbd, 0x2c, 0xea, 0x1f, 0x8a, 0x8c, 0xa7,
+		0x78, 0x46, 0xa9, 0x1b, 0x64, 0xd7, 0x33, 0x84, 0xae, 0x15, 0xf1, 0x88, 0x5b, 0x17, 0x8f, 0xe8,
+		0x1d, 0x4c, 0xd5, 0xe0, 0x7b, 0xe2, 0x3e, 0x7e, 0x52, 0x82, 0xbd, 0x5e, 0x54, 0x10, 0xf4, 0x15,
+		0x46, 0x46, 0x90, 0x26, 0xf1, 0x35, 0xc3, 0xf4, 0x8a, 0xec, 0x20, 0x4c, 0x3c, 0x46, 0x9a, 0xfb,
+		0xe9, 0x4c, 0x34, 0x45, 0xb5, 0x02, 0x92, 0xed, 0x10, 0xb7, 0x2b, 0xa4, 0x64, 0x16, 0x5a, 0xfc,
+		0xcd, 0x2f, 0x3e, 0x32, 0xc7, 0xd5, 0xcd, 0x83, 0x4a, 0x76, 0xb1, 0x55, 0x29, 0x08, 0x09, 0x4e,
+		0x96, 0x5f, 0x04, 0x29, 0xd8, 0xd9, 0xa9, 0x4e, 0x67, 0x37, 0x4c, 0x6b, 0xcd, 0xf5, 0xe9, 0xb5,
+		0x62, 0x1d, 0x56, 0x8b, 0x5f, 0x0b, 0xa1, 0xc3, 0x5c, 0x12, 0x26, 0x92, 0x0a, 0x01, 0xce, 0x16,
+		0x85, 0xc1, 0x10, 0xf1, 0x65, 0xcd, 0x30, 0xc5, 0xbb, 0xf5, 0x0a, 0x7f, 0x92, 0xcb, 0x90, 0xf6,
+		0x7c, 0xcd, 0xef, 0x78, 0xfc, 0xf3, 0x8f, 0xa5, 0x61, 0x96, 0x51, 0xb5, 0xad, 0x66, 0x83, 0x72,
+		0x2a, 0x5c, 0x42, 0xde, 0x86, 0xb4, 0x6f, 0x1f, 0x10, 0x8b, 0x2b, 0xe9, 0x58, 0x56, 0x3d, 0xe0,
+		0x2c, 0x8a, 0x61, 0xc9, 0x2d, 0x90, 0x9a, 0xc4, 0x24, 0x2d, 0x16, 0x10, 0xed, 0x6b, 0xb8, 0x6f,
+		0x48, 0xdf, 0x81, 0x59, 0x53, 0x08, 0x50, 0x1b, 0x14, 0x54, 0xbe, 0xda, 0x7d, 0xcc, 0xcc, 0xbe,
+		0x6e, 0x7b, 0xdf, 0xb0, 0xfe, 0x47, 0x2c, 0x53, 0x24, 0x13, 0x22, 0xd2, 0x68, 0x5c, 0x1d, 0x6b,
+		0xd7, 0xb6, 0xe8, 0x9b, 0xaa, 0x3c, 0x18, 0xcf, 0xd0, 0xf0, 0xa6, 0x10, 0xd0, 0x2f, 0x53, 0xb2,
+		0x7c, 0x15, 0xf2, 0x21, 0x2b, 0x9d, 0x3b, 0xd9, 0x63, 0xcc, 0x9d, 0xe9, 0x40, 0x16, 0x4b, 0xe5,
+		0xcb, 0x00, 0xe1, 0xc4, 0xa4, 0xe9, 0x81, 0xa9, 0x73, 0xa5, 0xd1, 0xb3, 0x5b, 0x6c, 0xb3, 0x42,
+		0x59, 0xd9, 0x84, 0xd9, 0xb6, 0x61, 0xa9, 0x1e, 0x31, 0xf7, 0x54, 0xae, 0x2a, 0x84, 0x9c, 0xba,
+		0x03, 0x43, 0x3b, 0xd3, 0x36, 0xac, 0x06, 0x31, 0xf7, 0x6a, 0x01, 0xac, 0xec, 0xc0, 0x89, 0x30,
+		0xec, 0xc5, 0x0e, 0x89, 0xa1, 0xce, 0xdd, 0x81, 0xa1, 0x9e, 0x0d, 0xa0, 0xa9, 0xd5, 0xb2, 0xe1,
+		0xd6, 0x60, 0xda, 0x34, 0x3e, 0xdc, 0x31, 0x82, 0x9a, 0xa6, 0xef, 0x40, 0x4d, 0x39, 0x06, 0xc9,
+		0xaa, 0x28, 0xe7, 0x3e, 0xf6, 0xc6, 0xe2, 0x04, 0x77, 0x10, 0x13, 0xa5, 0x2d, 0x9a, 0x77, 0xe7,
+		0x73, 0x9b, 0x78, 0xf2, 0x05, 0xc8, 0x6a, 0xe2, 0x81, 0x66, 0x43, 0x8e, 0xf2, 0x0d, 0x21, 0x2b,
+		0x73, 0x39, 0xaf, 0xfe, 0xee, 0x52, 0xac, 0xf4, 0xf3, 0x31, 0x48, 0xd7, 0xae, 0x6d, 0x69, 0x86,
+		0x2b, 0xd7, 0xf1, 0x44, 0x5e, 0xcc, 0x92, 0x71, 0x1d, 0x4e, 0x38, 0xb1, 0x38, 0x1d, 0x61, 0x06,
+		0x6f, 0x85, 0x8f, 0x84, 0xe9, 0xdd, 0x24, 0xf7, 0x74, 0xbc, 0x0e, 0x93, 0xac, 0x95, 0xf8, 0xfa,
+		0x76, 0xca, 0xc1, 0x1f, 0xc5, 0x58, 0xd7, 0xf9, 0x7c, 0xff, 0xec, 0xa2, 0xfc, 0x41, 0x5a, 0x14,
+		0x45, 0x4a, 0x7f, 0x12, 0x03, 0xa8, 0x5d, 0xbb, 0xb6, 0xed, 0x1a, 0x8e, 0x49, 0xfc, 0x3b, 0xd5,
+		0xe3, 0xb5, 0xa8, 0xe1, 0x79, 0xae, 0x3e, 0x76, 0xaf, 0x43, 0xa3, 0x6a, 0xb8, 0xfa, 0x40, 0xb4,
+		0xa6, 0xe7, 0x07, 0x68, 0x89, 0xb1, 0xd1, 0x6a, 0x9e, 0x3f, 0x58, 0x8d, 0x0d, 0x98, 0x0a, 0xbb,
+		0x8f, 0x5f, 0x01, 0xcc, 0xf8, 0xfc, 0x37, 0xd7, 0x66, 0x69, 0xb8, 0x36, 0x85, 0x18, 0xd7, 0x68,
+		0x20, 0x59, 0xfa, 0x85, 0x38, 0x40, 0x64, 0x1a, 0xbe, 0xa7, 0xcc, 0x08, 0x17, 0x14, 0x3e, 0x37,
+		0xef, 0x44, 0x98, 0xc4, 0xb1, 0x30, 0x8b, 0xda, 0xed, 0x6a, 0xd8, 0x67, 0x9c, 0x95, 0xe9, 0x2e,
+		0x2f, 0xd1, 0xa3, 0xfc, 0x8f, 0xc6, 0xf1, 0x13, 0x18, 0xdc, 0xd3, 0xbe, 0x67, 0x15, 0xb6, 0x05,
+		0x93, 0xc4, 0xf2, 0x5d, 0x83, 0x6a, 0x0c, 0x4d, 0xe2, 0xd1, 0x61, 0x26, 0x31, 0xa0, 0x2f, 0xf4,
+		0x0b, 0x6c, 0x22, 0xa7, 0xcf, 0x61, 0x7a, 0xb4, 0xf0, 0x1f, 0xe2, 0x50, 0x1c, 0x26, 0x89, 0x19,
+		0x4a, 0xdd, 0x25, 0x94, 0xa0, 0x76, 0x25, 0x16, 0xf3, 0x82, 0xcc, 0x17, 0xbc, 0x75, 0xc0, 0xe0,
+		0x11, 0xed, 0x0f, 0x59, 0x8f, 0x1d, 0x2d, 0xe6, 0x43, 0x61, 0x2c, 0x96, 0x09, 0x14, 0x0c, 0xcb,
+		0xf0, 0x0d, 0xcd, 0x54, 0x77, 0x35, 0x53, 0xb3, 0xf4, 0xdb, 0x89, 0xaa, 0xfb, 0x17, 0xa9, 0x3c,
+		0x07, 0xad, 0x32, 0x4c, 0xf9, 0x1a, 0x4c, 0x0a, 0xf8, 0xe4, 0x1d, 0x80, 0x17, 0x60, 0x91, 0x08,
+		0xf2, 0x77, 0xe2, 0x30, 0xa3, 0x90, 0xe6, 0x9f, 0x2e, 0xb5, 0xfe, 0x30, 0x00, 0x9b, 0x97, 0xe8,
+		0x2e, 0x8b, 0xc9, 0x3b, 0x30, 0xcf, 0xb3, 0x0c, 0xaf, 0xe6, 0xf9, 0x11, 0xdd, 0x7e, 0x2d, 0x0e,
+		0xb9, 0xa8, 0x6e, 0xff, 0x14, 0x2c, 0x1f, 0xf2, 0x6a, 0xe8, 0x0d, 0x92, 0xfc, 0xdb, 0xd1, 0x43,
+		0xbc, 0x41, 0x9f, 0xd5, 0x1d, 0xed, 0x06, 0x6e, 0x65, 0x20, 0xbd, 0xa5, 0xb9, 0x5a, 0xdb, 0x93,
+		0xaf, 0xf4, 0x05, 0xaf, 0x22, 0xc3, 0xd8, 0xf7, 0x3f, 0x1d, 0x78, 0x42, 0x83, 0x99, 0xdc, 0x27,
+		0x07, 0xc4, 0xae, 0xef, 0x83, 0x3c, 0x6e, 0x8f, 0x23, 0x97, 0x11, 0xe2, 0xf4, 0x88, 0x15, 0xf7,
+		0xb7, 0xe1, 0x49, 0x18, 0x7e, 0x3a, 0x05, 0xd9, 0x42, 0x47, 0x87, 0x3c, 0xd0, 0xd6, 0x6e, 0xd6,
+		0x19, 0x45, 0x7e, 0x04, 0xe4, 0xfd, 0x20, 0x61, 0xa1, 0x86, 0x2a, 0x40, 0xbe, 0x99, 0xb0, 0x44,
+		0xb0, 0x63, 0x5e, 0x13, 0x03, 0x4e, 0x76, 0xc1, 0x8d, 0xed, 0xef, 0xb2, 0x48, 0xa9, 0x21, 0x41,
+		0xfe, 0xf3, 0x2c, 0x0e, 0xee, 0xd9, 0x39, 0xf3, 0x2d, 0xc8, 0xda, 0xf1, 0x2c, 0xf5, 0xbb, 0x6f,
+		0x2d, 0xce, 0x1f, 0x6a, 0x6d, 0xb3, 0x5c, 0x1a, 0x00, 0x59, 0xa2, 0x71, 0x71, 0xf7, 0x8e, 0x5b,
+		0x7e, 0x02, 0x80, 0x38, 0xb6, 0xbe, 0xaf, 0xb6, 0xed, 0x26, 0xcb, 0x7a, 0x67, 0xaa, 0x27, 0xbe,
+		0xfb, 0xd6, 0xe2, 0x0c, 0x83, 0x09, 0xcb, 0x4a, 0x4a, 0x96, 0x3e, 0xd0, 0xcf, 0x8c, 0xfe, 0xa5,
+		0x58, 0x5f, 0x38, 0xbd, 0xa7, 0xe9, 0xbe, 0xed, 0xb2, 0xef, 0x11, 0x55, 0x37, 0x8e, 0xdd, 0xec,
+		0xbb, 0x59, 0x7d, 0x03, 0x41, 0x4b, 0x3d, 0x01, 0xf6, 0x45, 0x4a, 0x95, 0x7f, 0x2c, 0x06, 0xa7,
+		0x5a, 0xa6, 0xbd, 0xab, 0x99, 0xaa, 0x08, 0xb4, 0x99, 0xd9, 0xa9, 0xba, 0xe6, 0xd0, 0x3d, 0x4e,
+		0xb6, 0xaa, 0x1c, 0xbb, 0x21, 0x4b, 0xac, 0x21, 0x43, 0x81, 0x4b, 0xca, 0x49, 0x56, 0xb6, 0xc6,
+		0x22, 0x71, 0x56, 0xb2, 0xa2, 0x39, 0xf2, 0x4f, 0xc6, 0xe0, 0xee, 0xb0, 0xfd, 0x03, 0x9a, 0x44,
+		0x3f, 0xf5, 0x5f, 0xdd, 0x39, 0x76, 0x93, 0xee, 0xeb, 0xd5, 0xcd, 0xa0, 0x56, 0x9d, 0x0a, 0x8a,
+		0xfb, 0x1a, 0xd6, 0x04, 0xe9, 0x80, 0x1c, 0xaa, 0x2e, 0xff, 0x22, 0x8f, 0xba, 0x47, 0xd8, 0xe7,
+		0x8f, 0x71, 0x16, 0x0d, 0xb8, 0x1f, 0xba, 0x8c, 0x37, 0x32, 0xab, 0x8b, 0xd8, 0xcc, 0xef, 0xbe,
+		0xb5, 0x78, 0x17, 0xab, 0xbc, 0x17, 0xa0, 0xa4, 0xe4, 0x0f, 0xc8, 0xa1, 0xc2, 0x29, 0x17, 0x09,
+		0x91, 0x55, 0x38, 0x45, 0x93, 0x4f, 0xb6, 0x25, 0x72, 0x09, 0x81, 0x00, 0xdb, 0x65, 0x4d, 0x57,
+		0xef, 0x0f, 0xf5, 0x3b, 0x94, 0xb5, 0xa4, 0x9c, 0xc4, 0x74, 0x93, 0x6d, 0xf1, 0x4c, 0x82, 0xa8,
+		0x22, 0x9a, 0x0a, 0xf9, 0x4c, 0x0c, 0xe4, 0x30, 0x3a, 0x50, 0x88, 0xe7, 0xd8, 0x96, 0x47, 0xf7,
+		0xa6, 0x91, 0x8d, 0x64, 0xec, 0xe8, 0xbd, 0x69, 0x28, 0x2f, 0xf6, 0xa6, 0xa1, 0x2c, 0x7e, 0xe4,
+		0x5c, 0xac, 0x49, 0xf1, 0x51, 0x8a, 0xe2, 0x9e, 0xac, 0x77, 0xb9, 0x9d, 0x28, 0xfd, 0x4e, 0x0c,
+		0x4e, 0xf5, 0x39, 0xbe, 0xa0, 0xb1, 0x7f, 0x0e, 0x64, 0x37, 0x52, 0xc8, 0xbf, 0x57, 0xcb, 0x1a,
+		0x7d, 0x6c, 0x3f, 0x3a, 0xe3, 0xf6, 0x16, 0xbc, 0x6b, 0xe1, 0x04, 0xbb, 0x60, 0xfb, 0x4f, 0x63,
+		0x30, 0x17, 0x6d, 0x4c, 0xd0, 0xad, 0x0d, 0xc8, 0x45, 0xdb, 0xc2, 0x3b, 0x74, 0xff, 0x38, 0x1d,
+		0xe2, 0x7d, 0xe9, 0x92, 0x97, 0x9f, 0x0b, 0xd7, 0x18, 0x96, 0xd3, 0x7d, 0x6c, 0x6c, 0xdd, 0x88,
+		0x36, 0xf5, 0xae, 0x35, 0x49, 0x11, 0x70, 0x27, 0xb7, 0x6c, 0xdb, 0x94, 0xff, 0x02, 0xcc, 0x58,
+		0xb6, 0x4f, 0xfd, 0x0d, 0x69, 0xaa, 0x3c, 0xc1, 0xc4, 0x16, 0xea, 0xe7, 0x8e, 0xa7, 0xb2, 0x6f,
+		0xbd, 0xb5, 0xd8, 0x0f, 0xd5, 0xa3, 0xc7, 0x82, 0x65, 0xfb, 0x55, 0x5a, 0xbe, 0x4d, 0x8b, 0x65,
+		0x17, 0xa6, 0xbb, 0xab, 0x66, 0x0b, 0xfb, 0xfa, 0xb1, 0xab, 0x9e, 0x3e, 0xaa, 0xda, 0xdc, 0x6e,
+		0xa4, 0x4e, 0x76, 0xf5, 0xf0, 0x3b, 0x38, 0x8e, 0xbf, 0x11, 0x83, 0x59, 0x4a, 0x34, 0x5e, 0x21,
+		0x34, 0xa9, 0xa0, 0x10, 0xdd, 0x76, 0x9b, 0x72, 0x1e, 0xe2, 0xfc, 0x30, 0x2f, 0xa9, 0xc4, 0x0d,
+		0xfc, 0x26, 0x78, 0xca, 0xbe, 0x61, 0xf1, 0x9b, 0x40, 0x47, 0x05, 0x0a, 0x8c, 0x8d, 0x2e, 0xb5,
+		0x76, 0xb3, 0x63, 0x12, 0xfc, 0xd2, 0x33, 0xbd, 0xc1, 0xcd, 0x92, 0xa3, 0xd3, 0x8c, 0x5a, 0x61,
+		0x44, 0x4c, 0x51, 0x04, 0x6e, 0xab, 0x98, 0x1c, 0x01, 0x1d, 0xb2, 0x72, 0x23, 0x7c, 0x3d, 0x0e,
+		0x27, 0xc3, 0x69, 0xcc, 0xce, 0x10, 0x0e, 0x99, 0xf5, 0xbf, 0xb7, 0xb6, 0x4d, 0xc3, 0x2e, 0x24,
+		0x85, 0xfb, 0xcf, 0xe4, 0x9d, 0xdb, 0x7f, 0x72, 0xe5, 0xfc, 0x6c, 0x1c, 0x16, 0x07, 0x6c, 0xa5,
+		0xfe, 0x3f, 0xd4, 0xd2, 0x56, 0x6f, 0x98, 0xf9, 0x7d, 0x6f, 0x3a, 0x99, 0x86, 0xfe, 0x30, 0x0e,
+		0xc5, 0xa8, 0xd3, 0x78, 0x37, 0x54, 0xf3, 0x5e, 0x0e, 0xdf, 0x43, 0x7d, 0x27, 0xbb, 0xf4, 0x1d,
+		0x09, 0xeb, 0x53, 0xdf, 0x67, 0x58, 0xcf, 0x14, 0xfd, 0xc5, 0x04, 0x9c, 0xe2, 0x0b, 0xfa, 0xd5,
+		0x70, 0x41, 0xe7, 0xea, 0xbe, 0x33, 0x27, 0x19, 0xd7, 0xa0, 0x60, 0x9b, 0xcd, 0x68, 0x44, 0x71,
+		0x9b, 0x07, 0x19, 0xd3, 0xb6, 0xd9, 0xe4, 0x6d, 0xc5, 0x63, 0x8c, 0x6b, 0x50, 0xb0, 0xc8, 0x8d,
+		0x2e, 0xdc, 0xc4, 0xed, 0xe1, 0x5a, 0xe4, 0x46, 0x04, 0x77, 0x98, 0xee, 0x1f, 0x83, 0x04, 0x46,
+		0x67, 0xa9, 0xf1, 0x82, 0x0e, 0xe4, 0x1d, 0xb4, 0x2d, 0x4f, 0xdf, 0xfe, 0xb6, 0xbc, 0x9c, 0xf9,
+		0x18, 0x8f, 0x5f, 0x1e, 0xfe, 0x52, 0x0c, 0x20, 0x3c, 0x85, 0xc1, 0x83, 0xfa, 0xea, 0xe6, 0x46,
+		0x4d, 0x6d, 0x6c, 0x57, 0xb6, 0x77, 0x1a, 0xdd, 0x2f, 0xaf, 0x89, 0x63, 0x7d, 0xcf, 0x21, 0x3a,
+		0xfd, 0x22, 0xbb, 0xfc, 0x00, 0xcc, 0x75, 0x73, 0xe3, 0x13, 0xfe, 0x5f, 0x82, 0xf9, 0xdc, 0x6b,
+		0xb7, 0x96, 0x32, 0x6c, 0xa6, 0x12, 0xbc, 0x14, 0x79, 0xa2, 0x9f, 0x0f, 0x5f, 0x7c, 0x8b, 0xcf,
+		0x4f, 0xbf, 0x76, 0x6b, 0x29, 0x1b, 0x4c, 0x69, 0xb9, 0x04, 0x72, 0x94, 0x93, 0xe3, 0x25, 0xe6,
+		0xe1, 0xb5, 0x5b, 0x4b, 0x69, 0xb6, 0xa4, 0xce, 0x27, 0xf1, 0xf0, 0xbe, 0x7a, 0x71, 0xe8, 0xc1,
+		0xfd, 0x07, 0x8e, 0x74, 0xac, 0x37, 0x83, 0xc3, 0xf8, 0xae, 0xd3, 0xfa, 0xff, 0x3b, 0x00, 0x71,
+		0xb2, 0x47, 0x80, 0x69, 0x75, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -1939,6 +2435,24 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
 		return false
 	}
+	if this.EpochMode != that1.EpochMode {
+		return false
+	}
+	if !this.ValidatorBondFactor.Equal(that1.ValidatorBondFactor) {
+		return false
+	}
+	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorLiquidStakingCap.Equal(that1.ValidatorLiquidStakingCap) {
+		return false
+	}
+	if !this.KeyRotationFee.Equal(&that1.KeyRotationFee) {
+		return false
+	}
+	if this.MaxConsPubkeyRotations != that1.MaxConsPubkeyRotations {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -1995,19 +2509,164 @@ func (this *Pool) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *TokenizeShareRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *HistoricalInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*TokenizeShareRecord)
+	if !ok {
+		that2, ok := that.(TokenizeShareRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if this.ModuleAccount != that1.ModuleAccount {
+		return false
+	}
+	if this.Validator != that1.Validator {
+		return false
+	}
+	return true
+}
+func (this *DelegationHistoryEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegationHistoryEntry)
+	if !ok {
+		that2, ok := that.(DelegationHistoryEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DelegatorAddress != that1.DelegatorAddress {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Shares.Equal(that1.Shares) {
+		return false
+	}
+	return true
+}
+func (this *UnbondingDelegationHistoryEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UnbondingDelegationHistoryEntry)
+	if !ok {
+		that2, ok := that.(UnbondingDelegationHistoryEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DelegatorAddress != that1.DelegatorAddress {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if len(this.Entries) != len(that1.Entries) {
+		return false
+	}
+	for i := range this.Entries {
+		if !this.Entries[i].Equal(&that1.Entries[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *RedelegationHistoryEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RedelegationHistoryEntry)
+	if !ok {
+		that2, ok := that.(RedelegationHistoryEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DelegatorAddress != that1.DelegatorAddress {
+		return false
+	}
+	if this.ValidatorSrcAddress != that1.ValidatorSrcAddress {
+		return false
+	}
+	if this.ValidatorDstAddress != that1.ValidatorDstAddress {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if len(this.Entries) != len(that1.Entries) {
+		return false
+	}
+	for i := range this.Entries {
+		if !this.Entries[i].Equal(&that1.Entries[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoricalInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
 func (m *HistoricalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
@@ -2214,6 +2873,26 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.LiquidShares.Size()
+		i -= size
+		if _, err := m.LiquidShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.ValidatorBondShares.Size()
+		i -= size
+		if _, err := m.ValidatorBondShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
 	{
 		size := m.MinSelfDelegation.Size()
 		i -= size
@@ -2521,6 +3200,16 @@ func (m *Delegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ValidatorBond {
+		i--
+		if m.ValidatorBond {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	{
 		size := m.Shares.Size()
 		i -= size
@@ -2789,6 +3478,61 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxConsPubkeyRotations != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MaxConsPubkeyRotations))
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size, err := m.KeyRotationFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.ValidatorLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.ValidatorLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.GlobalLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.ValidatorBondFactor.Size()
+		i -= size
+		if _, err := m.ValidatorBondFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.EpochMode {
+		i--
+		if m.EpochMode {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
 	{
 		size := m.MinCommissionRate.Size()
 		i -= size
@@ -2821,12 +3565,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err10 != nil {
-		return 0, err10
+	n11, err11 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err11 != nil {
+		return 0, err11
 	}
-	i -= n10
-	i = encodeVarintStaking(dAtA, i, uint64(n10))
+	i -= n11
+	i = encodeVarintStaking(dAtA, i, uint64(n11))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -3008,169 +3752,324 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStaking(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *HistoricalInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *TokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Header.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if len(m.Valset) > 0 {
-		for _, e := range m.Valset {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
-		}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *CommissionRates) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DelegationHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Rate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.MaxRate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.MaxChangeRate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Commission) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.CommissionRates.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateTime)
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+func (m *DelegationHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Description) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DelegationHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Moniker)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	l = len(m.Identity)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.Website)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.SecurityContact)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Details)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Validator) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UnbondingDelegationHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbondingDelegationHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingDelegationHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	if m.ConsensusPubkey != nil {
-		l = m.ConsensusPubkey.Size()
-		n += 1 + l + sovStaking(uint64(l))
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.Jailed {
-		n += 2
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Status != 0 {
-		n += 1 + sovStaking(uint64(m.Status))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.Tokens.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.DelegatorShares.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.Description.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if m.UnbondingHeight != 0 {
-		n += 1 + sovStaking(uint64(m.UnbondingHeight))
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UnbondingTime)
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.Commission.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.MinSelfDelegation.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ValAddresses) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RedelegationHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RedelegationHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedelegationHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovStaking(uint64(l))
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
 		}
 	}
-	return n
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ValidatorDstAddress) > 0 {
+		i -= len(m.ValidatorDstAddress)
+		copy(dAtA[i:], m.ValidatorDstAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorDstAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorSrcAddress) > 0 {
+		i -= len(m.ValidatorSrcAddress)
+		copy(dAtA[i:], m.ValidatorSrcAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorSrcAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DVPair) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ConsPubKeyRotationHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsPubKeyRotationHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsPubKeyRotationHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err16 != nil {
+		return 0, err16
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	i -= n16
+	i = encodeVarintStaking(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NewConsPubkey != nil {
+		{
+			size, err := m.NewConsPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OldConsPubkey != nil {
+		{
+			size, err := m.OldConsPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DVPairs) Size() (n int) {
+func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStaking(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HistoricalInfo) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Pairs) > 0 {
-		for _, e := range m.Pairs {
+	l = m.Header.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.Valset) > 0 {
+		for _, e := range m.Valset {
 			l = e.Size()
 			n += 1 + l + sovStaking(uint64(l))
 		}
@@ -3178,22 +4077,168 @@ func (m *DVPairs) Size() (n int) {
 	return n
 }
 
-func (m *DVVTriplet) Size() (n int) {
+func (m *CommissionRates) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.ValidatorSrcAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.ValidatorDstAddress)
-	if l > 0 {
+	l = m.Rate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MaxRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *Commission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CommissionRates.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateTime)
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *Description) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.SecurityContact)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Details)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *Validator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.ConsensusPubkey != nil {
+		l = m.ConsensusPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.Status != 0 {
+		n += 1 + sovStaking(uint64(m.Status))
+	}
+	l = m.Tokens.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.DelegatorShares.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Description.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.UnbondingHeight != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UnbondingTime)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Commission.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MinSelfDelegation.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorBondShares.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.LiquidShares.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *ValAddresses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DVPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *DVPairs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pairs) > 0 {
+		for _, e := range m.Pairs {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DVVTriplet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorSrcAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorDstAddress)
+	if l > 0 {
 		n += 1 + l + sovStaking(uint64(l))
 	}
 	return n
@@ -3230,6 +4275,9 @@ func (m *Delegation) Size() (n int) {
 	}
 	l = m.Shares.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	if m.ValidatorBond {
+		n += 2
+	}
 	return n
 }
 
@@ -3342,6 +4390,20 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MinCommissionRate.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	if m.EpochMode {
+		n += 2
+	}
+	l = m.ValidatorBondFactor.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.GlobalLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.KeyRotationFee.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.MaxConsPubkeyRotations != 0 {
+		n += 1 + sovStaking(uint64(m.MaxConsPubkeyRotations))
+	}
 	return n
 }
 
@@ -3385,47 +4447,1413 @@ func (m *RedelegationResponse) Size() (n int) {
 			n += 1 + l + sovStaking(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *Pool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.NotBondedTokens.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.BondedTokens.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
-}
+	return n
+}
+
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NotBondedTokens.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.BondedTokens.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *TokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegationHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *UnbondingDelegationHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RedelegationHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorSrcAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorDstAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConsPubKeyRotationHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.OldConsPubkey != nil {
+		l = m.OldConsPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.NewConsPubkey != nil {
+		l = m.NewConsPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func sovStaking(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStaking(x uint64) (n int) {
+	return sovStaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ValAddresses) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ValAddresses{`,
+		`Addresses:` + fmt.Sprintf("%v", this.Addresses) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringStaking(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoricalInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoricalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Valset = append(m.Valset, Validator{})
+			if err := m.Valset[len(m.Valset)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommissionRates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommissionRates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommissionRates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Commission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Commission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Commission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionRates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Description) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Description: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Description: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecurityContact = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Details = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Validator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsensusPubkey == nil {
+				m.ConsensusPubkey = &types1.Any{}
+			}
+			if err := m.ConsensusPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BondStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingHeight", wireType)
+			}
+			m.UnbondingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorBondShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValAddresses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValAddresses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DVPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DVPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DVPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovStaking(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozStaking(x uint64) (n int) {
-	return sovStaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *ValAddresses) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ValAddresses{`,
-		`Addresses:` + fmt.Sprintf("%v", this.Addresses) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringStaking(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
+func (m *DVPairs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3448,15 +5876,15 @@ func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HistoricalInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: DVPairs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HistoricalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DVPairs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3483,13 +5911,210 @@ func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Pairs = append(m.Pairs, DVPair{})
+			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DVVTriplet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DVVTriplet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DVVTriplets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DVVTriplets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Triplets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3516,8 +6141,8 @@ func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Valset = append(m.Valset, Validator{})
-			if err := m.Valset[len(m.Valset)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Triplets = append(m.Triplets, DVVTriplet{})
+			if err := m.Triplets[len(m.Triplets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3542,7 +6167,7 @@ func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommissionRates) Unmarshal(dAtA []byte) error {
+func (m *Delegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3565,15 +6190,15 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommissionRates: wiretype end group for non-group")
+			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommissionRates: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3601,13 +6226,11 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3635,13 +6258,11 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3669,10 +6290,30 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBond", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ValidatorBond = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -3694,7 +6335,7 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Commission) Unmarshal(dAtA []byte) error {
+func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3717,17 +6358,17 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Commission: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnbondingDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Commission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -3737,28 +6378,59 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommissionRates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3785,7 +6457,8 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, UnbondingDelegationEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3810,7 +6483,7 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Description) Unmarshal(dAtA []byte) error {
+func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3833,17 +6506,17 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Description: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnbondingDelegationEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Description: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnbondingDelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
 			}
-			var stringLen uint64
+			m.CreationHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -3853,29 +6526,16 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CreationHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Moniker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -3885,27 +6545,28 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3933,11 +6594,13 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Website = string(dAtA[iNdEx:postIndex])
+			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3965,39 +6628,9 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecurityContact = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Details = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4020,7 +6653,7 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Validator) Unmarshal(dAtA []byte) error {
+func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4043,85 +6676,17 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedelegationEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ConsensusPubkey == nil {
-				m.ConsensusPubkey = &types1.Any{}
-			}
-			if err := m.ConsensusPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
 			}
-			var v int
+			m.CreationHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4131,17 +6696,16 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.CreationHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Jailed = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 			}
-			m.Status = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4151,14 +6715,28 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= BondStatus(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4186,13 +6764,13 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesDst", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4220,15 +6798,65 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DelegatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SharesDst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Redelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4238,49 +6866,29 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingHeight", wireType)
-			}
-			m.UnbondingHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4290,30 +6898,29 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4323,30 +6930,29 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4356,23 +6962,23 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, RedelegationEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4397,7 +7003,7 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValAddresses) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4407,28 +7013,118 @@ func (m *ValAddresses) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowStaking
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
+			}
+			m.MaxValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValidators |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
+			}
+			m.MaxEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.HistoricalEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoricalEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValAddresses: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4456,61 +7152,11 @@ func (m *ValAddresses) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.BondDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DVPair) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DVPair: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVPair: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4538,11 +7184,33 @@ func (m *DVPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochMode", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EpochMode = bool(v != 0)
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondFactor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4570,63 +7238,15 @@ func (m *DVPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.ValidatorBondFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DVPairs) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DVPairs: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVPairs: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4636,79 +7256,29 @@ func (m *DVPairs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pairs = append(m.Pairs, DVPair{})
-			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DVVTriplet: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVVTriplet: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidStakingCap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4736,13 +7306,15 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.ValidatorLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotationFee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4752,29 +7324,30 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.KeyRotationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConsPubkeyRotations", wireType)
 			}
-			var stringLen uint64
+			m.MaxConsPubkeyRotations = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4784,24 +7357,11 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxConsPubkeyRotations |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -4823,7 +7383,7 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
+func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4846,15 +7406,15 @@ func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DVVTriplets: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVVTriplets: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Triplets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4881,8 +7441,40 @@ func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Triplets = append(m.Triplets, DVVTriplet{})
-			if err := m.Triplets[len(m.Triplets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4907,7 +7499,7 @@ func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Delegation) Unmarshal(dAtA []byte) error {
+func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4930,17 +7522,17 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedelegationEntryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedelegationEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationEntry", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4950,59 +7542,28 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.RedelegationEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5030,7 +7591,7 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5055,7 +7616,7 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
+func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5078,17 +7639,17 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingDelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5098,57 +7659,26 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Redelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
@@ -5177,7 +7707,7 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, UnbondingDelegationEntry{})
+			m.Entries = append(m.Entries, RedelegationEntryResponse{})
 			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -5203,7 +7733,7 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
+func (m *Pool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5216,77 +7746,25 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingDelegationEntry: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingDelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
-			}
-			m.CreationHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreationHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBondedTokens", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5314,13 +7792,13 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NotBondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5348,7 +7826,7 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5373,7 +7851,7 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
+func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5396,17 +7874,17 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenizeShareRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.CreationHeight = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5416,16 +7894,16 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreationHeight |= int64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5435,28 +7913,27 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5484,13 +7961,11 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SharesDst", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5518,9 +7993,7 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SharesDst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5543,7 +8016,7 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Redelegation) Unmarshal(dAtA []byte) error {
+func (m *DelegationHistoryEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5566,10 +8039,10 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegationHistoryEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegationHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5606,7 +8079,7 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5634,13 +8107,13 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5650,29 +8123,16 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5682,23 +8142,23 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, RedelegationEntry{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5723,7 +8183,7 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *UnbondingDelegationHistoryEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5746,105 +8206,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnbondingDelegationHistoryEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnbondingDelegationHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
-			}
-			m.MaxValidators = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxValidators |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
-			}
-			m.MaxEntries = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxEntries |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
-			}
-			m.HistoricalEntries = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.HistoricalEntries |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5872,11 +8242,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5904,65 +8274,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5972,28 +8290,14 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6020,7 +8324,8 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, UnbondingDelegationEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6045,7 +8350,7 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
+func (m *RedelegationHistoryEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6068,17 +8373,17 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationEntryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedelegationHistoryEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedelegationHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationEntry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6088,28 +8393,59 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RedelegationEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6137,7 +8473,58 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, RedelegationEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6162,7 +8549,7 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *ConsPubKeyRotationHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6185,17 +8572,17 @@ func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsPubKeyRotationHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsPubKeyRotationHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Redelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6205,28 +8592,27 @@ func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Redelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldConsPubkey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6253,66 +8639,73 @@ func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, RedelegationEntryResponse{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.OldConsPubkey == nil {
+				m.OldConsPubkey = &types1.Any{}
+			}
+			if err := m.OldConsPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewConsPubkey", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Pool) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
+			if m.NewConsPubkey == nil {
+				m.NewConsPubkey = &types1.Any{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := m.NewConsPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotBondedTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6322,31 +8715,30 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NotBondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6356,23 +8748,22 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,26 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// GetModuleAddress returns the address of the module account holding the
+// delegation of the record.
+func (r TokenizeShareRecord) GetModuleAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(r.ModuleAccount)
+}
+
+// GetShareTokenDenom returns the denom of the share tokens minted for the
+// record.
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return fmt.Sprintf("%s/%d", r.Validator, r.Id)
+}
+
+// GetTokenizeShareRecordModuleAccount returns the name of the module account
+// holding the delegation of the record with the given id.
+func GetTokenizeShareRecordModuleAccount(id uint64) string {
+	return fmt.Sprintf("tokenizeshare_%d", id)
+}